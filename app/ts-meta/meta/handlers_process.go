@@ -137,9 +137,13 @@ func (h *Execute) Process() (transport.Codec, error) {
 	}
 
 	body := h.req.Body
-	var err error
 	// Make sure it's a valid command.
-	if _, err = validateCommand(body); err != nil {
+	cmd, err := validateCommand(body)
+	if err != nil {
+		rsp.Err = err.Error()
+		return rsp, nil
+	}
+	if body, err = stampLeaderTime(cmd, body); err != nil {
 		rsp.Err = err.Error()
 		return rsp, nil
 	}
@@ -655,9 +655,11 @@ func (s *Store) deleteMeasurement(db string, rp *meta.RetentionPolicyInfo, mst s
 	for sgIdx := range rp.ShardGroups {
 		for shIdx := range rp.ShardGroups[sgIdx].Shards {
 			if rp.ShardGroups[sgIdx].Shards[shIdx].ContainPrefix(mst) {
-				ptId := rp.ShardGroups[sgIdx].Shards[shIdx].Owners[0]
-				nodeId := s.cacheData.PtView[db][ptId].Owner.NodeID
-				nodeShardsMap[nodeId] = append(nodeShardsMap[nodeId], rp.ShardGroups[sgIdx].Shards[shIdx].ID)
+				// every replica of the shard drops the measurement
+				for _, ptId := range rp.ShardGroups[sgIdx].Shards[shIdx].Owners {
+					nodeId := s.cacheData.PtView[db][ptId].Owner.NodeID
+					nodeShardsMap[nodeId] = append(nodeShardsMap[nodeId], rp.ShardGroups[sgIdx].Shards[shIdx].ID)
+				}
 			}
 		}
 	}
@@ -116,6 +116,18 @@ func (fsm *storeFSM) executeCmd(cmd proto2.Command) interface{} {
 		return fsm.applyCreateSubscriptionCommand(&cmd)
 	case proto2.Command_DropSubscriptionCommand:
 		return fsm.applyDropSubscriptionCommand(&cmd)
+	case proto2.Command_CreateContinuousQueryCommand:
+		return fsm.applyCreateContinuousQueryCommand(&cmd)
+	case proto2.Command_DropContinuousQueryCommand:
+		return fsm.applyDropContinuousQueryCommand(&cmd)
+	case proto2.Command_ContinuousQueryLeaseCommand:
+		return fsm.applyContinuousQueryLeaseCommand(&cmd)
+	case proto2.Command_ContinuousQueryReportCommand:
+		return fsm.applyContinuousQueryReportCommand(&cmd)
+	case proto2.Command_CreateDownSamplePolicyCommand:
+		return fsm.applyCreateDownSamplePolicyCommand(&cmd)
+	case proto2.Command_DropDownSamplePolicyCommand:
+		return fsm.applyDropDownSamplePolicyCommand(&cmd)
 	case proto2.Command_CreateUserCommand:
 		return fsm.applyCreateUserCommand(&cmd)
 	case proto2.Command_DropUserCommand:
@@ -126,6 +138,18 @@ func (fsm *storeFSM) executeCmd(cmd proto2.Command) interface{} {
 		return fsm.applySetPrivilegeCommand(&cmd)
 	case proto2.Command_SetAdminPrivilegeCommand:
 		return fsm.applySetAdminPrivilegeCommand(&cmd)
+	case proto2.Command_CreateRoleCommand:
+		return fsm.applyCreateRoleCommand(&cmd)
+	case proto2.Command_DropRoleCommand:
+		return fsm.applyDropRoleCommand(&cmd)
+	case proto2.Command_SetUserRoleCommand:
+		return fsm.applySetUserRoleCommand(&cmd)
+	case proto2.Command_SetRolePrivilegeCommand:
+		return fsm.applySetRolePrivilegeCommand(&cmd)
+	case proto2.Command_SetRateLimitCommand:
+		return fsm.applySetRateLimitCommand(&cmd)
+	case proto2.Command_ReplicaLagCommand:
+		return fsm.applyReplicaLagCommand(&cmd)
 	case proto2.Command_SetDataCommand:
 		return fsm.applySetDataCommand(&cmd)
 	case proto2.Command_CreateMetaNodeCommand:
@@ -363,6 +387,50 @@ func (fsm *storeFSM) applyDropSubscriptionCommand(cmd *proto2.Command) interface
 	return fsm.data.DropSubscription(v.GetDatabase(), v.GetRetentionPolicy(), v.GetName())
 }
 
+func (fsm *storeFSM) applyCreateContinuousQueryCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateContinuousQueryCommand_Command)
+	v := ext.(*proto2.CreateContinuousQueryCommand)
+	return fsm.data.CreateContinuousQuery(v.GetDatabase(), v.GetName(), v.GetQuery())
+}
+
+func (fsm *storeFSM) applyDropContinuousQueryCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropContinuousQueryCommand_Command)
+	v := ext.(*proto2.DropContinuousQueryCommand)
+	return fsm.data.DropContinuousQuery(v.GetDatabase(), v.GetName())
+}
+
+func (fsm *storeFSM) applyContinuousQueryLeaseCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_ContinuousQueryLeaseCommand_Command)
+	v := ext.(*proto2.ContinuousQueryLeaseCommand)
+	return fsm.data.AcquireContinuousQueryLease(v.GetOwner(), time.Unix(0, v.GetTime()).UTC(), time.Duration(v.GetDuration()))
+}
+
+func (fsm *storeFSM) applyContinuousQueryReportCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_ContinuousQueryReportCommand_Command)
+	v := ext.(*proto2.ContinuousQueryReportCommand)
+	for _, r := range v.GetReports() {
+		err := fsm.data.SetContinuousQueryLastRun(v.GetOwner(), r.GetDatabase(), r.GetName(), time.Unix(0, r.GetLastRunTime()).UTC())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fsm *storeFSM) applyCreateDownSamplePolicyCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateDownSamplePolicyCommand_Command)
+	v := ext.(*proto2.CreateDownSamplePolicyCommand)
+	policy := &meta2.DownSamplePolicyInfo{}
+	policy.Unmarshal(v.GetPolicy())
+	return fsm.data.CreateDownSamplePolicy(v.GetDatabase(), v.GetRetentionPolicy(), policy)
+}
+
+func (fsm *storeFSM) applyDropDownSamplePolicyCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropDownSamplePolicyCommand_Command)
+	v := ext.(*proto2.DropDownSamplePolicyCommand)
+	return fsm.data.DropDownSamplePolicy(v.GetDatabase(), v.GetRetentionPolicy())
+}
+
 func (fsm *storeFSM) applyCreateUserCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_CreateUserCommand_Command)
 	v := ext.(*proto2.CreateUserCommand)
@@ -394,6 +462,64 @@ func (fsm *storeFSM) applySetPrivilegeCommand(cmd *proto2.Command) interface{} {
 	return err
 }
 
+func (fsm *storeFSM) applyCreateRoleCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateRoleCommand_Command)
+	v := ext.(*proto2.CreateRoleCommand)
+	err := fsm.data.CreateRole(v.GetName())
+	fsm.Logger.Info("apply create role command", zap.String("role", v.GetName()), zap.Error(err))
+	return err
+}
+
+func (fsm *storeFSM) applyDropRoleCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropRoleCommand_Command)
+	v := ext.(*proto2.DropRoleCommand)
+	err := fsm.data.DropRole(v.GetName())
+	fsm.Logger.Info("apply drop role command", zap.String("role", v.GetName()), zap.Error(err))
+	return err
+}
+
+func (fsm *storeFSM) applySetUserRoleCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetUserRoleCommand_Command)
+	v := ext.(*proto2.SetUserRoleCommand)
+	err := fsm.data.SetUserRole(v.GetUsername(), v.GetRole(), v.GetRevoke())
+	fsm.Logger.Info("apply set user role command", zap.String("userID", v.GetUsername()),
+		zap.String("role", v.GetRole()), zap.Bool("revoke", v.GetRevoke()), zap.Error(err))
+	return err
+}
+
+func (fsm *storeFSM) applySetRolePrivilegeCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetRolePrivilegeCommand_Command)
+	v := ext.(*proto2.SetRolePrivilegeCommand)
+	var grant meta2.RoleGrant
+	grant.Unmarshal(v.GetGrant())
+	err := fsm.data.SetRolePrivilege(v.GetRole(), grant, v.GetRevoke())
+	fsm.Logger.Info("apply set role privilege command", zap.String("role", v.GetRole()),
+		zap.String("privilege", grant.Privilege.String()), zap.String("on", grant.Scope().String()),
+		zap.Bool("revoke", v.GetRevoke()), zap.Error(err))
+	return err
+}
+
+func (fsm *storeFSM) applySetRateLimitCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetRateLimitCommand_Command)
+	v := ext.(*proto2.SetRateLimitCommand)
+	var rl meta2.RateLimitInfo
+	rl.Unmarshal(v.GetLimit())
+	err := fsm.data.SetRateLimit(rl)
+	fsm.Logger.Info("apply set rate limit command", zap.String("db", rl.Database), zap.String("user", rl.User),
+		zap.Int64("points", rl.PointsPerSecond), zap.Int64("bytes", rl.BytesPerSecond),
+		zap.Int64("queries", rl.QueriesPerSecond), zap.Error(err))
+	return err
+}
+
+func (fsm *storeFSM) applyReplicaLagCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_ReplicaLagCommand_Command)
+	v := ext.(*proto2.ReplicaLagCommand)
+	err := fsm.data.SetReplicaLag(v.GetDatabase(), v.GetPolicy(), v.GetShardID(), v.GetPtID(), v.GetLagging(), v.GetSeq())
+	fsm.Logger.Info("apply replica lag command", zap.String("db", v.GetDatabase()), zap.Uint64("shard", v.GetShardID()),
+		zap.Uint32("pt", v.GetPtID()), zap.Bool("lagging", v.GetLagging()), zap.Uint64("seq", v.GetSeq()), zap.Error(err))
+	return err
+}
+
 func (fsm *storeFSM) applySetAdminPrivilegeCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetAdminPrivilegeCommand_Command)
 	v := ext.(*proto2.SetAdminPrivilegeCommand)
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
@@ -32,3 +33,24 @@ func validateCommand(b []byte) (*proto2.Command, error) {
 
 	return cmd, nil
 }
+
+// stampLeaderTime replaces the time of commands whose outcome depends on the current time with
+// the clock of the meta leader, so that the clock skew between sql nodes does not matter.
+func stampLeaderTime(cmd *proto2.Command, b []byte) ([]byte, error) {
+	if cmd.GetType() != proto2.Command_ContinuousQueryLeaseCommand {
+		return b, nil
+	}
+	ext, err := proto.GetExtension(cmd, proto2.E_ContinuousQueryLeaseCommand_Command)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := ext.(*proto2.ContinuousQueryLeaseCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid continuous query lease command")
+	}
+	v.Time = proto.Int64(time.Now().UTC().UnixNano())
+	if err = proto.SetExtension(cmd, proto2.E_ContinuousQueryLeaseCommand_Command, v); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(cmd)
+}
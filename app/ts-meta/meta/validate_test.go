@@ -0,0 +1,60 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStampLeaderTime(t *testing.T) {
+	typ := proto2.Command_ContinuousQueryLeaseCommand
+	cmd := &proto2.Command{Type: &typ}
+	require.NoError(t, proto.SetExtension(cmd, proto2.E_ContinuousQueryLeaseCommand_Command, &proto2.ContinuousQueryLeaseCommand{
+		Owner:    proto.String("sql0"),
+		Time:     proto.Int64(0),
+		Duration: proto.Int64(int64(time.Minute)),
+	}))
+	b, err := proto.Marshal(cmd)
+	require.NoError(t, err)
+
+	before := time.Now().UnixNano()
+	cmd, err = validateCommand(b)
+	require.NoError(t, err)
+	b, err = stampLeaderTime(cmd, b)
+	require.NoError(t, err)
+
+	cmd, err = validateCommand(b)
+	require.NoError(t, err)
+	ext, err := proto.GetExtension(cmd, proto2.E_ContinuousQueryLeaseCommand_Command)
+	require.NoError(t, err)
+	v := ext.(*proto2.ContinuousQueryLeaseCommand)
+	require.Equal(t, "sql0", v.GetOwner())
+	require.GreaterOrEqual(t, v.GetTime(), before)
+
+	// other commands are applied as they are
+	typ = proto2.Command_CreateDatabaseCommand
+	other, err := proto.Marshal(&proto2.Command{Type: &typ})
+	require.NoError(t, err)
+	got, err := stampLeaderTime(&proto2.Command{Type: &typ}, other)
+	require.NoError(t, err)
+	require.Equal(t, other, got)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"runtime"
 	"strings"
 	"time"
@@ -43,7 +44,14 @@ import (
 	coordinator2 "github.com/openGemini/openGemini/open_src/influx/coordinator"
 	"github.com/openGemini/openGemini/open_src/influx/httpd"
 	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/services/auditlog"
 	"github.com/openGemini/openGemini/services/castor"
+	"github.com/openGemini/openGemini/services/continuousquery"
+	"github.com/openGemini/openGemini/services/graphite"
+	"github.com/openGemini/openGemini/services/handoff"
+	"github.com/openGemini/openGemini/services/opentsdb"
+	"github.com/openGemini/openGemini/services/subscriber"
+	"github.com/openGemini/openGemini/services/udp"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -62,6 +70,7 @@ type Server struct {
 	statisticsPusher *statisticsPusher.StatisticsPusher
 	QueryExecutor    *query.Executor
 	PointsWriter     *coordinator.PointsWriter
+	replicaSyncer    *coordinator.ReplicaSyncer
 	httpService      *httpd.Service
 
 	// joinPeers are the metaservers specified at run time to join this server to
@@ -73,6 +82,14 @@ type Server struct {
 	config *config.TSSql
 
 	castorService *castor.Service
+	cqService     *continuousquery.Service
+
+	subscriberService *subscriber.Service
+	handoffService    *handoff.Service
+	auditService      *auditlog.Service
+	openTSDBService   *opentsdb.Service
+	graphiteServices  []*graphite.Service
+	udpServices       []*udp.Service
 }
 
 // updateTLSConfig stores with into the tls config pointed at by into but only if with is not nil
@@ -133,6 +150,15 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 
 	s.PointsWriter = coordinator.NewPointsWriter(time.Duration(c.Coordinator.ShardWriterTimeout))
 	s.PointsWriter.TSDBStore = s.TSDBStore
+	consistency, err := coordinator.ParseConsistencyLevel(c.Coordinator.WriteConsistency)
+	if err != nil {
+		return nil, err
+	}
+	s.PointsWriter.SetWriteConsistency(consistency)
+	s.replicaSyncer = coordinator.NewReplicaSyncer(time.Duration(c.Coordinator.ReplicaSyncInterval),
+		time.Duration(c.Coordinator.ShardWriterTimeout))
+	s.replicaSyncer.TSDBStore = s.TSDBStore
+	s.PointsWriter.ReplicaSyncer = s.replicaSyncer
 
 	syscontrol.SysCtrl.MetaClient = s.MetaClient
 	syscontrol.SysCtrl.NetStore = store
@@ -152,6 +178,7 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 			Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
 		},
 		MetaExecutor:            metaExecutor,
+		PointsWriter:            s.PointsWriter,
 		MaxQueryMem:             int64(c.Coordinator.MaxQueryMem),
 		QueryTimeCompareEnabled: c.Coordinator.QueryTimeCompareEnabled,
 		RetentionPolicyLimit:    c.Coordinator.RetentionPolicyLimit,
@@ -160,6 +187,13 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
 	s.QueryExecutor.TaskManager.MaxConcurrentQueries = c.Coordinator.MaxConcurrentQueries
+	if len(c.WorkloadGroups) > 0 {
+		s.QueryExecutor.TaskManager.Workloads = query.NewWorkloadGroups(c.WorkloadGroups)
+	}
+	storeQueries := coordinator.NewStoreQueries()
+	storeQueries.MetaClient = s.MetaClient
+	storeQueries.NetStorage = s.TSDBStore
+	s.QueryExecutor.TaskManager.Cluster = storeQueries
 	s.httpService.Handler.QueryExecutor = s.QueryExecutor
 	s.httpService.Handler.ExtSysCtrl = s.TSDBStore
 
@@ -170,6 +204,58 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 	machine.InitMachineID(c.HTTP.BindAddress)
 
 	s.castorService = castor.NewService(c.Analysis)
+
+	if c.ContinuousQuery.Enabled {
+		s.cqService = continuousquery.NewService(cqLeaseOwner(c.HTTP.BindAddress),
+			time.Duration(c.ContinuousQuery.RunInterval), time.Duration(c.ContinuousQuery.LeaseDuration))
+		s.cqService.MetaClient = s.MetaClient
+		s.cqService.QueryExecutor = s.QueryExecutor
+	}
+
+	if c.Subscriber.Enabled {
+		s.subscriberService = subscriber.NewService(c.Subscriber)
+		s.subscriberService.MetaClient = s.MetaClient
+		s.PointsWriter.Subscriber = s.subscriberService
+	}
+
+	if c.HintedHandoff.Enabled {
+		s.handoffService = handoff.NewService(c.HintedHandoff, time.Duration(c.Coordinator.ShardWriterTimeout))
+		s.handoffService.TSDBStore = s.TSDBStore
+		s.PointsWriter.HintedHandoff = s.handoffService
+	}
+
+	if c.Audit.Enabled {
+		s.auditService = auditlog.NewService(c.Audit)
+		s.auditService.PointsWriter = s.PointsWriter
+		s.QueryExecutor.Auditor = s.auditService
+		s.httpService.Handler.Auditor = s.auditService
+	}
+
+	if c.OpenTSDB.Enabled {
+		s.openTSDBService = opentsdb.NewService(c.OpenTSDB)
+		s.openTSDBService.PointsWriter = s.PointsWriter
+	}
+
+	for _, g := range c.Graphite {
+		if !g.Enabled {
+			continue
+		}
+		srv, err := graphite.NewService(g)
+		if err != nil {
+			return nil, err
+		}
+		srv.PointsWriter = s.PointsWriter
+		s.graphiteServices = append(s.graphiteServices, srv)
+	}
+
+	for _, u := range c.UDP {
+		if !u.Enabled {
+			continue
+		}
+		srv := udp.NewService(u)
+		srv.PointsWriter = s.PointsWriter
+		s.udpServices = append(s.udpServices, srv)
+	}
 	return s, nil
 }
 
@@ -196,6 +282,20 @@ func (s *Server) Open() error {
 	}
 
 	s.PointsWriter.MetaClient = s.MetaClient
+	s.replicaSyncer.MetaClient = s.MetaClient
+	s.replicaSyncer.Open()
+	if s.handoffService != nil {
+		s.handoffService.MetaClient = s.MetaClient
+		if err := s.handoffService.Open(); err != nil {
+			return err
+		}
+	}
+	if s.auditService != nil {
+		s.auditService.MetaClient = s.MetaClient
+		if err := s.auditService.Open(); err != nil {
+			return err
+		}
+	}
 	s.httpService.Handler.MetaClient = s.MetaClient
 
 	if err := s.httpService.Open(); err != nil {
@@ -207,6 +307,39 @@ func (s *Server) Open() error {
 	if err := s.castorService.Open(); err != nil {
 		return err
 	}
+
+	if s.cqService != nil {
+		if err := s.cqService.Open(); err != nil {
+			return err
+		}
+	}
+
+	if s.subscriberService != nil {
+		if err := s.subscriberService.Open(); err != nil {
+			return err
+		}
+	}
+
+	if s.openTSDBService != nil {
+		s.openTSDBService.MetaClient = s.MetaClient
+		if err := s.openTSDBService.Open(); err != nil {
+			return err
+		}
+	}
+
+	for _, srv := range s.graphiteServices {
+		srv.MetaClient = s.MetaClient
+		if err := srv.Open(); err != nil {
+			return err
+		}
+	}
+
+	for _, srv := range s.udpServices {
+		srv.MetaClient = s.MetaClient
+		if err := srv.Open(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -220,14 +353,47 @@ func (s *Server) Close() error {
 		util.MustClose(s.Listener)
 	}
 
+	// the UDP listeners parse with the unmarshal workers of the HTTP service
+	for _, srv := range s.udpServices {
+		util.MustClose(srv)
+	}
+
 	if s.httpService != nil {
 		util.MustClose(s.httpService)
 	}
 
+	if s.openTSDBService != nil {
+		util.MustClose(s.openTSDBService)
+	}
+
+	for _, srv := range s.graphiteServices {
+		util.MustClose(srv)
+	}
+
+	if s.cqService != nil {
+		util.MustClose(s.cqService)
+	}
+
+	if s.subscriberService != nil {
+		util.MustClose(s.subscriberService)
+	}
+
 	if s.QueryExecutor != nil {
 		util.MustClose(s.QueryExecutor)
 	}
 
+	if s.replicaSyncer != nil {
+		s.replicaSyncer.Close()
+	}
+
+	if s.handoffService != nil {
+		util.MustClose(s.handoffService)
+	}
+
+	if s.auditService != nil {
+		util.MustClose(s.auditService)
+	}
+
 	if s.MetaClient != nil {
 		util.MustClose(s.MetaClient)
 	}
@@ -237,6 +403,16 @@ func (s *Server) Close() error {
 
 func (s *Server) Err() <-chan error { return nil }
 
+// cqLeaseOwner identifies the ts-sql in the continuous query lease,
+// the bind address alone is not unique if it is not bound to a specific ip.
+func cqLeaseOwner(bindAddress string) string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return bindAddress
+	}
+	return hostname + "/" + bindAddress
+}
+
 func (s *Server) initializeMetaClient() error {
 	if len(s.metaJoinPeers) == 0 {
 		// start up a new single node cluster
@@ -288,6 +464,8 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitRuntimeStatistics(globalTags, int(time.Duration(s.config.Monitor.StoreInterval).Seconds()))
 	stat.NewMetaStatistics().Init(globalTags)
 	stat.InitExecutorStatistics(globalTags)
+	stat.InitSubscriberStatistics(globalTags)
+	stat.InitHintedHandoffStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 
 	s.statisticsPusher.Register(
@@ -297,6 +475,8 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectRuntimeStatistics,
 		stat.NewMetaStatistics().Collect,
 		stat.CollectExecutorStatistics,
+		stat.CollectSubscriberStatistics,
+		stat.CollectHintedHandoffStatistics,
 		stat.NewErrnoStat().Collect,
 	)
 	s.statisticsPusher.Start()
@@ -25,6 +25,7 @@ import (
 	"github.com/influxdata/influxdb/cmd"
 	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/app/ts-store/run"
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -45,7 +46,9 @@ var (
 	pidPath  = flag.String("pidfile", "", "-pidfile=store config file path")
 )
 
-var versionUsage = `ts-store -config=config_file_path -pidfile=pid_file_path`
+var versionUsage = `ts-store -config=config_file_path -pidfile=pid_file_path
+ts-store restore -config=config_file_path -backup=backup_path -database=restored_database -node=node_id
+ts-store verify -backup=backup_path`
 
 func usage() {
 	fmt.Println(versionUsage)
@@ -64,7 +67,7 @@ func main() {
 
 func doRun(args ...string) error {
 	errno.SetNode(errno.NodeStore)
-	name, _ := cmd.ParseCommandName(args)
+	name, args := cmd.ParseCommandName(args)
 
 	switch name {
 	case "", "run":
@@ -111,8 +114,61 @@ func doRun(args ...string) error {
 		mainCmd.Logger.Info("Store service received shutdown signal", zap.Any("signal", signal))
 		util.MustClose(mainCmd)
 		mainCmd.Logger.Info("Store shutdown successfully!")
+	case "restore":
+		return restore(args)
+	case "verify":
+		return verify(args)
 	default:
 		return fmt.Errorf(`unknown command, usage:\n "%s"`+"\n\n", versionUsage)
 	}
 	return nil
 }
+
+// restore puts the files of a database restored into the meta data by the restore sysctrl cmd into the data
+// directory of the node, it runs while the node is stopped.
+func restore(args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	conf := fs.String("config", "", "-config=store config file path")
+	dir := fs.String("backup", "", "-backup=backup path")
+	database := fs.String("database", "", "-database=name of the restored database")
+	nodeID := fs.Uint64("node", 0, "-node=id of the data node")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *dir == "" || *database == "" || *nodeID == 0 {
+		return fmt.Errorf("backup, database and node are required, usage:\n %s", versionUsage)
+	}
+
+	c := config.NewTSStore()
+	if err := config.Parse(c, *conf); err != nil {
+		return fmt.Errorf("parse config: %s", err)
+	}
+	r, err := backup.ReadRemapping(*dir, *database)
+	if err != nil {
+		return err
+	}
+	n, err := backup.RestoreNode(*dir, c.Data.DataDir, *nodeID, r)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "restored %d shards and indexes of %s as %s\n", n, r.Database, r.NewDatabase)
+	return nil
+}
+
+// verify checks the files of a backup and of the backups of its incremental chain against the manifests.
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	dir := fs.String("backup", "", "-backup=backup path")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *dir == "" {
+		return fmt.Errorf("backup is required, usage:\n %s", versionUsage)
+	}
+	n, err := backup.Verify(*dir)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "verified %d files of %s\n", n, *dir)
+	return nil
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/openGemini/openGemini/open_src/influx/meta/proto"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/services/castor"
+	"github.com/openGemini/openGemini/services/downsample"
 	"github.com/openGemini/openGemini/services/hierarchical"
 	"github.com/openGemini/openGemini/services/retention"
 	"go.uber.org/zap"
@@ -59,9 +60,12 @@ type StoreEngine interface {
 	UnrefEngineDbPt(string, uint32)
 	ExecuteDelete(*netstorage.DeleteRequest) error
 	GetShardSplitPoints(string, uint32, uint64, []int64) ([]string, error)
+	ReadShardWal(string, uint32, uint64) ([][]byte, error)
+	ReadShardRows(string, uint32, uint64, netstorage.RowsCursor, int) ([]byte, netstorage.RowsCursor, bool, error)
 	SeriesCardinality(string, []uint32, []string, influxql.Expr) ([]meta.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(string, []uint32, []string, influxql.Expr) (map[string]uint64, error)
 	SeriesKeys(string, []uint32, []string, influxql.Expr) ([]string, error)
+	DropSeries(string, []uint32, []string, influxql.Expr) (int, error)
 	TagValues(string, []uint32, map[string][][]byte, influxql.Expr) (netstorage.TablesTagSets, error)
 	TagValuesCardinality(string, []uint32, map[string][][]byte, influxql.Expr) (map[string]uint64, error)
 	SendSysCtrlOnNode(*netstorage.SysCtrlRequest) error
@@ -111,6 +115,17 @@ func (s *Storage) appendHierarchicalService(c retention2.Config) {
 	s.Services = append(s.Services, srv)
 }
 
+func (s *Storage) appendDownSampleService(c retention2.Config) {
+	if !c.Enabled {
+		return
+	}
+
+	srv := downsample.NewService(time.Duration(c.CheckInterval))
+	srv.Engine = s.engine
+	srv.MetaClient = s.metaClient
+	s.Services = append(s.Services, srv)
+}
+
 func (s *Storage) appendAnalysisService(c config.Castor) {
 	if !c.Enabled {
 		return
@@ -153,7 +168,11 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	opt.WalSyncInterval = time.Duration(conf.Data.WalSyncInterval)
 	opt.WalEnabled = conf.Data.WalEnabled
 	opt.WalReplayParallel = conf.Data.WalReplayParallel
+	opt.WalReplaySalvage = conf.Data.WalReplaySalvage
 	opt.CompactionMethod = conf.Data.CompactionMethod
+	opt.MaxSeriesPerDatabase = conf.Data.MaxSeriesPerDatabase
+	opt.MaxSeriesPerMeasurement = conf.Data.MaxSeriesPerMeasurement
+	opt.MaxValuesPerTag = conf.Data.MaxValuesPerTag
 
 	eng, err := newEngineFn(conf.Data.DataDir, conf.Data.WALDir, opt, &loadCtx)
 	if err != nil {
@@ -183,6 +202,7 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	// Append services.
 	s.appendRetentionPolicyService(conf.Retention)
 	s.appendHierarchicalService(conf.HierarchicalStore)
+	s.appendDownSampleService(conf.DownSample)
 	s.appendAnalysisService(conf.Analysis)
 
 	for _, service := range s.Services {
@@ -297,6 +317,15 @@ func (s *Storage) GetShardSplitPoints(db string, pt uint32, shardID uint64, idxe
 	return s.engine.GetShardSplitPoints(db, pt, shardID, idxes)
 }
 
+func (s *Storage) ReadShardWal(db string, pt uint32, shardID uint64) ([][]byte, error) {
+	return s.engine.ReadShardWal(db, pt, shardID)
+}
+
+func (s *Storage) ReadShardRows(db string, pt uint32, shardID uint64, cursor netstorage.RowsCursor,
+	limit int) ([]byte, netstorage.RowsCursor, bool, error) {
+	return s.engine.ReadShardRows(db, pt, shardID, cursor, limit)
+}
+
 func (s *Storage) RefEngineDbPt(db string, ptId uint32) error {
 	return s.engine.DbPTRef(db, ptId)
 }
@@ -330,6 +359,15 @@ func (s *Storage) SeriesKeys(db string, ptIDs []uint32, measurements []string, c
 	return s.engine.SeriesKeys(db, ptIDs, ms, condition)
 }
 
+func (s *Storage) DropSeries(db string, ptIDs []uint32, measurements []string, condition influxql.Expr) (int, error) {
+	sources := make([]influxql.Source, 0, len(measurements))
+	for _, name := range measurements {
+		sources = append(sources, &influxql.Measurement{Database: db, Name: name})
+	}
+
+	return s.engine.DropSeries(db, sources, ptIDs, condition)
+}
+
 func (s *Storage) SeriesCardinality(db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]meta.MeasurementCardinalityInfo, error) {
 	ms := stringSlice2BytesSlice(measurements)
 	return s.engine.SeriesCardinality(db, ptIDs, ms, condition)
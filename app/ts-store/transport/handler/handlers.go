@@ -42,8 +42,18 @@ func newHandler(typ uint8) RPCHandler {
 		return &GetShardSplitPoints{}
 	case netstorage.DeleteRequestMessage:
 		return &Delete{}
+	case netstorage.DropSeriesRequestMessage:
+		return &DropSeries{}
 	case netstorage.CreateDataBaseRequestMessage:
 		return &CreateDataBase{}
+	case netstorage.ReadShardWalRequestMessage:
+		return &ReadShardWal{}
+	case netstorage.ShowQueriesRequestMessage:
+		return &ShowQueries{}
+	case netstorage.KillQueryRequestMessage:
+		return &KillQuery{}
+	case netstorage.ReadShardRowsRequestMessage:
+		return &ReadShardRows{}
 	default:
 		return nil
 	}
@@ -168,6 +178,23 @@ func (h *Delete) SetMessage(msg codec.BinaryCodec) error {
 	return nil
 }
 
+type DropSeries struct {
+	BaseHandler
+
+	req *netstorage.DropSeriesRequest
+	rsp *netstorage.DropSeriesResponse
+}
+
+func (h *DropSeries) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.DropSeriesResponse{}
+	req, ok := msg.(*netstorage.DropSeriesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DropSeriesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
 type CreateDataBase struct {
 	BaseHandler
 
@@ -184,3 +211,71 @@ func (h *CreateDataBase) SetMessage(msg codec.BinaryCodec) error {
 	h.req = req
 	return nil
 }
+
+type ReadShardWal struct {
+	BaseHandler
+
+	req *netstorage.ReadShardWalRequest
+	rsp *netstorage.ReadShardWalResponse
+}
+
+func (h *ReadShardWal) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ReadShardWalResponse{}
+	req, ok := msg.(*netstorage.ReadShardWalRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ReadShardWalRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ShowQueries struct {
+	BaseHandler
+
+	req *netstorage.ShowQueriesRequest
+	rsp *netstorage.ShowQueriesResponse
+}
+
+func (h *ShowQueries) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowQueriesResponse{}
+	req, ok := msg.(*netstorage.ShowQueriesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowQueriesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type KillQuery struct {
+	BaseHandler
+
+	req *netstorage.KillQueryRequest
+	rsp *netstorage.KillQueryResponse
+}
+
+func (h *KillQuery) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.KillQueryResponse{}
+	req, ok := msg.(*netstorage.KillQueryRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.KillQueryRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ReadShardRows struct {
+	BaseHandler
+
+	req *netstorage.ReadShardRowsRequest
+	rsp *netstorage.ReadShardRowsResponse
+}
+
+func (h *ReadShardRows) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ReadShardRowsResponse{}
+	req, ok := msg.(*netstorage.ReadShardRowsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ReadShardRowsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
@@ -18,12 +18,16 @@ package handler
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/influxdata/influxdb/kit/errors"
+	"github.com/openGemini/openGemini/app/ts-store/transport/query"
 	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	internal "github.com/openGemini/openGemini/lib/netstorage/data"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"go.uber.org/zap"
 )
@@ -49,6 +53,66 @@ func (h *GetShardSplitPoints) Process() (codec.BinaryCodec, error) {
 	return h.rsp, nil
 }
 
+func (h *ReadShardWal) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(nil, func(expr influxql.Expr) error {
+		var err error
+		h.rsp.Records, err = h.store.ReadShardWal(h.req.GetDB(), h.req.GetPtID(), h.req.GetShardID())
+		return err
+	})
+
+	return h.rsp, nil
+}
+
+func (h *ReadShardRows) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(nil, func(expr influxql.Expr) error {
+		cursor := netstorage.RowsCursor{
+			Measurement: h.req.GetMeasurement(),
+			OutOfOrder:  h.req.GetOutOfOrder(),
+			SeriesID:    h.req.GetSeriesID(),
+		}
+		rows, next, done, err := h.store.ReadShardRows(h.req.GetDB(), h.req.GetPtID(), h.req.GetShardID(), cursor,
+			int(h.req.GetLimit()))
+		if err != nil {
+			return err
+		}
+		h.rsp.Rows = rows
+		h.rsp.Measurement = proto.String(next.Measurement)
+		h.rsp.OutOfOrder = proto.Bool(next.OutOfOrder)
+		h.rsp.SeriesID = proto.Uint64(next.SeriesID)
+		h.rsp.Done = proto.Bool(done)
+		return nil
+	})
+
+	return h.rsp, nil
+}
+
+func (h *ShowQueries) Process() (codec.BinaryCodec, error) {
+	infos := query.Running()
+	h.rsp.Pipelines = make([]*internal.QueryPipeline, 0, len(infos))
+	for i := range infos {
+		info := &infos[i]
+		h.rsp.Pipelines = append(h.rsp.Pipelines, &internal.QueryPipeline{
+			Host:     proto.String(info.Host),
+			QueryID:  proto.Uint64(info.QueryID),
+			Query:    proto.String(info.Query),
+			Database: proto.String(info.Database),
+			PtID:     proto.Uint32(info.PtID),
+			Shards:   proto.Int64(int64(info.Shards)),
+			Chunks:   proto.Int64(info.Chunks),
+			Duration: proto.Int64(int64(time.Since(info.Begin))),
+		})
+	}
+	return h.rsp, nil
+}
+
+func (h *KillQuery) Process() (codec.BinaryCodec, error) {
+	n := query.Kill(h.req.GetHost(), h.req.GetQueryID())
+	logger.GetLogger().Info("KillQuery", zap.String("host", h.req.GetHost()), zap.Uint64("qid", h.req.GetQueryID()),
+		zap.Int("pipelines", n))
+	h.rsp.Killed = proto.Int64(int64(n))
+	return h.rsp, nil
+}
+
 func (h *SeriesCardinality) Process() (codec.BinaryCodec, error) {
 	err := processDDL(h.req.Condition, func(expr influxql.Expr) error {
 		var err error
@@ -82,6 +146,16 @@ func (h *SeriesKeys) Process() (codec.BinaryCodec, error) {
 	return h.rsp, nil
 }
 
+func (h *DropSeries) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr) error {
+		n, err := h.store.DropSeries(*h.req.Db, h.req.PtIDs, h.req.Measurements, expr)
+		h.rsp.Count = proto.Int64(int64(n))
+		return err
+	})
+
+	return h.rsp, nil
+}
+
 func (h *CreateDataBase) Process() (codec.BinaryCodec, error) {
 	if err := createDir(h.store.GetPath(), h.req.GetDb(), h.req.GetPt(), h.req.GetRp()); err != nil {
 		h.rsp.Err = proto.String(err.Error())
@@ -26,6 +26,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy"
 	"github.com/openGemini/openGemini/engine/executor/spdy/rpc"
 	"github.com/openGemini/openGemini/lib/codec"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"go.uber.org/zap"
@@ -152,6 +153,10 @@ func (p *SelectProcessor) Handle(w spdy.Responser, data interface{}) error {
 			_ = w.Response(executor.NewFinishMessage(), true)
 			return
 		}
+		if query.Killed(req.QueryHost, req.QueryID) {
+			_ = w.Response(executor.NewErrorMessage(killedError(req).Error()), true)
+			return
+		}
 
 		s := NewSelect(p.store, w, req)
 		qm.Add(w.Sequence(), s)
@@ -167,6 +172,12 @@ func (p *SelectProcessor) Handle(w spdy.Responser, data interface{}) error {
 			return
 		}
 
+		// a killed query must not look complete to the SQL node
+		if query.Killed(req.QueryHost, req.QueryID) {
+			_ = w.Response(executor.NewErrorMessage(killedError(req).Error()), true)
+			return
+		}
+
 		err = w.Response(executor.NewFinishMessage(), true)
 		if err != nil {
 			logger.GetLogger().Error("failed to response finish message", zap.Error(err))
@@ -176,6 +187,10 @@ func (p *SelectProcessor) Handle(w spdy.Responser, data interface{}) error {
 	return nil
 }
 
+func killedError(req *executor.RemoteQuery) error {
+	return errno.NewError(errno.QueryKilled, req.QueryID, req.QueryHost)
+}
+
 type AbortProcessor struct {
 }
 
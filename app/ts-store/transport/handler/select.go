@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/openGemini/openGemini/app/ts-store/storage"
+	"github.com/openGemini/openGemini/app/ts-store/transport/query"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/executor/spdy"
+	"github.com/openGemini/openGemini/engine/executor/spdy/rpc"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -43,9 +46,10 @@ type Select struct {
 	BaseHandler
 
 	req    *executor.RemoteQuery
-	w      spdy.Responser
+	w      *chunkCounter
 	mu     sync.RWMutex
 	logger *logger.Logger
+	begin  time.Time
 
 	abort   chan struct{}
 	aborted bool
@@ -59,8 +63,9 @@ type Select struct {
 func NewSelect(store *storage.Storage, w spdy.Responser, req *executor.RemoteQuery) *Select {
 	s := &Select{
 		req:     req,
-		w:       w,
+		w:       &chunkCounter{Responser: w},
 		aborted: false,
+		begin:   time.Now(),
 		logger: logger.NewLogger(errno.ModuleQueryEngine).With(
 			zap.String("query", "Select"),
 			zap.Uint64("trace_id", req.Opt.Traceid)),
@@ -69,6 +74,32 @@ func NewSelect(store *storage.Storage, w spdy.Responser, req *executor.RemoteQue
 	return s
 }
 
+// chunkCounter counts the chunks sent back to the SQL node, as the progress of the query.
+type chunkCounter struct {
+	spdy.Responser
+	chunks int64
+}
+
+func (c *chunkCounter) Response(response interface{}, full bool) error {
+	if msg, ok := response.(*rpc.Message); ok && msg.Type() == executor.ChunkResponseMessage {
+		atomic.AddInt64(&c.chunks, 1)
+	}
+	return c.Responser.Response(response, full)
+}
+
+func (s *Select) Info() query.Info {
+	return query.Info{
+		Host:     s.req.QueryHost,
+		QueryID:  s.req.QueryID,
+		Query:    s.req.Opt.Query,
+		Database: s.req.Database,
+		PtID:     s.req.PtID,
+		Shards:   len(s.req.ShardIDs),
+		Chunks:   atomic.LoadInt64(&s.w.chunks),
+		Begin:    s.begin,
+	}
+}
+
 func (s *Select) Abort() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -59,6 +59,15 @@ func (s *MockStoreEngine) GetShardSplitPoints(db string, pt uint32, shardID uint
 	return nil, nil
 }
 
+func (s *MockStoreEngine) ReadShardWal(db string, pt uint32, shardID uint64) ([][]byte, error) {
+	return nil, nil
+}
+
+func (s *MockStoreEngine) ReadShardRows(db string, pt uint32, shardID uint64, cursor netstorage.RowsCursor,
+	limit int) ([]byte, netstorage.RowsCursor, bool, error) {
+	return nil, cursor, true, nil
+}
+
 func (s *MockStoreEngine) SeriesCardinality(db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]meta.MeasurementCardinalityInfo, error) {
 	return nil, nil
 }
@@ -71,6 +80,10 @@ func (s *MockStoreEngine) SeriesKeys(db string, ptIDs []uint32, measurements []s
 	return nil, nil
 }
 
+func (s *MockStoreEngine) DropSeries(db string, ptIDs []uint32, measurements []string, condition influxql.Expr) (int, error) {
+	return 0, nil
+}
+
 func (s *MockStoreEngine) TagValues(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr) (netstorage.TablesTagSets, error) {
 	return nil, nil
 }
@@ -29,6 +29,23 @@ type IQuery interface {
 	Abort()
 }
 
+// Info describes a pipeline running on the store for a query of a SQL node.
+type Info struct {
+	Host     string
+	QueryID  uint64
+	Query    string
+	Database string
+	PtID     uint32
+	Shards   int
+	Chunks   int64
+	Begin    time.Time
+}
+
+// Described is implemented by the queries that know which query of a SQL node they run for.
+type Described interface {
+	Info() Info
+}
+
 type Manager struct {
 	mu    sync.RWMutex
 	items map[uint64]*Item
@@ -47,8 +64,85 @@ type Item struct {
 var managers map[uint64]*Manager
 var mu sync.Mutex
 
+type killedQuery struct {
+	host string
+	id   uint64
+}
+
+// the queries killed recently, their pipelines still to come are not started
+var killed map[killedQuery]time.Time
+var killedMu sync.Mutex
+
 func init() {
 	managers = make(map[uint64]*Manager)
+	killed = make(map[killedQuery]time.Time)
+}
+
+func allManagers() []*Manager {
+	mu.Lock()
+	defer mu.Unlock()
+	ms := make([]*Manager, 0, len(managers))
+	for _, m := range managers {
+		ms = append(ms, m)
+	}
+	return ms
+}
+
+// Running returns the pipelines of all clients that know which query they run for.
+func Running() []Info {
+	var infos []Info
+	for _, qm := range allManagers() {
+		qm.mu.RLock()
+		for _, item := range qm.items {
+			if d, ok := item.val.(Described); ok {
+				infos = append(infos, d.Info())
+			}
+		}
+		qm.mu.RUnlock()
+	}
+	return infos
+}
+
+// Kill aborts the pipelines running for a query of a SQL node, and keeps the pipelines of the query that are
+// still to come from starting for a while. It returns the number of the pipelines aborted.
+func Kill(host string, queryID uint64) int {
+	now := time.Now()
+	killedMu.Lock()
+	for k, t := range killed {
+		if now.Sub(t) > defaultAbortedExpire {
+			delete(killed, k)
+		}
+	}
+	killed[killedQuery{host: host, id: queryID}] = now
+	killedMu.Unlock()
+
+	n := 0
+	for _, qm := range allManagers() {
+		var seqs []uint64
+		qm.mu.RLock()
+		for seq, item := range qm.items {
+			if d, ok := item.val.(Described); ok {
+				if info := d.Info(); info.Host == host && info.QueryID == queryID {
+					seqs = append(seqs, seq)
+				}
+			}
+		}
+		qm.mu.RUnlock()
+
+		for _, seq := range seqs {
+			qm.Abort(seq)
+		}
+		n += len(seqs)
+	}
+	return n
+}
+
+// Killed reports whether the query of a SQL node was killed recently.
+func Killed(host string, queryID uint64) bool {
+	killedMu.Lock()
+	defer killedMu.Unlock()
+	t, ok := killed[killedQuery{host: host, id: queryID}]
+	return ok && time.Since(t) <= defaultAbortedExpire
 }
 
 func NewManager(client uint64) *Manager {
@@ -62,6 +62,53 @@ func TestManager_Query(t *testing.T) {
 	assert.Equal(t, nilQuery, qm.Get(seq))
 }
 
+func TestManager_Kill(t *testing.T) {
+	qm := NewManager(clientID + 1)
+	other := NewManager(clientID + 2)
+	q1 := &mockDescribedQuery{info: Info{Host: "127.0.0.1:8086", QueryID: 3, PtID: 1}}
+	q2 := &mockDescribedQuery{info: Info{Host: "127.0.0.1:8086", QueryID: 3, PtID: 2}}
+	q3 := &mockDescribedQuery{info: Info{Host: "127.0.0.2:8086", QueryID: 3}}
+	qm.Add(1, q1)
+	qm.Add(2, &mockQuery{id: 2})
+	other.Add(3, q2)
+	other.Add(4, q3)
+	defer func() {
+		for seq := uint64(1); seq <= 4; seq++ {
+			qm.Finish(seq)
+			other.Finish(seq)
+		}
+	}()
+
+	running := 0
+	for _, info := range Running() {
+		if info.QueryID == 3 {
+			running++
+		}
+	}
+	assert.Equal(t, 3, running)
+
+	assert.False(t, Killed("127.0.0.1:8086", 3))
+	assert.Equal(t, 2, Kill("127.0.0.1:8086", 3))
+	assert.True(t, q1.aborted && q2.aborted)
+	assert.False(t, q3.aborted)
+	assert.True(t, qm.Aborted(1))
+	assert.True(t, Killed("127.0.0.1:8086", 3))
+	assert.False(t, Killed("127.0.0.2:8086", 3))
+}
+
+type mockDescribedQuery struct {
+	info    Info
+	aborted bool
+}
+
+func (m *mockDescribedQuery) Abort() {
+	m.aborted = true
+}
+
+func (m *mockDescribedQuery) Info() Info {
+	return m.info
+}
+
 type mockQuery struct {
 	id int
 }
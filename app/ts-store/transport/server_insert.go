@@ -93,9 +93,11 @@ func (p *InsertProcessor) Handle(w spdy.Responser, data interface{}) error {
 	err := ww.WritePoints()
 	putWritePointsWork(ww)
 
-	rsp := netstorage.NewWritePointsResponse(0, "")
-	if err != nil {
-		rsp = netstorage.NewWritePointsResponse(1, err.Error())
+	rsp := netstorage.NewWritePointsResponse(netstorage.WritePointsSuccess, "")
+	if partialErr, ok := err.(netstorage.PartialWriteError); ok {
+		rsp = netstorage.NewPartialWritePointsResponse(partialErr.Dropped, partialErr.Reason.Error())
+	} else if err != nil {
+		rsp = netstorage.NewWritePointsResponse(netstorage.WritePointsFailed, err.Error())
 	}
 
 	return w.Response(rsp, true)
@@ -18,6 +18,7 @@ package coordinator
 
 import (
 	"errors"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
@@ -56,29 +57,52 @@ type PointsWriter struct {
 		UpdateSchema(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error
 		CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, indexR *meta2.IndexRelation) (*meta2.MeasurementInfo, error)
 		GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []int
+		LagReplica(database, policy string, shardID uint64, pt uint32) error
 	}
 
 	TSDBStore interface {
 		WriteRows(nodeID uint64, database, rp string, pt uint32, shard uint64, rows *[]influx.Row, timeout time.Duration) error
 	}
 
-	logger *logger.Logger
+	// Subscriber forwards the accepted rows to the subscriptions of the retention policy
+	Subscriber interface {
+		Subscribed(database, retentionPolicy string) bool
+		Send(database, retentionPolicy string, rows []*influx.Row)
+	}
+
+	// ReplicaSyncer re-syncs the replicas that missed writes, it is nil if the replicas are not re-synced
+	ReplicaSyncer *ReplicaSyncer
+
+	// HintedHandoff queues the rows written to the stores that cannot be reached, it is nil if hinted handoff is disabled
+	HintedHandoff interface {
+		WriteShard(nodeID uint64, database, rp string, pt uint32, shard uint64, rows []influx.Row) error
+	}
+
+	consistency ConsistencyLevel
+	logger      *logger.Logger
 }
 
 // NewPointsWriter returns a new instance of PointsWriter for a node.
 func NewPointsWriter(timeout time.Duration) *PointsWriter {
 	return &PointsWriter{
-		timeout: timeout,
-		logger:  logger.NewLogger(errno.ModuleCoordinator),
+		timeout:     timeout,
+		consistency: ConsistencyLevelQuorum,
+		logger:      logger.NewLogger(errno.ModuleCoordinator),
 	}
 }
 
+// SetWriteConsistency sets how many replicas of a shard must accept a write.
+func (w *PointsWriter) SetWriteConsistency(level ConsistencyLevel) {
+	w.consistency = level
+}
+
 // ShardMapping contains a mapping of shards to points.
 type injestionCtx struct {
 	fieldToCreatePool []*proto2.FieldSchema
 	rowsPool          sync.Pool
 	shardRowMap       dictpool.Dict
 	shardMap          dictpool.Dict
+	subRows           []*influx.Row
 }
 
 func (s *injestionCtx) getShardRowMap() *dictpool.Dict {
@@ -109,6 +133,10 @@ func (s *injestionCtx) Reset() {
 	s.fieldToCreatePool = s.fieldToCreatePool[:0]
 	s.shardMap.Reset()
 	s.shardRowMap.Reset()
+	for i := range s.subRows {
+		s.subRows[i] = nil
+	}
+	s.subRows = s.subRows[:0]
 }
 
 func getInjestionCtx() *injestionCtx {
@@ -267,6 +295,7 @@ func (w *PointsWriter) WritePointRows(database, retentionPolicy string, rows []i
 	isDropRow := false
 	var partialErr error
 	var dropped int
+	subscribed := w.Subscriber != nil && w.Subscriber.Subscribed(database, retentionPolicy)
 
 	//validate, map and push point to bach transport buffer
 	for i := range rows {
@@ -396,6 +425,9 @@ func (w *PointsWriter) WritePointRows(database, retentionPolicy string, rows []i
 		if err = w.MapRowToShard(shardrowmap, ctx, id, r); err != nil {
 			return err
 		}
+		if subscribed {
+			ctx.subRows = append(ctx.subRows, r)
+		}
 		atomic.AddInt64(&statistics.HandlerStat.FieldsWritten, int64(r.Fields.Len()))
 	}
 
@@ -413,20 +445,27 @@ func (w *PointsWriter) WritePointRows(database, retentionPolicy string, rows []i
 			return errno.NewError(errno.WriteMapMetaShardInfo)
 		}
 		go func(shard *meta2.ShardInfo, db, rp string, rs *[]influx.Row, ctx *injestionCtx) {
-			err := w.writeRowToShard(sh, database, retentionPolicy, rows, ctx)
+			err := w.writeRowToShard(shard, db, rp, rs, ctx)
 			errC <- err
 		}(sh, database, retentionPolicy, rows, ctx)
 	}
 
 	for i := 0; i < shardrowmap.Len(); i++ {
 		errShard := <-errC
-		if errShard != nil {
+		if shardErr, ok := errShard.(netstorage.PartialWriteError); ok {
+			// the rows over the cardinality limits of the shard were dropped by the store
+			partialErr = shardErr.Reason
+			dropped += shardErr.Dropped
+		} else if errShard != nil {
 			err = errShard
 		}
 	}
 	if err != nil {
 		return err
 	}
+	if len(ctx.subRows) > 0 {
+		w.Subscriber.Send(database, retentionPolicy, ctx.subRows)
+	}
 	if dropped > 0 {
 		return netstorage.PartialWriteError{Reason: partialErr, Dropped: dropped}
 	}
@@ -454,25 +493,62 @@ func (w *PointsWriter) MapRowToShard(shardrowmap *dictpool.Dict, ctx *injestionC
 	return nil
 }
 
-// writeRowToShard writes row to a shard.
+// writeRowToShard writes row to a shard, the replicas of the shard are written by writeReplicas.
 func (w *PointsWriter) writeRowToShard(shard *meta2.ShardInfo, database, retentionPolicy string, row *[]influx.Row, ctx *injestionCtx) error {
 	ptView, err := w.MetaClient.DBPtView(database)
 	if err != nil {
+		ctx.putRowsPool(row)
 		return err
 	}
 	start := time.Now()
-	for _, ptId := range shard.Owners {
-		err = w.TSDBStore.WriteRows(ptView[ptId].Owner.NodeID, database, retentionPolicy, ptId, shard.ID, row, w.timeout)
-
-		ctx.putRowsPool(row)
+	if len(shard.Owners) > 1 {
+		err = w.writeReplicas(shard, database, retentionPolicy, ptView, row, ctx)
+	} else {
+		ptId := shard.Owners[0]
+		nodeID := ptView[ptId].Owner.NodeID
+		err = w.TSDBStore.WriteRows(nodeID, database, retentionPolicy, ptId, shard.ID, row, w.timeout)
 		if err != nil {
-			return err
+			err = w.handoff(nodeID, database, retentionPolicy, ptId, shard.ID, *row, err)
 		}
+		ctx.putRowsPool(row)
+	}
+	if err != nil {
+		return err
 	}
 	atomic.AddInt64(&statistics.HandlerStat.WriteStoresDuration, time.Since(start).Nanoseconds())
 	return nil
 }
 
+// handoff queues the rows that could not be written to a shard because its store is unreachable. The write
+// error is returned as is if it is not caused by an unreachable store or the rows cannot be queued.
+func (w *PointsWriter) handoff(nodeID uint64, database, retentionPolicy string, pt uint32, shard uint64, rows []influx.Row, cause error) error {
+	if w.HintedHandoff == nil || !isUnreachable(cause) {
+		return cause
+	}
+	if err := w.HintedHandoff.WriteShard(nodeID, database, retentionPolicy, pt, shard, rows); err != nil {
+		w.logger.Warn("hinted handoff failed", zap.Uint64("node", nodeID), zap.String("db", database),
+			zap.Uint64("shard", shard), zap.Error(err))
+		return cause
+	}
+	return nil
+}
+
+// isUnreachable reports whether a write failed before its request was sent to the store. The errors raised
+// while waiting for the response are not counted, the store may have applied the rows already.
+func isUnreachable(err error) bool {
+	var e *errno.Error
+	if errors.As(err, &e) {
+		switch e.Errno() {
+		case errno.NoConnectionAvailable, errno.NoNodeAvailable, errno.ConnectionClosed, errno.OpenSessionTimeout,
+			errno.PoolClosed, errno.DataNoAlive, errno.WriteReplicaOffline:
+			return true
+		}
+		return false
+	}
+	var oe *net.OpError
+	return errors.As(err, &oe) && oe.Op == "dial"
+}
+
 // define to sync.Pool
 type Columns []string
 
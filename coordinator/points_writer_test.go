@@ -41,6 +41,7 @@ type MockMetaClient struct {
 	UpdateSchemaFn      func(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error
 	CreateMeasurementFn func(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, indexR *meta2.IndexRelation) (*meta2.MeasurementInfo, error)
 	GetAliveShardsFn    func(database string, sgi *meta2.ShardGroupInfo) []int
+	LagReplicaFn        func(database, policy string, shardID uint64, pt uint32) error
 }
 
 func (mmc *MockMetaClient) Database(name string) (di *meta2.DatabaseInfo, err error) {
@@ -75,6 +76,10 @@ func (mmc *MockMetaClient) GetAliveShards(database string, sgi *meta2.ShardGroup
 	return mmc.GetAliveShardsFn(database, sgi)
 }
 
+func (mmc *MockMetaClient) LagReplica(database, policy string, shardID uint64, pt uint32) error {
+	return mmc.LagReplicaFn(database, policy, shardID, pt)
+}
+
 func NewMockMetaClient() *MockMetaClient {
 	mc := &MockMetaClient{}
 	rpInfo := NewRetentionPolicy("rp0", time.Hour)
@@ -118,6 +123,9 @@ func NewMockMetaClient() *MockMetaClient {
 		}
 		return idxes
 	}
+	mc.LagReplicaFn = func(database, policy string, shardID uint64, pt uint32) error {
+		return nil
+	}
 	return mc
 }
 
@@ -274,6 +282,7 @@ func generateRows() []influx.Row {
 			pt.Tags[i].Value = kv[1]
 		}
 		sort.Sort(&pt.Tags)
+		pt.Fields = influx.Fields{{Key: "value", Type: influx.Field_Type_Float, NumValue: 1}}
 		pt.Timestamp = time.Now().UnixNano()
 		pt.UnmarshalIndexKeys(nil)
 		pt.ShardKey = pt.IndexKey
@@ -0,0 +1,372 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/rand"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// ConsistencyLevel is the number of the replicas of a shard that must accept a write before it is acknowledged.
+type ConsistencyLevel int
+
+const (
+	ConsistencyLevelOne ConsistencyLevel = iota
+	ConsistencyLevelQuorum
+	ConsistencyLevelAll
+)
+
+func ParseConsistencyLevel(level string) (ConsistencyLevel, error) {
+	switch level {
+	case config.WriteConsistencyOne:
+		return ConsistencyLevelOne, nil
+	case config.WriteConsistencyQuorum, "":
+		return ConsistencyLevelQuorum, nil
+	case config.WriteConsistencyAll:
+		return ConsistencyLevelAll, nil
+	}
+	return 0, fmt.Errorf("invalid write consistency %q", level)
+}
+
+// required returns how many of replicaN replicas must accept a write.
+func (l ConsistencyLevel) required(replicaN int) int {
+	switch l {
+	case ConsistencyLevelOne:
+		return 1
+	case ConsistencyLevelAll:
+		return replicaN
+	}
+	return replicaN/2 + 1
+}
+
+type replicaWriteResult struct {
+	pt  uint32
+	err error
+}
+
+// writeReplicas writes the rows to all replicas of the shard in parallel and returns once the replicas required by
+// the consistency level accepted the rows, or once so many failed that the level can no longer be reached. The
+// replicas missing the rows are handed to the hinted handoff queues, or else marked as lagging in meta to be re-synced
+// by the replica syncer, after all writes are done. The writes may outlive the request, so they use a copy of the rows
+// and the rows are put back to the pool at once.
+func (w *PointsWriter) writeReplicas(shard *meta2.ShardInfo, database, retentionPolicy string, ptView meta2.DBPtInfos,
+	row *[]influx.Row, ctx *injestionCtx) error {
+	rows, err := ownedRows(*row)
+	ctx.putRowsPool(row)
+	if err != nil {
+		return err
+	}
+
+	replicaN := len(shard.Owners)
+	required := w.consistency.required(replicaN)
+	results := make(chan replicaWriteResult, replicaN)
+	for _, pt := range shard.Owners {
+		go func(pt uint32) {
+			if int(pt) >= len(ptView) || ptView[pt].Status != meta2.Online {
+				results <- replicaWriteResult{pt: pt, err: errno.NewError(errno.WriteReplicaOffline, pt, shard.ID)}
+				return
+			}
+			err := w.TSDBStore.WriteRows(ptView[pt].Owner.NodeID, database, retentionPolicy, pt, shard.ID, &rows, w.timeout)
+			results <- replicaWriteResult{pt: pt, err: err}
+		}(pt)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		var accepted []uint32
+		var failed []replicaWriteResult
+		var lastErr, partialErr error
+		replied := false
+		for i := 0; i < replicaN; i++ {
+			res := <-results
+			if _, ok := res.err.(netstorage.PartialWriteError); ok {
+				// the replica wrote the rows within the cardinality limits of its index
+				partialErr = res.err
+				accepted = append(accepted, res.pt)
+			} else if res.err != nil {
+				failed = append(failed, res)
+				lastErr = res.err
+				w.logger.Warn("write replica failed", zap.String("db", database), zap.Uint32("pt", res.pt),
+					zap.Uint64("shard", shard.ID), zap.Error(res.err))
+			} else {
+				accepted = append(accepted, res.pt)
+			}
+			if replied {
+				continue
+			}
+			if len(accepted) >= required {
+				done <- partialErr
+				replied = true
+			} else if replicaN-len(failed) < required {
+				done <- errno.NewError(errno.WriteConsistencyNotReached, len(accepted), replicaN, shard.ID, required, lastErr)
+				replied = true
+			}
+		}
+		if len(accepted) > 0 {
+			for _, res := range failed {
+				// the queued rows are replayed to the replica, it is not re-synced from the others
+				if int(res.pt) < len(ptView) &&
+					w.handoff(ptView[res.pt].Owner.NodeID, database, retentionPolicy, res.pt, shard.ID, rows, res.err) == nil {
+					continue
+				}
+				w.lagReplica(database, retentionPolicy, shard.ID, res.pt)
+			}
+		}
+	}()
+	return <-done
+}
+
+// lagReplica marks the replica of the shard on pt as missing writes in meta, so that the reads avoid the replica until
+// the replica syncer re-syncs it.
+func (w *PointsWriter) lagReplica(database, retentionPolicy string, shard uint64, pt uint32) {
+	if err := w.MetaClient.LagReplica(database, retentionPolicy, shard, pt); err != nil {
+		w.logger.Error("mark lagging replica failed, the replica diverges from the others and is still read",
+			zap.String("db", database), zap.Uint32("pt", pt), zap.Uint64("shard", shard), zap.Error(err))
+		return
+	}
+	if w.ReplicaSyncer == nil {
+		w.logger.Error("replica missed writes and diverges from the others, it is not re-synced as the replica sync is disabled",
+			zap.String("db", database), zap.Uint32("pt", pt), zap.Uint64("shard", shard))
+	}
+}
+
+// ownedRows copies the rows to a buffer of their own, the rows of a request are views into the request buffer.
+func ownedRows(rows []influx.Row) ([]influx.Row, error) {
+	buf, err := influx.FastMarshalMultiRows(nil, rows)
+	if err != nil {
+		return nil, err
+	}
+	owned, _, _, _, _, err := influx.FastUnmarshalMultiRows(buf, nil, nil, nil, nil, nil)
+	return owned, err
+}
+
+// replicaSyncRows is the number of the flushed rows read from a healthy replica at a time
+const replicaSyncRows = 10000
+
+// ReplicaSyncer brings the replicas that missed writes up to date. The lagging replicas are kept in meta, so that
+// the reads of all SQL nodes avoid them until they are re-synced. Once the pt of a lagging replica is online again,
+// the rows of a healthy replica of the shard are copied to it: the rows of the flushed files first, then the rows
+// kept by the WAL. The WAL is read before the files, so the rows flushed in between are read from the files.
+type ReplicaSyncer struct {
+	MetaClient interface {
+		DBPtView(database string) (meta2.DBPtInfos, error)
+		ShardOwner(shardID uint64) (database, policy string, sgi *meta2.ShardGroupInfo)
+		LaggingReplicas() []meta2.LaggingReplica
+		ReplicaSynced(database, policy string, shardID uint64, pt uint32, seq uint64) error
+	}
+
+	TSDBStore interface {
+		WriteRows(nodeID uint64, database, rp string, pt uint32, shard uint64, rows *[]influx.Row, timeout time.Duration) error
+		ReadShardWal(nodeID uint64, db string, ptID uint32, shardID uint64) ([][]byte, error)
+		ReadShardRows(nodeID uint64, db string, ptID uint32, shardID uint64, cursor netstorage.RowsCursor,
+			limit int) ([]byte, netstorage.RowsCursor, bool, error)
+	}
+
+	interval time.Duration
+	timeout  time.Duration
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+	logger  *logger.Logger
+}
+
+func NewReplicaSyncer(interval, timeout time.Duration) *ReplicaSyncer {
+	return &ReplicaSyncer{
+		interval: interval,
+		timeout:  timeout,
+		closing:  make(chan struct{}),
+		logger:   logger.NewLogger(errno.ModuleCoordinator),
+	}
+}
+
+func (s *ReplicaSyncer) Open() {
+	s.wg.Add(1)
+	go s.run()
+}
+
+func (s *ReplicaSyncer) Close() {
+	close(s.closing)
+	s.wg.Wait()
+}
+
+func (s *ReplicaSyncer) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+			s.SyncAll()
+		}
+	}
+}
+
+// SyncAll re-syncs the lagging replicas whose pts are online, and clears their marks in meta. The mark of a replica
+// that missed another write while it was re-synced is kept, the replica is re-synced again.
+func (s *ReplicaSyncer) SyncAll() {
+	for _, r := range s.MetaClient.LaggingReplicas() {
+		done, err := s.sync(r)
+		if err != nil {
+			s.logger.Warn("re-sync replica failed", zap.String("db", r.Database), zap.Uint32("pt", r.PtID),
+				zap.Uint64("shard", r.ShardID), zap.Error(err))
+			continue
+		}
+		if !done {
+			continue
+		}
+		if err = s.MetaClient.ReplicaSynced(r.Database, r.Policy, r.ShardID, r.PtID, r.Seq); err != nil {
+			s.logger.Warn("clear lagging replica failed", zap.String("db", r.Database), zap.Uint32("pt", r.PtID),
+				zap.Uint64("shard", r.ShardID), zap.Error(err))
+		}
+	}
+}
+
+// sync copies the rows of a healthy replica of the shard to the lagging replica. It returns false if the replica
+// cannot be re-synced yet.
+func (s *ReplicaSyncer) sync(r meta2.LaggingReplica) (bool, error) {
+	_, _, sgi := s.MetaClient.ShardOwner(r.ShardID)
+	if sgi == nil {
+		return false, nil
+	}
+	shard := sgi.Shard(r.ShardID)
+	if shard == nil {
+		return false, nil
+	}
+	ptView, err := s.MetaClient.DBPtView(r.Database)
+	if err != nil {
+		return false, err
+	}
+	if int(r.PtID) >= len(ptView) || ptView[r.PtID].Status != meta2.Online {
+		return false, nil
+	}
+
+	source, ok := s.source(r.PtID, shard, ptView)
+	if !ok {
+		return false, nil
+	}
+	sourceNode := ptView[source].Owner.NodeID
+	records, err := s.TSDBStore.ReadShardWal(sourceNode, r.Database, source, r.ShardID)
+	if err != nil {
+		return false, err
+	}
+
+	w := &replicaWriter{syncer: s, replica: r, nodeID: ptView[r.PtID].Owner.NodeID}
+	var cursor netstorage.RowsCursor
+	for done := false; !done; {
+		var rows []byte
+		rows, cursor, done, err = s.TSDBStore.ReadShardRows(sourceNode, r.Database, source, r.ShardID, cursor, replicaSyncRows)
+		if err != nil {
+			return false, err
+		}
+		if err = w.write(rows); err != nil {
+			return false, err
+		}
+	}
+	flushed := w.n
+	for _, rec := range records {
+		if err = w.write(rec); err != nil {
+			return false, err
+		}
+	}
+	s.logger.Info("replica re-synced", zap.String("db", r.Database), zap.Uint32("pt", r.PtID),
+		zap.Uint64("shard", r.ShardID), zap.Uint32("source", source), zap.Int("flushed rows", flushed),
+		zap.Int("wal rows", w.n-flushed))
+	return true, nil
+}
+
+// source picks an online replica of the shard that is not lagging itself.
+func (s *ReplicaSyncer) source(target uint32, shard *meta2.ShardInfo, ptView meta2.DBPtInfos) (uint32, bool) {
+	for _, pt := range shard.Owners {
+		if pt == target || int(pt) >= len(ptView) || ptView[pt].Status != meta2.Online || shard.LaggingOwner(pt) {
+			continue
+		}
+		return pt, true
+	}
+	return 0, false
+}
+
+// replicaWriter writes the marshaled rows to a lagging replica, reusing the buffers of the unmarshaled rows.
+type replicaWriter struct {
+	syncer  *ReplicaSyncer
+	replica meta2.LaggingReplica
+	nodeID  uint64
+
+	rows             []influx.Row
+	tagPools         []influx.Tag
+	fieldPools       []influx.Field
+	indexOptionPools []influx.IndexOption
+	indexKeyPools    []byte
+	// the number of rows written
+	n int
+}
+
+func (w *replicaWriter) write(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	w.rows, w.tagPools, w.fieldPools, w.indexOptionPools, w.indexKeyPools, err = influx.FastUnmarshalMultiRows(buf,
+		w.rows[:0], w.tagPools[:0], w.fieldPools[:0], w.indexOptionPools[:0], w.indexKeyPools[:0])
+	if err != nil {
+		return err
+	}
+	if len(w.rows) == 0 {
+		return nil
+	}
+	r := w.replica
+	if err = w.syncer.TSDBStore.WriteRows(w.nodeID, r.Database, r.Policy, r.PtID, r.ShardID, &w.rows, w.syncer.timeout); err != nil {
+		return err
+	}
+	w.n += len(w.rows)
+	return nil
+}
+
+// pickReplica returns a random online replica among the owners of a shard, preferring the replicas that did not
+// miss writes. It falls back to a random owner if no replica is online.
+func pickReplica(shard *meta2.ShardInfo, ptView meta2.DBPtInfos) uint32 {
+	owners := shard.Owners
+	start := rand.Intn(len(owners))
+	online := -1
+	for i := range owners {
+		pt := owners[(start+i)%len(owners)]
+		if int(pt) >= len(ptView) || ptView[pt].Status != meta2.Online {
+			continue
+		}
+		if !shard.LaggingOwner(pt) {
+			return pt
+		}
+		if online < 0 {
+			online = int(pt)
+		}
+	}
+	if online >= 0 {
+		return uint32(online)
+	}
+	return owners[start]
+}
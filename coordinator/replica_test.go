@@ -0,0 +1,304 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistencyLevel(t *testing.T) {
+	for level, exp := range map[string][3]int{"one": {1, 1, 1}, "quorum": {1, 2, 2}, "all": {1, 2, 3}} {
+		l, err := ParseConsistencyLevel(level)
+		require.NoError(t, err)
+		for i := range exp {
+			assert.Equal(t, exp[i], l.required(i+1), level)
+		}
+	}
+	_, err := ParseConsistencyLevel("any")
+	require.Error(t, err)
+}
+
+type mockReplicaStore struct {
+	mu      sync.Mutex
+	written map[uint32]int
+	failPts map[uint32]bool
+	wal     [][]byte
+	// the pages of the flushed rows
+	flushed [][]byte
+	readErr error
+}
+
+func (s *mockReplicaStore) WriteRows(nodeID uint64, database, rp string, pt uint32, shard uint64, rows *[]influx.Row, timeout time.Duration) error {
+	if s.failPts[pt] {
+		return errno.NewError(errno.NoConnectionAvailable, nodeID, "")
+	}
+	s.mu.Lock()
+	s.written[pt] += len(*rows)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *mockReplicaStore) ReadShardWal(nodeID uint64, db string, ptID uint32, shardID uint64) ([][]byte, error) {
+	return s.wal, nil
+}
+
+func (s *mockReplicaStore) ReadShardRows(nodeID uint64, db string, ptID uint32, shardID uint64, cursor netstorage.RowsCursor,
+	limit int) ([]byte, netstorage.RowsCursor, bool, error) {
+	if s.readErr != nil {
+		return nil, cursor, false, s.readErr
+	}
+	if len(s.flushed) == 0 {
+		return nil, cursor, true, nil
+	}
+	page := s.flushed[cursor.SeriesID]
+	cursor.SeriesID++
+	return page, cursor, int(cursor.SeriesID) == len(s.flushed), nil
+}
+
+func (s *mockReplicaStore) writtenTo(pt uint32) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.written[pt]
+}
+
+func newReplicaMetaClient(status ...meta2.PtStatus) (*mockShardOwner, *meta2.ShardGroupInfo) {
+	mc := NewMockMetaClient()
+	rp, _ := mc.RetentionPolicyFn("db0", "rp0")
+	sgi := &rp.ShardGroups[0]
+	sgi.Shards[0].Owners = []uint32{0, 1, 2}
+	mc.DBPtViewFn = func(database string) (meta2.DBPtInfos, error) {
+		view := make(meta2.DBPtInfos, len(status))
+		for i := range status {
+			view[i] = meta2.PtInfo{PtId: uint32(i), Owner: meta2.PtOwner{NodeID: uint64(i + 1)}, Status: status[i]}
+		}
+		return view, nil
+	}
+	return &mockShardOwner{MockMetaClient: mc, sgi: sgi}, sgi
+}
+
+// mockShardOwner keeps the lagging replicas of the shards of sgi as meta does
+type mockShardOwner struct {
+	*MockMetaClient
+	mu  sync.Mutex
+	sgi *meta2.ShardGroupInfo
+	seq uint64
+}
+
+func (m *mockShardOwner) ShardOwner(shardID uint64) (string, string, *meta2.ShardGroupInfo) {
+	return "db0", "rp0", m.sgi
+}
+
+func (m *mockShardOwner) LagReplica(database, policy string, shardID uint64, pt uint32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seq++
+	sh := m.sgi.Shard(shardID)
+	for i := range sh.Lagging {
+		if sh.Lagging[i].PtID == pt {
+			sh.Lagging[i].Seq = m.seq
+			return nil
+		}
+	}
+	sh.Lagging = append(sh.Lagging, meta2.ReplicaLag{PtID: pt, Seq: m.seq})
+	return nil
+}
+
+func (m *mockShardOwner) ReplicaSynced(database, policy string, shardID uint64, pt uint32, seq uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sh := m.sgi.Shard(shardID)
+	for i := range sh.Lagging {
+		if sh.Lagging[i].PtID == pt && sh.Lagging[i].Seq == seq {
+			sh.Lagging = append(sh.Lagging[:i], sh.Lagging[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockShardOwner) LaggingReplicas() []meta2.LaggingReplica {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var replicas []meta2.LaggingReplica
+	for _, sh := range m.sgi.Shards {
+		for _, lag := range sh.Lagging {
+			replicas = append(replicas, meta2.LaggingReplica{Database: "db0", Policy: "rp0", ShardID: sh.ID, ReplicaLag: lag})
+		}
+	}
+	return replicas
+}
+
+func (m *mockShardOwner) lagging(pt uint32) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sgi.Shards[0].LaggingOwner(pt)
+}
+
+func TestPointsWriter_WriteReplicas(t *testing.T) {
+	mc, _ := newReplicaMetaClient(meta2.Online, meta2.Online, meta2.Offline)
+	store := &mockReplicaStore{written: map[uint32]int{}, failPts: map[uint32]bool{1: true}}
+
+	pw := NewPointsWriter(time.Second)
+	pw.MetaClient = mc
+	pw.TSDBStore = store
+	pw.ReplicaSyncer = NewReplicaSyncer(time.Hour, time.Second)
+
+	// pt 1 fails and pt 2 is offline, only one of three replicas accepts the rows
+	pw.SetWriteConsistency(ConsistencyLevelQuorum)
+	require.Error(t, pw.WritePointRows("db0", "rp0", generateRows()))
+	pw.SetWriteConsistency(ConsistencyLevelOne)
+	require.NoError(t, pw.WritePointRows("db0", "rp0", generateRows()))
+	assert.Equal(t, 0, store.writtenTo(2))
+
+	// the replicas missing the rows are marked in meta
+	assert.Eventually(t, func() bool {
+		return mc.lagging(1) && mc.lagging(2)
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, mc.lagging(0))
+
+	store.failPts = nil
+	pw.SetWriteConsistency(ConsistencyLevelAll)
+	require.Error(t, pw.WritePointRows("db0", "rp0", generateRows()))
+	mc.DBPtViewFn = func(database string) (meta2.DBPtInfos, error) {
+		return meta2.DBPtInfos{{PtId: 0, Status: meta2.Online}, {PtId: 1, Status: meta2.Online}, {PtId: 2, Status: meta2.Online}}, nil
+	}
+	require.NoError(t, pw.WritePointRows("db0", "rp0", generateRows()))
+}
+
+func TestReplicaSyncer_SyncAll(t *testing.T) {
+	mc, sgi := newReplicaMetaClient(meta2.Online, meta2.Online, meta2.Offline)
+	rows := generateRows()
+	rec, err := influx.FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+	store := &mockReplicaStore{written: map[uint32]int{}, wal: [][]byte{rec}, flushed: [][]byte{rec, rec}}
+
+	syncer := NewReplicaSyncer(time.Hour, time.Second)
+	syncer.MetaClient = mc
+	syncer.TSDBStore = store
+	shard := sgi.Shards[0].ID
+	require.NoError(t, mc.LagReplica("db0", "rp0", shard, 1))
+	require.NoError(t, mc.LagReplica("db0", "rp0", shard, 2))
+
+	// the replica stays lagging if the flushed rows cannot be read
+	store.readErr = errors.New("read failed")
+	syncer.SyncAll()
+	assert.True(t, mc.lagging(1))
+	store.readErr = nil
+
+	// pt 2 is offline and waits for the next round, pt 1 gets the flushed rows and the rows of the WAL
+	written := store.writtenTo(1)
+	syncer.SyncAll()
+	assert.Equal(t, written+3*len(rows), store.writtenTo(1))
+	assert.Equal(t, 0, store.writtenTo(2))
+	assert.False(t, mc.lagging(1))
+	assert.True(t, mc.lagging(2))
+
+	mc.DBPtViewFn = func(database string) (meta2.DBPtInfos, error) {
+		return meta2.DBPtInfos{{PtId: 0, Status: meta2.Online}, {PtId: 1, Status: meta2.Online}, {PtId: 2, Status: meta2.Online}}, nil
+	}
+	syncer.SyncAll()
+	assert.Equal(t, 3*len(rows), store.writtenTo(2))
+	assert.False(t, mc.lagging(2))
+	assert.Empty(t, mc.LaggingReplicas())
+}
+
+func TestPickReplica(t *testing.T) {
+	shard := &meta2.ShardInfo{ID: 1, Owners: []uint32{0, 1, 2}, Lagging: []meta2.ReplicaLag{{PtID: 2, Seq: 1}}}
+	view := meta2.DBPtInfos{{PtId: 0, Status: meta2.Offline}, {PtId: 1, Status: meta2.Online}, {PtId: 2, Status: meta2.Online}}
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, uint32(1), pickReplica(shard, view))
+	}
+
+	// the lagging replica is still better than an offline one
+	view[1].Status = meta2.Offline
+	assert.Equal(t, uint32(2), pickReplica(shard, view))
+	view[2].Status = meta2.Offline
+	assert.Contains(t, shard.Owners, pickReplica(shard, view))
+}
+
+type mockHintedHandoff struct {
+	mu     sync.Mutex
+	full   bool
+	queued map[uint32]int
+}
+
+func (h *mockHintedHandoff) WriteShard(nodeID uint64, database, rp string, pt uint32, shard uint64, rows []influx.Row) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.full {
+		return errno.NewError(errno.HintedHandoffQueueFull, nodeID, 0)
+	}
+	h.queued[pt] += len(rows)
+	return nil
+}
+
+func (h *mockHintedHandoff) queuedFor(pt uint32) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.queued[pt]
+}
+
+func TestPointsWriter_HintedHandoff(t *testing.T) {
+	store := &mockReplicaStore{written: map[uint32]int{}, failPts: map[uint32]bool{0: true}}
+	hh := &mockHintedHandoff{queued: map[uint32]int{}}
+	pw := NewPointsWriter(time.Second)
+	pw.MetaClient = NewMockMetaClient()
+	pw.TSDBStore = store
+	pw.HintedHandoff = hh
+
+	rows := generateRows()
+	require.NoError(t, pw.WritePointRows("db0", "rp0", rows))
+	assert.Equal(t, len(rows), hh.queuedFor(0))
+
+	// the write fails as before once the queue is full
+	hh.full = true
+	require.Error(t, pw.WritePointRows("db0", "rp0", generateRows()))
+	hh.full = false
+
+	// rows rejected by the store are not queued
+	assert.False(t, isUnreachable(errno.NewRemote("shard is closed", errno.EngineClosed)))
+	assert.False(t, isUnreachable(errno.NewError(errno.WriteConsistencyNotReached, 0, 1, 1, 1, nil)))
+	assert.True(t, isUnreachable(errno.NewError(errno.WriteReplicaOffline, 0, 1)))
+	assert.True(t, isUnreachable(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}))
+	// the rows may have been applied when the response is lost
+	assert.False(t, isUnreachable(errno.NewError(errno.SessionSelectTimeout, time.Second)))
+	assert.False(t, isUnreachable(errno.NewError(errno.ResponserClosed)))
+	assert.False(t, isUnreachable(&net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset")}))
+
+	// the replicas are queued instead of being re-synced, but do not count for the quorum
+	mc, _ := newReplicaMetaClient(meta2.Online, meta2.Online, meta2.Offline)
+	pw.MetaClient = mc
+	pw.ReplicaSyncer = NewReplicaSyncer(time.Hour, time.Second)
+	store.failPts = map[uint32]bool{1: true}
+	require.Error(t, pw.WritePointRows("db0", "rp0", generateRows()))
+	assert.Eventually(t, func() bool {
+		return hh.queuedFor(1) == len(rows) && hh.queuedFor(2) == len(rows)
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, mc.lagging(1))
+	assert.False(t, mc.lagging(2))
+}
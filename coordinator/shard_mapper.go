@@ -29,9 +29,9 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/machine"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
-	"github.com/openGemini/openGemini/lib/rand"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
@@ -119,6 +119,11 @@ func (csm *ClusterShardMapper) mapShards(a *ClusterShardMapping, sources influxq
 					continue
 				}
 
+				ptView, err := csm.MetaClient.DBPtView(s.Database)
+				if err != nil {
+					return err
+				}
+
 				shardIDsByPtID := make(map[uint32][]uint64)
 				for i, g := range groups {
 					gTimeRange := influxql.TimeRange{Min: g.StartTime, Max: g.EndTime}
@@ -148,7 +153,7 @@ func (csm *ClusterShardMapper) mapShards(a *ClusterShardMapping, sources influxq
 					for shIdx := range shs {
 						var ptID uint32
 						if len(shs[shIdx].Owners) > 0 {
-							ptID = shs[shIdx].Owners[rand.Intn(len(shs[shIdx].Owners))]
+							ptID = pickReplica(&shs[shIdx], ptView)
 						} else {
 							csm.Logger.Warn("shard has no owners", zap.Uint64("shardID", shs[shIdx].ID))
 							continue
@@ -162,6 +167,10 @@ func (csm *ClusterShardMapper) mapShards(a *ClusterShardMapping, sources influxq
 			if err := csm.mapShards(a, s.Statement.Sources, tmin, tmax, condition, opt); err != nil {
 				return err
 			}
+		case *influxql.Join:
+			if err := csm.mapShards(a, influxql.Sources{s.LSrc, s.RSrc}, tmin, tmax, condition, opt); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -510,6 +519,9 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 		Opt:      opt,
 		Analyze:  analyze,
 		Node:     nil,
+
+		QueryID:   opt.QueryID,
+		QueryHost: machine.GetMachineAddr(),
 	}
 	return rq, nil
 }
@@ -539,7 +551,7 @@ func (csm *ClusterShardMapping) GetSources(sources influxql.Sources) influxql.So
 				clone.Name = measurements[i].Name
 				srcs = append(srcs, clone)
 			}
-		case *influxql.SubQuery:
+		case *influxql.SubQuery, *influxql.Join:
 			srcs = append(srcs, src)
 		default:
 			panic("unknown measurement.")
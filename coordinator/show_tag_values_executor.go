@@ -35,6 +35,8 @@ type ShowTagValuesExecutor struct {
 	store       netstorage.Storage
 	cardinality bool
 	dimensions  influxql.Dimensions
+	// count the values of each tag key instead of listing them
+	tagCardinality bool
 }
 
 func NewShowTagValuesExecutor(logger *logger.Logger, mc meta.MetaClient, me IMetaExecutor, store netstorage.Storage) *ShowTagValuesExecutor {
@@ -51,6 +53,10 @@ func (e *ShowTagValuesExecutor) Cardinality(dimensions influxql.Dimensions) {
 	e.cardinality = true
 }
 
+func (e *ShowTagValuesExecutor) TagCardinality() {
+	e.tagCardinality = true
+}
+
 func (e *ShowTagValuesExecutor) Execute(stmt *influxql.ShowTagValuesStatement) (models.Rows, error) {
 	if stmt.Database == "" {
 		return nil, ErrDatabaseNameRequired
@@ -65,6 +71,9 @@ func (e *ShowTagValuesExecutor) Execute(stmt *influxql.ShowTagValuesStatement) (
 	if e.cardinality {
 		return e.emitCardinality(tagValues)
 	}
+	if e.tagCardinality {
+		return e.emitTagCardinality(tagValues, stmt.Offset, stmt.Limit)
+	}
 	return e.emit(tagValues, stmt.Offset, stmt.Limit)
 }
 
@@ -113,6 +122,57 @@ func (e *ShowTagValuesExecutor) emitCardinality(tagValues TagValuesSlice) (model
 	return rows, nil
 }
 
+// emitTagCardinality emits the tag keys of each measurement by descending number of values.
+func (e *ShowTagValuesExecutor) emitTagCardinality(tagValues TagValuesSlice, offset, limit int) (models.Rows, error) {
+	rows := make(models.Rows, 0, len(tagValues))
+
+	for _, m := range tagValues {
+		values := e.applyLimit(0, 0, m.Values)
+		if len(values) == 0 {
+			continue
+		}
+
+		// the values are sorted by key
+		var keys []tagKeyCardinality
+		for i := range values {
+			if i == 0 || values[i].Key != values[i-1].Key {
+				keys = append(keys, tagKeyCardinality{key: values[i].Key})
+			}
+			keys[len(keys)-1].count++
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			return keys[i].count > keys[j].count
+		})
+
+		if offset > 0 {
+			if offset >= len(keys) {
+				continue
+			}
+			keys = keys[offset:]
+		}
+		if limit > 0 && limit < len(keys) {
+			keys = keys[:limit]
+		}
+
+		row := &models.Row{
+			Name:    m.Name,
+			Columns: []string{"tagKey", "count"},
+			Values:  make([][]interface{}, len(keys)),
+		}
+		for i := range keys {
+			row.Values[i] = []interface{}{keys[i].key, keys[i].count}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+type tagKeyCardinality struct {
+	key   string
+	count int
+}
+
 func (e *ShowTagValuesExecutor) applyLimit(offset, limit int, values netstorage.TagSets) netstorage.TagSets {
 	size := len(values)
 	if offset >= size {
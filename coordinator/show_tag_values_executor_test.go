@@ -108,17 +108,49 @@ func TestShowTagValuesExecutor(t *testing.T) {
 	assert.EqualError(t, err, "mock error")
 }
 
+func TestShowTagValuesExecutor_TagCardinality(t *testing.T) {
+	e := &ShowTagValuesExecutor{}
+	tagValues := TagValuesSlice{{
+		Name: "mst",
+		Values: netstorage.TagSets{
+			{Key: "host", Value: "h1"},
+			{Key: "id", Value: "1"},
+			{Key: "id", Value: "2"},
+			{Key: "region", Value: "r1"},
+			{Key: "id", Value: "3"},
+			{Key: "host", Value: "h2"},
+			{Key: "id", Value: "1"},
+		},
+	}}
+
+	rows, err := e.emitTagCardinality(tagValues, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, models.Rows{&models.Row{
+		Name:    "mst",
+		Columns: []string{"tagKey", "count"},
+		Values:  [][]interface{}{{"id", 3}, {"host", 2}},
+	}}, rows)
+
+	rows, err = e.emitTagCardinality(tagValues, 2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"region", 1}}, rows[0].Values)
+
+	rows, err = e.emitTagCardinality(tagValues, 3, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, models.Rows{}, rows)
+}
+
 func TestApplyLimit(t *testing.T) {
 	e := &ShowTagValuesExecutor{}
 	data := netstorage.TagSets{
-		{"a", "aaa"},
-		{"b", "bbb"},
-		{"a", "aaa111"},
-		{"a", "aaa"},
-		{"b", "bbb"},
-		{"b", "bbb111"},
-		{"b", "bbb"},
-		{"c", "ccc"},
+		{Key: "a", Value: "aaa"},
+		{Key: "b", Value: "bbb"},
+		{Key: "a", Value: "aaa111"},
+		{Key: "a", Value: "aaa"},
+		{Key: "b", Value: "bbb"},
+		{Key: "b", Value: "bbb111"},
+		{Key: "b", Value: "bbb"},
+		{Key: "c", Value: "ccc"},
 	}
 
 	format := "limit failed. exp: len=%d, got: len=%d"
@@ -182,9 +214,9 @@ func (m *mockNS) TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map
 		return append(netstorage.TablesTagSets{}, netstorage.TableTagSets{
 			Name: "mst",
 			Values: netstorage.TagSets{
-				{"author", "petter"},
-				{"author", "van"},
-				{"author", "san"},
+				{Key: "author", Value: "petter"},
+				{Key: "author", Value: "van"},
+				{Key: "author", Value: "san"},
 			},
 		}), nil
 	}
@@ -193,8 +225,8 @@ func (m *mockNS) TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map
 		return append(netstorage.TablesTagSets{}, netstorage.TableTagSets{
 			Name: "mst_2",
 			Values: netstorage.TagSets{
-				{"author", "mao"},
-				{"author", "tai"},
+				{Key: "author", Value: "mao"},
+				{Key: "author", Value: "tai"},
 			},
 		}), nil
 	}
@@ -209,9 +241,9 @@ func (m *mockNS) TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map
 	return append(netstorage.TablesTagSets{}, netstorage.TableTagSets{
 		Name: "mst",
 		Values: netstorage.TagSets{
-			{"author", "mao"},
-			{"author", "tai"},
-			{"author", "san"},
+			{Key: "author", Value: "mao"},
+			{Key: "author", Value: "tai"},
+			{Key: "author", Value: "san"},
 		},
 	}), nil
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"go.uber.org/zap"
+)
+
+// StoreQueries reaches the pipelines the stores run for the queries of all SQL nodes, so that the queries can be
+// listed and killed from any SQL node. The stores that cannot be reached are skipped.
+type StoreQueries struct {
+	MetaClient interface {
+		DataNodes() ([]meta2.DataNode, error)
+	}
+
+	NetStorage interface {
+		ShowQueries(nodeID uint64) ([]query.StorePipeline, error)
+		KillQuery(nodeID uint64, host string, qid uint64) (int, error)
+	}
+
+	logger *logger.Logger
+}
+
+func NewStoreQueries() *StoreQueries {
+	return &StoreQueries{
+		logger: logger.NewLogger(errno.ModuleCoordinator),
+	}
+}
+
+func (s *StoreQueries) Pipelines() []query.StorePipeline {
+	var mu sync.Mutex
+	var pipelines []query.StorePipeline
+	_ = s.eachNode(func(nodeID uint64) error {
+		ps, err := s.NetStorage.ShowQueries(nodeID)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		pipelines = append(pipelines, ps...)
+		mu.Unlock()
+		return nil
+	})
+	return pipelines
+}
+
+// KillQuery fails only if none of the stores can be reached.
+func (s *StoreQueries) KillQuery(host string, qid uint64) (int, error) {
+	var mu sync.Mutex
+	killed := 0
+	err := s.eachNode(func(nodeID uint64) error {
+		n, err := s.NetStorage.KillQuery(nodeID, host, qid)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		killed += n
+		mu.Unlock()
+		return nil
+	})
+	return killed, err
+}
+
+// eachNode calls fn for all data nodes in parallel, and returns the last error if fn failed on every node.
+func (s *StoreQueries) eachNode(fn func(nodeID uint64) error) error {
+	nodes, err := s.MetaClient.DataNodes()
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(nodes))
+	for i := range nodes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if errs[i] = fn(nodes[i].ID); errs[i] != nil {
+				s.logger.Warn("failed to reach the queries on the node", zap.Uint64("node", nodes[i].ID), zap.Error(errs[i]))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, e := range errs {
+		if e == nil {
+			return nil
+		}
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDataNodes []uint64
+
+func (m mockDataNodes) DataNodes() ([]meta2.DataNode, error) {
+	nodes := make([]meta2.DataNode, 0, len(m))
+	for _, id := range m {
+		nodes = append(nodes, meta2.DataNode{NodeInfo: meta2.NodeInfo{ID: id}})
+	}
+	return nodes, nil
+}
+
+type mockQueryStore struct {
+	mu        sync.Mutex
+	down      map[uint64]bool
+	pipelines map[uint64][]query.StorePipeline
+}
+
+func (s *mockQueryStore) ShowQueries(nodeID uint64) ([]query.StorePipeline, error) {
+	if s.down[nodeID] {
+		return nil, errno.NewError(errno.NoConnectionAvailable, nodeID, "")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pipelines[nodeID], nil
+}
+
+func (s *mockQueryStore) KillQuery(nodeID uint64, host string, qid uint64) (int, error) {
+	if s.down[nodeID] {
+		return 0, errno.NewError(errno.NoConnectionAvailable, nodeID, "")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var kept []query.StorePipeline
+	for _, p := range s.pipelines[nodeID] {
+		if p.Host != host || p.QueryID != qid {
+			kept = append(kept, p)
+		}
+	}
+	n := len(s.pipelines[nodeID]) - len(kept)
+	s.pipelines[nodeID] = kept
+	return n, nil
+}
+
+func TestStoreQueries(t *testing.T) {
+	store := &mockQueryStore{
+		down: map[uint64]bool{3: true},
+		pipelines: map[uint64][]query.StorePipeline{
+			1: {{NodeID: 1, Host: "sql1", QueryID: 1, PtID: 0}, {NodeID: 1, Host: "sql2", QueryID: 1, PtID: 0}},
+			2: {{NodeID: 2, Host: "sql1", QueryID: 1, PtID: 1}},
+			3: {{NodeID: 3, Host: "sql1", QueryID: 1, PtID: 2}},
+		},
+	}
+	sq := NewStoreQueries()
+	sq.MetaClient = mockDataNodes{1, 2, 3}
+	sq.NetStorage = store
+
+	// the unreachable node is skipped
+	assert.Equal(t, 3, len(sq.Pipelines()))
+
+	n, err := sq.KillQuery("sql1", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []query.StorePipeline{{NodeID: 1, Host: "sql2", QueryID: 1, PtID: 0}}, sq.Pipelines())
+
+	store.down = map[uint64]bool{1: true, 2: true, 3: true}
+	_, err = sq.KillQuery("sql2", 1)
+	require.Error(t, err)
+}
@@ -786,6 +786,11 @@ func (s *AggTagSetCursor) assignMinFunc(index int) {
 			function: record.GetRecordIntegerMin,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordUnsignedMin,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordBooleanMin,
@@ -806,6 +811,11 @@ func (s *AggTagSetCursor) assignColumnMinFunc(index int) {
 			function: record.GetRecordColumnIntegerMin,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordColumnUnsignedMin,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordColumnBooleanMin,
@@ -826,6 +836,11 @@ func (s *AggTagSetCursor) assignMaxFunc(index int) {
 			function: record.GetRecordIntegerMax,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordUnsignedMax,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordBooleanMax,
@@ -846,6 +861,11 @@ func (s *AggTagSetCursor) assignColumnMaxFunc(index int) {
 			function: record.GetRecordColumnIntegerMax,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordColumnUnsignedMax,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordColumnBooleanMax,
@@ -866,6 +886,11 @@ func (s *AggTagSetCursor) assignFirstFunc(index int) {
 			function: record.GetRecordIntegerFirst,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordUnsignedFirst,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordBooleanFirst,
@@ -891,6 +916,11 @@ func (s *AggTagSetCursor) assignColumnFirstFunc(index int) {
 			function: record.GetRecordColumnIntegerFirst,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordColumnUnsignedFirst,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordColumnBooleanFirst,
@@ -916,6 +946,11 @@ func (s *AggTagSetCursor) assignLastFunc(index int) {
 			function: record.GetRecordIntegerLast,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordUnsignedLast,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordBooleanLast,
@@ -941,6 +976,11 @@ func (s *AggTagSetCursor) assignColumnLastFunc(index int) {
 			function: record.GetRecordColumnIntegerLast,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordColumnUnsignedLast,
+			index:    index,
+		})
 	case influx.Field_Type_Boolean:
 		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
 			function: record.GetRecordColumnBooleanLast,
@@ -966,6 +1006,11 @@ func (s *AggTagSetCursor) assignSumFunc(index int) {
 			function: record.GetRecordIntegerSum,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordUnsignedSum,
+			index:    index,
+		})
 	}
 }
 
@@ -981,6 +1026,11 @@ func (s *AggTagSetCursor) assignColumnSumFunc(index int) {
 			function: record.GetRecordColumnIntegerSum,
 			index:    index,
 		})
+	case influx.Field_Type_UInt:
+		s.aggFunction.functions = append(s.aggFunction.functions, &clusterCursorFunction{
+			function: record.GetRecordColumnUnsignedSum,
+			index:    index,
+		})
 	}
 }
 
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"go.uber.org/zap"
+)
+
+// Backup writes an online backup of the shards and indexes of the engine to dir, laid out as the data directory and
+// listed by the manifest of the backup. The immutable files are hard linked, so dir should be on the file system
+// of the data directory. If base, the directory of an earlier backup of the node, is given, the backup is an
+// incremental one and the TSSP files kept by base are left out; the indexes are always backed up in full.
+func (e *Engine) Backup(dir, base string) error {
+	if dir == "" {
+		return fmt.Errorf("backup path is required")
+	}
+	if _, err := fileops.Stat(dir); err == nil {
+		return backup.ErrBackupExists
+	}
+	baseShards := make(map[uint64]backup.FileSet)
+	if base != "" {
+		bm, err := backup.ReadManifest(base)
+		if err != nil {
+			return fmt.Errorf("read base backup: %v", err)
+		}
+		for _, sh := range bm.Shards {
+			baseShards[sh.ID] = backup.NewFileSet(sh.Files)
+		}
+	}
+	if err := fileops.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	log.Info("start backup...", zap.String("path", dir), zap.String("base", base))
+	manifest := &backup.Manifest{Time: start.UTC(), Base: base}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for db, partitions := range e.DBPartitions {
+		for id := range partitions {
+			if err := e.checkAndAddRefPTNoLock(db, id); err != nil {
+				// the pt is being dropped or moved
+				log.Warn("skip backup of db pt", zap.String("db", db), zap.Uint32("pt", id), zap.Error(err))
+				continue
+			}
+			dbPT := partitions[id]
+			err := dbPT.backup(dir, manifest, baseShards)
+			dbPT.unref()
+			if err != nil {
+				log.Error("backup db pt failed", zap.String("db", db), zap.Uint32("pt", id), zap.Error(err))
+				return err
+			}
+		}
+	}
+
+	if err := backup.WriteManifest(dir, manifest); err != nil {
+		return err
+	}
+	log.Info("backup done", zap.String("path", dir), zap.Int("shards", len(manifest.Shards)),
+		zap.Int("indexes", len(manifest.Indexes)), zap.Duration("time used", time.Since(start)))
+	return nil
+}
+
+// backup writes the shards and then the indexes of the pt to dir. The series of the flushed rows are indexed
+// before the rows are written, so the indexes backed up later cover all series in the shards.
+func (dbPT *DBPTInfo) backup(dir string, manifest *backup.Manifest, baseShards map[uint64]backup.FileSet) error {
+	ptDir := filepath.Join(DataDirectory, dbPT.database, strconv.Itoa(int(dbPT.id)))
+
+	dbPT.mu.RLock()
+	shards := make([]Shard, 0, len(dbPT.shards))
+	for _, sh := range dbPT.shards {
+		shards = append(shards, sh)
+	}
+	dbPT.mu.RUnlock()
+
+	for _, sh := range shards {
+		rel, err := filepath.Rel(dbPT.path, sh.DataPath())
+		if err != nil {
+			return err
+		}
+		files, err := sh.Backup(filepath.Join(dir, ptDir, rel), baseShards[sh.GetID()])
+		if err == ErrShardClosed {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		manifest.Shards = append(manifest.Shards, backup.Entry{
+			Database:        dbPT.database,
+			PtID:            dbPT.id,
+			RetentionPolicy: sh.RPName(),
+			ID:              sh.GetID(),
+			Dir:             filepath.Join(ptDir, rel),
+			Files:           files,
+		})
+	}
+
+	dbPT.mu.RLock()
+	defer dbPT.mu.RUnlock()
+	for id, iBuilder := range dbPT.indexBuilder {
+		rel, err := filepath.Rel(dbPT.path, iBuilder.Path())
+		if err != nil {
+			return err
+		}
+		if err = iBuilder.Backup(filepath.Join(dir, ptDir, rel)); err != nil {
+			return err
+		}
+		files, err := backup.ListFiles(filepath.Join(dir, ptDir, rel))
+		if err != nil {
+			return err
+		}
+		manifest.Indexes = append(manifest.Indexes, backup.Entry{
+			Database:        dbPT.database,
+			PtID:            dbPT.id,
+			RetentionPolicy: iBuilder.Ident().Policy,
+			ID:              id,
+			Dir:             filepath.Join(ptDir, rel),
+			Files:           files,
+		})
+	}
+	return nil
+}
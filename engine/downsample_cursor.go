@@ -0,0 +1,655 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/comm"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// ErrDownSampleQuery is returned for the queries on a down sampled measurement which can not be answered
+// by the windows kept by its downsample policy, which are the queries with
+//   - conditions on fields, whose values are aggregated into the windows
+//   - GROUP BY time windows which are not made of whole windows, in UTC or in the time zone of the query
+//   - calls other than sum, count, min, max, first and last, or calls not kept for the type of their field
+//   - raw selects of fields whose type keeps several calls, or a call which is not first, last, min or max
+//   - fields selected along with calls
+//
+// Fields whose type keeps no call are dropped by the downsample policy, they are read from the data
+// written after the shard is down sampled only.
+var ErrDownSampleQuery = fmt.Errorf("query can not be answered by the down sampled data")
+
+// downSampleCalls are the calls which can be served by the down sampled tables of the same op
+var downSampleCalls = map[string]bool{
+	"sum":   true,
+	"count": true,
+	"min":   true,
+	"max":   true,
+	"first": true,
+	"last":  true,
+}
+
+// downSampleValueCalls are the calls which pick one of the values of a window,
+// a raw select reads the windows of such a call as the values of a field
+var downSampleValueCalls = map[string]bool{
+	"min":   true,
+	"max":   true,
+	"first": true,
+	"last":  true,
+}
+
+// downSampleColumnName returns the name of the column which keeps field aggregated by op in a down sampled query
+func downSampleColumnName(field, op string) string {
+	return field + immutable.DownSampleTableSep + op
+}
+
+// downSamplePlan describes how a query on a down sampled measurement is served.
+// Each call is read from the down sampled table of its op into a column of its own, and the rows
+// written after the shard is down sampled are read into all columns as windows of a single row.
+// Count is served as the sum of the counts of the windows.
+// A raw select reads each field from the table of the only call kept for its type, one row per window.
+type downSamplePlan struct {
+	name     string
+	interval int64
+	// aggregate is false for raw selects, whose rows are the windows at their start time
+	aggregate bool
+
+	// columns are the columns read by the query sorted by name, followed by the time column.
+	// A raw select reads each field into a column of the name of the field.
+	columns record.Schemas
+	// fields and ops are the field and the op of each column, the op of a raw select of a dropped field is empty
+	fields []string
+	ops    []string
+	// dropped reports whether the type of the field of each column keeps no call,
+	// such a column is read from the raw data only
+	dropped []bool
+
+	// tables are the ops of the down sampled tables read by the query
+	tables []string
+	// raw is the schema of the fields read from the data written after the shard is down sampled
+	raw record.Schemas
+}
+
+// newDownSamplePlan returns an error wrapping ErrDownSampleQuery if the query can not be served by the down sampled tables.
+// The raw data of the measurement has been removed, so the query is never served by it.
+func newDownSamplePlan(name string, schema *executor.QuerySchema, state *immutable.DownSampleState) (*downSamplePlan, error) {
+	errorf := func(format string, a ...interface{}) error {
+		return fmt.Errorf("measurement %s is down sampled into windows of %s, %s: %w", name,
+			influxql.FormatDuration(time.Duration(state.Interval)), fmt.Sprintf(format, a...), ErrDownSampleQuery)
+	}
+
+	opt, ok := schema.Options().(*query.ProcessorOptions)
+	if !ok {
+		return nil, errorf("the query is not supported")
+	}
+	if schema.HasFieldCondition() {
+		return nil, errorf("conditions on fields are not supported")
+	}
+
+	type column struct {
+		field, op string
+		typ       int
+		dropped   bool
+	}
+	columns := make(map[string]column)
+	raw := make(map[string]record.Field)
+	addColumn := func(ref *influxql.VarRef, op string, typ int, dropped bool) {
+		colName := ref.Val
+		if schema.HasCall() {
+			colName = downSampleColumnName(ref.Val, op)
+		}
+		columns[colName] = column{field: ref.Val, op: op, typ: typ, dropped: dropped}
+		raw[ref.Val] = record.Field{Name: ref.Val, Type: record.ToModelTypes(ref.Type)}
+	}
+
+	if schema.HasCall() {
+		if !downSampleAligned(opt, state.Interval) {
+			return nil, errorf("GROUP BY time requires a multiple of the window with an offset and a time zone aligned to it")
+		}
+		for _, call := range schema.OrigCalls() {
+			if !downSampleCalls[call.Name] || len(call.Args) != 1 {
+				return nil, errorf("%s is not supported", call.Name)
+			}
+			ref, ok := call.Args[0].(*influxql.VarRef)
+			if !ok || !isDownSampleFieldType(ref.Type) {
+				return nil, errorf("%s is not supported", call.String())
+			}
+			typ := record.ToModelTypes(ref.Type)
+			dropped := len(state.Calls[typ]) == 0
+			if !dropped && !state.HasTable(typ, call.Name) {
+				return nil, errorf("%s of %s fields is not kept by the downsample policy", call.Name, ref.Type)
+			}
+
+			colType := typ
+			if call.Name == "count" {
+				colType = influx.Field_Type_Int
+			}
+			addColumn(ref, call.Name, colType, dropped)
+		}
+		for _, ref := range schema.Refs() {
+			if _, ok := raw[ref.Val]; !ok && ref.Type != influxql.Tag {
+				return nil, errorf("field %s must be aggregated", ref.Val)
+			}
+		}
+	} else {
+		for _, ref := range schema.Refs() {
+			if ref.Type == influxql.Tag {
+				continue
+			}
+			if !isDownSampleFieldType(ref.Type) {
+				return nil, errorf("field %s is not supported", ref.Val)
+			}
+			typ := record.ToModelTypes(ref.Type)
+			calls := state.Calls[typ]
+			if len(calls) == 0 {
+				addColumn(ref, "", typ, true)
+				continue
+			}
+			if len(calls) != 1 || !downSampleValueCalls[calls[0]] {
+				return nil, errorf("%s fields keep %s, raw selects require a single call of first, last, min or max",
+					ref.Type, strings.Join(calls, ","))
+			}
+			addColumn(ref, calls[0], typ, false)
+		}
+	}
+
+	p := &downSamplePlan{name: name, interval: state.Interval, aggregate: schema.HasCall()}
+	names := make([]string, 0, len(columns))
+	for colName := range columns {
+		names = append(names, colName)
+	}
+	sort.Strings(names)
+	tables := make(map[string]bool)
+	for _, colName := range names {
+		col := columns[colName]
+		p.columns = append(p.columns, record.Field{Name: colName, Type: col.typ})
+		p.fields = append(p.fields, col.field)
+		p.ops = append(p.ops, col.op)
+		p.dropped = append(p.dropped, col.dropped)
+		if !col.dropped && !tables[col.op] {
+			tables[col.op] = true
+			p.tables = append(p.tables, col.op)
+		}
+	}
+	p.columns = append(p.columns, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	sort.Strings(p.tables)
+
+	for _, f := range raw {
+		p.raw = append(p.raw, f)
+	}
+	sort.Sort(p.raw)
+	p.raw = append(p.raw, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	return p, nil
+}
+
+// isDownSampleFieldType reports whether typ is the type of a field, the fields of the types not kept
+// by the downsample policy are dropped from the down sampled tables
+func isDownSampleFieldType(typ influxql.DataType) bool {
+	switch typ {
+	case influxql.Float, influxql.Integer, influxql.String, influxql.Boolean:
+		return true
+	}
+	return false
+}
+
+// tableSchema returns the schema of the fields read from the down sampled table of op
+func (p *downSamplePlan) tableSchema(op string) record.Schemas {
+	var schema record.Schemas
+	for i, col := range p.columns[:len(p.columns)-1] {
+		if p.ops[i] == op && !p.dropped[i] {
+			schema = append(schema, record.Field{Name: p.fields[i], Type: col.Type})
+		}
+	}
+	sort.Sort(schema)
+	return append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+}
+
+// view returns a record sharing the columns of rec, named as the columns of the query.
+// rec is read from the down sampled table of op, or from the raw data if op is empty.
+func (p *downSamplePlan) view(rec *record.Record, op string) *record.Record {
+	view := &record.Record{}
+	for i, col := range p.columns[:len(p.columns)-1] {
+		if op != "" && (p.ops[i] != op || p.dropped[i]) {
+			continue
+		}
+		idx := rec.Schema.FieldIndex(p.fields[i])
+		if idx < 0 {
+			continue
+		}
+		cv := rec.ColVals[idx]
+		if op == "" && p.ops[i] == "count" {
+			cv = countColumn(&rec.ColVals[idx], rec.RowNums())
+		}
+		view.Schema = append(view.Schema, col)
+		view.ColVals = append(view.ColVals, cv)
+	}
+	timeIdx := rec.ColNums() - 1
+	view.Schema = append(view.Schema, rec.Schema[timeIdx])
+	view.ColVals = append(view.ColVals, rec.ColVals[timeIdx])
+	return view
+}
+
+// countColumn returns the count of each row of src, which is 1 if the row has a value
+func countColumn(src *record.ColVal, rows int) record.ColVal {
+	var cv record.ColVal
+	for i := 0; i < rows; i++ {
+		if src.IsNil(i) {
+			cv.AppendIntegerNull()
+		} else {
+			cv.AppendInteger(1)
+		}
+	}
+	return cv
+}
+
+// rewriteCallOptions makes each call read the column of its op, count adds up the counts of the windows
+func (p *downSamplePlan) rewriteCallOptions(ops []hybridqp.ExprOptions) []hybridqp.ExprOptions {
+	rewritten := make([]hybridqp.ExprOptions, 0, len(ops))
+	for _, op := range ops {
+		call, ok := op.Expr.(*influxql.Call)
+		if !ok {
+			rewritten = append(rewritten, op)
+			continue
+		}
+		ref := call.Args[0].(*influxql.VarRef)
+		arg := &influxql.VarRef{Val: downSampleColumnName(ref.Val, call.Name), Type: ref.Type}
+		name := call.Name
+		if name == "count" {
+			name = "sum"
+			arg.Type = influxql.Integer
+		}
+		rewritten = append(rewritten, hybridqp.ExprOptions{
+			Expr: &influxql.Call{Name: name, Args: []influxql.Expr{arg}},
+			Ref:  op.Ref,
+		})
+	}
+	return rewritten
+}
+
+// downSampleAligned reports whether the time windows of the query are made of whole windows of interval,
+// a query without GROUP BY time reads the whole time range as one window.
+// The windows at the edges of the time range of the query are read whole.
+// The offset of the time zone of the query is checked at the start and the end of its time range.
+func downSampleAligned(opt *query.ProcessorOptions, interval int64) bool {
+	d, offset := int64(opt.Interval.Duration), int64(opt.Interval.Offset)
+	if d == 0 {
+		return true
+	}
+	if d%interval != 0 || offset%interval != 0 {
+		return false
+	}
+	for _, t := range []int64{opt.StartTime, opt.EndTime} {
+		if _, zone := opt.Zone(t); zone%interval != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// downSampleReaders are the files read by a query on a down sampled measurement
+type downSampleReaders struct {
+	plan   *downSamplePlan
+	tables map[string]*immutable.MmsReaders
+	raw    *immutable.MmsReaders
+}
+
+func (s *shard) refDownSampleReaders(plan *downSamplePlan) *downSampleReaders {
+	r := &downSampleReaders{
+		plan:   plan,
+		tables: make(map[string]*immutable.MmsReaders, len(plan.tables)),
+	}
+	for _, op := range plan.tables {
+		r.tables[op] = s.cloneMeasurementReaders(immutable.DownSampleTableName(plan.name, op))
+	}
+	r.raw = s.cloneMeasurementReaders(plan.name)
+	return r
+}
+
+func (r *downSampleReaders) unref() {
+	unref := func(readers *immutable.MmsReaders) {
+		immutable.UnrefFiles(readers.Orders...)
+		immutable.UnrefFiles(readers.OutOfOrders...)
+	}
+	for _, readers := range r.tables {
+		unref(readers)
+	}
+	unref(r.raw)
+}
+
+// downSampleContext reads the down sampled tables and the raw data for the series of a group cursor
+type downSampleContext struct {
+	plan   *downSamplePlan
+	tables map[string]*idKeyCursorContext
+	raw    *idKeyCursorContext
+}
+
+func newDownSampleContext(ctx *idKeyCursorContext, readers *downSampleReaders) *downSampleContext {
+	plan := readers.plan
+	sub := func(readers *immutable.MmsReaders, schema record.Schemas, tr record.TimeRange) *idKeyCursorContext {
+		return &idKeyCursorContext{
+			decs:         ctx.decs,
+			maxRowCnt:    ctx.maxRowCnt,
+			tr:           tr,
+			filterTags:   ctx.filterTags,
+			m:            ctx.m,
+			schema:       schema,
+			readers:      readers,
+			aggPool:      ctx.aggPool,
+			seriesPool:   ctx.seriesPool,
+			tmsMergePool: ctx.tmsMergePool,
+			querySchema:  ctx.querySchema,
+		}
+	}
+
+	// the rows of the down sampled tables are at the start of their windows, aggregations read
+	// the window at the start of the time range whole, raw selects only the windows starting in it
+	tr := ctx.tr
+	if rem := tr.Min % plan.interval; rem != 0 && plan.aggregate && tr.Min > influxql.MinTime+plan.interval {
+		tr.Min -= rem
+		if rem < 0 {
+			tr.Min -= plan.interval
+		}
+	}
+
+	dc := &downSampleContext{
+		plan:   plan,
+		tables: make(map[string]*idKeyCursorContext, len(plan.tables)),
+		raw:    sub(readers.raw, plan.raw, ctx.tr),
+	}
+	for _, op := range plan.tables {
+		dc.tables[op] = sub(readers.tables[op], plan.tableSchema(op), tr)
+	}
+	return dc
+}
+
+// readFiles appends the rows of a series in the files of ctx to dst
+func (dc *downSampleContext) readFiles(dst *record.Record, ctx *idKeyCursorContext, op string, sid uint64,
+	filter influxql.Expr, tags *influx.PointTags, span *tracing.Span) error {
+	c, err := NewTsmMergeCursor(ctx, sid, filter, tags, span)
+	if err != nil || c == nil {
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	for {
+		rec, err := c.Next()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			return nil
+		}
+		dst.AppendRec(dc.plan.view(rec, op), 0, rec.RowNums())
+	}
+}
+
+func (s *shard) newDownSampleTagSetCursor(ctx *idKeyCursorContext, span *tracing.Span, schema *executor.QuerySchema,
+	tagSet *tsi.TagSetInfo, start, step int) (*tagSetCursor, error) {
+	if start >= len(tagSet.IDs) {
+		return nil, fmt.Errorf("error tagset start index")
+	}
+
+	itrs := make(comm.KeyCursors, 0, len(tagSet.IDs)/step+1)
+	for i := start; i < len(tagSet.IDs); i += step {
+		itr, err := s.newDownSampleSeriesCursor(ctx, span, schema, tagSet, i)
+		if err != nil {
+			_ = itrs.Close()
+			return nil, err
+		}
+		if itr == nil {
+			continue
+		}
+
+		var cursor comm.KeyCursor = itr
+		if itr.plan.aggregate {
+			cursor = NewAggregateCursor(itr, schema, ctx.aggPool, ctx.hasAuxTags())
+		}
+		if schema.Options().GetOffset()+schema.Options().GetLimit() > 0 {
+			itrLimit := NewLimitCursor(schema, RecordCutNormal)
+			itrLimit.SetCursor(cursor)
+			cursor = itrLimit
+		}
+		itrs = append(itrs, cursor)
+	}
+	if len(itrs) == 0 {
+		return nil, nil
+	}
+
+	tagSetItr := &tagSetCursor{
+		schema: schema,
+		init:   false,
+		heapCursor: &heapCursor{
+			ascending: schema.Options().IsAscending(),
+			items:     make([]*TagSetCursorItem, 0, len(itrs)),
+		},
+		breakPoint:      &breakPoint{},
+		RecordCutHelper: RecordCutNormal,
+		keyCursors:      itrs,
+		ctx:             ctx,
+	}
+	if hasMultipleColumnsWithFirst(schema) {
+		tagSetItr.GetRecord = tagSetItr.NextWithPreAgg
+	} else {
+		tagSetItr.GetRecord = tagSetItr.NextWithoutPreAgg
+	}
+	return tagSetItr, nil
+}
+
+// newDownSampleSeriesCursor reads all rows of a series, which are few since they are aggregated into windows
+func (s *shard) newDownSampleSeriesCursor(ctx *idKeyCursorContext, span *tracing.Span, schema *executor.QuerySchema,
+	tagSet *tsi.TagSetInfo, idx int) (*downSampleSeriesCursor, error) {
+	dc := ctx.downSample
+	sid := tagSet.IDs[idx]
+	filter := tagSet.Filters[idx]
+	ptTags := &(tagSet.TagsVec[idx])
+	ascending := schema.Options().IsAscending()
+
+	all := record.NewRecordBuilder(dc.plan.columns)
+	for _, op := range dc.plan.tables {
+		if err := dc.readFiles(all, dc.tables[op], op, sid, filter, ptTags, span); err != nil {
+			return nil, err
+		}
+	}
+
+	// the rows written after the shard is down sampled
+	memTableRecord := s.GetValuesInMutableAndSnapshot(dc.plan.name, sid, dc.raw.tr, dc.raw.schema, ascending)
+	if memTableRecord != nil && memTableRecord.RowNums() > 0 {
+		all.AppendRec(dc.plan.view(memTableRecord, ""), 0, memTableRecord.RowNums())
+	}
+	if err := dc.readFiles(all, dc.raw, "", sid, filter, ptTags, span); err != nil {
+		return nil, err
+	}
+
+	if all.RowNums() == 0 {
+		return nil, nil
+	}
+	rec := sortRecordByTime(all, ascending).KickNilRow()
+	if rec.RowNums() == 0 {
+		return nil, nil
+	}
+	if !dc.plan.aggregate {
+		// the fields of a raw select may be read from the tables of different ops
+		rec = mergeRowsByTime(rec)
+	}
+
+	tagSet.Ref()
+	return &downSampleSeriesCursor{
+		sInfo:     &seriesInfo{key: tagSet.SeriesKeys[idx], tags: *ptTags},
+		tagSetRef: tagSet,
+		plan:      dc.plan,
+		maxRowCnt: schema.Options().ChunkSizeNum(),
+		rec:       rec,
+	}, nil
+}
+
+// sortRecordByTime returns the rows of rec sorted by time, rows of the same time are kept in order
+func sortRecordByTime(rec *record.Record, ascending bool) *record.Record {
+	times := rec.Times()
+	idxs := make([]int, len(times))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		if ascending {
+			return times[idxs[i]] < times[idxs[j]]
+		}
+		return times[idxs[i]] > times[idxs[j]]
+	})
+
+	dst := record.NewRecordBuilder(rec.Schema)
+	for start := 0; start < len(idxs); {
+		end := start + 1
+		for end < len(idxs) && idxs[end] == idxs[end-1]+1 {
+			end++
+		}
+		dst.AppendRec(rec, idxs[start], idxs[end-1]+1)
+		start = end
+	}
+	return dst
+}
+
+// mergeRowsByTime merges the rows of the same time of rec, which is sorted by time, into one row.
+// Each column of a merged row keeps the first value of the rows.
+func mergeRowsByTime(rec *record.Record) *record.Record {
+	times := rec.Times()
+	timeIdx := rec.ColNums() - 1
+	dst := record.NewRecordBuilder(rec.Schema)
+	for start := 0; start < len(times); {
+		end := start + 1
+		for end < len(times) && times[end] == times[start] {
+			end++
+		}
+		for i := 0; i < timeIdx; i++ {
+			col := &rec.ColVals[i]
+			row := start
+			for row < end && col.IsNil(row) {
+				row++
+			}
+			if row < end {
+				dst.ColVals[i].AppendColVal(col, rec.Schema[i].Type, row, row+1)
+			} else {
+				dst.ColVals[i].PadColVal(rec.Schema[i].Type, 1)
+			}
+		}
+		dst.ColVals[timeIdx].AppendInteger(times[start])
+		start = end
+	}
+	return dst
+}
+
+// downSampleSeriesCursor returns the rows of a series read by a downSamplePlan. For aggregations
+// it is followed by an aggregateCursor which reads the column of the op of each call, the rows of
+// a raw select are returned with the columns named as the fields.
+type downSampleSeriesCursor struct {
+	sInfo     *seriesInfo
+	tagSetRef *tsi.TagSetInfo
+	plan      *downSamplePlan
+	maxRowCnt int
+
+	rec    *record.Record
+	pos    int
+	schema record.Schemas
+}
+
+// SetOps does nothing, the down sampled data is not pre aggregated
+func (c *downSampleSeriesCursor) SetOps(_ []*comm.CallOption) {
+}
+
+func (c *downSampleSeriesCursor) SinkPlan(plan hybridqp.QueryNode) {
+	refs := make(map[string]string)
+	for _, expr := range plan.RowExprOptions() {
+		if ref, ok := expr.Expr.(*influxql.VarRef); ok {
+			refs[ref.Val] = expr.Ref.Val
+		}
+	}
+
+	schema := make(record.Schemas, 0, len(c.plan.columns))
+	for i, col := range c.plan.columns[:len(c.plan.columns)-1] {
+		name := c.plan.fields[i]
+		if ref, ok := refs[name]; ok {
+			name = ref
+		}
+		if c.plan.aggregate {
+			name = downSampleColumnName(name, c.plan.ops[i])
+		}
+		schema = append(schema, record.Field{Name: name, Type: col.Type})
+	}
+	c.schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+}
+
+func (c *downSampleSeriesCursor) Next() (*record.Record, comm.SeriesInfoIntf, error) {
+	rows := c.rec.RowNums()
+	if c.pos >= rows {
+		return nil, nil, nil
+	}
+	end := c.pos + c.maxRowCnt
+	if c.maxRowCnt <= 0 || end > rows {
+		end = rows
+	}
+
+	schema := c.GetSchema()
+	rec := &record.Record{Schema: schema, ColVals: make([]record.ColVal, len(schema))}
+	for i := range rec.ColVals {
+		rec.ColVals[i].AppendColVal(&c.rec.ColVals[i], schema[i].Type, c.pos, end)
+	}
+	c.pos = end
+	return rec, c.sInfo, nil
+}
+
+func (c *downSampleSeriesCursor) GetSchema() record.Schemas {
+	if c.schema != nil {
+		return c.schema
+	}
+	return c.plan.columns
+}
+
+func (c *downSampleSeriesCursor) Close() error {
+	if c.tagSetRef != nil {
+		c.tagSetRef.Unref()
+		c.tagSetRef = nil
+	}
+	return nil
+}
+
+func (c *downSampleSeriesCursor) Name() string {
+	return "down_sample_series_cursor"
+}
+
+func (c *downSampleSeriesCursor) StartSpan(_ *tracing.Span) {
+}
+
+func (c *downSampleSeriesCursor) EndSpan() {
+}
+
+func (c *downSampleSeriesCursor) NextAggData() (*record.Record, *comm.FileInfo, error) {
+	return nil, nil, nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func buildDownSampleQuerySchema(t *testing.T, q string) *executor.QuerySchema {
+	shardGroup := &mockShardGroup{
+		Fields: map[string]influxql.DataType{
+			"v": influxql.Float,
+			"n": influxql.Integer,
+			"s": influxql.String,
+		},
+		Dimensions: []string{"host"},
+	}
+	stmt := MustParseSelectStatement(q)
+	stmt, err := stmt.RewriteFields(shardGroup, true)
+	require.NoError(t, err)
+	opt, err := query.NewProcessorOptionsStmt(stmt, query.SelectOptions{ChunkSize: 1024})
+	require.NoError(t, err)
+	opt.Name = "mst"
+	return executor.NewQuerySchema(stmt.Fields, stmt.ColumnNames(), &opt)
+}
+
+func TestNewDownSamplePlan(t *testing.T) {
+	state := &immutable.DownSampleState{
+		Level:    1,
+		Interval: int64(time.Minute),
+		Calls: map[int][]string{
+			influx.Field_Type_Float: {"max", "mean", "min"},
+			influx.Field_Type_Int:   {"max"},
+		},
+	}
+
+	plan, err := newDownSamplePlan("mst",
+		buildDownSampleQuerySchema(t, "SELECT max(v), min(v), mean(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)"), state)
+	require.NoError(t, err)
+	require.Equal(t, []string{"count", "max", "min", "sum"}, plan.tables)
+	names := make([]string, 0, len(plan.columns))
+	for _, col := range plan.columns {
+		names = append(names, col.Name)
+	}
+	require.Equal(t, []string{"v:count", "v:max", "v:min", "v:sum", record.TimeField}, names)
+	require.Equal(t, influx.Field_Type_Int, plan.columns[0].Type)
+	require.Equal(t, record.Schemas{{Name: "v", Type: influx.Field_Type_Float}, {Name: record.TimeField, Type: influx.Field_Type_Int}},
+		plan.tableSchema("max"))
+
+	for _, q := range []string{
+		// the whole time range is made of whole windows
+		"SELECT max(v), mean(v) FROM mst WHERE time >= 0 AND time < 3600000000000",
+		// offsets and time zones aligned to the windows
+		"SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m, 2m)",
+		"SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(1h) tz('Asia/Shanghai')",
+		// the data of the string fields is dropped, it is read from the rows written after the shard is down sampled
+		"SELECT count(s), last(s) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+	} {
+		_, err = newDownSamplePlan("mst", buildDownSampleQuerySchema(t, q), state)
+		require.NoError(t, err, q)
+	}
+
+	plan, err = newDownSamplePlan("mst",
+		buildDownSampleQuerySchema(t, "SELECT count(s), max(n) FROM mst WHERE time >= 0 AND time < 3600000000000"), state)
+	require.NoError(t, err)
+	require.Equal(t, []string{"max"}, plan.tables)
+	require.Equal(t, []bool{false, true}, plan.dropped)
+	require.Equal(t, record.Schemas{{Name: "n", Type: influx.Field_Type_Int}, {Name: record.TimeField, Type: influx.Field_Type_Int}},
+		plan.tableSchema("max"))
+
+	// a raw select reads the windows of the only call kept for the type of each field
+	plan, err = newDownSamplePlan("mst", buildDownSampleQuerySchema(t, "SELECT n, s FROM mst WHERE time >= 0 AND time < 3600000000000"), state)
+	require.NoError(t, err)
+	require.False(t, plan.aggregate)
+	require.Equal(t, []string{"max"}, plan.tables)
+	require.Equal(t, record.Schemas{
+		{Name: "n", Type: influx.Field_Type_Int},
+		{Name: "s", Type: influx.Field_Type_String},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	}, plan.columns)
+	require.Equal(t, []string{"max", ""}, plan.ops)
+
+	for _, q := range []string{
+		// the op is not kept for the type
+		"SELECT count(n) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+		"SELECT min(n) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+		"SELECT last(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+		// raw select of a type keeping several calls
+		"SELECT v FROM mst WHERE time >= 0 AND time < 3600000000000",
+		// conditions on fields
+		"SELECT max(v) FROM mst WHERE v > 1 AND time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+		// fields selected along with calls
+		"SELECT max(v), n FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m)",
+		// windows not made of whole down sampled windows
+		"SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(90s)",
+		"SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(5m, 30s)",
+	} {
+		_, err = newDownSamplePlan("mst", buildDownSampleQuerySchema(t, q), state)
+		require.ErrorIs(t, err, ErrDownSampleQuery, q)
+	}
+
+	// the offset of the time zone is not a multiple of the window
+	hourly := *state
+	hourly.Interval = int64(time.Hour)
+	_, err = newDownSamplePlan("mst",
+		buildDownSampleQuerySchema(t, "SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(1d) tz('Asia/Kolkata')"), &hourly)
+	require.ErrorIs(t, err, ErrDownSampleQuery)
+	_, err = newDownSamplePlan("mst",
+		buildDownSampleQuerySchema(t, "SELECT max(v) FROM mst WHERE time >= 0 AND time < 3600000000000 GROUP BY time(1d) tz('Asia/Shanghai')"), &hourly)
+	require.NoError(t, err)
+}
+
+func TestDownSampleSeriesCursor(t *testing.T) {
+	state := &immutable.DownSampleState{
+		Level:    1,
+		Interval: 10,
+		Calls:    map[int][]string{influx.Field_Type_Float: {"max", "mean"}},
+	}
+	schema := buildDownSampleQuerySchema(t, "SELECT max(v), count(v) FROM mst WHERE time >= 0 AND time < 100 GROUP BY time(20ns)")
+	plan, err := newDownSamplePlan("mst", schema, state)
+	require.NoError(t, err)
+
+	// the windows of the down sampled tables
+	all := record.NewRecordBuilder(plan.columns)
+	maxRec := record.NewRecordBuilder(plan.tableSchema("max"))
+	maxRec.ColVals[0].AppendFloats(3, 5)
+	maxRec.AppendTime(0, 10)
+	all.AppendRec(plan.view(maxRec, "max"), 0, maxRec.RowNums())
+	countRec := record.NewRecordBuilder(plan.tableSchema("count"))
+	countRec.ColVals[0].AppendIntegers(4, 2)
+	countRec.AppendTime(0, 10)
+	all.AppendRec(plan.view(countRec, "count"), 0, countRec.RowNums())
+
+	// the rows written after the shard is down sampled
+	raw := record.NewRecordBuilder(plan.raw)
+	raw.ColVals[0].AppendFloat(7)
+	raw.ColVals[0].AppendFloatNull()
+	raw.AppendTime(15, 16)
+	all.AppendRec(plan.view(raw, ""), 0, raw.RowNums())
+
+	rec := sortRecordByTime(all, true).KickNilRow()
+	require.Equal(t, []int64{0, 0, 10, 10, 15}, rec.Times())
+
+	input := &downSampleSeriesCursor{plan: plan, maxRowCnt: 2, rec: rec}
+	ops := plan.rewriteCallOptions([]hybridqp.ExprOptions{
+		{
+			Expr: &influxql.Call{Name: "max", Args: []influxql.Expr{&influxql.VarRef{Val: "v", Type: influxql.Float}}},
+			Ref:  influxql.VarRef{Val: "max", Type: influxql.Float},
+		},
+		{
+			Expr: &influxql.Call{Name: "count", Args: []influxql.Expr{&influxql.VarRef{Val: "v", Type: influxql.Float}}},
+			Ref:  influxql.VarRef{Val: "count", Type: influxql.Integer},
+		},
+	})
+	require.Equal(t, "sum(\"v:count\"::integer)", ops[1].Expr.String())
+
+	c := NewAggregateCursor(input, schema, record.NewRecordPool(), false)
+	c.SetSchema(input.GetSchema(), record.Schemas{
+		{Name: "count", Type: influx.Field_Type_Int},
+		{Name: "max", Type: influx.Field_Type_Float},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	}, ops)
+
+	out, _, err := c.Next()
+	require.NoError(t, err)
+	require.Equal(t, []int64{7}, out.ColVals[0].IntegerValues())
+	require.Equal(t, []float64{7}, out.ColVals[1].FloatValues())
+	require.NoError(t, c.Close())
+}
+
+func TestDownSampleSeriesCursor_RawSelect(t *testing.T) {
+	state := &immutable.DownSampleState{
+		Level:    1,
+		Interval: 10,
+		Calls: map[int][]string{
+			influx.Field_Type_Float: {"max"},
+			influx.Field_Type_Int:   {"last"},
+		},
+	}
+	plan, err := newDownSamplePlan("mst", buildDownSampleQuerySchema(t, "SELECT n, v FROM mst WHERE time >= 0 AND time < 100"), state)
+	require.NoError(t, err)
+	require.Equal(t, []string{"last", "max"}, plan.tables)
+
+	all := record.NewRecordBuilder(plan.columns)
+	maxRec := record.NewRecordBuilder(plan.tableSchema("max"))
+	maxRec.ColVals[0].AppendFloats(3, 5)
+	maxRec.AppendTime(0, 10)
+	all.AppendRec(plan.view(maxRec, "max"), 0, maxRec.RowNums())
+	lastRec := record.NewRecordBuilder(plan.tableSchema("last"))
+	lastRec.ColVals[0].AppendIntegers(1, 2)
+	lastRec.AppendTime(0, 20)
+	all.AppendRec(plan.view(lastRec, "last"), 0, lastRec.RowNums())
+	raw := record.NewRecordBuilder(plan.raw)
+	raw.ColVals[0].AppendIntegerNull()
+	raw.ColVals[1].AppendFloat(7)
+	raw.AppendTime(15)
+	all.AppendRec(plan.view(raw, ""), 0, raw.RowNums())
+
+	rec := mergeRowsByTime(sortRecordByTime(all, true).KickNilRow())
+	require.Equal(t, []int64{0, 10, 15, 20}, rec.Times())
+	require.Equal(t, []int64{1, 2}, rec.ColVals[0].IntegerValues())
+	require.True(t, rec.ColVals[0].IsNil(1))
+	require.True(t, rec.ColVals[0].IsNil(2))
+	require.Equal(t, []float64{3, 5, 7}, rec.ColVals[1].FloatValues())
+	require.True(t, rec.ColVals[1].IsNil(3))
+
+	c := &downSampleSeriesCursor{plan: plan, maxRowCnt: 3, rec: rec}
+	out, _, err := c.Next()
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 10, 15}, out.Times())
+	out, _, err = c.Next()
+	require.NoError(t, err)
+	require.Equal(t, []int64{20}, out.Times())
+	out, _, err = c.Next()
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
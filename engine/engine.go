@@ -532,6 +532,43 @@ func (e *Engine) ChangeShardTierToWarm(db string, ptId uint32, shardID uint64) e
 	return nil
 }
 
+func (e *Engine) FetchShardsDownSampleInfo() []*meta2.ShardDownSampleInfo {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var infos []*meta2.ShardDownSampleInfo
+	for db := range e.DBPartitions {
+		for pt := range e.DBPartitions[db] {
+			for _, shard := range e.DBPartitions[db][pt].shards {
+				infos = append(infos, shard.DownSampleInfo())
+			}
+		}
+	}
+	return infos
+}
+
+func (e *Engine) DownSampleShard(db string, ptId uint32, shardID uint64, level int, policy *meta2.DownSamplePolicyInfo) error {
+	log.Info("downsample shard", zap.String("db", db), zap.Uint64("shardID", shardID), zap.Int("level", level))
+	e.mu.RLock()
+	if err := e.checkAndAddRefPTNoLock(db, ptId); err != nil {
+		e.mu.RUnlock()
+		return err
+	}
+	dbPtInfo := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+
+	defer e.unrefDBPT(db, ptId)
+
+	dbPtInfo.mu.RLock()
+	sh, ok := dbPtInfo.shards[shardID]
+	dbPtInfo.mu.RUnlock()
+	if !ok {
+		return ErrShardNotFound
+	}
+
+	return sh.DownSample(level, policy)
+}
+
 func (e *Engine) WriteRows(db, rp string, ptId uint32, shardID uint64, rows []influx.Row, binaryRows []byte) error {
 	if err := e.checkReadonly(); err != nil {
 		return err
@@ -611,6 +648,44 @@ func (e *Engine) GetShardSplitPoints(db string, ptId uint32, shardID uint64, idx
 	return shard.GetSplitPoints(idxes)
 }
 
+// ReadShardWal returns the WAL records of a shard, which a replica that missed writes of the shard is re-synced from.
+func (e *Engine) ReadShardWal(db string, ptId uint32, shardID uint64) ([][]byte, error) {
+	e.mu.RLock()
+	if !e.isDBPtExist(db, ptId) {
+		e.mu.RUnlock()
+		return nil, ErrPTNotFound
+	}
+	dbPtInfo := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+
+	shard := dbPtInfo.Shard(shardID)
+	if shard == nil {
+		return nil, ErrShardNotFound
+	}
+
+	return shard.ReadWal()
+}
+
+// ReadShardRows returns a page of the flushed rows of a shard from cursor on, which a replica that missed writes of the
+// shard is re-synced from.
+func (e *Engine) ReadShardRows(db string, ptId uint32, shardID uint64, cursor netstorage.RowsCursor,
+	limit int) ([]byte, netstorage.RowsCursor, bool, error) {
+	e.mu.RLock()
+	if !e.isDBPtExist(db, ptId) {
+		e.mu.RUnlock()
+		return nil, cursor, false, ErrPTNotFound
+	}
+	dbPtInfo := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+
+	shard := dbPtInfo.Shard(shardID)
+	if shard == nil {
+		return nil, cursor, false, ErrShardNotFound
+	}
+
+	return shard.ReadRows(cursor, limit)
+}
+
 func (e *Engine) isDBPtExist(db string, ptId uint32) bool {
 	if dbPT, dbExist := e.DBPartitions[db]; dbExist {
 		if _, dbPTExist := dbPT[ptId]; dbPTExist {
@@ -1297,8 +1372,64 @@ func appendValuesToMap(results map[string][][]string, name string, values [][]st
 	}
 }
 
+// DropSeries deletes the rows of the series matching the condition from the partitions.
+// The time range in the condition limits the rows to delete, and a series is removed
+// from the index once it has no rows left.
 func (e *Engine) DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error) {
-	panic("implement me")
+	start := time.Now()
+	atomic.AddInt64(&stat.EngineStat.DropSeriesCount, 1)
+	defer func(tm time.Time) {
+		d := time.Since(tm)
+		atomic.AddInt64(&stat.EngineStat.DropSeriesDurations, d.Nanoseconds())
+		stat.UpdateEngineStatS()
+		e.log.Info("drop series done", zap.String("db", database), zap.Uint32s("pts", ptId),
+			zap.Duration("time used", d))
+	}(start)
+
+	cond, timeRange, err := influxql.ConditionExpr(condition, nil)
+	if err != nil {
+		atomic.AddInt64(&stat.EngineStat.DropSeriesErrs, 1)
+		return 0, err
+	}
+	tr := record.TimeRange{Min: timeRange.MinTimeNano(), Max: timeRange.MaxTimeNano()}
+
+	names := make([]string, 0, len(sources))
+	for _, source := range sources {
+		if mst, ok := source.(*influxql.Measurement); ok {
+			names = append(names, mst.Name)
+		}
+	}
+
+	e.mu.RLock()
+	if err = e.checkAndAddRefPTSNoLock(database, ptId); err != nil {
+		e.mu.RUnlock()
+		atomic.AddInt64(&stat.EngineStat.DropSeriesErrs, 1)
+		return 0, err
+	}
+	defer e.unrefDBPTs(database, ptId)
+	pts, ok := e.DBPartitions[database]
+	e.mu.RUnlock()
+	if !ok {
+		return 0, nil
+	}
+
+	total := 0
+	for _, id := range ptId {
+		pt, ok := pts[id]
+		if !ok {
+			continue
+		}
+
+		n, err := pt.dropSeries(names, cond, tr, timeRange.IsZero())
+		total += n
+		if err != nil {
+			e.log.Error("drop series fail", zap.String("db", database), zap.Uint32("pt", id), zap.Error(err))
+			atomic.AddInt64(&stat.EngineStat.DropSeriesErrs, 1)
+			return total, err
+		}
+	}
+
+	return total, nil
 }
 
 func (e *Engine) DbPTRef(db string, ptId uint32) error {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/influxdata/influxdb/logger"
 	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/bufferpool"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/interruptsignal"
@@ -566,3 +567,53 @@ func TestEngine_UpdateShardDurationInfo(t *testing.T) {
 	info := &meta.ShardDurationInfo{Ident: meta.ShardIdentifier{ShardID: 0, OwnerDb: defaultDb, OwnerPt: defaultPtId}}
 	assert2.Equal(t, nil, eng.UpdateShardDurationInfo(info))
 }
+
+func TestEngine_Backup(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows("db0", "rp0", 0, 1, rows, nil))
+
+	backupDir := backup.NodeDir(filepath.Join(dir, "backup"), 1)
+	require.NoError(t, eng.Backup(backupDir, ""))
+
+	manifest, err := backup.ReadManifest(backupDir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(manifest.Shards))
+	require.Equal(t, 1, len(manifest.Indexes))
+	require.Equal(t, uint64(1), manifest.Shards[0].ID)
+	require.Equal(t, uint64(659), manifest.Indexes[0].ID)
+
+	var tsspFiles int
+	err = filepath.Walk(filepath.Join(backupDir, manifest.Shards[0].Dir), func(p string, info os.FileInfo, err error) error {
+		if err == nil && filepath.Ext(p) == ".tssp" {
+			tsspFiles++
+		}
+		return err
+	})
+	require.NoError(t, err)
+	require.True(t, tsspFiles > 0, "no tssp file backed up")
+
+	require.Equal(t, backup.ErrBackupExists, eng.Backup(backupDir, ""))
+
+	// no rows are written since the full backup, the incremental one keeps no TSSP file
+	incrDir := backup.NodeDir(filepath.Join(dir, "incr"), 1)
+	require.NoError(t, eng.Backup(incrDir, backupDir))
+	incr, err := backup.ReadManifest(incrDir)
+	require.NoError(t, err)
+	require.Equal(t, backupDir, incr.Base)
+	require.Equal(t, len(manifest.Shards[0].Files), len(incr.Shards[0].Files))
+	for _, f := range incr.Shards[0].Files {
+		require.Equal(t, filepath.Ext(f.Name) == ".tssp", f.Base, f.Name)
+	}
+	n, err := backup.Verify(filepath.Dir(incrDir))
+	require.NoError(t, err)
+	require.True(t, n > 0)
+}
@@ -19,12 +19,13 @@ package engine
 import "fmt"
 
 var (
-	ErrDBNotFound    = fmt.Errorf("database not found")
-	ErrPTNotFound    = fmt.Errorf("partition not found")
-	ErrRPNotFound    = fmt.Errorf("rp not found")
-	ErrShardNotFound = fmt.Errorf("shard not found")
-	ErrMMNotFound    = fmt.Errorf("measurment not found")
-	ErrShardClosed   = fmt.Errorf("shard closed")
-	ErrIndexNotFound = fmt.Errorf("index not found")
-	ErrInvalidDir    = fmt.Errorf("shard or index dir not valid")
+	ErrDBNotFound       = fmt.Errorf("database not found")
+	ErrPTNotFound       = fmt.Errorf("partition not found")
+	ErrRPNotFound       = fmt.Errorf("rp not found")
+	ErrShardNotFound    = fmt.Errorf("shard not found")
+	ErrMMNotFound       = fmt.Errorf("measurment not found")
+	ErrShardClosed      = fmt.Errorf("shard closed")
+	ErrShardDownSampled = fmt.Errorf("shard is down sampled")
+	ErrIndexNotFound    = fmt.Errorf("index not found")
+	ErrInvalidDir       = fmt.Errorf("shard or index dir not valid")
 )
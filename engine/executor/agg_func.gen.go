@@ -218,6 +218,20 @@ func IntegerCountReduce(c Chunk, ordinal, start, end int) (int, int64, bool) {
 	return start, count, count == 0
 }
 
+func UnsignedCountReduce(c Chunk, ordinal, start, end int) (int, int64, bool) {
+	var count int64
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		count = int64(end - start)
+		return start, count, count == 0
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	count = int64(ve - vs)
+	return start, count, count == 0
+}
+
 func StringCountReduce(c Chunk, ordinal, start, end int) (int, int64, bool) {
 	var count int64
 	if c.Column(ordinal).NilCount() == 0 {
@@ -324,6 +338,39 @@ func IntegerSumMerge(prevPoint, currPoint *IntegerPoint) {
 	prevPoint.value += currPoint.value
 }
 
+func UnsignedSumReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	var sum uint64
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		for i := start; i < end; i++ {
+			sum += c.Column(ordinal).UnsignedValue(i)
+		}
+		return start, sum, false
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	for i := vs; i < ve; i++ {
+		sum += c.Column(ordinal).UnsignedValue(i)
+	}
+	return start, sum, false
+}
+
+func UnsignedSumMerge(prevPoint, currPoint *UnsignedPoint) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+		return
+	}
+	prevPoint.value += currPoint.value
+}
+
 func FloatMeanReduce(c Chunk, ordinal, start, end int) (int, float64, bool) {
 	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
 	if vs == ve {
@@ -444,6 +491,47 @@ func IntegerMinMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedMinReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		minValue, minIndex := c.Column(ordinal).UnsignedValue(start), start
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if v < minValue || (v == minValue && c.TimeByIndex(i) < c.TimeByIndex(minIndex)) {
+				minIndex = i
+				minValue = v
+			}
+		}
+		return minIndex, minValue, false
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	minValue, minIndex := c.Column(ordinal).UnsignedValue(vs), c.Column(ordinal).GetTimeIndex(vs)
+	for i := vs; i < ve; i++ {
+		v, index := c.Column(ordinal).UnsignedValue(i), c.Column(ordinal).GetTimeIndex(i)
+		if v < minValue || (v == minValue && c.TimeByIndex(index) < c.TimeByIndex(minIndex)) {
+			minIndex = index
+			minValue = v
+		}
+	}
+	return minIndex, minValue, false
+}
+
+func UnsignedMinMerge(prevPoint, currPoint *UnsignedPoint) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil || (currPoint.value < prevPoint.value) ||
+		(currPoint.value == prevPoint.value && currPoint.time < prevPoint.time) {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+	}
+}
+
 func BooleanMinReduce(c Chunk, ordinal, start, end int) (int, bool, bool) {
 	if c.Column(ordinal).NilCount() == 0 {
 		// fast path
@@ -567,6 +655,47 @@ func IntegerMaxMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedMaxReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		maxValue, maxIndex := c.Column(ordinal).UnsignedValue(start), start
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if v > maxValue || (v == maxValue && c.TimeByIndex(i) < c.TimeByIndex(maxIndex)) {
+				maxIndex = i
+				maxValue = v
+			}
+		}
+		return maxIndex, maxValue, false
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	maxValue, maxIndex := c.Column(ordinal).UnsignedValue(vs), c.Column(ordinal).GetTimeIndex(vs)
+	for i := vs; i < ve; i++ {
+		v, index := c.Column(ordinal).UnsignedValue(i), c.Column(ordinal).GetTimeIndex(i)
+		if v > maxValue || (v == maxValue && c.TimeByIndex(index) < c.TimeByIndex(maxIndex)) {
+			maxIndex = index
+			maxValue = v
+		}
+	}
+	return maxIndex, maxValue, false
+}
+
+func UnsignedMaxMerge(prevPoint, currPoint *UnsignedPoint) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil || (currPoint.value > prevPoint.value) ||
+		(currPoint.value == prevPoint.value && currPoint.time < prevPoint.time) {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+	}
+}
+
 func BooleanMaxReduce(c Chunk, ordinal, start, end int) (int, bool, bool) {
 	if c.Column(ordinal).NilCount() == 0 {
 		// fast path
@@ -686,6 +815,45 @@ func IntegerFirstMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedFirstReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		firstValue, firstIndex := c.Column(ordinal).UnsignedValue(start), start
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if c.TimeByIndex(i) < c.TimeByIndex(firstIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(firstIndex) && v > firstValue) {
+				firstIndex = i
+				firstValue = v
+			}
+		}
+		return firstIndex, firstValue, false
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	firstValue, firstIndex := c.Column(ordinal).UnsignedValue(vs), int(c.Column(ordinal).GetTimeIndex(vs))
+	for i := vs; i < ve; i++ {
+		v, index := c.Column(ordinal).UnsignedValue(i), int(c.Column(ordinal).GetTimeIndex(i))
+		if c.TimeByIndex(index) < c.TimeByIndex(firstIndex) ||
+			(c.TimeByIndex(index) == c.TimeByIndex(firstIndex) && v > firstValue) {
+			firstIndex = index
+			firstValue = v
+		}
+	}
+	return firstIndex, firstValue, false
+}
+
+func UnsignedFirstMerge(prevPoint, currPoint *UnsignedPoint) {
+	if prevPoint.isNil || (currPoint.time < prevPoint.time) ||
+		(currPoint.time == prevPoint.time && currPoint.value > prevPoint.value) {
+		prevPoint.Assign(currPoint)
+	}
+}
+
 func StringFirstReduce(c Chunk, ordinal, start, end int) (int, string, bool) {
 	if c.Column(ordinal).NilCount() == 0 {
 		// fast path
@@ -842,6 +1010,45 @@ func IntegerLastMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedLastReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		// fast path
+		lastValue, lastIndex := c.Column(ordinal).UnsignedValue(start), start
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if c.TimeByIndex(i) > c.TimeByIndex(lastIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(lastIndex) && v > lastValue) {
+				lastIndex = i
+				lastValue = v
+			}
+		}
+		return lastIndex, lastValue, false
+	}
+
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	lastValue, lastIndex := c.Column(ordinal).UnsignedValue(vs), c.Column(ordinal).GetTimeIndex(vs)
+	for i := vs; i < ve; i++ {
+		v, index := c.Column(ordinal).UnsignedValue(i), c.Column(ordinal).GetTimeIndex(i)
+		if c.TimeByIndex(index) > c.TimeByIndex(lastIndex) ||
+			(c.TimeByIndex(index) == c.TimeByIndex(lastIndex) && v > lastValue) {
+			lastIndex = index
+			lastValue = v
+		}
+	}
+	return lastIndex, lastValue, false
+}
+
+func UnsignedLastMerge(prevPoint, currPoint *UnsignedPoint) {
+	if prevPoint.isNil || (currPoint.time > prevPoint.time) ||
+		(currPoint.time == prevPoint.time && currPoint.value > prevPoint.value) {
+		prevPoint.Assign(currPoint)
+	}
+}
+
 func StringLastReduce(c Chunk, ordinal, start, end int) (int, string, bool) {
 	if c.Column(ordinal).NilCount() == 0 {
 		// fast path
@@ -1072,6 +1279,82 @@ func IntegerFirstTimeColMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedFirstTimeColFastReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	// fast path
+	firstValue, firstIndex := c.Column(ordinal).UnsignedValue(start), start
+	// column time is not initialized in the subquery
+	if len(c.Column(ordinal).ColumnTimes()) == 0 {
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if c.TimeByIndex(i) < c.TimeByIndex(firstIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(firstIndex) && v > firstValue) {
+				firstIndex = i
+				firstValue = v
+			}
+		}
+		return firstIndex, firstValue, false
+	}
+	// column time is initialized
+	for i := start; i < end; i++ {
+		v := c.Column(ordinal).UnsignedValue(i)
+		if c.Column(ordinal).ColumnTime(i) < c.Column(ordinal).ColumnTime(firstIndex) ||
+			(c.Column(ordinal).ColumnTime(i) == c.Column(ordinal).ColumnTime(firstIndex) && v > firstValue) {
+			firstIndex = i
+			firstValue = v
+		}
+	}
+	return firstIndex, firstValue, false
+}
+
+func UnsignedFirstTimeColSlowReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	// column time is not initialized in the subquery
+	if len(c.Column(ordinal).ColumnTimes()) == 0 {
+		firstValue, firstIndex := c.Column(ordinal).UnsignedValue(vs), c.Column(ordinal).GetTimeIndex(vs)
+		for i := start; i < end; i++ {
+			if c.Column(ordinal).IsNilV2(i) {
+				continue
+			}
+			v := c.Column(ordinal).UnsignedValue(c.Column(ordinal).GetValueIndexV2(i))
+			if c.TimeByIndex(i) < c.TimeByIndex(firstIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(firstIndex) && v > firstValue) {
+				firstIndex = i
+				firstValue = v
+			}
+		}
+		return firstIndex, firstValue, false
+	}
+	// column time is initialized
+	firstValue, firstIndex := c.Column(ordinal).UnsignedValue(vs), vs
+	for i := vs; i < ve; i++ {
+		v := c.Column(ordinal).UnsignedValue(i)
+		if c.Column(ordinal).ColumnTime(i) < c.Column(ordinal).ColumnTime(firstIndex) ||
+			(c.Column(ordinal).ColumnTime(i) == c.Column(ordinal).ColumnTime(firstIndex) && v > firstValue) {
+			firstIndex = i
+			firstValue = v
+		}
+	}
+	return firstIndex, firstValue, false
+}
+
+func UnsignedFirstTimeColReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		return UnsignedFirstTimeColFastReduce(c, ordinal, start, end)
+	}
+	return UnsignedFirstTimeColSlowReduce(c, ordinal, start, end)
+}
+
+func UnsignedFirstTimeColMerge(prevPoint, currPoint *UnsignedPoint) {
+	if prevPoint.isNil || (currPoint.time < prevPoint.time) ||
+		(currPoint.time == prevPoint.time && currPoint.value > prevPoint.value) {
+		prevPoint.Assign(currPoint)
+	}
+}
+
 func StringFirstTimeColFastReduce(c Chunk, ordinal, start, end int) (int, string, bool) {
 	// fast path
 	firstValue, firstIndex := c.Column(ordinal).StringValue(start), start
@@ -1375,6 +1658,82 @@ func IntegerLastTimeColMerge(prevPoint, currPoint *IntegerPoint) {
 	}
 }
 
+func UnsignedLastTimeColFastReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	// fast path
+	lastValue, lastIndex := c.Column(ordinal).UnsignedValue(start), start
+	// column time is not initialized in the subquery
+	if len(c.Column(ordinal).ColumnTimes()) == 0 {
+		for i := start; i < end; i++ {
+			v := c.Column(ordinal).UnsignedValue(i)
+			if c.TimeByIndex(i) > c.TimeByIndex(lastIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(lastIndex) && v > lastValue) {
+				lastIndex = i
+				lastValue = v
+			}
+		}
+		return lastIndex, lastValue, false
+	}
+	// column time is initialized
+	for i := start; i < end; i++ {
+		v := c.Column(ordinal).UnsignedValue(i)
+		if c.Column(ordinal).ColumnTime(i) > c.Column(ordinal).ColumnTime(lastIndex) ||
+			(c.Column(ordinal).ColumnTime(i) == c.Column(ordinal).ColumnTime(lastIndex) && v > lastValue) {
+			lastIndex = i
+			lastValue = v
+		}
+	}
+	return lastIndex, lastValue, false
+}
+
+func UnsignedLastTimeColSlowReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	// slow path
+	vs, ve := c.Column(ordinal).GetRangeValueIndexV2(start, end)
+	if vs == ve {
+		return start, 0, true
+	}
+	// column time is not initialized in the subquery
+	if len(c.Column(ordinal).ColumnTimes()) == 0 {
+		lastValue, lastIndex := c.Column(ordinal).UnsignedValue(vs), c.Column(ordinal).GetTimeIndex(vs)
+		for i := start; i < end; i++ {
+			if c.Column(ordinal).IsNilV2(i) {
+				continue
+			}
+			v := c.Column(ordinal).UnsignedValue(c.Column(ordinal).GetValueIndexV2(i))
+			if c.TimeByIndex(i) > c.TimeByIndex(lastIndex) ||
+				(c.TimeByIndex(i) == c.TimeByIndex(lastIndex) && v > lastValue) {
+				lastIndex = i
+				lastValue = v
+			}
+		}
+		return lastIndex, lastValue, false
+	}
+	// column time is initialized
+	lastValue, lastIndex := c.Column(ordinal).UnsignedValue(vs), vs
+	for i := vs; i < ve; i++ {
+		v := c.Column(ordinal).UnsignedValue(i)
+		if c.Column(ordinal).ColumnTime(i) > c.Column(ordinal).ColumnTime(lastIndex) ||
+			(c.Column(ordinal).ColumnTime(i) == c.Column(ordinal).ColumnTime(lastIndex) && v > lastValue) {
+			lastIndex = i
+			lastValue = v
+		}
+	}
+	return lastIndex, lastValue, false
+}
+
+func UnsignedLastTimeColReduce(c Chunk, ordinal, start, end int) (int, uint64, bool) {
+	if c.Column(ordinal).NilCount() == 0 {
+		return UnsignedLastTimeColFastReduce(c, ordinal, start, end)
+	}
+	return UnsignedLastTimeColSlowReduce(c, ordinal, start, end)
+}
+
+func UnsignedLastTimeColMerge(prevPoint, currPoint *UnsignedPoint) {
+	if prevPoint.isNil || (currPoint.time > prevPoint.time) ||
+		(currPoint.time == prevPoint.time && currPoint.value > prevPoint.value) {
+		prevPoint.Assign(currPoint)
+	}
+}
+
 func StringLastTimeColFastReduce(c Chunk, ordinal, start, end int) (int, string, bool) {
 	// fast path
 	lastValue, lastIndex := c.Column(ordinal).StringValue(start), start
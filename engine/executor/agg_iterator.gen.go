@@ -86,6 +86,34 @@ func (p *IntegerPoint) Assign(c *IntegerPoint) {
 	p.value = c.value
 }
 
+type UnsignedPoint struct {
+	time  int64
+	value uint64
+	index int
+	isNil bool
+}
+
+func newUnsignedPoint() *UnsignedPoint {
+	return &UnsignedPoint{isNil: true}
+}
+
+func (p *UnsignedPoint) Set(index int, time int64, value uint64) {
+	p.index = index
+	p.time = time
+	p.value = value
+	p.isNil = false
+}
+
+func (p *UnsignedPoint) Reset() {
+	p.isNil = true
+}
+
+func (p *UnsignedPoint) Assign(c *UnsignedPoint) {
+	p.index = c.index
+	p.time = c.time
+	p.value = c.value
+}
+
 type BooleanPoint struct {
 	time  int64
 	value bool
@@ -845,6 +873,350 @@ func (r *IntegerColIntegerIterator) Next(ie *IteratorEndpoint, p *IteratorParams
 	}
 }
 
+type UnsignedColIntegerReduce func(c Chunk, ordinal, start, end int) (index int, value int64, isNil bool)
+
+type UnsignedColIntegerMerge func(prevPoint, currPoint *IntegerPoint)
+
+type UnsignedColIntegerIterator struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	prevPoint    *IntegerPoint
+	currPoint    *IntegerPoint
+	fn           UnsignedColIntegerReduce
+	fv           UnsignedColIntegerMerge
+	auxChunk     Chunk
+	auxProcessor []*AuxProcessor
+}
+
+func NewUnsignedColIntegerIterator(fn UnsignedColIntegerReduce, fv UnsignedColIntegerMerge,
+	isSingleCall bool, inOrdinal, outOrdinal int, auxProcessor []*AuxProcessor, rowDataType hybridqp.RowDataType,
+) *UnsignedColIntegerIterator {
+	r := &UnsignedColIntegerIterator{
+		fn:           fn,
+		fv:           fv,
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		prevPoint:    newIntegerPoint(),
+		currPoint:    newIntegerPoint(),
+	}
+	if isSingleCall && len(auxProcessor) > 0 {
+		r.auxProcessor = auxProcessor
+		r.auxChunk = NewChunkBuilder(rowDataType).NewChunk("")
+	}
+	return r
+}
+
+func (r *UnsignedColIntegerIterator) appendInAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].inOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedColIntegerIterator) appendOutAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].outOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedColIntegerIterator) mergePrevItem(
+	inChunk, outChunk Chunk,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(r.prevPoint.time)
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+	outChunk.Column(r.outOrdinal).AppendIntegerValues(r.prevPoint.value)
+	if r.auxProcessor != nil {
+		if r.prevPoint.index == 0 {
+			r.appendOutAuxCol(r.auxChunk, outChunk, r.prevPoint.index)
+		} else {
+			r.appendInAuxCol(inChunk, outChunk, r.prevPoint.index-1)
+		}
+		r.auxChunk.Reset()
+	}
+}
+
+func (r *UnsignedColIntegerIterator) processFirstWindow(
+	inChunk, outChunk Chunk, isNil, sameInterval, onlyOneInterval bool, index int, value int64,
+) {
+	// To distinguish values between inChunk and auxChunk, r.currPoint.index incremented by 1.
+	if !isNil {
+		r.currPoint.Set(index+1, inChunk.TimeByIndex(index), value)
+		r.fv(r.prevPoint, r.currPoint)
+	}
+	if onlyOneInterval && sameInterval {
+		if r.auxProcessor != nil && r.prevPoint.index > 0 {
+			r.auxChunk.Reset()
+			r.auxChunk.AppendTime(inChunk.TimeByIndex(r.prevPoint.index - 1))
+			r.appendInAuxCol(inChunk, r.auxChunk, r.prevPoint.index-1)
+		}
+		r.prevPoint.index = 0
+	} else {
+		if !r.prevPoint.isNil {
+			r.mergePrevItem(inChunk, outChunk)
+		}
+		r.prevPoint.Reset()
+	}
+	r.currPoint.Reset()
+}
+
+func (r *UnsignedColIntegerIterator) processLastWindow(
+	inChunk Chunk, index int, isNil bool, value int64,
+) {
+	if isNil {
+		r.prevPoint.Reset()
+	} else {
+		r.prevPoint.Set(0, inChunk.TimeByIndex(index), value)
+	}
+	if r.auxProcessor != nil {
+		r.auxChunk.AppendTime(inChunk.TimeByIndex(index))
+		r.appendInAuxCol(inChunk, r.auxChunk, index)
+	}
+}
+
+func (r *UnsignedColIntegerIterator) processMiddleWindow(
+	inChunk, outChunk Chunk, index int, value int64,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(inChunk.TimeByIndex(index))
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+	outChunk.Column(r.outOrdinal).AppendIntegerValues(value)
+	if r.auxProcessor != nil {
+		r.appendInAuxCol(inChunk, outChunk, index)
+	}
+}
+
+func (r *UnsignedColIntegerIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	if inChunk.Column(r.inOrdinal).IsEmpty() && r.prevPoint.isNil {
+		var addIntervalLen int
+		if p.sameInterval {
+			addIntervalLen = inChunk.IntervalLen() - 1
+		} else {
+			addIntervalLen = inChunk.IntervalLen()
+		}
+		if addIntervalLen > 0 {
+			outChunk.Column(r.outOrdinal).AppendManyNil(addIntervalLen)
+		}
+		return
+	}
+
+	var end int
+	firstIndex, lastIndex := 0, len(inChunk.IntervalIndex())-1
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		index, value, isNil := r.fn(inChunk, r.inOrdinal, start, end)
+		if isNil && ((i > firstIndex && i < lastIndex) ||
+			(firstIndex == lastIndex && r.prevPoint.isNil && !p.sameInterval) ||
+			(firstIndex != lastIndex && i == firstIndex && r.prevPoint.isNil) ||
+			(firstIndex != lastIndex && i == lastIndex && !p.sameInterval)) {
+			outChunk.Column(r.outOrdinal).AppendNil()
+			continue
+		}
+		if i == firstIndex && !r.prevPoint.isNil {
+			r.processFirstWindow(inChunk, outChunk, isNil, p.sameInterval,
+				firstIndex == lastIndex, index, value)
+		} else if i == lastIndex && p.sameInterval {
+			r.processLastWindow(inChunk, index, isNil, value)
+		} else if !isNil {
+			r.processMiddleWindow(inChunk, outChunk, index, value)
+		}
+	}
+}
+
+type UnsignedColUnsignedReduce func(c Chunk, ordinal, start, end int) (index int, value uint64, isNil bool)
+
+type UnsignedColUnsignedMerge func(prevPoint, currPoint *UnsignedPoint)
+
+type UnsignedColUnsignedIterator struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	prevPoint    *UnsignedPoint
+	currPoint    *UnsignedPoint
+	fn           UnsignedColUnsignedReduce
+	fv           UnsignedColUnsignedMerge
+	auxChunk     Chunk
+	auxProcessor []*AuxProcessor
+}
+
+func NewUnsignedColUnsignedIterator(fn UnsignedColUnsignedReduce, fv UnsignedColUnsignedMerge,
+	isSingleCall bool, inOrdinal, outOrdinal int, auxProcessor []*AuxProcessor, rowDataType hybridqp.RowDataType,
+) *UnsignedColUnsignedIterator {
+	r := &UnsignedColUnsignedIterator{
+		fn:           fn,
+		fv:           fv,
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		prevPoint:    newUnsignedPoint(),
+		currPoint:    newUnsignedPoint(),
+	}
+	if isSingleCall && len(auxProcessor) > 0 {
+		r.auxProcessor = auxProcessor
+		r.auxChunk = NewChunkBuilder(rowDataType).NewChunk("")
+	}
+	return r
+}
+
+func (r *UnsignedColUnsignedIterator) appendInAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].inOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedColUnsignedIterator) appendOutAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].outOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedColUnsignedIterator) mergePrevItem(
+	inChunk, outChunk Chunk,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(r.prevPoint.time)
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+	outChunk.Column(r.outOrdinal).AppendUnsignedValues(r.prevPoint.value)
+	if r.auxProcessor != nil {
+		if r.prevPoint.index == 0 {
+			r.appendOutAuxCol(r.auxChunk, outChunk, r.prevPoint.index)
+		} else {
+			r.appendInAuxCol(inChunk, outChunk, r.prevPoint.index-1)
+		}
+		r.auxChunk.Reset()
+	}
+}
+
+func (r *UnsignedColUnsignedIterator) processFirstWindow(
+	inChunk, outChunk Chunk, isNil, sameInterval, onlyOneInterval bool, index int, value uint64,
+) {
+	// To distinguish values between inChunk and auxChunk, r.currPoint.index incremented by 1.
+	if !isNil {
+		r.currPoint.Set(index+1, inChunk.TimeByIndex(index), value)
+		r.fv(r.prevPoint, r.currPoint)
+	}
+	if onlyOneInterval && sameInterval {
+		if r.auxProcessor != nil && r.prevPoint.index > 0 {
+			r.auxChunk.Reset()
+			r.auxChunk.AppendTime(inChunk.TimeByIndex(r.prevPoint.index - 1))
+			r.appendInAuxCol(inChunk, r.auxChunk, r.prevPoint.index-1)
+		}
+		r.prevPoint.index = 0
+	} else {
+		if !r.prevPoint.isNil {
+			r.mergePrevItem(inChunk, outChunk)
+		}
+		r.prevPoint.Reset()
+	}
+	r.currPoint.Reset()
+}
+
+func (r *UnsignedColUnsignedIterator) processLastWindow(
+	inChunk Chunk, index int, isNil bool, value uint64,
+) {
+	if isNil {
+		r.prevPoint.Reset()
+	} else {
+		r.prevPoint.Set(0, inChunk.TimeByIndex(index), value)
+	}
+	if r.auxProcessor != nil {
+		r.auxChunk.AppendTime(inChunk.TimeByIndex(index))
+		r.appendInAuxCol(inChunk, r.auxChunk, index)
+	}
+}
+
+func (r *UnsignedColUnsignedIterator) processMiddleWindow(
+	inChunk, outChunk Chunk, index int, value uint64,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(inChunk.TimeByIndex(index))
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+	outChunk.Column(r.outOrdinal).AppendUnsignedValues(value)
+	if r.auxProcessor != nil {
+		r.appendInAuxCol(inChunk, outChunk, index)
+	}
+}
+
+func (r *UnsignedColUnsignedIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	if inChunk.Column(r.inOrdinal).IsEmpty() && r.prevPoint.isNil {
+		var addIntervalLen int
+		if p.sameInterval {
+			addIntervalLen = inChunk.IntervalLen() - 1
+		} else {
+			addIntervalLen = inChunk.IntervalLen()
+		}
+		if addIntervalLen > 0 {
+			outChunk.Column(r.outOrdinal).AppendManyNil(addIntervalLen)
+		}
+		return
+	}
+
+	var end int
+	firstIndex, lastIndex := 0, len(inChunk.IntervalIndex())-1
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		index, value, isNil := r.fn(inChunk, r.inOrdinal, start, end)
+		if isNil && ((i > firstIndex && i < lastIndex) ||
+			(firstIndex == lastIndex && r.prevPoint.isNil && !p.sameInterval) ||
+			(firstIndex != lastIndex && i == firstIndex && r.prevPoint.isNil) ||
+			(firstIndex != lastIndex && i == lastIndex && !p.sameInterval)) {
+			outChunk.Column(r.outOrdinal).AppendNil()
+			continue
+		}
+		if i == firstIndex && !r.prevPoint.isNil {
+			r.processFirstWindow(inChunk, outChunk, isNil, p.sameInterval,
+				firstIndex == lastIndex, index, value)
+		} else if i == lastIndex && p.sameInterval {
+			r.processLastWindow(inChunk, index, isNil, value)
+		} else if !isNil {
+			r.processMiddleWindow(inChunk, outChunk, index, value)
+		}
+	}
+}
+
 type StringColIntegerReduce func(c Chunk, ordinal, start, end int) (index int, value int64, isNil bool)
 
 type StringColIntegerMerge func(prevPoint, currPoint *IntegerPoint)
@@ -1789,6 +2161,134 @@ func (r *IntegerTimeColIntegerIterator) Next(ie *IteratorEndpoint, p *IteratorPa
 	}
 }
 
+type UnsignedTimeColUnsignedReduce func(c Chunk, ordinal, start, end int) (index int, value uint64, isNil bool)
+
+type UnsignedTimeColUnsignedMerge func(prevPoint, currPoint *UnsignedPoint)
+
+type UnsignedTimeColUnsignedIterator struct {
+	initTimeCol bool
+	inOrdinal   int
+	outOrdinal  int
+	prevPoint   *UnsignedPoint
+	currPoint   *UnsignedPoint
+	fn          UnsignedTimeColUnsignedReduce
+	fv          UnsignedTimeColUnsignedMerge
+}
+
+func NewUnsignedTimeColUnsignedIterator(
+	fn UnsignedTimeColUnsignedReduce, fv UnsignedTimeColUnsignedMerge, inOrdinal, outOrdinal int,
+) *UnsignedTimeColUnsignedIterator {
+	r := &UnsignedTimeColUnsignedIterator{
+		fn:         fn,
+		fv:         fv,
+		inOrdinal:  inOrdinal,
+		outOrdinal: outOrdinal,
+		prevPoint:  newUnsignedPoint(),
+		currPoint:  newUnsignedPoint(),
+	}
+	return r
+}
+
+func (r *UnsignedTimeColUnsignedIterator) mergePrevItem(
+	outChunk Chunk,
+) {
+	outChunk.Column(r.outOrdinal).AppendUnsignedValues(r.prevPoint.value)
+	outChunk.Column(r.outOrdinal).AppendColumnTimes(r.prevPoint.time)
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processFirstWindow(
+	inChunk, outChunk Chunk, isNil, sameInterval, onlyOneInterval bool, index int, value uint64,
+) {
+	// To distinguish values between inChunk and auxChunk, r.currPoint.index incremented by 1.
+	if !isNil {
+		if r.initTimeCol {
+			r.currPoint.Set(index+1, inChunk.Column(r.inOrdinal).ColumnTime(index), value)
+		} else {
+			r.currPoint.Set(index+1, inChunk.TimeByIndex(index), value)
+		}
+		r.fv(r.prevPoint, r.currPoint)
+	}
+	if onlyOneInterval && sameInterval {
+		r.prevPoint.index = 0
+	} else {
+		if !r.prevPoint.isNil {
+			r.mergePrevItem(outChunk)
+		}
+		r.prevPoint.Reset()
+	}
+	r.currPoint.Reset()
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processLastWindow(
+	inChunk Chunk, index int, isNil bool, value uint64,
+) {
+	if isNil {
+		r.prevPoint.Reset()
+		return
+	}
+	if r.initTimeCol {
+		r.prevPoint.Set(0, inChunk.Column(r.inOrdinal).ColumnTime(index), value)
+	} else {
+		r.prevPoint.Set(0, inChunk.TimeByIndex(index), value)
+	}
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processMiddleWindow(
+	inChunk, outChunk Chunk, index int, value uint64,
+) {
+	if r.initTimeCol {
+		outChunk.Column(r.outOrdinal).AppendColumnTimes(inChunk.Column(r.inOrdinal).ColumnTime(index))
+	} else {
+		outChunk.Column(r.outOrdinal).AppendColumnTimes(inChunk.TimeByIndex(index))
+	}
+	outChunk.Column(r.outOrdinal).AppendUnsignedValues(value)
+	outChunk.Column(r.outOrdinal).AppendNilsV2(true)
+}
+
+func (r *UnsignedTimeColUnsignedIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	if inChunk.Column(r.inOrdinal).IsEmpty() && r.prevPoint.isNil {
+		var addIntervalLen int
+		if p.sameInterval {
+			addIntervalLen = inChunk.IntervalLen() - 1
+		} else {
+			addIntervalLen = inChunk.IntervalLen()
+		}
+		if addIntervalLen > 0 {
+			outChunk.Column(r.outOrdinal).AppendManyNil(addIntervalLen)
+		}
+		return
+	}
+
+	var end int
+	r.initTimeCol = len(inChunk.Column(r.inOrdinal).ColumnTimes()) > 0
+	firstIndex, lastIndex := 0, len(inChunk.IntervalIndex())-1
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		index, value, isNil := r.fn(inChunk, r.inOrdinal, start, end)
+		if isNil && ((i > firstIndex && i < lastIndex) ||
+			(firstIndex == lastIndex && r.prevPoint.isNil && !p.sameInterval) ||
+			(firstIndex != lastIndex && i == firstIndex && r.prevPoint.isNil) ||
+			(firstIndex != lastIndex && i == lastIndex && !p.sameInterval)) {
+			outChunk.Column(r.outOrdinal).AppendNil()
+			continue
+		}
+		if i == firstIndex && !r.prevPoint.isNil {
+			r.processFirstWindow(inChunk, outChunk, isNil, p.sameInterval,
+				firstIndex == lastIndex, index, value)
+		} else if i == lastIndex && p.sameInterval {
+			r.processLastWindow(inChunk, index, isNil, value)
+		} else if !isNil {
+			r.processMiddleWindow(inChunk, outChunk, index, value)
+		}
+	}
+}
+
 type StringTimeColStringReduce func(c Chunk, ordinal, start, end int) (index int, value string, isNil bool)
 
 type StringTimeColStringMerge func(prevPoint, currPoint *StringPoint)
@@ -538,6 +538,87 @@ func TestStreamAggregateTransformMedian(t *testing.T) {
 	)
 }
 
+func buildUnsignedInChunk() []executor.Chunk {
+	b := executor.NewChunkBuilder(hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "value1", Type: influxql.Unsigned},
+	))
+
+	inCk := b.NewChunk("mst")
+	inCk.AppendTagsAndIndexes([]executor.ChunkTags{
+		*ParseChunkTags("name=aaa"), *ParseChunkTags("name=bbb"),
+	}, []int{0, 3})
+	inCk.AppendIntervalIndex([]int{0, 3}...)
+	inCk.AppendTime([]int64{1, 2, 3, 5, 6}...)
+	inCk.Column(0).AppendUnsignedValues([]uint64{5, 1<<63 + 1, 3, 4}...)
+	inCk.Column(0).AppendNilsV2(true, false, true, true, true)
+
+	return []executor.Chunk{inCk}
+}
+
+func buildDstRowDataTypeUnsigned() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "count(\"value1\")", Type: influxql.Integer},
+		influxql.VarRef{Val: "sum(\"value1\")", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "min(\"value1\")", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "max(\"value1\")", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "first(\"value1\")", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "last(\"value1\")", Type: influxql.Unsigned},
+	)
+}
+
+func buildDstChunkUnsigned() []executor.Chunk {
+	b := executor.NewChunkBuilder(buildDstRowDataTypeUnsigned())
+
+	chunk := b.NewChunk("mst")
+	chunk.AppendTagsAndIndexes([]executor.ChunkTags{
+		*ParseChunkTags("name=aaa"), *ParseChunkTags("name=bbb"),
+	}, []int{0, 1})
+	chunk.AppendIntervalIndex([]int{0, 1}...)
+	chunk.AppendTime([]int64{1, 5}...)
+	chunk.Column(0).AppendIntegerValues([]int64{2, 2}...)
+	chunk.Column(0).AppendManyNotNil(2)
+	chunk.Column(1).AppendUnsignedValues([]uint64{1<<63 + 6, 7}...)
+	chunk.Column(1).AppendManyNotNil(2)
+	chunk.Column(2).AppendUnsignedValues([]uint64{5, 3}...)
+	chunk.Column(2).AppendManyNotNil(2)
+	chunk.Column(3).AppendUnsignedValues([]uint64{1<<63 + 1, 4}...)
+	chunk.Column(3).AppendManyNotNil(2)
+	chunk.Column(4).AppendUnsignedValues([]uint64{5, 3}...)
+	chunk.Column(4).AppendColumnTimes([]int64{1, 5}...)
+	chunk.Column(4).AppendManyNotNil(2)
+	chunk.Column(5).AppendUnsignedValues([]uint64{1<<63 + 1, 4}...)
+	chunk.Column(5).AppendColumnTimes([]int64{3, 6}...)
+	chunk.Column(5).AppendManyNotNil(2)
+
+	return []executor.Chunk{chunk}
+}
+
+func TestStreamAggregateTransformUnsigned(t *testing.T) {
+	var exprOpt []hybridqp.ExprOptions
+	var exprs []influxql.Expr
+	for _, ref := range buildDstRowDataTypeUnsigned().Fields() {
+		call := hybridqp.MustParseExpr(ref.Name()).(*influxql.Call)
+		exprOpt = append(exprOpt, hybridqp.ExprOptions{Expr: call, Ref: *ref.Expr.(*influxql.VarRef)})
+		exprs = append(exprs, call)
+	}
+
+	opt := query.ProcessorOptions{
+		Exprs:      exprs,
+		Dimensions: []string{"name"},
+		Interval:   hybridqp.Interval{Duration: 4 * time.Nanosecond},
+		Ordered:    true,
+		Ascending:  true,
+		ChunkSize:  10,
+	}
+
+	testStreamAggregateTransformBase(
+		t,
+		buildUnsignedInChunk(), buildDstChunkUnsigned(),
+		hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value1", Type: influxql.Unsigned}), buildDstRowDataTypeUnsigned(),
+		exprOpt, opt,
+	)
+}
+
 func buildSourceRowDataType() hybridqp.RowDataType {
 	rowDataType := hybridqp.NewRowDataTypeImpl(
 		influxql.VarRef{Val: "value1", Type: influxql.Integer},
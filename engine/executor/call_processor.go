@@ -306,6 +306,11 @@ func NewCountRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 			NewIntegerColIntegerIterator(IntegerCountReduce, IntegerCountMerge, isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(
+			NewUnsignedColIntegerIterator(UnsignedCountReduce, IntegerCountMerge, isSingleCall, inOrdinal, outOrdinal,
+				nil, nil),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(
 			NewFloatColIntegerIterator(FloatCountReduce, IntegerCountMerge, isSingleCall, inOrdinal, outOrdinal,
@@ -339,6 +344,11 @@ func NewSumRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 			NewIntegerColIntegerIterator(IntegerSumReduce, IntegerSumMerge, isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(
+			NewUnsignedColUnsignedIterator(UnsignedSumReduce, UnsignedSumMerge, isSingleCall, inOrdinal, outOrdinal,
+				nil, nil),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(
 			NewFloatColFloatIterator(FloatSumReduce, FloatSumMerge, isSingleCall, inOrdinal, outOrdinal,
@@ -365,6 +375,15 @@ func NewFirstRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 		return NewRoutineImpl(NewIntegerTimeColIntegerIterator(IntegerFirstTimeColReduce, IntegerFirstTimeColMerge,
 			inOrdinal, outOrdinal),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		if isSingleCall {
+			return NewRoutineImpl(NewUnsignedColUnsignedIterator(UnsignedFirstReduce, UnsignedFirstMerge,
+				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+				inOrdinal, outOrdinal), nil
+		}
+		return NewRoutineImpl(NewUnsignedTimeColUnsignedIterator(UnsignedFirstTimeColReduce, UnsignedFirstTimeColMerge,
+			inOrdinal, outOrdinal),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		if isSingleCall {
 			return NewRoutineImpl(NewFloatColFloatIterator(FloatFirstReduce, FloatFirstMerge,
@@ -414,6 +433,15 @@ func NewLastRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 		return NewRoutineImpl(NewIntegerTimeColIntegerIterator(IntegerLastTimeColReduce, IntegerLastTimeColMerge,
 			inOrdinal, outOrdinal),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		if isSingleCall {
+			return NewRoutineImpl(NewUnsignedColUnsignedIterator(UnsignedLastReduce, UnsignedLastMerge,
+				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+				inOrdinal, outOrdinal), nil
+		}
+		return NewRoutineImpl(NewUnsignedTimeColUnsignedIterator(UnsignedLastTimeColReduce, UnsignedLastTimeColMerge,
+			inOrdinal, outOrdinal),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		if isSingleCall {
 			return NewRoutineImpl(NewFloatColFloatIterator(FloatLastReduce, FloatLastMerge,
@@ -458,6 +486,10 @@ func NewMinRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 		return NewRoutineImpl(NewIntegerColIntegerIterator(IntegerMinReduce, IntegerMinMerge,
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewUnsignedColUnsignedIterator(UnsignedMinReduce, UnsignedMinMerge,
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatColFloatIterator(FloatMinReduce, FloatMinMerge,
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -483,6 +515,10 @@ func NewMaxRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 		return NewRoutineImpl(NewIntegerColIntegerIterator(IntegerMaxReduce, IntegerMaxMerge,
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewUnsignedColUnsignedIterator(UnsignedMaxReduce, UnsignedMaxMerge,
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatColFloatIterator(FloatMaxReduce, FloatMaxMerge,
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -924,6 +960,12 @@ func NewAuxCoProcessor(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 			outOrdinal:    outOrdinal,
 			auxHelperFunc: IntegerAuxHelpFunc,
 		}
+	case influxql.Unsigned:
+		return &AuxProcessor{
+			inOrdinal:     inOrdinal,
+			outOrdinal:    outOrdinal,
+			auxHelperFunc: UnsignedAuxHelpFunc,
+		}
 	case influxql.Float:
 		return &AuxProcessor{
 			inOrdinal:     inOrdinal,
@@ -964,6 +1006,17 @@ func IntegerAuxHelpFunc(input, output Column, rowIdx ...int) {
 	}
 }
 
+func UnsignedAuxHelpFunc(input, output Column, rowIdx ...int) {
+	for _, idx := range rowIdx {
+		if !input.IsNilV2(idx) {
+			output.AppendUnsignedValues(input.UnsignedValue(input.GetValueIndexV2(idx)))
+			output.AppendNilsV2(true)
+		} else {
+			output.AppendNil()
+		}
+	}
+}
+
 func FloatAuxHelpFunc(input, output Column, rowIdx ...int) {
 	for _, idx := range rowIdx {
 		if !input.IsNilV2(idx) {
@@ -30,7 +30,7 @@ import (
 	"github.com/openGemini/openGemini/open_src/influx/query"
 )
 
-//go:generate tmpl -data=@./tmpldata column.gen.go.tmpl
+//go:generate tmpl -data=@./column_tmpldata column.gen.go.tmpl
 
 func init() {
 	initColumnTypeFunc()
@@ -80,6 +80,27 @@ func initIntegerColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []inte
 	return dst
 }
 
+func initUnsignedColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
+	// fast path
+	if col.NilCount() == 0 {
+		values := col.UnsignedValues()[bmStart:bmEnd]
+		for _, v := range values {
+			dst = append(dst, v)
+		}
+		return dst
+	}
+
+	// slow path
+	for j := bmStart; j < bmEnd; j++ {
+		if col.IsNilV2(j) {
+			dst = append(dst, nil)
+		} else {
+			dst = append(dst, col.UnsignedValue(col.GetValueIndexV2(j)))
+		}
+	}
+	return dst
+}
+
 func initBooleanColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
 	// fast path
 	if col.NilCount() == 0 {
@@ -154,12 +175,14 @@ func initTagColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interfac
 }
 
 func initColumnTypeFunc() {
-	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 5)
+	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 6)
 
 	GetColValsFn[influxql.Float] = initFloatColumnFunc
 
 	GetColValsFn[influxql.Integer] = initIntegerColumnFunc
 
+	GetColValsFn[influxql.Unsigned] = initUnsignedColumnFunc
+
 	GetColValsFn[influxql.Boolean] = initBooleanColumnFunc
 
 	GetColValsFn[influxql.String] = initStringColumnFunc
@@ -413,6 +436,8 @@ func (c *ChunkImpl) Clone() Chunk {
 		switch dataType {
 		case influxql.Integer:
 			clone.Column(i).AppendIntegerValues(c.Column(i).IntegerValues()...)
+		case influxql.Unsigned:
+			clone.Column(i).AppendUnsignedValues(c.Column(i).UnsignedValues()...)
 		case influxql.Float:
 			clone.Column(i).AppendFloatValues(c.Column(i).FloatValues()...)
 		case influxql.Boolean:
@@ -495,6 +520,8 @@ func (c *ChunkImpl) String() string {
 			switch c.Column(i).DataType() {
 			case influxql.Integer:
 				line = append(line, strconv.FormatInt(c.Column(i).IntegerValue(l), 10))
+			case influxql.Unsigned:
+				line = append(line, strconv.FormatUint(c.Column(i).UnsignedValue(l), 10))
 			case influxql.Float:
 				line = append(line, strconv.FormatFloat(c.Column(i).FloatValue(l), 'f', -1, 64))
 			case influxql.Boolean:
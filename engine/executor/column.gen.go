@@ -67,6 +67,11 @@ type Column interface {
 	AppendIntegerValues(...int64)
 	SetIntegerValues([]int64)
 
+	UnsignedValue(int) uint64
+	UnsignedValues() []uint64
+	AppendUnsignedValues(...uint64)
+	SetUnsignedValues([]uint64)
+
 	StringValue(int) string
 	StringValuesV2(dst []string) []string
 	StringValuesRange(dst []string, start, end int) []string
@@ -93,14 +98,15 @@ type Column interface {
 }
 
 type ColumnImpl struct {
-	dataType      influxql.DataType
-	floatValues   []float64
-	integerValues []int64
-	stringBytes   []byte
-	offset        []uint32
-	booleanValues []bool
-	times         []int64
-	nilsV2        *Bitmap
+	dataType       influxql.DataType
+	floatValues    []float64
+	integerValues  []int64
+	unsignedValues []uint64
+	stringBytes    []byte
+	offset         []uint32
+	booleanValues  []bool
+	times          []int64
+	nilsV2         *Bitmap
 }
 
 func NewColumnImpl(dataType influxql.DataType) *ColumnImpl {
@@ -216,6 +222,7 @@ func (c *ColumnImpl) GetTimeIndex(valIdx int) int {
 func (c *ColumnImpl) Reset() {
 	c.floatValues = c.floatValues[:0]
 	c.integerValues = c.integerValues[:0]
+	c.unsignedValues = c.unsignedValues[:0]
 	c.stringBytes = c.stringBytes[:0]
 	c.offset = c.offset[:0]
 	c.booleanValues = c.booleanValues[:0]
@@ -255,6 +262,22 @@ func (c *ColumnImpl) SetIntegerValues(values []int64) {
 	c.integerValues = values
 }
 
+func (c *ColumnImpl) UnsignedValue(idx int) uint64 {
+	return c.unsignedValues[idx]
+}
+
+func (c *ColumnImpl) UnsignedValues() []uint64 {
+	return c.unsignedValues
+}
+
+func (c *ColumnImpl) AppendUnsignedValues(values ...uint64) {
+	c.unsignedValues = append(c.unsignedValues, values...)
+}
+
+func (c *ColumnImpl) SetUnsignedValues(values []uint64) {
+	c.unsignedValues = values
+}
+
 // String type
 
 func (c *ColumnImpl) StringValue(idx int) string {
@@ -381,28 +404,35 @@ func (c *ColumnImpl) SetBooleanValues(values []bool) {
 func (c *ColumnImpl) CheckColumn(length int) {
 	switch c.dataType {
 	case influxql.String, influxql.Tag:
-		if len(c.integerValues) != 0 || len(c.floatValues) != 0 || len(c.booleanValues) != 0 {
+		if len(c.integerValues) != 0 || len(c.floatValues) != 0 || len(c.booleanValues) != 0 || len(c.unsignedValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be string!")
 		}
 		if c.NilCount()+len(c.offset) != length {
 			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
 		}
 	case influxql.Float:
-		if len(c.integerValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 {
+		if len(c.integerValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 || len(c.unsignedValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be float64!")
 		}
 		if c.NilCount()+len(c.floatValues) != length {
 			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
 		}
 	case influxql.Integer:
-		if len(c.floatValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 {
+		if len(c.floatValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 || len(c.unsignedValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be int64!")
 		}
 		if c.NilCount()+len(c.integerValues) != length {
 			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
 		}
+	case influxql.Unsigned:
+		if len(c.floatValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 || len(c.integerValues) != 0 {
+			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be uint64!")
+		}
+		if c.NilCount()+len(c.unsignedValues) != length {
+			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
+		}
 	case influxql.Boolean:
-		if len(c.integerValues) != 0 || len(c.stringBytes) != 0 || len(c.floatValues) != 0 {
+		if len(c.integerValues) != 0 || len(c.stringBytes) != 0 || len(c.floatValues) != 0 || len(c.unsignedValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be boolean!")
 		}
 		if c.NilCount()+len(c.booleanValues) != length {
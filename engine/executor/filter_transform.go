@@ -228,6 +228,14 @@ func (trans *FilterTransform) initValueFunc() {
 				}
 				return col.IntegerValue(startValue)
 			}
+		case influxql.Unsigned:
+			trans.valueFunc[i] = func(i int, col Column) interface{} {
+				startValue, endValue := col.GetRangeValueIndexV2(i, i+1)
+				if startValue == endValue {
+					return nil
+				}
+				return col.UnsignedValue(startValue)
+			}
 		case influxql.Float:
 			trans.valueFunc[i] = func(i int, col Column) interface{} {
 				startValue, endValue := col.GetRangeValueIndexV2(i, i+1)
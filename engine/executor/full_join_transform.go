@@ -0,0 +1,399 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+const (
+	joinLeft = iota
+	joinRight
+)
+
+// joinRow is a row of an input chunk of the join.
+type joinRow struct {
+	chunk Chunk
+	index int
+}
+
+func (r joinRow) time() int64 {
+	return r.chunk.TimeByIndex(r.index)
+}
+
+// FullJoinTransform joins the rows of its inputs by the value of the join tags and the time.
+// All rows of both inputs are hashed by the join tag, then the rows of each tag value are
+// merged in time order, a row without a row of the other side at the same time is joined with nulls.
+type FullJoinTransform struct {
+	BaseProcessor
+
+	Inputs  ChunkPorts
+	Outputs ChunkPorts
+
+	sides   []int
+	keys    [2]string
+	rts     [2]hybridqp.RowDataType
+	names   [2]string
+	rows    [2]map[string][]joinRow
+	opt     query.ProcessorOptions
+	builder *ChunkBuilder
+
+	chunk   Chunk
+	tags    ChunkTags
+	hasTags bool
+
+	workTracing *tracing.Span
+}
+
+// NewFullJoinTransform creates a join of the inputs, sides gives the side of the join of each input.
+func NewFullJoinTransform(inRowDataTypes []hybridqp.RowDataType, sides []int, outRowDataType hybridqp.RowDataType,
+	sideRowDataTypes [2]hybridqp.RowDataType, keys [2]string, opt query.ProcessorOptions) *FullJoinTransform {
+	trans := &FullJoinTransform{
+		Inputs:  make(ChunkPorts, 0, len(inRowDataTypes)),
+		Outputs: make(ChunkPorts, 0, 1),
+		sides:   sides,
+		keys:    keys,
+		rts:     sideRowDataTypes,
+		opt:     opt,
+		builder: NewChunkBuilder(outRowDataType),
+	}
+
+	for _, rt := range inRowDataTypes {
+		trans.Inputs = append(trans.Inputs, NewChunkPort(rt))
+	}
+	trans.Outputs = append(trans.Outputs, NewChunkPort(outRowDataType))
+
+	for i := range trans.rows {
+		trans.rows[i] = make(map[string][]joinRow)
+	}
+
+	return trans
+}
+
+type FullJoinTransformCreator struct {
+}
+
+func (c *FullJoinTransformCreator) Create(plan LogicalPlan, opt query.ProcessorOptions) (Processor, error) {
+	join, ok := plan.(*LogicalFullJoin)
+	if !ok {
+		return nil, errors.New("full join transform only accepts a logical full join")
+	}
+
+	keys, err := fullJoinKeys(join.Condition())
+	if err != nil {
+		return nil, err
+	}
+
+	inRowDataTypes := make([]hybridqp.RowDataType, 0, 2)
+	sides := make([]int, 0, 2)
+	if join.HasLeft() {
+		inRowDataTypes = append(inRowDataTypes, join.Left())
+		sides = append(sides, joinLeft)
+	}
+	if join.HasRight() {
+		inRowDataTypes = append(inRowDataTypes, join.Right())
+		sides = append(sides, joinRight)
+	}
+
+	p := NewFullJoinTransform(inRowDataTypes, sides, plan.RowDataType(),
+		[2]hybridqp.RowDataType{join.Left(), join.Right()}, keys, opt)
+	return p, nil
+}
+
+var _ = RegistryTransformCreator(&LogicalFullJoin{}, &FullJoinTransformCreator{})
+
+// fullJoinKeys returns the join tags of the left and the right side.
+func fullJoinKeys(condition influxql.Expr) ([2]string, error) {
+	expr, ok := condition.(*influxql.BinaryExpr)
+	if !ok || expr.Op != influxql.EQ {
+		return [2]string{}, errors.New("full join condition must be an equality of two tags")
+	}
+	lhs, lok := expr.LHS.(*influxql.VarRef)
+	rhs, rok := expr.RHS.(*influxql.VarRef)
+	if !lok || !rok {
+		return [2]string{}, errors.New("full join condition must be an equality of two tags")
+	}
+	return [2]string{lhs.Val, rhs.Val}, nil
+}
+
+func (trans *FullJoinTransform) Name() string {
+	return GetTypeName(trans)
+}
+
+func (trans *FullJoinTransform) Explain() []ValuePair {
+	return []ValuePair{{First: trans.keys[0], Second: trans.keys[1]}}
+}
+
+func (trans *FullJoinTransform) Close() {
+	trans.Outputs.Close()
+}
+
+func (trans *FullJoinTransform) Release() error {
+	return nil
+}
+
+func (trans *FullJoinTransform) Work(ctx context.Context) error {
+	span := trans.StartSpan("[FullJoin]TotalWorkCost", false)
+	trans.workTracing = tracing.Start(span, "cost_for_full_join", false)
+	defer func() {
+		trans.Close()
+		tracing.Finish(span, trans.workTracing)
+	}()
+
+	var wg sync.WaitGroup
+	for i := range trans.Inputs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			trans.collect(ctx, trans.Inputs[i], trans.sides[i])
+		}(i)
+	}
+	wg.Wait()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	default:
+	}
+
+	tracing.SpanElapsed(trans.workTracing, func() {
+		trans.join()
+	})
+	return nil
+}
+
+// collect hashes all rows of an input by the value of the join tag of its side.
+// The chunks are cloned since the chunks of the input are reused once they are consumed.
+func (trans *FullJoinTransform) collect(ctx context.Context, input *ChunkPort, side int) {
+	rows := trans.rows[side]
+	for {
+		select {
+		case chunk, ok := <-input.State:
+			if !ok {
+				return
+			}
+			c := chunk.Clone()
+			if trans.names[side] == "" {
+				trans.names[side] = c.Name()
+			}
+
+			tagIndex := c.TagIndex()
+			for i, tags := range c.Tags() {
+				value, _ := tags.GetChunkTagValue(trans.keys[side])
+				end := c.Len()
+				if i < len(tagIndex)-1 {
+					end = tagIndex[i+1]
+				}
+				for j := tagIndex[i]; j < end; j++ {
+					rows[value] = append(rows[value], joinRow{chunk: c, index: j})
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (trans *FullJoinTransform) join() {
+	values := make([]string, 0, len(trans.rows[joinLeft])+len(trans.rows[joinRight]))
+	for value := range trans.rows[joinLeft] {
+		values = append(values, value)
+	}
+	for value := range trans.rows[joinRight] {
+		if _, ok := trans.rows[joinLeft][value]; !ok {
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+
+	trans.newChunk()
+	for _, value := range values {
+		trans.setTags(value)
+		trans.joinRows(trans.sortRows(trans.rows[joinLeft][value]), trans.sortRows(trans.rows[joinRight][value]))
+	}
+	if trans.chunk.Len() > 0 {
+		trans.Outputs[0].State <- trans.chunk
+	}
+}
+
+func (trans *FullJoinTransform) sortRows(rows []joinRow) []joinRow {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return trans.before(rows[i].time(), rows[j].time())
+	})
+	return rows
+}
+
+func (trans *FullJoinTransform) before(a, b int64) bool {
+	if trans.opt.Ascending {
+		return a < b
+	}
+	return a > b
+}
+
+// joinRows merges the rows of a join tag value, the rows of both sides at the same time
+// are joined with each other.
+func (trans *FullJoinTransform) joinRows(left, right []joinRow) {
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		switch {
+		case j == len(right) || (i < len(left) && trans.before(left[i].time(), right[j].time())):
+			trans.appendRow(left[i].time(), &left[i], nil)
+			i++
+		case i == len(left) || trans.before(right[j].time(), left[i].time()):
+			trans.appendRow(right[j].time(), nil, &right[j])
+			j++
+		default:
+			t := left[i].time()
+			li, rj := i, j
+			for li < len(left) && left[li].time() == t {
+				li++
+			}
+			for rj < len(right) && right[rj].time() == t {
+				rj++
+			}
+			for l := i; l < li; l++ {
+				for r := j; r < rj; r++ {
+					trans.appendRow(t, &left[l], &right[r])
+				}
+			}
+			i, j = li, rj
+		}
+	}
+}
+
+func (trans *FullJoinTransform) newChunk() {
+	trans.chunk = trans.builder.NewChunk(trans.chunkName())
+	trans.hasTags = false
+}
+
+func (trans *FullJoinTransform) chunkName() string {
+	names := make([]string, 0, 2)
+	for _, name := range trans.names {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func (trans *FullJoinTransform) setTags(value string) {
+	pts := influx.PointTags{{Key: trans.keys[joinLeft], Value: value}}
+	keys := []string{trans.keys[joinLeft]}
+	if trans.keys[joinRight] != trans.keys[joinLeft] {
+		pts = append(pts, influx.Tag{Key: trans.keys[joinRight], Value: value})
+		keys = append(keys, trans.keys[joinRight])
+		sort.Sort(&pts)
+		sort.Strings(keys)
+	}
+	trans.tags = *NewChunkTags(pts, keys)
+	trans.hasTags = false
+}
+
+func (trans *FullJoinTransform) appendRow(t int64, left, right *joinRow) {
+	if trans.opt.ChunkSize > 0 && trans.chunk.Len() >= trans.opt.ChunkSize {
+		trans.Outputs[0].State <- trans.chunk
+		trans.newChunk()
+	}
+	if !trans.hasTags {
+		trans.chunk.AppendTagsAndIndex(trans.tags, trans.chunk.Len())
+		trans.chunk.AppendIntervalIndex(trans.chunk.Len())
+		trans.hasTags = true
+	}
+
+	trans.chunk.AppendTime(t)
+	offset := 0
+	for side, row := range [2]*joinRow{left, right} {
+		n := trans.rts[side].NumColumn()
+		for i := 0; i < n; i++ {
+			dst := trans.chunk.Column(offset + i)
+			if row == nil {
+				dst.AppendNil()
+				continue
+			}
+			appendJoinValue(dst, row.chunk.Column(i), row.index)
+		}
+		offset += n
+	}
+}
+
+func appendJoinValue(dst Column, src Column, index int) {
+	if src.IsNilV2(index) {
+		dst.AppendNil()
+		return
+	}
+
+	valueIndex := src.GetValueIndexV2(index)
+	switch dst.DataType() {
+	case influxql.Float:
+		dst.AppendFloatValues(src.FloatValue(valueIndex))
+	case influxql.Integer:
+		dst.AppendIntegerValues(src.IntegerValue(valueIndex))
+	case influxql.Unsigned:
+		dst.AppendUnsignedValues(src.UnsignedValue(valueIndex))
+	case influxql.Boolean:
+		dst.AppendBooleanValues(src.BooleanValue(valueIndex))
+	case influxql.String, influxql.Tag:
+		dst.AppendStringValues(src.StringValue(valueIndex))
+	}
+	dst.AppendNilsV2(true)
+}
+
+func (trans *FullJoinTransform) GetOutputs() Ports {
+	ports := make(Ports, 0, len(trans.Outputs))
+
+	for _, output := range trans.Outputs {
+		ports = append(ports, output)
+	}
+	return ports
+}
+
+func (trans *FullJoinTransform) GetInputs() Ports {
+	ports := make(Ports, 0, len(trans.Inputs))
+
+	for _, input := range trans.Inputs {
+		ports = append(ports, input)
+	}
+	return ports
+}
+
+func (trans *FullJoinTransform) GetOutputNumber(port Port) int {
+	for i, output := range trans.Outputs {
+		if output == port {
+			return i
+		}
+	}
+	return INVALID_NUMBER
+}
+
+func (trans *FullJoinTransform) GetInputNumber(port Port) int {
+	for i, input := range trans.Inputs {
+		if input == port {
+			return i
+		}
+	}
+	return INVALID_NUMBER
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type joinResultRow struct {
+	host  string
+	time  int64
+	usage interface{}
+	used  interface{}
+}
+
+func buildJoinLeftChunk(rt hybridqp.RowDataType) executor.Chunk {
+	chunk := executor.NewChunkBuilder(rt).NewChunk("cpu")
+	chunk.AppendTime(2, 1, 1)
+	chunk.AddTagAndIndex(*ParseChunkTags("host=A"), 0)
+	chunk.AddIntervalIndex(0)
+	chunk.AddTagAndIndex(*ParseChunkTags("host=B"), 2)
+	chunk.AddIntervalIndex(2)
+	chunk.Column(0).AppendFloatValues(2.0, 1.0, 3.0)
+	chunk.Column(0).AppendManyNotNil(3)
+	return chunk
+}
+
+func buildJoinRightChunk(rt hybridqp.RowDataType) executor.Chunk {
+	chunk := executor.NewChunkBuilder(rt).NewChunk("mem")
+	chunk.AppendTime(2, 3)
+	chunk.AddTagAndIndex(*ParseChunkTags("hostname=A"), 0)
+	chunk.AddIntervalIndex(0)
+	chunk.AddTagAndIndex(*ParseChunkTags("hostname=C"), 1)
+	chunk.AddIntervalIndex(1)
+	chunk.Column(0).AppendIntegerValues(20, 30)
+	chunk.Column(0).AppendManyNotNil(2)
+	return chunk
+}
+
+func runFullJoin(t *testing.T, left, right hybridqp.QueryNode, lrt, rrt hybridqp.RowDataType, sources []executor.Chunk) []joinResultRow {
+	condition := &influxql.BinaryExpr{
+		Op:  influxql.EQ,
+		LHS: &influxql.VarRef{Val: "host"},
+		RHS: &influxql.VarRef{Val: "hostname"},
+	}
+	plan := executor.NewLogicalFullJoin(left, right, lrt, rrt, condition, nil)
+	processor, err := (&executor.FullJoinTransformCreator{}).Create(plan, query.ProcessorOptions{Ascending: true, ChunkSize: 2})
+	require.NoError(t, err)
+	join := processor.(*executor.FullJoinTransform)
+
+	var rows []joinResultRow
+	sink := NewSinkFromFunction(plan.RowDataType(), func(chunk executor.Chunk) error {
+		tagIndex := chunk.TagIndex()
+		for i, tags := range chunk.Tags() {
+			host, _ := tags.GetChunkTagValue("host")
+			hostname, _ := tags.GetChunkTagValue("hostname")
+			assert.Equal(t, host, hostname)
+			end := chunk.Len()
+			if i < len(tagIndex)-1 {
+				end = tagIndex[i+1]
+			}
+			for j := tagIndex[i]; j < end; j++ {
+				row := joinResultRow{host: host, time: chunk.TimeByIndex(j)}
+				if !chunk.Column(0).IsNilV2(j) {
+					row.usage = chunk.Column(0).FloatValue(chunk.Column(0).GetValueIndexV2(j))
+				}
+				if !chunk.Column(1).IsNilV2(j) {
+					row.used = chunk.Column(1).IntegerValue(chunk.Column(1).GetValueIndexV2(j))
+				}
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	processors := executor.Processors{join, sink}
+	for i, chunk := range sources {
+		source := NewSourceFromSingleChunk(chunk.RowDataType(), []executor.Chunk{chunk})
+		require.NoError(t, executor.Connect(source.Output, join.GetInputs()[i]))
+		processors = append(processors, source)
+	}
+	require.NoError(t, executor.Connect(join.GetOutputs()[0], sink.Input))
+
+	require.NoError(t, executor.NewPipelineExecutor(processors).Execute(context.Background()))
+	return rows
+}
+
+func TestFullJoinTransform(t *testing.T) {
+	lrt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "cpu.usage", Type: influxql.Float})
+	rrt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "mem.used", Type: influxql.Integer})
+
+	rows := runFullJoin(t, executor.NewLogicalMst(lrt), executor.NewLogicalMst(rrt), lrt, rrt,
+		[]executor.Chunk{buildJoinLeftChunk(lrt), buildJoinRightChunk(rrt)})
+	assert.Equal(t, []joinResultRow{
+		{host: "A", time: 1, usage: 1.0},
+		{host: "A", time: 2, usage: 2.0, used: int64(20)},
+		{host: "B", time: 1, usage: 3.0},
+		{host: "C", time: 3, used: int64(30)},
+	}, rows)
+}
+
+func TestFullJoinTransformWithoutRight(t *testing.T) {
+	lrt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "cpu.usage", Type: influxql.Float})
+	rrt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "mem.used", Type: influxql.Integer})
+
+	plan := executor.NewLogicalFullJoin(executor.NewLogicalMst(lrt), nil, lrt, rrt, &influxql.BinaryExpr{}, nil)
+	assert.Equal(t, 1, len(plan.Children()))
+	assert.Equal(t, 2, plan.RowDataType().NumColumn())
+
+	rows := runFullJoin(t, executor.NewLogicalMst(lrt), nil, lrt, rrt, []executor.Chunk{buildJoinLeftChunk(lrt)})
+	assert.Equal(t, []joinResultRow{
+		{host: "A", time: 1, usage: 1.0},
+		{host: "A", time: 2, usage: 2.0},
+		{host: "B", time: 1, usage: 3.0},
+	}, rows)
+}
@@ -92,6 +92,8 @@ func (trans *GroupByTransform) initTransParent(vr *influxql.VarRef, i int) {
 	switch vr.Type {
 	case influxql.Integer:
 		trans.transparents[i] = TransparentForwardIntegerColumn
+	case influxql.Unsigned:
+		trans.transparents[i] = TransparentForwardUnsignedColumn
 	case influxql.Float:
 		trans.transparents[i] = TransparentForwardFloatColumn
 	case influxql.Boolean:
@@ -471,6 +471,37 @@ func (f *Int64LimitIterator) Next(endpoint *IteratorEndpoint, params *IteratorPa
 	}
 }
 
+type Uint64LimitIterator struct {
+	input  Column
+	output Column
+}
+
+func NewUint64LimitIterator() *Uint64LimitIterator {
+	return &Uint64LimitIterator{}
+}
+
+func (f *Uint64LimitIterator) Next(endpoint *IteratorEndpoint, params *IteratorParams) {
+	f.input = endpoint.InputPoint.Chunk.Column(endpoint.InputPoint.Ordinal)
+	f.output = endpoint.OutputPoint.Chunk.Column(endpoint.OutputPoint.Ordinal)
+	startValue, endValue := f.input.GetRangeValueIndexV2(params.start, params.end)
+	f.output.AppendUnsignedValues(f.input.UnsignedValues()[startValue:endValue]...)
+	if endValue-startValue != params.end-params.start {
+		for i := params.start; i < params.end; i++ {
+			if f.input.IsNilV2(i) {
+				f.output.AppendNil()
+			} else {
+				f.output.AppendNilsV2(true)
+			}
+		}
+	} else {
+		f.output.AppendManyNotNil(endValue - startValue)
+	}
+
+	if f.input.ColumnTimes() != nil {
+		f.output.AppendColumnTimes(f.input.ColumnTimes()[startValue:endValue]...)
+	}
+}
+
 type Float64LimitIterator struct {
 	input  Column
 	output Column
@@ -40,6 +40,7 @@ var (
 	_ LogicalPlan = &LogicalFilterBlank{}
 	_ LogicalPlan = &LogicalMerge{}
 	_ LogicalPlan = &LogicalSortMerge{}
+	_ LogicalPlan = &LogicalFullJoin{}
 	_ LogicalPlan = &LogicalDedupe{}
 	_ LogicalPlan = &LogicalInterval{}
 	_ LogicalPlan = &LogicalReader{}
@@ -1231,6 +1232,170 @@ func (p *LogicalSortMerge) Dummy() bool {
 	return false
 }
 
+// LogicalFullJoin joins the rows of two inputs having the same value of the join tags at the same time,
+// rows without a match are kept with nulls for the fields of the other input.
+// An input is nil if its side of the join has no shard to read.
+type LogicalFullJoin struct {
+	left      hybridqp.QueryNode
+	right     hybridqp.QueryNode
+	lrt       hybridqp.RowDataType
+	rrt       hybridqp.RowDataType
+	condition influxql.Expr
+	LogicalPlanBase
+}
+
+// NewLogicalFullJoin creates a join of left and right, lrt and rrt are the row data types of
+// the sides and are only used for a side whose input is nil.
+func NewLogicalFullJoin(left, right hybridqp.QueryNode, lrt, rrt hybridqp.RowDataType, condition influxql.Expr,
+	schema hybridqp.Catalog) *LogicalFullJoin {
+	join := &LogicalFullJoin{
+		left:      left,
+		right:     right,
+		lrt:       lrt,
+		rrt:       rrt,
+		condition: condition,
+		LogicalPlanBase: LogicalPlanBase{
+			id:     hybridqp.GenerateNodeId(),
+			schema: schema,
+			rt:     nil,
+			ops:    nil,
+		},
+	}
+
+	join.init()
+
+	return join
+}
+
+func (p *LogicalFullJoin) DeriveOperations() {
+	p.init()
+}
+
+func (p *LogicalFullJoin) init() {
+	if p.left != nil {
+		p.lrt = p.left.RowDataType()
+	}
+	if p.right != nil {
+		p.rrt = p.right.RowDataType()
+	}
+
+	inrefs := append(p.lrt.MakeRefs(), p.rrt.MakeRefs()...)
+	refs := make([]influxql.VarRef, 0, len(inrefs))
+	p.ops = make([]hybridqp.ExprOptions, 0, len(inrefs))
+
+	for _, ref := range inrefs {
+		clone := ref
+		p.ops = append(p.ops, hybridqp.ExprOptions{Expr: &clone, Ref: ref})
+		refs = append(refs, ref)
+	}
+
+	p.rt = hybridqp.NewRowDataTypeImpl(refs...)
+}
+
+func (p *LogicalFullJoin) Clone() hybridqp.QueryNode {
+	clone := &LogicalFullJoin{}
+	*clone = *p
+	clone.id = hybridqp.GenerateNodeId()
+	return clone
+}
+
+// Left returns the row data type of the left side of the join.
+func (p *LogicalFullJoin) Left() hybridqp.RowDataType {
+	return p.lrt
+}
+
+// Right returns the row data type of the right side of the join.
+func (p *LogicalFullJoin) Right() hybridqp.RowDataType {
+	return p.rrt
+}
+
+// HasLeft reports whether the left side of the join has an input.
+func (p *LogicalFullJoin) HasLeft() bool {
+	return p.left != nil
+}
+
+// HasRight reports whether the right side of the join has an input.
+func (p *LogicalFullJoin) HasRight() bool {
+	return p.right != nil
+}
+
+func (p *LogicalFullJoin) Condition() influxql.Expr {
+	return p.condition
+}
+
+func (p *LogicalFullJoin) Children() []hybridqp.QueryNode {
+	nodes := make([]hybridqp.QueryNode, 0, 2)
+	if p.left != nil {
+		nodes = append(nodes, p.left)
+	}
+	if p.right != nil {
+		nodes = append(nodes, p.right)
+	}
+	return nodes
+}
+
+func (p *LogicalFullJoin) ReplaceChildren(children []hybridqp.QueryNode) {
+	if len(p.Children()) != len(children) {
+		panic(fmt.Sprintf("%d children in logical full join, but replace with %d children", len(p.Children()), len(children)))
+	}
+
+	for i, child := range children {
+		p.ReplaceChild(i, child)
+	}
+}
+
+func (p *LogicalFullJoin) ReplaceChild(ordinal int, child hybridqp.QueryNode) {
+	slots := make([]*hybridqp.QueryNode, 0, 2)
+	if p.left != nil {
+		slots = append(slots, &p.left)
+	}
+	if p.right != nil {
+		slots = append(slots, &p.right)
+	}
+	if ordinal >= len(slots) {
+		panic(fmt.Sprintf("index %d out of range %d", ordinal, len(slots)))
+	}
+	*slots[ordinal] = child
+}
+
+func (p *LogicalFullJoin) Explain(writer LogicalPlanWriter) {
+	writer.Item("condition", p.condition.String())
+	p.ExplainIterms(writer)
+	writer.Explain(p)
+}
+
+func (p *LogicalFullJoin) String() string {
+	return GetTypeName(p)
+}
+
+func (p *LogicalFullJoin) Type() string {
+	return GetType(p)
+}
+
+func (p *LogicalFullJoin) Digest() string {
+	ids := make([]string, 0, 2)
+	for _, child := range p.Children() {
+		ids = append(ids, fmt.Sprintf("%d", child.ID()))
+	}
+	return fmt.Sprintf("%s[%s](%s)", GetTypeName(p), strings.Join(ids, ","), p.condition)
+}
+
+func (p *LogicalFullJoin) RowDataType() hybridqp.RowDataType {
+	return p.rt
+}
+
+func (p *LogicalFullJoin) RowExprOptions() []hybridqp.ExprOptions {
+	return p.ops
+}
+
+func (p *LogicalFullJoin) Schema() hybridqp.Catalog {
+	return p.schema
+}
+
+func (p *LogicalFullJoin) Dummy() bool {
+	return false
+}
+
 type LogicalSortAppend struct {
 	inputs []hybridqp.QueryNode
 	LogicalPlanBase
@@ -57,6 +57,21 @@ func TransparentForwardIntegerColumn(dst Column, src Column) {
 	}
 }
 
+func TransparentForwardUnsignedColumn(dst Column, src Column) {
+	dst.AppendUnsignedValues(src.UnsignedValues()...)
+	if src.NilCount() == 0 {
+		dst.AppendManyNotNil(src.Length())
+	} else {
+		for i := 0; i < src.Length(); i++ {
+			if src.IsNilV2(i) {
+				dst.AppendNil()
+			} else {
+				dst.AppendNilsV2(true)
+			}
+		}
+	}
+}
+
 func TransparentForwardFloatColumn(dst Column, src Column) {
 	dst.AppendFloatValues(src.FloatValues()...)
 	if src.NilCount() == 0 {
@@ -106,6 +121,8 @@ func getRowValue(column Column, index int) interface{} {
 	switch column.DataType() {
 	case influxql.Integer:
 		return column.IntegerValue(index)
+	case influxql.Unsigned:
+		return column.UnsignedValue(index)
 	case influxql.Float:
 		return column.FloatValue(index)
 	case influxql.Boolean:
@@ -125,6 +142,12 @@ func appendRowValue(column Column, value interface{}) {
 		} else {
 			panic("expect integer value")
 		}
+	case influxql.Unsigned:
+		if v, ok := value.(uint64); ok {
+			column.AppendUnsignedValues(v)
+		} else {
+			panic("expect unsigned value")
+		}
 	case influxql.Float:
 		if v, ok := value.(float64); ok {
 			column.AppendFloatValues(v)
@@ -211,6 +234,8 @@ func createTransparents(ops []hybridqp.ExprOptions) []func(dst Column, src Colum
 			switch vr.Type {
 			case influxql.Integer:
 				transparents[i] = TransparentForwardIntegerColumn
+			case influxql.Unsigned:
+				transparents[i] = TransparentForwardUnsignedColumn
 			case influxql.Float:
 				transparents[i] = TransparentForwardFloatColumn
 			case influxql.Boolean:
@@ -666,6 +666,38 @@ func (f *Int64MergeIterator) Next(endpoint *IteratorEndpoint, params *IteratorPa
 	}
 }
 
+type Uint64MergeIterator struct {
+	input  Column
+	output Column
+}
+
+func NewUint64MergeIterator() *Uint64MergeIterator {
+	return &Uint64MergeIterator{}
+}
+
+func (f *Uint64MergeIterator) Next(endpoint *IteratorEndpoint, params *IteratorParams) {
+	f.output = endpoint.OutputPoint.Chunk.Column(endpoint.OutputPoint.Ordinal)
+	index := endpoint.InputPoint.Chunk.RowDataType().FieldIndex(endpoint.OutputPoint.Chunk.RowDataType().Field(endpoint.OutputPoint.Ordinal).Name())
+	f.input = endpoint.InputPoint.Chunk.Column(index)
+	startValue, endValue := f.input.GetRangeValueIndexV2(params.start, params.end)
+	f.output.AppendUnsignedValues(f.input.UnsignedValues()[startValue:endValue]...)
+	if endValue-startValue != params.end-params.start {
+		for i := params.start; i < params.end; i++ {
+			if f.input.IsNilV2(i) {
+				f.output.AppendNil()
+			} else {
+				f.output.AppendNilsV2(true)
+			}
+		}
+	} else {
+		f.output.AppendManyNotNil(endValue - startValue)
+	}
+
+	if f.input.ColumnTimes() != nil {
+		f.output.AppendColumnTimes(f.input.ColumnTimes()[startValue:endValue]...)
+	}
+}
+
 type Float64MergeIterator struct {
 	input  Column
 	output Column
@@ -1219,6 +1251,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedAscendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64AscendingAuxHelper,
@@ -1256,6 +1294,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedDescendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64DescendingAuxHelper,
@@ -1474,6 +1518,15 @@ func IntegerAscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	return false, x.IntegerValue(xvi) < y.IntegerValue(yvj)
 }
 
+func UnsignedAscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xvi := x.GetValueIndexV2(i)
+	yvj := y.GetValueIndexV2(j)
+	if x.UnsignedValue(xvi) == y.UnsignedValue(yvj) {
+		return true, false
+	}
+	return false, x.UnsignedValue(xvi) < y.UnsignedValue(yvj)
+}
+
 func Float64AscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	xvi := x.GetValueIndexV2(i)
 	yvj := y.GetValueIndexV2(j)
@@ -1510,6 +1563,15 @@ func IntegerDescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	return false, x.IntegerValue(xvi) > y.IntegerValue(yvj)
 }
 
+func UnsignedDescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xvi := x.GetValueIndexV2(i)
+	yvj := y.GetValueIndexV2(j)
+	if x.UnsignedValue(xvi) == y.UnsignedValue(yvj) {
+		return true, false
+	}
+	return false, x.UnsignedValue(xvi) > y.UnsignedValue(yvj)
+}
+
 func Float64DescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	xvi := x.GetValueIndexV2(i)
 	yvj := y.GetValueIndexV2(j)
@@ -1545,6 +1607,8 @@ func FixedMergeColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcess
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanMergeIterator(), i, i))
 		case influxql.Integer:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64MergeIterator(), i, i))
+		case influxql.Unsigned:
+			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewUint64MergeIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64MergeIterator(), i, i))
 		case influxql.String, influxql.Tag:
@@ -1562,6 +1626,8 @@ func FixedColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcessor {
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanLimitIterator(), i, i))
 		case influxql.Integer:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64LimitIterator(), i, i))
+		case influxql.Unsigned:
+			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewUint64LimitIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64LimitIterator(), i, i))
 		case influxql.String, influxql.Tag:
@@ -24,6 +24,7 @@ import (
 
 	"github.com/openGemini/openGemini/engine/executor/spdy"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/bucket"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
@@ -58,6 +59,9 @@ type PipelineExecutor struct {
 
 	info *PipelineExecutorInfo
 
+	// the memory budget of the workload group of the query, nil if unlimited
+	memQuota bucket.ResourceBucket
+
 	RunTimeStats  *statistics.StatisticTimer
 	WaitTimeStats *statistics.StatisticTimer
 }
@@ -172,7 +176,21 @@ func (exec *PipelineExecutor) Release() {
 	}
 }
 
+// SetMemQuota makes the executor hold its memory from the budget of its workload group as well.
+func (exec *PipelineExecutor) SetMemQuota(quota bucket.ResourceBucket) {
+	exec.memQuota = quota
+}
+
 func (exec *PipelineExecutor) ExecuteExecutor(ctx context.Context) error {
+	if exec.memQuota != nil {
+		MemoryEstimator(exec)
+		cost := exec.info.MemoryOccupation
+		if err := exec.memQuota.GetResource(cost); err != nil {
+			statistics.ExecutorStat.ExecTimeout.Increase()
+			return err
+		}
+		defer exec.memQuota.ReleaseResource(cost)
+	}
 	if err := pipelineExecutorResourceManager.ManageMemResource(exec); err != nil {
 		statistics.ExecutorStat.ExecTimeout.Increase()
 		return err
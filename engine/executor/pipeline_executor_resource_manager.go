@@ -129,7 +129,7 @@ func NodeMemEstimator(rt hybridqp.RowDataType, chunkSize int64) int64 {
 			panic("RowDataType Field Should Be Varef!")
 		}
 		switch f.Type {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			size += chunkSize * DefaultIntegerSize
 		case influxql.Float:
 			size += chunkSize * DefaultFloatSize
@@ -173,6 +173,10 @@ type RemoteQuery struct {
 	Opt      query.ProcessorOptions
 	Analyze  bool
 	Node     []byte
+
+	// the query on the SQL node the remote query belongs to
+	QueryID   uint64
+	QueryHost string
 }
 
 func (c *RemoteQuery) Marshal(buf []byte) ([]byte, error) {
@@ -189,6 +193,8 @@ func (c *RemoteQuery) Marshal(buf []byte) ([]byte, error) {
 		Opt:       opt,
 		Analyze:   c.Analyze,
 		QueryNode: c.Node,
+		QueryID:   c.QueryID,
+		QueryHost: c.QueryHost,
 	})
 
 	ret := make([]byte, len(buf)+len(msg))
@@ -210,6 +216,8 @@ func (c *RemoteQuery) Unmarshal(buf []byte) error {
 	c.Analyze = pb.GetAnalyze()
 	c.NodeID = pb.GetNodeID()
 	c.Node = pb.QueryNode
+	c.QueryID = pb.GetQueryID()
+	c.QueryHost = pb.GetQueryHost()
 
 	if err := c.Opt.UnmarshalBinary(pb.GetOpt()); err != nil {
 		return err
@@ -78,8 +78,10 @@ func makeRemoteQueryMsg(nodeID uint64) *executor.RemoteQuery {
 			EnableBinaryTreeMerge: 0,
 			HintType:              0,
 		},
-		Analyze: false,
-		Node:    []byte{1, 2, 3, 4, 5, 6, 7},
+		Analyze:   false,
+		Node:      []byte{1, 2, 3, 4, 5, 6, 7},
+		QueryID:   12,
+		QueryHost: "127.0.0.1:8086",
 	}
 }
 
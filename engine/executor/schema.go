@@ -388,7 +388,8 @@ func (qs *QuerySchema) HasSubQuery() bool {
 		return false
 	}
 
-	if _, ok := src[0].(*influxql.SubQuery); ok {
+	switch src[0].(type) {
+	case *influxql.SubQuery, *influxql.Join:
 		return true
 	}
 	return false
@@ -407,6 +408,20 @@ func (qs *QuerySchema) HasNonPreCall() bool {
 	return false
 }
 
+// HasUnsignedCall returns true if a call aggregates an unsigned field,
+// the pre-aggregated metas are not merged for unsigned values.
+func (qs *QuerySchema) HasUnsignedCall() bool {
+	for _, call := range qs.calls {
+		if len(call.Args) == 0 {
+			continue
+		}
+		if ref, ok := call.Args[0].(*influxql.VarRef); ok && ref.Type == influxql.Unsigned {
+			return true
+		}
+	}
+	return false
+}
+
 func (qs *QuerySchema) CanLimitCut() bool {
 	return qs.HasLimit() && !qs.HasCall() && !qs.HasFieldCondition()
 }
@@ -795,6 +810,10 @@ func (qs *QuerySchema) MatchPreAgg() bool {
 		return false
 	}
 
+	if qs.HasUnsignedCall() {
+		return false
+	}
+
 	if qs.HasInterval() {
 		return false
 	}
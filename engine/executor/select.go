@@ -362,12 +362,62 @@ func buildSources(ctx context.Context, qc query.LogicalPlanCreator, sources infl
 		builder.GroupBy()
 		builder.OrderBy()
 		return builder.Build()
+	case *influxql.Join:
+		joinPlan, err := buildJoin(ctx, qc, source, schema)
+		if joinPlan == nil || err != nil {
+			return nil, err
+		}
+		builder := NewLogicalPlanBuilderImpl(schema)
+		builder.Push(joinPlan)
+		if schema.Options().GetCondition() != nil {
+			builder.Filter()
+		}
+		builder.SubQuery()
+		builder.GroupBy()
+		builder.OrderBy()
+		return builder.Build()
 
 	default:
 		return nil, nil
 	}
 }
 
+// buildJoin builds the plans of both sides of a join, the sides are the sub queries rewritten by
+// SelectStatement.RewriteJoin. A side without a plan has no data, the join keeps the rows of the other side.
+func buildJoin(ctx context.Context, qc query.LogicalPlanCreator, join *influxql.Join, schema *QuerySchema) (hybridqp.QueryNode, error) {
+	var plans [2]hybridqp.QueryNode
+	var rts [2]hybridqp.RowDataType
+	for i, src := range []influxql.Source{join.LSrc, join.RSrc} {
+		subQuery, ok := src.(*influxql.SubQuery)
+		if !ok {
+			return nil, fmt.Errorf("unsupported source of join: %s", src)
+		}
+		subQueryBuilder := SubQueryBuilder{
+			qc:   qc,
+			stmt: subQuery.Statement,
+		}
+		plan, err := subQueryBuilder.Build(ctx, *schema.Options().(*query.ProcessorOptions))
+		if err != nil {
+			return nil, err
+		}
+		plans[i] = plan
+
+		refs := make([]influxql.VarRef, 0, len(subQuery.Statement.Fields))
+		for _, f := range subQuery.Statement.Fields {
+			ref := influxql.VarRef{Val: f.Name()}
+			if vr, ok := f.Expr.(*influxql.VarRef); ok {
+				ref.Type = vr.Type
+			}
+			refs = append(refs, ref)
+		}
+		rts[i] = hybridqp.NewRowDataTypeImpl(refs...)
+	}
+	if plans[0] == nil && plans[1] == nil {
+		return nil, nil
+	}
+	return NewLogicalFullJoin(plans[0], plans[1], rts[0], rts[1], join.Condition, schema), nil
+}
+
 var _ = query.RegistryStmtBuilderCreator(&PrepareStmtBuilderCreator{})
 
 type PrepareStmtBuilderCreator struct {
@@ -599,6 +599,8 @@ func AppendColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcessor {
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanAppendIterator(), i, i))
 		case influxql.Integer:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64AppendIterator(), i, i))
+		case influxql.Unsigned:
+			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewUint64AppendIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64AppendIterator(), i, i))
 		case influxql.String, influxql.Tag:
@@ -639,6 +641,37 @@ func (f *Int64AppendIterator) Next(endpoint *IteratorEndpoint, params *IteratorP
 	}
 }
 
+type Uint64AppendIterator struct {
+	input  Column
+	output Column
+}
+
+func NewUint64AppendIterator() *Uint64AppendIterator {
+	return &Uint64AppendIterator{}
+}
+
+func (f *Uint64AppendIterator) Next(endpoint *IteratorEndpoint, params *IteratorParams) {
+	f.output = endpoint.OutputPoint.Chunk.Column(endpoint.OutputPoint.Ordinal)
+	f.input = endpoint.InputPoint.Chunk.Column(params.Table[endpoint.OutputPoint.Ordinal])
+	startValue, endValue := f.input.GetRangeValueIndexV2(params.start, params.end)
+	f.output.AppendUnsignedValues(f.input.UnsignedValues()[startValue:endValue]...)
+	if endValue-startValue != params.end-params.start {
+		for i := params.start; i < params.end; i++ {
+			if f.input.IsNilV2(i) {
+				f.output.AppendNil()
+			} else {
+				f.output.AppendNilsV2(true)
+			}
+		}
+	} else {
+		f.output.AppendManyNotNil(endValue - startValue)
+	}
+
+	if f.input.ColumnTimes() != nil {
+		f.output.AppendColumnTimes(f.input.ColumnTimes()[startValue:endValue]...)
+	}
+}
+
 type Float64AppendIterator struct {
 	input  Column
 	output Column
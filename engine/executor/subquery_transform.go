@@ -81,6 +81,8 @@ func (trans *SubQueryTransform) initTransformMapping() error {
 			switch vr.Type {
 			case influxql.Integer:
 				trans.transparents[i] = TransparentForwardIntegerColumn
+			case influxql.Unsigned:
+				trans.transparents[i] = TransparentForwardUnsignedColumn
 			case influxql.Float:
 				trans.transparents[i] = TransparentForwardFloatColumn
 			case influxql.Boolean:
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/backup"
+)
+
+// Backup links the TSSP files of the table store into dir and copies the tombstone and down sample files, which are
+// rewritten in place. Compaction and merge are switched off and the running ones are waited for, so that no file is
+// replaced while the files are linked. The files being flushed are not renamed yet and are left out. The TSSP files
+// in base, the files of the previous backup, are not linked again.
+func (m *MmsTables) Backup(dir string, base backup.FileSet) ([]backup.File, error) {
+	compactionEn, mergeEn := m.CompactionEnabled(), m.MergeEnabled()
+	m.CompactionDisable()
+	m.MergeDisable()
+	defer func() {
+		if compactionEn {
+			m.CompactionEnable()
+		}
+		if mergeEn {
+			m.MergeEnable()
+		}
+	}()
+
+	// compaction, merge and down sampling hold the read lock of tombstoneLock while they replace files
+	m.downSampleLock.Lock()
+	defer m.downSampleLock.Unlock()
+	m.tombstoneLock.Lock()
+	defer m.tombstoneLock.Unlock()
+
+	return backup.LinkTree(m.path, dir, base, backupAction)
+}
+
+func backupAction(name string) backup.FileAction {
+	switch {
+	case strings.HasSuffix(name, tsspFileSuffix):
+		return backup.LinkFile
+	case strings.HasSuffix(name, tmpTsspFileSuffix):
+		return backup.SkipFile
+	default:
+		return backup.CopyFile
+	}
+}
@@ -134,6 +134,8 @@ type ChunkIterator struct {
 	rec    *record.Record
 	merge  *record.Record
 	log    *Log.Logger
+
+	seriesTombstones []Tombstone
 }
 
 type ChunkIterators struct {
@@ -281,6 +283,18 @@ func (c *ChunkIterator) Close() {
 }
 
 func (c *ChunkIterator) Next() bool {
+	for {
+		if !c.next() {
+			return false
+		}
+		// skip the chunks whose rows are all deleted
+		if len(c.tombstones) == 0 || c.merge.RowNums() > 0 {
+			return true
+		}
+	}
+}
+
+func (c *ChunkIterator) next() bool {
 	if c.err != nil {
 		return false
 	}
@@ -346,9 +360,32 @@ func (c *ChunkIterator) read() error {
 		c.chunkUsed++
 	}
 
+	if len(c.tombstones) > 0 {
+		c.filterTombstones()
+	}
+
 	return nil
 }
 
+func (c *ChunkIterator) filterTombstones() {
+	c.seriesTombstones = seriesTombstones(c.tombstones, c.id, c.seriesTombstones[:0])
+	if len(c.seriesTombstones) == 0 {
+		return
+	}
+
+	rec := FilterByTombstones(c.merge, c.seriesTombstones)
+	if rec == c.merge {
+		return
+	}
+
+	c.merge.Reset()
+	c.merge.SetSchema(c.fields)
+	c.merge.ReserveColVal(len(c.fields))
+	if rec != nil {
+		c.merge.Merge(rec)
+	}
+}
+
 func (m *MmsTables) refMmsTable(name string, refOutOfOrder bool) (orderWg, outOfOrderWg *sync.WaitGroup) {
 	m.mu.RLock()
 	fs, ok := m.Order[name]
@@ -388,6 +425,9 @@ func (m *MmsTables) LevelCompact(level uint16, shid uint64) error {
 		case compLimiter <- struct{}{}:
 			m.wg.Add(1)
 			go func(group *CompactGroup) {
+				m.tombstoneLock.RLock()
+				defer m.tombstoneLock.RUnlock()
+
 				orderWg, inorderWg := m.refMmsTable(group.name, false)
 				if m.compactRecovery {
 					defer CompactRecovery(m.path, group)
@@ -904,6 +944,9 @@ func (m *MmsTables) MergeOutOfOrder(shId uint64) error {
 		case compLimiter <- struct{}{}:
 			m.wg.Add(1)
 			go func(name string, ctx *OutOfOrderMergeContext) {
+				m.tombstoneLock.RLock()
+				defer m.tombstoneLock.RUnlock()
+
 				stat.AddActive(1)
 				ctx.mstName = name
 				ctx.shId = shId
@@ -1134,10 +1177,12 @@ func (m *MmsTables) deleteOutOfOrderFiles(name string, outs *TSSPFiles) {
 
 	noFiles := false
 	v.lock.Lock()
+	tombstoneKeys := m.tombstoneKeys(outs.Files())
 	for _, f := range outs.Files() {
 		v.deleteFile(f)
 		m.removeFile(f)
 	}
+	m.removeTombstones(tombstoneKeys)
 	if v.Len() == 0 {
 		noFiles = true
 	} else {
@@ -1375,6 +1420,9 @@ func (m *MmsTables) FullCompact(shid uint64) error {
 			m.wg.Add(1)
 			atomic.AddInt64(&fullCompactingCount, 1)
 			go func(group *CompactGroup) {
+				m.tombstoneLock.RLock()
+				defer m.tombstoneLock.RUnlock()
+
 				orderWg, inorderWg := m.refMmsTable(group.name, false)
 				if m.compactRecovery {
 					defer CompactRecovery(m.path, group)
@@ -0,0 +1,912 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+const (
+	DownSampleFileName = "downsample"
+	downSampleTmpName  = DownSampleFileName + tmpTsspFileSuffix
+
+	// DownSampleTableSep separates the measurement and the call in the name of a down sampled table,
+	// it is not allowed in measurement names
+	DownSampleTableSep = ":"
+)
+
+var downSampleMagic = []byte("2022D5D5")
+
+var errDownSampleCorrupted = fmt.Errorf("downsample file corrupted")
+
+var ErrDownSampleBusy = fmt.Errorf("tables are being compacted or merged, downsample later")
+
+// DownSampleTableName returns the name of the table which keeps the data of measurement name aggregated by op
+func DownSampleTableName(name, op string) string {
+	return name + DownSampleTableSep + op
+}
+
+func isDownSampleTable(name string) bool {
+	return strings.Contains(name, DownSampleTableSep)
+}
+
+// DownSampleState is the down sample state of all tables in a shard
+type DownSampleState struct {
+	// Level is the number of policy levels the shard has been down sampled to, 0 if it keeps the raw data
+	Level int
+	// Interval is the length of the time windows the data is aggregated into
+	Interval int64
+	// Calls are the aggregations kept for the fields of each record type
+	Calls map[int][]string
+
+	// pending are the raw files which have been down sampled but not removed yet
+	pending []string
+}
+
+// HasCall reports whether the down sampled tables keep op for the fields of typ
+func (s *DownSampleState) HasCall(typ int, op string) bool {
+	for _, c := range s.Calls[typ] {
+		if c == op {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTable reports whether the fields of typ are kept in the down sampled table of op.
+// Mean is kept as the sum and the count of each window, so that the mean over several windows is exact.
+func (s *DownSampleState) HasTable(typ int, op string) bool {
+	if op == "mean" {
+		return false
+	}
+	for _, c := range s.Calls[typ] {
+		if c == op || (c == "mean" && (op == "sum" || op == "count")) {
+			return true
+		}
+	}
+	return false
+}
+
+// TableOps returns the ops of all down sampled tables, sorted by name
+func (s *DownSampleState) TableOps() []string {
+	seen := make(map[string]struct{})
+	for _, calls := range s.Calls {
+		for _, c := range calls {
+			if c == "mean" {
+				seen["sum"] = struct{}{}
+				seen["count"] = struct{}{}
+				continue
+			}
+			seen[c] = struct{}{}
+		}
+	}
+	ops := make([]string, 0, len(seen))
+	for op := range seen {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+// DownSampleFile persists the down sample state of a shard.
+type DownSampleFile struct {
+	mu   sync.RWMutex
+	path string
+
+	state DownSampleState
+}
+
+func NewDownSampleFile(shardDir string) *DownSampleFile {
+	return &DownSampleFile{
+		path: filepath.Join(shardDir, DownSampleFileName),
+	}
+}
+
+func (f *DownSampleFile) Path() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.path
+}
+
+// State returns the current state, which must not be modified by the caller
+func (f *DownSampleFile) State() DownSampleState {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.state
+}
+
+func (f *DownSampleFile) Load() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, err := fileops.Stat(f.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	lock := fileops.FileLockOption("")
+	buf, err := fileops.ReadFile(f.path, lock)
+	if err != nil {
+		log.Error("read downsample file fail", zap.String("path", f.path), zap.Error(err))
+		return err
+	}
+
+	state, err := unmarshalDownSampleState(buf)
+	if err != nil {
+		log.Error("unmarshal downsample file fail", zap.String("path", f.path), zap.Error(err))
+		return err
+	}
+	f.state = state
+	return nil
+}
+
+// Save writes state to a temporary file and renames it to the downsample file
+func (f *DownSampleFile) Save(state DownSampleState) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	buf := marshalDownSampleState(nil, &state)
+	tmpName := filepath.Join(filepath.Dir(f.path), downSampleTmpName)
+	lock := fileops.FileLockOption("")
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_NORMAL)
+	fd, err := fileops.OpenFile(tmpName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640, lock, pri)
+	if err != nil {
+		log.Error("create downsample file fail", zap.String("name", tmpName), zap.Error(err))
+		return err
+	}
+
+	s, err := fd.Write(buf)
+	if err != nil || s != len(buf) {
+		_ = fd.Close()
+		return fmt.Errorf("write downsample file fail, write %v, size %v, err:%v", s, len(buf), err)
+	}
+
+	if err = fd.Sync(); err != nil {
+		_ = fd.Close()
+		return err
+	}
+
+	if err = fd.Close(); err != nil {
+		return err
+	}
+
+	if err = fileops.RenameFile(tmpName, f.path, lock); err != nil {
+		return err
+	}
+	f.state = state
+	return nil
+}
+
+func marshalDownSampleState(dst []byte, state *DownSampleState) []byte {
+	dst = numberenc.MarshalUint16Append(dst, uint16(state.Level))
+	dst = numberenc.MarshalInt64Append(dst, state.Interval)
+
+	types := make([]int, 0, len(state.Calls))
+	for typ := range state.Calls {
+		types = append(types, typ)
+	}
+	sort.Ints(types)
+
+	dst = numberenc.MarshalUint16Append(dst, uint16(len(types)))
+	for _, typ := range types {
+		dst = numberenc.MarshalUint16Append(dst, uint16(typ))
+		dst = marshalStrings(dst, state.Calls[typ])
+	}
+	dst = marshalStrings(dst, state.pending)
+
+	crc := crc32.ChecksumIEEE(dst)
+	dst = numberenc.MarshalUint32Append(dst, crc)
+	dst = append(dst, downSampleMagic...)
+	return dst
+}
+
+func unmarshalDownSampleState(buf []byte) (DownSampleState, error) {
+	var state DownSampleState
+	if len(buf) < 4+len(downSampleMagic) {
+		return state, errDownSampleCorrupted
+	}
+
+	magic := buf[len(buf)-len(downSampleMagic):]
+	if !bytes.Equal(magic, downSampleMagic) {
+		return state, errDownSampleCorrupted
+	}
+	buf = buf[:len(buf)-len(downSampleMagic)]
+
+	crc := numberenc.UnmarshalUint32(buf[len(buf)-4:])
+	buf = buf[:len(buf)-4]
+	if crc32.ChecksumIEEE(buf) != crc {
+		return state, errDownSampleCorrupted
+	}
+
+	// level + interval + number of types
+	if len(buf) < 2+8+2 {
+		return state, errDownSampleCorrupted
+	}
+	state.Level = int(numberenc.UnmarshalUint16(buf))
+	state.Interval = numberenc.UnmarshalInt64(buf[2:])
+	n := int(numberenc.UnmarshalUint16(buf[10:]))
+	buf = buf[12:]
+
+	var err error
+	state.Calls = make(map[int][]string, n)
+	for i := 0; i < n; i++ {
+		if len(buf) < 2 {
+			return state, errDownSampleCorrupted
+		}
+		typ := int(numberenc.UnmarshalUint16(buf))
+		if state.Calls[typ], buf, err = unmarshalStrings(buf[2:]); err != nil {
+			return state, err
+		}
+	}
+
+	if state.pending, buf, err = unmarshalStrings(buf); err != nil {
+		return state, err
+	}
+	if len(buf) > 0 {
+		return state, errDownSampleCorrupted
+	}
+	return state, nil
+}
+
+func marshalStrings(dst []byte, ss []string) []byte {
+	dst = numberenc.MarshalUint32Append(dst, uint32(len(ss)))
+	for _, s := range ss {
+		dst = numberenc.MarshalUint16Append(dst, uint16(len(s)))
+		dst = append(dst, s...)
+	}
+	return dst
+}
+
+func unmarshalStrings(buf []byte) ([]string, []byte, error) {
+	if len(buf) < 4 {
+		return nil, nil, errDownSampleCorrupted
+	}
+	n := int(numberenc.UnmarshalUint32(buf))
+	buf = buf[4:]
+
+	var ss []string
+	for i := 0; i < n; i++ {
+		if len(buf) < 2 {
+			return nil, nil, errDownSampleCorrupted
+		}
+		l := int(numberenc.UnmarshalUint16(buf))
+		buf = buf[2:]
+		if len(buf) < l {
+			return nil, nil, errDownSampleCorrupted
+		}
+		ss = append(ss, string(buf[:l]))
+		buf = buf[l:]
+	}
+	return ss, buf, nil
+}
+
+// DownSampleRecord aggregates the rows of rec into windows of interval by op, the rows must be sorted by time.
+// Only the fields whose type is kept in the table of op are aggregated, and nil is returned if there is no such field.
+// The time of each aggregated row is the start of its window, and count is the number of values of each window.
+func DownSampleRecord(rec *record.Record, op string, interval int64, calls map[int][]string) *record.Record {
+	state := DownSampleState{Calls: calls}
+	return downSampleRecord(rec, op, interval, func(typ int) bool {
+		return state.HasTable(typ, op)
+	})
+}
+
+// reDownSampleRecord aggregates the rows of the down sampled table of op into larger windows of interval,
+// the counts of the windows are added up.
+func reDownSampleRecord(rec *record.Record, op string, interval int64) *record.Record {
+	if op == "count" {
+		op = "sum"
+	}
+	return downSampleRecord(rec, op, interval, func(int) bool {
+		return true
+	})
+}
+
+func downSampleRecord(rec *record.Record, op string, interval int64, keep func(typ int) bool) *record.Record {
+	var schema record.Schemas
+	var idxs []int
+	for i, f := range rec.Schema[:len(rec.Schema)-1] {
+		if f.Type != influx.Field_Type_Float && f.Type != influx.Field_Type_Int {
+			continue
+		}
+		if keep(f.Type) {
+			if op == "count" {
+				f.Type = influx.Field_Type_Int
+			}
+			schema = append(schema, f)
+			idxs = append(idxs, i)
+		}
+	}
+	if len(schema) == 0 {
+		return nil
+	}
+	schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+
+	dst := record.NewRecordBuilder(schema)
+	times := rec.Times()
+	for start := 0; start < len(times); {
+		window := windowStart(times[start], interval)
+		end := start + 1
+		for end < len(times) && times[end] < window+interval {
+			end++
+		}
+
+		empty := true
+		for _, idx := range idxs {
+			if s, e := rec.ColVals[idx].GetValIndexRange(start, end); s < e {
+				empty = false
+				break
+			}
+		}
+		if !empty {
+			for i, idx := range idxs {
+				aggregateColumn(&dst.ColVals[i], &rec.ColVals[idx], rec.Schema[idx].Type, op, start, end)
+			}
+			dst.ColVals[len(idxs)].AppendInteger(window)
+		}
+		start = end
+	}
+
+	if dst.RowNums() == 0 {
+		return nil
+	}
+	return dst
+}
+
+// windowStart returns the start of the window of interval which t belongs to
+func windowStart(t, interval int64) int64 {
+	w := t - t%interval
+	if t < 0 && w != t {
+		w -= interval
+	}
+	return w
+}
+
+func aggregateColumn(dst, src *record.ColVal, typ int, op string, start, end int) {
+	s, e := src.GetValIndexRange(start, end)
+	if op == "count" {
+		if s == e {
+			dst.AppendIntegerNull()
+			return
+		}
+		dst.AppendInteger(int64(e - s))
+		return
+	}
+	switch typ {
+	case influx.Field_Type_Float:
+		if s == e {
+			dst.AppendFloatNull()
+			return
+		}
+		dst.AppendFloat(aggregateFloats(src.FloatValues()[s:e], op))
+	case influx.Field_Type_Int:
+		if s == e {
+			dst.AppendIntegerNull()
+			return
+		}
+		dst.AppendInteger(aggregateIntegers(src.IntegerValues()[s:e], op))
+	}
+}
+
+func aggregateFloats(values []float64, op string) float64 {
+	v := values[0]
+	switch op {
+	case "sum":
+		for _, x := range values[1:] {
+			v += x
+		}
+	case "min":
+		for _, x := range values[1:] {
+			if x < v {
+				v = x
+			}
+		}
+	case "max":
+		for _, x := range values[1:] {
+			if x > v {
+				v = x
+			}
+		}
+	case "last":
+		v = values[len(values)-1]
+	}
+	return v
+}
+
+func aggregateIntegers(values []int64, op string) int64 {
+	v := values[0]
+	switch op {
+	case "sum":
+		for _, x := range values[1:] {
+			v += x
+		}
+	case "min":
+		for _, x := range values[1:] {
+			if x < v {
+				v = x
+			}
+		}
+	case "max":
+		for _, x := range values[1:] {
+			if x > v {
+				v = x
+			}
+		}
+	case "last":
+		v = values[len(values)-1]
+	}
+	return v
+}
+
+// downSampleTable is a table whose files are acquired for down sampling
+type downSampleTable struct {
+	name     string
+	orders   []TSSPFile
+	unorders []TSSPFile
+	paths    []string
+}
+
+// DownSampleState returns the down sample state of the shard
+func (m *MmsTables) DownSampleState() DownSampleState {
+	return m.downSample.State()
+}
+
+// DownSample aggregates the data of all measurements into windows of interval, keeping the calls of each field type.
+// The first level replaces the raw data of each measurement with one table per call named by DownSampleTableName,
+// and the following levels aggregate these tables again into larger windows.
+func (m *MmsTables) DownSample(level int, interval int64, calls map[int][]string) error {
+	m.downSampleLock.Lock()
+	defer m.downSampleLock.Unlock()
+
+	state := m.downSample.State()
+	if level <= state.Level {
+		return nil
+	}
+	if interval <= 0 {
+		return fmt.Errorf("invalid downsample interval %d", interval)
+	}
+
+	if m.isClosed() {
+		return ErrCompStopped
+	}
+	m.wg.Add(1)
+	defer m.wg.Done()
+
+	m.tombstoneLock.RLock()
+	defer m.tombstoneLock.RUnlock()
+
+	if state.Level == 0 {
+		return m.downSampleRaw(level, interval, calls)
+	}
+	return m.downSampleAgain(level, interval, state)
+}
+
+func (m *MmsTables) downSampleRaw(level int, interval int64, calls map[int][]string) error {
+	// the down sampled tables of a previous run which was interrupted before the state was saved
+	for _, name := range m.tableNames(true) {
+		if err := m.DropMeasurement(context.Background(), name); err != nil {
+			return err
+		}
+	}
+
+	tables, err := m.acquireDownSampleTables(false)
+	if err != nil {
+		return err
+	}
+	defer m.releaseDownSampleTables(tables)
+
+	ops := (&DownSampleState{Calls: calls}).TableOps()
+	aggregate := func(rec *record.Record, op string) *record.Record {
+		return DownSampleRecord(rec, op, interval, calls)
+	}
+
+	var pending []string
+	for _, tbl := range tables {
+		outputs := make(map[string]string, len(ops))
+		for _, op := range ops {
+			outputs[op] = DownSampleTableName(tbl.name, op)
+		}
+		newFiles, err := m.downSampleFiles(tbl, outputs, aggregate)
+		if err != nil {
+			return err
+		}
+		for name, files := range newFiles {
+			if err = RenameTmpFiles(files); err != nil {
+				return err
+			}
+			m.AddTSSPFiles(name, true, files...)
+		}
+
+		for _, f := range append(tbl.orders, tbl.unorders...) {
+			pending = append(pending, tombstoneKey(m.path, f.Path()))
+		}
+	}
+
+	// queries read the down sampled tables once the state is saved,
+	// the raw files are removed again when the shard is opened if they are not removed below
+	state := DownSampleState{Level: level, Interval: interval, Calls: calls, pending: pending}
+	if err = m.downSample.Save(state); err != nil {
+		return err
+	}
+	for _, tbl := range tables {
+		m.removeTableFiles(tbl.name, tbl.orders, true)
+		m.removeTableFiles(tbl.name, tbl.unorders, false)
+	}
+
+	state.pending = nil
+	return m.downSample.Save(state)
+}
+
+func (m *MmsTables) downSampleAgain(level int, interval int64, state DownSampleState) error {
+	if interval%state.Interval != 0 {
+		return fmt.Errorf("downsample interval %d is not a multiple of the current interval %d", interval, state.Interval)
+	}
+
+	tables, err := m.acquireDownSampleTables(true)
+	if err != nil {
+		return err
+	}
+	defer m.releaseDownSampleTables(tables)
+
+	aggregate := func(rec *record.Record, op string) *record.Record {
+		return reDownSampleRecord(rec, op, interval)
+	}
+	for _, tbl := range tables {
+		op := tbl.name[strings.LastIndex(tbl.name, DownSampleTableSep)+1:]
+		newFiles, err := m.downSampleFiles(tbl, map[string]string{op: tbl.name}, aggregate)
+		if err != nil {
+			return err
+		}
+		if err = m.ReplaceFiles(tbl.name, tbl.orders, newFiles[tbl.name], true, CLog); err != nil {
+			return err
+		}
+	}
+
+	return m.downSample.Save(DownSampleState{Level: level, Interval: interval, Calls: state.Calls})
+}
+
+// downSampleFiles aggregates the files of tbl by each op of outputs into new tmp files of the output table
+func (m *MmsTables) downSampleFiles(tbl *downSampleTable, outputs map[string]string,
+	aggregate func(rec *record.Record, op string) *record.Record) (map[string][]TSSPFile, error) {
+	orders := m.newDownSampleIterators(tbl.name, tbl.orders, true)
+	defer orders.Close()
+	unorders := m.newDownSampleIterators(tbl.name, tbl.unorders, false)
+	defer unorders.Close()
+
+	builders := make(map[string]*MsBuilder, len(outputs))
+	defer func() {
+		for _, b := range builders {
+			PutMsBuilder(b)
+		}
+	}()
+
+	merged := &record.Record{}
+	sortAux := &record.SortAux{}
+	write := func(id uint64, rec *record.Record) error {
+		// later rows win when deduplicating, so that out of order rows overwrite the ordered ones
+		sortAux.InitRecord(rec.Schema)
+		rec.SortAndDedupe(sortAux)
+		rec = sortAux.SortRec
+
+		for op, name := range outputs {
+			aggregated := aggregate(rec, op)
+			if aggregated == nil {
+				continue
+			}
+
+			b, ok := builders[op]
+			if !ok {
+				fileName := NewTSSPFileName(m.NextSequence(), 0, 0, 0, true)
+				b = AllocMsBuilder(m.path, name, m.Conf, orders.maxN+unorders.maxN, fileName, m.Tier(), nil,
+					orders.estimateSize+unorders.estimateSize)
+				b.WithLog(CLog)
+			}
+			var err error
+			b, err = b.WriteRecord(id, aggregated, func(fn TSSPFileName) (uint64, uint16, uint16, uint16) {
+				return fn.seq, fn.level, 0, fn.extent + 1
+			})
+			builders[op] = b
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	oid, orec, err := orders.Next()
+	if err != nil {
+		return nil, err
+	}
+	uid, urec, err := unorders.Next()
+	if err != nil {
+		return nil, err
+	}
+	for oid != 0 || uid != 0 {
+		if m.isClosed() {
+			return nil, ErrCompStopped
+		}
+
+		id := oid
+		if oid == 0 || (uid != 0 && uid < oid) {
+			id = uid
+		}
+
+		merged.Reset()
+		if id == oid {
+			merged.SetSchema(orec.Schema)
+			merged.ReserveColVal(len(orec.Schema))
+			merged.Merge(orec)
+			if oid, orec, err = orders.Next(); err != nil {
+				return nil, err
+			}
+		}
+		if id == uid {
+			if merged.RowNums() == 0 {
+				merged.SetSchema(urec.Schema)
+				merged.ReserveColVal(len(urec.Schema))
+			}
+			merged.Merge(urec)
+			if uid, urec, err = unorders.Next(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = write(id, merged); err != nil {
+			return nil, err
+		}
+	}
+
+	newFiles := make(map[string][]TSSPFile, len(builders))
+	for op, b := range builders {
+		if b.Size() > 0 {
+			f, err := b.NewTSSPFile(true)
+			if err != nil {
+				return nil, err
+			}
+			if f != nil {
+				b.Files = append(b.Files, f)
+			}
+		} else {
+			b.removeEmptyFile()
+		}
+		if len(b.Files) > 0 {
+			newFiles[outputs[op]] = append([]TSSPFile(nil), b.Files...)
+		}
+	}
+	return newFiles, nil
+}
+
+func (m *MmsTables) newDownSampleIterators(name string, files []TSSPFile, order bool) *ChunkIterators {
+	var fi FilesInfo
+	fi.name = name
+	fi.dropping = new(int64)
+	if fs := m.tableFiles(name, order); fs != nil {
+		fi.dropping = &fs.closing
+	}
+
+	for _, f := range files {
+		// the iterator unrefs the file when it is closed
+		f.Ref()
+		itr := NewFileIterator(f, CLog)
+		if !itr.NextChunkMeta() {
+			itr.Close()
+			continue
+		}
+		fi.compIts = append(fi.compIts, itr)
+		if fi.maxChunkN < itr.chunkN {
+			fi.maxChunkN = itr.chunkN
+		}
+		fi.estimateSize += int(f.FileSize())
+	}
+
+	itrs, _ := m.NewChunkIterators(fi)
+	itrs.WithLog(CLog)
+	return itrs
+}
+
+// tableNames returns the names of the down sampled tables if downSampled is true, otherwise the raw tables
+func (m *MmsTables) tableNames(downSampled bool) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	seen := make(map[string]struct{}, len(m.Order))
+	names := make([]string, 0, len(m.Order))
+	for _, tables := range []map[string]*TSSPFiles{m.Order, m.OutOfOrder} {
+		for name := range tables {
+			if _, ok := seen[name]; ok || isDownSampleTable(name) != downSampled {
+				continue
+			}
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// downSampleTables returns the names of the down sampled tables of measurement name
+func (m *MmsTables) downSampleTables(name string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var names []string
+	prefix := name + DownSampleTableSep
+	for tbl := range m.Order {
+		if strings.HasPrefix(tbl, prefix) {
+			names = append(names, tbl)
+		}
+	}
+	return names
+}
+
+// acquireDownSampleTables refs the files of all tables and keeps them from being compacted or merged,
+// ErrDownSampleBusy is returned if any table is being compacted or merged
+func (m *MmsTables) acquireDownSampleTables(downSampled bool) ([]*downSampleTable, error) {
+	var tables []*downSampleTable
+	for _, name := range m.tableNames(downSampled) {
+		if !m.inMerge.Add(name) {
+			m.releaseDownSampleTables(tables)
+			return nil, ErrDownSampleBusy
+		}
+
+		tbl := &downSampleTable{
+			name:     name,
+			orders:   m.GetFilesRef(name, true),
+			unorders: m.GetFilesRef(name, false),
+		}
+		tables = append(tables, tbl)
+
+		paths := make([]string, 0, len(tbl.orders))
+		for _, f := range tbl.orders {
+			paths = append(paths, f.Path())
+		}
+		if !m.acquire(paths) {
+			m.releaseDownSampleTables(tables)
+			return nil, ErrDownSampleBusy
+		}
+		tbl.paths = paths
+
+		// a compaction done between getting and acquiring the files may have replaced them
+		if !m.containsFiles(name, tbl.orders, true) {
+			m.releaseDownSampleTables(tables)
+			return nil, ErrDownSampleBusy
+		}
+	}
+	return tables, nil
+}
+
+func (m *MmsTables) releaseDownSampleTables(tables []*downSampleTable) {
+	for _, tbl := range tables {
+		UnrefFiles(tbl.orders...)
+		UnrefFiles(tbl.unorders...)
+		m.CompactDone(tbl.paths)
+		m.inMerge.Del(tbl.name)
+	}
+}
+
+func (m *MmsTables) containsFiles(name string, files []TSSPFile, order bool) bool {
+	fs := m.tableFiles(name, order)
+	if fs == nil {
+		return len(files) == 0
+	}
+
+	fs.lock.RLock()
+	defer fs.lock.RUnlock()
+	for _, f := range files {
+		if fs.fileIndex(f) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// removeTableFiles removes files from the table and the disk
+func (m *MmsTables) removeTableFiles(name string, files []TSSPFile, order bool) {
+	if len(files) == 0 {
+		return
+	}
+
+	m.mu.RLock()
+	tables := m.Order
+	if !order {
+		tables = m.OutOfOrder
+	}
+	fs, ok := tables[name]
+	if !ok {
+		m.mu.RUnlock()
+		return
+	}
+
+	fs.lock.Lock()
+	tombstoneKeys := m.tombstoneKeys(files)
+	for _, f := range files {
+		if fs.fileIndex(f) < 0 {
+			continue
+		}
+		fs.deleteFile(f)
+		m.removeFile(f)
+	}
+	m.removeTombstones(tombstoneKeys)
+	noFiles := fs.Len() == 0
+	fs.lock.Unlock()
+
+	m.mu.RUnlock()
+
+	if noFiles {
+		m.mu.Lock()
+		fs, ok = tables[name]
+		if ok && fs.Len() == 0 {
+			delete(tables, name)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// loadDownSample removes the raw files which have been down sampled before the shard was closed
+func (m *MmsTables) loadDownSample() error {
+	if err := m.downSample.Load(); err != nil {
+		return err
+	}
+
+	state := m.downSample.State()
+	if len(state.pending) == 0 {
+		return nil
+	}
+
+	pending := make(map[string]struct{}, len(state.pending))
+	for _, key := range state.pending {
+		pending[key] = struct{}{}
+	}
+	remove := func(tables map[string]*TSSPFiles, order bool) {
+		for _, name := range m.tableNames(false) {
+			fs, ok := tables[name]
+			if !ok {
+				continue
+			}
+			var files []TSSPFile
+			for _, f := range fs.files {
+				if _, ok := pending[tombstoneKey(m.path, f.Path())]; ok {
+					files = append(files, f)
+				}
+			}
+			m.removeTableFiles(name, files, order)
+		}
+	}
+	remove(m.Order, true)
+	remove(m.OutOfOrder, false)
+
+	state.pending = nil
+	return m.downSample.Save(state)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownSampleFile_SaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	f := NewDownSampleFile(dir)
+	require.NoError(t, f.Load())
+	assert.Equal(t, 0, f.State().Level)
+
+	state := DownSampleState{
+		Level:    2,
+		Interval: 600e9,
+		Calls: map[int][]string{
+			influx.Field_Type_Float: {"mean", "max"},
+			influx.Field_Type_Int:   {"sum"},
+		},
+		pending: []string{"mst_0000/00000001-0000-00000000.tssp"},
+	}
+	require.NoError(t, f.Save(state))
+
+	loaded := NewDownSampleFile(dir)
+	require.NoError(t, loaded.Load())
+	assert.Equal(t, state, loaded.State())
+	assert.True(t, state.HasCall(influx.Field_Type_Float, "max"))
+	assert.False(t, state.HasCall(influx.Field_Type_Int, "max"))
+	assert.True(t, state.HasTable(influx.Field_Type_Float, "count"))
+	assert.False(t, state.HasTable(influx.Field_Type_Float, "mean"))
+	assert.Equal(t, []string{"count", "max", "sum"}, state.TableOps())
+
+	buf := marshalDownSampleState(nil, &state)
+	_, err := unmarshalDownSampleState(buf[:len(buf)-1])
+	assert.Equal(t, errDownSampleCorrupted, err)
+}
+
+func TestDownSampleRecord(t *testing.T) {
+	rec := record.NewRecordBuilder(schema)
+	for i := 0; i < 10; i++ {
+		if i%5 == 4 {
+			rec.Column(0).AppendFloatNull()
+		} else {
+			rec.Column(0).AppendFloat(float64(i))
+		}
+		rec.Column(1).AppendInteger(int64(i))
+		rec.Column(2).AppendBoolean(i%2 == 0)
+		rec.Column(3).AppendString("v")
+		rec.Column(4).AppendInteger(int64(i) - 5)
+	}
+	calls := map[int][]string{
+		influx.Field_Type_Float: {"mean", "max"},
+		influx.Field_Type_Int:   {"max"},
+	}
+
+	// windows of [-5, 0) and [0, 5), mean is kept as sum and count
+	assert.Nil(t, DownSampleRecord(rec, "mean", 5, calls))
+	sum := DownSampleRecord(rec, "sum", 5, calls)
+	require.NotNil(t, sum)
+	assert.Equal(t, 2, sum.Len())
+	assert.Equal(t, []int64{-5, 0}, sum.Times())
+	assert.Equal(t, []float64{6, 26}, sum.ColVals[0].FloatValues())
+	count := DownSampleRecord(rec, "count", 5, calls)
+	require.NotNil(t, count)
+	assert.Equal(t, influx.Field_Type_Int, count.Schema[0].Type)
+	assert.Equal(t, []int64{4, 4}, count.ColVals[0].IntegerValues())
+
+	// counts are added up when aggregated again
+	count = record.NewRecordBuilder(count.Schema)
+	count.ColVals[0].AppendIntegers(4, 3)
+	count.ColVals[1].AppendIntegers(0, 5)
+	again := reDownSampleRecord(count, "count", 10)
+	require.NotNil(t, again)
+	assert.Equal(t, []int64{0}, again.Times())
+	assert.Equal(t, []int64{7}, again.ColVals[0].IntegerValues())
+
+	max := DownSampleRecord(rec, "max", 5, calls)
+	require.NotNil(t, max)
+	assert.Equal(t, 3, max.Len())
+	assert.Equal(t, []float64{3, 8}, max.ColVals[0].FloatValues())
+	assert.Equal(t, []int64{4, 9}, max.ColVals[1].IntegerValues())
+
+	assert.Nil(t, DownSampleRecord(rec, "min", 5, calls))
+	assert.Equal(t, int64(-10), windowStart(-6, 5))
+	assert.Equal(t, int64(-5), windowStart(-5, 5))
+}
@@ -34,6 +34,9 @@ const (
 	// BlockInteger designates a block encodes int64 values.
 	BlockInteger = byte(influx.Field_Type_Int)
 
+	// BlockUnsigned designates a block encodes uint64 values.
+	BlockUnsigned = byte(influx.Field_Type_UInt)
+
 	// BlockBoolean designates a block encodes boolean values.
 	BlockBoolean = byte(influx.Field_Type_Boolean)
 
@@ -19,6 +19,7 @@ package immutable
 import (
 	safeRand "crypto/rand"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"testing"
@@ -377,6 +378,51 @@ func TestEncoding_IntBlock_Negatives(t *testing.T) {
 	intTest(record.Int64Slice2byte(arr))
 }
 
+func TestEncoding_UnsignedBlock(t *testing.T) {
+	values := []uint64{0, 1, math.MaxInt64, math.MaxInt64 + 1, math.MaxUint64}
+	out, err := EncodeUnsignedBlock(record.Uint64Slice2byte(values), nil, decs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decOut []byte
+	outValues, err := DecodeUnsignedBlock(out, &decOut, decs)
+	if err != nil {
+		t.Fatalf("unexpected error decoding block: %v", err)
+	}
+	if !reflect.DeepEqual(outValues, values) {
+		t.Fatalf("unexpected results:\n\tgot: %v\n\texp: %v\n", outValues, values)
+	}
+}
+
+func TestUnsignedPreAgg(t *testing.T) {
+	col := &record.ColVal{}
+	col.AppendUnsigneds(5, math.MaxUint64, 7)
+	a := NewUnsignedPreAgg()
+	a.addValues(col, []int64{1, 2, 3})
+
+	b := NewUnsignedPreAgg()
+	buf := a.marshal(nil)
+	if _, err := b.unmarshal(buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	other := NewUnsignedPreAgg()
+	col.Init()
+	col.AppendUnsigned(2)
+	other.addValues(col, []int64{4})
+	b.merge(other)
+
+	if v, tm := b.min(); v.(uint64) != 2 || tm != 4 {
+		t.Fatalf("unexpected min %v at %d", v, tm)
+	}
+	if v, tm := b.max(); v.(uint64) != math.MaxUint64 || tm != 2 {
+		t.Fatalf("unexpected max %v at %d", v, tm)
+	}
+	if b.count() != 4 {
+		t.Fatalf("unexpected count %d", b.count())
+	}
+}
+
 func TestEncoding_BooleanBlock_Basic(t *testing.T) {
 	boolTest := func(preData []byte, valueCount int) {
 		values := make([]bool, valueCount)
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"github.com/openGemini/openGemini/lib/record"
+)
+
+// Measurements returns the names of the measurements with flushed files, the down sampled tables are left out
+func (m *MmsTables) Measurements() []string {
+	return m.tableNames(false)
+}
+
+// ReadSeries reads the series of measurement name in the order or out of order files from the series sid on, in the
+// order of the series ids. The rows of a series are merged across the files and fn is called with each series until
+// it returns false. The record passed to fn is reused once fn returns.
+func (m *MmsTables) ReadSeries(name string, isOrder bool, sid uint64, fn func(id uint64, rec *record.Record) bool) error {
+	files := m.GetFilesRef(name, isOrder)
+	defer UnrefFiles(files...)
+
+	var fi FilesInfo
+	fi.name = name
+	fi.dropping = new(int64)
+	if fs := m.tableFiles(name, isOrder); fs != nil {
+		fi.dropping = &fs.closing
+	}
+	for _, f := range files {
+		// the iterator unrefs the file when it is closed
+		f.Ref()
+		itr := NewFileIterator(f, CLog)
+		if !itr.skipTo(sid) {
+			itr.Close()
+			continue
+		}
+		fi.compIts = append(fi.compIts, itr)
+	}
+
+	itrs, _ := m.NewChunkIterators(fi)
+	itrs.WithLog(CLog)
+	defer itrs.Close()
+	for {
+		id, rec, err := itrs.Next()
+		if err != nil || id == 0 {
+			return err
+		}
+		if !fn(id, rec) {
+			return nil
+		}
+	}
+}
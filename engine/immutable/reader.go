@@ -370,6 +370,11 @@ func getColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) interfa
 		if isNil {
 			return nil
 		}
+	case influx.Field_Type_UInt:
+		value, isNil = col.UnsignedValue(rowIndex)
+		if isNil {
+			return nil
+		}
 	case influx.Field_Type_Float:
 		value, isNil = col.FloatValue(rowIndex)
 		if isNil {
@@ -399,6 +404,14 @@ func reserveColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) {
 		} else {
 			col.AppendIntegerNull()
 		}
+	case influx.Field_Type_UInt:
+		value, isNil := col.UnsignedValue(rowIndex)
+		col.Init()
+		if !isNil {
+			col.AppendUnsigned(value)
+		} else {
+			col.AppendUnsignedNull()
+		}
 	case influx.Field_Type_Float:
 		value, isNil := col.FloatValue(rowIndex)
 		col.Init()
@@ -431,6 +444,9 @@ func setColumnDefaultValue(ref *record.Field, col *record.ColVal) {
 	case influx.Field_Type_Int:
 		col.Init()
 		col.AppendInteger(int64(0))
+	case influx.Field_Type_UInt:
+		col.Init()
+		col.AppendUnsigned(uint64(0))
 	case influx.Field_Type_Float:
 		col.Init()
 		col.AppendFloat(float64(0))
@@ -517,6 +533,33 @@ func appendIntegerColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte,
 	return nil
 }
 
+func appendUnsignedColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
+	col.Init()
+	if len(encData) != 0 {
+		values, err := DecodeUnsignedBlock(encData, &col.Val, ctx.coderCtx)
+		if err != nil {
+			return err
+		}
+
+		rows := len(values) + int(nilCount)
+		col.ReserveBitmap(len(col.Val))
+		col.AppendBitmap(nilBitmap, int(bitmapOffset), rows, 0, rows)
+
+		if !ctx.Ascending {
+			_ = reverseUnsignedValues(values)
+			col.Bitmap = record.ReverseBitMap(col.Bitmap, uint32(col.BitMapOffset), rows)
+		}
+
+		col.Len += rows
+		col.NilCount += int(nilCount)
+	} else {
+		rows := int(nilCount)
+		col.Append(nil, nil, nilBitmap, int(bitmapOffset), rows, int(nilCount), influx.Field_Type_UInt, 0, rows)
+	}
+
+	return nil
+}
+
 func appendFloatColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
 	col.Init()
 	if len(encData) != 0 {
@@ -605,10 +648,11 @@ func appendStringColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, n
 	return nil
 }
 
-var decFuncs = make(map[int]func(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, decoders *ReadContext) error, 4)
+var decFuncs = make(map[int]func(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, decoders *ReadContext) error, 5)
 
 func InitDecFunctions() {
 	decFuncs[influx.Field_Type_Int] = appendIntegerColumn
+	decFuncs[influx.Field_Type_UInt] = appendUnsignedColumn
 	decFuncs[influx.Field_Type_Float] = appendFloatColumn
 	decFuncs[influx.Field_Type_Boolean] = appendBooleanColumn
 	decFuncs[influx.Field_Type_String] = appendStringColumn
@@ -717,6 +761,10 @@ type Location struct {
 	r      TSSPFile
 	meta   *ChunkMeta
 	segPos int
+
+	// tombstones of the current series in the file
+	tombstones []Tombstone
+	liveRanges []record.TimeRange
 }
 
 func NewLocation(r TSSPFile, decs *ReadContext) *Location {
@@ -945,6 +993,14 @@ func (l *Location) readChunkMeta(id uint64, tr record.TimeRange) error {
 		return nil
 	}
 
+	l.tombstones = l.tombstones[:0]
+	if l.r.HasTombstones() {
+		l.tombstones = seriesTombstones(l.r.Tombstones(), id, l.tombstones)
+		if len(l.tombstones) > 0 && len(l.chunkLiveRanges(meta, tr)) == 0 {
+			return nil
+		}
+	}
+
 	l.meta = meta
 	if !l.decs.Ascending {
 		l.segPos = int(meta.segCount) - 1
@@ -1043,6 +1099,12 @@ func (l *Location) readData(filterOpts *FilterOptions, dst *record.Record) (*rec
 			continue
 		}
 
+		if l.isPreAggRead() && len(l.tombstones) > 0 {
+			rec, err = l.readPreAggWithTombstones(dst)
+			l.nextSegment()
+			return rec, err
+		}
+
 		rec, err = l.r.ReadAt(l.meta, l.segPos, dst, l.decs)
 		if err != nil {
 			return nil, err
@@ -1060,6 +1122,7 @@ func (l *Location) readData(filterOpts *FilterOptions, dst *record.Record) (*rec
 				rec = FilterByTimeDescend(rec, l.decs.tr)
 			}
 		}
+		rec = FilterByTombstones(rec, l.tombstones)
 		// filter by field
 		if rec != nil {
 			rec = FilterByField(rec, filterOpts.filtersMap, filterOpts.cond, filterOpts.fieldsIdx,
@@ -1070,6 +1133,46 @@ func (l *Location) readData(filterOpts *FilterOptions, dst *record.Record) (*rec
 	return rec, nil
 }
 
+// chunkLiveRanges returns the time ranges of the chunk within tr which are not deleted
+func (l *Location) chunkLiveRanges(cm *ChunkMeta, tr record.TimeRange) []record.TimeRange {
+	min, max := cm.MinMaxTime()
+	if min < tr.Min {
+		min = tr.Min
+	}
+	if max > tr.Max {
+		max = tr.Max
+	}
+	l.liveRanges = liveTimeRanges(record.TimeRange{Min: min, Max: max}, l.tombstones, l.liveRanges)
+	return l.liveRanges
+}
+
+// readPreAggWithTombstones aggregates each live time range of the chunk separately,
+// the pre-aggregated column meta can not be used for the deleted rows
+func (l *Location) readPreAggWithTombstones(dst *record.Record) (*record.Record, error) {
+	tr := l.decs.tr
+	defer func() {
+		l.decs.tr = tr
+	}()
+
+	var rec *record.Record
+	for _, r := range l.chunkLiveRanges(l.meta, tr) {
+		l.decs.tr = r
+		tmp, err := l.r.ReadAt(l.meta, l.segPos, dst, l.decs)
+		if err != nil {
+			return nil, err
+		}
+		if tmp == nil {
+			continue
+		}
+		if rec == nil {
+			rec = tmp.Copy()
+			continue
+		}
+		AggregateData(rec, tmp, l.decs.ops)
+	}
+	return rec, nil
+}
+
 func (l *Location) readMeta(filterOpts *FilterOptions, dst *record.Record) (*record.Record, error) {
 	if l.decs.preAggBuilders == nil {
 		l.decs.preAggBuilders = newPreAggBuilders()
@@ -1192,6 +1295,14 @@ func initIgnoreTypeFun() {
 		return value
 	}
 
+	ignoreTypeFun[influxql.Unsigned] = func(i int, col record.ColVal) interface{} {
+		value, isNil := col.UnsignedValue(i)
+		if isNil {
+			return (*uint64)(nil)
+		}
+		return value
+	}
+
 	ignoreTypeFun[influxql.Float] = func(i int, col record.ColVal) interface{} {
 		value, isNil := col.FloatValue(i)
 		if isNil {
@@ -1226,6 +1337,15 @@ func reverseIntergerValues(values []int64) []int64 {
 	return values
 }
 
+func reverseUnsignedValues(values []uint64) []uint64 {
+	for i, j := 0, len(values)-1; i < j; {
+		values[i], values[j] = values[j], values[i]
+		i++
+		j--
+	}
+	return values
+}
+
 func reverseFloatValues(values []float64) []float64 {
 	for i, j := 0, len(values)-1; i < j; {
 		values[i], values[j] = values[j], values[i]
@@ -1376,6 +1496,8 @@ func readAuxData(cm *ChunkMeta, segment int, rowIndex int, dst *record.Record, d
 				col.AppendFloatNull()
 			case influx.Field_Type_Int:
 				col.AppendIntegerNull()
+			case influx.Field_Type_UInt:
+				col.AppendUnsignedNull()
 			case influx.Field_Type_String:
 				col.AppendStringNull()
 			case influx.Field_Type_Boolean:
@@ -58,7 +58,7 @@ var (
 
 type FileIterator struct {
 	r          TSSPFile
-	tombstones []TombstoneFile
+	tombstones []Tombstone
 	err        error
 	chunkN     int
 	chunkUsed  int
@@ -97,6 +97,7 @@ func NewFileIterator(r TSSPFile, log *Log.Logger) *FileIterator {
 	}
 
 	fi.r = r
+	fi.tombstones = r.Tombstones()
 	fi.chunkN = int(trailer.idCount)
 	fi.mIndexN = int(trailer.metaIndexItemNum)
 	fi.log = log
@@ -109,7 +110,7 @@ func NewFileIterator(r TSSPFile, log *Log.Logger) *FileIterator {
 
 func (itr *FileIterator) reset() {
 	itr.r = nil
-	itr.tombstones = itr.tombstones[:0]
+	itr.tombstones = nil
 	itr.err = nil
 	itr.chunkN = 0
 	itr.chunkUsed = 0
@@ -240,6 +241,18 @@ func (itr *FileIterator) NextChunkMeta() bool {
 	return true
 }
 
+// skipTo skips the chunks of the series before sid, it returns false if the file has no chunk of a series from sid on
+func (itr *FileIterator) skipTo(sid uint64) bool {
+	for itr.NextChunkMeta() {
+		if itr.curtChunkMeta.sid >= sid {
+			return true
+		}
+		itr.curtChunkMeta = nil
+		itr.chunkUsed++
+	}
+	return false
+}
+
 type FileIterators []*FileIterator
 
 func (m *MmsTables) NewFileIterators(group *CompactGroup) (FilesInfo, error) {
@@ -332,6 +345,13 @@ func (i FileIterators) MaxColumns() int {
 }
 
 func NonStreamingCompaction(fi FilesInfo) bool {
+	// only the chunk iterator drops the deleted rows
+	for _, f := range fi.oldFiles {
+		if f.HasTombstones() {
+			return true
+		}
+	}
+
 	flag := MergeFlag()
 	if flag == NonStreamingCompact {
 		return true
@@ -560,6 +580,15 @@ func (c *StreamIterators) swapLastSegment(ref *record.Field, col *record.ColVal)
 				c.tmpCol.AppendInteger(v)
 			}
 		}
+	case influx.Field_Type_UInt:
+		for i := 0; i < col.Len; i++ {
+			v, isNil := col.UnsignedValue(i)
+			if isNil {
+				c.tmpCol.AppendUnsignedNull()
+			} else {
+				c.tmpCol.AppendUnsigned(v)
+			}
+		}
 	case influx.Field_Type_String:
 		for i := 0; i < col.Len; i++ {
 			v, isNil := col.StringValueUnsafe(i)
@@ -660,6 +689,8 @@ func (c *StreamIterators) padRows(rows int, ref *record.Field) {
 		c.col.AppendFloatNulls(rows)
 	case influx.Field_Type_Int:
 		c.col.AppendIntegerNulls(rows)
+	case influx.Field_Type_UInt:
+		c.col.AppendUnsignedNulls(rows)
 	}
 }
 
@@ -1508,6 +1539,8 @@ func (b *ColumnBuilder) encodeColumn(segCols []record.ColVal, offset int64, ref
 			b.data, err = EncodeFloatBlock(segCol.Val, b.data, b.coder)
 		case influx.Field_Type_Int:
 			b.data, err = EncodeIntegerBlock(segCol.Val, b.data, b.coder)
+		case influx.Field_Type_UInt:
+			b.data, err = EncodeUnsignedBlock(segCol.Val, b.data, b.coder)
 		default:
 			panic(ref)
 		}
@@ -1557,6 +1590,9 @@ func (b *ColumnBuilder) encodeColumnCalcPreAgg(segCols []record.ColVal, tmCols [
 		case influx.Field_Type_Int:
 			b.data, err = EncodeIntegerBlock(segCol.Val, b.data, b.coder)
 			b.intPreAggBuilder.addValues(segCol, times)
+		case influx.Field_Type_UInt:
+			b.data, err = EncodeUnsignedBlock(segCol.Val, b.data, b.coder)
+			b.unsignedPreAggBuilder.addValues(segCol, times)
 		default:
 			panic(ref)
 		}
@@ -1623,6 +1659,34 @@ func (c *StreamIterators) mergeIntegerPreAgg(cm *ColumnMeta, ref *record.Field)
 	return nil
 }
 
+func (c *StreamIterators) mergeUnsignedPreAgg(cm *ColumnMeta, ref *record.Field) error {
+	ab := c.colBuilder.unsignedPreAggBuilder
+	if c.chunkSegments > c.Conf.maxSegmentLimit {
+		cm.preAgg = ab.marshal(cm.preAgg[:0])
+		return nil
+	}
+
+	aggBuilder := c.ctx.preAggBuilders.unsignedBuilder
+	aggBuilder.reset()
+	for i := 0; i < len(c.chunkItrs); i++ {
+		itr := c.chunkItrs[i]
+		idx := itr.curtChunkMeta.columnIndex(ref)
+		if idx < 0 {
+			continue
+		}
+		srcMeta := &itr.curtChunkMeta.colMeta[idx]
+		ab.reset()
+		if _, err := ab.unmarshal(srcMeta.preAgg); err != nil {
+			c.log.Error("unmarshal preagg fail", zap.String("column", ref.String()))
+			return err
+		}
+		aggBuilder.(*UnsignedPreAgg).merge(ab.(*UnsignedPreAgg))
+	}
+
+	cm.preAgg = aggBuilder.marshal(cm.preAgg[:0])
+	return nil
+}
+
 func (c *StreamIterators) mergeFloatPreAgg(cm *ColumnMeta, ref *record.Field) error {
 	ab := c.colBuilder.floatPreAggBuilder
 	if c.chunkSegments > c.Conf.maxSegmentLimit {
@@ -1757,6 +1821,8 @@ func (c *StreamIterators) genColumnPreAgg(cm *ColumnMeta) error {
 		} else {
 			err = c.mergeIntegerPreAgg(cm, ref)
 		}
+	case influx.Field_Type_UInt:
+		err = c.mergeUnsignedPreAgg(cm, ref)
 	case influx.Field_Type_Float:
 		err = c.mergeFloatPreAgg(cm, ref)
 	case influx.Field_Type_Boolean:
@@ -271,11 +271,12 @@ type ColumnBuilder struct {
 	colMeta *ColumnMeta
 	segCol  []record.ColVal
 
-	intPreAggBuilder    PreAggBuilder
-	floatPreAggBuilder  PreAggBuilder
-	stringPreAggBuilder PreAggBuilder
-	boolPreAggBuilder   PreAggBuilder
-	timePreAggBuilder   PreAggBuilder
+	intPreAggBuilder      PreAggBuilder
+	unsignedPreAggBuilder PreAggBuilder
+	floatPreAggBuilder    PreAggBuilder
+	stringPreAggBuilder   PreAggBuilder
+	boolPreAggBuilder     PreAggBuilder
+	timePreAggBuilder     PreAggBuilder
 
 	coder *CoderContext
 	log   *Log.Logger
@@ -291,6 +292,9 @@ func (b *ColumnBuilder) resetPreAgg() {
 	if b.intPreAggBuilder != nil {
 		b.intPreAggBuilder.reset()
 	}
+	if b.unsignedPreAggBuilder != nil {
+		b.unsignedPreAggBuilder.reset()
+	}
 	if b.floatPreAggBuilder != nil {
 		b.floatPreAggBuilder.reset()
 	}
@@ -321,6 +325,15 @@ func (b *ColumnBuilder) initEncoder(ref record.Field) error {
 		}
 		b.intPreAggBuilder.reset()
 		return nil
+	case influx.Field_Type_UInt:
+		if b.coder.intCoder == nil {
+			b.coder.intCoder = GetInterCoder()
+		}
+		if b.unsignedPreAggBuilder == nil {
+			b.unsignedPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+		}
+		b.unsignedPreAggBuilder.reset()
+		return nil
 	case influx.Field_Type_Float:
 		if b.coder.floatCoder == nil {
 			b.coder.floatCoder = GetFloatCoder()
@@ -398,6 +411,49 @@ func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []rec
 	return err
 }
 
+func (b *ColumnBuilder) encUnsignedColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
+	var err error
+	if b.unsignedPreAggBuilder == nil {
+		b.unsignedPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+	}
+	b.unsignedPreAggBuilder.reset()
+
+	for i := range segCols {
+		segCol := &segCols[i]
+		tmCol := timeCols[i]
+		if segCol.Length() != tmCol.Length() {
+			err = fmt.Errorf("%v column rows not equal time rows, %v != %v", b.colMeta.name, segCol.Length(), tmCol.Length())
+			b.log.Error(err.Error())
+			panic(err)
+		}
+
+		times := tmCol.IntegerValues()
+		b.unsignedPreAggBuilder.addValues(segCol, times)
+		m := &b.colMeta.entries[i]
+		m.setOffset(offset)
+
+		pos := len(b.data)
+		b.data = append(b.data, BlockUnsigned)
+		nilBitMap, bitmapOffset := segCol.SubBitmapBytes()
+		b.data = numberenc.MarshalUint32Append(b.data, uint32(len(nilBitMap)))
+		b.data = append(b.data, nilBitMap...)
+		b.data = numberenc.MarshalUint32Append(b.data, uint32(bitmapOffset))
+		b.data = numberenc.MarshalUint32Append(b.data, uint32(segCol.NullN()))
+		b.data, err = EncodeUnsignedBlock(segCol.Val, b.data, b.coder)
+		if err != nil {
+			b.log.Error("encode unsigned value fail", zap.Error(err))
+			return err
+		}
+		size := uint32(len(b.data) - pos)
+		m.setSize(size)
+		offset += int64(size)
+	}
+
+	b.colMeta.preAgg = b.unsignedPreAggBuilder.marshal(b.colMeta.preAgg[:0])
+
+	return err
+}
+
 func (b *ColumnBuilder) encFloatColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
 	var err error
 	if b.floatPreAggBuilder == nil {
@@ -545,6 +601,8 @@ func (b *ColumnBuilder) EncodeColumn(ref record.Field, col *record.ColVal, timeC
 	switch ref.Type {
 	case influx.Field_Type_Int:
 		err = b.encIntegerColumn(timeCols, b.segCol, dataOffset)
+	case influx.Field_Type_UInt:
+		err = b.encUnsignedColumn(timeCols, b.segCol, dataOffset)
 	case influx.Field_Type_Float:
 		err = b.encFloatColumn(timeCols, b.segCol, dataOffset)
 	case influx.Field_Type_String:
@@ -1131,6 +1189,11 @@ func (b *MsBuilder) releaseEncoders() {
 					colBuilder.intPreAggBuilder = nil
 				}
 
+				if colBuilder.unsignedPreAggBuilder != nil {
+					colBuilder.unsignedPreAggBuilder.release()
+					colBuilder.unsignedPreAggBuilder = nil
+				}
+
 				if colBuilder.floatPreAggBuilder != nil {
 					colBuilder.floatPreAggBuilder.release()
 					colBuilder.floatPreAggBuilder = nil
@@ -16,18 +16,59 @@ limitations under the License.
 
 package immutable
 
-import "sync"
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+const (
+	TombstoneFileName = "tombstone"
+	tombstoneTmpName  = TombstoneFileName + tmpTsspFileSuffix
+
+	// key length + id + min time + max time
+	tombstoneEntryMinSize = 2 + 8 + 8 + 8
+)
+
+var tombstoneMagic = []byte("2022T5T5")
+
+var errTombstoneCorrupted = fmt.Errorf("tombstone file corrupted")
+
+// Tombstone marks the rows of series ID within [MinTime, MaxTime] as deleted
 type Tombstone struct {
 	ID               uint64
 	MinTime, MaxTime int64
 }
 
+func (t *Tombstone) Contains(id uint64, tm int64) bool {
+	return t.ID == id && t.MinTime <= tm && tm <= t.MaxTime
+}
+
+// TombstoneFile persists the tombstones of all tssp files in a shard.
+// Tombstones are grouped by the path of the tssp file relative to the tssp directory,
+// and are dropped together with the file when it is compacted or removed.
 type TombstoneFile struct {
 	mu   sync.RWMutex
 	path string
 
-	tombstones []Tombstone
+	tombstones map[string][]Tombstone
+}
+
+func NewTombstoneFile(shardDir string) *TombstoneFile {
+	return &TombstoneFile{
+		path:       filepath.Join(shardDir, TombstoneFileName),
+		tombstones: make(map[string][]Tombstone),
+	}
 }
 
 func (t *TombstoneFile) Path() string {
@@ -41,5 +82,432 @@ func (t *TombstoneFile) TombstonesCount() int {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	return len(t.tombstones)
+	n := 0
+	for _, ts := range t.tombstones {
+		n += len(ts)
+	}
+	return n
+}
+
+func (t *TombstoneFile) Tombstones(key string) []Tombstone {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.tombstones[key]
+}
+
+func (t *TombstoneFile) Set(key string, ts []Tombstone) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(ts) == 0 {
+		delete(t.tombstones, key)
+		return
+	}
+	t.tombstones[key] = ts
+}
+
+func (t *TombstoneFile) Remove(keys ...string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	removed := false
+	for _, key := range keys {
+		if _, ok := t.tombstones[key]; ok {
+			delete(t.tombstones, key)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// RemoveMeasurement removes the tombstones of all files belonging to the measurement
+func (t *TombstoneFile) RemoveMeasurement(name string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prefix := name + string(filepath.Separator)
+	removed := false
+	for key := range t.tombstones {
+		if strings.HasPrefix(key, prefix) {
+			delete(t.tombstones, key)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// Retain removes the tombstones of all files that are not in keys
+func (t *TombstoneFile) Retain(keys map[string]struct{}) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	removed := false
+	for key := range t.tombstones {
+		if _, ok := keys[key]; !ok {
+			delete(t.tombstones, key)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func (t *TombstoneFile) Load() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, err := fileops.Stat(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	lock := fileops.FileLockOption("")
+	buf, err := fileops.ReadFile(t.path, lock)
+	if err != nil {
+		log.Error("read tombstone file fail", zap.String("path", t.path), zap.Error(err))
+		return err
+	}
+
+	tombstones, err := unmarshalTombstones(buf)
+	if err != nil {
+		log.Error("unmarshal tombstone file fail", zap.String("path", t.path), zap.Error(err))
+		return err
+	}
+	t.tombstones = tombstones
+	return nil
+}
+
+// Flush writes all tombstones to a temporary file and renames it to the tombstone file,
+// the tombstone file is removed if there is no tombstone
+func (t *TombstoneFile) Flush() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	lock := fileops.FileLockOption("")
+	if len(t.tombstones) == 0 {
+		err := fileops.Remove(t.path, lock)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	buf := marshalTombstones(nil, t.tombstones)
+	tmpName := filepath.Join(filepath.Dir(t.path), tombstoneTmpName)
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_NORMAL)
+	fd, err := fileops.OpenFile(tmpName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640, lock, pri)
+	if err != nil {
+		log.Error("create tombstone file fail", zap.String("name", tmpName), zap.Error(err))
+		return err
+	}
+
+	s, err := fd.Write(buf)
+	if err != nil || s != len(buf) {
+		_ = fd.Close()
+		return fmt.Errorf("write tombstone file fail, write %v, size %v, err:%v", s, len(buf), err)
+	}
+
+	if err = fd.Sync(); err != nil {
+		_ = fd.Close()
+		return err
+	}
+
+	if err = fd.Close(); err != nil {
+		return err
+	}
+
+	return fileops.RenameFile(tmpName, t.path, lock)
+}
+
+func marshalTombstones(dst []byte, tombstones map[string][]Tombstone) []byte {
+	keys := make([]string, 0, len(tombstones))
+	for key := range tombstones {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, t := range tombstones[key] {
+			dst = numberenc.MarshalUint16Append(dst, uint16(len(key)))
+			dst = append(dst, key...)
+			dst = numberenc.MarshalUint64Append(dst, t.ID)
+			dst = numberenc.MarshalInt64Append(dst, t.MinTime)
+			dst = numberenc.MarshalInt64Append(dst, t.MaxTime)
+		}
+	}
+
+	crc := crc32.ChecksumIEEE(dst)
+	dst = numberenc.MarshalUint32Append(dst, crc)
+	dst = append(dst, tombstoneMagic...)
+	return dst
+}
+
+func unmarshalTombstones(buf []byte) (map[string][]Tombstone, error) {
+	if len(buf) < 4+len(tombstoneMagic) {
+		return nil, errTombstoneCorrupted
+	}
+
+	magic := buf[len(buf)-len(tombstoneMagic):]
+	if !bytes.Equal(magic, tombstoneMagic) {
+		return nil, errTombstoneCorrupted
+	}
+	buf = buf[:len(buf)-len(tombstoneMagic)]
+
+	crc := numberenc.UnmarshalUint32(buf[len(buf)-4:])
+	buf = buf[:len(buf)-4]
+	if crc32.ChecksumIEEE(buf) != crc {
+		return nil, errTombstoneCorrupted
+	}
+
+	tombstones := make(map[string][]Tombstone)
+	for len(buf) > 0 {
+		if len(buf) < tombstoneEntryMinSize {
+			return nil, errTombstoneCorrupted
+		}
+		n := int(numberenc.UnmarshalUint16(buf))
+		buf = buf[2:]
+		if len(buf) < n+tombstoneEntryMinSize-2 {
+			return nil, errTombstoneCorrupted
+		}
+		key := string(buf[:n])
+		buf = buf[n:]
+
+		t := Tombstone{
+			ID:      numberenc.UnmarshalUint64(buf),
+			MinTime: numberenc.UnmarshalInt64(buf[8:]),
+			MaxTime: numberenc.UnmarshalInt64(buf[16:]),
+		}
+		buf = buf[24:]
+		tombstones[key] = append(tombstones[key], t)
+	}
+
+	return tombstones, nil
+}
+
+func tombstoneKey(tsspDir, fileName string) string {
+	key, err := filepath.Rel(tsspDir, fileName)
+	if err != nil {
+		key = fileName
+	}
+	if strings.HasSuffix(key, tmpTsspFileSuffix) {
+		key = key[:len(key)-tmpSuffixNameLen]
+	}
+	return key
+}
+
+// seriesTombstones appends the tombstones of series id to dst
+func seriesTombstones(ts []Tombstone, id uint64, dst []Tombstone) []Tombstone {
+	for i := range ts {
+		if ts[i].ID == id {
+			dst = append(dst, ts[i])
+		}
+	}
+	return dst
+}
+
+// liveTimeRanges splits tr into the ascending sub ranges which are not covered by tombstones of a single series
+func liveTimeRanges(tr record.TimeRange, ts []Tombstone, dst []record.TimeRange) []record.TimeRange {
+	dst = append(dst[:0], tr)
+	for i := range ts {
+		n := len(dst)
+		for j := 0; j < n; j++ {
+			r := dst[j]
+			if ts[i].MaxTime < r.Min || ts[i].MinTime > r.Max {
+				dst = append(dst, r)
+				continue
+			}
+			if ts[i].MinTime > r.Min {
+				dst = append(dst, record.TimeRange{Min: r.Min, Max: ts[i].MinTime - 1})
+			}
+			if ts[i].MaxTime < r.Max {
+				dst = append(dst, record.TimeRange{Min: ts[i].MaxTime + 1, Max: r.Max})
+			}
+		}
+		dst = append(dst[:0], dst[n:]...)
+	}
+
+	sort.Slice(dst, func(i, j int) bool {
+		return dst[i].Min < dst[j].Min
+	})
+	return dst
+}
+
+// FilterByTombstones removes the rows covered by the tombstones of a single series.
+// The original record is returned if no row is deleted, and nil is returned if all rows are deleted.
+func FilterByTombstones(rec *record.Record, ts []Tombstone) *record.Record {
+	if rec == nil || len(ts) == 0 {
+		return rec
+	}
+
+	times := rec.Times()
+	deleted := func(tm int64) bool {
+		for i := range ts {
+			if ts[i].MinTime <= tm && tm <= ts[i].MaxTime {
+				return true
+			}
+		}
+		return false
+	}
+
+	first := -1
+	for i, tm := range times {
+		if deleted(tm) {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return rec
+	}
+
+	newRec := record.NewRecordBuilder(rec.Schema)
+	newRec.RecMeta = rec.RecMeta
+	newRec.AppendRec(rec, 0, first)
+	start := -1
+	for i := first + 1; i < len(times); i++ {
+		if deleted(times[i]) {
+			if start >= 0 {
+				newRec.AppendRec(rec, start, i)
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		newRec.AppendRec(rec, start, len(times))
+	}
+
+	if newRec.RowNums() == 0 {
+		return nil
+	}
+	return newRec
+}
+
+func (m *MmsTables) tombstoneKeys(files []TSSPFile) []string {
+	if m.tombstones == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.HasTombstones() {
+			continue
+		}
+		if p := f.Path(); p != "" {
+			keys = append(keys, tombstoneKey(m.path, p))
+		}
+	}
+	return keys
+}
+
+func (m *MmsTables) removeTombstones(keys []string) {
+	if len(keys) == 0 || !m.tombstones.Remove(keys...) {
+		return
+	}
+	if err := m.tombstones.Flush(); err != nil {
+		log.Error("flush tombstones fail", zap.String("path", m.tombstones.Path()), zap.Error(err))
+	}
+}
+
+// loadTombstones applies the persisted tombstones to the opened files,
+// and drops the tombstones of files which no longer exist
+func (m *MmsTables) loadTombstones() error {
+	if err := m.tombstones.Load(); err != nil {
+		return err
+	}
+
+	exists := make(map[string]struct{})
+	apply := func(tables map[string]*TSSPFiles) {
+		for _, fs := range tables {
+			for _, f := range fs.files {
+				key := tombstoneKey(m.path, f.Path())
+				exists[key] = struct{}{}
+				if ts := m.tombstones.Tombstones(key); len(ts) > 0 {
+					f.SetTombstones(ts)
+				}
+			}
+		}
+	}
+	apply(m.Order)
+	apply(m.OutOfOrder)
+
+	if m.tombstones.Retain(exists) {
+		return m.tombstones.Flush()
+	}
+	return nil
+}
+
+// DeleteSeries adds tombstones for the rows of series ids within tr in all files of the measurement.
+// The rows are masked by readers immediately and dropped physically by the next compaction or merge.
+func (m *MmsTables) DeleteSeries(name string, ids []uint64, tr record.TimeRange) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m.tombstoneLock.Lock()
+	defer m.tombstoneLock.Unlock()
+
+	files := m.seriesFilesRef(name)
+	defer UnrefFiles(files...)
+
+	changed := false
+	for _, f := range files {
+		path := f.Path()
+		deleted, err := f.DeleteRange(ids, tr.Min, tr.Max)
+		if err == errFileClosed || path == "" {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(deleted) == 0 {
+			continue
+		}
+
+		m.tombstones.Set(tombstoneKey(m.path, path), f.Tombstones())
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return m.tombstones.Flush()
+}
+
+// ContainsSeries reports whether any file of the measurement still has undeleted rows of the series
+func (m *MmsTables) ContainsSeries(name string, id uint64) (bool, error) {
+	files := m.seriesFilesRef(name)
+	defer UnrefFiles(files...)
+
+	decs := NewReadContext(true)
+	defer decs.Release()
+	for _, f := range files {
+		loc := NewLocation(f, decs)
+		contains, err := loc.Contains(id, record.MinMaxTimeRange)
+		if err == errFileClosed {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if contains {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// seriesFilesRef returns the files of the measurement and its down sampled tables
+func (m *MmsTables) seriesFilesRef(name string) []TSSPFile {
+	files := append(m.GetFilesRef(name, true), m.GetFilesRef(name, false)...)
+	for _, tbl := range m.downSampleTables(name) {
+		files = append(files, m.GetFilesRef(tbl, true)...)
+	}
+	return files
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstoneFile_FlushAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	tf := NewTombstoneFile(dir)
+	require.NoError(t, tf.Load())
+	assert.Equal(t, 0, tf.TombstonesCount())
+
+	key1 := filepath.Join("mst_0000", "00000001-0000-00000000.tssp")
+	key2 := filepath.Join("mst_0000", "out-of-order", "00000002-0000-00000000.tssp")
+	key3 := filepath.Join("cpu_0000", "00000001-0000-00000000.tssp")
+	tf.Set(key1, []Tombstone{{ID: 1, MinTime: math.MinInt64, MaxTime: math.MaxInt64}, {ID: 2, MinTime: 10, MaxTime: 20}})
+	tf.Set(key2, []Tombstone{{ID: 3, MinTime: -5, MaxTime: 5}})
+	tf.Set(key3, []Tombstone{{ID: 4, MinTime: 0, MaxTime: 0}})
+	require.NoError(t, tf.Flush())
+
+	loaded := NewTombstoneFile(dir)
+	require.NoError(t, loaded.Load())
+	assert.Equal(t, 4, loaded.TombstonesCount())
+	assert.Equal(t, tf.Tombstones(key1), loaded.Tombstones(key1))
+	assert.Equal(t, tf.Tombstones(key2), loaded.Tombstones(key2))
+
+	assert.True(t, loaded.RemoveMeasurement("mst_0000"))
+	assert.False(t, loaded.Remove(key1))
+	assert.True(t, loaded.Retain(map[string]struct{}{}))
+	require.NoError(t, loaded.Flush())
+
+	_, err := os.Stat(loaded.Path())
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUnmarshalTombstones_Corrupted(t *testing.T) {
+	buf := marshalTombstones(nil, map[string][]Tombstone{"a.tssp": {{ID: 1, MinTime: 1, MaxTime: 2}}})
+
+	_, err := unmarshalTombstones(buf[:len(buf)-1])
+	assert.Equal(t, errTombstoneCorrupted, err)
+
+	corrupted := append([]byte{}, buf...)
+	corrupted[0]++
+	_, err = unmarshalTombstones(corrupted)
+	assert.Equal(t, errTombstoneCorrupted, err)
+
+	ts, err := unmarshalTombstones(buf)
+	require.NoError(t, err)
+	assert.Equal(t, []Tombstone{{ID: 1, MinTime: 1, MaxTime: 2}}, ts["a.tssp"])
+}
+
+func TestLiveTimeRanges(t *testing.T) {
+	tr := record.TimeRange{Min: 0, Max: 100}
+	ts := []Tombstone{
+		{ID: 1, MinTime: 10, MaxTime: 20},
+		{ID: 1, MinTime: 50, MaxTime: 60},
+		{ID: 1, MinTime: 15, MaxTime: 30},
+	}
+	ranges := liveTimeRanges(tr, ts, nil)
+	assert.Equal(t, []record.TimeRange{{Min: 0, Max: 9}, {Min: 31, Max: 49}, {Min: 61, Max: 100}}, ranges)
+
+	ranges = liveTimeRanges(tr, []Tombstone{{ID: 1, MinTime: math.MinInt64, MaxTime: math.MaxInt64}}, ranges)
+	assert.Equal(t, 0, len(ranges))
+}
+
+func TestFilterByTombstones(t *testing.T) {
+	rec := record.NewRecordBuilder(schema)
+	for i := 0; i < 10; i++ {
+		rec.Column(0).AppendFloat(float64(i))
+		rec.Column(1).AppendInteger(int64(i))
+		rec.Column(2).AppendBoolean(i%2 == 0)
+		rec.Column(3).AppendString("v")
+		rec.Column(4).AppendInteger(int64(i))
+	}
+
+	assert.True(t, rec == FilterByTombstones(rec, []Tombstone{{ID: 1, MinTime: 20, MaxTime: 30}}))
+	assert.Nil(t, FilterByTombstones(rec, []Tombstone{{ID: 1, MinTime: 0, MaxTime: 9}}))
+
+	newRec := FilterByTombstones(rec, []Tombstone{{ID: 1, MinTime: 0, MaxTime: 1}, {ID: 1, MinTime: 4, MaxTime: 6}})
+	require.NotNil(t, newRec)
+	assert.Equal(t, []int64{2, 3, 7, 8, 9}, newRec.Times())
+	assert.Equal(t, []int64{2, 3, 7, 8, 9}, newRec.ColVals[1].IntegerValues())
+}
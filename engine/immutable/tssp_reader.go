@@ -31,6 +31,7 @@ import (
 
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/openGemini/openGemini/engine/comm"
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
@@ -87,10 +88,11 @@ type TSSPFile interface {
 	ContainsValue(id uint64, tr record.TimeRange) (bool, error)
 	MinMaxTime() (int64, int64, error)
 
-	Delete(ids []int64) error
-	DeleteRange(ids []int64, min, max int64) error
+	Delete(ids []uint64) ([]uint64, error)
+	DeleteRange(ids []uint64, min, max int64) ([]uint64, error)
 	HasTombstones() bool
-	TombstoneFiles() []TombstoneFile
+	Tombstones() []Tombstone
+	SetTombstones(ts []Tombstone)
 
 	Open() error
 	Close() error
@@ -341,6 +343,8 @@ type tsspFile struct {
 
 	memEle *list.Element // lru node
 	reader TableReader
+
+	tombstones []Tombstone
 }
 
 func OpenTSSPFile(name string, isOrder bool, cacheData bool) (TSSPFile, error) {
@@ -625,28 +629,52 @@ func (f *tsspFile) ContainsByTime(tr record.TimeRange) (contains bool, err error
 	return
 }
 
-func (f *tsspFile) Delete([]int64) error {
-	f.mu.RLock()
-	defer f.mu.RUnlock()
-	panic("impl me")
+func (f *tsspFile) Delete(ids []uint64) ([]uint64, error) {
+	return f.DeleteRange(ids, math.MinInt64, math.MaxInt64)
 }
 
-func (f *tsspFile) DeleteRange([]int64, int64, int64) error {
-	f.mu.RLock()
-	defer f.mu.RUnlock()
-	panic("impl me")
+// DeleteRange adds tombstones of the series which have data within [min, max] in the file,
+// and returns the ids of these series
+func (f *tsspFile) DeleteRange(ids []uint64, min, max int64) ([]uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.stopped() {
+		return nil, errFileClosed
+	}
+
+	var deleted []uint64
+	tr := record.TimeRange{Min: min, Max: max}
+	// never append in place, readers may hold the previous slice
+	tombstones := f.tombstones[:len(f.tombstones):len(f.tombstones)]
+	for _, id := range ids {
+		if !f.reader.Contains(id, tr) {
+			continue
+		}
+		tombstones = append(tombstones, Tombstone{ID: id, MinTime: min, MaxTime: max})
+		deleted = append(deleted, id)
+	}
+	f.tombstones = tombstones
+
+	return deleted, nil
 }
 
 func (f *tsspFile) HasTombstones() bool {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	panic("impl me")
+	return len(f.tombstones) > 0
 }
 
-func (f *tsspFile) TombstoneFiles() []TombstoneFile {
+func (f *tsspFile) Tombstones() []Tombstone {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	panic("impl me")
+	return f.tombstones
+}
+
+func (f *tsspFile) SetTombstones(ts []Tombstone) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tombstones = ts
 }
 
 func (f *tsspFile) Rename(newName string) error {
@@ -882,6 +910,13 @@ type TablesStore interface {
 	GetOutOfOrderFileNum() int
 	GetMstFileStat() *stats.FileStat
 	DropMeasurement(ctx context.Context, name string) error
+	DeleteSeries(name string, ids []uint64, tr record.TimeRange) error
+	ContainsSeries(name string, id uint64) (bool, error)
+	DownSampleState() DownSampleState
+	DownSample(level int, interval int64, calls map[int][]string) error
+	Backup(dir string, base backup.FileSet) ([]backup.File, error)
+	Measurements() []string
+	ReadSeries(name string, isOrder bool, sid uint64, fn func(id uint64, rec *record.Record) bool) error
 }
 
 var compactGroupPool = sync.Pool{New: func() interface{} { return &CompactGroup{group: make([]string, 0, 8)} }}
@@ -949,6 +984,14 @@ type MmsTables struct {
 	sequencer       *Sequencer
 	compactRecovery bool
 
+	// tombstoneLock serializes deleting with compaction, merge and down sampling,
+	// so that tombstones added to the old files are not lost while they are being replaced
+	tombstoneLock sync.RWMutex
+	tombstones    *TombstoneFile
+
+	downSampleLock sync.Mutex
+	downSample     *DownSampleFile
+
 	Conf          *Config
 	lastMergeTime time.Time
 }
@@ -966,6 +1009,8 @@ func NewTableStore(dir string, tier *uint64, compactRecovery bool, config *Confi
 		compactionEn:    1,
 		sequencer:       NewSequencer(),
 		compactRecovery: compactRecovery,
+		tombstones:      NewTombstoneFile(filepath.Dir(dir)),
+		downSample:      NewDownSampleFile(filepath.Dir(dir)),
 		Conf:            config,
 	}
 	return store
@@ -1403,6 +1448,16 @@ func (m *MmsTables) Open() (int64, int64, error) {
 		return 0, 0, err
 	}
 
+	if err = m.loadTombstones(); err != nil {
+		log.Error("load tombstones fail", zap.String("path", m.path), zap.Error(err))
+		return 0, 0, err
+	}
+
+	if err = m.loadDownSample(); err != nil {
+		log.Error("load downsample fail", zap.String("path", m.path), zap.Error(err))
+		return 0, 0, err
+	}
+
 	d := time.Since(start)
 	log.Info("table store open done", zap.Duration("time used", d))
 
@@ -1577,7 +1632,13 @@ func (m *MmsTables) sortTSSPFiles() {
 	}
 }
 
-func (m *MmsTables) DropMeasurement(_ context.Context, name string) error {
+func (m *MmsTables) DropMeasurement(ctx context.Context, name string) error {
+	for _, tbl := range m.downSampleTables(name) {
+		if err := m.DropMeasurement(ctx, tbl); err != nil {
+			return err
+		}
+	}
+
 	var orderWg, inorderWg *sync.WaitGroup
 	mstPath := filepath.Join(m.path, name)
 	log.Info("start drop measurement...", zap.String("name", name), zap.String("path", mstPath))
@@ -1628,6 +1689,12 @@ func (m *MmsTables) DropMeasurement(_ context.Context, name string) error {
 	delete(m.OutOfOrder, name)
 	m.mu.Unlock()
 
+	if m.tombstones != nil && m.tombstones.RemoveMeasurement(name) {
+		if err := m.tombstones.Flush(); err != nil {
+			log.Error("flush tombstones fail", zap.String("name", name), zap.Error(err))
+		}
+	}
+
 	mmsDir := filepath.Join(m.path, name)
 	lockFile := fileops.FileLockOption("")
 	_ = fileops.RemoveAll(mmsDir, lockFile)
@@ -1738,6 +1805,9 @@ func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isO
 
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
+	// the deleted rows are dropped while rewriting the old files
+	tombstoneKeys := m.tombstoneKeys(oldFiles)
+
 	// remove old files
 	for _, f := range oldFiles {
 		if m.isClosed() {
@@ -1751,6 +1821,7 @@ func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isO
 	// add new files
 	fs.files = append(fs.files, newFiles...)
 	sort.Sort(fs)
+	m.removeTombstones(tombstoneKeys)
 
 	lock := fileops.FileLockOption("")
 	if err = fileops.Remove(logFile, lock); err != nil {
@@ -62,11 +62,12 @@ type PreAggBuilder interface {
 }
 
 var (
-	integerPreAggPool = sync.Pool{}
-	floatPreAggPool   = sync.Pool{}
-	boolPreAggPool    = sync.Pool{}
-	stringPreAggPool  = sync.Pool{}
-	timePreAggPool    = sync.Pool{}
+	integerPreAggPool  = sync.Pool{}
+	unsignedPreAggPool = sync.Pool{}
+	floatPreAggPool    = sync.Pool{}
+	boolPreAggPool     = sync.Pool{}
+	stringPreAggPool   = sync.Pool{}
+	timePreAggPool     = sync.Pool{}
 
 	MinMaxTimeLen    = int(unsafe.Sizeof(SegmentRange{}))
 	SegmentLen       = (Segment{}).bytes()
@@ -77,20 +78,22 @@ var (
 )
 
 type PreAggBuilders struct {
-	intBuilder    PreAggBuilder
-	floatBuilder  PreAggBuilder
-	stringBuilder PreAggBuilder
-	boolBuilder   PreAggBuilder
-	timeBuilder   PreAggBuilder
+	intBuilder      PreAggBuilder
+	unsignedBuilder PreAggBuilder
+	floatBuilder    PreAggBuilder
+	stringBuilder   PreAggBuilder
+	boolBuilder     PreAggBuilder
+	timeBuilder     PreAggBuilder
 }
 
 func newPreAggBuilders() *PreAggBuilders {
 	b := &PreAggBuilders{
-		intBuilder:    acquireColumnBuilder(influx.Field_Type_Int),
-		floatBuilder:  acquireColumnBuilder(influx.Field_Type_Float),
-		stringBuilder: acquireColumnBuilder(influx.Field_Type_String),
-		boolBuilder:   acquireColumnBuilder(influx.Field_Type_Boolean),
-		timeBuilder:   acquireTimePreAggBuilder(),
+		intBuilder:      acquireColumnBuilder(influx.Field_Type_Int),
+		unsignedBuilder: acquireColumnBuilder(influx.Field_Type_UInt),
+		floatBuilder:    acquireColumnBuilder(influx.Field_Type_Float),
+		stringBuilder:   acquireColumnBuilder(influx.Field_Type_String),
+		boolBuilder:     acquireColumnBuilder(influx.Field_Type_Boolean),
+		timeBuilder:     acquireTimePreAggBuilder(),
 	}
 	b.reset()
 	return b
@@ -98,6 +101,7 @@ func newPreAggBuilders() *PreAggBuilders {
 
 func (b *PreAggBuilders) reset() {
 	b.intBuilder.reset()
+	b.unsignedBuilder.reset()
 	b.floatBuilder.reset()
 	b.stringBuilder.reset()
 	b.boolBuilder.reset()
@@ -111,6 +115,8 @@ func (b *PreAggBuilders) Release() {
 
 	ReleaseColumnBuilder(b.intBuilder)
 	b.intBuilder = nil
+	ReleaseColumnBuilder(b.unsignedBuilder)
+	b.unsignedBuilder = nil
 	ReleaseColumnBuilder(b.floatBuilder)
 	b.floatBuilder = nil
 	ReleaseColumnBuilder(b.stringBuilder)
@@ -128,6 +134,8 @@ func (b *PreAggBuilders) aggBuilder(ref *record.Field) PreAggBuilder {
 			return b.timeBuilder
 		}
 		return b.intBuilder
+	case influx.Field_Type_UInt:
+		return b.unsignedBuilder
 	case influx.Field_Type_Float:
 		return b.floatBuilder
 	case influx.Field_Type_String:
@@ -154,6 +162,12 @@ func acquireColumnBuilder(ty int) PreAggBuilder {
 			return NewIntegerPreAgg()
 		}
 		return v.(*IntegerPreAgg)
+	case influx.Field_Type_UInt:
+		v := unsignedPreAggPool.Get()
+		if v == nil {
+			return NewUnsignedPreAgg()
+		}
+		return v.(*UnsignedPreAgg)
 	case influx.Field_Type_Float:
 		v := floatPreAggPool.Get()
 		if v == nil {
@@ -306,6 +320,140 @@ func (m *IntegerPreAgg) addMax(value float64, tm int64) {
 func (m *IntegerPreAgg) addSum(v float64) { m.values[sumIndex] += int64(v) }
 func (m *IntegerPreAgg) addCount(n int64) { m.values[countIndex] += n }
 
+// UnsignedPreAgg If you change the order of the elements in the structure,
+// remember to modify marshal() and unmarshal() as well.
+type UnsignedPreAgg struct {
+	minV    uint64
+	maxV    uint64
+	minTime int64
+	maxTime int64
+	sumV    uint64
+	countV  int64
+}
+
+func NewUnsignedPreAgg() *UnsignedPreAgg {
+	m := &UnsignedPreAgg{}
+	m.reset()
+	return m
+}
+
+func (m *UnsignedPreAgg) size() int {
+	return int(unsafe.Sizeof(*m))
+}
+
+func (m *UnsignedPreAgg) marshal(dst []byte) []byte {
+	dst = numberenc.MarshalUint64Append(dst, m.minV)
+	dst = numberenc.MarshalUint64Append(dst, m.maxV)
+	dst = numberenc.MarshalInt64Append(dst, m.minTime)
+	dst = numberenc.MarshalInt64Append(dst, m.maxTime)
+	dst = numberenc.MarshalUint64Append(dst, m.sumV)
+	dst = numberenc.MarshalInt64Append(dst, m.countV)
+	return dst
+}
+
+func (m *UnsignedPreAgg) unmarshal(src []byte) ([]byte, error) {
+	if len(src) < m.size() {
+		return nil, fmt.Errorf("too small data %v for ColumnMetaUnsigned", len(src))
+	}
+
+	m.minV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.maxV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.minTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	m.maxTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	m.sumV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.countV, src = numberenc.UnmarshalInt64(src), src[8:]
+	return src, nil
+}
+
+func (m *UnsignedPreAgg) reset() {
+	m.minV = math.MaxUint64 // min
+	m.maxV = 0              // max
+	m.minTime = 0           // minT
+	m.maxTime = 0           // maxT
+	m.sumV = 0              // sum
+	m.countV = 0            // count
+}
+
+func (m *UnsignedPreAgg) min() (interface{}, int64) {
+	return m.minV, m.minTime
+}
+
+func (m *UnsignedPreAgg) max() (interface{}, int64) {
+	return m.maxV, m.maxTime
+}
+
+func (m *UnsignedPreAgg) count() int64 {
+	return m.countV
+}
+
+func (m *UnsignedPreAgg) sum() interface{} {
+	return m.sumV
+}
+
+func (m *UnsignedPreAgg) addValues(col *record.ColVal, times []int64) {
+	values := col.UnsignedValues()
+	valLen := len(values)
+	for i := 0; i < valLen; i++ {
+		v := values[i]
+		if m.countV == 0 && i == 0 || m.minV > v {
+			m.minV = v
+			m.minTime = times[i]
+		}
+		if m.countV == 0 && i == 0 || m.maxV < v {
+			m.maxV = v
+			m.maxTime = times[i]
+		}
+
+		m.sumV += v
+	}
+
+	m.countV += int64(valLen)
+}
+
+// merge adds the pre-aggregation of another block, the values are merged as uint64 so that none above 2^53 is
+// rounded through a float64.
+func (m *UnsignedPreAgg) merge(o *UnsignedPreAgg) {
+	if o.countV == 0 {
+		return
+	}
+	if m.countV == 0 || o.minV < m.minV || (o.minV == m.minV && o.minTime < m.minTime) {
+		m.minV, m.minTime = o.minV, o.minTime
+	}
+	if m.countV == 0 || o.maxV > m.maxV || (o.maxV == m.maxV && o.maxTime < m.maxTime) {
+		m.maxV, m.maxTime = o.maxV, o.maxTime
+	}
+	m.sumV += o.sumV
+	m.countV += o.countV
+}
+
+func (m *UnsignedPreAgg) release() {
+	m.reset()
+	unsignedPreAggPool.Put(m)
+}
+
+func (m *UnsignedPreAgg) addMin(value float64, tm int64) {
+	v := uint64(value)
+	if v < m.minV {
+		m.minV = v
+		m.minTime = tm
+	} else if m.minV == v && tm < m.minTime {
+		m.minTime = tm
+	}
+}
+
+func (m *UnsignedPreAgg) addMax(value float64, tm int64) {
+	v := uint64(value)
+	if v > m.maxV {
+		m.maxV = v
+		m.maxTime = tm
+	} else if m.maxV == v && tm < m.maxTime {
+		m.maxTime = tm
+	}
+}
+
+func (m *UnsignedPreAgg) addSum(v float64) { m.sumV += uint64(v) }
+func (m *UnsignedPreAgg) addCount(n int64) { m.countV += n }
+
 // FloatPreAgg If you change the order of the elements in the structure,
 // remember to modify marshal() and unmarshal() as well.
 type FloatPreAgg struct {
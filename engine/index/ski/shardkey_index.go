@@ -326,6 +326,11 @@ func (idx *ShardKeyIndex) isSplitKey(rowCount, pos int64, shardKey []byte, f fun
 	return res, rowCount, nil
 }
 
+// Backup links the parts of the shard key index into dir, the directory of the backup of the shard
+func (idx *ShardKeyIndex) Backup(dir string) error {
+	return idx.tb.CreateSnapshotAt(path.Join(dir, ShardKeyDirectory))
+}
+
 func (idx *ShardKeyIndex) Close() error {
 	idx.tb.MustClose()
 	return idx.cache.Save(path.Join(idx.path, ShardKeyCache))
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsi
+
+import (
+	"sync"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// CardinalityLimits caps the series and the tag values of an index, a zero limit means unlimited.
+// An index holds the series of one pt of a database in the time range of an index group, so the limits apply to
+// each pt and index group.
+type CardinalityLimits struct {
+	MaxSeries               int
+	MaxSeriesPerMeasurement int
+	MaxValuesPerTag         int
+}
+
+func (l CardinalityLimits) enabled() bool {
+	return l.MaxSeries > 0 || l.MaxSeriesPerMeasurement > 0 || l.MaxValuesPerTag > 0
+}
+
+// IsCardinalityLimitError returns true if a row was rejected by the index because it would exceed a limit.
+func IsCardinalityLimitError(err error) bool {
+	return errno.Equal(err, errno.SeriesLimitExceeded) || errno.Equal(err, errno.MeasurementSeriesLimitExceeded) ||
+		errno.Equal(err, errno.TagValuesLimitExceeded)
+}
+
+// cardinality counts the series and the tag values of an index to enforce its limits. The counts are loaded from
+// the index the first time they are needed and then kept up to date as series are created. The series deleted
+// since the index was opened are still counted.
+type cardinality struct {
+	mu     sync.Mutex
+	limits CardinalityLimits
+
+	series       int // -1 until loaded
+	measurements map[string]*measurementCardinality
+}
+
+type measurementCardinality struct {
+	series    int
+	tagValues map[string]map[string]struct{}
+}
+
+func newCardinality(limits CardinalityLimits) *cardinality {
+	return &cardinality{
+		limits:       limits,
+		series:       -1,
+		measurements: make(map[string]*measurementCardinality),
+	}
+}
+
+// admit returns an error naming the limit that the new series of the measurement would exceed. vname is the
+// name of the measurement followed by its version.
+func (c *cardinality) admit(idx *MergeSetIndex, vname []byte, tags []influx.Tag) error {
+	name := vname[:len(vname)-2]
+	if c.limits.MaxSeries > 0 {
+		if c.series < 0 {
+			n, err := c.loadSeries(idx)
+			if err != nil {
+				return err
+			}
+			c.series = n
+		}
+		if c.series >= c.limits.MaxSeries {
+			return errno.NewError(errno.SeriesLimitExceeded, idx.database, c.limits.MaxSeries)
+		}
+	}
+
+	if c.limits.MaxSeriesPerMeasurement <= 0 && c.limits.MaxValuesPerTag <= 0 {
+		return nil
+	}
+	mc, err := c.measurement(idx, vname)
+	if err != nil {
+		return err
+	}
+	if c.limits.MaxSeriesPerMeasurement > 0 && mc.series >= c.limits.MaxSeriesPerMeasurement {
+		return errno.NewError(errno.MeasurementSeriesLimitExceeded, name, c.limits.MaxSeriesPerMeasurement)
+	}
+	if c.limits.MaxValuesPerTag <= 0 {
+		return nil
+	}
+	for i := range tags {
+		values, ok := mc.tagValues[tags[i].Key]
+		if !ok {
+			values, err = idx.searchTagValues(vname, record.Str2bytes(tags[i].Key))
+			if err != nil {
+				return err
+			}
+			mc.tagValues[tags[i].Key] = values
+		}
+		if _, ok = values[tags[i].Value]; !ok && len(values) >= c.limits.MaxValuesPerTag {
+			return errno.NewError(errno.TagValuesLimitExceeded, name, tags[i].Key, tags[i].Value, c.limits.MaxValuesPerTag)
+		}
+	}
+	return nil
+}
+
+// created counts a series admitted by admit once it is created.
+func (c *cardinality) created(vname []byte, tags []influx.Tag) {
+	if c.series >= 0 {
+		c.series++
+	}
+	mc, ok := c.measurements[string(vname)]
+	if !ok {
+		return
+	}
+	mc.series++
+	if mc.tagValues == nil {
+		return
+	}
+	for i := range tags {
+		if values := mc.tagValues[tags[i].Key]; values != nil {
+			values[tags[i].Value] = struct{}{}
+		}
+	}
+}
+
+func (c *cardinality) measurement(idx *MergeSetIndex, vname []byte) (*measurementCardinality, error) {
+	if mc, ok := c.measurements[string(vname)]; ok {
+		return mc, nil
+	}
+	mc := &measurementCardinality{}
+	if c.limits.MaxSeriesPerMeasurement > 0 {
+		n, err := idx.seriesCardinality(vname)
+		if err != nil {
+			return nil, err
+		}
+		mc.series = int(n)
+	}
+	if c.limits.MaxValuesPerTag > 0 {
+		mc.tagValues = make(map[string]map[string]struct{})
+	}
+	c.measurements[string(vname)] = mc
+	return mc, nil
+}
+
+// loadSeries counts the series of the current versions of all measurements of the index.
+func (c *cardinality) loadSeries(idx *MergeSetIndex) (int, error) {
+	total := 0
+	err := idx.indexBuilder.walkVersions(func(name string, version uint16) error {
+		n, err := idx.seriesCardinality(encoding.MarshalUint16([]byte(name), version))
+		total += int(n)
+		return err
+	})
+	return total, err
+}
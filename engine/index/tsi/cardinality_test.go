@@ -0,0 +1,133 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/open_src/github.com/savsgio/dictpool"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestIndexBuilderWithLimits(limits CardinalityLimits) (Index, *IndexBuilder) {
+	opts := new(Options).
+		Path(testIndexPath + "index-" + fmt.Sprintf("%d", time.Now().UnixNano())).
+		IndexType(MergeSet).
+		EndTime(time.Now().Add(time.Hour)).
+		Duration(time.Hour).
+		CardinalityLimits(limits)
+
+	indexBuilder := NewIndexBuilder(opts)
+	indexBuilder.Relations = make(map[uint32]*IndexRelation)
+
+	primaryIndex, err := NewIndex(opts)
+	if err != nil {
+		panic(err)
+	}
+	primaryIndex.SetIndexBuilder(indexBuilder)
+	indexRelation, _ := NewIndexRelation(opts, primaryIndex, indexBuilder)
+	indexBuilder.Relations[uint32(MergeSet)] = indexRelation
+	if err = indexBuilder.Open(); err != nil {
+		panic(err)
+	}
+	return primaryIndex, indexBuilder
+}
+
+func rowsOf(keys ...string) *dictpool.Dict {
+	mmPoints := &dictpool.Dict{}
+	for _, key := range keys {
+		pt := influx.Row{}
+		strs := strings.Split(key, ",")
+		pt.Name = strs[0]
+		pt.Tags = make(influx.PointTags, len(strs)-1)
+		for i, str := range strs[1:] {
+			kv := strings.Split(str, "=")
+			pt.Tags[i].Key = kv[0]
+			pt.Tags[i].Value = kv[1]
+		}
+		sort.Sort(&pt.Tags)
+		pt.Timestamp = time.Now().UnixNano()
+		pt.UnmarshalIndexKeys(nil)
+		pt.ShardKey = pt.IndexKey
+
+		if !mmPoints.Has(pt.Name) {
+			mmPoints.Set(pt.Name, &[]influx.Row{})
+		}
+		rows := mmPoints.Get(pt.Name).(*[]influx.Row)
+		*rows = append(*rows, pt)
+	}
+	return mmPoints
+}
+
+func createdSeries(mmPoints *dictpool.Dict) []string {
+	var keys []string
+	for i := range mmPoints.D {
+		for _, row := range *mmPoints.D[i].Value.(*[]influx.Row) {
+			if row.SeriesId != 0 {
+				keys = append(keys, string(row.IndexKey))
+			}
+		}
+	}
+	return keys
+}
+
+func TestCardinalityLimits_MaxSeries(t *testing.T) {
+	idx, builder := getTestIndexBuilderWithLimits(CardinalityLimits{MaxSeries: 3})
+	defer clear(idx)
+
+	require.NoError(t, builder.CreateIndexIfNotExists(rowsOf("mn-1,tk1=v1", "mn-2,tk1=v1")))
+
+	mmPoints := rowsOf("mn-1,tk1=v2", "mn-1,tk1=v1", "mn-2,tk1=v2")
+	err := builder.CreateIndexIfNotExists(mmPoints)
+	require.True(t, errno.Equal(err, errno.SeriesLimitExceeded))
+	require.True(t, IsCardinalityLimitError(err))
+	// the existing series are still written
+	require.Equal(t, 2, len(createdSeries(mmPoints)))
+}
+
+func TestCardinalityLimits_MaxSeriesPerMeasurement(t *testing.T) {
+	idx, builder := getTestIndexBuilderWithLimits(CardinalityLimits{MaxSeriesPerMeasurement: 1})
+	defer clear(idx)
+
+	mmPoints := rowsOf("mn-1,tk1=v1", "mn-1,tk1=v2", "mn-2,tk1=v1")
+	err := builder.CreateIndexIfNotExists(mmPoints)
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimitExceeded))
+	require.Contains(t, err.Error(), "measurement=mn-1")
+	require.Equal(t, 2, len(createdSeries(mmPoints)))
+}
+
+func TestCardinalityLimits_MaxValuesPerTag(t *testing.T) {
+	idx, builder := getTestIndexBuilderWithLimits(CardinalityLimits{MaxValuesPerTag: 2})
+	defer clear(idx)
+
+	require.NoError(t, builder.CreateIndexIfNotExists(rowsOf("mn-1,host=h1,id=1", "mn-1,host=h1,id=2", "mn-1,host=h2,id=1")))
+
+	mmPoints := rowsOf("mn-1,host=h2,id=2", "mn-1,host=h1,id=3")
+	err := builder.CreateIndexIfNotExists(mmPoints)
+	require.True(t, errno.Equal(err, errno.TagValuesLimitExceeded))
+	require.Contains(t, err.Error(), "tag=id value=3")
+	require.Equal(t, []string{string((*mmPoints.D[0].Value.(*[]influx.Row))[0].IndexKey)}, createdSeries(mmPoints))
+
+	// the other measurements have their own tag values
+	require.NoError(t, builder.CreateIndexIfNotExists(rowsOf("mn-2,host=h1,id=3")))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/openGemini/openGemini/open_src/influx/meta"
 	"github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/open_src/vm/uint64set"
 )
 
 const (
@@ -227,6 +228,31 @@ func (gs GroupSeries) SeriesCnt() int {
 	return cnt
 }
 
+// FilterByIDs keeps the series whose id is in ids, the tag sets without series are released.
+func (gs GroupSeries) FilterByIDs(ids *uint64set.Set) GroupSeries {
+	n := 0
+	for _, t := range gs {
+		j := 0
+		for i := range t.IDs {
+			if !ids.Has(t.IDs[i]) {
+				putSeriesKeyBuf(t.SeriesKeys[i])
+				continue
+			}
+			t.IDs[j], t.Filters[j], t.SeriesKeys[j], t.TagsVec[j] = t.IDs[i], t.Filters[i], t.SeriesKeys[i], t.TagsVec[i]
+			j++
+		}
+		t.IDs, t.Filters, t.SeriesKeys, t.TagsVec = t.IDs[:j], t.Filters[:j], t.SeriesKeys[:j], t.TagsVec[:j]
+
+		if j == 0 {
+			t.release()
+			continue
+		}
+		gs[n] = t
+		n++
+	}
+	return gs[:n]
+}
+
 type Index interface {
 	CreateIndexIfNotExists(mmRows *dictpool.Dict) error
 	GetSeriesIdBySeriesKey(key, name []byte) (uint64, error)
@@ -235,6 +261,7 @@ type Index interface {
 	SeriesCardinality(name []byte, condition influxql.Expr, tr TimeRange) (uint64, error)
 	SearchSeriesKeys(series [][]byte, name []byte, condition influxql.Expr) ([][]byte, error)
 	SearchAllSeriesKeys() ([][]byte, error)
+	SearchSeriesKey(dst []byte, sid uint64) ([]byte, error)
 	SearchTagValues(name []byte, tagKeys [][]byte, condition influxql.Expr) ([][]string, error)
 	SearchAllTagValues(tagKey []byte) (map[string]map[string]struct{}, error)
 	SearchTagValuesCardinality(name, tagKey []byte) (uint64, error)
@@ -262,6 +289,8 @@ type Options struct {
 	endTime   time.Time
 	duration  time.Duration
 	kvStorage kvstorage.KVStorage
+
+	cardinalityLimits CardinalityLimits
 }
 
 func (opts *Options) Ident(ident *meta.IndexIdentifier) *Options {
@@ -294,6 +323,11 @@ func (opts *Options) KVStorage(storage kvstorage.KVStorage) *Options {
 	return opts
 }
 
+func (opts *Options) CardinalityLimits(limits CardinalityLimits) *Options {
+	opts.cardinalityLimits = limits
+	return opts
+}
+
 func NewIndex(opts *Options) (Index, error) {
 	switch opts.indexType {
 	case MergeSet:
@@ -44,6 +44,15 @@ func GetIndexTypeByName(name string) IndexType {
 	return MergeSet
 }
 
+func GetIndexNameByType(idxType IndexType) string {
+	for _, am := range IndexAms {
+		if am.IdxType == idxType {
+			return am.IdxName
+		}
+	}
+	return ""
+}
+
 func GetIndexIdByType(idxType IndexType) uint32 {
 	for _, am := range IndexAms {
 		if am.IdxType == idxType {
@@ -82,5 +91,7 @@ type IndexAmRoutine struct {
 	amDelete       func(index interface{}, primaryIndex PrimaryIndex, name []byte, condition influxql.Expr, tr TimeRange) error
 	amScan         func(index interface{}, primaryIndex PrimaryIndex, span *tracing.Span, name []byte, opt *query.ProcessorOptions) (interface{}, error)
 	amScanrelation func(oid1 int, oid2 int, result1 interface{}, result2 interface{}) (interface{}, error)
+	amCompact      func(index interface{}, primaryIndex PrimaryIndex) error
+	amBackup       func(index interface{}, dir string) error
 	amClose        func(index interface{}) error
 }
@@ -18,6 +18,7 @@ package tsi
 
 import (
 	"fmt"
+	"path"
 	"sync"
 	"time"
 
@@ -78,10 +79,8 @@ type indexRow struct {
 type indexRows []indexRow
 
 func (rows *indexRows) reset() {
-	for _, row := range *rows {
-		row.Wg = nil
-		row.Row = nil
-		row.Err = nil
+	for i := range *rows {
+		(*rows)[i] = indexRow{}
 	}
 }
 
@@ -130,6 +129,10 @@ func (iBuilder *IndexBuilder) Open() error {
 			logger.GetLogger().Error("Index open fail", zap.Error(err))
 			return err
 		}
+		if err := relation.IndexBuild(nil, nil); err != nil {
+			logger.GetLogger().Error("Index build fail", zap.Error(err))
+			return err
+		}
 	}
 	return nil
 }
@@ -280,29 +283,32 @@ func (iBuilder *IndexBuilder) CreateIndexIfNotExists(mmRows *dictpool.Dict) erro
 			}
 
 			row.Version = version
-
-			if cap(*iRows) > len(*iRows) {
-				*iRows = (*iRows)[:len(*iRows)+1]
-			} else {
-				*iRows = append(*iRows, indexRow{})
-			}
-			iRow := (*iRows)[len(*iRows)-1]
-			iRow.Row = row
-			iRow.Wg = &wg
-
-			wg.Add(1)
-			idx := primaryIndex.(*MergeSetIndex)
-			idx.WriteRow(&iRow)
+			*iRows = append(*iRows, indexRow{Row: row, Wg: &wg})
 		}
 	}
+
+	// The rows are sent once all are appended, so that the indexes write their errors to the final slice.
+	idx := primaryIndex.(*MergeSetIndex)
+	wg.Add(len(*iRows))
+	for i := range *iRows {
+		idx.WriteRow(&(*iRows)[i])
+	}
 	// Wait all rows in the batch finished.
 	wg.Wait()
 
-	// Check Err.
-	for _, row := range *iRows {
-		if row.Err != nil {
+	// Check Err. The rows exceeding a cardinality limit keep a zero series id and are dropped by the caller.
+	var limitErr error
+	for i := range *iRows {
+		err := (*iRows)[i].Err
+		if err == nil {
+			continue
+		}
+		if !IsCardinalityLimitError(err) {
 			putIndexRows(iRows)
-			return row.Err
+			return err
+		}
+		if limitErr == nil {
+			limitErr = err
 		}
 	}
 	putIndexRows(iRows)
@@ -312,13 +318,16 @@ func (iBuilder *IndexBuilder) CreateIndexIfNotExists(mmRows *dictpool.Dict) erro
 		rows, _ := mmRows.D[mmIdx].Value.(*[]influx.Row)
 		for rowIdx := range *rows {
 			row := &(*rows)[rowIdx]
+			if row.SeriesId == 0 {
+				continue
+			}
 			if err := iBuilder.createSecondaryIndex(row, primaryIndex); err != nil {
 				return err
 			}
 		}
 	}
 
-	return nil
+	return limitErr
 }
 
 func (iBuilder *IndexBuilder) CreateIndexIfPrimaryKeyExists(mmRows *dictpool.Dict, openIndexOption bool) error {
@@ -351,21 +360,9 @@ func (iBuilder *IndexBuilder) CreateIndexIfPrimaryKeyExists(mmRows *dictpool.Dic
 
 func (iBuilder *IndexBuilder) createSecondaryIndex(row *influx.Row, primaryIndex PrimaryIndex) error {
 	for _, indexOpt := range row.IndexOptions {
-		relation := iBuilder.Relations[indexOpt.Oid]
-		if relation == nil {
-			opt := &Options{
-				indexType: GetIndexTypeById(indexOpt.Oid),
-				path:      primaryIndex.Path(),
-			}
-			var err error
-			relation, err = NewIndexRelation(opt, primaryIndex, iBuilder)
-			if err != nil {
-				return err
-			}
-			if err = relation.IndexOpen(); err != nil {
-				return err
-			}
-			iBuilder.Relations[indexOpt.Oid] = relation
+		relation, err := iBuilder.getOrCreateRelation(indexOpt.Oid, primaryIndex)
+		if err != nil {
+			return err
 		}
 		if err := relation.IndexInsert([]byte(row.Name), row); err != nil {
 			return err
@@ -374,9 +371,52 @@ func (iBuilder *IndexBuilder) createSecondaryIndex(row *influx.Row, primaryIndex
 	return nil
 }
 
+func (iBuilder *IndexBuilder) getOrCreateRelation(oid uint32, primaryIndex PrimaryIndex) (*IndexRelation, error) {
+	iBuilder.mu.RLock()
+	relation := iBuilder.Relations[oid]
+	iBuilder.mu.RUnlock()
+	if relation != nil {
+		return relation, nil
+	}
+
+	iBuilder.mu.Lock()
+	defer iBuilder.mu.Unlock()
+	if relation = iBuilder.Relations[oid]; relation != nil {
+		return relation, nil
+	}
+
+	idxType := GetIndexTypeById(oid)
+	opt := &Options{
+		indexType: idxType,
+		path:      path.Join(primaryIndex.Path(), GetIndexNameByType(idxType)),
+	}
+	relation, err := NewIndexRelation(opt, primaryIndex, iBuilder)
+	if err != nil {
+		return nil, err
+	}
+	if err = relation.IndexOpen(); err != nil {
+		return nil, err
+	}
+	if err = relation.IndexBuild(nil, nil); err != nil {
+		_ = relation.IndexClose()
+		return nil, err
+	}
+	iBuilder.Relations[oid] = relation
+	return relation, nil
+}
+
+// HasIndex reports whether an index of the type has been created
+func (iBuilder *IndexBuilder) HasIndex(idxType IndexType) bool {
+	iBuilder.mu.RLock()
+	defer iBuilder.mu.RUnlock()
+	return iBuilder.Relations[GetIndexIdByType(idxType)] != nil
+}
+
 func (iBuilder *IndexBuilder) Scan(span *tracing.Span, name []byte, opt *query.ProcessorOptions, idxType IndexType) (interface{}, error) {
 	oid := GetIndexIdByType(idxType)
+	iBuilder.mu.RLock()
 	relation := iBuilder.Relations[oid]
+	iBuilder.mu.RUnlock()
 	if relation == nil {
 		return nil, fmt.Errorf("Index type do not exist!")
 	}
@@ -401,6 +441,36 @@ func (iBuilder *IndexBuilder) Delete(name []byte, condition influxql.Expr, tr Ti
 	return iBuilder.Relations[index].IndexDelete(name, condition, tr)
 }
 
+// Compact drops the entries of deleted series from the secondary indexes
+func (iBuilder *IndexBuilder) Compact() error {
+	iBuilder.mu.RLock()
+	defer iBuilder.mu.RUnlock()
+	for _, relation := range iBuilder.Relations {
+		if relation.oid == uint32(MergeSet) {
+			continue
+		}
+		if err := relation.IndexCompact(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Backup writes a copy of the key-value storage and all indexes to dir, laid out as the index directory.
+func (iBuilder *IndexBuilder) Backup(dir string) error {
+	iBuilder.mu.RLock()
+	defer iBuilder.mu.RUnlock()
+	if err := iBuilder.kvStorage.Checkpoint(path.Join(dir, KVDirName)); err != nil {
+		return err
+	}
+	for _, relation := range iBuilder.Relations {
+		if err := relation.IndexBackup(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (iBuilder *IndexBuilder) Close() error {
 	if err := iBuilder.kvStorage.Close(); err != nil {
 		return err
@@ -81,6 +81,24 @@ func (relation *IndexRelation) IndexRelation(oid1 int, oid2 int, result1 interfa
 	return relation.indexAmRoutine.amScanrelation(oid1, oid2, result1, result2)
 }
 
+// IndexCompact drops the entries of the series deleted from the primary index
+func (relation *IndexRelation) IndexCompact() error {
+	if relation.indexAmRoutine.amCompact == nil {
+		return nil
+	}
+	index := relation.indexAmRoutine.index
+	primaryIndex := relation.indexAmRoutine.primaryIndex
+	return relation.indexAmRoutine.amCompact(index, primaryIndex)
+}
+
+// IndexBackup writes a copy of the index to dir, the directory of the backup of the index builder
+func (relation *IndexRelation) IndexBackup(dir string) error {
+	if relation.indexAmRoutine.amBackup == nil {
+		return nil
+	}
+	return relation.indexAmRoutine.amBackup(relation.indexAmRoutine.index, dir)
+}
+
 func (relation *IndexRelation) IndexClose() error {
 	index := relation.indexAmRoutine.index
 	return relation.indexAmRoutine.amClose(index)
@@ -90,5 +108,6 @@ type PrimaryIndex interface {
 	CreateIndexIfNotExists(mmRows *dictpool.Dict) error
 	GetPrimaryKeys(name []byte, opt *query.ProcessorOptions) ([]uint64, error)
 	GetDeletePrimaryKeys(name []byte, condition influxql.Expr, tr TimeRange) ([]uint64, error)
+	SearchSeriesKey(dst []byte, sid uint64) ([]byte, error)
 	Path() string
 }
@@ -100,12 +100,22 @@ type MergeSetIndex struct {
 	mu sync.RWMutex
 
 	indexBuilder *IndexBuilder
+
+	database string
+	// nil if the index has no cardinality limit
+	cardinality *cardinality
 }
 
 func NewMergeSetIndex(opts *Options) (*MergeSetIndex, error) {
 	ms := &MergeSetIndex{
 		path: opts.path,
 	}
+	if opts.ident != nil {
+		ms.database = opts.ident.OwnerDb
+	}
+	if opts.cardinalityLimits.enabled() {
+		ms.cardinality = newCardinality(opts.cardinalityLimits)
+	}
 
 	return ms, nil
 }
@@ -232,7 +242,7 @@ func (idx *MergeSetIndex) CreateIndexIfNotExists(mmRows *dictpool.Dict) error {
 	vname := kbPool.Get()
 	defer kbPool.Put(vname)
 
-	var err error
+	var err, limitErr error
 	idx.mu.Lock()
 	defer idx.mu.Unlock()
 
@@ -258,12 +268,19 @@ func (idx *MergeSetIndex) CreateIndexIfNotExists(mmRows *dictpool.Dict) error {
 			vkey.B = append(vkey.B[:0], (*rows)[rowIdx].IndexKey...)
 			vkey.B = encoding.MarshalUint16(vkey.B, version)
 			(*rows)[rowIdx].SeriesId, err = idx.createIndexesIfNotExists(vkey.B, vname.B, (*rows)[rowIdx].Tags, (*rows)[rowIdx].ShardKey)
+			if IsCardinalityLimitError(err) {
+				// the row keeps a zero series id and the other rows are indexed
+				if limitErr == nil {
+					limitErr = err
+				}
+				continue
+			}
 			if err != nil {
 				return err
 			}
 		}
 	}
-	return nil
+	return limitErr
 }
 
 func (idx *MergeSetIndex) CreateIndexIfNotExistsByRow(row *influx.Row) (uint64, error) {
@@ -298,6 +315,19 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 		}
 	}(&tsid)
 
+	if idx.cardinality != nil {
+		c := idx.cardinality
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if err = c.admit(idx, vname, tags); err != nil {
+			return 0, err
+		}
+		if tsid, err = idx.createIndexes(vkey, vname, tags); err == nil {
+			c.created(vname, tags)
+		}
+		return tsid, err
+	}
+
 	tsid, err = idx.createIndexes(vkey, vname, tags)
 	return tsid, err
 }
@@ -625,6 +655,17 @@ func (idx *MergeSetIndex) SearchTagValuesCardinality(name, tagKey []byte) (uint6
 	return uint64(len(tagValueMap)), nil
 }
 
+func (idx *MergeSetIndex) searchTagValues(name, tagKey []byte) (map[string]struct{}, error) {
+	is := idx.getIndexSearch()
+	defer idx.putIndexSearch(is)
+	return is.searchTagValuesBySingleKey(name, tagKey, nil)
+}
+
+// SearchSeriesKey appends the index key of the series tsid to dst, the tags of the key are parsed by influx.IndexKeyToTags
+func (idx *MergeSetIndex) SearchSeriesKey(dst []byte, tsid uint64) ([]byte, error) {
+	return idx.searchSeriesKey(dst, tsid)
+}
+
 func (idx *MergeSetIndex) searchSeriesKey(dst []byte, tsid uint64) ([]byte, error) {
 	// fast path, get from cache
 	seriesKey := idx.cache.getFromSeriesKeyCache(dst, tsid)
@@ -777,6 +818,11 @@ func (idx *MergeSetIndex) Close() error {
 	return nil
 }
 
+// Backup links the parts of the mergeset table into dir, the items in memory are flushed first
+func (idx *MergeSetIndex) Backup(dir string) error {
+	return idx.tb.CreateSnapshotAt(path.Join(dir, MergeSetDirName))
+}
+
 func (idx *MergeSetIndex) Path() string {
 	return idx.path
 }
@@ -861,12 +907,48 @@ func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
 	return idx.tb.AddItems(ii.Items)
 }
 
+// SearchTSIDs returns the ids of all series of the measurement which match the tag condition
+func (idx *MergeSetIndex) SearchTSIDs(name []byte, condition influxql.Expr) ([]uint64, error) {
+	version, ok := idx.indexBuilder.getVersion(record.Bytes2str(name))
+	if !ok {
+		return nil, nil
+	}
+	name = encoding.MarshalUint16(name, version)
+
+	return idx.searchTSIDs(name, condition, DefaultTR)
+}
+
+// DeleteSeries removes the series from the index.
+// A deleted series gets a new id once it is written again.
+func (idx *MergeSetIndex) DeleteSeries(tsids []uint64) error {
+	if len(tsids) == 0 {
+		return nil
+	}
+
+	// block series creating until the cached ids of deleted series are dropped
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := idx.deleteTSIDs(tsids); err != nil {
+		return err
+	}
+	idx.cache.SeriesKeyToTSIDCache.Reset()
+	idx.cache.tagCache.Reset()
+	return nil
+}
+
 func (idx *MergeSetIndex) getDeletedTSIDs() *uint64set.Set {
 	return idx.deletedTSIDs.Load().(*uint64set.Set)
 }
 
 func (idx *MergeSetIndex) GetDeletePrimaryKeys(name []byte, condition influxql.Expr, tr TimeRange) ([]uint64, error) {
-	return nil, nil
+	version, ok := idx.indexBuilder.getVersion(record.Bytes2str(name))
+	if !ok {
+		return nil, nil
+	}
+	name = encoding.MarshalUint16(name, version)
+
+	return idx.searchTSIDs(name, condition, tr)
 }
 
 func (idx *MergeSetIndex) GetPrimaryKeys(name []byte, opt *query.ProcessorOptions) ([]uint64, error) {
@@ -885,6 +967,7 @@ func MergeSetIndexHandler(opt *Options, primaryIndex PrimaryIndex) *IndexAmRouti
 		amInsert:     MergeSetInsert,
 		amDelete:     MergeSetDelete,
 		amScan:       MergeSetScan,
+		amBackup:     MergeSetBackup,
 		amClose:      MergeSetClose,
 		index:        primaryIndex,
 		primaryIndex: nil,
@@ -922,6 +1005,11 @@ func MergeSetDelete(index interface{}, primaryIndex PrimaryIndex, name []byte, c
 	return nil
 }
 
+func MergeSetBackup(index interface{}, dir string) error {
+	mergeindex := index.(*MergeSetIndex)
+	return mergeindex.Backup(dir)
+}
+
 func MergeSetClose(index interface{}) error {
 	mergeindex := index.(*MergeSetIndex)
 	return mergeindex.Close()
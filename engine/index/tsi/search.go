@@ -55,6 +55,7 @@ func (is *indexSearch) getTSIDBySeriesKey(indexkey []byte) (uint64, error) {
 	kb.B = append(kb.B[:0], nsPrefixKeyToTSID)
 	kb.B = append(kb.B, indexkey...)
 	kb.B = append(kb.B, kvSeparatorChar)
+	deleted := is.idx.getDeletedTSIDs()
 	ts.Seek(kb.B)
 	for ts.NextItem() {
 		if !bytes.HasPrefix(ts.Item, kb.B) {
@@ -63,6 +64,10 @@ func (is *indexSearch) getTSIDBySeriesKey(indexkey []byte) (uint64, error) {
 		}
 		v := ts.Item[len(kb.B):]
 		pid := encoding.UnmarshalUint64(v)
+		if deleted.Has(pid) {
+			// the series has been dropped, a new tsid may follow
+			continue
+		}
 
 		// Found valid dst.
 		return pid, nil
@@ -17,59 +17,352 @@ limitations under the License.
 package tsi
 
 import (
+	"bytes"
 	"fmt"
+	"path"
+	"sync"
 
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"github.com/openGemini/openGemini/lib/kvstorage"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/open_src/vm/uint64set"
 )
 
+const (
+	// TextDirName is the directory of the text index under the index path
+	TextDirName = "text"
+
+	// textFieldPrefix + name + textSeparator + field, the columns indexed of a measurement
+	textFieldPrefix = 'f'
+	// textPostingPrefix + name + textSeparator + field + textSeparator + token + textSeparator + sid
+	textPostingPrefix = 'p'
+	textSeparator     = 0
+
+	// maxTextWrittenCache limits the postings remembered to skip writing them again
+	maxTextWrittenCache = 1 << 20
+)
+
+// TextIndex is an inverted index of the words of string columns.
+// A posting is kept for each word of each series, so the index limits the series to read for a MATCH condition,
+// and the rows of the series are filtered by the condition when they are read.
 type TextIndex struct {
+	path string
+	kv   kvstorage.KVStorage
+
+	mu sync.RWMutex
+	// indexed columns of measurements, loaded from kv when the index is built
+	fields  map[string]map[string]struct{}
+	written map[string]struct{}
 }
 
 func NewTextIndex(opts *Options) (*TextIndex, error) {
-	textIndex := &TextIndex{}
-
-	if err := textIndex.Open(); err != nil {
-		return nil, err
+	textIndex := &TextIndex{
+		path:    opts.path,
+		kv:      opts.kvStorage,
+		fields:  make(map[string]map[string]struct{}),
+		written: make(map[string]struct{}),
 	}
-
 	return textIndex, nil
 }
 
 func (idx *TextIndex) Open() error {
-	fmt.Println("TextIndex Open")
-	// TODO
+	if idx.kv != nil && !idx.kv.Closed() {
+		return nil
+	}
+	kv, err := kvstorage.NewStorage(&kvstorage.Config{
+		KVType: kvstorage.PEBBLEDB,
+		Path:   idx.path,
+		Pebble: &kvstorage.PebbleOptions{},
+	})
+	if err != nil {
+		return err
+	}
+	idx.kv = kv
 	return nil
 }
 
 func (idx *TextIndex) Close() error {
-	fmt.Println("TextIndex Close")
-	// TODO
+	if idx.kv == nil {
+		return nil
+	}
+	return idx.kv.Close()
+}
+
+// Backup writes a checkpoint of the text index to its directory under dir.
+func (idx *TextIndex) Backup(dir string) error {
+	return idx.kv.Checkpoint(path.Join(dir, path.Base(idx.path)))
+}
+
+// Build loads the indexed columns of all measurements.
+func (idx *TextIndex) Build() error {
+	fields := make(map[string]map[string]struct{})
+	idx.kv.GetByPrefixWithFunc([]byte{textFieldPrefix}, func(k, v []byte) bool {
+		i := bytes.IndexByte(k, textSeparator)
+		if i < 0 {
+			return false
+		}
+		name, field := string(k[1:i]), string(k[i+1:])
+		if fields[name] == nil {
+			fields[name] = make(map[string]struct{})
+		}
+		fields[name][field] = struct{}{}
+		return false
+	})
+
+	idx.mu.Lock()
+	idx.fields = fields
+	idx.mu.Unlock()
 	return nil
 }
 
 func (idx *TextIndex) CreateIndexIfNotExists(primaryIndex PrimaryIndex, row *influx.Row) (uint64, error) {
-	//fmt.Println("TextIndex CreateIndexIfNotExists")
-	// TODO
-	return 0, nil
+	if row.SeriesId == 0 {
+		return 0, nil
+	}
+
+	var keys [][]byte
+	for _, opt := range row.IndexOptions {
+		if opt.Oid != uint32(Text) {
+			continue
+		}
+		for _, i := range opt.IndexList {
+			column, value, ok := textColumn(row, int(i))
+			if !ok {
+				continue
+			}
+			if !idx.indexed(row.Name, column) {
+				keys = append(keys, textFieldKey(row.Name, column))
+			}
+			for _, token := range influxql.Tokenize(value) {
+				keys = append(keys, textPostingKey(row.Name, column, token, row.SeriesId))
+			}
+		}
+	}
+
+	return row.SeriesId, idx.writeKeys(keys)
+}
+
+// textColumn returns the name and the value of the column i of the row, the columns are the tags followed by the fields.
+// Only tags and string fields can be indexed.
+func textColumn(row *influx.Row, i int) (string, string, bool) {
+	if i < len(row.Tags) {
+		return row.Tags[i].Key, row.Tags[i].Value, true
+	}
+	i -= len(row.Tags)
+	if i >= len(row.Fields) || row.Fields[i].Type != influx.Field_Type_String {
+		return "", "", false
+	}
+	return row.Fields[i].Key, row.Fields[i].StrValue, true
+}
+
+func (idx *TextIndex) writeKeys(keys [][]byte) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	batch := idx.kv.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	for _, key := range keys {
+		if _, ok := idx.written[string(key)]; ok {
+			continue
+		}
+		if err := batch.Set(key, nil); err != nil {
+			return err
+		}
+	}
+	if batch.Count() == 0 {
+		return nil
+	}
+	if err := idx.kv.Apply(batch); err != nil {
+		return err
+	}
+
+	if len(idx.written)+len(keys) > maxTextWrittenCache {
+		idx.written = make(map[string]struct{})
+	}
+	for _, key := range keys {
+		if key[0] == textFieldPrefix {
+			i := bytes.IndexByte(key, textSeparator)
+			name, field := string(key[1:i]), string(key[i+1:])
+			if idx.fields[name] == nil {
+				idx.fields[name] = make(map[string]struct{})
+			}
+			idx.fields[name][field] = struct{}{}
+			continue
+		}
+		idx.written[string(key)] = struct{}{}
+	}
+	return nil
+}
+
+func (idx *TextIndex) indexed(name, field string) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	_, ok := idx.fields[name][field]
+	return ok
+}
+
+// Search returns the series which may match the MATCH conditions of the query,
+// the result is nil if the series can not be limited by the index.
+func (idx *TextIndex) Search(primaryIndex PrimaryIndex, span *tracing.Span, name []byte, opt *query.ProcessorOptions) (*uint64set.Set, error) {
+	if span != nil {
+		span = span.StartSpan("text_index_search")
+		span.StartPP()
+		defer span.Finish()
+	}
+	return idx.searchExpr(string(name), opt.Condition)
 }
 
-func (idx *TextIndex) Search(primaryIndex PrimaryIndex, span *tracing.Span, name []byte, opt *query.ProcessorOptions) ([][]byte, error) {
-	sids, _ := primaryIndex.GetPrimaryKeys(name, opt)
-	fmt.Println("TextIndex Search", len(sids))
-	// TODO
+func (idx *TextIndex) searchExpr(name string, expr influxql.Expr) (*uint64set.Set, error) {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return idx.searchExpr(name, expr.Expr)
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND, influxql.OR:
+			lhs, err := idx.searchExpr(name, expr.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := idx.searchExpr(name, expr.RHS)
+			if err != nil {
+				return nil, err
+			}
+
+			if expr.Op == influxql.AND {
+				if lhs == nil {
+					return rhs, nil
+				} else if rhs != nil {
+					lhs.Intersect(rhs)
+				}
+				return lhs, nil
+			}
+
+			// a side which can not be limited may match any series
+			if lhs == nil || rhs == nil {
+				return nil, nil
+			}
+			lhs.UnionMayOwn(rhs)
+			return lhs, nil
+		case influxql.MATCH:
+			return idx.searchMatch(name, expr)
+		}
+	}
 	return nil, nil
 }
 
+func (idx *TextIndex) searchMatch(name string, expr *influxql.BinaryExpr) (*uint64set.Set, error) {
+	ref, ok := expr.LHS.(*influxql.VarRef)
+	if !ok || !idx.indexed(name, ref.Val) {
+		return nil, nil
+	}
+	lit, ok := expr.RHS.(*influxql.StringLiteral)
+	if !ok {
+		return nil, nil
+	}
+
+	var sids *uint64set.Set
+	for _, token := range influxql.Tokenize(lit.Val) {
+		prefix := textPostingPrefixKey(name, ref.Val, token)
+		set := &uint64set.Set{}
+		idx.kv.GetByPrefixWithFunc(prefix, func(k, v []byte) bool {
+			set.Add(encoding.UnmarshalUint64(k[len(prefix):]))
+			return false
+		})
+
+		if sids == nil {
+			sids = set
+		} else {
+			sids.Intersect(set)
+		}
+		if sids.Len() == 0 {
+			break
+		}
+	}
+	if sids == nil {
+		// no word to match, no row matches
+		return &uint64set.Set{}, nil
+	}
+	return sids, nil
+}
+
+// Delete removes the postings of the series which match the condition.
 func (idx *TextIndex) Delete(primaryIndex PrimaryIndex, name []byte, condition influxql.Expr, tr TimeRange) error {
-	sids, _ := primaryIndex.GetDeletePrimaryKeys(name, condition, tr)
-	fmt.Println("TextIndex Delete", len(sids))
-	// TODO
+	sids, err := primaryIndex.GetDeletePrimaryKeys(name, condition, tr)
+	if err != nil || len(sids) == 0 {
+		return err
+	}
+
+	deleted := &uint64set.Set{}
+	deleted.AddMulti(sids)
+	prefix := append([]byte{textPostingPrefix}, name...)
+	prefix = append(prefix, textSeparator)
+	return idx.deletePostings(prefix, deleted.Has)
+}
+
+// Compact removes the postings of the series which are deleted from the primary index.
+func (idx *TextIndex) Compact(primaryIndex PrimaryIndex) error {
+	mergeIndex, ok := primaryIndex.(*MergeSetIndex)
+	if !ok {
+		return nil
+	}
+	deleted := mergeIndex.getDeletedTSIDs()
+	if deleted.Len() == 0 {
+		return nil
+	}
+	return idx.deletePostings([]byte{textPostingPrefix}, deleted.Has)
+}
+
+func (idx *TextIndex) deletePostings(prefix []byte, deleted func(sid uint64) bool) error {
+	var keys [][]byte
+	idx.kv.GetByPrefixWithFunc(prefix, func(k, v []byte) bool {
+		if len(k) > 8 && deleted(encoding.UnmarshalUint64(k[len(k)-8:])) {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		return false
+	})
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, key := range keys {
+		if err := idx.kv.Delete(key); err != nil {
+			return err
+		}
+		delete(idx.written, string(key))
+	}
 	return nil
 }
 
+func textFieldKey(name, field string) []byte {
+	key := make([]byte, 0, len(name)+len(field)+2)
+	key = append(key, textFieldPrefix)
+	key = append(key, name...)
+	key = append(key, textSeparator)
+	key = append(key, field...)
+	return key
+}
+
+func textPostingPrefixKey(name, field, token string) []byte {
+	key := make([]byte, 0, len(name)+len(field)+len(token)+12)
+	key = append(key, textPostingPrefix)
+	key = append(key, name...)
+	key = append(key, textSeparator)
+	key = append(key, field...)
+	key = append(key, textSeparator)
+	key = append(key, token...)
+	key = append(key, textSeparator)
+	return key
+}
+
+func textPostingKey(name, field, token string, sid uint64) []byte {
+	return encoding.MarshalUint64(textPostingPrefixKey(name, field, token), sid)
+}
+
 func TextIndexHandler(opt *Options, primaryIndex PrimaryIndex) *IndexAmRoutine {
 	index, _ := NewTextIndex(opt)
 	return &IndexAmRoutine{
@@ -79,6 +372,8 @@ func TextIndexHandler(opt *Options, primaryIndex PrimaryIndex) *IndexAmRoutine {
 		amInsert:     TextInsert,
 		amDelete:     TextDelete,
 		amScan:       TextScan,
+		amCompact:    TextCompact,
+		amBackup:     TextBackup,
 		amClose:      TextClose,
 		index:        index,
 		primaryIndex: primaryIndex,
@@ -86,7 +381,11 @@ func TextIndexHandler(opt *Options, primaryIndex PrimaryIndex) *IndexAmRoutine {
 }
 
 func TextBuild(relation *IndexRelation) error {
-	return nil
+	textIndex, ok := relation.indexAmRoutine.index.(*TextIndex)
+	if !ok {
+		return fmt.Errorf("index %v is not a TextIndex", relation.indexAmRoutine.index)
+	}
+	return textIndex.Build()
 }
 
 func TextOpen(index interface{}) error {
@@ -111,6 +410,16 @@ func TextDelete(index interface{}, primaryIndex PrimaryIndex, name []byte, condi
 	return textIndex.Delete(primaryIndex, name, condition, tr)
 }
 
+func TextCompact(index interface{}, primaryIndex PrimaryIndex) error {
+	textIndex := index.(*TextIndex)
+	return textIndex.Compact(primaryIndex)
+}
+
+func TextBackup(index interface{}, dir string) error {
+	textIndex := index.(*TextIndex)
+	return textIndex.Backup(dir)
+}
+
 func TextClose(index interface{}) error {
 	textIndex := index.(*TextIndex)
 	return textIndex.Close()
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsi
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/github.com/savsgio/dictpool"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/open_src/vm/uint64set"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTextRows(t *testing.T, iBuilder *IndexBuilder, msgs map[string]string) map[string]uint64 {
+	opts := []influx.IndexOption{{IndexList: []uint16{1}, Oid: GetIndexIdByType(Text)}}
+	pts := make([]influx.Row, 0, len(msgs))
+	for host, msg := range msgs {
+		pt := influx.Row{
+			Name:         "mn-1",
+			Tags:         influx.PointTags{{Key: "host", Value: host}},
+			Fields:       influx.Fields{{Key: "msg", StrValue: msg, Type: influx.Field_Type_String}},
+			Timestamp:    time.Now().UnixNano(),
+			IndexOptions: opts,
+		}
+		pt.UnmarshalIndexKeys(nil)
+		pt.ShardKey = pt.IndexKey
+		pts = append(pts, pt)
+	}
+
+	mmPoints := &dictpool.Dict{}
+	mmPoints.Set("mn-1", &pts)
+	require.NoError(t, iBuilder.CreateIndexIfNotExists(mmPoints))
+
+	sids := make(map[string]uint64, len(pts))
+	for i := range pts {
+		require.NotEqual(t, uint64(0), pts[i].SeriesId)
+		sids[pts[i].Tags[0].Value] = pts[i].SeriesId
+	}
+	return sids
+}
+
+func searchText(t *testing.T, iBuilder *IndexBuilder, cond string) []uint64 {
+	opt := &query.ProcessorOptions{Name: "mn-1", Condition: MustParseExpr(cond)}
+	result, err := iBuilder.Scan(nil, []byte("mn-1"), opt, Text)
+	require.NoError(t, err)
+	ids := result.(*uint64set.Set)
+	if ids == nil {
+		return nil
+	}
+	dst := ids.AppendTo(nil)
+	sort.Slice(dst, func(i, j int) bool { return dst[i] < dst[j] })
+	return dst
+}
+
+func sortedIDs(ids ...uint64) []uint64 {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestTextIndexSearch(t *testing.T) {
+	idx, iBuilder := getTestIndexAndBuilder()
+	defer clear(idx)
+
+	sids := writeTextRows(t, iBuilder, map[string]string{
+		"a": "connect timeout to 10.0.0.1",
+		"b": "Read TIMEOUT, retry",
+		"c": "connection refused",
+	})
+	require.True(t, iBuilder.HasIndex(Text))
+
+	require.Equal(t, sortedIDs(sids["a"], sids["b"]), searchText(t, iBuilder, `msg MATCH 'timeout'`))
+	require.Equal(t, []uint64{sids["a"]}, searchText(t, iBuilder, `msg MATCH 'Connect Timeout'`))
+	require.Equal(t, sortedIDs(sids["b"], sids["c"]), searchText(t, iBuilder, `msg MATCH 'retry' OR msg MATCH 'refused'`))
+	// the tag predicates are not limited by the text index, the series are filtered by the tag index afterwards
+	require.Equal(t, sortedIDs(sids["a"], sids["b"]), searchText(t, iBuilder, `host = 'b' AND msg MATCH 'timeout'`))
+	require.Empty(t, searchText(t, iBuilder, `msg MATCH 'unknown'`))
+	require.Nil(t, searchText(t, iBuilder, `msg MATCH 'timeout' OR host = 'c'`))
+	require.Nil(t, searchText(t, iBuilder, `other MATCH 'timeout'`))
+
+	// the indexed columns are loaded when the index is reopened
+	require.NoError(t, iBuilder.Close())
+	require.NoError(t, iBuilder.Open())
+	require.Equal(t, sortedIDs(sids["a"], sids["b"]), searchText(t, iBuilder, `msg MATCH 'timeout'`))
+}
+
+func TestTextIndexCompact(t *testing.T) {
+	idx, iBuilder := getTestIndexAndBuilder()
+	defer clear(idx)
+
+	sids := writeTextRows(t, iBuilder, map[string]string{
+		"a": "connect timeout",
+		"b": "read timeout",
+	})
+
+	mergeIndex := idx.(*MergeSetIndex)
+	require.NoError(t, mergeIndex.DeleteSeries([]uint64{sids["a"]}))
+	require.NoError(t, iBuilder.Compact())
+	require.Equal(t, []uint64{sids["b"]}, searchText(t, iBuilder, `msg MATCH 'timeout'`))
+	require.Empty(t, searchText(t, iBuilder, `msg MATCH 'connect'`))
+}
+
+func TestGroupSeriesFilterByIDs(t *testing.T) {
+	t1, t2 := setPool.get(), setPool.get()
+	t1.Append(1, getSeriesKeyBuf(), nil, nil)
+	t1.Append(2, getSeriesKeyBuf(), nil, nil)
+	t2.Append(3, getSeriesKeyBuf(), nil, nil)
+
+	ids := &uint64set.Set{}
+	ids.Add(2)
+	gs := GroupSeries{t1, t2}.FilterByIDs(ids)
+	require.Equal(t, 1, len(gs))
+	require.Equal(t, []uint64{2}, gs[0].IDs)
+	require.Equal(t, 1, len(gs[0].SeriesKeys))
+}
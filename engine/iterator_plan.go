@@ -386,6 +386,11 @@ func initTransColAuxFun() {
 		column.AppendIntegerValues(values...)
 	}
 
+	transColAuxFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.UnsignedValues()
+		column.AppendUnsignedValues(values...)
+	}
+
 	transColAuxFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.AppendFloatValues(values...)
@@ -411,6 +416,11 @@ func initTransColumnFun() {
 		column.SetIntegerValues(values)
 	}
 
+	transColumnFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.UnsignedValues()
+		column.SetUnsignedValues(values)
+	}
+
 	transColumnFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.SetFloatValues(values)
@@ -441,8 +451,8 @@ func initTransColumnFun() {
 }
 
 func validColumnType(dataType influxql.DataType) bool {
-	if dataType == influxql.Integer || dataType == influxql.Float || dataType == influxql.Boolean ||
-		dataType == influxql.String || dataType == influxql.Tag {
+	if dataType == influxql.Integer || dataType == influxql.Unsigned || dataType == influxql.Float ||
+		dataType == influxql.Boolean || dataType == influxql.String || dataType == influxql.Tag {
 		return true
 	}
 	return false
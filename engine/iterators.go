@@ -42,6 +42,7 @@ import (
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/open_src/vm/uint64set"
 	"go.uber.org/zap"
 )
 
@@ -73,7 +74,7 @@ var (
 var AppendManyNils map[int]func(colVal *record.ColVal, count int)
 
 func init() {
-	AppendManyNils = make(map[int]func(colVal *record.ColVal, count int), 4)
+	AppendManyNils = make(map[int]func(colVal *record.ColVal, count int), 5)
 
 	AppendManyNils[influx.Field_Type_Float] = func(colVal *record.ColVal, count int) {
 		colVal.AppendFloatNulls(count)
@@ -83,6 +84,10 @@ func init() {
 		colVal.AppendIntegerNulls(count)
 	}
 
+	AppendManyNils[influx.Field_Type_UInt] = func(colVal *record.ColVal, count int) {
+		colVal.AppendUnsignedNulls(count)
+	}
+
 	AppendManyNils[influx.Field_Type_Boolean] = func(colVal *record.ColVal, count int) {
 		colVal.AppendBooleanNulls(count)
 	}
@@ -117,6 +122,7 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 		return nil, nil
 	}
 	tagSets := result.(tsi.GroupSeries)
+	tagSets = s.filterByTextIndex(span, tagSets, schema.Options().(*query.ProcessorOptions))
 
 	qDuration, _ := ctx.Value(query.QueryDurationKey).(*statistics.StoreSlowQueryStatistics)
 	if qDuration != nil {
@@ -138,11 +144,25 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 	}
 
 	var readers *immutable.MmsReaders
+	mm := schema.Options().(*query.ProcessorOptions).Name
+	if state := s.immTables.DownSampleState(); state.Level > 0 {
+		// the raw data of the down sampled shard is removed, the query is served by the down sampled tables only
+		plan, err := newDownSamplePlan(mm, schema, &state)
+		if cloneMsSpan != nil {
+			cloneMsSpan.Finish()
+		}
+		if err != nil {
+			return nil, err
+		}
+		dsReaders := s.refDownSampleReaders(plan)
+		defer dsReaders.unref()
+		return s.createGroupCursors(span, schema, tagSets, &immutable.MmsReaders{}, dsReaders)
+	}
 	if executor.GetEnableFileCursor() && schema.HasInSeriesAgg() {
 		tr := record.TimeRange{Min: schema.Options().GetStartTime(), Max: schema.Options().GetEndTime()}
-		readers = s.cloneMeasurementReadersByTime(schema.Options().(*query.ProcessorOptions).Name, schema.Options().IsAscending(), tr)
+		readers = s.cloneMeasurementReadersByTime(mm, schema.Options().IsAscending(), tr)
 	} else {
-		readers = s.cloneMeasurementReaders(schema.Options().(*query.ProcessorOptions).Name)
+		readers = s.cloneMeasurementReaders(mm)
 	}
 	if cloneMsSpan != nil {
 		cloneMsSpan.SetNameValue(fmt.Sprintf("order=%d,unorder=%d", len(readers.Orders), len(readers.OutOfOrders)))
@@ -158,7 +178,36 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 		}
 	}()
 
-	return s.createGroupCursors(span, schema, tagSets, readers)
+	return s.createGroupCursors(span, schema, tagSets, readers, nil)
+}
+
+// filterByTextIndex drops the series without any row matching the MATCH conditions,
+// the rows of the remaining series are filtered by the condition when they are read.
+func (s *shard) filterByTextIndex(span *tracing.Span, tagSets tsi.GroupSeries, opt *query.ProcessorOptions) tsi.GroupSeries {
+	if len(tagSets) == 0 || !hasMatchCondition(opt.Condition) || !s.indexBuilder.HasIndex(tsi.Text) {
+		return tagSets
+	}
+
+	result, err := s.indexBuilder.Scan(span, record.Str2bytes(opt.Name), opt, tsi.Text)
+	if err != nil {
+		s.log.Warn("search text index fail", zap.Error(err))
+		return tagSets
+	}
+	ids, ok := result.(*uint64set.Set)
+	if !ok || ids == nil {
+		return tagSets
+	}
+	return tagSets.FilterByIDs(ids)
+}
+
+func hasMatchCondition(condition influxql.Expr) bool {
+	var found bool
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if expr, ok := node.(*influxql.BinaryExpr); ok && expr.Op == influxql.MATCH {
+			found = true
+		}
+	})
+	return found
 }
 
 func (s *shard) cloneMeasurementReaders(mm string) *immutable.MmsReaders {
@@ -279,7 +328,7 @@ func (s *shard) initGroupCursors(querySchema *executor.QuerySchema, parallelism
 }
 
 func (s *shard) createGroupCursors(span *tracing.Span, schema *executor.QuerySchema, tagSets []*tsi.TagSetInfo,
-	readers *immutable.MmsReaders) ([]comm.KeyCursor, error) {
+	readers *immutable.MmsReaders, downSample *downSampleReaders) ([]comm.KeyCursor, error) {
 
 	parallelism := schema.Options().GetMaxParallel()
 	if parallelism <= 0 {
@@ -324,7 +373,14 @@ func (s *shard) createGroupCursors(span *tracing.Span, schema *executor.QuerySch
 		}
 	}
 
-	enableFileCursor := executor.GetEnableFileCursor() && schema.HasInSeriesAgg()
+	if downSample != nil {
+		for i := 0; i < len(cursors); i++ {
+			ctx := cursors[i].(*groupCursor).ctx
+			ctx.downSample = newDownSampleContext(ctx, downSample)
+		}
+	}
+
+	enableFileCursor := downSample == nil && executor.GetEnableFileCursor() && schema.HasInSeriesAgg()
 
 	var startGroupIdx int
 	errs := make([]error, parallelism)
@@ -353,7 +409,9 @@ func (s *shard) createGroupCursors(span *tracing.Span, schema *executor.QuerySch
 				groupCur := cursors[groupIdx].(*groupCursor)
 				var tsCursor comm.KeyCursor
 				var err error
-				if enableFileCursor {
+				if downSample != nil {
+					tsCursor, err = s.newDownSampleTagSetCursor(groupCur.ctx, groupCur.span, schema, tagSet, start, subTagSetN)
+				} else if enableFileCursor {
 					tsCursor, err = s.newAggTagSetCursor(groupCur.ctx, groupCur.span, schema, tagSet, start, subTagSetN)
 				} else {
 					tsCursor, err = s.newTagSetCursor(groupCur.ctx, groupCur.span, schema, tagSet, start, subTagSetN)
@@ -459,6 +517,7 @@ type idKeyCursorContext struct {
 	seriesPool      *record.RecordPool
 	tmsMergePool    *record.RecordPool
 	querySchema     *executor.QuerySchema
+	downSample      *downSampleContext
 }
 
 func (i *idKeyCursorContext) hasAuxTags() bool {
@@ -704,6 +763,10 @@ func MatchPreAgg(schema *executor.QuerySchema, ctx *idKeyCursorContext) bool {
 		return false
 	}
 
+	if schema.HasUnsignedCall() {
+		return false
+	}
+
 	if hasInterval(schema) {
 		return false
 	}
@@ -2413,6 +2476,10 @@ func (c *aggregateCursor) SinkPlan(plan hybridqp.QueryNode) {
 		opsCopy = append(opsCopy[:idx-i], opsCopy[idx+1-i:]...)
 	}
 
+	if ds, ok := c.input.(*downSampleSeriesCursor); ok {
+		opsCopy = ds.plan.rewriteCallOptions(opsCopy)
+	}
+
 	c.SetSchema(inSchema, outSchema, opsCopy)
 }
 
@@ -2942,6 +3009,12 @@ func setSchemaColVal(field *record.Field, col *record.ColVal, rowIndex int) {
 			col.Init()
 			col.AppendInteger(value)
 		}
+	case influx.Field_Type_UInt:
+		value, isNil := col.UnsignedValue(rowIndex)
+		if !isNil {
+			col.Init()
+			col.AppendUnsigned(value)
+		}
 	case influx.Field_Type_String:
 		value, isNil := col.StringValueSafe(rowIndex)
 		if !isNil {
@@ -326,9 +326,12 @@ func checkSchemaIsSame(msSchema record.Schemas, fields []influx.Field) bool {
 }
 
 func (t *MemTable) appendFieldToCol(col *record.ColVal, field *influx.Field, size *int64) error {
-	if field.Type == influx.Field_Type_Int || field.Type == influx.Field_Type_UInt {
+	if field.Type == influx.Field_Type_Int {
 		col.AppendInteger(int64(field.NumValue))
 		*size += int64(record.Int64SizeBytes)
+	} else if field.Type == influx.Field_Type_UInt {
+		col.AppendUnsigned(field.UintValue)
+		*size += int64(record.Uint64SizeBytes)
 	} else if field.Type == influx.Field_Type_Float {
 		col.AppendFloat(field.NumValue)
 		*size += int64(record.Float64SizeBytes)
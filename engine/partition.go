@@ -34,6 +34,7 @@ import (
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"github.com/openGemini/openGemini/open_src/influx/meta"
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
@@ -312,7 +313,8 @@ func (dbPT *DBPTInfo) OpenIndexes(rp string) error {
 				Ident(indexIdent).
 				Path(ipath).
 				IndexType(tsi.MergeSet).
-				EndTime(tr.EndTime)
+				EndTime(tr.EndTime).
+				CardinalityLimits(dbPT.cardinalityLimits())
 
 			dbPT.mu.Lock()
 			// init indexBuilder and default indexRelation
@@ -378,10 +380,12 @@ func (dbPT *DBPTInfo) OpenIndexes(rp string) error {
 }
 
 func containOtherIndexes(dirName string) bool {
-	if dirName == "mergeset" || dirName == "kv" {
-		return false
+	for _, am := range tsi.IndexAms {
+		if am.IdxName == dirName {
+			return am.IdxType != tsi.MergeSet
+		}
 	}
-	return true
+	return false
 }
 
 func parseShardDir(shardDirName string) (uint64, uint64, *meta.TimeRangeInfo, error) {
@@ -503,6 +507,14 @@ func (dbPT *DBPTInfo) SetOption(opt netstorage.EngineOptions) {
 	dbPT.opt = opt
 }
 
+func (dbPT *DBPTInfo) cardinalityLimits() tsi.CardinalityLimits {
+	return tsi.CardinalityLimits{
+		MaxSeries:               dbPT.opt.MaxSeriesPerDatabase,
+		MaxSeriesPerMeasurement: dbPT.opt.MaxSeriesPerMeasurement,
+		MaxValuesPerTag:         dbPT.opt.MaxValuesPerTag,
+	}
+}
+
 func (dbPT *DBPTInfo) NewShard(rp string, shardID uint64, timeRangeInfo *meta.ShardTimeRangeInfo) (Shard, error) {
 	var err error
 	dbPTLockFile := dbPT.LockFile()
@@ -530,7 +542,8 @@ func (dbPT *DBPTInfo) NewShard(rp string, shardID uint64, timeRangeInfo *meta.Sh
 			Path(iPath).
 			IndexType(tsi.MergeSet).
 			EndTime(timeRangeInfo.OwnerIndex.TimeRange.EndTime).
-			Duration(timeRangeInfo.ShardDuration.DurationInfo.Duration)
+			Duration(timeRangeInfo.ShardDuration.DurationInfo.Duration).
+			CardinalityLimits(dbPT.cardinalityLimits())
 
 		// init indexBuilder and default indexRelation
 		indexBuilder = tsi.NewIndexBuilder(opts)
@@ -669,3 +682,88 @@ func (dbPT *DBPTInfo) seriesCardinalityWithCondition(measurements [][]byte, cond
 	}
 	return measurementCardinalityInfos, nil
 }
+
+// dropSeries adds tombstones for the matched series to all shards of the partition,
+// and removes the series which have no rows left from the index
+func (dbPT *DBPTInfo) dropSeries(names []string, condition influxql.Expr, tr record.TimeRange, allRows bool) (int, error) {
+	dbPT.mu.RLock()
+	defer dbPT.mu.RUnlock()
+
+	shardGroups := make(map[*tsi.IndexBuilder][]Shard, len(dbPT.indexBuilder))
+	for _, sh := range dbPT.shards {
+		if iBuild := sh.GetIndexBuild(); iBuild != nil {
+			shardGroups[iBuild] = append(shardGroups[iBuild], sh)
+		}
+	}
+
+	total := 0
+	for iBuild, shards := range shardGroups {
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			continue
+		}
+
+		series := make(map[string][]uint64, len(names))
+		for _, name := range names {
+			sids, err := idx.SearchTSIDs([]byte(name), condition)
+			if err != nil {
+				return total, err
+			}
+			if len(sids) > 0 {
+				series[name] = sids
+				total += len(sids)
+			}
+		}
+		if len(series) == 0 {
+			continue
+		}
+
+		for _, sh := range shards {
+			if err := sh.DeleteSeries(series, tr); err != nil {
+				return total, err
+			}
+		}
+
+		for name, sids := range series {
+			if !allRows {
+				var err error
+				if sids, err = deadSeries(shards, name, sids); err != nil {
+					return total, err
+				}
+			}
+			if err := idx.DeleteSeries(sids); err != nil {
+				return total, err
+			}
+		}
+
+		if len(series) > 0 {
+			if err := iBuild.Compact(); err != nil {
+				return total, err
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// deadSeries returns the series which have no rows left in any of the shards
+func deadSeries(shards []Shard, name string, sids []uint64) ([]uint64, error) {
+	dead := sids[:0]
+	for _, sid := range sids {
+		alive := false
+		for _, sh := range shards {
+			contains, err := sh.ContainsSeries(name, sid)
+			if err != nil {
+				return nil, err
+			}
+			if contains {
+				alive = true
+				break
+			}
+		}
+		if !alive {
+			dead = append(dead, sid)
+		}
+	}
+	return dead, nil
+}
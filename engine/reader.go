@@ -31,7 +31,7 @@ func NewRecordSchema(querySchema *executor.QuerySchema, auxTags []string, schema
 	fieldMap := dictpool.Dict{}
 	for key, ref := range querySchema.Refs() {
 		switch ref.Type {
-		case influxql.Integer, influxql.String, influxql.Boolean, influxql.Float:
+		case influxql.Integer, influxql.Unsigned, influxql.String, influxql.Boolean, influxql.Float:
 			{
 				fieldType := ref.Type
 				v := fieldMap.Get(key)
@@ -53,7 +53,7 @@ func NewRecordSchema(querySchema *executor.QuerySchema, auxTags []string, schema
 
 	for _, cond := range filterConditions {
 		switch cond.Type {
-		case influxql.Integer, influxql.String, influxql.Boolean, influxql.Float:
+		case influxql.Integer, influxql.Unsigned, influxql.String, influxql.Boolean, influxql.Float:
 			{
 				fieldType := cond.Type
 				v := fieldMap.Get(cond.String())
@@ -33,6 +33,11 @@ func integerCountReduce(cv *record.ColVal, values []int64, start, end int) (int,
 	return start, count, count == 0
 }
 
+func unsignedCountReduce(cv *record.ColVal, values []uint64, start, end int) (int, int64, bool) {
+	count := int64(cv.ValidCount(start, end))
+	return start, count, count == 0
+}
+
 func stringCountReduce(cv *record.ColVal, values []string, start, end int) (int, int64, bool) {
 	count := int64(cv.ValidCount(start, end))
 	return start, count, count == 0
@@ -83,6 +88,24 @@ func integerSumMerge(prevBuf, currBuf *integerColBuf) {
 	prevBuf.value += currBuf.value
 }
 
+func unsignedSumReduce(cv *record.ColVal, values []uint64, start, end int) (int, uint64, bool) {
+	var sum uint64
+	var aggregated int
+	if cv.Length()+cv.NilCount == 0 {
+		return start, 0, aggregated == 0
+	}
+	start, end = cv.GetValIndexRange(start, end)
+	for _, v := range values[start:end] {
+		sum += v
+		aggregated++
+	}
+	return start, sum, aggregated == 0
+}
+
+func unsignedSumMerge(prevBuf, currBuf *unsignedColBuf) {
+	prevBuf.value += currBuf.value
+}
+
 func floatMeanReduce(cv *record.ColVal, values []float64, start, end int) (int, float64, bool) {
 	var sum float64
 	var aggregated int
@@ -147,6 +170,22 @@ func integerMinMerge(prevBuf, currBuf *integerColBuf) {
 	}
 }
 
+func unsignedMinReduce(cv *record.ColVal, values []uint64, start, end int) (int, uint64, bool) {
+	minValue, minIndex := cv.MinUnsignedValue(values, start, end)
+	if minIndex == -1 {
+		return 0, 0, true
+	}
+	return minIndex, minValue, false
+}
+
+func unsignedMinMerge(prevBuf, currBuf *unsignedColBuf) {
+	if currBuf.value < prevBuf.value {
+		prevBuf.index = currBuf.index
+		prevBuf.time = currBuf.time
+		prevBuf.value = currBuf.value
+	}
+}
+
 func booleanMinReduce(cv *record.ColVal, values []bool, start, end int) (int, bool, bool) {
 	minValue, minIndex := cv.MinBooleanValue(values, start, end)
 	if minIndex == -1 {
@@ -195,6 +234,22 @@ func integerMaxMerge(prevBuf, currBuf *integerColBuf) {
 	}
 }
 
+func unsignedMaxReduce(cv *record.ColVal, values []uint64, start, end int) (int, uint64, bool) {
+	maxValue, maxIndex := cv.MaxUnsignedValue(values, start, end)
+	if maxIndex == -1 {
+		return 0, 0, true
+	}
+	return maxIndex, maxValue, false
+}
+
+func unsignedMaxMerge(prevBuf, currBuf *unsignedColBuf) {
+	if currBuf.value > prevBuf.value {
+		prevBuf.index = currBuf.index
+		prevBuf.time = currBuf.time
+		prevBuf.value = currBuf.value
+	}
+}
+
 func booleanMaxReduce(cv *record.ColVal, values []bool, start, end int) (int, bool, bool) {
 	maxValue, maxIndex := cv.MaxBooleanValue(values, start, end)
 	if maxIndex == -1 {
@@ -233,6 +288,17 @@ func integerFirstReduce(cv *record.ColVal, values []int64, start, end int) (int,
 func integerFirstMerge(prevBuf, currBuf *integerColBuf) {
 }
 
+func unsignedFirstReduce(cv *record.ColVal, values []uint64, start, end int) (int, uint64, bool) {
+	firstValue, firstIndex := cv.FirstUnsignedValue(values, start, end)
+	if firstIndex == -1 {
+		return 0, 0, true
+	}
+	return firstIndex, firstValue, false
+}
+
+func unsignedFirstMerge(prevBuf, currBuf *unsignedColBuf) {
+}
+
 func stringFirstReduce(cv *record.ColVal, values []string, start, end int) (int, string, bool) {
 	firstValue, firstIndex := cv.FirstStringValue(values, start, end)
 	if firstIndex == -1 {
@@ -281,6 +347,19 @@ func integerLastMerge(prevBuf, currBuf *integerColBuf) {
 	prevBuf.assign(currBuf)
 }
 
+// note: last is designed in ascending order.
+func unsignedLastReduce(cv *record.ColVal, values []uint64, start, end int) (int, uint64, bool) {
+	lastValue, lastIndex := cv.LastUnsignedValue(values, start, end)
+	if lastIndex == -1 {
+		return 0, 0, true
+	}
+	return lastIndex, lastValue, false
+}
+
+func unsignedLastMerge(prevBuf, currBuf *unsignedColBuf) {
+	prevBuf.assign(currBuf)
+}
+
 // note: last is designed in ascending order.
 func stringLastReduce(cv *record.ColVal, values []string, start, end int) (int, string, bool) {
 	lastValue, lastIndex := cv.LastStringValue(values, start, end)
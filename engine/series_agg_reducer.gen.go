@@ -49,6 +49,16 @@ func integerAuxHelpFunc(input, output *record.ColVal, index ...int) {
 	}
 }
 
+func unsignedAuxHelpFunc(input, output *record.ColVal, index ...int) {
+	for _, idx := range index {
+		if v, isNil := input.UnsignedValue(idx); !isNil {
+			output.AppendUnsigned(v)
+		} else {
+			output.AppendUnsignedNull()
+		}
+	}
+}
+
 func stringAuxHelpFunc(input, output *record.ColVal, index ...int) {
 	for _, idx := range index {
 		if v, isNil := input.StringValueUnsafe(idx); !isNil {
@@ -125,6 +135,34 @@ func (b *integerColBuf) assign(src *integerColBuf) {
 	b.value = src.value
 }
 
+type unsignedColBuf struct {
+	index int
+	time  int64
+	value uint64
+	isNil bool
+}
+
+func newUnsignedColBuf() *unsignedColBuf {
+	return &unsignedColBuf{isNil: true}
+}
+
+func (b *unsignedColBuf) set(index int, time int64, value uint64) {
+	b.index = index
+	b.time = time
+	b.value = value
+	b.isNil = false
+}
+
+func (b *unsignedColBuf) reset() {
+	b.isNil = true
+}
+
+func (b *unsignedColBuf) assign(src *unsignedColBuf) {
+	b.index = src.index
+	b.time = src.time
+	b.value = src.value
+}
+
 type stringColBuf struct {
 	index int
 	time  int64
@@ -1261,6 +1299,276 @@ func (r *integerColBooleanReducer) appendOutRecord(inRecord, outRecord *record.R
 	}
 }
 
+type unsignedColIntegerReduce func(col *record.ColVal, values []uint64, bmStart, bmEnd int) (index int, value int64, isNil bool)
+
+type unsignedColIntegerMerge func(prevColumn, currColumn *integerColBuf)
+
+type unsignedColIntegerReducer struct {
+	fn           unsignedColIntegerReduce
+	fv           unsignedColIntegerMerge
+	prevBuf      *integerColBuf
+	currBuf      *integerColBuf
+	auxRecord    *record.Record
+	auxProcessor []*auxProcessor
+}
+
+func newUnsignedColIntegerReducer(fn unsignedColIntegerReduce, fv unsignedColIntegerMerge, auxProcessor []*auxProcessor) *unsignedColIntegerReducer {
+	r := &unsignedColIntegerReducer{
+		fn:           fn,
+		fv:           fv,
+		prevBuf:      newIntegerColBuf(),
+		currBuf:      newIntegerColBuf(),
+		auxProcessor: auxProcessor,
+	}
+	return r
+}
+
+func (r *unsignedColIntegerReducer) Aggregate(p *ReducerEndpoint, param *ReducerParams) {
+	if len(r.auxProcessor) > 0 && r.auxRecord == nil {
+		r.auxRecord = record.NewRecordBuilder(p.OutputPoint.Record.Schema)
+	}
+	var end int
+	inRecord, outRecord := p.InputPoint.Record, p.OutputPoint.Record
+	inOrdinal, outOrdinal := p.InputPoint.Ordinal, p.OutputPoint.Ordinal
+	firstIndex, lastIndex := 0, len(param.intervalIndex)-1
+	values := inRecord.ColVals[inOrdinal].UnsignedValues()
+
+	for i, start := range param.intervalIndex {
+		if i < lastIndex {
+			end = int(param.intervalIndex[i+1])
+		} else {
+			end = inRecord.RowNums()
+		}
+
+		index, value, isNil := r.fn(&inRecord.ColVals[inOrdinal], values, int(start), end)
+
+		if !isNil {
+			// A.the aggregation result is not empty.
+			if i == firstIndex && !r.prevBuf.isNil {
+				// 1.the aggregation result and prevBuf belong to the same time window.
+				r.currBuf.set(index+1, inRecord.Time(index), value)
+				r.fv(r.prevBuf, r.currBuf)
+				// 1.1 the prevBuf and the first group with the next record belong to the same time window.
+				if firstIndex == lastIndex && param.sameWindow {
+					if len(r.auxProcessor) > 0 && r.prevBuf.index > 0 {
+						r.auxRecord.Reuse()
+						r.appendAuxRecord(inRecord, r.auxRecord, r.prevBuf.index-1)
+					}
+					r.prevBuf.index = 0
+				} else {
+					// 1.2 the prevBuf belong to a complete time window.
+					outRecord.ColVals[outOrdinal].AppendInteger(r.prevBuf.value)
+					if !param.multiCall {
+						outRecord.AppendTime(r.prevBuf.time)
+					}
+					if len(r.auxProcessor) > 0 {
+						if r.prevBuf.index == 0 {
+							r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+						} else {
+							r.appendAuxRecord(inRecord, outRecord, r.prevBuf.index-1)
+						}
+						r.auxRecord.Reuse()
+					}
+					r.prevBuf.reset()
+				}
+				r.currBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				// 2.the aggregation result and the first group with the next record belong to the same time window.
+				r.prevBuf.set(0, inRecord.Time(index), value)
+				if len(r.auxProcessor) > 0 {
+					r.appendAuxRecord(inRecord, r.auxRecord, index)
+				}
+				break
+			}
+			// 3.the aggregation result belong to a complete time window.
+			outRecord.ColVals[outOrdinal].AppendInteger(value)
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		} else {
+			// B. the aggregation result is empty.
+			if (i == firstIndex && !r.prevBuf.isNil) && (firstIndex < lastIndex || !param.sameWindow) {
+				outRecord.ColVals[outOrdinal].AppendInteger(r.prevBuf.value)
+				if !param.multiCall {
+					outRecord.AppendTime(r.prevBuf.time)
+				}
+				if len(r.auxProcessor) > 0 {
+					r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+					r.auxRecord.Reuse()
+				}
+				r.prevBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				break
+			}
+			outRecord.ColVals[outOrdinal].AppendIntegerNull()
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		}
+	}
+}
+
+func (r *unsignedColIntegerReducer) appendAuxRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].inOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
+func (r *unsignedColIntegerReducer) appendOutRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
+type unsignedColUnsignedReduce func(col *record.ColVal, values []uint64, bmStart, bmEnd int) (index int, value uint64, isNil bool)
+
+type unsignedColUnsignedMerge func(prevColumn, currColumn *unsignedColBuf)
+
+type unsignedColUnsignedReducer struct {
+	fn           unsignedColUnsignedReduce
+	fv           unsignedColUnsignedMerge
+	prevBuf      *unsignedColBuf
+	currBuf      *unsignedColBuf
+	auxRecord    *record.Record
+	auxProcessor []*auxProcessor
+}
+
+func newUnsignedColUnsignedReducer(fn unsignedColUnsignedReduce, fv unsignedColUnsignedMerge, auxProcessor []*auxProcessor) *unsignedColUnsignedReducer {
+	r := &unsignedColUnsignedReducer{
+		fn:           fn,
+		fv:           fv,
+		prevBuf:      newUnsignedColBuf(),
+		currBuf:      newUnsignedColBuf(),
+		auxProcessor: auxProcessor,
+	}
+	return r
+}
+
+func (r *unsignedColUnsignedReducer) Aggregate(p *ReducerEndpoint, param *ReducerParams) {
+	if len(r.auxProcessor) > 0 && r.auxRecord == nil {
+		r.auxRecord = record.NewRecordBuilder(p.OutputPoint.Record.Schema)
+	}
+	var end int
+	inRecord, outRecord := p.InputPoint.Record, p.OutputPoint.Record
+	inOrdinal, outOrdinal := p.InputPoint.Ordinal, p.OutputPoint.Ordinal
+	firstIndex, lastIndex := 0, len(param.intervalIndex)-1
+	values := inRecord.ColVals[inOrdinal].UnsignedValues()
+
+	for i, start := range param.intervalIndex {
+		if i < lastIndex {
+			end = int(param.intervalIndex[i+1])
+		} else {
+			end = inRecord.RowNums()
+		}
+
+		index, value, isNil := r.fn(&inRecord.ColVals[inOrdinal], values, int(start), end)
+
+		if !isNil {
+			// A.the aggregation result is not empty.
+			if i == firstIndex && !r.prevBuf.isNil {
+				// 1.the aggregation result and prevBuf belong to the same time window.
+				r.currBuf.set(index+1, inRecord.Time(index), value)
+				r.fv(r.prevBuf, r.currBuf)
+				// 1.1 the prevBuf and the first group with the next record belong to the same time window.
+				if firstIndex == lastIndex && param.sameWindow {
+					if len(r.auxProcessor) > 0 && r.prevBuf.index > 0 {
+						r.auxRecord.Reuse()
+						r.appendAuxRecord(inRecord, r.auxRecord, r.prevBuf.index-1)
+					}
+					r.prevBuf.index = 0
+				} else {
+					// 1.2 the prevBuf belong to a complete time window.
+					outRecord.ColVals[outOrdinal].AppendUnsigned(r.prevBuf.value)
+					if !param.multiCall {
+						outRecord.AppendTime(r.prevBuf.time)
+					}
+					if len(r.auxProcessor) > 0 {
+						if r.prevBuf.index == 0 {
+							r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+						} else {
+							r.appendAuxRecord(inRecord, outRecord, r.prevBuf.index-1)
+						}
+						r.auxRecord.Reuse()
+					}
+					r.prevBuf.reset()
+				}
+				r.currBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				// 2.the aggregation result and the first group with the next record belong to the same time window.
+				r.prevBuf.set(0, inRecord.Time(index), value)
+				if len(r.auxProcessor) > 0 {
+					r.appendAuxRecord(inRecord, r.auxRecord, index)
+				}
+				break
+			}
+			// 3.the aggregation result belong to a complete time window.
+			outRecord.ColVals[outOrdinal].AppendUnsigned(value)
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		} else {
+			// B. the aggregation result is empty.
+			if (i == firstIndex && !r.prevBuf.isNil) && (firstIndex < lastIndex || !param.sameWindow) {
+				outRecord.ColVals[outOrdinal].AppendUnsigned(r.prevBuf.value)
+				if !param.multiCall {
+					outRecord.AppendTime(r.prevBuf.time)
+				}
+				if len(r.auxProcessor) > 0 {
+					r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+					r.auxRecord.Reuse()
+				}
+				r.prevBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				break
+			}
+			outRecord.ColVals[outOrdinal].AppendUnsignedNull()
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		}
+	}
+}
+
+func (r *unsignedColUnsignedReducer) appendAuxRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].inOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
+func (r *unsignedColUnsignedReducer) appendOutRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
 type stringColFloatReduce func(col *record.ColVal, values []string, bmStart, bmEnd int) (index int, value float64, isNil bool)
 
 type stringColFloatMerge func(prevColumn, currColumn *floatColBuf)
@@ -2635,6 +2943,149 @@ func (r *integerTimeColIntegerReducer) appendOutRecord(inRecord, outRecord *reco
 	}
 }
 
+type unsignedTimeColUnsignedReduce func(col *record.ColVal, values []uint64, bmStart, bmEnd int) (index int, value uint64, isNil bool)
+
+type unsignedTimeColUnsignedMerge func(prevColumn, currColumn *unsignedColBuf)
+
+type unsignedTimeColUnsignedReducer struct {
+	fn           unsignedTimeColUnsignedReduce
+	fv           unsignedTimeColUnsignedMerge
+	prevBuf      *unsignedColBuf
+	currBuf      *unsignedColBuf
+	auxRecord    *record.Record
+	auxProcessor []*auxProcessor
+}
+
+func newUnsignedTimeColUnsignedReducer(fn unsignedTimeColUnsignedReduce, fv unsignedTimeColUnsignedMerge, auxProcessor []*auxProcessor) *unsignedTimeColUnsignedReducer {
+	return &unsignedTimeColUnsignedReducer{
+		fn:           fn,
+		fv:           fv,
+		prevBuf:      newUnsignedColBuf(),
+		currBuf:      newUnsignedColBuf(),
+		auxProcessor: auxProcessor,
+	}
+}
+
+func (r *unsignedTimeColUnsignedReducer) Aggregate(p *ReducerEndpoint, param *ReducerParams) {
+	if len(r.auxProcessor) > 0 && r.auxRecord == nil {
+		r.auxRecord = record.NewRecordBuilder(p.OutputPoint.Record.Schema)
+	}
+	var end int
+	inRecord, outRecord := p.InputPoint.Record, p.OutputPoint.Record
+	inOrdinal, outOrdinal := p.InputPoint.Ordinal, p.OutputPoint.Ordinal
+	firstIndex, lastIndex := 0, len(param.intervalIndex)-1
+
+	values := inRecord.ColVals[inOrdinal].UnsignedValues()
+
+	for i, start := range param.intervalIndex {
+		if i < lastIndex {
+			end = int(param.intervalIndex[i+1])
+		} else {
+			end = inRecord.RowNums()
+		}
+
+		index, value, isNil := r.fn(&inRecord.ColVals[inOrdinal], values, int(start), end)
+
+		if !isNil {
+			// A.the aggregation result is not empty.
+			if i == firstIndex && !r.prevBuf.isNil {
+				// 1.the aggregation result and prevBuf belong to the same time window.
+				r.currBuf.set(index+1, inRecord.Time(index), value)
+				r.fv(r.prevBuf, r.currBuf)
+				// 1.1 the prevBuf and the first group with the next record belong to the same time window.
+				if firstIndex == lastIndex && param.sameWindow {
+					if len(r.auxProcessor) > 0 && r.prevBuf.index > 0 {
+						r.auxRecord.Reuse()
+						r.appendAuxRecord(inRecord, r.auxRecord, r.prevBuf.index-1)
+					}
+					r.prevBuf.index = 0
+				} else {
+					// 1.2 the prevBuf belong to a complete time window.
+					outRecord.ColVals[outOrdinal].AppendUnsigned(r.prevBuf.value)
+					if !param.multiCall {
+						outRecord.AppendTime(r.prevBuf.time)
+					} else {
+						outRecord.RecMeta.Times[outOrdinal] = append(outRecord.RecMeta.Times[outOrdinal], r.prevBuf.time)
+					}
+					if len(r.auxProcessor) > 0 {
+						if r.prevBuf.index == 0 {
+							r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+						} else {
+							r.appendAuxRecord(inRecord, outRecord, r.prevBuf.index-1)
+						}
+						r.auxRecord.Reuse()
+					}
+					r.prevBuf.reset()
+				}
+				r.currBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				// 2.the aggregation result and the first group with the next record belong to the same time window.
+				r.prevBuf.set(0, inRecord.Time(index), value)
+				if len(r.auxProcessor) > 0 {
+					r.appendAuxRecord(inRecord, r.auxRecord, index)
+				}
+				break
+			}
+			// 3.the aggregation result belong to a complete time window.
+			outRecord.ColVals[outOrdinal].AppendUnsigned(value)
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			} else {
+				outRecord.RecMeta.Times[outOrdinal] = append(outRecord.RecMeta.Times[outOrdinal], inRecord.Time(index))
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		} else {
+			// B. the aggregation result is empty.
+			if (i == firstIndex && !r.prevBuf.isNil) && (firstIndex < lastIndex || !param.sameWindow) {
+				outRecord.ColVals[outOrdinal].AppendUnsigned(r.prevBuf.value)
+				if !param.multiCall {
+					outRecord.AppendTime(r.prevBuf.time)
+				} else {
+					outRecord.RecMeta.Times[outOrdinal] = append(outRecord.RecMeta.Times[outOrdinal], r.prevBuf.time)
+				}
+				if len(r.auxProcessor) > 0 {
+					r.appendOutRecord(r.auxRecord, outRecord, r.prevBuf.index)
+					r.auxRecord.Reuse()
+				}
+				r.prevBuf.reset()
+				continue
+			} else if i == lastIndex && param.sameWindow {
+				break
+			}
+			outRecord.ColVals[outOrdinal].AppendUnsignedNull()
+			if !param.multiCall {
+				outRecord.AppendTime(inRecord.Time(index))
+			} else {
+				outRecord.RecMeta.Times[outOrdinal] = append(outRecord.RecMeta.Times[outOrdinal], 0)
+			}
+			if len(r.auxProcessor) > 0 {
+				r.appendAuxRecord(inRecord, outRecord, index)
+			}
+		}
+	}
+}
+
+func (r *unsignedTimeColUnsignedReducer) appendAuxRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].inOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
+func (r *unsignedTimeColUnsignedReducer) appendOutRecord(inRecord, outRecord *record.Record, index int) {
+	for i := range r.auxProcessor {
+		r.auxProcessor[i].auxHelperFunc(
+			&inRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			&outRecord.ColVals[r.auxProcessor[i].outOrdinal],
+			index)
+	}
+}
+
 type stringTimeColStringReduce func(col *record.ColVal, values []string, bmStart, bmEnd int) (index int, value string, isNil bool)
 
 type stringTimeColStringMerge func(prevColumn, currColumn *stringColBuf)
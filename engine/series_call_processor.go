@@ -94,6 +94,12 @@ func newCountRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOp
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedColIntegerReducer(unsignedCountReduce, integerCountMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColIntegerReducer(floatCountReduce, integerCountMerge, auxProcessors),
@@ -131,6 +137,12 @@ func newSumRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedColUnsignedReducer(unsignedSumReduce, unsignedSumMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatSumReduce, floatSumMerge, auxProcessors),
@@ -181,6 +193,12 @@ func newMinRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedColUnsignedReducer(unsignedMinReduce, unsignedMinMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatMinReduce, floatMinMerge, auxProcessors),
@@ -212,6 +230,12 @@ func newMaxRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedColUnsignedReducer(unsignedMaxReduce, unsignedMaxMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatMaxReduce, floatMaxMerge, auxProcessors),
@@ -243,6 +267,12 @@ func newFirstRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOp
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedTimeColUnsignedReducer(unsignedFirstReduce, unsignedFirstMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatTimeColFloatReducer(floatFirstReduce, floatFirstMerge, auxProcessors),
@@ -280,6 +310,12 @@ func newLastRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpt
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newUnsignedTimeColUnsignedReducer(unsignedLastReduce, unsignedLastMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatTimeColFloatReducer(floatLastReduce, floatLastMerge, auxProcessors),
@@ -425,6 +461,12 @@ func newAuxProcessor(inSchema, outSchema record.Schemas, opt hybridqp.ExprOption
 			outOrdinal:    outOrdinal,
 			auxHelperFunc: integerAuxHelpFunc,
 		}
+	case influx.Field_Type_UInt:
+		return &auxProcessor{
+			inOrdinal:     inOrdinal,
+			outOrdinal:    outOrdinal,
+			auxHelperFunc: unsignedAuxHelpFunc,
+		}
 	case influx.Field_Type_Float:
 		return &auxProcessor{
 			inOrdinal:     inOrdinal,
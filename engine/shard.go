@@ -34,6 +34,7 @@ import (
 	"github.com/openGemini/openGemini/engine/index/ski"
 	"github.com/openGemini/openGemini/engine/index/tsi"
 	"github.com/openGemini/openGemini/engine/mutable"
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/bucket"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -105,6 +106,18 @@ type Shard interface {
 
 	DropMeasurement(ctx context.Context, name string) error
 
+	DeleteSeries(series map[string][]uint64, tr record.TimeRange) error
+
+	ContainsSeries(name string, sid uint64) (bool, error)
+
+	DownSampleInfo() *meta.ShardDownSampleInfo
+
+	DownSample(level int, policy *meta.DownSamplePolicyInfo) error
+
+	Backup(dir string, base backup.FileSet) ([]backup.File, error)
+	ReadWal() ([][]byte, error)
+	ReadRows(cursor netstorage.RowsCursor, limit int) ([]byte, netstorage.RowsCursor, bool, error)
+
 	Statistics(buffer []byte) ([]byte, error)
 
 	NewShardKeyIdx(shardType, dataPath string) error
@@ -203,7 +216,7 @@ func NewShard(dataPath string, walPath string, ident *meta.ShardIdentifier, inde
 		walPath:           walPath,
 		tsspPath:          tsspPath,
 		ident:             ident,
-		wal:               NewWAL(walPath, options.WalSyncInterval, options.WalEnabled, options.WalReplayParallel, getWalPartitionNum()),
+		wal:               NewWAL(walPath, options.WalSyncInterval, options.WalEnabled, options.WalReplayParallel, options.WalReplaySalvage, getWalPartitionNum()),
 		activeTbl:         mutable.NewMemTable(mutable.NewConfig(), dataPath),
 		indexBuilder:      indexBuilder,
 		maxTime:           0,
@@ -311,15 +324,17 @@ func (s *shard) WriteRows(rows []influx.Row, binaryRows []byte) error {
 
 	atomic.StoreUint64(&s.lastWriteTime, fasttime.UnixTimestamp())
 
-	if err := s.writeRowsToTable(rows, binaryRows); err != nil {
+	err := s.writeRowsToTable(rows, binaryRows)
+	partialErr, ok := err.(netstorage.PartialWriteError)
+	if err != nil && !ok {
 		log.Error("write buffer failed", zap.Error(err))
 		atomic.AddInt64(&statistics.PerfStat.WriteReqErrors, 1)
 		return err
 	}
 
 	atomic.AddInt64(&statistics.PerfStat.WriteRowsBatch, 1)
-	atomic.AddInt64(&statistics.PerfStat.WriteRowsCount, int64(len(rows)))
-	return nil
+	atomic.AddInt64(&statistics.PerfStat.WriteRowsCount, int64(len(rows)-partialErr.Dropped))
+	return err
 }
 
 func (s *shard) shouldSnapshot() bool {
@@ -466,7 +481,7 @@ func calculateMemSize(rows influx.Rows) int64 {
 				memCost += int64(len(rows[i].Fields[j].StrValue))
 			} else if rows[i].Fields[j].Type == influx.Field_Type_Boolean {
 				memCost += int64(record.BooleanSizeBytes)
-			} else if rows[i].Fields[j].Type == influx.Field_Type_Int {
+			} else if rows[i].Fields[j].Type == influx.Field_Type_Int || rows[i].Fields[j].Type == influx.Field_Type_UInt {
 				memCost += int64(record.Uint64SizeBytes)
 			}
 		}
@@ -499,6 +514,9 @@ func (s *shard) writeRowsToTable(rows influx.Rows, binaryRows []byte) error {
 	atomic.AddInt64(&statistics.PerfStat.WriteSortIndexDurationNs, time.Since(start).Nanoseconds())
 
 	var writeIndexRequired bool
+	var indexOptionRequired bool
+	var dropped int
+	var partialErr error
 	start = time.Now()
 
 	tm := int64(math.MinInt64)
@@ -531,6 +549,10 @@ func (s *shard) writeRowsToTable(rows influx.Rows, binaryRows []byte) error {
 			tm = rows[i].Timestamp
 		}
 
+		if len(rows[i].IndexOptions) > 0 {
+			indexOptionRequired = true
+		}
+
 		if !writeIndexRequired {
 			ri.SeriesId, err = mergetIndex.GetSeriesIdBySeriesKey(rows[i].IndexKey, record.Str2bytes(rows[i].Name))
 			if err != nil {
@@ -552,10 +574,24 @@ func (s *shard) writeRowsToTable(rows influx.Rows, binaryRows []byte) error {
 		failpoint.Inject("SlowDownCreateIndex", nil)
 
 		if err = s.indexBuilder.CreateIndexIfNotExists(mmPoints); err != nil {
-			return err
+			if !tsi.IsCardinalityLimitError(err) {
+				return err
+			}
+			// the rows rejected by a cardinality limit are dropped and the others are written
+			dropped = dropRowsWithoutSeries(mmPoints)
+			partialErr = netstorage.PartialWriteError{Reason: err, Dropped: dropped}
+			if dropped == len(rows) {
+				nodeMutableLimit.freeResource(curSize)
+				return partialErr
+			}
+			if binaryRows != nil {
+				if binaryRows, err = marshalMstRows(mmPoints); err != nil {
+					return err
+				}
+			}
 		}
 	} else {
-		if err = s.indexBuilder.CreateIndexIfPrimaryKeyExists(mmPoints, false); err != nil {
+		if err = s.indexBuilder.CreateIndexIfPrimaryKeyExists(mmPoints, indexOptionRequired); err != nil {
 			return err
 		}
 	}
@@ -591,8 +627,42 @@ func (s *shard) writeRowsToTable(rows influx.Rows, binaryRows []byte) error {
 	}
 	atomic.AddInt64(&statistics.PerfStat.WriteWalDurationNs, time.Since(start).Nanoseconds())
 	s.snapshotLock.RUnlock()
-	s.addRowCounts(int64(len(rows)))
-	return nil
+	s.addRowCounts(int64(len(rows) - dropped))
+	return partialErr
+}
+
+// dropRowsWithoutSeries removes the rows that the index did not create a series for, and returns their number.
+func dropRowsWithoutSeries(mmPoints *dictpool.Dict) int {
+	dropped := 0
+	for i := range mmPoints.D {
+		rows, ok := mmPoints.D[i].Value.(*[]influx.Row)
+		if !ok {
+			continue
+		}
+		// the rows are swapped rather than copied, so that the pooled rows do not share their buffers
+		n := 0
+		for j := range *rows {
+			if (*rows)[j].SeriesId == 0 {
+				dropped++
+				continue
+			}
+			(*rows)[n], (*rows)[j] = (*rows)[j], (*rows)[n]
+			n++
+		}
+		*rows = (*rows)[:n]
+	}
+	return dropped
+}
+
+// marshalMstRows marshals the rows of all measurements for the wal.
+func marshalMstRows(mmPoints *dictpool.Dict) ([]byte, error) {
+	var rows []influx.Row
+	for i := range mmPoints.D {
+		if mstRows, ok := mmPoints.D[i].Value.(*[]influx.Row); ok {
+			rows = append(rows, *mstRows...)
+		}
+	}
+	return influx.FastMarshalMultiRows(nil, rows)
 }
 
 func (s *shard) enableForceFlush() {
@@ -877,7 +947,12 @@ func (s *shard) writeWalBuffer(binary []byte) error {
 		return nil
 	}
 
-	return s.writeRowsToTable(rows, nil)
+	err = s.writeRowsToTable(rows, nil)
+	if _, ok := err.(netstorage.PartialWriteError); ok {
+		// the rows over a cardinality limit were dropped from the wal when they were written
+		return nil
+	}
+	return err
 }
 
 func (s *shard) replayWal() error {
@@ -1012,6 +1087,229 @@ func (s *shard) DropMeasurement(ctx context.Context, name string) error {
 	return s.immTables.DropMeasurement(ctx, name)
 }
 
+func (s *shard) DeleteSeries(series map[string][]uint64, tr record.TimeRange) error {
+	if s.closed.Closed() {
+		return ErrShardClosed
+	}
+
+	// flush data in mem, so that tombstones cover all written rows. The flush runs without s.mu,
+	// the writers are not blocked by it
+	s.ForceFlush()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return ErrShardClosed
+	}
+
+	for name, sids := range series {
+		if err := s.immTables.DeleteSeries(name, sids, tr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *shard) ContainsSeries(name string, sid uint64) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return false, ErrShardClosed
+	}
+	return s.immTables.ContainsSeries(name, sid)
+}
+
+func (s *shard) DownSampleInfo() *meta.ShardDownSampleInfo {
+	return &meta.ShardDownSampleInfo{
+		Ident:   *s.ident,
+		EndTime: s.endTime,
+		Level:   s.immTables.DownSampleState().Level,
+	}
+}
+
+// DownSample aggregates the data of the shard as the level of the policy requires
+func (s *shard) DownSample(level int, policy *meta.DownSamplePolicyInfo) error {
+	if level <= 0 || level > len(policy.Levels) {
+		return fmt.Errorf("invalid downsample level %d", level)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return ErrShardClosed
+	}
+
+	// flush data in mem, so that all written rows are down sampled
+	s.ForceFlush()
+
+	calls := make(map[int][]string, len(policy.Calls))
+	for _, c := range policy.Calls {
+		calls[record.ToModelTypes(c.DataType)] = c.Ops
+	}
+	return s.immTables.DownSample(level, int64(policy.Levels[level-1].TimeInterval), calls)
+}
+
+// Backup flushes the mutable table and writes a copy of the immutable files and the shard key index to dir, the
+// TSSP files in base, the files of the shard in the previous backup, are left out. It returns the files of the shard.
+func (s *shard) Backup(dir string, base backup.FileSet) ([]backup.File, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return nil, ErrShardClosed
+	}
+
+	s.ForceFlush()
+
+	files, err := s.immTables.Backup(filepath.Join(dir, immutable.TsspDirName), base.Sub(immutable.TsspDirName))
+	if err != nil {
+		return nil, err
+	}
+	files = backup.Rebase(immutable.TsspDirName, files)
+	if s.skIdx == nil {
+		return files, nil
+	}
+	if err = s.skIdx.Backup(dir); err != nil {
+		return nil, err
+	}
+	skFiles, err := backup.ListFiles(filepath.Join(dir, ski.ShardKeyDirectory))
+	if err != nil {
+		return nil, err
+	}
+	return append(files, backup.Rebase(ski.ShardKeyDirectory, skFiles)...), nil
+}
+
+// ReadWal returns the records kept by the WAL of the shard, which hold the rows written since the last flush. The
+// records of a flush in progress may be missing if the flush removes them while they are read.
+func (s *shard) ReadWal() ([][]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return nil, ErrShardClosed
+	}
+
+	s.snapshotLock.RLock()
+	defer s.snapshotLock.RUnlock()
+	var mu sync.Mutex
+	var records [][]byte
+	_, err := s.wal.Replay(func(binary []byte) error {
+		mu.Lock()
+		records = append(records, binary)
+		mu.Unlock()
+		return nil
+	})
+	return records, err
+}
+
+// ReadRows reads the flushed rows of the shard from cursor on, and stops after the series with which the rows reach
+// limit. It returns the rows, marshaled as a write request, and the cursor to read the next rows from, done is true
+// once all flushed rows are read. The series ids differ between the replicas of a shard, so a replica that missed
+// writes is re-synced with the rows rather than with copies of the files.
+func (s *shard) ReadRows(cursor netstorage.RowsCursor, limit int) ([]byte, netstorage.RowsCursor, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed.Closed() {
+		return nil, cursor, false, ErrShardClosed
+	}
+	// the raw rows are gone once the shard is down sampled
+	if s.immTables.DownSampleState().Level > 0 {
+		return nil, cursor, false, ErrShardDownSampled
+	}
+
+	index := s.indexBuilder.GetPrimaryIndex()
+	var rows []influx.Row
+	var key []byte
+	for _, name := range s.immTables.Measurements() {
+		if name < cursor.Measurement {
+			continue
+		}
+		if name > cursor.Measurement {
+			cursor = netstorage.RowsCursor{Measurement: name}
+		}
+		for {
+			var readErr error
+			full := false
+			err := s.immTables.ReadSeries(name, !cursor.OutOfOrder, cursor.SeriesID, func(id uint64, rec *record.Record) bool {
+				key, readErr = index.SearchSeriesKey(key[:0], id)
+				if readErr != nil {
+					return false
+				}
+				var tags influx.PointTags
+				if _, readErr = influx.IndexKeyToTags(key, true, &tags); readErr != nil {
+					return false
+				}
+				rows = appendRecordRows(rows, name, tags, rec)
+				cursor.SeriesID = id + 1
+				full = len(rows) >= limit
+				return !full
+			})
+			if err == nil {
+				err = readErr
+			}
+			if err != nil {
+				return nil, cursor, false, err
+			}
+			if full {
+				buf, err := influx.FastMarshalMultiRows(nil, rows)
+				return buf, cursor, false, err
+			}
+			if cursor.OutOfOrder {
+				break
+			}
+			cursor.OutOfOrder = true
+			cursor.SeriesID = 0
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, cursor, true, nil
+	}
+	buf, err := influx.FastMarshalMultiRows(nil, rows)
+	return buf, cursor, true, err
+}
+
+// appendRecordRows appends the rows of rec, which holds the rows of a series of measurement name, to dst
+func appendRecordRows(dst []influx.Row, name string, tags influx.PointTags, rec *record.Record) []influx.Row {
+	fieldN := rec.ColNums() - 1
+	// the position of the next value of each column, the nil values are not stored
+	pos := make([]int, fieldN)
+	for i, t := range rec.Times() {
+		row := influx.Row{Name: name, Tags: tags, Timestamp: t, Fields: make(influx.Fields, 0, fieldN)}
+		for j := 0; j < fieldN; j++ {
+			col := &rec.ColVals[j]
+			if col.IsNil(i) {
+				continue
+			}
+			field := influx.Field{Key: rec.Schema[j].Name, Type: int32(rec.Schema[j].Type)}
+			switch rec.Schema[j].Type {
+			case influx.Field_Type_Int:
+				field.NumValue = float64(col.IntegerValues()[pos[j]])
+			case influx.Field_Type_UInt:
+				field.UintValue = col.UnsignedValues()[pos[j]]
+			case influx.Field_Type_Float:
+				field.NumValue = col.FloatValues()[pos[j]]
+			case influx.Field_Type_Boolean:
+				if col.BooleanValues()[pos[j]] {
+					field.NumValue = 1
+				}
+			case influx.Field_Type_String:
+				field.StrValue, _ = col.StringValueSafe(i)
+			}
+			pos[j]++
+			row.Fields = append(row.Fields, field)
+		}
+		if len(row.Fields) > 0 {
+			dst = append(dst, row)
+		}
+	}
+	return dst
+}
+
 func (s *shard) Statistics(buffer []byte) ([]byte, error) {
 	s.mu.RLock()
 	if s.closed.Closed() {
@@ -38,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/bufferpool"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/rand"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
@@ -1968,6 +1969,59 @@ func TestDropMeasurement(t *testing.T) {
 	}
 }
 
+func TestShard_ReadRows(t *testing.T) {
+	testDir := t.TempDir()
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeShard(sh)
+
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord([]string{"cpu", "mem"}, 10, 20, time.Second, tm, false, true, true)
+	if err = writeData(sh, rows, true); err != nil {
+		t.Fatal(err)
+	}
+	rowKey := func(r *influx.Row) string {
+		return fmt.Sprintf("%s%v%d", r.Name, r.Tags, r.Timestamp)
+	}
+	expected := make(map[string]influx.Fields, len(rows))
+	for i := range rows {
+		expected[rowKey(&rows[i])] = rows[i].Fields
+	}
+
+	// each page ends with a whole series, the 10 series hold 20 rows each and the last page is empty
+	var cursor netstorage.RowsCursor
+	var pages int
+	read := make(map[string]influx.Fields, len(rows))
+	for done := false; !done; pages++ {
+		var buf []byte
+		buf, cursor, done, err = sh.ReadRows(cursor, 30)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) == 0 {
+			continue
+		}
+		page, _, _, _, _, err := influx.FastUnmarshalMultiRows(buf, nil, nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !done && len(page) != 40 {
+			t.Fatalf("page of %d rows, expect 40", len(page))
+		}
+		for i := range page {
+			read[rowKey(&page[i])] = page[i].Fields
+		}
+	}
+	if pages != 6 {
+		t.Fatalf("%d pages, expect 6", pages)
+	}
+	if !reflect.DeepEqual(expected, read) {
+		t.Fatalf("read rows %v, expect %v", read, expected)
+	}
+}
+
 func TestEngine_DropMeasurement(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine1(dir)
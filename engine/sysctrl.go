@@ -33,6 +33,8 @@ import (
  curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=compen&switchon=true&allshards=true&shid=4'
  curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=merge&switchon=true&allshards=true&shid=4'
  curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=snapshot&duration=30m'
+ curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=backup&path=/data/backup/20220601'
+ curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=backup&path=/data/backup/20220602&base=/data/backup/20220601'
 */
 
 const (
@@ -42,6 +44,7 @@ const (
 	snapshot     = "snapshot"
 	Failpoint    = "failpoint"
 	Readonly     = "readonly"
+	dataBackup   = "backup"
 )
 
 var (
@@ -120,6 +123,12 @@ func (e *Engine) processReq(req *netstorage.SysCtrlRequest) error {
 		return nil
 	case Readonly:
 		return e.handleReadonly(req)
+	case dataBackup:
+		if err := e.Backup(req.Param()["path"], req.Param()["base"]); err != nil {
+			log.Error("backup fail", zap.String("path", req.Param()["path"]), zap.Error(err))
+			return err
+		}
+		return nil
 	default:
 		return fmt.Errorf("unknown sys cmd %v", req.Mod())
 	}
@@ -18,6 +18,7 @@ package engine
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -35,24 +36,36 @@ import (
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/pingcap/failpoint"
+	"go.uber.org/zap"
 )
 
 const (
 	DefaultFileSize   = 10 * 1024 * 1024
 	WALFileSuffixes   = "wal"
 	WalRecordHeadSize = 1 + 4
-	WalCompBufSize    = 256 * 1024
-	WalCompMaxBufSize = 2 * 1024 * 1024
+	// WalRecordHeadSizeV2 is the header size of a record with a checksum: type + length + crc32
+	WalRecordHeadSizeV2 = 1 + 4 + 4
+	WalCompBufSize      = 256 * 1024
+	WalCompMaxBufSize   = 2 * 1024 * 1024
 )
 
 type WalRecordType byte
 
 const (
-	WriteWALRecord WalRecordType = 0x01
+	WriteWALRecord   WalRecordType = 0x01
+	WriteWALRecordV2 WalRecordType = 0x02
 )
 
+// walResyncWindow is the size of the blocks read while searching for the next valid record in salvage mode
+const walResyncWindow = 64 * 1024
+
+// WalRecordMaxSize bounds the data read to check each candidate record while searching for the next valid
+// one in salvage mode. Writes are not limited by it, a larger record after a corrupt one is skipped by the search.
+const WalRecordMaxSize = 64 * 1024 * 1024
+
 var (
 	walCompBufPool = bufferpool.NewByteBufferPool(WalCompBufSize)
+	walCrcTable    = crc32.MakeTable(crc32.Castagnoli)
 )
 
 type WAL struct {
@@ -64,15 +77,19 @@ type WAL struct {
 	logWriter      []LogWriter
 	walEnabled     bool
 	replayParallel bool
+	// replaySalvage skips a corrupt record and continues with the next valid one instead of
+	// dropping the rest of the file
+	replaySalvage bool
 }
 
-func NewWAL(path string, walSyncInterval time.Duration, walEnabled, replayParallel bool, partitionNum int) *WAL {
+func NewWAL(path string, walSyncInterval time.Duration, walEnabled, replayParallel, replaySalvage bool, partitionNum int) *WAL {
 	wal := &WAL{
 		logPath:        path,
 		partitionNum:   partitionNum,
 		logWriter:      make([]LogWriter, partitionNum),
 		walEnabled:     walEnabled,
 		replayParallel: replayParallel,
+		replaySalvage:  replaySalvage,
 		log:            logger.NewLogger(errno.ModuleWal),
 	}
 
@@ -97,7 +114,7 @@ func (l *WAL) writeBinary(binaryData []byte) error {
 	// prepare for compress memory
 	compBuf := walCompBufPool.Get()
 	maxEncodeLen := snappy.MaxEncodedLen(len(binaryData))
-	compBuf = bufferpool.Resize(compBuf, WalRecordHeadSize+maxEncodeLen)
+	compBuf = bufferpool.Resize(compBuf, WalRecordHeadSizeV2+maxEncodeLen)
 	defer func() {
 		if len(compBuf) <= WalCompMaxBufSize {
 			walCompBufPool.Put(compBuf)
@@ -105,12 +122,13 @@ func (l *WAL) writeBinary(binaryData []byte) error {
 	}()
 
 	// compress data
-	compData := snappy.Encode(compBuf[WalRecordHeadSize:], binaryData)
+	compData := snappy.Encode(compBuf[WalRecordHeadSizeV2:], binaryData)
 
-	// encode record header
-	compBuf[0] = byte(WriteWALRecord)
+	// encode record header, the checksum covers the compressed data
+	compBuf[0] = byte(WriteWALRecordV2)
 	binary.BigEndian.PutUint32(compBuf[1:WalRecordHeadSize], uint32(len(compData)))
-	compBuf = compBuf[:WalRecordHeadSize+len(compData)]
+	binary.BigEndian.PutUint32(compBuf[WalRecordHeadSize:WalRecordHeadSizeV2], crc32.Checksum(compData, walCrcTable))
+	compBuf = compBuf[:WalRecordHeadSizeV2+len(compData)]
 
 	// write data, switch to new file if needed
 	l.mu.RLock()
@@ -190,44 +208,136 @@ func (l *WAL) replayPhysicRecord(fd fileops.File, offset, fileSize int64, record
 		return offset, recordCompBuff, io.EOF
 	}
 
-	// read record header
-	var recordHeader [WalRecordHeadSize]byte
-	n, err := fd.ReadAt(recordHeader[:], offset)
-	if err != nil {
+	recordBuff, next, recordCompBuff, ok := l.readRecord(fd, offset, fileSize, recordCompBuff)
+	if !ok {
+		if !l.replaySalvage {
+			return offset, recordCompBuff, io.EOF
+		}
+
+		var found bool
+		next, recordCompBuff, found = l.resync(fd, offset+1, fileSize, recordCompBuff)
+		l.log.Warn("skip corrupt wal records", zap.String("file", fd.Name()), zap.Int64("offset", offset),
+			zap.Int64("size", next-offset), zap.Bool("resynchronized", found))
+		if !found {
+			return next, recordCompBuff, io.EOF
+		}
+		return next, recordCompBuff, nil
+	}
+
+	if err := callBack(recordBuff); err != nil {
+		return next, recordCompBuff, err
+	}
+	return next, recordCompBuff, nil
+}
+
+// readRecord reads and decodes the record at offset, both record versions are supported.
+// It returns false if the record is corrupt or cannot be read.
+func (l *WAL) readRecord(fd fileops.File, offset, fileSize int64, recordCompBuff []byte) ([]byte, int64, []byte, bool) {
+	// read record header, a record of the first version has a shorter header
+	var recordHeader [WalRecordHeadSizeV2]byte
+	headSize := int64(len(recordHeader))
+	if fileSize-offset < headSize {
+		headSize = fileSize - offset
+	}
+	n, err := fd.ReadAt(recordHeader[:headSize], offset)
+	if err != nil && err != io.EOF {
 		l.log.Warn(errno.NewError(errno.ReadWalFileFailed, fd.Name(), offset, "record header").Error())
-		return offset, recordCompBuff, io.EOF
+		return nil, offset, recordCompBuff, false
 	}
-	if n != WalRecordHeadSize {
+
+	switch WalRecordType(recordHeader[0]) {
+	case WriteWALRecord:
+		headSize = WalRecordHeadSize
+	case WriteWALRecordV2:
+		headSize = WalRecordHeadSizeV2
+	default:
 		l.log.Warn(errno.NewError(errno.WalRecordHeaderCorrupted, fd.Name(), offset).Error())
-		return offset, recordCompBuff, io.EOF
+		return nil, offset, recordCompBuff, false
+	}
+	compBinaryLen := int64(binary.BigEndian.Uint32(recordHeader[1:WalRecordHeadSize]))
+	if int64(n) < headSize || offset+headSize+compBinaryLen > fileSize {
+		l.log.Warn(errno.NewError(errno.WalRecordHeaderCorrupted, fd.Name(), offset).Error())
+		return nil, offset, recordCompBuff, false
 	}
-	offset += int64(len(recordHeader))
 
-	// prepare record memory
-	compBinaryLen := binary.BigEndian.Uint32(recordHeader[1:WalRecordHeadSize])
+	// read record body
 	recordCompBuff = bufferpool.Resize(recordCompBuff, int(compBinaryLen))
+	n, err = fd.ReadAt(recordCompBuff, offset+headSize)
+	if n != len(recordCompBuff) {
+		l.log.Warn(errno.NewError(errno.ReadWalFileFailed, fd.Name(), offset, "record body", err).Error())
+		return nil, offset, recordCompBuff, false
+	}
+	if headSize == WalRecordHeadSizeV2 &&
+		crc32.Checksum(recordCompBuff, walCrcTable) != binary.BigEndian.Uint32(recordHeader[WalRecordHeadSize:WalRecordHeadSizeV2]) {
+		l.log.Warn(errno.NewError(errno.WalRecordChecksumMismatch, fd.Name(), offset).Error())
+		return nil, offset, recordCompBuff, false
+	}
 
-	// read record body
-	var recordBuff []byte
-	n, err = fd.ReadAt(recordCompBuff, offset)
-	if err == nil || err == io.EOF {
-		offset += int64(n)
-		var innerErr error
-		recordBuff, innerErr = snappy.Decode(recordBuff, recordCompBuff)
-		if innerErr != nil {
-			l.log.Warn(errno.NewError(errno.DecompressWalRecordFailed, fd.Name(), offset, innerErr.Error()).Error())
-			return offset, recordCompBuff, io.EOF
+	recordBuff, err := snappy.Decode(nil, recordCompBuff)
+	if err != nil {
+		l.log.Warn(errno.NewError(errno.DecompressWalRecordFailed, fd.Name(), offset, err.Error()).Error())
+		return nil, offset, recordCompBuff, false
+	}
+	return recordBuff, offset + headSize + compBinaryLen, recordCompBuff, true
+}
+
+// resync searches the file from offset for the next record whose header and checksum are valid.
+// Only the records with a checksum can be recognized, so the rest of a file of the first version is skipped.
+func (l *WAL) resync(fd fileops.File, offset, fileSize int64, recordCompBuff []byte) (int64, []byte, bool) {
+	window := make([]byte, walResyncWindow)
+	for offset+WalRecordHeadSizeV2 <= fileSize {
+		n, err := fd.ReadAt(window, offset)
+		if n < WalRecordHeadSizeV2 {
+			if err != nil && err != io.EOF {
+				l.log.Warn(errno.NewError(errno.ReadWalFileFailed, fd.Name(), offset, "resync").Error())
+			}
+			break
 		}
 
-		innerErr = callBack(recordBuff)
-		if innerErr == nil {
-			return offset, recordCompBuff, err
+		for i := 0; i+WalRecordHeadSizeV2 <= n; i++ {
+			if WalRecordType(window[i]) != WriteWALRecordV2 {
+				continue
+			}
+			pos := offset + int64(i)
+			compBinaryLen, ok := walRecordCandidateLen(window[i:n], pos, fileSize)
+			if !ok {
+				continue
+			}
+
+			recordCompBuff = bufferpool.Resize(recordCompBuff, int(compBinaryLen))
+			if m, _ := fd.ReadAt(recordCompBuff, pos+WalRecordHeadSizeV2); m != len(recordCompBuff) {
+				continue
+			}
+			if crc32.Checksum(recordCompBuff, walCrcTable) == binary.BigEndian.Uint32(window[i+WalRecordHeadSize:i+WalRecordHeadSizeV2]) {
+				return pos, recordCompBuff, true
+			}
 		}
+		// the next block overlaps the last bytes which cannot hold a whole header
+		offset += int64(n - WalRecordHeadSizeV2 + 1)
+	}
+	return fileSize, recordCompBuff, false
+}
+
+// walRecordCandidateLen returns the length of the compressed data of the record whose header starts buf,
+// if the record can be one written by the WAL. buf holds the bytes of the file from pos.
+func walRecordCandidateLen(buf []byte, pos, fileSize int64) (int64, bool) {
+	compBinaryLen := int64(binary.BigEndian.Uint32(buf[1:WalRecordHeadSize]))
+	if compBinaryLen == 0 || compBinaryLen > WalRecordMaxSize || pos+WalRecordHeadSizeV2+compBinaryLen > fileSize {
+		return 0, false
+	}
 
-		return offset, recordCompBuff, innerErr
+	// the compressed data starts with the length of the data, which bounds the length of the compressed data
+	body := buf[WalRecordHeadSizeV2:]
+	if len(body) > int(compBinaryLen) {
+		body = body[:compBinaryLen]
+	}
+	if len(body) >= binary.MaxVarintLen32 || len(body) == int(compBinaryLen) {
+		decodedLen, err := snappy.DecodedLen(body)
+		if err != nil || int64(snappy.MaxEncodedLen(decodedLen)) < compBinaryLen {
+			return 0, false
+		}
 	}
-	l.log.Warn(errno.NewError(errno.ReadWalFileFailed, fd.Name(), offset, "record body").Error())
-	return offset, recordCompBuff, io.EOF
+	return compBinaryLen, true
 }
 
 func (l *WAL) replayWalFile(walFileName string, callBack func(binary []byte) error) error {
@@ -17,9 +17,14 @@ package engine
 
 import (
 	"context"
+	"encoding/binary"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWalReplayParallel(t *testing.T) {
@@ -183,3 +188,110 @@ func TestWalReplaySerial(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func writeWalRecords(t *testing.T, dir string, records []string) string {
+	wal := NewWAL(dir, 0, true, false, false, 1)
+	for _, rec := range records {
+		require.NoError(t, wal.Write([]byte(rec)))
+	}
+	files, err := wal.Switch()
+	require.NoError(t, err)
+	require.NoError(t, wal.Close())
+	require.Equal(t, 1, len(files))
+	return files[0]
+}
+
+func replayWalRecords(t *testing.T, dir string, salvage bool) []string {
+	var records []string
+	wal := NewWAL(dir, 0, true, false, salvage, 1)
+	_, err := wal.Replay(func(binary []byte) error {
+		records = append(records, string(binary))
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, wal.Close())
+	return records
+}
+
+func walRecordSize(rec string) int {
+	return WalRecordHeadSizeV2 + len(snappy.Encode(nil, []byte(rec)))
+}
+
+func TestWalReplay_Checksum(t *testing.T) {
+	dir := t.TempDir()
+	records := []string{"cpu,host=a value=1", "cpu,host=b value=2", "cpu,host=c value=3"}
+	fileName := writeWalRecords(t, dir, records)
+
+	// flip a byte in the body of the second record, snappy may still decode it
+	buf, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	buf[walRecordSize(records[0])+WalRecordHeadSizeV2+2] ^= 0xff
+	require.NoError(t, os.WriteFile(fileName, buf, 0640))
+
+	require.Equal(t, records[:1], replayWalRecords(t, dir, false))
+	require.Equal(t, []string{records[0], records[2]}, replayWalRecords(t, dir, true))
+}
+
+func TestWalReplay_SalvageResync(t *testing.T) {
+	dir := t.TempDir()
+	records := []string{"mem,host=a used=1", "mem,host=b used=2", "mem,host=c used=3"}
+	fileName := writeWalRecords(t, dir, records)
+
+	// garbage between the records and a truncated record at the end of the file
+	buf, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	first := walRecordSize(records[0])
+	garbage := []byte{byte(WriteWALRecordV2), 0, 0, 0, 3, 1, 2, 3, 4, 5, 6, 7, byte(WriteWALRecordV2), 0xff}
+	corrupted := append(append(append([]byte{}, buf[:first]...), garbage...), buf[first:]...)
+	corrupted = append(corrupted, buf[:first-1]...)
+	require.NoError(t, os.WriteFile(fileName, corrupted, 0640))
+
+	require.Equal(t, records[:1], replayWalRecords(t, dir, false))
+	require.Equal(t, records, replayWalRecords(t, dir, true))
+}
+
+func TestWalReplay_RecordV1(t *testing.T) {
+	dir := t.TempDir()
+	records := []string{"disk,host=a free=1", "disk,host=b free=2"}
+
+	// files written before the checksum was added are still replayed
+	var buf []byte
+	for _, rec := range records {
+		comp := snappy.Encode(nil, []byte(rec))
+		var header [WalRecordHeadSize]byte
+		header[0] = byte(WriteWALRecord)
+		binary.BigEndian.PutUint32(header[1:], uint32(len(comp)))
+		buf = append(append(buf, header[:]...), comp...)
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "0"), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0", "1."+WALFileSuffixes), buf, 0640))
+
+	require.Equal(t, records, replayWalRecords(t, dir, false))
+	require.Equal(t, records, replayWalRecords(t, dir, true))
+}
+
+func TestWalRecordCandidateLen(t *testing.T) {
+	comp := snappy.Encode(nil, []byte("cpu,host=a value=1"))
+	header := func(compLen uint32) []byte {
+		buf := make([]byte, WalRecordHeadSizeV2)
+		buf[0] = byte(WriteWALRecordV2)
+		binary.BigEndian.PutUint32(buf[1:WalRecordHeadSize], compLen)
+		return buf
+	}
+	record := append(header(uint32(len(comp))), comp...)
+
+	n, ok := walRecordCandidateLen(record, 0, int64(len(record)))
+	require.True(t, ok)
+	require.Equal(t, int64(len(comp)), n)
+
+	// the record exceeds the file
+	_, ok = walRecordCandidateLen(record, 1, int64(len(record)))
+	require.False(t, ok)
+	// the length exceeds the largest record checked while resyncing
+	_, ok = walRecordCandidateLen(header(WalRecordMaxSize+1), 0, 2*WalRecordMaxSize)
+	require.False(t, ok)
+	// the length exceeds the longest encoding of the data
+	long := append(header(1024), comp...)
+	_, ok = walRecordCandidateLen(long, 0, 2048)
+	require.False(t, ok)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+)
+
+// Categories of the audited operations.
+const (
+	CategoryDDL       = "ddl"
+	CategoryPrivilege = "privilege"
+	CategoryUser      = "user"
+	CategoryAdmin     = "admin"
+	CategorySysCtrl   = "sysctrl"
+	CategoryLogin     = "login"
+)
+
+// Event is an audited operation.
+type Event struct {
+	Time     time.Time
+	Category string
+	User     string
+	// address of the client, preceded by the X-Forwarded-For addresses if any
+	Addr      string
+	Database  string
+	Statement string
+	Err       error
+	Duration  time.Duration
+}
+
+// Recorder records the audit events.
+type Recorder interface {
+	Record(e Event)
+}
+
+// StatementCategory returns the category of the statement, or an empty string if the statement is not audited.
+func StatementCategory(stmt influxql.Statement) string {
+	switch stmt.(type) {
+	case *influxql.CreateDatabaseStatement, *influxql.DropDatabaseStatement,
+		*influxql.CreateRetentionPolicyStatement, *influxql.AlterRetentionPolicyStatement, *influxql.DropRetentionPolicyStatement,
+		*influxql.CreateMeasurementStatement, *influxql.DropMeasurementStatement, *influxql.AlterShardKeyStatement,
+		*influxql.DeleteSeriesStatement, *influxql.DropSeriesStatement, *influxql.DeleteStatement,
+		*influxql.CreateContinuousQueryStatement, *influxql.DropContinuousQueryStatement,
+		*influxql.CreateDownSampleStatement, *influxql.DropDownSampleStatement,
+		*influxql.CreateSubscriptionStatement, *influxql.DropSubscriptionStatement:
+		return CategoryDDL
+	case *influxql.GrantStatement, *influxql.RevokeStatement, *influxql.GrantAdminStatement, *influxql.RevokeAdminStatement,
+		*influxql.GrantRoleStatement, *influxql.RevokeRoleStatement,
+		*influxql.GrantRolePrivilegeStatement, *influxql.RevokeRolePrivilegeStatement:
+		return CategoryPrivilege
+	case *influxql.CreateUserStatement, *influxql.DropUserStatement, *influxql.SetPasswordUserStatement,
+		*influxql.CreateRoleStatement, *influxql.DropRoleStatement:
+		return CategoryUser
+	case *influxql.KillQueryStatement, *influxql.DropShardStatement,
+		*influxql.PrepareSnapshotStatement, *influxql.EndPrepareSnapshotStatement:
+		return CategoryAdmin
+	}
+	return ""
+}
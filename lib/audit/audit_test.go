@@ -0,0 +1,33 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementCategory(t *testing.T) {
+	assert.Equal(t, CategoryDDL, StatementCategory(&influxql.DropDatabaseStatement{Name: "db0"}))
+	assert.Equal(t, CategoryPrivilege, StatementCategory(&influxql.GrantRoleStatement{Role: "reader", User: "bob"}))
+	assert.Equal(t, CategoryUser, StatementCategory(&influxql.SetPasswordUserStatement{Name: "bob"}))
+	assert.Equal(t, CategoryAdmin, StatementCategory(&influxql.KillQueryStatement{QueryID: 1}))
+	assert.Equal(t, "", StatementCategory(&influxql.SelectStatement{}))
+	assert.Equal(t, "", StatementCategory(&influxql.ShowDatabasesStatement{}))
+}
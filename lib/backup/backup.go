@@ -0,0 +1,191 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+/*
+A backup is a directory laid out as:
+
+	<dir>/meta.snapshot                       meta data of the cluster, encoded as the raft snapshot of ts-meta
+	<dir>/node_<id>/manifest.json             shards and indexes backed up by the data node
+	<dir>/node_<id>/data/<db>/<pt>/<rp>/...   files of the shards and indexes, as laid out in the data directory
+
+An incremental backup has the node directory of an earlier backup as the base of its manifests. The TSSP files are
+never rewritten under the same name, compaction and merge write files of a new level or merge number instead, so the
+TSSP files already in the base are listed by the manifest without being put into the backup again.
+*/
+
+const (
+	MetaFile     = "meta.snapshot"
+	ManifestFile = "manifest.json"
+
+	nodeDirPrefix = "node_"
+)
+
+var ErrBackupExists = errors.New("backup directory already exists")
+
+// NodeDir returns the directory of the files backed up by the data node.
+func NodeDir(dir string, nodeID uint64) string {
+	return filepath.Join(dir, nodeDirPrefix+strconv.FormatUint(nodeID, 10))
+}
+
+// NodeIDs returns the ids of the data nodes having files in the backup.
+func NodeIDs(dir string) ([]uint64, error) {
+	fis, err := fileops.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint64
+	for _, fi := range fis {
+		if !fi.IsDir() || !strings.HasPrefix(fi.Name(), nodeDirPrefix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimPrefix(fi.Name(), nodeDirPrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Manifest lists the shards and indexes backed up by a data node. Base is the node directory of the backup an
+// incremental backup is based on.
+type Manifest struct {
+	Time    time.Time `json:"time"`
+	Base    string    `json:"base,omitempty"`
+	Shards  []Entry   `json:"shards"`
+	Indexes []Entry   `json:"indexes"`
+}
+
+// Entry is a shard or an index in the backup, Dir is relative to the directory of the node. Files is the manifest
+// of the shard or index, all its files at the time of the backup.
+type Entry struct {
+	Database        string `json:"database"`
+	PtID            uint32 `json:"pt"`
+	RetentionPolicy string `json:"rp"`
+	ID              uint64 `json:"id"`
+	Dir             string `json:"dir"`
+	Files           []File `json:"files,omitempty"`
+}
+
+func WriteManifest(dir string, m *Manifest) error {
+	buf, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileops.WriteFile(filepath.Join(dir, ManifestFile), buf, 0640)
+}
+
+func ReadManifest(dir string) (*Manifest, error) {
+	buf, err := fileops.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err = json.Unmarshal(buf, m); err != nil {
+		return nil, fmt.Errorf("invalid manifest in %s: %v", dir, err)
+	}
+	return m, nil
+}
+
+// FileAction is how a file is put into a backup or restored from it.
+type FileAction int
+
+const (
+	SkipFile FileAction = iota
+	LinkFile
+	CopyFile
+)
+
+// LinkTree puts the regular files under src to the same paths under dst as action decides, and returns them with
+// their checksums. The immutable files are hard linked, the files modified in place must be copied. A file that
+// cannot be linked, e.g. because dst is on another file system, is copied. The immutable files found in base with
+// the same size are kept by an earlier backup of the chain, they are listed with Base set and not put into dst.
+func LinkTree(src, dst string, base FileSet, action func(name string) FileAction) ([]File, error) {
+	var files []File
+	err := filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			// the files removed while walking are not a part of the backup
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if fi.IsDir() {
+			return fileops.MkdirAll(target, 0750)
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+		act := action(fi.Name())
+		if f, ok := base[name]; ok && act == LinkFile && f.Size == fi.Size() {
+			f.Base = true
+			files = append(files, f)
+			return nil
+		}
+		if err = putFile(path, target, act); err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if act == SkipFile {
+			return nil
+		}
+		f, err := checksumFile(target, name)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		return nil
+	})
+	return files, err
+}
+
+func putFile(src, dst string, action FileAction) error {
+	switch action {
+	case LinkFile:
+		if err := os.Link(src, dst); err == nil || os.IsNotExist(err) {
+			return err
+		}
+	case CopyFile:
+	default:
+		return nil
+	}
+	_, err := fileops.CopyFile(src, dst)
+	return err
+}
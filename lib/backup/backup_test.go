@@ -0,0 +1,96 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0750))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0640))
+	}
+}
+
+func TestLinkTree(t *testing.T) {
+	src, dst := t.TempDir(), filepath.Join(t.TempDir(), "backup")
+	writeFiles(t, src, map[string]string{
+		"cpu/00000001-0000-00000000.tssp":              "order",
+		"cpu/out-of-order/00000002-0000-00000000.tssp": "unordered",
+		"cpu/00000003-0000-00000000.tssp.init":         "flushing",
+		"tombstone":                                    "deleted",
+	})
+
+	files, err := LinkTree(src, dst, nil, func(name string) FileAction {
+		switch {
+		case strings.HasSuffix(name, ".tssp"):
+			return LinkFile
+		case strings.HasSuffix(name, ".init"):
+			return SkipFile
+		}
+		return CopyFile
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+	for _, f := range files {
+		require.False(t, f.Base)
+		require.NotEqual(t, "cpu/00000003-0000-00000000.tssp.init", f.Name)
+	}
+
+	for _, name := range []string{"cpu/00000001-0000-00000000.tssp", "cpu/out-of-order/00000002-0000-00000000.tssp"} {
+		fi, err := os.Stat(filepath.Join(dst, name))
+		require.NoError(t, err)
+		srcFi, err := os.Stat(filepath.Join(src, name))
+		require.NoError(t, err)
+		require.True(t, os.SameFile(fi, srcFi), name)
+	}
+	_, err = os.Stat(filepath.Join(dst, "cpu/00000003-0000-00000000.tssp.init"))
+	require.True(t, os.IsNotExist(err))
+
+	// the copied file does not change with the source
+	require.NoError(t, os.WriteFile(filepath.Join(src, "tombstone"), []byte("changed"), 0640))
+	buf, err := os.ReadFile(filepath.Join(dst, "tombstone"))
+	require.NoError(t, err)
+	require.Equal(t, "deleted", string(buf))
+}
+
+func TestManifest(t *testing.T) {
+	dir := t.TempDir()
+	m := &Manifest{
+		Time:    time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		Shards:  []Entry{{Database: "db0", PtID: 1, RetentionPolicy: "rp0", ID: 3, Dir: "data/db0/1/rp0/3_0_1_2"}},
+		Indexes: []Entry{{Database: "db0", PtID: 1, RetentionPolicy: "rp0", ID: 2, Dir: "data/db0/1/rp0/index/2_0_1"}},
+	}
+	require.NoError(t, WriteManifest(dir, m))
+	other, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, m, other)
+
+	require.NoError(t, os.MkdirAll(NodeDir(dir, 4), 0750))
+	require.NoError(t, os.MkdirAll(NodeDir(dir, 12), 0750))
+	ids, err := NodeIDs(dir)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{4, 12}, ids)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+// File is a file of a shard or an index, Name is slash separated and relative to the directory of the entry. A file
+// with Base set is kept by an earlier backup of the chain.
+type File struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"crc"`
+	Base     bool   `json:"base,omitempty"`
+}
+
+// FileSet is the files of an entry by name.
+type FileSet map[string]File
+
+func NewFileSet(files []File) FileSet {
+	fs := make(FileSet, len(files))
+	for _, f := range files {
+		fs[f.Name] = f
+	}
+	return fs
+}
+
+// Sub returns the files under dir with the names relative to it.
+func (fs FileSet) Sub(dir string) FileSet {
+	sub := make(FileSet)
+	prefix := dir + "/"
+	for name, f := range fs {
+		if strings.HasPrefix(name, prefix) {
+			f.Name = name[len(prefix):]
+			sub[f.Name] = f
+		}
+	}
+	return sub
+}
+
+// Rebase returns the files with dir prepended to their names, the reverse of Sub.
+func Rebase(dir string, files []File) []File {
+	for i := range files {
+		files[i].Name = path.Join(dir, files[i].Name)
+	}
+	return files
+}
+
+// ListFiles returns the regular files under dir with their checksums.
+func ListFiles(dir string) ([]File, error) {
+	var files []File
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || !fi.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		f, err := checksumFile(p, filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		return nil
+	})
+	return files, err
+}
+
+func checksumFile(p, name string) (File, error) {
+	fd, err := fileops.Open(p)
+	if err != nil {
+		return File{}, err
+	}
+	defer fd.Close()
+
+	h := crc32.NewIEEE()
+	n, err := io.Copy(h, fd)
+	if err != nil {
+		return File{}, err
+	}
+	return File{Name: name, Size: n, Checksum: h.Sum32()}, nil
+}
+
+// verifyFile checks the size and the checksum of the file at p against f.
+func verifyFile(p string, f File) error {
+	got, err := checksumFile(p, f.Name)
+	if err != nil {
+		return err
+	}
+	if got.Size != f.Size || got.Checksum != f.Checksum {
+		return fmt.Errorf("file %s is corrupted, size %d crc %08x, expect size %d crc %08x",
+			p, got.Size, got.Checksum, f.Size, f.Checksum)
+	}
+	return nil
+}
+
+type entryKey struct {
+	database string
+	shard    bool
+	id       uint64
+}
+
+// chain is the backups of a node from an incremental backup back to the full backup it is based on.
+type chain struct {
+	// paths of the files kept by the backups of the chain, the latest backup keeping a file wins
+	stored map[entryKey]map[string]string
+}
+
+// readChain reads the manifest in nodeDir and the manifests of the backups it is based on.
+func readChain(nodeDir string) (*Manifest, *chain, error) {
+	head, err := ReadManifest(nodeDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &chain{stored: make(map[entryKey]map[string]string)}
+	visited := make(map[string]bool)
+	for m, dir := head, nodeDir; m != nil; {
+		visited[dir] = true
+		c.add(dir, m.Shards, true)
+		c.add(dir, m.Indexes, false)
+		if m.Base == "" {
+			break
+		}
+		dir = m.Base
+		if visited[dir] {
+			return nil, nil, fmt.Errorf("backup chain of %s loops at %s", nodeDir, dir)
+		}
+		if m, err = ReadManifest(dir); err != nil {
+			return nil, nil, fmt.Errorf("read base backup of %s: %v", nodeDir, err)
+		}
+	}
+	return head, c, nil
+}
+
+func (c *chain) add(dir string, entries []Entry, shard bool) {
+	for _, e := range entries {
+		k := entryKey{database: e.Database, shard: shard, id: e.ID}
+		files, ok := c.stored[k]
+		if !ok {
+			files = make(map[string]string)
+			c.stored[k] = files
+		}
+		for _, f := range e.Files {
+			if _, ok := files[f.Name]; !ok && !f.Base {
+				files[f.Name] = filepath.Join(dir, e.Dir, filepath.FromSlash(f.Name))
+			}
+		}
+	}
+}
+
+// path returns where the file of the entry is kept in the chain.
+func (c *chain) path(e Entry, shard bool, f File) (string, error) {
+	p, ok := c.stored[entryKey{database: e.Database, shard: shard, id: e.ID}][f.Name]
+	if !ok {
+		return "", fmt.Errorf("file %s of %s %d is missing in the backup chain", f.Name, e.Database, e.ID)
+	}
+	return p, nil
+}
+
+// Verify checks the sizes and checksums of all files listed by the manifests of the nodes in the backup, the files
+// kept by the earlier backups of the chains included. It returns the number of the files verified.
+func Verify(dir string) (int, error) {
+	ids, err := NodeIDs(dir)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, id := range ids {
+		m, c, err := readChain(NodeDir(dir, id))
+		if err != nil {
+			return n, err
+		}
+		for _, e := range m.Indexes {
+			if err = c.verify(e, false); err != nil {
+				return n, err
+			}
+			n += len(e.Files)
+		}
+		for _, e := range m.Shards {
+			if err = c.verify(e, true); err != nil {
+				return n, err
+			}
+			n += len(e.Files)
+		}
+	}
+	return n, nil
+}
+
+func (c *chain) verify(e Entry, shard bool) error {
+	for _, f := range e.Files {
+		p, err := c.path(e, shard, f)
+		if err != nil {
+			return err
+		}
+		if err = verifyFile(p, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
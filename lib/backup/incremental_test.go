@@ -0,0 +1,137 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSet(t *testing.T) {
+	fs := NewFileSet([]File{
+		{Name: "tssp/cpu/00000001-0000-00000000.tssp", Size: 1},
+		{Name: "shard_key_index/parts.json", Size: 2},
+	})
+	sub := fs.Sub("tssp")
+	require.Equal(t, FileSet{"cpu/00000001-0000-00000000.tssp": {Name: "cpu/00000001-0000-00000000.tssp", Size: 1}}, sub)
+	files := Rebase("tssp", []File{sub["cpu/00000001-0000-00000000.tssp"]})
+	require.Equal(t, "tssp/cpu/00000001-0000-00000000.tssp", files[0].Name)
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	src, root := t.TempDir(), t.TempDir()
+	shardDir := "data/db0/0/rp0/1_100_200_2"
+	backupShard := func(name, base string) {
+		nodeDir := NodeDir(filepath.Join(root, name), 1)
+		var baseFiles FileSet
+		if base != "" {
+			m, err := ReadManifest(base)
+			require.NoError(t, err)
+			baseFiles = NewFileSet(m.Shards[0].Files)
+		}
+		files, err := LinkTree(src, filepath.Join(nodeDir, shardDir), baseFiles, restoreAction)
+		require.NoError(t, err)
+		require.NoError(t, WriteManifest(nodeDir, &Manifest{Base: base, Shards: []Entry{
+			{Database: "db0", RetentionPolicy: "rp0", ID: 1, Dir: shardDir, Files: files},
+		}}))
+	}
+
+	writeFiles(t, src, map[string]string{
+		"cpu/00000001-0000-00000000.tssp": "level0",
+		"cpu/00000002-0000-00000000.tssp": "level0",
+		"tombstone":                       "v1",
+	})
+	backupShard("full", "")
+
+	// the files of level 0 are compacted and a file is flushed
+	require.NoError(t, os.Remove(filepath.Join(src, "cpu/00000002-0000-00000000.tssp")))
+	writeFiles(t, src, map[string]string{
+		"cpu/00000002-0001-00000000.tssp": "level1",
+		"cpu/00000003-0000-00000000.tssp": "level0",
+		"tombstone":                       "v2",
+	})
+	backupShard("incr1", NodeDir(filepath.Join(root, "full"), 1))
+	writeFiles(t, src, map[string]string{"cpu/00000004-0000-00000000.tssp": "level0"})
+	backupShard("incr2", NodeDir(filepath.Join(root, "incr1"), 1))
+
+	// only the new TSSP files and the mutable files are kept by the incremental backups
+	incr2 := filepath.Join(root, "incr2")
+	m, err := ReadManifest(NodeDir(incr2, 1))
+	require.NoError(t, err)
+	stored := map[string]bool{}
+	for _, f := range m.Shards[0].Files {
+		stored[f.Name] = !f.Base
+	}
+	require.Equal(t, map[string]bool{
+		"cpu/00000001-0000-00000000.tssp": false,
+		"cpu/00000002-0001-00000000.tssp": false,
+		"cpu/00000003-0000-00000000.tssp": false,
+		"cpu/00000004-0000-00000000.tssp": true,
+		"tombstone":                       true,
+	}, stored)
+	_, err = os.Stat(filepath.Join(NodeDir(incr2, 1), shardDir, "cpu/00000001-0000-00000000.tssp"))
+	require.True(t, os.IsNotExist(err))
+
+	n, err := Verify(incr2)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	r := &Remapping{Database: "db0", NewDatabase: "db1", Shards: map[uint64]uint64{1: 11}, Indexes: map[uint64]uint64{2: 12}}
+	restoreAt := func(name string) map[string]string {
+		dataDir := t.TempDir()
+		n, err := RestoreNode(filepath.Join(root, name), dataDir, 1, r)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		restored := map[string]string{}
+		target := filepath.Join(dataDir, "data/db1/0/rp0/11_100_200_12")
+		files, err := ListFiles(target)
+		require.NoError(t, err)
+		for _, f := range files {
+			buf, err := os.ReadFile(filepath.Join(target, f.Name))
+			require.NoError(t, err)
+			restored[f.Name] = string(buf)
+		}
+		return restored
+	}
+	require.Equal(t, map[string]string{
+		"cpu/00000001-0000-00000000.tssp": "level0",
+		"cpu/00000002-0001-00000000.tssp": "level1",
+		"cpu/00000003-0000-00000000.tssp": "level0",
+		"tombstone":                       "v2",
+	}, restoreAt("incr1"))
+	require.Equal(t, map[string]string{
+		"cpu/00000001-0000-00000000.tssp": "level0",
+		"cpu/00000002-0000-00000000.tssp": "level0",
+		"tombstone":                       "v1",
+	}, restoreAt("full"))
+	require.Equal(t, 5, len(restoreAt("incr2")))
+
+	// a corrupted file of the base fails the verification of the chain
+	require.NoError(t, os.WriteFile(filepath.Join(NodeDir(filepath.Join(root, "full"), 1), shardDir,
+		"cpu/00000001-0000-00000000.tssp"), []byte("level9"), 0640))
+	_, err = Verify(incr2)
+	require.Error(t, err)
+	_, err = RestoreNode(incr2, t.TempDir(), 1, r)
+	require.Error(t, err)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(root, "incr1")))
+	_, err = Verify(incr2)
+	require.Error(t, err)
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+)
+
+const (
+	dataDirectory  = "data"
+	indexDirectory = "index"
+	tsspFileSuffix = ".tssp"
+	dirSeparator   = "_"
+)
+
+// Remapping is how a database of a backup is restored. The database, its retention policies and the owners of
+// its pts may be renamed, the shards and indexes always get new ids, which are set when the meta data is restored.
+type Remapping struct {
+	Database          string            `json:"database"`
+	NewDatabase       string            `json:"newDatabase"`
+	RetentionPolicies map[string]string `json:"retentionPolicies,omitempty"`
+	Nodes             map[uint64]uint64 `json:"nodes,omitempty"`
+	Shards            map[uint64]uint64 `json:"shards"`
+	Indexes           map[uint64]uint64 `json:"indexes"`
+}
+
+// RemappingFile returns the file the remapping of the restored database is kept in, the data nodes read it to
+// restore their files.
+func RemappingFile(dir, database string) string {
+	return filepath.Join(dir, "restore_"+database+".json")
+}
+
+func (r *Remapping) Write(dir string) error {
+	buf, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileops.WriteFile(RemappingFile(dir, r.NewDatabase), buf, 0640)
+}
+
+func ReadRemapping(dir, database string) (*Remapping, error) {
+	buf, err := fileops.ReadFile(RemappingFile(dir, database))
+	if err != nil {
+		return nil, err
+	}
+	r := &Remapping{}
+	if err = json.Unmarshal(buf, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParseRenames parses the renames written as "old:new,old:new".
+func ParseRenames(s string) (map[string]string, error) {
+	renames := make(map[string]string)
+	if s == "" {
+		return renames, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		i := strings.IndexByte(kv, ':')
+		if i <= 0 || i == len(kv)-1 {
+			return nil, fmt.Errorf("invalid rename %q, expect old:new", kv)
+		}
+		renames[kv[:i]] = kv[i+1:]
+	}
+	return renames, nil
+}
+
+// ParseNodes parses the node ids mapped as "old:new,old:new".
+func ParseNodes(s string) (map[uint64]uint64, error) {
+	renames, err := ParseRenames(s)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[uint64]uint64, len(renames))
+	for k, v := range renames {
+		old, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id %q", k)
+		}
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id %q", v)
+		}
+		nodes[old] = id
+	}
+	return nodes, nil
+}
+
+// RestoreMeta imports the database of the meta data backed up in dir into data as r requires, the new ids of the
+// shards and indexes are set in r.
+func RestoreMeta(data *meta2.Data, dir string, r *Remapping) error {
+	buf, err := fileops.ReadFile(filepath.Join(dir, MetaFile))
+	if err != nil {
+		return err
+	}
+	other := &meta2.Data{}
+	if err = other.UnmarshalBinary(buf); err != nil {
+		return err
+	}
+	if r.NewDatabase == "" {
+		r.NewDatabase = r.Database
+	}
+	r.Shards, r.Indexes, err = data.RestoreDatabase(other, r.Database, r.NewDatabase, r.RetentionPolicies, r.Nodes)
+	return err
+}
+
+// RestoreNode puts the files of the database restored by r into dataDir, the data directory of the node nodeID. The
+// files are taken from the backups of the nodes mapped to it, the files of an incremental backup kept by an earlier
+// backup of its chain are taken from there, and the immutable files are hard linked if possible. The files listed
+// by the manifests are verified against their checksums. The shards and indexes unknown to the restored meta data
+// are left out.
+func RestoreNode(dir, dataDir string, nodeID uint64, r *Remapping) (int, error) {
+	ids, err := NodeIDs(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, old := range ids {
+		owner := old
+		if id, ok := r.Nodes[old]; ok {
+			owner = id
+		}
+		if owner != nodeID {
+			continue
+		}
+
+		nodeDir := NodeDir(dir, old)
+		m, c, err := readChain(nodeDir)
+		if err != nil {
+			return n, err
+		}
+		restore := func(entries []Entry, ids map[uint64]uint64, shard bool) error {
+			for _, e := range entries {
+				target, ok := r.targetDir(e, ids)
+				if !ok {
+					continue
+				}
+				target = filepath.Join(dataDir, target)
+				if _, err := fileops.Stat(target); err == nil {
+					return fmt.Errorf("restore target %s already exists", target)
+				}
+				if err := c.restore(e, shard, filepath.Join(nodeDir, e.Dir), target); err != nil {
+					return err
+				}
+				n++
+			}
+			return nil
+		}
+		if err = restore(m.Indexes, r.Indexes, false); err != nil {
+			return n, err
+		}
+		if err = restore(m.Shards, r.Shards, true); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// restore puts the files of the entry backed up in src into target. The backups without the files in the manifest
+// are restored as they are.
+func (c *chain) restore(e Entry, shard bool, src, target string) error {
+	if len(e.Files) == 0 {
+		_, err := LinkTree(src, target, nil, restoreAction)
+		return err
+	}
+	for _, f := range e.Files {
+		p, err := c.path(e, shard, f)
+		if err != nil {
+			return err
+		}
+		if err = verifyFile(p, f); err != nil {
+			return err
+		}
+		dst := filepath.Join(target, filepath.FromSlash(f.Name))
+		if err = fileops.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+			return err
+		}
+		if err = putFile(p, dst, restoreAction(path.Base(f.Name))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// targetDir returns the directory of the restored shard or index relative to the data directory, the id in the
+// name of the directory and the id of the index of a shard are replaced by the new ids.
+func (r *Remapping) targetDir(e Entry, ids map[uint64]uint64) (string, bool) {
+	if e.Database != r.Database {
+		return "", false
+	}
+	id, ok := ids[e.ID]
+	if !ok {
+		return "", false
+	}
+	rp := e.RetentionPolicy
+	if name, ok := r.RetentionPolicies[rp]; ok {
+		rp = name
+	}
+
+	// shard: <id>_<start>_<end>_<index id>, index: index/<id>_<start>_<end>
+	fields := strings.Split(filepath.Base(e.Dir), dirSeparator)
+	fields[0] = strconv.FormatUint(id, 10)
+	parent := filepath.Join(dataDirectory, r.NewDatabase, strconv.Itoa(int(e.PtID)), rp)
+	if filepath.Base(filepath.Dir(e.Dir)) == indexDirectory {
+		parent = filepath.Join(parent, indexDirectory)
+	} else if len(fields) == 4 {
+		indexID, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return "", false
+		}
+		if id, ok := r.Indexes[indexID]; ok {
+			fields[3] = strconv.FormatUint(id, 10)
+		}
+	}
+	return filepath.Join(parent, strings.Join(fields, dirSeparator)), true
+}
+
+// restoreAction links the TSSP files, the other files, e.g. the manifests of the key-value stores, are modified in
+// place by the running node and are copied.
+func restoreAction(name string) FileAction {
+	if strings.HasSuffix(name, tsspFileSuffix) {
+		return LinkFile
+	}
+	return CopyFile
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRenames(t *testing.T) {
+	renames, err := ParseRenames("autogen:rp1,rp0:rp2")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"autogen": "rp1", "rp0": "rp2"}, renames)
+	renames, err = ParseRenames("")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(renames))
+	_, err = ParseRenames("rp0")
+	require.EqualError(t, err, `invalid rename "rp0", expect old:new`)
+
+	nodes, err := ParseNodes("1:4,2:5")
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]uint64{1: 4, 2: 5}, nodes)
+	_, err = ParseNodes("a:4")
+	require.EqualError(t, err, `invalid node id "a"`)
+}
+
+func TestRestoreNode(t *testing.T) {
+	dir, dataDir := t.TempDir(), t.TempDir()
+	backupNode := func(id uint64, shards, indexes []Entry) {
+		nodeDir := NodeDir(dir, id)
+		for _, e := range append(shards, indexes...) {
+			writeFiles(t, filepath.Join(nodeDir, e.Dir), map[string]string{"cpu/00000001-0000-00000000.tssp": "data", "kv/MANIFEST": "kv"})
+		}
+		require.NoError(t, WriteManifest(nodeDir, &Manifest{Shards: shards, Indexes: indexes}))
+	}
+	backupNode(1,
+		[]Entry{{Database: "db0", PtID: 0, RetentionPolicy: "rp0", ID: 3, Dir: "data/db0/0/rp0/3_100_200_2"}},
+		[]Entry{{Database: "db0", PtID: 0, RetentionPolicy: "rp0", ID: 2, Dir: "data/db0/0/rp0/index/2_100_200"}})
+	backupNode(2,
+		[]Entry{
+			{Database: "db0", PtID: 1, RetentionPolicy: "rp0", ID: 4, Dir: "data/db0/1/rp0/4_100_200_5"},
+			// the shard created after the meta data was saved
+			{Database: "db0", PtID: 1, RetentionPolicy: "rp0", ID: 6, Dir: "data/db0/1/rp0/6_200_300_5"},
+			{Database: "other", PtID: 1, RetentionPolicy: "autogen", ID: 7, Dir: "data/other/1/autogen/7_100_200_8"},
+		},
+		[]Entry{{Database: "db0", PtID: 1, RetentionPolicy: "rp0", ID: 5, Dir: "data/db0/1/rp0/index/5_100_200"}})
+
+	r := &Remapping{
+		Database:          "db0",
+		NewDatabase:       "db1",
+		RetentionPolicies: map[string]string{"rp0": "rp1"},
+		Nodes:             map[uint64]uint64{2: 1},
+		Shards:            map[uint64]uint64{3: 13, 4: 14},
+		Indexes:           map[uint64]uint64{2: 12, 5: 15},
+	}
+	require.NoError(t, r.Write(dir))
+	r, err := ReadRemapping(dir, "db1")
+	require.NoError(t, err)
+
+	n, err := RestoreNode(dir, dataDir, 3, r)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	n, err = RestoreNode(dir, dataDir, 1, r)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	for _, name := range []string{
+		"data/db1/0/rp1/13_100_200_12/cpu/00000001-0000-00000000.tssp",
+		"data/db1/0/rp1/index/12_100_200/kv/MANIFEST",
+		"data/db1/1/rp1/14_100_200_15/cpu/00000001-0000-00000000.tssp",
+		"data/db1/1/rp1/index/15_100_200/kv/MANIFEST",
+	} {
+		_, err := os.Stat(filepath.Join(dataDir, name))
+		require.NoError(t, err, name)
+	}
+	_, err = os.Stat(filepath.Join(dataDir, "data/other"))
+	require.True(t, os.IsNotExist(err))
+
+	_, err = RestoreNode(dir, dataDir, 1, r)
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"path"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultAuditMeasurement is the measurement the audit events are written to.
+	DefaultAuditMeasurement = "audit"
+
+	// DefaultAuditWriteInterval is the default interval of writing the audit events to the audit database.
+	DefaultAuditWriteInterval = 10 * time.Second
+)
+
+// Audit represents the configuration of the audit log, which records who changed the schema, the users or the
+// privileges, who called the sys ctrl and who failed to log in.
+type Audit struct {
+	Enabled bool `toml:"enabled"`
+
+	// File of the audit log, rotated the same way as the other logs.
+	Path            string    `toml:"path"`
+	MaxSize         toml.Size `toml:"max-size"`
+	MaxNum          int       `toml:"max-num"`
+	MaxAge          int       `toml:"max-age"`
+	CompressEnabled bool      `toml:"compress-enabled"`
+
+	// The events are also written to the audit measurement of this database if it is set, the database is
+	// created if it does not exist.
+	Database      string        `toml:"database"`
+	WriteInterval toml.Duration `toml:"write-interval"`
+}
+
+// NewAudit returns a new instance of Audit with defaults.
+func NewAudit() Audit {
+	return Audit{
+		Enabled:         false,
+		Path:            path.Join(DefaultPath, "audit.log"),
+		MaxSize:         toml.Size(DefaultMaxSize),
+		MaxNum:          DefaultMaxNum,
+		MaxAge:          DefaultMaxAge,
+		CompressEnabled: DefaultCompressEnabled,
+		WriteInterval:   toml.Duration(DefaultAuditWriteInterval),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Audit) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Path == "" {
+		return errors.New("audit path must be specified")
+	}
+	if c.MaxSize <= 0 || c.MaxNum <= 0 || c.MaxAge <= 0 {
+		return errors.New("audit max-size, max-num and max-age must be positive")
+	}
+	if c.Database != "" && c.WriteInterval <= 0 {
+		return errors.New("audit write-interval must be positive")
+	}
+	return nil
+}
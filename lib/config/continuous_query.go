@@ -0,0 +1,70 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultCQRunInterval is the default interval of checking whether any continuous query needs to be run.
+	DefaultCQRunInterval = time.Second
+
+	// DefaultCQLeaseDuration is the default duration of the lease which allows a ts-sql to run continuous queries.
+	DefaultCQLeaseDuration = time.Minute
+)
+
+// ContinuousQuery represents the configuration for the continuous query service.
+type ContinuousQuery struct {
+	// If this flag is set to false, the ts-sql never runs continuous queries.
+	Enabled bool `toml:"enabled"`
+
+	// Run interval for checking continuous queries. This should be set to the least common factor
+	// of the interval for running continuous queries.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// Only the ts-sql holding the lease runs continuous queries,
+	// another ts-sql takes over after the lease is not renewed for this duration.
+	LeaseDuration toml.Duration `toml:"lease-duration"`
+}
+
+// NewContinuousQuery returns a new instance of ContinuousQuery with defaults.
+func NewContinuousQuery() ContinuousQuery {
+	return ContinuousQuery{
+		Enabled:       true,
+		RunInterval:   toml.Duration(DefaultCQRunInterval),
+		LeaseDuration: toml.Duration(DefaultCQLeaseDuration),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c ContinuousQuery) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.RunInterval <= 0 {
+		return errors.New("continuous_queries run-interval must be positive")
+	}
+	if c.LeaseDuration < c.RunInterval {
+		return errors.New("continuous_queries lease-duration must be no less than run-interval")
+	}
+	return nil
+}
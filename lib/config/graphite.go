@@ -0,0 +1,170 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultGraphiteBindAddress is the default address a Graphite listener binds to.
+	DefaultGraphiteBindAddress = ":2003"
+
+	// DefaultGraphiteDatabase is the default database the Graphite points are written to.
+	DefaultGraphiteDatabase = "graphite"
+
+	// DefaultGraphiteProtocol is the default protocol of a Graphite listener.
+	DefaultGraphiteProtocol = "tcp"
+
+	// DefaultGraphiteSeparator is the default separator joining the parts of a metric into a measurement,
+	// a field or a tag value.
+	DefaultGraphiteSeparator = "."
+
+	// DefaultGraphiteBatchSize is the default number of points written in a batch.
+	DefaultGraphiteBatchSize = 5000
+
+	// DefaultGraphiteBatchTimeout is the default time a batch waits for more points before it is written.
+	DefaultGraphiteBatchTimeout = time.Second
+
+	// DefaultGraphiteUDPReadBuffer is the default size of the socket read buffer of a UDP listener,
+	// zero keeps the size of the system.
+	DefaultGraphiteUDPReadBuffer = 0
+)
+
+// Graphite represents the configuration of a Graphite listener, which accepts the plaintext protocol and writes
+// the points to one database. There may be a listener for each target database.
+type Graphite struct {
+	Enabled     bool   `toml:"enabled"`
+	BindAddress string `toml:"bind-address"`
+
+	// Protocol is tcp or udp.
+	Protocol      string    `toml:"protocol"`
+	UDPReadBuffer toml.Size `toml:"udp-read-buffer"`
+
+	// Database and retention policy the points are written to, the database is created if it does not exist.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// Templates map the parts of a metric to the measurement, the field and the tags of its point. A template is
+	// "[filter] template [tag1=value1,tag2=value2]", the parts of the template are measurement, field, a tag key
+	// or an empty part to skip, and the last may be measurement* or field* to take the rest of the metric.
+	// A metric matched by no filter uses the template without filter, or measurement* if there is none.
+	Templates []string `toml:"templates"`
+
+	// Tags are added to all the points, as tag=value.
+	Tags      []string `toml:"tags"`
+	Separator string   `toml:"separator"`
+
+	BatchSize    int           `toml:"batch-size"`
+	BatchTimeout toml.Duration `toml:"batch-timeout"`
+
+	// Log the lines that cannot be parsed.
+	LogPointErrors bool `toml:"log-point-errors"`
+}
+
+// NewGraphite returns a new instance of Graphite with defaults.
+func NewGraphite() Graphite {
+	return Graphite{
+		Enabled:       false,
+		BindAddress:   DefaultGraphiteBindAddress,
+		Protocol:      DefaultGraphiteProtocol,
+		UDPReadBuffer: toml.Size(DefaultGraphiteUDPReadBuffer),
+		Database:      DefaultGraphiteDatabase,
+		Separator:     DefaultGraphiteSeparator,
+		BatchSize:     DefaultGraphiteBatchSize,
+		BatchTimeout:  toml.Duration(DefaultGraphiteBatchTimeout),
+	}
+}
+
+// WithDefaults fills the unset options with defaults.
+func (c Graphite) WithDefaults() Graphite {
+	d := NewGraphite()
+	if c.BindAddress == "" {
+		c.BindAddress = d.BindAddress
+	}
+	if c.Protocol == "" {
+		c.Protocol = d.Protocol
+	}
+	if c.Database == "" {
+		c.Database = d.Database
+	}
+	if c.Separator == "" {
+		c.Separator = d.Separator
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = d.BatchSize
+	}
+	if c.BatchTimeout == 0 {
+		c.BatchTimeout = d.BatchTimeout
+	}
+	return c
+}
+
+// Validate returns an error if the config is invalid. The templates are checked by the listener.
+func (c Graphite) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.BindAddress == "" {
+		return errors.New("graphite bind-address must be specified")
+	}
+	if c.Protocol != "tcp" && c.Protocol != "udp" {
+		return fmt.Errorf("invalid graphite protocol %q, expect tcp or udp", c.Protocol)
+	}
+	if c.Database == "" {
+		return errors.New("graphite database must be specified")
+	}
+	if c.BatchSize <= 0 || c.BatchTimeout <= 0 {
+		return errors.New("graphite batch-size and batch-timeout must be positive")
+	}
+	for _, tag := range c.Tags {
+		if kv := strings.SplitN(tag, "=", 2); len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return fmt.Errorf("invalid graphite tag %q, expect tag=value", tag)
+		}
+	}
+	return nil
+}
+
+// Graphites are the Graphite listeners.
+type Graphites []Graphite
+
+// Validate returns an error if a listener is invalid or two listeners bind to the same address. The unset options
+// of the listeners take their defaults.
+func (c Graphites) Validate() error {
+	addrs := make(map[string]struct{}, len(c))
+	for i := range c {
+		g := c[i].WithDefaults()
+		if err := g.Validate(); err != nil {
+			return err
+		}
+		if !g.Enabled {
+			continue
+		}
+		addr := g.Protocol + "://" + g.BindAddress
+		if _, ok := addrs[addr]; ok {
+			return fmt.Errorf("graphite listeners bind to the same address %s", addr)
+		}
+		addrs[addr] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultHintedHandoffDir is the default directory of the hinted handoff queues.
+	DefaultHintedHandoffDir = "/opt/openGemini/hhd"
+
+	// DefaultHintedHandoffMaxSize is the default maximum size of the queue of each node.
+	DefaultHintedHandoffMaxSize = 1024 * 1024 * 1024
+
+	// DefaultHintedHandoffMaxAge is the default maximum age of the writes in a queue.
+	DefaultHintedHandoffMaxAge = 7 * 24 * time.Hour
+
+	// DefaultHintedHandoffSegmentSize is the default size at which a queue rolls over to a new segment file.
+	DefaultHintedHandoffSegmentSize = 10 * 1024 * 1024
+
+	// DefaultHintedHandoffRetryInterval is the default interval of replaying the queues.
+	DefaultHintedHandoffRetryInterval = 10 * time.Second
+)
+
+// HintedHandoff represents the configuration of the hinted handoff service, which queues the writes to the
+// ts-store nodes that cannot be reached and replays them once the nodes rejoin.
+type HintedHandoff struct {
+	// If this flag is set to false, the writes to an unreachable node fail.
+	Enabled bool `toml:"enabled"`
+
+	// Directory of the queues, one sub directory per node.
+	Dir string `toml:"dir"`
+
+	// Writes to a node fail once its queue reaches this size.
+	MaxSize toml.Size `toml:"max-size"`
+
+	// Queued writes older than this are dropped.
+	MaxAge toml.Duration `toml:"max-age"`
+
+	// Size at which a queue rolls over to a new segment file.
+	SegmentSize toml.Size `toml:"segment-size"`
+
+	// Interval of replaying the queues, they are also replayed as soon as a node rejoins.
+	RetryInterval toml.Duration `toml:"retry-interval"`
+}
+
+// NewHintedHandoff returns a new instance of HintedHandoff with defaults.
+func NewHintedHandoff() HintedHandoff {
+	return HintedHandoff{
+		Enabled:       false,
+		Dir:           DefaultHintedHandoffDir,
+		MaxSize:       toml.Size(DefaultHintedHandoffMaxSize),
+		MaxAge:        toml.Duration(DefaultHintedHandoffMaxAge),
+		SegmentSize:   toml.Size(DefaultHintedHandoffSegmentSize),
+		RetryInterval: toml.Duration(DefaultHintedHandoffRetryInterval),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c HintedHandoff) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Dir == "" {
+		return errors.New("hinted-handoff dir must be specified")
+	}
+	if c.MaxSize == 0 {
+		return errors.New("hinted-handoff max-size must be positive")
+	}
+	if c.MaxAge <= 0 {
+		return errors.New("hinted-handoff max-age must be positive")
+	}
+	if c.SegmentSize == 0 || c.SegmentSize > c.MaxSize {
+		return errors.New("hinted-handoff segment-size must be positive and not greater than max-size")
+	}
+	if c.RetryInterval <= 0 {
+		return errors.New("hinted-handoff retry-interval must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultOpenTSDBBindAddress is the default address the OpenTSDB listener binds to.
+	DefaultOpenTSDBBindAddress = ":4242"
+
+	// DefaultOpenTSDBDatabase is the default database the OpenTSDB points are written to.
+	DefaultOpenTSDBDatabase = "opentsdb"
+
+	// DefaultOpenTSDBField is the default field the value of an OpenTSDB point is written to.
+	DefaultOpenTSDBField = "value"
+
+	// DefaultOpenTSDBBatchSize is the default number of points written in a batch.
+	DefaultOpenTSDBBatchSize = 1000
+
+	// DefaultOpenTSDBBatchTimeout is the default time a batch waits for more points before it is written.
+	DefaultOpenTSDBBatchTimeout = time.Second
+)
+
+// OpenTSDB represents the configuration of the OpenTSDB listener, which accepts the put lines of the telnet
+// protocol and the JSON of /api/put on the same address.
+type OpenTSDB struct {
+	Enabled     bool   `toml:"enabled"`
+	BindAddress string `toml:"bind-address"`
+
+	// Database and retention policy the points are written to. The database is created if it does not exist.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// A point is written to a measurement named after its metric and to this field, unless its metric is
+	// mapped by a rule.
+	Field string         `toml:"field"`
+	Rules []OpenTSDBRule `toml:"rules"`
+
+	BatchSize    int           `toml:"batch-size"`
+	BatchTimeout toml.Duration `toml:"batch-timeout"`
+
+	// Log the lines and the points that cannot be parsed.
+	LogPointErrors bool `toml:"log-point-errors"`
+}
+
+// OpenTSDBRule maps the metric equal to Prefix and the metrics beginning with Prefix and a dot to Measurement.
+// The rest of a metric after the dot names its field, the metric equal to the prefix is written to the default
+// field. The rule of the longest matching prefix applies.
+type OpenTSDBRule struct {
+	Prefix      string `toml:"prefix"`
+	Measurement string `toml:"measurement"`
+}
+
+// NewOpenTSDB returns a new instance of OpenTSDB with defaults.
+func NewOpenTSDB() OpenTSDB {
+	return OpenTSDB{
+		Enabled:      false,
+		BindAddress:  DefaultOpenTSDBBindAddress,
+		Database:     DefaultOpenTSDBDatabase,
+		Field:        DefaultOpenTSDBField,
+		BatchSize:    DefaultOpenTSDBBatchSize,
+		BatchTimeout: toml.Duration(DefaultOpenTSDBBatchTimeout),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c OpenTSDB) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.BindAddress == "" {
+		return errors.New("opentsdb bind-address must be specified")
+	}
+	if c.Database == "" {
+		return errors.New("opentsdb database must be specified")
+	}
+	if c.Field == "" {
+		return errors.New("opentsdb field must be specified")
+	}
+	if c.BatchSize <= 0 || c.BatchTimeout <= 0 {
+		return errors.New("opentsdb batch-size and batch-timeout must be positive")
+	}
+	for _, r := range c.Rules {
+		if r.Prefix == "" || r.Measurement == "" {
+			return fmt.Errorf("opentsdb rule %+v must have a prefix and a measurement", r)
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/influxdata/influxdb/pkg/tlsconfig"
@@ -50,6 +51,18 @@ const (
 	DefaultShardTier                = "warm"
 	DefaultForceBroadcastQuery      = false
 	DefaultRetentionPolicyLimit     = 100
+
+	// DefaultWriteConsistency is the number of replicas of a shard that must accept a write before it is acknowledged.
+	DefaultWriteConsistency = WriteConsistencyQuorum
+
+	// DefaultReplicaSyncInterval is how often the replicas that missed writes are re-synced from the healthy replicas.
+	DefaultReplicaSyncInterval = 10 * time.Second
+)
+
+const (
+	WriteConsistencyOne    = "one"
+	WriteConsistencyQuorum = "quorum"
+	WriteConsistencyAll    = "all"
 )
 
 // TSSql represents the configuration format for the TSSql binary.
@@ -60,11 +73,20 @@ type TSSql struct {
 	Logging     Logger      `toml:"logging"`
 	Spdy        Spdy        `toml:"spdy"`
 
-	HTTP httpdConfig.Config `toml:"http"`
+	HTTP     httpdConfig.Config `toml:"http"`
+	OpenTSDB OpenTSDB           `toml:"opentsdb"`
+	Graphite Graphites          `toml:"graphite"`
+	UDP      UDPs               `toml:"udp"`
 
 	// TLS provides configuration options for all https endpoints.
 	TLS      tlsconfig.Config `toml:"tls"`
 	Analysis Castor           `toml:"castor"`
+
+	ContinuousQuery ContinuousQuery `toml:"continuous_queries"`
+	Subscriber      Subscriber      `toml:"subscriber"`
+	HintedHandoff   HintedHandoff   `toml:"hinted-handoff"`
+	WorkloadGroups  WorkloadGroups  `toml:"workload-group"`
+	Audit           Audit           `toml:"audit"`
 }
 
 // NewTSSql returns an instance of Config with reasonable defaults.
@@ -75,7 +97,12 @@ func NewTSSql() *TSSql {
 	c.Monitor = NewMonitor(AppSql)
 	c.Logging = NewLogger(AppSql)
 	c.HTTP = httpdConfig.NewConfig()
+	c.OpenTSDB = NewOpenTSDB()
 	c.Analysis = NewCastor()
+	c.ContinuousQuery = NewContinuousQuery()
+	c.Subscriber = NewSubscriber()
+	c.HintedHandoff = NewHintedHandoff()
+	c.Audit = NewAudit()
 	return c
 }
 
@@ -88,8 +115,16 @@ func (c *TSSql) Validate() error {
 		c.Logging,
 		c.Coordinator,
 		c.HTTP,
+		c.OpenTSDB,
+		c.Graphite,
+		c.UDP,
 		c.Spdy,
 		c.Analysis,
+		c.ContinuousQuery,
+		c.Subscriber,
+		c.HintedHandoff,
+		c.WorkloadGroups,
+		c.Audit,
 	}
 
 	for _, item := range items {
@@ -137,6 +172,10 @@ type Coordinator struct {
 	QueryLimitFlag          bool `toml:"query-limit-flag"`
 	QueryTimeCompareEnabled bool `toml:"query-time-compare-enabled"`
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
+
+	// WriteConsistency is one of one, quorum and all
+	WriteConsistency    string        `toml:"write-consistency"`
+	ReplicaSyncInterval toml.Duration `toml:"replica-sync-interval"`
 }
 
 // NewCoordinator returns an instance of Config with defaults.
@@ -156,6 +195,8 @@ func NewCoordinator() Coordinator {
 		ShardTier:                DefaultShardTier,
 		RetentionPolicyLimit:     DefaultRetentionPolicyLimit,
 		ForceBroadcastQuery:      DefaultForceBroadcastQuery,
+		WriteConsistency:         DefaultWriteConsistency,
+		ReplicaSyncInterval:      toml.Duration(DefaultReplicaSyncInterval),
 	}
 }
 
@@ -173,5 +214,13 @@ func (c Coordinator) Validate() error {
 	if c.RetentionPolicyLimit <= 0 {
 		return errors.New("coordinator rp-limit can not be negative")
 	}
+	switch c.WriteConsistency {
+	case WriteConsistencyOne, WriteConsistencyQuorum, WriteConsistencyAll:
+	default:
+		return fmt.Errorf("invalid coordinator write-consistency %q, expect one, quorum or all", c.WriteConsistency)
+	}
+	if c.ReplicaSyncInterval <= 0 {
+		return errors.New("coordinator replica-sync-interval must be positive")
+	}
 	return nil
 }
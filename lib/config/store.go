@@ -67,6 +67,7 @@ type TSStore struct {
 	HTTPD             httpdConf.Config `toml:"http"`
 	Retention         retention.Config `toml:"retention"`
 	HierarchicalStore retention.Config `toml:"hierarchical-storage"`
+	DownSample        retention.Config `toml:"downsample"`
 
 	// TLS provides configuration options for all https endpoints.
 	TLS      tlsconfig.Config `toml:"tls"`
@@ -87,6 +88,7 @@ func NewTSStore() *TSStore {
 
 	c.Retention = retention.NewConfig()
 	c.HierarchicalStore = retention.NewConfig()
+	c.DownSample = retention.NewConfig()
 	c.Gossip = NewGossip()
 
 	c.Analysis = NewCastor()
@@ -105,6 +107,7 @@ func (c *TSStore) Validate() error {
 		c.Monitor,
 		c.Retention,
 		c.HierarchicalStore,
+		c.DownSample,
 		c.TLS,
 		c.Logging,
 		c.Spdy,
@@ -170,6 +173,7 @@ type Store struct {
 
 	WalEnabled        bool `toml:"wal-enabled"`
 	WalReplayParallel bool `toml:"wal-replay-parallel"`
+	WalReplaySalvage  bool `toml:"wal-replay-salvage"`
 	CacheDataBlock    bool `toml:"cache-table-data-block"`
 	CacheMetaBlock    bool `toml:"cache-table-meta-block"`
 	EnableMmapRead    bool `toml:"enable-mmap-read"`
@@ -178,6 +182,11 @@ type Store struct {
 
 	ReadCacheLimit       int `toml:"read-cache-limit"`
 	WriteConcurrentLimit int `toml:"write-concurrent-limit"`
+
+	// Cardinality limits of each index of a pt of a database, 0 means unlimited
+	MaxSeriesPerDatabase    int `toml:"max-series-per-database"`
+	MaxSeriesPerMeasurement int `toml:"max-series-per-measurement"`
+	MaxValuesPerTag         int `toml:"max-values-per-tag"`
 }
 
 // NewStore returns the default configuration for tsdb.
@@ -200,6 +209,7 @@ func NewStore() Store {
 		WalSyncInterval:              toml.Duration(DefaultWALSyncInterval),
 		WalEnabled:                   true,
 		WalReplayParallel:            false,
+		WalReplaySalvage:             false,
 		CompactRecovery:              true,
 		CompactionMethod:             0,
 	}
@@ -254,6 +264,9 @@ func (c Store) Validate() error {
 		{"data imm-table-max-memory-percentage", int64(c.ImmTableMaxMemoryPercentage), false},
 		{"data write-cold-duration", int64(c.WriteColdDuration), false},
 		{"data max-write-hang-time", int64(c.MaxWriteHangTime), false},
+		{"data max-series-per-database", int64(c.MaxSeriesPerDatabase), true},
+		{"data max-series-per-measurement", int64(c.MaxSeriesPerMeasurement), true},
+		{"data max-values-per-tag", int64(c.MaxValuesPerTag), true},
 	}
 	iv := intValidator{0, math.MaxInt64}
 	if err := iv.Validate(ivItems); err != nil {
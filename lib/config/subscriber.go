@@ -0,0 +1,95 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultSubscriberHTTPTimeout is the default timeout of writing to an HTTP destination.
+	DefaultSubscriberHTTPTimeout = 30 * time.Second
+
+	// DefaultSubscriberWriteConcurrency is the default number of writers of each destination.
+	DefaultSubscriberWriteConcurrency = 40
+
+	// DefaultSubscriberWriteBufferSize is the default number of pending writes of each destination.
+	DefaultSubscriberWriteBufferSize = 1000
+
+	// DefaultSubscriberSyncInterval is the default interval of reloading subscriptions from meta.
+	DefaultSubscriberSyncInterval = 10 * time.Second
+)
+
+// Subscriber represents the configuration of the subscriber service,
+// which forwards the points written to a retention policy to the destinations of its subscriptions.
+type Subscriber struct {
+	// If this flag is set to false, the writes are never forwarded.
+	Enabled bool `toml:"enabled"`
+
+	// Timeout of each write to an HTTP destination.
+	HTTPTimeout toml.Duration `toml:"http-timeout"`
+
+	// Skip the certificate verification of HTTPS destinations.
+	InsecureSkipVerify bool `toml:"insecure-skip-verify"`
+
+	// Path of the PEM encoded CA certificates used to verify HTTPS destinations.
+	CaCerts string `toml:"ca-certs"`
+
+	// Number of concurrent writes to each destination.
+	WriteConcurrency int `toml:"write-concurrency"`
+
+	// Writes to a destination are dropped once this number of writes are pending.
+	WriteBufferSize int `toml:"write-buffer-size"`
+
+	// Interval of reloading the subscriptions from meta.
+	SyncInterval toml.Duration `toml:"sync-interval"`
+}
+
+// NewSubscriber returns a new instance of Subscriber with defaults.
+func NewSubscriber() Subscriber {
+	return Subscriber{
+		Enabled:          true,
+		HTTPTimeout:      toml.Duration(DefaultSubscriberHTTPTimeout),
+		WriteConcurrency: DefaultSubscriberWriteConcurrency,
+		WriteBufferSize:  DefaultSubscriberWriteBufferSize,
+		SyncInterval:     toml.Duration(DefaultSubscriberSyncInterval),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Subscriber) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.HTTPTimeout <= 0 {
+		return errors.New("subscriber http-timeout must be positive")
+	}
+	if c.WriteConcurrency <= 0 {
+		return errors.New("subscriber write-concurrency must be positive")
+	}
+	if c.WriteBufferSize <= 0 {
+		return errors.New("subscriber write-buffer-size must be positive")
+	}
+	if c.SyncInterval <= 0 {
+		return errors.New("subscriber sync-interval must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultUDPBindAddress is the default address a UDP line protocol listener binds to.
+	DefaultUDPBindAddress = ":8089"
+
+	// DefaultUDPDatabase is the default database the points received on UDP are written to.
+	DefaultUDPDatabase = "udp"
+
+	// DefaultUDPBatchSize is the default number of points written in a batch.
+	DefaultUDPBatchSize = 5000
+
+	// DefaultUDPBatchTimeout is the default time a batch waits for more points before it is written.
+	DefaultUDPBatchTimeout = time.Second
+
+	// DefaultUDPPendingPackets is the default number of datagrams waiting to be parsed.
+	DefaultUDPPendingPackets = 1000
+)
+
+// UDP represents the configuration of a listener receiving the line protocol in UDP datagrams. A datagram holds
+// whole lines, the lines of a datagram that cannot be parsed drop the datagram.
+type UDP struct {
+	Enabled     bool      `toml:"enabled"`
+	BindAddress string    `toml:"bind-address"`
+	ReadBuffer  toml.Size `toml:"read-buffer"`
+
+	// Database and retention policy the points are written to, the database is created if it does not exist.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// Precision of the timestamps of the points, n, u, ms, s, m or h. The default is nanoseconds.
+	Precision string `toml:"precision"`
+
+	BatchSize    int           `toml:"batch-size"`
+	BatchTimeout toml.Duration `toml:"batch-timeout"`
+
+	// PendingPackets is the number of datagrams waiting to be parsed, the datagrams received when as many are
+	// waiting are dropped.
+	PendingPackets int `toml:"pending-packets"`
+
+	// Log the datagrams that cannot be parsed.
+	LogPointErrors bool `toml:"log-point-errors"`
+}
+
+// NewUDP returns a new instance of UDP with defaults.
+func NewUDP() UDP {
+	return UDP{
+		Enabled:        false,
+		BindAddress:    DefaultUDPBindAddress,
+		Database:       DefaultUDPDatabase,
+		BatchSize:      DefaultUDPBatchSize,
+		BatchTimeout:   toml.Duration(DefaultUDPBatchTimeout),
+		PendingPackets: DefaultUDPPendingPackets,
+	}
+}
+
+// WithDefaults fills the unset options with defaults.
+func (c UDP) WithDefaults() UDP {
+	d := NewUDP()
+	if c.BindAddress == "" {
+		c.BindAddress = d.BindAddress
+	}
+	if c.Database == "" {
+		c.Database = d.Database
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = d.BatchSize
+	}
+	if c.BatchTimeout == 0 {
+		c.BatchTimeout = d.BatchTimeout
+	}
+	if c.PendingPackets == 0 {
+		c.PendingPackets = d.PendingPackets
+	}
+	return c
+}
+
+// Validate returns an error if the config is invalid.
+func (c UDP) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.BindAddress == "" {
+		return errors.New("udp bind-address must be specified")
+	}
+	if c.Database == "" {
+		return errors.New("udp database must be specified")
+	}
+	switch c.Precision {
+	case "", "n", "ns", "u", "us", "ms", "s", "m", "h":
+	default:
+		return fmt.Errorf("invalid udp precision %q", c.Precision)
+	}
+	if c.BatchSize <= 0 || c.BatchTimeout <= 0 {
+		return errors.New("udp batch-size and batch-timeout must be positive")
+	}
+	if c.PendingPackets <= 0 {
+		return errors.New("udp pending-packets must be positive")
+	}
+	return nil
+}
+
+// UDPs are the UDP line protocol listeners.
+type UDPs []UDP
+
+// Validate returns an error if a listener is invalid or two listeners bind to the same address. The unset options
+// of the listeners take their defaults.
+func (c UDPs) Validate() error {
+	addrs := make(map[string]struct{}, len(c))
+	for i := range c {
+		u := c[i].WithDefaults()
+		if err := u.Validate(); err != nil {
+			return err
+		}
+		if !u.Enabled {
+			continue
+		}
+		if _, ok := addrs[u.BindAddress]; ok {
+			return fmt.Errorf("udp listeners bind to the same address %s", u.BindAddress)
+		}
+		addrs[u.BindAddress] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+// DefaultWorkloadGroup is the name of the group of the users that are not mapped to any group. The users are not
+// limited by a group if it is not configured.
+const DefaultWorkloadGroup = "default"
+
+// WorkloadGroup limits the queries of the users mapped to it, e.g. to keep the batch reports from starving the
+// dashboards. A zero limit means unlimited.
+type WorkloadGroup struct {
+	Name  string   `toml:"name"`
+	Users []string `toml:"users"`
+
+	// Maximum number of the queries of the group running at the same time.
+	MaxConcurrency int `toml:"max-concurrency"`
+
+	// Maximum number of the queries of the group waiting for running, further queries fail at once.
+	MaxQueue int `toml:"max-queue"`
+
+	// Memory the pipeline executors of the group can hold at the same time.
+	MaxMemory toml.Size `toml:"max-memory"`
+
+	// Timeout of the queries of the group, the time waiting in the queue included. It overrides the query-timeout
+	// of the coordinator.
+	Timeout toml.Duration `toml:"timeout"`
+}
+
+type WorkloadGroups []WorkloadGroup
+
+// Validate returns an error if the config is invalid.
+func (c WorkloadGroups) Validate() error {
+	names := make(map[string]struct{}, len(c))
+	users := make(map[string]string)
+	for _, g := range c {
+		if g.Name == "" {
+			return fmt.Errorf("workload-group name must be specified")
+		}
+		if _, ok := names[g.Name]; ok {
+			return fmt.Errorf("duplicate workload-group %q", g.Name)
+		}
+		names[g.Name] = struct{}{}
+
+		if g.MaxConcurrency < 0 || g.MaxQueue < 0 || g.Timeout < 0 {
+			return fmt.Errorf("workload-group %q limits can not be negative", g.Name)
+		}
+		for _, u := range g.Users {
+			if other, ok := users[u]; ok {
+				return fmt.Errorf("user %q is mapped to both workload-group %q and %q", u, other, g.Name)
+			}
+			users[u] = g.Name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadGroups_Validate(t *testing.T) {
+	require.NoError(t, config.WorkloadGroups{{Name: "a", Users: []string{"u1"}}, {Name: "b", Users: []string{"u2"}}}.Validate())
+	require.Error(t, config.WorkloadGroups{{Name: "a"}, {Name: "a"}}.Validate())
+	require.Error(t, config.WorkloadGroups{{Name: "a", Users: []string{"u1"}}, {Name: "b", Users: []string{"u1"}}}.Validate())
+	require.Error(t, config.WorkloadGroups{{Name: "a", MaxQueue: -1}}.Validate())
+	require.Error(t, config.WorkloadGroups{{}}.Validate())
+}
@@ -94,6 +94,8 @@ const (
 	BucketLacks                  = 1113
 	CreatePipelineExecutorFail   = 1114
 	LogicalPlainBuildFailInShard = 1115
+	QueryKilled                  = 1116
+	WorkloadGroupQueueFull       = 1117
 )
 
 // store engine error codes
@@ -125,6 +127,7 @@ const (
 	WalRecordHeaderCorrupted           = 2125
 	WalRecordUnmarshalFailed           = 2126
 	CompactPanicFail                   = 2127
+	WalRecordChecksumMismatch          = 2128
 )
 
 // merge out of order
@@ -193,11 +196,17 @@ const (
 	WritePointOutOfRP          = 5013
 	WritePointShardKeyTooLarge = 5014
 	EngineClosed               = 5015
+	WriteReplicaOffline        = 5016
+	WriteConsistencyNotReached = 5017
+	HintedHandoffQueueFull     = 5018
 )
 
 // index
 const (
-	ConvertToBinaryExprFailed = 6001
+	ConvertToBinaryExprFailed      = 6001
+	SeriesLimitExceeded            = 6002
+	MeasurementSeriesLimitExceeded = 6003
+	TagValuesLimitExceeded         = 6004
 )
 
 const (
@@ -76,6 +76,10 @@ var messageMap = map[Errno]*Message{
 	DuplicateField:     newWarnMessage("duplicate field: %s", ModuleWrite),
 	EngineClosed:       newWarnMessage("engine is closed", ModuleWrite),
 
+	WriteReplicaOffline:        newWarnMessage("replica pt %d of shard %d is offline", ModuleWrite),
+	WriteConsistencyNotReached: newWarnMessage("%d of %d replicas of shard %d accepted the write, %d required: %v", ModuleWrite),
+	HintedHandoffQueueFull:     newWarnMessage("hinted handoff queue of node %d is full: %d bytes queued", ModuleWrite),
+
 	// network module error codes
 	NoConnectionAvailable: newFatalMessage("no connections available, node: %v, %v", ModuleNetwork),
 	NoNodeAvailable:       newFatalMessage("no node available, node: %v", ModuleNetwork),
@@ -120,6 +124,8 @@ var messageMap = map[Errno]*Message{
 	UnsupportedDataType:        newWarnMessage("unsupported (%s) iterator type: (%s)", ModuleQueryEngine),
 	LogicalPlanBuildFail:       newWarnMessage("logical plan build failed: %s", ModuleQueryEngine),
 	CreatePipelineExecutorFail: newWarnMessage("create pipeline executor raise panic: %s", ModuleQueryEngine),
+	QueryKilled:                newWarnMessage("query %d of %s was killed", ModuleQueryEngine),
+	WorkloadGroupQueueFull:     newWarnMessage("workload group %s has %d queries queued, the limit is %d", ModuleQueryEngine),
 
 	// store engine error codes
 	CreateIndexFailPointRowType:        newFatalMessage("create index failed due to rows are not belong to type PointRow", ModuleIndex),
@@ -151,6 +157,7 @@ var messageMap = map[Errno]*Message{
 	DecompressWalRecordFailed: newWarnMessage("decompress wal record failed", ModuleWal),
 	WalRecordHeaderCorrupted:  newWarnMessage("wal record header is corrupt", ModuleWal),
 	WalRecordUnmarshalFailed:  newWarnMessage("wal record unmarshal failed", ModuleWal),
+	WalRecordChecksumMismatch: newWarnMessage("wal record checksum mismatch, file: %s, offset: %d", ModuleWal),
 
 	// merge out of order
 	SeriesIdIsZero:     newFatalMessage("invalid record, series id is 0. file: %s", ModuleMerge),
@@ -200,7 +207,10 @@ var messageMap = map[Errno]*Message{
 	InvalidUsernameLen: newNoticeMessage("the username needs to be between %d and %d characters long", ModuleMetaClient),
 
 	// index error codes
-	ConvertToBinaryExprFailed: newWarnMessage("convert to BinaryExpr failed: expr %T is not *influxql.BinaryExpr", ModuleIndex),
+	ConvertToBinaryExprFailed:      newWarnMessage("convert to BinaryExpr failed: expr %T is not *influxql.BinaryExpr", ModuleIndex),
+	SeriesLimitExceeded:            newWarnMessage("max-series-per-database limit exceeded: db=%s limit=%d", ModuleIndex),
+	MeasurementSeriesLimitExceeded: newWarnMessage("max-series-per-measurement limit exceeded: measurement=%s limit=%d", ModuleIndex),
+	TagValuesLimitExceeded:         newWarnMessage("max-values-per-tag limit exceeded: measurement=%s tag=%s value=%s limit=%d", ModuleIndex),
 
 	// monitoring and statistics
 	WatchFileTimeout: newWarnMessage("watch file timeout", ModuleStat),
@@ -34,10 +34,13 @@ type KVStorage interface {
 	NewIterator(lowerBound []byte, upperBound []byte) PebbleDBIterator
 	NewBatch() *Batch
 	Apply(b *Batch) error
+	// Checkpoint writes a consistent copy of the storage to dir, which must not exist.
+	Checkpoint(dir string) error
 }
 
 var (
 	ErrNotFound = errors.New("kv: not found")
+	ErrClosed   = errors.New("kv: closed")
 )
 
 type DBType int
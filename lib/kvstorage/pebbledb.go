@@ -254,6 +254,17 @@ func (db *PebbleDB) Flush() error {
 	return db.db.Flush()
 }
 
+func (db *PebbleDB) Checkpoint(dir string) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return ErrClosed
+	}
+
+	return db.db.Checkpoint(dir)
+}
+
 func (db *PebbleDB) Apply(b *Batch) error {
 	db.mu.RLock()
 	defer db.mu.RUnlock()
@@ -30,6 +30,9 @@ import (
 // machineID: 2 byte random, 4 byte ip, 2 byte port
 var machineID uint64
 
+// machineAddr: the address the machine ID is initialized with
+var machineAddr string
+
 func init() {
 	machineID = uint64(rand.Int63())
 }
@@ -38,7 +41,13 @@ func GetMachineID() uint64 {
 	return machineID
 }
 
+// GetMachineAddr returns the address the machine ID is initialized with, it identifies the machine to the users.
+func GetMachineAddr() string {
+	return machineAddr
+}
+
 func InitMachineID(addr string) {
+	machineAddr = addr
 	ip, port, err := parseAddr(addr)
 	if err != nil {
 		logger.NewLogger(errno.ModuleNetwork).Warn("failed to parse address", zap.Error(err))
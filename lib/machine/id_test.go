@@ -40,6 +40,7 @@ func TestMachineID(t *testing.T) {
 
 	// test ipv4
 	machine.InitMachineID("127.0.0.1:8433")
+	assert.Equal(t, "127.0.0.1:8433", machine.GetMachineAddr())
 	id := machine.GetMachineID()
 	binary.BigEndian.PutUint64(buf[:], id)
 	assert.Equal(t, id&0xffff, uint64(8433), "invalid port")
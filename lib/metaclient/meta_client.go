@@ -122,7 +122,10 @@ type MetaClient interface {
 	CreateDatabaseWithRetentionPolicy(name string, spec *meta2.RetentionPolicySpec, shardKey *meta2.ShardKeyInfo) (*meta2.DatabaseInfo, error)
 	CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error)
 	CreateSubscription(database, rp, name, mode string, destinations []string) error
+	CreateContinuousQuery(database, name, query string) error
+	CreateDownSamplePolicy(database, name string, policy *meta2.DownSamplePolicyInfo) error
 	CreateUser(name, password string, admin, rwuser bool) (meta2.User, error)
+	CreateRole(name string) error
 	Databases() map[string]*meta2.DatabaseInfo
 	Database(name string) (*meta2.DatabaseInfo, error)
 	DataNode(id uint64) (*meta2.DataNode, error)
@@ -133,11 +136,21 @@ type MetaClient interface {
 	DropDatabase(name string) error
 	DropRetentionPolicy(database, name string) error
 	DropSubscription(database, rp, name string) error
+	DropContinuousQuery(database, name string) error
+	DropDownSamplePolicy(database, name string) error
 	DropUser(name string) error
+	DropRole(name string) error
 	MetaNodes() ([]meta2.NodeInfo, error)
 	RetentionPolicy(database, name string) (rpi *meta2.RetentionPolicyInfo, err error)
 	SetAdminPrivilege(username string, admin bool) error
 	SetPrivilege(username, database string, p originql.Privilege) error
+	SetUserRole(username, role string, revoke bool) error
+	SetRolePrivilege(role string, grant meta2.RoleGrant, revoke bool) error
+	SetRateLimit(rl meta2.RateLimitInfo) error
+	LagReplica(database, policy string, shardID uint64, pt uint32) error
+	ReplicaSynced(database, policy string, shardID uint64, pt uint32, seq uint64) error
+	LaggingReplicas() []meta2.LaggingReplica
+	RateLimits() []meta2.RateLimitInfo
 	ShardsByTimeRange(sources influxql.Sources, tmin, tmax time.Time) (a []meta2.ShardInfo, err error)
 	ShardGroupsByTimeRange(database, policy string, min, max time.Time) (a []meta2.ShardGroupInfo, err error)
 	TruncateShardGroups(t time.Time) error
@@ -162,8 +175,13 @@ type MetaClient interface {
 	ShowShards() models.Rows
 	ShowShardGroups() models.Rows
 	ShowSubscriptions() models.Rows
+	ShowRoles() models.Rows
+	ShowContinuousQueries() models.Rows
+	ShowDownSamplePolicies(database string) (models.Rows, error)
 	ShowRetentionPolicies(database string) (models.Rows, error)
 	GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []int
+	Data() meta2.Data
+	SetData(data *meta2.Data) error
 }
 
 type LoadCtx struct {
@@ -255,6 +273,10 @@ type Client struct {
 	muAuthData   sync.RWMutex
 	authFailRcds map[string]authFailCache
 	authSuccRcds map[string]time.Time
+
+	// cqLeaseRenewAt is the local time after which the continuous query lease
+	// held by this node is renewed, it does not depend on the clock of the meta leader.
+	cqLeaseRenewAt time.Time
 }
 
 type authRcd struct {
@@ -614,6 +636,31 @@ func (c *Client) ShowSubscriptions() models.Rows {
 	return c.cacheData.ShowSubscriptions()
 }
 
+func (c *Client) ShowRoles() models.Rows {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowRoles()
+}
+
+// RateLimits returns the rate limits of the databases and of the users.
+func (c *Client) RateLimits() []meta2.RateLimitInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.RateLimits
+}
+
+func (c *Client) ShowContinuousQueries() models.Rows {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowContinuousQueries()
+}
+
+func (c *Client) ShowDownSamplePolicies(database string) (models.Rows, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowDownSamplePolicies(database)
+}
+
 func (c *Client) ShowRetentionPolicies(database string) (models.Rows, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -1350,6 +1397,89 @@ func (c *Client) SetPrivilege(username, database string, p originql.Privilege) e
 	)
 }
 
+// CreateRole creates a role without privileges.
+func (c *Client) CreateRole(name string) error {
+	return c.retryUntilExec(proto2.Command_CreateRoleCommand, proto2.E_CreateRoleCommand_Command,
+		&proto2.CreateRoleCommand{
+			Name: proto.String(name),
+		},
+	)
+}
+
+// DropRole removes a role and revokes it from its users.
+func (c *Client) DropRole(name string) error {
+	return c.retryUntilExec(proto2.Command_DropRoleCommand, proto2.E_DropRoleCommand_Command,
+		&proto2.DropRoleCommand{
+			Name: proto.String(name),
+		},
+	)
+}
+
+// SetUserRole grants a role to the given username, or revokes it.
+func (c *Client) SetUserRole(username, role string, revoke bool) error {
+	return c.retryUntilExec(proto2.Command_SetUserRoleCommand, proto2.E_SetUserRoleCommand_Command,
+		&proto2.SetUserRoleCommand{
+			Username: proto.String(username),
+			Role:     proto.String(role),
+			Revoke:   proto.Bool(revoke),
+		},
+	)
+}
+
+// SetRolePrivilege grants a privilege to a role, or revokes it.
+func (c *Client) SetRolePrivilege(role string, grant meta2.RoleGrant, revoke bool) error {
+	return c.retryUntilExec(proto2.Command_SetRolePrivilegeCommand, proto2.E_SetRolePrivilegeCommand_Command,
+		&proto2.SetRolePrivilegeCommand{
+			Role:   proto.String(role),
+			Grant:  grant.Marshal(),
+			Revoke: proto.Bool(revoke),
+		},
+	)
+}
+
+// SetRateLimit sets the rate limit of a database or of a user, a limit without any rate removes it.
+func (c *Client) SetRateLimit(rl meta2.RateLimitInfo) error {
+	return c.retryUntilExec(proto2.Command_SetRateLimitCommand, proto2.E_SetRateLimitCommand_Command,
+		&proto2.SetRateLimitCommand{
+			Limit: rl.Marshal(),
+		},
+	)
+}
+
+// LagReplica marks the replica of a shard on pt as missing writes, the replica is not read until it is re-synced.
+func (c *Client) LagReplica(database, policy string, shardID uint64, pt uint32) error {
+	return c.retryUntilExec(proto2.Command_ReplicaLagCommand, proto2.E_ReplicaLagCommand_Command,
+		&proto2.ReplicaLagCommand{
+			Database: proto.String(database),
+			Policy:   proto.String(policy),
+			ShardID:  proto.Uint64(shardID),
+			PtID:     proto.Uint32(pt),
+			Lagging:  proto.Bool(true),
+		},
+	)
+}
+
+// ReplicaSynced clears the mark of a replica re-synced with the writes it missed up to seq.
+func (c *Client) ReplicaSynced(database, policy string, shardID uint64, pt uint32, seq uint64) error {
+	return c.retryUntilExec(proto2.Command_ReplicaLagCommand, proto2.E_ReplicaLagCommand_Command,
+		&proto2.ReplicaLagCommand{
+			Database: proto.String(database),
+			Policy:   proto.String(policy),
+			ShardID:  proto.Uint64(shardID),
+			PtID:     proto.Uint32(pt),
+			Lagging:  proto.Bool(false),
+			Seq:      proto.Uint64(seq),
+		},
+	)
+}
+
+// LaggingReplicas returns the replicas of the shards which missed writes.
+func (c *Client) LaggingReplicas() []meta2.LaggingReplica {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.LaggingReplicas()
+}
+
 // SetAdminPrivilege sets or unsets admin privilege to the given username.
 func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 	return c.retryUntilExec(proto2.Command_SetAdminPrivilegeCommand, proto2.E_SetAdminPrivilegeCommand_Command,
@@ -1765,8 +1895,12 @@ func (c *Client) GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []in
 	c.mu.RLock()
 	aliveShardIdxes := make([]int, 0, c.cacheData.ClusterPtNum)
 	for i := range sgi.Shards {
-		if c.cacheData.PtView[database][sgi.Shards[i].Owners[0]].Status == meta2.Online {
-			aliveShardIdxes = append(aliveShardIdxes, i)
+		// a shard is writable while any of its replicas is online
+		for _, pt := range sgi.Shards[i].Owners {
+			if c.cacheData.PtView[database][pt].Status == meta2.Online {
+				aliveShardIdxes = append(aliveShardIdxes, i)
+				break
+			}
 		}
 	}
 	c.mu.RUnlock()
@@ -1938,6 +2072,97 @@ func (c *Client) DropSubscription(database, rp, name string) error {
 	)
 }
 
+// CreateContinuousQuery creates a continuous query on the given database.
+func (c *Client) CreateContinuousQuery(database, name, query string) error {
+	return c.retryUntilExec(proto2.Command_CreateContinuousQueryCommand, proto2.E_CreateContinuousQueryCommand_Command,
+		&proto2.CreateContinuousQueryCommand{
+			Database: proto.String(database),
+			Name:     proto.String(name),
+			Query:    proto.String(query),
+		},
+	)
+}
+
+// DropContinuousQuery removes the continuous query from the given database.
+func (c *Client) DropContinuousQuery(database, name string) error {
+	return c.retryUntilExec(proto2.Command_DropContinuousQueryCommand, proto2.E_DropContinuousQueryCommand_Command,
+		&proto2.DropContinuousQueryCommand{
+			Database: proto.String(database),
+			Name:     proto.String(name),
+		},
+	)
+}
+
+// AcquireContinuousQueryLease grants or renews the continuous query lease to owner for duration d.
+// The expiration is decided with the clock of the meta leader. A lease held by owner is only
+// renewed in raft once half of d has elapsed locally since it was last granted.
+func (c *Client) AcquireContinuousQueryLease(owner string, d time.Duration) error {
+	start := time.Now()
+	c.mu.RLock()
+	lease := c.cacheData.CQLease
+	renewAt := c.cqLeaseRenewAt
+	c.mu.RUnlock()
+
+	if lease.Owner == owner && start.Before(renewAt) {
+		return nil
+	}
+	if lease.Owner != owner && !lease.Expired(start.UTC()) {
+		return meta2.ErrContinuousQueryLeaseConflict
+	}
+
+	err := c.retryUntilExec(proto2.Command_ContinuousQueryLeaseCommand, proto2.E_ContinuousQueryLeaseCommand_Command,
+		&proto2.ContinuousQueryLeaseCommand{
+			Owner:    proto.String(owner),
+			Time:     proto.Int64(start.UnixNano()), // replaced by the meta leader
+			Duration: proto.Int64(int64(d)),
+		},
+	)
+
+	c.mu.Lock()
+	if err == nil {
+		// the leader granted the lease no earlier than start, so half of d is left at least until then
+		c.cqLeaseRenewAt = start.Add(d / 2)
+	} else {
+		c.cqLeaseRenewAt = time.Time{}
+	}
+	c.mu.Unlock()
+	return err
+}
+
+// ReportContinuousQueries persists the last run time of continuous queries executed by the lease owner.
+func (c *Client) ReportContinuousQueries(owner string, reports []*proto2.ContinuousQueryReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+	return c.retryUntilExec(proto2.Command_ContinuousQueryReportCommand, proto2.E_ContinuousQueryReportCommand_Command,
+		&proto2.ContinuousQueryReportCommand{
+			Owner:   proto.String(owner),
+			Reports: reports,
+		},
+	)
+}
+
+// CreateDownSamplePolicy attaches the down sample policy to the retention policy.
+func (c *Client) CreateDownSamplePolicy(database, name string, policy *meta2.DownSamplePolicyInfo) error {
+	return c.retryUntilExec(proto2.Command_CreateDownSamplePolicyCommand, proto2.E_CreateDownSamplePolicyCommand_Command,
+		&proto2.CreateDownSamplePolicyCommand{
+			Database:        proto.String(database),
+			RetentionPolicy: proto.String(name),
+			Policy:          policy.Marshal(),
+		},
+	)
+}
+
+// DropDownSamplePolicy removes the down sample policy of the retention policy.
+func (c *Client) DropDownSamplePolicy(database, name string) error {
+	return c.retryUntilExec(proto2.Command_DropDownSamplePolicyCommand, proto2.E_DropDownSamplePolicyCommand_Command,
+		&proto2.DropDownSamplePolicyCommand{
+			Database:        proto.String(database),
+			RetentionPolicy: proto.String(name),
+		},
+	)
+}
+
 // SetData overwrites the underlying data in the meta store.
 func (c *Client) SetData(data *meta2.Data) error {
 	return c.retryUntilExec(proto2.Command_SetDataCommand, proto2.E_SetDataCommand_Command,
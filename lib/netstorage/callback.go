@@ -86,9 +86,12 @@ func (c *WritePointsCallback) GetCodec() transport.Codec {
 }
 
 func (c *WritePointsCallback) Error() error {
-	if c.data.Code == 0 {
+	switch c.data.Code {
+	case WritePointsSuccess:
 		return nil
+	case WritePointsPartial:
+		return PartialWriteError{Reason: errors.New(c.data.Message), Dropped: int(c.data.Dropped)}
+	default:
+		return errors.New(c.data.Message)
 	}
-
-	return errors.New(c.data.Message)
 }
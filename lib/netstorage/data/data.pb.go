@@ -806,6 +806,578 @@ func (m *ExactCardinalityResponse) GetErr() string {
 	return ""
 }
 
+type DropSeriesResponse struct {
+	Count                *int64   `protobuf:"varint,1,opt,name=Count" json:"Count,omitempty"`
+	Err                  *string  `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropSeriesResponse) Reset()         { *m = DropSeriesResponse{} }
+func (m *DropSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*DropSeriesResponse) ProtoMessage()    {}
+func (*DropSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{15}
+}
+func (m *DropSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropSeriesResponse.Unmarshal(m, b)
+}
+func (m *DropSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropSeriesResponse.Marshal(b, m, deterministic)
+}
+func (m *DropSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropSeriesResponse.Merge(m, src)
+}
+func (m *DropSeriesResponse) XXX_Size() int {
+	return xxx_messageInfo_DropSeriesResponse.Size(m)
+}
+func (m *DropSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropSeriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropSeriesResponse proto.InternalMessageInfo
+
+func (m *DropSeriesResponse) GetCount() int64 {
+	if m != nil && m.Count != nil {
+		return *m.Count
+	}
+	return 0
+}
+
+func (m *DropSeriesResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
+type ReadShardWalRequest struct {
+	DB                   *string  `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	PtID                 *uint32  `protobuf:"varint,2,req,name=PtID" json:"PtID,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,3,req,name=ShardID" json:"ShardID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadShardWalRequest) Reset()         { *m = ReadShardWalRequest{} }
+func (m *ReadShardWalRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadShardWalRequest) ProtoMessage()    {}
+func (*ReadShardWalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{16}
+}
+func (m *ReadShardWalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadShardWalRequest.Unmarshal(m, b)
+}
+func (m *ReadShardWalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadShardWalRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadShardWalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadShardWalRequest.Merge(m, src)
+}
+func (m *ReadShardWalRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadShardWalRequest.Size(m)
+}
+func (m *ReadShardWalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadShardWalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadShardWalRequest proto.InternalMessageInfo
+
+func (m *ReadShardWalRequest) GetDB() string {
+	if m != nil && m.DB != nil {
+		return *m.DB
+	}
+	return ""
+}
+
+func (m *ReadShardWalRequest) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *ReadShardWalRequest) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+type ReadShardWalResponse struct {
+	Records              [][]byte `protobuf:"bytes,1,rep,name=Records" json:"Records,omitempty"`
+	Err                  *string  `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadShardWalResponse) Reset()         { *m = ReadShardWalResponse{} }
+func (m *ReadShardWalResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadShardWalResponse) ProtoMessage()    {}
+func (*ReadShardWalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{17}
+}
+func (m *ReadShardWalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadShardWalResponse.Unmarshal(m, b)
+}
+func (m *ReadShardWalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadShardWalResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadShardWalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadShardWalResponse.Merge(m, src)
+}
+func (m *ReadShardWalResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadShardWalResponse.Size(m)
+}
+func (m *ReadShardWalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadShardWalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadShardWalResponse proto.InternalMessageInfo
+
+func (m *ReadShardWalResponse) GetRecords() [][]byte {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReadShardWalResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
+type ShowQueriesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowQueriesRequest) Reset()         { *m = ShowQueriesRequest{} }
+func (m *ShowQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowQueriesRequest) ProtoMessage()    {}
+func (*ShowQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{18}
+}
+func (m *ShowQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowQueriesRequest.Unmarshal(m, b)
+}
+func (m *ShowQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowQueriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowQueriesRequest.Merge(m, src)
+}
+func (m *ShowQueriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowQueriesRequest.Size(m)
+}
+func (m *ShowQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowQueriesRequest proto.InternalMessageInfo
+
+type QueryPipeline struct {
+	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
+	QueryID              *uint64  `protobuf:"varint,2,req,name=QueryID" json:"QueryID,omitempty"`
+	Query                *string  `protobuf:"bytes,3,req,name=Query" json:"Query,omitempty"`
+	Database             *string  `protobuf:"bytes,4,req,name=Database" json:"Database,omitempty"`
+	PtID                 *uint32  `protobuf:"varint,5,req,name=PtID" json:"PtID,omitempty"`
+	Shards               *int64   `protobuf:"varint,6,req,name=Shards" json:"Shards,omitempty"`
+	Chunks               *int64   `protobuf:"varint,7,req,name=Chunks" json:"Chunks,omitempty"`
+	Duration             *int64   `protobuf:"varint,8,req,name=Duration" json:"Duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPipeline) Reset()         { *m = QueryPipeline{} }
+func (m *QueryPipeline) String() string { return proto.CompactTextString(m) }
+func (*QueryPipeline) ProtoMessage()    {}
+func (*QueryPipeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{19}
+}
+func (m *QueryPipeline) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPipeline.Unmarshal(m, b)
+}
+func (m *QueryPipeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPipeline.Marshal(b, m, deterministic)
+}
+func (m *QueryPipeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPipeline.Merge(m, src)
+}
+func (m *QueryPipeline) XXX_Size() int {
+	return xxx_messageInfo_QueryPipeline.Size(m)
+}
+func (m *QueryPipeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPipeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPipeline proto.InternalMessageInfo
+
+func (m *QueryPipeline) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
+}
+
+func (m *QueryPipeline) GetQueryID() uint64 {
+	if m != nil && m.QueryID != nil {
+		return *m.QueryID
+	}
+	return 0
+}
+
+func (m *QueryPipeline) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
+	}
+	return ""
+}
+
+func (m *QueryPipeline) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *QueryPipeline) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *QueryPipeline) GetShards() int64 {
+	if m != nil && m.Shards != nil {
+		return *m.Shards
+	}
+	return 0
+}
+
+func (m *QueryPipeline) GetChunks() int64 {
+	if m != nil && m.Chunks != nil {
+		return *m.Chunks
+	}
+	return 0
+}
+
+func (m *QueryPipeline) GetDuration() int64 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return 0
+}
+
+type ShowQueriesResponse struct {
+	Pipelines            []*QueryPipeline `protobuf:"bytes,1,rep,name=Pipelines" json:"Pipelines,omitempty"`
+	Err                  *string          `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ShowQueriesResponse) Reset()         { *m = ShowQueriesResponse{} }
+func (m *ShowQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowQueriesResponse) ProtoMessage()    {}
+func (*ShowQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{20}
+}
+func (m *ShowQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowQueriesResponse.Unmarshal(m, b)
+}
+func (m *ShowQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowQueriesResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowQueriesResponse.Merge(m, src)
+}
+func (m *ShowQueriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowQueriesResponse.Size(m)
+}
+func (m *ShowQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowQueriesResponse proto.InternalMessageInfo
+
+func (m *ShowQueriesResponse) GetPipelines() []*QueryPipeline {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *ShowQueriesResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
+type KillQueryRequest struct {
+	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
+	QueryID              *uint64  `protobuf:"varint,2,req,name=QueryID" json:"QueryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KillQueryRequest) Reset()         { *m = KillQueryRequest{} }
+func (m *KillQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*KillQueryRequest) ProtoMessage()    {}
+func (*KillQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{21}
+}
+func (m *KillQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KillQueryRequest.Unmarshal(m, b)
+}
+func (m *KillQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KillQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *KillQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KillQueryRequest.Merge(m, src)
+}
+func (m *KillQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_KillQueryRequest.Size(m)
+}
+func (m *KillQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KillQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KillQueryRequest proto.InternalMessageInfo
+
+func (m *KillQueryRequest) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
+}
+
+func (m *KillQueryRequest) GetQueryID() uint64 {
+	if m != nil && m.QueryID != nil {
+		return *m.QueryID
+	}
+	return 0
+}
+
+type KillQueryResponse struct {
+	Killed               *int64   `protobuf:"varint,1,req,name=Killed" json:"Killed,omitempty"`
+	Err                  *string  `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KillQueryResponse) Reset()         { *m = KillQueryResponse{} }
+func (m *KillQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*KillQueryResponse) ProtoMessage()    {}
+func (*KillQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{22}
+}
+func (m *KillQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KillQueryResponse.Unmarshal(m, b)
+}
+func (m *KillQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KillQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *KillQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KillQueryResponse.Merge(m, src)
+}
+func (m *KillQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_KillQueryResponse.Size(m)
+}
+func (m *KillQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KillQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KillQueryResponse proto.InternalMessageInfo
+
+func (m *KillQueryResponse) GetKilled() int64 {
+	if m != nil && m.Killed != nil {
+		return *m.Killed
+	}
+	return 0
+}
+
+func (m *KillQueryResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
+type ReadShardRowsRequest struct {
+	DB                   *string  `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	PtID                 *uint32  `protobuf:"varint,2,req,name=PtID" json:"PtID,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,3,req,name=ShardID" json:"ShardID,omitempty"`
+	Measurement          *string  `protobuf:"bytes,4,opt,name=Measurement" json:"Measurement,omitempty"`
+	OutOfOrder           *bool    `protobuf:"varint,5,opt,name=OutOfOrder" json:"OutOfOrder,omitempty"`
+	SeriesID             *uint64  `protobuf:"varint,6,opt,name=SeriesID" json:"SeriesID,omitempty"`
+	Limit                *int64   `protobuf:"varint,7,opt,name=Limit" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadShardRowsRequest) Reset()         { *m = ReadShardRowsRequest{} }
+func (m *ReadShardRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadShardRowsRequest) ProtoMessage()    {}
+func (*ReadShardRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{23}
+}
+func (m *ReadShardRowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadShardRowsRequest.Unmarshal(m, b)
+}
+func (m *ReadShardRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadShardRowsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadShardRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadShardRowsRequest.Merge(m, src)
+}
+func (m *ReadShardRowsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadShardRowsRequest.Size(m)
+}
+func (m *ReadShardRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadShardRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadShardRowsRequest proto.InternalMessageInfo
+
+func (m *ReadShardRowsRequest) GetDB() string {
+	if m != nil && m.DB != nil {
+		return *m.DB
+	}
+	return ""
+}
+
+func (m *ReadShardRowsRequest) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *ReadShardRowsRequest) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+func (m *ReadShardRowsRequest) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *ReadShardRowsRequest) GetOutOfOrder() bool {
+	if m != nil && m.OutOfOrder != nil {
+		return *m.OutOfOrder
+	}
+	return false
+}
+
+func (m *ReadShardRowsRequest) GetSeriesID() uint64 {
+	if m != nil && m.SeriesID != nil {
+		return *m.SeriesID
+	}
+	return 0
+}
+
+func (m *ReadShardRowsRequest) GetLimit() int64 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
+type ReadShardRowsResponse struct {
+	Rows                 []byte   `protobuf:"bytes,1,opt,name=Rows" json:"Rows,omitempty"`
+	Measurement          *string  `protobuf:"bytes,2,opt,name=Measurement" json:"Measurement,omitempty"`
+	OutOfOrder           *bool    `protobuf:"varint,3,opt,name=OutOfOrder" json:"OutOfOrder,omitempty"`
+	SeriesID             *uint64  `protobuf:"varint,4,opt,name=SeriesID" json:"SeriesID,omitempty"`
+	Done                 *bool    `protobuf:"varint,5,opt,name=Done" json:"Done,omitempty"`
+	Err                  *string  `protobuf:"bytes,6,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadShardRowsResponse) Reset()         { *m = ReadShardRowsResponse{} }
+func (m *ReadShardRowsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadShardRowsResponse) ProtoMessage()    {}
+func (*ReadShardRowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{24}
+}
+func (m *ReadShardRowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadShardRowsResponse.Unmarshal(m, b)
+}
+func (m *ReadShardRowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadShardRowsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadShardRowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadShardRowsResponse.Merge(m, src)
+}
+func (m *ReadShardRowsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadShardRowsResponse.Size(m)
+}
+func (m *ReadShardRowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadShardRowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadShardRowsResponse proto.InternalMessageInfo
+
+func (m *ReadShardRowsResponse) GetRows() []byte {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *ReadShardRowsResponse) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *ReadShardRowsResponse) GetOutOfOrder() bool {
+	if m != nil && m.OutOfOrder != nil {
+		return *m.OutOfOrder
+	}
+	return false
+}
+
+func (m *ReadShardRowsResponse) GetSeriesID() uint64 {
+	if m != nil && m.SeriesID != nil {
+		return *m.SeriesID
+	}
+	return 0
+}
+
+func (m *ReadShardRowsResponse) GetDone() bool {
+	if m != nil && m.Done != nil {
+		return *m.Done
+	}
+	return false
+}
+
+func (m *ReadShardRowsResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SeriesKeysRequest)(nil), "internal.SeriesKeysRequest")
 	proto.RegisterType((*SeriesKeysResponse)(nil), "internal.SeriesKeysResponse")
@@ -825,53 +1397,82 @@ func init() {
 	proto.RegisterType((*TagValuesSlice)(nil), "internal.TagValuesSlice")
 	proto.RegisterType((*ExactCardinalityResponse)(nil), "internal.ExactCardinalityResponse")
 	proto.RegisterMapType((map[string]uint64)(nil), "internal.ExactCardinalityResponse.CardinalityEntry")
+	proto.RegisterType((*DropSeriesResponse)(nil), "internal.DropSeriesResponse")
+	proto.RegisterType((*ReadShardWalRequest)(nil), "internal.ReadShardWalRequest")
+	proto.RegisterType((*ReadShardWalResponse)(nil), "internal.ReadShardWalResponse")
+	proto.RegisterType((*ShowQueriesRequest)(nil), "internal.ShowQueriesRequest")
+	proto.RegisterType((*QueryPipeline)(nil), "internal.QueryPipeline")
+	proto.RegisterType((*ShowQueriesResponse)(nil), "internal.ShowQueriesResponse")
+	proto.RegisterType((*KillQueryRequest)(nil), "internal.KillQueryRequest")
+	proto.RegisterType((*KillQueryResponse)(nil), "internal.KillQueryResponse")
+	proto.RegisterType((*ReadShardRowsRequest)(nil), "internal.ReadShardRowsRequest")
+	proto.RegisterType((*ReadShardRowsResponse)(nil), "internal.ReadShardRowsResponse")
 }
 
 func init() { proto.RegisterFile("data.proto", fileDescriptor_871986018790d2fd) }
 
 var fileDescriptor_871986018790d2fd = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x6e, 0xd3, 0x3e,
-	0x14, 0x56, 0x9c, 0xb6, 0x5b, 0x4f, 0xb7, 0xfe, 0xf6, 0xb3, 0xb6, 0xc9, 0x2a, 0x08, 0x45, 0x41,
-	0x48, 0x11, 0x17, 0x11, 0x1a, 0x37, 0x1b, 0x88, 0x5d, 0xb4, 0x9d, 0xa6, 0x09, 0x55, 0x1a, 0xee,
-	0xe0, 0x02, 0x24, 0x24, 0x6f, 0xb1, 0xb6, 0x68, 0x59, 0x12, 0x6c, 0x17, 0x16, 0xf1, 0x06, 0xdc,
-	0x71, 0xc5, 0x15, 0x4f, 0xc3, 0x8b, 0xa1, 0xd8, 0x4e, 0x93, 0x96, 0x55, 0x68, 0xdc, 0xf9, 0x7c,
-	0x3e, 0x7f, 0x3e, 0x9f, 0xf3, 0x1d, 0x03, 0x44, 0x4c, 0xb1, 0x30, 0x17, 0x99, 0xca, 0xf0, 0x7a,
-	0x9c, 0x2a, 0x2e, 0x52, 0x96, 0xf8, 0x5f, 0xe1, 0xff, 0x29, 0x17, 0x31, 0x97, 0xaf, 0x79, 0x21,
-	0x29, 0xff, 0x34, 0xe3, 0x52, 0xe1, 0x3e, 0xa0, 0xf1, 0x39, 0x71, 0x3c, 0x14, 0x74, 0x29, 0x1a,
-	0x9f, 0xe3, 0x6d, 0x68, 0x9f, 0xaa, 0x93, 0xb1, 0x24, 0xc8, 0x73, 0x83, 0x4d, 0x6a, 0x0c, 0xec,
-	0xc3, 0xc6, 0x84, 0x33, 0x39, 0x13, 0xfc, 0x86, 0xa7, 0x4a, 0x12, 0xd7, 0x73, 0x83, 0x2e, 0x5d,
-	0xc0, 0xf0, 0x43, 0xe8, 0x5e, 0x64, 0x69, 0x14, 0xab, 0x38, 0x4b, 0x49, 0xcb, 0x73, 0x82, 0x2e,
-	0xad, 0x01, 0xff, 0x10, 0x70, 0xb3, 0xb8, 0xcc, 0xb3, 0x54, 0x72, 0xbc, 0x0b, 0x1d, 0x83, 0x12,
-	0x47, 0x67, 0xb4, 0x16, 0xde, 0x02, 0xf7, 0x48, 0x08, 0x82, 0x74, 0x96, 0xf2, 0xe8, 0x1f, 0xc3,
-	0xce, 0x48, 0x70, 0xa6, 0xf8, 0x98, 0x29, 0x36, 0x64, 0x92, 0xaf, 0x7a, 0x40, 0x1f, 0x50, 0xae,
-	0x08, 0xf2, 0x50, 0xb0, 0x49, 0x51, 0xae, 0xef, 0x45, 0x4e, 0x5c, 0x73, 0x2f, 0x72, 0xff, 0x29,
-	0xec, 0x2e, 0x27, 0xb2, 0x64, 0x6c, 0x51, 0xa7, 0x2e, 0xfa, 0xc3, 0x81, 0xfe, 0xb4, 0x90, 0x23,
-	0x25, 0x92, 0xaa, 0xdc, 0x16, 0xb8, 0x93, 0x2c, 0xb2, 0xf5, 0xca, 0x23, 0x3e, 0x80, 0xf6, 0x29,
-	0x13, 0xec, 0x46, 0x77, 0xac, 0xb7, 0xf7, 0x38, 0xac, 0x1a, 0x1e, 0x2e, 0x86, 0x86, 0xda, 0xeb,
-	0x28, 0x55, 0xa2, 0xa0, 0x26, 0x62, 0xb0, 0x0f, 0x50, 0x83, 0x65, 0xea, 0x6b, 0x5e, 0x54, 0xf5,
-	0xaf, 0x79, 0x51, 0x0e, 0xe3, 0x33, 0x4b, 0x66, 0xdc, 0x36, 0xc2, 0x18, 0x2f, 0xd0, 0xbe, 0xe3,
-	0xff, 0x74, 0xe0, 0xbf, 0x79, 0xfa, 0x65, 0xfe, 0xc8, 0xf2, 0xc7, 0xaf, 0xa0, 0x43, 0xb9, 0x9c,
-	0x25, 0xca, 0x72, 0x7b, 0x72, 0x07, 0x37, 0x13, 0x1c, 0x1a, 0x3f, 0xc3, 0xce, 0x06, 0x0d, 0x0e,
-	0xa0, 0xd7, 0x80, 0xef, 0xc5, 0x2f, 0x87, 0xc1, 0x31, 0x57, 0xd3, 0x2b, 0x26, 0xa2, 0x69, 0x9e,
-	0xc4, 0xea, 0x34, 0x8b, 0x53, 0xb5, 0x20, 0xba, 0xe1, 0x7c, 0x66, 0x43, 0x8c, 0xa1, 0x55, 0xea,
-	0xcc, 0x4e, 0x4d, 0x9f, 0x31, 0x81, 0x35, 0x1d, 0x7e, 0x32, 0xd6, 0xc3, 0x6b, 0xd1, 0xca, 0x2c,
-	0xab, 0x9e, 0x44, 0xb7, 0x5c, 0x92, 0x96, 0xe7, 0x06, 0x2e, 0x35, 0x86, 0xff, 0x06, 0x1e, 0xdc,
-	0x59, 0xd1, 0x36, 0xc7, 0x83, 0x5e, 0x03, 0xb6, 0x72, 0x6b, 0x42, 0x77, 0x68, 0xee, 0xbb, 0x03,
-	0x9b, 0x63, 0x9e, 0x70, 0xc5, 0x57, 0x11, 0xef, 0x03, 0xa2, 0xb9, 0x0d, 0x41, 0x34, 0xd7, 0xea,
-	0x90, 0x8a, 0xb8, 0x26, 0xc7, 0x44, 0x2a, 0x3c, 0x80, 0x75, 0xcb, 0xdb, 0xf0, 0x6d, 0xd1, 0xb9,
-	0x8d, 0x1f, 0x01, 0x98, 0xf4, 0x67, 0x45, 0xce, 0x49, 0xdb, 0x43, 0x41, 0x9b, 0x36, 0x10, 0xdb,
-	0x96, 0x88, 0x74, 0x3c, 0xc7, 0xb6, 0x25, 0xf2, 0x7d, 0xe8, 0x57, 0x94, 0x56, 0xca, 0xf6, 0x9b,
-	0x03, 0xdb, 0xd3, 0xab, 0xec, 0xcb, 0x19, 0xbb, 0x7c, 0x57, 0x4e, 0xe4, 0x9e, 0xcb, 0x1e, 0xc2,
-	0xda, 0x19, 0xbb, 0x2c, 0xf7, 0x54, 0xef, 0x79, 0x6f, 0x6f, 0xbb, 0x96, 0xcd, 0x84, 0xe5, 0xf6,
-	0x8e, 0x56, 0x4e, 0xe5, 0xe2, 0x8f, 0x96, 0x17, 0x7f, 0x0e, 0xf8, 0x1f, 0x60, 0x67, 0x89, 0xcb,
-	0x2a, 0xde, 0xf8, 0x19, 0x74, 0x8c, 0x8f, 0x95, 0x2b, 0xa9, 0xeb, 0xce, 0xc3, 0xa7, 0x49, 0x7c,
-	0xc1, 0xa9, 0xf5, 0xf3, 0x87, 0x00, 0x35, 0xa3, 0x72, 0xc6, 0x8d, 0x1f, 0xc9, 0xbe, 0xb3, 0x09,
-	0x95, 0x1d, 0xd5, 0xef, 0x42, 0x7a, 0xfc, 0xfa, 0xec, 0x7f, 0x84, 0xfe, 0x62, 0xf6, 0x7f, 0xcb,
-	0x53, 0xfe, 0x65, 0x96, 0xbd, 0xf9, 0x1d, 0x2b, 0x8e, 0xbf, 0x1c, 0x20, 0x47, 0xb7, 0xec, 0x42,
-	0x8d, 0x98, 0x88, 0xe2, 0x94, 0x25, 0xb1, 0x2a, 0xe6, 0x4d, 0x78, 0x0b, 0xbd, 0x06, 0xac, 0x65,
-	0xd9, 0xdb, 0x7b, 0x5e, 0xbf, 0x7b, 0x55, 0x60, 0xd8, 0xc0, 0xcc, 0xd2, 0x36, 0xf3, 0xfc, 0xa9,
-	0xe5, 0xc1, 0x21, 0x6c, 0x2d, 0x87, 0xfc, 0x6d, 0xa1, 0x5b, 0x8d, 0x85, 0x1e, 0x6e, 0xbc, 0x87,
-	0xf0, 0x65, 0x45, 0xeb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x3a, 0xc2, 0x01, 0x61, 0x06,
-	0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xfe, 0xd8, 0x8e, 0x8f, 0x13, 0x93, 0x4e, 0x9d, 0x32, 0x32, 0x08, 0xad, 0x16, 0x21,
+	0x59, 0x5c, 0x58, 0x28, 0x08, 0xa9, 0x05, 0x5a, 0x21, 0xdb, 0x51, 0x89, 0x4a, 0x94, 0x74, 0x1c,
+	0x40, 0x02, 0xa9, 0xd2, 0x24, 0x3b, 0x34, 0xab, 0x6c, 0x76, 0x97, 0x99, 0x59, 0x5a, 0x8b, 0x37,
+	0xe0, 0x8e, 0x2b, 0xae, 0x78, 0x06, 0x1e, 0x82, 0x1b, 0xee, 0x78, 0x25, 0x34, 0x3f, 0xfb, 0xe3,
+	0xc5, 0xa6, 0x0a, 0xca, 0xdd, 0x9c, 0xb3, 0x33, 0xe7, 0x7c, 0xe7, 0x9b, 0xef, 0x9c, 0x59, 0x80,
+	0x88, 0x4a, 0x3a, 0xcd, 0x79, 0x26, 0x33, 0xb4, 0x13, 0xa7, 0x92, 0xf1, 0x94, 0x26, 0xe1, 0xcf,
+	0x70, 0x6f, 0xc9, 0x78, 0xcc, 0xc4, 0x33, 0xb6, 0x12, 0x84, 0xfd, 0x58, 0x30, 0x21, 0xd1, 0x10,
+	0xdc, 0xc5, 0x05, 0x76, 0x02, 0x77, 0xd2, 0x27, 0xee, 0xe2, 0x02, 0x8d, 0xa0, 0x73, 0x26, 0x8f,
+	0x17, 0x02, 0xbb, 0x81, 0x37, 0xd9, 0x23, 0xc6, 0x40, 0x21, 0xec, 0x9e, 0x30, 0x2a, 0x0a, 0xce,
+	0x6e, 0x58, 0x2a, 0x05, 0xf6, 0x02, 0x6f, 0xd2, 0x27, 0x6b, 0x3e, 0xf4, 0x2e, 0xf4, 0x2f, 0xb3,
+	0x34, 0x8a, 0x65, 0x9c, 0xa5, 0xd8, 0x0f, 0x9c, 0x49, 0x9f, 0xd4, 0x8e, 0xf0, 0x09, 0xa0, 0x66,
+	0x72, 0x91, 0x67, 0xa9, 0x60, 0xe8, 0x01, 0x74, 0x8d, 0x17, 0x3b, 0x3a, 0xa2, 0xb5, 0xd0, 0x3e,
+	0x78, 0x47, 0x9c, 0x63, 0x57, 0x47, 0x51, 0xcb, 0xf0, 0x29, 0x1c, 0xcc, 0x39, 0xa3, 0x92, 0x2d,
+	0xa8, 0xa4, 0x33, 0x2a, 0xd8, 0xb6, 0x02, 0x86, 0xe0, 0xe6, 0x12, 0xbb, 0x81, 0x3b, 0xd9, 0x23,
+	0x6e, 0xae, 0xbf, 0xf3, 0x1c, 0x7b, 0xe6, 0x3b, 0xcf, 0xc3, 0x0f, 0xe1, 0x41, 0x3b, 0x90, 0x05,
+	0x63, 0x93, 0x3a, 0x75, 0xd2, 0xdf, 0x1c, 0x18, 0x2e, 0x57, 0x62, 0x2e, 0x79, 0x52, 0xa6, 0xdb,
+	0x07, 0xef, 0x24, 0x8b, 0x6c, 0x3e, 0xb5, 0x44, 0x8f, 0xa0, 0x73, 0x46, 0x39, 0xbd, 0xd1, 0x8c,
+	0x0d, 0x0e, 0xdf, 0x9f, 0x96, 0x84, 0x4f, 0xd7, 0x8f, 0x4e, 0xf5, 0xae, 0xa3, 0x54, 0xf2, 0x15,
+	0x31, 0x27, 0xc6, 0x0f, 0x01, 0x6a, 0xa7, 0x0a, 0x7d, 0xcd, 0x56, 0x65, 0xfe, 0x6b, 0xb6, 0x52,
+	0x97, 0xf1, 0x13, 0x4d, 0x0a, 0x66, 0x89, 0x30, 0xc6, 0xa7, 0xee, 0x43, 0x27, 0xfc, 0xdd, 0x81,
+	0xb7, 0xaa, 0xf0, 0x6d, 0xfc, 0xae, 0xc5, 0x8f, 0x1e, 0x43, 0x97, 0x30, 0x51, 0x24, 0xd2, 0x62,
+	0xfb, 0x60, 0x03, 0x36, 0x73, 0x78, 0x6a, 0xf6, 0x19, 0x74, 0xf6, 0xd0, 0xf8, 0x11, 0x0c, 0x1a,
+	0xee, 0x5b, 0xe1, 0xcb, 0x61, 0xfc, 0x94, 0xc9, 0xe5, 0x15, 0xe5, 0xd1, 0x32, 0x4f, 0x62, 0x79,
+	0x96, 0xc5, 0xa9, 0x5c, 0x13, 0xdd, 0xac, 0xba, 0xb3, 0x19, 0x42, 0xe0, 0x2b, 0x9d, 0xd9, 0x5b,
+	0xd3, 0x6b, 0x84, 0xa1, 0xa7, 0x8f, 0x1f, 0x2f, 0xf4, 0xe5, 0xf9, 0xa4, 0x34, 0x55, 0xd6, 0xe3,
+	0xe8, 0x35, 0x13, 0xd8, 0x0f, 0xbc, 0x89, 0x47, 0x8c, 0x11, 0x3e, 0x87, 0x77, 0x36, 0x66, 0xb4,
+	0xe4, 0x04, 0x30, 0x68, 0xb8, 0xad, 0xdc, 0x9a, 0xae, 0x0d, 0x9a, 0xfb, 0xd5, 0x81, 0xbd, 0x05,
+	0x4b, 0x98, 0x64, 0xdb, 0x80, 0x0f, 0xc1, 0x25, 0xb9, 0x3d, 0xe2, 0x92, 0x5c, 0xab, 0x43, 0x48,
+	0xec, 0x99, 0x18, 0x27, 0x42, 0xa2, 0x31, 0xec, 0x58, 0xdc, 0x06, 0xaf, 0x4f, 0x2a, 0x1b, 0xbd,
+	0x07, 0x60, 0xc2, 0x9f, 0xaf, 0x72, 0x86, 0x3b, 0x81, 0x3b, 0xe9, 0x90, 0x86, 0xc7, 0xd2, 0x12,
+	0xe1, 0x6e, 0xe0, 0x58, 0x5a, 0xa2, 0x30, 0x84, 0x61, 0x09, 0x69, 0xab, 0x6c, 0x7f, 0x71, 0x60,
+	0xb4, 0xbc, 0xca, 0x5e, 0x9d, 0xd3, 0x97, 0xdf, 0xa8, 0x1b, 0xb9, 0x65, 0xb3, 0x4f, 0xa1, 0x77,
+	0x4e, 0x5f, 0xaa, 0x3e, 0xd5, 0x7d, 0x3e, 0x38, 0x1c, 0xd5, 0xb2, 0x39, 0xa1, 0xb9, 0xfd, 0x46,
+	0xca, 0x4d, 0xaa, 0xf1, 0xe7, 0xed, 0xc6, 0xaf, 0x1c, 0xe1, 0xf7, 0x70, 0xd0, 0xc2, 0xb2, 0x0d,
+	0x37, 0xfa, 0x08, 0xba, 0x66, 0x8f, 0x95, 0x2b, 0xae, 0xf3, 0x56, 0xc7, 0x97, 0x49, 0x7c, 0xc9,
+	0x88, 0xdd, 0x17, 0xce, 0x00, 0x6a, 0x44, 0xea, 0x8e, 0x1b, 0x13, 0xc9, 0xd6, 0xd9, 0x74, 0x29,
+	0x46, 0x75, 0x5d, 0xae, 0xbe, 0x7e, 0xbd, 0x0e, 0x5f, 0xc0, 0x70, 0x3d, 0xfa, 0xff, 0x8b, 0xa3,
+	0x66, 0x99, 0x45, 0x6f, 0xa6, 0x63, 0x89, 0xf1, 0x4f, 0x07, 0xf0, 0xd1, 0x6b, 0x7a, 0x29, 0xe7,
+	0x94, 0x47, 0x71, 0x4a, 0x93, 0x58, 0xae, 0x2a, 0x12, 0xbe, 0x86, 0x41, 0xc3, 0xad, 0x65, 0x39,
+	0x38, 0xfc, 0xb8, 0xae, 0x7b, 0xdb, 0xc1, 0x69, 0xc3, 0x67, 0x9a, 0xb6, 0x19, 0xe7, 0xdf, 0x5a,
+	0x1e, 0x3f, 0x81, 0xfd, 0xf6, 0x91, 0x37, 0x35, 0xb4, 0xdf, 0x6c, 0xe8, 0xcf, 0x01, 0x2d, 0x78,
+	0x96, 0x9b, 0xf9, 0x5c, 0xc1, 0x1f, 0x41, 0x67, 0x9e, 0x15, 0x9a, 0x23, 0x47, 0xb5, 0xa2, 0x36,
+	0x36, 0x74, 0xd2, 0x12, 0xee, 0x13, 0x46, 0x23, 0xad, 0xfc, 0x6f, 0x69, 0x72, 0x27, 0x73, 0x20,
+	0x9c, 0xc1, 0x68, 0x3d, 0xa8, 0x05, 0x85, 0xa1, 0x47, 0xd8, 0x65, 0xc6, 0x23, 0xd3, 0xe6, 0xbb,
+	0xa4, 0x34, 0x37, 0x00, 0x1b, 0x01, 0x52, 0xea, 0x7c, 0x5e, 0xd8, 0xba, 0x34, 0xae, 0xf0, 0x6f,
+	0x07, 0xf6, 0x94, 0x6b, 0x75, 0x16, 0xe7, 0x2c, 0x89, 0x53, 0xdd, 0x8a, 0x5f, 0x66, 0xa2, 0xd4,
+	0x82, 0x5e, 0xab, 0x3c, 0x7a, 0x93, 0x05, 0xec, 0x93, 0xd2, 0x54, 0xb4, 0xe8, 0xa5, 0x7d, 0x76,
+	0x8c, 0xa1, 0x46, 0x81, 0x7a, 0x73, 0x2e, 0xa8, 0x60, 0xd8, 0xd7, 0x1f, 0x2a, 0xbb, 0xaa, 0xbc,
+	0xd3, 0xa8, 0x5c, 0x3d, 0x8e, 0xaa, 0x36, 0x81, 0xbb, 0x81, 0x3b, 0xf1, 0x88, 0xb5, 0x94, 0x7f,
+	0x7e, 0x55, 0xa4, 0xd7, 0x02, 0xf7, 0x8c, 0xdf, 0x58, 0x3a, 0x7e, 0xc1, 0xa9, 0x6e, 0xc3, 0x1d,
+	0xfd, 0xa5, 0xb2, 0xc3, 0x17, 0x70, 0x7f, 0xad, 0x4e, 0x4b, 0xd5, 0x27, 0xd0, 0x2f, 0x4b, 0x14,
+	0x56, 0x7c, 0x6f, 0xd7, 0xe2, 0x5b, 0xa3, 0x80, 0xd4, 0x3b, 0x37, 0xf0, 0xf8, 0x05, 0xec, 0x3f,
+	0x8b, 0x93, 0x44, 0x9f, 0x28, 0x6f, 0xf7, 0x56, 0x9c, 0x85, 0x8f, 0xe1, 0x5e, 0x23, 0x42, 0xfd,
+	0x7f, 0xa0, 0x9c, 0xcc, 0x3c, 0xb8, 0x1e, 0xb1, 0xd6, 0x06, 0x00, 0x7f, 0x39, 0x0d, 0x35, 0x90,
+	0xec, 0xd5, 0x1d, 0xbd, 0x35, 0xad, 0x51, 0x60, 0xa6, 0x5b, 0xd3, 0xa5, 0x86, 0xf8, 0x69, 0x21,
+	0x4f, 0x7f, 0x38, 0xe5, 0x11, 0xe3, 0xb8, 0x13, 0x38, 0x93, 0x1d, 0xd2, 0xf0, 0xe8, 0x07, 0x40,
+	0x93, 0x7e, 0xbc, 0xd0, 0x83, 0xdc, 0x27, 0x95, 0xad, 0x74, 0xf2, 0x55, 0x7c, 0x13, 0x4b, 0xdc,
+	0x33, 0xed, 0xa3, 0x8d, 0xf0, 0x0f, 0x07, 0x0e, 0x5a, 0xa5, 0x58, 0x3a, 0x10, 0xf8, 0xca, 0xd6,
+	0xdd, 0xb6, 0x4b, 0xf4, 0xba, 0x8d, 0xd0, 0x7d, 0x13, 0x42, 0xef, 0x3f, 0x11, 0xfa, 0x2d, 0x84,
+	0x08, 0xfc, 0x45, 0x96, 0x32, 0x5b, 0x97, 0x5e, 0x97, 0xe4, 0x77, 0x2b, 0xf2, 0x67, 0xbb, 0xdf,
+	0xc1, 0xf4, 0xb3, 0x52, 0x36, 0xff, 0x0c, 0x00, 0x9b, 0xfb, 0xad, 0xc6, 0x7e, 0x0a, 0x00, 0x00,
 }
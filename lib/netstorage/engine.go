@@ -29,6 +29,14 @@ import (
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 )
 
+// RowsCursor is the position of a paged read of the flushed rows of a shard, the series of Measurement in the order or
+// out of order files are read from SeriesID on.
+type RowsCursor struct {
+	Measurement string
+	OutOfOrder  bool
+	SeriesID    uint64
+}
+
 type NewEngineFun func(dataPath, walPath string, options EngineOptions, ctx *metaclient.LoadCtx) (Engine, error)
 
 var engines = make(map[string]NewEngineFun)
@@ -69,6 +77,8 @@ type Engine interface {
 	ExpiredIndexes() []*meta.IndexIdentifier
 
 	FetchShardsNeedChangeStore() ([]*meta.ShardIdentifier, []*meta.ShardIdentifier)
+	FetchShardsDownSampleInfo() []*meta.ShardDownSampleInfo
+	DownSampleShard(db string, ptId uint32, shardID uint64, level int, policy *meta.DownSamplePolicyInfo) error
 	ChangeShardTierToWarm(db string, ptId uint32, shardID uint64) error
 
 	CreateShard(db, rp string, ptId uint32, shardID uint64, timeRangeInfo *meta.ShardTimeRangeInfo) error
@@ -76,6 +86,8 @@ type Engine interface {
 	CreateDBPT(db string, pt uint32)
 
 	GetShardSplitPoints(db string, ptId uint32, shardID uint64, idxes []int64) ([]string, error)
+	ReadShardWal(db string, ptId uint32, shardID uint64) ([][]byte, error)
+	ReadShardRows(db string, ptId uint32, shardID uint64, cursor RowsCursor, limit int) ([]byte, RowsCursor, bool, error)
 
 	DeleteDatabase(db string, ptId uint32) error
 
@@ -58,6 +58,8 @@ type EngineOptions struct {
 	WalEnabled        bool
 	WalSyncInterval   time.Duration
 	WalReplayParallel bool
+	// WalReplaySalvage skips corrupt wal records instead of dropping the rest of the file
+	WalReplaySalvage bool
 
 	// Immutable config
 	ReadCacheLimit   int
@@ -65,6 +67,11 @@ type EngineOptions struct {
 	CacheMetaBlock   bool
 	EnableMmapRead   bool
 	CompactionMethod int // 0:auto, 1:stream, 2: non-stream
+
+	// Cardinality limits of the index of each pt of a database, 0 means unlimited
+	MaxSeriesPerDatabase    int
+	MaxSeriesPerMeasurement int
+	MaxValuesPerTag         int
 }
 
 func NewEngineOptions() EngineOptions {
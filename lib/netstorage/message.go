@@ -241,8 +241,17 @@ func (r *WritePointsRequest) Size() int {
 	return len(r.points)
 }
 
+// Codes of WritePointsResponse
+const (
+	WritePointsSuccess uint8 = iota
+	WritePointsFailed
+	// some points were dropped, the response holds their number
+	WritePointsPartial
+)
+
 type WritePointsResponse struct {
 	Code    uint8
+	Dropped uint32
 	Message string
 }
 
@@ -253,8 +262,19 @@ func NewWritePointsResponse(code uint8, message string) *WritePointsResponse {
 	}
 }
 
+func NewPartialWritePointsResponse(dropped int, message string) *WritePointsResponse {
+	return &WritePointsResponse{
+		Code:    WritePointsPartial,
+		Dropped: uint32(dropped),
+		Message: message,
+	}
+}
+
 func (r *WritePointsResponse) Marshal(buf []byte) ([]byte, error) {
 	buf = append(buf, r.Code)
+	if r.Code == WritePointsPartial {
+		buf = codec.AppendUint32(buf, r.Dropped)
+	}
 	buf = append(buf, r.Message...)
 	return buf, nil
 }
@@ -265,7 +285,15 @@ func (r *WritePointsResponse) Unmarshal(buf []byte) error {
 	}
 
 	r.Code = buf[0]
-	r.Message = string(buf[1:])
+	buf = buf[1:]
+	if r.Code == WritePointsPartial {
+		if len(buf) < codec.SizeOfUint32() {
+			return errno.NewError(errno.ShortBufferSize, len(buf), codec.SizeOfUint32())
+		}
+		r.Dropped = codec.NewBinaryDecoder(buf).Uint32()
+		buf = buf[codec.SizeOfUint32():]
+	}
+	r.Message = string(buf)
 	return nil
 }
 
@@ -274,5 +302,8 @@ func (r *WritePointsResponse) Instance() transport.Codec {
 }
 
 func (r *WritePointsResponse) Size() int {
+	if r.Code == WritePointsPartial {
+		return 1 + codec.SizeOfUint32() + len(r.Message)
+	}
 	return 1 + len(r.Message)
 }
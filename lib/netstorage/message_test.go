@@ -216,6 +216,19 @@ func TestWritePointsResponse(t *testing.T) {
 	assert.Equal(t, req, other.(*netstorage.WritePointsResponse))
 }
 
+func TestPartialWritePointsResponse(t *testing.T) {
+	req := netstorage.NewPartialWritePointsResponse(3, "max-values-per-tag limit exceeded")
+
+	other, ok := assertCodec(t, req, true, true)
+	if !ok {
+		return
+	}
+
+	assert.Equal(t, req, other.(*netstorage.WritePointsResponse))
+	assert.EqualError(t, other.Unmarshal([]byte{netstorage.WritePointsPartial, 0}),
+		errno.NewError(errno.ShortBufferSize, 1, 4).Error())
+}
+
 func TestInvalidDDLMessage(t *testing.T) {
 	msg := &netstorage.DDLMessage{}
 	err := msg.Unmarshal(nil)
@@ -48,8 +48,23 @@ const (
 	DeleteRequestMessage
 	DeleteResponseMessage
 
+	DropSeriesRequestMessage
+	DropSeriesResponseMessage
+
 	CreateDataBaseRequestMessage
 	CreateDatabaseResponseMessage
+
+	ReadShardWalRequestMessage
+	ReadShardWalResponseMessage
+
+	ShowQueriesRequestMessage
+	ShowQueriesResponseMessage
+
+	KillQueryRequestMessage
+	KillQueryResponseMessage
+
+	ReadShardRowsRequestMessage
+	ReadShardRowsResponseMessage
 )
 
 func NewMessage(typ uint8) codec.BinaryCodec {
@@ -82,10 +97,30 @@ func NewMessage(typ uint8) codec.BinaryCodec {
 		return &DeleteRequest{}
 	case DeleteResponseMessage:
 		return &DeleteResponse{}
+	case DropSeriesRequestMessage:
+		return &DropSeriesRequest{}
+	case DropSeriesResponseMessage:
+		return &DropSeriesResponse{}
 	case CreateDataBaseRequestMessage:
 		return &CreateDataBaseRequest{}
 	case CreateDatabaseResponseMessage:
 		return &CreateDataBaseResponse{}
+	case ReadShardWalRequestMessage:
+		return &ReadShardWalRequest{}
+	case ReadShardWalResponseMessage:
+		return &ReadShardWalResponse{}
+	case ShowQueriesRequestMessage:
+		return &ShowQueriesRequest{}
+	case ShowQueriesResponseMessage:
+		return &ShowQueriesResponse{}
+	case KillQueryRequestMessage:
+		return &KillQueryRequest{}
+	case KillQueryResponseMessage:
+		return &KillQueryResponse{}
+	case ReadShardRowsRequestMessage:
+		return &ReadShardRowsRequest{}
+	case ReadShardRowsResponseMessage:
+		return &ReadShardRowsResponse{}
 	default:
 		return nil
 	}
@@ -107,6 +142,16 @@ func GetResponseMessageType(typ uint8) uint8 {
 		return GetShardSplitPointsResponseMessage
 	case DeleteRequestMessage:
 		return DeleteResponseMessage
+	case DropSeriesRequestMessage:
+		return DropSeriesResponseMessage
+	case ReadShardWalRequestMessage:
+		return ReadShardWalResponseMessage
+	case ShowQueriesRequestMessage:
+		return ShowQueriesResponseMessage
+	case KillQueryRequestMessage:
+		return KillQueryResponseMessage
+	case ReadShardRowsRequestMessage:
+		return ReadShardRowsResponseMessage
 	default:
 		return UnknownMessage
 	}
@@ -39,6 +39,11 @@ func TestMessageTypes(t *testing.T) {
 		store.ShowTagValuesCardinalityRequestMessage: {&store.ShowTagValuesCardinalityRequest{}, &store.ShowTagValuesCardinalityResponse{}},
 		store.GetShardSplitPointsRequestMessage:      {&store.GetShardSplitPointsRequest{}, &store.GetShardSplitPointsResponse{}},
 		store.DeleteRequestMessage:                   {&store.DeleteRequest{}, &store.DeleteResponse{}},
+		store.DropSeriesRequestMessage:               {&store.DropSeriesRequest{}, &store.DropSeriesResponse{}},
+		store.ReadShardWalRequestMessage:             {&store.ReadShardWalRequest{}, &store.ReadShardWalResponse{}},
+		store.ShowQueriesRequestMessage:              {&store.ShowQueriesRequest{}, &store.ShowQueriesResponse{}},
+		store.KillQueryRequestMessage:                {&store.KillQueryRequest{}, &store.KillQueryResponse{}},
+		store.ReadShardRowsRequestMessage:            {&store.ReadShardRowsRequest{}, &store.ReadShardRowsResponse{}},
 	}
 
 	for typ, items := range data {
@@ -184,6 +184,29 @@ func (r *ExactCardinalityResponse) Error() error {
 	return fmt.Errorf("%s", *r.Err)
 }
 
+type DropSeriesRequest struct {
+	SeriesKeysRequest
+}
+
+type DropSeriesResponse struct {
+	internal2.DropSeriesResponse
+}
+
+func (r *DropSeriesResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.DropSeriesResponse)
+}
+
+func (r *DropSeriesResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.DropSeriesResponse)
+}
+
+func (r *DropSeriesResponse) Error() error {
+	if r.Err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *r.Err)
+}
+
 type ShowTagValuesRequest struct {
 	internal2.ShowTagValuesRequest
 }
@@ -366,3 +389,143 @@ func (r *CreateDataBaseResponse) Error() error {
 	}
 	return fmt.Errorf("%s", *r.Err)
 }
+
+type ReadShardWalRequest struct {
+	internal2.ReadShardWalRequest
+}
+
+func (r *ReadShardWalRequest) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ReadShardWalRequest)
+}
+
+func (r *ReadShardWalRequest) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ReadShardWalRequest)
+}
+
+func (r *ReadShardWalRequest) Error() error {
+	return nil
+}
+
+type ReadShardWalResponse struct {
+	internal2.ReadShardWalResponse
+}
+
+func (r *ReadShardWalResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ReadShardWalResponse)
+}
+
+func (r *ReadShardWalResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ReadShardWalResponse)
+}
+
+func (r *ReadShardWalResponse) Error() error {
+	if r.Err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *r.Err)
+}
+
+type ShowQueriesRequest struct {
+	internal2.ShowQueriesRequest
+}
+
+func (r *ShowQueriesRequest) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ShowQueriesRequest)
+}
+
+func (r *ShowQueriesRequest) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ShowQueriesRequest)
+}
+
+func (r *ShowQueriesRequest) Error() error {
+	return nil
+}
+
+type ShowQueriesResponse struct {
+	internal2.ShowQueriesResponse
+}
+
+func (r *ShowQueriesResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ShowQueriesResponse)
+}
+
+func (r *ShowQueriesResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ShowQueriesResponse)
+}
+
+func (r *ShowQueriesResponse) Error() error {
+	if r.Err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *r.Err)
+}
+
+type KillQueryRequest struct {
+	internal2.KillQueryRequest
+}
+
+func (r *KillQueryRequest) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.KillQueryRequest)
+}
+
+func (r *KillQueryRequest) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.KillQueryRequest)
+}
+
+func (r *KillQueryRequest) Error() error {
+	return nil
+}
+
+type KillQueryResponse struct {
+	internal2.KillQueryResponse
+}
+
+func (r *KillQueryResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.KillQueryResponse)
+}
+
+func (r *KillQueryResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.KillQueryResponse)
+}
+
+func (r *KillQueryResponse) Error() error {
+	if r.Err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *r.Err)
+}
+
+type ReadShardRowsRequest struct {
+	internal2.ReadShardRowsRequest
+}
+
+func (r *ReadShardRowsRequest) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ReadShardRowsRequest)
+}
+
+func (r *ReadShardRowsRequest) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ReadShardRowsRequest)
+}
+
+func (r *ReadShardRowsRequest) Error() error {
+	return nil
+}
+
+type ReadShardRowsResponse struct {
+	internal2.ReadShardRowsResponse
+}
+
+func (r *ReadShardRowsResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.ReadShardRowsResponse)
+}
+
+func (r *ReadShardRowsResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.ReadShardRowsResponse)
+}
+
+func (r *ReadShardRowsResponse) Error() error {
+	if r.Err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *r.Err)
+}
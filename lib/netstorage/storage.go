@@ -31,6 +31,7 @@ import (
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 	"go.uber.org/zap"
 )
@@ -56,6 +57,11 @@ type Storage interface {
 	ShowSeries(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 	SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) (map[string]uint64, error)
+	DropSeries(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) (int, error)
+	ReadShardWal(nodeID uint64, db string, ptID uint32, shardID uint64) ([][]byte, error)
+	ReadShardRows(nodeID uint64, db string, ptID uint32, shardID uint64, cursor RowsCursor, limit int) ([]byte, RowsCursor, bool, error)
+	ShowQueries(nodeID uint64) ([]query.StorePipeline, error)
+	KillQuery(nodeID uint64, host string, qid uint64) (int, error)
 
 	SendSysCtrlOnNode(nodID uint64, req SysCtrlRequest) (map[string]string, error)
 
@@ -339,6 +345,134 @@ func (s *NetStorage) ShowSeries(nodeID uint64, db string, ptIDs []uint32, measur
 	return resp.Series, resp.Error()
 }
 
+func (s *NetStorage) DropSeries(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) (int, error) {
+	req := &DropSeriesRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = ptIDs
+	req.Measurements = measurements
+	if condition != nil {
+		req.Condition = proto.String(condition.String())
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, DropSeriesRequestMessage, req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, ok := v.(*DropSeriesResponse)
+	if !ok {
+		return 0, executor.NewInvalidTypeError("*netstorage.DropSeriesResponse", v)
+	}
+
+	return int(resp.GetCount()), resp.Error()
+}
+
+// ReadShardWal returns the records of rows kept by the WAL of a shard replica, the rows written since the replica was
+// last flushed.
+func (s *NetStorage) ReadShardWal(nodeID uint64, db string, ptID uint32, shardID uint64) ([][]byte, error) {
+	req := &ReadShardWalRequest{}
+	req.DB = proto.String(db)
+	req.PtID = proto.Uint32(ptID)
+	req.ShardID = proto.Uint64(shardID)
+
+	v, err := s.ddlRequestWithNodeId(nodeID, ReadShardWalRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*ReadShardWalResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ReadShardWalResponse", v)
+	}
+
+	return resp.GetRecords(), resp.Error()
+}
+
+// ReadShardRows returns a page of the flushed rows of a shard replica from cursor on, marshaled as a write request,
+// and the cursor of the next page. done is true once all flushed rows are read.
+func (s *NetStorage) ReadShardRows(nodeID uint64, db string, ptID uint32, shardID uint64, cursor RowsCursor,
+	limit int) ([]byte, RowsCursor, bool, error) {
+	req := &ReadShardRowsRequest{}
+	req.DB = proto.String(db)
+	req.PtID = proto.Uint32(ptID)
+	req.ShardID = proto.Uint64(shardID)
+	req.Measurement = proto.String(cursor.Measurement)
+	req.OutOfOrder = proto.Bool(cursor.OutOfOrder)
+	req.SeriesID = proto.Uint64(cursor.SeriesID)
+	req.Limit = proto.Int64(int64(limit))
+
+	v, err := s.ddlRequestWithNodeId(nodeID, ReadShardRowsRequestMessage, req)
+	if err != nil {
+		return nil, cursor, false, err
+	}
+
+	resp, ok := v.(*ReadShardRowsResponse)
+	if !ok {
+		return nil, cursor, false, executor.NewInvalidTypeError("*netstorage.ReadShardRowsResponse", v)
+	}
+	if err = resp.Error(); err != nil {
+		return nil, cursor, false, err
+	}
+
+	next := RowsCursor{
+		Measurement: resp.GetMeasurement(),
+		OutOfOrder:  resp.GetOutOfOrder(),
+		SeriesID:    resp.GetSeriesID(),
+	}
+	return resp.GetRows(), next, resp.GetDone(), nil
+}
+
+// ShowQueries returns the pipelines the node runs for the queries of all SQL nodes.
+func (s *NetStorage) ShowQueries(nodeID uint64) ([]query.StorePipeline, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowQueriesRequestMessage, &ShowQueriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*ShowQueriesResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowQueriesResponse", v)
+	}
+	if err = resp.Error(); err != nil {
+		return nil, err
+	}
+
+	pipelines := make([]query.StorePipeline, 0, len(resp.GetPipelines()))
+	for _, p := range resp.GetPipelines() {
+		pipelines = append(pipelines, query.StorePipeline{
+			NodeID:   nodeID,
+			Host:     p.GetHost(),
+			QueryID:  p.GetQueryID(),
+			Query:    p.GetQuery(),
+			Database: p.GetDatabase(),
+			PtID:     p.GetPtID(),
+			Shards:   int(p.GetShards()),
+			Chunks:   p.GetChunks(),
+			Duration: time.Duration(p.GetDuration()),
+		})
+	}
+	return pipelines, nil
+}
+
+// KillQuery aborts the pipelines the node runs for a query of a SQL node, and returns how many were aborted.
+func (s *NetStorage) KillQuery(nodeID uint64, host string, qid uint64) (int, error) {
+	req := &KillQueryRequest{}
+	req.Host = proto.String(host)
+	req.QueryID = proto.Uint64(qid)
+
+	v, err := s.ddlRequestWithNodeId(nodeID, KillQueryRequestMessage, req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, ok := v.(*KillQueryResponse)
+	if !ok {
+		return 0, executor.NewInvalidTypeError("*netstorage.KillQueryResponse", v)
+	}
+
+	return int(resp.GetKilled()), resp.Error()
+}
+
 func (s *NetStorage) DropShard(nodeID uint64, database, rpName string, dbPts []uint32, shardID uint64) error {
 	return nil
 }
@@ -0,0 +1,505 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The protobuf messages are decoded field by field with protowire, the unknown fields are skipped. The field
+// numbers are the ones of opentelemetry/proto/metrics/v1/metrics.proto and common/v1/common.proto.
+
+// Unmarshal decodes a request in the protobuf encoding of OTLP.
+func Unmarshal(b []byte, req *ExportMetricsServiceRequest) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		req.ResourceMetrics = append(req.ResourceMetrics, ResourceMetrics{})
+		return message(typ, v, req.ResourceMetrics[len(req.ResourceMetrics)-1].unmarshal)
+	})
+}
+
+func (m *ResourceMetrics) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			return message(typ, v, m.Resource.unmarshal)
+		case 2:
+			m.ScopeMetrics = append(m.ScopeMetrics, ScopeMetrics{})
+			return message(typ, v, m.ScopeMetrics[len(m.ScopeMetrics)-1].unmarshal)
+		}
+		return nil
+	})
+}
+
+func (r *Resource) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		return appendKeyValue(&r.Attributes, typ, v)
+	})
+}
+
+func (m *ScopeMetrics) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			return message(typ, v, m.Scope.unmarshal)
+		case 2:
+			m.Metrics = append(m.Metrics, Metric{})
+			return message(typ, v, m.Metrics[len(m.Metrics)-1].unmarshal)
+		}
+		return nil
+	})
+}
+
+func (s *Scope) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			s.Name, err = stringValue(typ, v)
+		case 2:
+			s.Version, err = stringValue(typ, v)
+		}
+		return err
+	})
+}
+
+func (m *Metric) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			m.Name, err = stringValue(typ, v)
+		case 3:
+			m.Unit, err = stringValue(typ, v)
+		case 5:
+			m.Gauge = &Gauge{}
+			err = message(typ, v, m.Gauge.unmarshal)
+		case 7:
+			m.Sum = &Sum{}
+			err = message(typ, v, m.Sum.unmarshal)
+		case 9:
+			m.Histogram = &Histogram{}
+			err = message(typ, v, m.Histogram.unmarshal)
+		case 10:
+			m.ExponentialHistogram = &ExponentialHistogram{}
+			err = message(typ, v, m.ExponentialHistogram.unmarshal)
+		case 11:
+			m.Summary = &Summary{}
+			err = message(typ, v, m.Summary.unmarshal)
+		}
+		return err
+	})
+}
+
+func (g *Gauge) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		g.DataPoints = append(g.DataPoints, NumberDataPoint{})
+		return message(typ, v, g.DataPoints[len(g.DataPoints)-1].unmarshal)
+	})
+}
+
+func (s *Sum) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			s.DataPoints = append(s.DataPoints, NumberDataPoint{})
+			return message(typ, v, s.DataPoints[len(s.DataPoints)-1].unmarshal)
+		case 2:
+			t, err := varintValue(typ, v)
+			s.AggregationTemporality = Temporality(t)
+			return err
+		case 3:
+			t, err := varintValue(typ, v)
+			s.IsMonotonic = t != 0
+			return err
+		}
+		return nil
+	})
+}
+
+func (h *Histogram) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			h.DataPoints = append(h.DataPoints, HistogramDataPoint{})
+			return message(typ, v, h.DataPoints[len(h.DataPoints)-1].unmarshal)
+		case 2:
+			t, err := varintValue(typ, v)
+			h.AggregationTemporality = Temporality(t)
+			return err
+		}
+		return nil
+	})
+}
+
+func (h *ExponentialHistogram) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			h.DataPoints = append(h.DataPoints, ExponentialHistogramDataPoint{})
+			return message(typ, v, h.DataPoints[len(h.DataPoints)-1].unmarshal)
+		case 2:
+			t, err := varintValue(typ, v)
+			h.AggregationTemporality = Temporality(t)
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *Summary) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		s.DataPoints = append(s.DataPoints, SummaryDataPoint{})
+		return message(typ, v, s.DataPoints[len(s.DataPoints)-1].unmarshal)
+	})
+}
+
+func (p *NumberDataPoint) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 3:
+			return fixed64Field(&p.TimeUnixNano, typ, v)
+		case 4:
+			d, err := doubleValue(typ, v)
+			p.AsDouble = &d
+			return err
+		case 6:
+			x, err := fixed64Value(typ, v)
+			i := Int64(x)
+			p.AsInt = &i
+			return err
+		case 7:
+			return appendKeyValue(&p.Attributes, typ, v)
+		case 8:
+			return flagsField(&p.Flags, typ, v)
+		}
+		return nil
+	})
+}
+
+func (p *HistogramDataPoint) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 3:
+			err = fixed64Field(&p.TimeUnixNano, typ, v)
+		case 4:
+			err = fixed64Field(&p.Count, typ, v)
+		case 5:
+			d, e := doubleValue(typ, v)
+			p.Sum, err = &d, e
+		case 6:
+			p.BucketCounts, err = appendFixed64s(p.BucketCounts, typ, v)
+		case 7:
+			var bounds []Uint64
+			bounds, err = appendFixed64s(nil, typ, v)
+			for _, x := range bounds {
+				p.ExplicitBounds = append(p.ExplicitBounds, Float64(math.Float64frombits(uint64(x))))
+			}
+		case 9:
+			err = appendKeyValue(&p.Attributes, typ, v)
+		case 10:
+			err = flagsField(&p.Flags, typ, v)
+		}
+		return err
+	})
+}
+
+func (p *ExponentialHistogramDataPoint) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			err = appendKeyValue(&p.Attributes, typ, v)
+		case 3:
+			err = fixed64Field(&p.TimeUnixNano, typ, v)
+		case 4:
+			err = fixed64Field(&p.Count, typ, v)
+		case 5:
+			d, e := doubleValue(typ, v)
+			p.Sum, err = &d, e
+		case 6:
+			var x uint64
+			x, err = varintValue(typ, v)
+			p.Scale = int32(protowire.DecodeZigZag(x))
+		case 7:
+			err = fixed64Field(&p.ZeroCount, typ, v)
+		case 8:
+			err = message(typ, v, p.Positive.unmarshal)
+		case 9:
+			err = message(typ, v, p.Negative.unmarshal)
+		case 10:
+			err = flagsField(&p.Flags, typ, v)
+		case 14:
+			p.ZeroThreshold, err = doubleValue(typ, v)
+		}
+		return err
+	})
+}
+
+func (bs *Buckets) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			var x uint64
+			x, err = varintValue(typ, v)
+			bs.Offset = int32(protowire.DecodeZigZag(x))
+		case 2:
+			bs.BucketCounts, err = appendVarints(bs.BucketCounts, typ, v)
+		}
+		return err
+	})
+}
+
+func (p *SummaryDataPoint) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 3:
+			err = fixed64Field(&p.TimeUnixNano, typ, v)
+		case 4:
+			err = fixed64Field(&p.Count, typ, v)
+		case 5:
+			p.Sum, err = doubleValue(typ, v)
+		case 6:
+			p.QuantileValues = append(p.QuantileValues, ValueAtQuantile{})
+			err = message(typ, v, p.QuantileValues[len(p.QuantileValues)-1].unmarshal)
+		case 7:
+			err = appendKeyValue(&p.Attributes, typ, v)
+		case 8:
+			err = flagsField(&p.Flags, typ, v)
+		}
+		return err
+	})
+}
+
+func (q *ValueAtQuantile) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			q.Quantile, err = doubleValue(typ, v)
+		case 2:
+			q.Value, err = doubleValue(typ, v)
+		}
+		return err
+	})
+}
+
+func appendKeyValue(dst *[]KeyValue, typ protowire.Type, v []byte) error {
+	*dst = append(*dst, KeyValue{})
+	return message(typ, v, (*dst)[len(*dst)-1].unmarshal)
+}
+
+func (kv *KeyValue) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		var err error
+		switch num {
+		case 1:
+			kv.Key, err = stringValue(typ, v)
+		case 2:
+			err = message(typ, v, kv.Value.unmarshal)
+		}
+		return err
+	})
+}
+
+func (av *AnyValue) unmarshal(b []byte) error {
+	return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch num {
+		case 1:
+			s, err := stringValue(typ, v)
+			av.StringValue = &s
+			return err
+		case 2:
+			x, err := varintValue(typ, v)
+			t := x != 0
+			av.BoolValue = &t
+			return err
+		case 3:
+			x, err := varintValue(typ, v)
+			i := Int64(x)
+			av.IntValue = &i
+			return err
+		case 4:
+			d, err := doubleValue(typ, v)
+			av.DoubleValue = &d
+			return err
+		case 5:
+			av.ArrayValue = &ArrayValue{}
+			return message(typ, v, func(b []byte) error {
+				return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+					if num != 1 {
+						return nil
+					}
+					av.ArrayValue.Values = append(av.ArrayValue.Values, AnyValue{})
+					return message(typ, v, av.ArrayValue.Values[len(av.ArrayValue.Values)-1].unmarshal)
+				})
+			})
+		case 6:
+			av.KvlistValue = &KeyValueList{}
+			return message(typ, v, func(b []byte) error {
+				return walk(b, func(num protowire.Number, typ protowire.Type, v []byte) error {
+					if num != 1 {
+						return nil
+					}
+					return appendKeyValue(&av.KvlistValue.Values, typ, v)
+				})
+			})
+		case 7:
+			p, err := bytesValue(typ, v)
+			av.BytesValue = append([]byte{}, p...)
+			return err
+		}
+		return nil
+	})
+}
+
+// walk calls fn with the number, the wire type and the encoded value of each field of a message.
+func walk(b []byte, fn func(num protowire.Number, typ protowire.Type, v []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err := fn(num, typ, b[:n]); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func wireTypeError(want, got protowire.Type) error {
+	return fmt.Errorf("invalid wire type %d, expect %d", got, want)
+}
+
+func message(typ protowire.Type, v []byte, unmarshal func(b []byte) error) error {
+	b, err := bytesValue(typ, v)
+	if err != nil {
+		return err
+	}
+	return unmarshal(b)
+}
+
+func bytesValue(typ protowire.Type, v []byte) ([]byte, error) {
+	if typ != protowire.BytesType {
+		return nil, wireTypeError(protowire.BytesType, typ)
+	}
+	b, _ := protowire.ConsumeBytes(v)
+	return b, nil
+}
+
+func stringValue(typ protowire.Type, v []byte) (string, error) {
+	b, err := bytesValue(typ, v)
+	return string(b), err
+}
+
+func varintValue(typ protowire.Type, v []byte) (uint64, error) {
+	if typ != protowire.VarintType {
+		return 0, wireTypeError(protowire.VarintType, typ)
+	}
+	x, _ := protowire.ConsumeVarint(v)
+	return x, nil
+}
+
+func fixed64Value(typ protowire.Type, v []byte) (uint64, error) {
+	if typ != protowire.Fixed64Type {
+		return 0, wireTypeError(protowire.Fixed64Type, typ)
+	}
+	x, _ := protowire.ConsumeFixed64(v)
+	return x, nil
+}
+
+func fixed64Field(dst *Uint64, typ protowire.Type, v []byte) error {
+	x, err := fixed64Value(typ, v)
+	*dst = Uint64(x)
+	return err
+}
+
+func doubleValue(typ protowire.Type, v []byte) (Float64, error) {
+	x, err := fixed64Value(typ, v)
+	return Float64(math.Float64frombits(x)), err
+}
+
+func flagsField(dst *uint32, typ protowire.Type, v []byte) error {
+	x, err := varintValue(typ, v)
+	*dst = uint32(x)
+	return err
+}
+
+// appendFixed64s appends a packed or an unpacked repeated fixed64 or double field.
+func appendFixed64s(dst []Uint64, typ protowire.Type, v []byte) ([]Uint64, error) {
+	if typ == protowire.Fixed64Type {
+		x, _ := protowire.ConsumeFixed64(v)
+		return append(dst, Uint64(x)), nil
+	}
+	b, err := bytesValue(typ, v)
+	if err != nil {
+		return dst, err
+	}
+	for len(b) > 0 {
+		x, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return dst, protowire.ParseError(n)
+		}
+		dst = append(dst, Uint64(x))
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+// appendVarints appends a packed or an unpacked repeated uint64 field.
+func appendVarints(dst []Uint64, typ protowire.Type, v []byte) ([]Uint64, error) {
+	if typ == protowire.VarintType {
+		x, _ := protowire.ConsumeVarint(v)
+		return append(dst, Uint64(x)), nil
+	}
+	b, err := bytesValue(typ, v)
+	if err != nil {
+		return dst, err
+	}
+	for len(b) > 0 {
+		x, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return dst, protowire.ParseError(n)
+		}
+		dst = append(dst, Uint64(x))
+		b = b[n:]
+	}
+	return dst, nil
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package otlp decodes the OpenTelemetry OTLP metrics export requests, in protobuf and in JSON, and converts them
+// to Prometheus remote write requests. Only the fields needed by the conversion are decoded.
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExportMetricsServiceRequest is the body of a request to /v1/metrics.
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics []ResourceMetrics `json:"resourceMetrics"`
+}
+
+type ResourceMetrics struct {
+	Resource     Resource       `json:"resource"`
+	ScopeMetrics []ScopeMetrics `json:"scopeMetrics"`
+}
+
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+type ScopeMetrics struct {
+	Scope   Scope    `json:"scope"`
+	Metrics []Metric `json:"metrics"`
+}
+
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Metric holds one of the kinds of data.
+type Metric struct {
+	Name                 string                `json:"name"`
+	Unit                 string                `json:"unit"`
+	Gauge                *Gauge                `json:"gauge"`
+	Sum                  *Sum                  `json:"sum"`
+	Histogram            *Histogram            `json:"histogram"`
+	ExponentialHistogram *ExponentialHistogram `json:"exponentialHistogram"`
+	Summary              *Summary              `json:"summary"`
+}
+
+// Temporality is the aggregation temporality of the sums and the histograms.
+type Temporality int32
+
+const (
+	TemporalityUnspecified Temporality = 0
+	TemporalityDelta       Temporality = 1
+	TemporalityCumulative  Temporality = 2
+)
+
+var temporalityNames = map[string]Temporality{
+	"AGGREGATION_TEMPORALITY_UNSPECIFIED": TemporalityUnspecified,
+	"AGGREGATION_TEMPORALITY_DELTA":       TemporalityDelta,
+	"AGGREGATION_TEMPORALITY_CUMULATIVE":  TemporalityCumulative,
+}
+
+func (t *Temporality) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		v, ok := temporalityNames[name]
+		if !ok {
+			return fmt.Errorf("invalid aggregation temporality %q", name)
+		}
+		*t = v
+		return nil
+	}
+	var v int32
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*t = Temporality(v)
+	return nil
+}
+
+type Gauge struct {
+	DataPoints []NumberDataPoint `json:"dataPoints"`
+}
+
+type Sum struct {
+	DataPoints             []NumberDataPoint `json:"dataPoints"`
+	AggregationTemporality Temporality       `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type Histogram struct {
+	DataPoints             []HistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality Temporality          `json:"aggregationTemporality"`
+}
+
+type ExponentialHistogram struct {
+	DataPoints             []ExponentialHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality Temporality                     `json:"aggregationTemporality"`
+}
+
+type Summary struct {
+	DataPoints []SummaryDataPoint `json:"dataPoints"`
+}
+
+// flagNoRecordedValue marks a data point without value.
+const flagNoRecordedValue = 1
+
+type NumberDataPoint struct {
+	Attributes   []KeyValue `json:"attributes"`
+	TimeUnixNano Uint64     `json:"timeUnixNano"`
+	AsDouble     *Float64   `json:"asDouble"`
+	AsInt        *Int64     `json:"asInt"`
+	Flags        uint32     `json:"flags"`
+}
+
+// Value returns the value of the data point, an integer is converted to float64.
+func (p *NumberDataPoint) Value() float64 {
+	if p.AsInt != nil {
+		return float64(*p.AsInt)
+	}
+	if p.AsDouble != nil {
+		return float64(*p.AsDouble)
+	}
+	return 0
+}
+
+type HistogramDataPoint struct {
+	Attributes     []KeyValue `json:"attributes"`
+	TimeUnixNano   Uint64     `json:"timeUnixNano"`
+	Count          Uint64     `json:"count"`
+	Sum            *Float64   `json:"sum"`
+	BucketCounts   []Uint64   `json:"bucketCounts"`
+	ExplicitBounds []Float64  `json:"explicitBounds"`
+	Flags          uint32     `json:"flags"`
+}
+
+type ExponentialHistogramDataPoint struct {
+	Attributes    []KeyValue `json:"attributes"`
+	TimeUnixNano  Uint64     `json:"timeUnixNano"`
+	Count         Uint64     `json:"count"`
+	Sum           *Float64   `json:"sum"`
+	Scale         int32      `json:"scale"`
+	ZeroCount     Uint64     `json:"zeroCount"`
+	Positive      Buckets    `json:"positive"`
+	Negative      Buckets    `json:"negative"`
+	Flags         uint32     `json:"flags"`
+	ZeroThreshold Float64    `json:"zeroThreshold"`
+}
+
+// Buckets are the consecutive buckets of an exponential histogram beginning with the bucket of index Offset.
+type Buckets struct {
+	Offset       int32    `json:"offset"`
+	BucketCounts []Uint64 `json:"bucketCounts"`
+}
+
+type SummaryDataPoint struct {
+	Attributes     []KeyValue        `json:"attributes"`
+	TimeUnixNano   Uint64            `json:"timeUnixNano"`
+	Count          Uint64            `json:"count"`
+	Sum            Float64           `json:"sum"`
+	QuantileValues []ValueAtQuantile `json:"quantileValues"`
+	Flags          uint32            `json:"flags"`
+}
+
+type ValueAtQuantile struct {
+	Quantile Float64 `json:"quantile"`
+	Value    Float64 `json:"value"`
+}
+
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue holds one of the kinds of attribute values.
+type AnyValue struct {
+	StringValue *string       `json:"stringValue"`
+	BoolValue   *bool         `json:"boolValue"`
+	IntValue    *Int64        `json:"intValue"`
+	DoubleValue *Float64      `json:"doubleValue"`
+	ArrayValue  *ArrayValue   `json:"arrayValue"`
+	KvlistValue *KeyValueList `json:"kvlistValue"`
+	BytesValue  []byte        `json:"bytesValue"`
+}
+
+type ArrayValue struct {
+	Values []AnyValue `json:"values"`
+}
+
+type KeyValueList struct {
+	Values []KeyValue `json:"values"`
+}
+
+// String returns the value as a label value, the arrays and the key value lists are formatted as JSON.
+func (v *AnyValue) String() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return strconv.FormatInt(int64(*v.IntValue), 10)
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(float64(*v.DoubleValue), 'g', -1, 64)
+	case v.BytesValue != nil:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case v.ArrayValue != nil:
+		items := make([]string, len(v.ArrayValue.Values))
+		for i := range v.ArrayValue.Values {
+			items[i] = v.ArrayValue.Values[i].jsonString()
+		}
+		return "[" + strings.Join(items, ",") + "]"
+	case v.KvlistValue != nil:
+		kvs := v.KvlistValue.Values
+		items := make([]string, len(kvs))
+		for i := range kvs {
+			items[i] = strconv.Quote(kvs[i].Key) + ":" + kvs[i].Value.jsonString()
+		}
+		sort.Strings(items)
+		return "{" + strings.Join(items, ",") + "}"
+	}
+	return ""
+}
+
+func (v *AnyValue) jsonString() string {
+	if v.StringValue != nil || v.BytesValue != nil {
+		return strconv.Quote(v.String())
+	}
+	if v.DoubleValue != nil {
+		if f := float64(*v.DoubleValue); math.IsNaN(f) || math.IsInf(f, 0) {
+			return strconv.Quote(v.String())
+		}
+	}
+	if s := v.String(); s != "" {
+		return s
+	}
+	return "null"
+}
+
+// Uint64 is a uint64 encoded in JSON as a string or a number.
+type Uint64 uint64
+
+func (u *Uint64) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uint64 %s", b)
+	}
+	*u = Uint64(v)
+	return nil
+}
+
+// Int64 is an int64 encoded in JSON as a string or a number.
+type Int64 int64
+
+func (i *Int64) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid int64 %s", b)
+	}
+	*i = Int64(v)
+	return nil
+}
+
+// Float64 is a float64 encoded in JSON as a number, or as a string for NaN and the infinities.
+type Float64 float64
+
+func (f *Float64) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	var v float64
+	switch s {
+	case "NaN":
+		v = math.NaN()
+	case "Infinity":
+		v = math.Inf(1)
+	case "-Infinity":
+		v = math.Inf(-1)
+	default:
+		var err error
+		if v, err = strconv.ParseFloat(s, 64); err != nil {
+			return fmt.Errorf("invalid double %s", b)
+		}
+	}
+	*f = Float64(v)
+	return nil
+}
+
+// UnmarshalJSON decodes a request in the JSON encoding of OTLP.
+func UnmarshalJSON(b []byte, req *ExportMetricsServiceRequest) error {
+	return json.Unmarshal(b, req)
+}
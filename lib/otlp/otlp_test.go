@@ -0,0 +1,240 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func appendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendFixed64(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, v)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func stringAttribute(key, value string) []byte {
+	kv := appendString(nil, 1, key)
+	return appendMessage(kv, 2, appendString(nil, 1, value))
+}
+
+func TestUnmarshal(t *testing.T) {
+	// a gauge with an int value and a histogram with packed bucket counts and unpacked bounds
+	gaugePoint := appendFixed64(nil, 3, 2e9)
+	gaugePoint = appendFixed64(gaugePoint, 6, uint64(7))
+	gaugePoint = appendMessage(gaugePoint, 7, stringAttribute("host", "a"))
+	gauge := appendString(nil, 1, "cpu.usage")
+	gauge = appendMessage(gauge, 5, appendMessage(nil, 1, gaugePoint))
+
+	var counts []byte
+	for _, c := range []uint64{1, 2, 3} {
+		counts = protowire.AppendFixed64(counts, c)
+	}
+	histPoint := appendFixed64(nil, 4, 6)
+	histPoint = appendFixed64(histPoint, 5, math.Float64bits(12.5))
+	histPoint = appendMessage(histPoint, 6, counts)
+	histPoint = appendFixed64(histPoint, 7, math.Float64bits(1))
+	histPoint = appendFixed64(histPoint, 7, math.Float64bits(5))
+	histogram := appendMessage(nil, 1, histPoint)
+	histogram = appendVarint(histogram, 2, uint64(TemporalityDelta))
+	hist := appendString(nil, 1, "latency")
+	hist = appendMessage(hist, 9, histogram)
+
+	expBuckets := appendVarint(nil, 1, protowire.EncodeZigZag(-1))
+	expBuckets = appendMessage(expBuckets, 2, protowire.AppendVarint(protowire.AppendVarint(nil, 4), 5))
+	expPoint := appendVarint(nil, 6, protowire.EncodeZigZag(-2))
+	expPoint = appendMessage(expPoint, 8, expBuckets)
+	exp := appendString(nil, 1, "size")
+	exp = appendMessage(exp, 10, appendMessage(nil, 1, expPoint))
+
+	scope := appendMessage(nil, 1, appendString(nil, 1, "meter"))
+	scope = appendMessage(scope, 2, gauge)
+	scope = appendMessage(scope, 2, hist)
+	scope = appendMessage(scope, 2, exp)
+	rm := appendMessage(nil, 1, appendMessage(nil, 1, stringAttribute("service.name", "api")))
+	rm = appendMessage(rm, 2, scope)
+	body := appendMessage(nil, 1, rm)
+	// the unknown fields are skipped
+	body = appendString(body, 9, "unknown")
+
+	var req ExportMetricsServiceRequest
+	require.NoError(t, Unmarshal(body, &req))
+	require.Len(t, req.ResourceMetrics, 1)
+	assert.Equal(t, "service.name", req.ResourceMetrics[0].Resource.Attributes[0].Key)
+	sm := req.ResourceMetrics[0].ScopeMetrics[0]
+	assert.Equal(t, "meter", sm.Scope.Name)
+	require.Len(t, sm.Metrics, 3)
+
+	p := sm.Metrics[0].Gauge.DataPoints[0]
+	assert.Equal(t, Uint64(2e9), p.TimeUnixNano)
+	assert.Equal(t, float64(7), p.Value())
+	assert.Equal(t, "a", p.Attributes[0].Value.String())
+
+	h := sm.Metrics[1].Histogram
+	assert.Equal(t, TemporalityDelta, h.AggregationTemporality)
+	assert.Equal(t, []Uint64{1, 2, 3}, h.DataPoints[0].BucketCounts)
+	assert.Equal(t, []Float64{1, 5}, h.DataPoints[0].ExplicitBounds)
+	assert.Equal(t, Float64(12.5), *h.DataPoints[0].Sum)
+
+	e := sm.Metrics[2].ExponentialHistogram.DataPoints[0]
+	assert.Equal(t, int32(-2), e.Scale)
+	assert.Equal(t, int32(-1), e.Positive.Offset)
+	assert.Equal(t, []Uint64{4, 5}, e.Positive.BucketCounts)
+
+	assert.Error(t, Unmarshal([]byte{0x0a, 0x05, 0x01}, &req))
+	assert.Error(t, Unmarshal(appendVarint(nil, 1, 1), &req))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	body := `{"resourceMetrics":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"api"}}]},
+"scopeMetrics":[{"scope":{"name":"meter"},"metrics":[
+{"name":"requests","sum":{"aggregationTemporality":"AGGREGATION_TEMPORALITY_CUMULATIVE","isMonotonic":true,
+"dataPoints":[{"timeUnixNano":"2000000000","asInt":"3","attributes":[{"key":"code","value":{"intValue":"200"}}]}]}},
+{"name":"temp","gauge":{"dataPoints":[{"asDouble":"NaN"},{"asDouble":1.5,"flags":1}]}},
+{"name":"rpc","summary":{"dataPoints":[{"count":"2","sum":3,"quantileValues":[{"quantile":0.5,"value":1}]}]}}]}]}]}`
+
+	var req ExportMetricsServiceRequest
+	require.NoError(t, UnmarshalJSON([]byte(body), &req))
+	metrics := req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	require.Len(t, metrics, 3)
+	assert.Equal(t, TemporalityCumulative, metrics[0].Sum.AggregationTemporality)
+	assert.Equal(t, float64(3), metrics[0].Sum.DataPoints[0].Value())
+	assert.Equal(t, "200", metrics[0].Sum.DataPoints[0].Attributes[0].Value.String())
+	assert.True(t, math.IsNaN(metrics[1].Gauge.DataPoints[0].Value()))
+	assert.Equal(t, Uint64(2), metrics[2].Summary.DataPoints[0].Count)
+
+	assert.Error(t, UnmarshalJSON([]byte(`{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{"sum":{"aggregationTemporality":"DELTA"}}]}]}]}`), &req))
+}
+
+// seriesString formats the series as name{labels} value@timestamp for the comparisons.
+func seriesString(ts prompb.TimeSeries) string {
+	var name string
+	var labels []string
+	for _, l := range ts.Labels {
+		if l.Name == nameLabel {
+			name = l.Value
+			continue
+		}
+		labels = append(labels, l.Name+"="+l.Value)
+	}
+	s := ts.Samples[0]
+	return name + "{" + strings.Join(labels, ",") + "} " + formatFloat(s.Value) + "@" + formatFloat(float64(s.Timestamp))
+}
+
+func convert(req *ExportMetricsServiceRequest) []string {
+	wr := ToWriteRequest(req, time.Unix(100, 0))
+	series := make([]string, len(wr.Timeseries))
+	for i := range wr.Timeseries {
+		series[i] = seriesString(wr.Timeseries[i])
+	}
+	return series
+}
+
+func TestToWriteRequest(t *testing.T) {
+	str := func(s string) AnyValue { return AnyValue{StringValue: &s} }
+	f := func(v float64) *Float64 { x := Float64(v); return &x }
+	i := func(v int64) *Int64 { x := Int64(v); return &x }
+
+	req := &ExportMetricsServiceRequest{ResourceMetrics: []ResourceMetrics{{
+		Resource: Resource{Attributes: []KeyValue{
+			{Key: "service.name", Value: str("api")},
+			{Key: "service.namespace", Value: str("shop")},
+			{Key: "service.instance.id", Value: str("pod-1")},
+			{Key: "k8s.region", Value: str("eu")},
+		}},
+		ScopeMetrics: []ScopeMetrics{{
+			Scope: Scope{Name: "meter"},
+			Metrics: []Metric{
+				{Name: "cpu.usage", Gauge: &Gauge{DataPoints: []NumberDataPoint{
+					{TimeUnixNano: 2e9, AsDouble: f(0.5), Attributes: []KeyValue{{Key: "k8s.region", Value: str("us")}}},
+					{AsDouble: f(1), Flags: flagNoRecordedValue},
+				}}},
+				{Name: "requests", Sum: &Sum{IsMonotonic: true, AggregationTemporality: TemporalityDelta,
+					DataPoints: []NumberDataPoint{{TimeUnixNano: 2e9, AsInt: i(3)}}}},
+				{Name: "latency", Histogram: &Histogram{AggregationTemporality: TemporalityCumulative,
+					DataPoints: []HistogramDataPoint{{TimeUnixNano: 2e9, Count: 6, Sum: f(12),
+						BucketCounts: []Uint64{1, 2, 3}, ExplicitBounds: []Float64{1, 5}}}}},
+			},
+		}},
+	}}}
+
+	head, tail := "instance=pod-1,job=shop/api,k8s_region=eu,", "otel_scope_name=meter,service_instance_id=pod-1,service_name=api,service_namespace=shop"
+	base := head + tail
+	assert.Equal(t, []string{
+		"cpu_usage{instance=pod-1,job=shop/api,k8s_region=us,otel_scope_name=meter,service_instance_id=pod-1,service_name=api,service_namespace=shop} 0.5@2000",
+		"requests_total{" + base + ",temporality=delta} 3@2000",
+		"latency_bucket{" + head + "le=1," + tail + "} 1@2000",
+		"latency_bucket{" + head + "le=5," + tail + "} 3@2000",
+		"latency_bucket{" + head + "le=+Inf," + tail + "} 6@2000",
+		"latency_sum{" + base + "} 12@2000",
+		"latency_count{" + base + "} 6@2000",
+	}, convert(req))
+
+	// base 4 at scale -1: the bucket of index i holds (4^i, 4^(i+1)]
+	req = &ExportMetricsServiceRequest{ResourceMetrics: []ResourceMetrics{{ScopeMetrics: []ScopeMetrics{{
+		Metrics: []Metric{
+			{Name: "size", ExponentialHistogram: &ExponentialHistogram{DataPoints: []ExponentialHistogramDataPoint{{
+				Count: 10, Sum: f(100), Scale: -1, ZeroCount: 1,
+				Negative: Buckets{Offset: 0, BucketCounts: []Uint64{2}},
+				Positive: Buckets{Offset: 1, BucketCounts: []Uint64{3, 4}},
+			}}}},
+			{Name: "rpc", Summary: &Summary{DataPoints: []SummaryDataPoint{{TimeUnixNano: 2e9, Count: 2, Sum: 3,
+				QuantileValues: []ValueAtQuantile{{Quantile: 0.5, Value: 1}, {Quantile: 0.99, Value: 2}}}}}},
+		},
+	}}}}}
+	assert.Equal(t, []string{
+		"size_bucket{le=-1} 2@100000",
+		"size_bucket{le=0} 3@100000",
+		"size_bucket{le=16} 6@100000",
+		"size_bucket{le=64} 10@100000",
+		"size_bucket{le=+Inf} 10@100000",
+		"size_sum{} 100@100000",
+		"size_count{} 10@100000",
+		"rpc{quantile=0.5} 1@2000",
+		"rpc{quantile=0.99} 2@2000",
+		"rpc_sum{} 3@2000",
+		"rpc_count{} 2@2000",
+	}, convert(req))
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeName("http.server.duration"))
+	assert.Equal(t, "a:b_c", sanitizeName("a:b-c"))
+	assert.Equal(t, "a_b_c", sanitizeLabel("a:b-c"))
+	assert.Equal(t, "_1xx", sanitizeLabel("1xx"))
+}
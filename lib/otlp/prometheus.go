@@ -0,0 +1,284 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+)
+
+const (
+	nameLabel        = "__name__"
+	jobLabel         = "job"
+	instanceLabel    = "instance"
+	scopeNameLabel   = "otel_scope_name"
+	temporalityLabel = "temporality"
+	bucketLabel      = "le"
+	quantileLabel    = "quantile"
+
+	serviceNameAttr       = "service.name"
+	serviceNamespaceAttr  = "service.namespace"
+	serviceInstanceIDAttr = "service.instance.id"
+)
+
+// ToWriteRequest converts the metrics to time series the way the OpenTelemetry Prometheus exporters do, so the
+// series of a metric are the same whichever way it is collected:
+//
+//   - a gauge is a series named after the metric, a monotonic sum has the _total suffix.
+//   - a histogram is the _bucket series of the cumulative counts labeled by their upper bound le, the _sum and
+//     the _count series. An exponential histogram is converted to the buckets of its upper bounds, the zero bucket
+//     is bounded by the zero threshold.
+//   - a summary is the series of its quantiles labeled by quantile, the _sum and the _count series.
+//
+// The labels are the attributes of the resource overridden by the attributes of the data point, with the names
+// sanitized for Prometheus. service.name and service.namespace make the job label, service.instance.id the instance
+// label, and the name of the instrumentation scope the otel_scope_name label. The sums and the histograms of delta
+// temporality have the temporality="delta" label as their values are not cumulative. The samples are in
+// milliseconds, the data points without timestamp are at now, and the ones flagged without recorded value are
+// skipped.
+func ToWriteRequest(req *ExportMetricsServiceRequest, now time.Time) *prompb.WriteRequest {
+	c := &converter{now: now.UnixNano() / int64(time.Millisecond)}
+	for i := range req.ResourceMetrics {
+		rm := &req.ResourceMetrics[i]
+		resource := resourceLabels(rm.Resource.Attributes)
+		for j := range rm.ScopeMetrics {
+			sm := &rm.ScopeMetrics[j]
+			base := resource
+			if sm.Scope.Name != "" {
+				base = setLabel(append([]prompb.Label(nil), resource...), scopeNameLabel, sm.Scope.Name)
+			}
+			for k := range sm.Metrics {
+				c.metric(&sm.Metrics[k], base)
+			}
+		}
+	}
+	return &prompb.WriteRequest{Timeseries: c.series}
+}
+
+type converter struct {
+	now    int64
+	series []prompb.TimeSeries
+}
+
+func (c *converter) timestamp(t Uint64) int64 {
+	if t == 0 {
+		return c.now
+	}
+	return int64(t) / int64(time.Millisecond)
+}
+
+func (c *converter) add(labels []prompb.Label, name string, value float64, ts int64, extra ...string) {
+	ls := make([]prompb.Label, 0, len(labels)+1+len(extra)/2)
+	ls = append(ls, labels...)
+	for i := 0; i+1 < len(extra); i += 2 {
+		ls = setLabel(ls, extra[i], extra[i+1])
+	}
+	ls = setLabel(ls, nameLabel, name)
+	sort.Slice(ls, func(i, j int) bool {
+		return ls[i].Name < ls[j].Name
+	})
+	c.series = append(c.series, prompb.TimeSeries{
+		Labels:  ls,
+		Samples: []prompb.Sample{{Value: value, Timestamp: ts}},
+	})
+}
+
+func (c *converter) metric(m *Metric, base []prompb.Label) {
+	name := sanitizeName(m.Name)
+	switch {
+	case m.Gauge != nil:
+		for i := range m.Gauge.DataPoints {
+			p := &m.Gauge.DataPoints[i]
+			if p.Flags&flagNoRecordedValue == 0 {
+				c.add(pointLabels(base, p.Attributes), name, p.Value(), c.timestamp(p.TimeUnixNano))
+			}
+		}
+	case m.Sum != nil:
+		if m.Sum.IsMonotonic && !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+		for i := range m.Sum.DataPoints {
+			p := &m.Sum.DataPoints[i]
+			if p.Flags&flagNoRecordedValue == 0 {
+				labels := temporalityLabels(pointLabels(base, p.Attributes), m.Sum.AggregationTemporality)
+				c.add(labels, name, p.Value(), c.timestamp(p.TimeUnixNano))
+			}
+		}
+	case m.Histogram != nil:
+		for i := range m.Histogram.DataPoints {
+			p := &m.Histogram.DataPoints[i]
+			if p.Flags&flagNoRecordedValue != 0 {
+				continue
+			}
+			labels := temporalityLabels(pointLabels(base, p.Attributes), m.Histogram.AggregationTemporality)
+			ts := c.timestamp(p.TimeUnixNano)
+			var cumulative uint64
+			for j, bound := range p.ExplicitBounds {
+				if j < len(p.BucketCounts) {
+					cumulative += uint64(p.BucketCounts[j])
+				}
+				c.add(labels, name+"_bucket", float64(cumulative), ts, bucketLabel, formatFloat(float64(bound)))
+			}
+			c.add(labels, name+"_bucket", float64(p.Count), ts, bucketLabel, "+Inf")
+			c.totals(labels, name, ts, uint64(p.Count), p.Sum)
+		}
+	case m.ExponentialHistogram != nil:
+		for i := range m.ExponentialHistogram.DataPoints {
+			p := &m.ExponentialHistogram.DataPoints[i]
+			if p.Flags&flagNoRecordedValue != 0 {
+				continue
+			}
+			labels := temporalityLabels(pointLabels(base, p.Attributes), m.ExponentialHistogram.AggregationTemporality)
+			c.exponentialHistogram(labels, name, p)
+		}
+	case m.Summary != nil:
+		for i := range m.Summary.DataPoints {
+			p := &m.Summary.DataPoints[i]
+			if p.Flags&flagNoRecordedValue != 0 {
+				continue
+			}
+			labels := pointLabels(base, p.Attributes)
+			ts := c.timestamp(p.TimeUnixNano)
+			for _, q := range p.QuantileValues {
+				c.add(labels, name, float64(q.Value), ts, quantileLabel, formatFloat(float64(q.Quantile)))
+			}
+			sum := p.Sum
+			c.totals(labels, name, ts, uint64(p.Count), &sum)
+		}
+	}
+}
+
+// totals adds the _sum and the _count series of a histogram or a summary.
+func (c *converter) totals(labels []prompb.Label, name string, ts int64, count uint64, sum *Float64) {
+	if sum != nil {
+		c.add(labels, name+"_sum", float64(*sum), ts)
+	}
+	c.add(labels, name+"_count", float64(count), ts)
+}
+
+// exponentialHistogram adds the buckets of an exponential histogram from the most negative to the most positive.
+// The bucket of index i of a scale s holds the values in (base^i, base^(i+1)] with base = 2^(2^-s), and the
+// negative buckets hold the opposite values.
+func (c *converter) exponentialHistogram(labels []prompb.Label, name string, p *ExponentialHistogramDataPoint) {
+	ts := c.timestamp(p.TimeUnixNano)
+	base := math.Pow(2, math.Pow(2, -float64(p.Scale)))
+	var cumulative uint64
+	for j := len(p.Negative.BucketCounts) - 1; j >= 0; j-- {
+		cumulative += uint64(p.Negative.BucketCounts[j])
+		bound := -math.Pow(base, float64(int(p.Negative.Offset)+j))
+		c.add(labels, name+"_bucket", float64(cumulative), ts, bucketLabel, formatFloat(bound))
+	}
+	cumulative += uint64(p.ZeroCount)
+	c.add(labels, name+"_bucket", float64(cumulative), ts, bucketLabel, formatFloat(float64(p.ZeroThreshold)))
+	for j := range p.Positive.BucketCounts {
+		cumulative += uint64(p.Positive.BucketCounts[j])
+		bound := math.Pow(base, float64(int(p.Positive.Offset)+j+1))
+		c.add(labels, name+"_bucket", float64(cumulative), ts, bucketLabel, formatFloat(bound))
+	}
+	c.add(labels, name+"_bucket", float64(p.Count), ts, bucketLabel, "+Inf")
+	c.totals(labels, name, ts, uint64(p.Count), p.Sum)
+}
+
+func resourceLabels(attrs []KeyValue) []prompb.Label {
+	labels := make([]prompb.Label, 0, len(attrs)+2)
+	var service, namespace string
+	for i := range attrs {
+		v := attrs[i].Value.String()
+		switch attrs[i].Key {
+		case serviceNameAttr:
+			service = v
+		case serviceNamespaceAttr:
+			namespace = v
+		case serviceInstanceIDAttr:
+			labels = setLabel(labels, instanceLabel, v)
+		}
+		labels = setLabel(labels, sanitizeLabel(attrs[i].Key), v)
+	}
+	if service != "" {
+		if namespace != "" {
+			service = namespace + "/" + service
+		}
+		labels = setLabel(labels, jobLabel, service)
+	}
+	return labels
+}
+
+func pointLabels(base []prompb.Label, attrs []KeyValue) []prompb.Label {
+	labels := append(make([]prompb.Label, 0, len(base)+len(attrs)), base...)
+	for i := range attrs {
+		labels = setLabel(labels, sanitizeLabel(attrs[i].Key), attrs[i].Value.String())
+	}
+	return labels
+}
+
+func temporalityLabels(labels []prompb.Label, t Temporality) []prompb.Label {
+	if t == TemporalityDelta {
+		return setLabel(labels, temporalityLabel, "delta")
+	}
+	return labels
+}
+
+func setLabel(labels []prompb.Label, name, value string) []prompb.Label {
+	if value == "" {
+		return labels
+	}
+	for i := range labels {
+		if labels[i].Name == name {
+			labels[i].Value = value
+			return labels
+		}
+	}
+	return append(labels, prompb.Label{Name: name, Value: value})
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// sanitizeName replaces the characters not allowed in a Prometheus metric name by underscores.
+func sanitizeName(name string) string {
+	return sanitize(name, true)
+}
+
+// sanitizeLabel replaces the characters not allowed in a Prometheus label name by underscores.
+func sanitizeLabel(name string) string {
+	return sanitize(name, false)
+}
+
+func sanitize(name string, colon bool) string {
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', colon && r == ':':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
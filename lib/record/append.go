@@ -30,6 +30,7 @@ var appendColValPool *AppendColValPool
 func init() {
 	typeSize = map[int]int{
 		influx.Field_Type_Int:     Int64SizeBytes,
+		influx.Field_Type_UInt:    Uint64SizeBytes,
 		influx.Field_Type_Float:   Float64SizeBytes,
 		influx.Field_Type_Boolean: BooleanSizeBytes,
 	}
@@ -180,7 +181,7 @@ func (cv *AppendColVal) AppendSequence(src *AppendColVal, typ, limit int) {
 	switch typ {
 	case influx.Field_Type_String:
 		cv.val.appendStringCol(src.val, src.offset, limit)
-	case influx.Field_Type_Int, influx.Field_Type_Float, influx.Field_Type_Boolean:
+	case influx.Field_Type_Int, influx.Field_Type_UInt, influx.Field_Type_Float, influx.Field_Type_Boolean:
 		if valid > 0 {
 			cv.val.appendBytes(src.val, typ, src.valid, src.valid+valid)
 		}
@@ -323,7 +324,7 @@ func (cv *AppendColVal) Append(src *AppendColVal, typ, offset, limit int) {
 	switch typ {
 	case influx.Field_Type_String:
 		cv.val.appendStringCol(src.val, offset, limit)
-	case influx.Field_Type_Int, influx.Field_Type_Float, influx.Field_Type_Boolean:
+	case influx.Field_Type_Int, influx.Field_Type_UInt, influx.Field_Type_Float, influx.Field_Type_Boolean:
 		if end > start {
 			cv.val.appendBytes(src.val, typ, start, end)
 		}
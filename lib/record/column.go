@@ -100,7 +100,7 @@ func (cv *ColVal) Append(value []byte, offsets []uint32, bitMap []byte, bitOffse
 	} else {
 		startOffset, endOffset = valueIndexRange(bitMap, bitOffset, start, end)
 	}
-	if colType == influx.Field_Type_Int {
+	if colType == influx.Field_Type_Int || colType == influx.Field_Type_UInt {
 		cv.Val = append(cv.Val, value[startOffset*Int64SizeBytes:endOffset*Int64SizeBytes]...)
 	} else if colType == influx.Field_Type_Float {
 		cv.Val = append(cv.Val, value[startOffset*Float64SizeBytes:endOffset*Float64SizeBytes]...)
@@ -264,7 +264,7 @@ func (cv *ColVal) reserveValOffset(size int) {
 
 func (cv *ColVal) sliceValAndOffset(srcCol *ColVal, start, end, colType, valOffset int) (offset int, valueValidCount int) {
 	var validCount, endOffset int
-	if colType == influx.Field_Type_Int {
+	if colType == influx.Field_Type_Int || colType == influx.Field_Type_UInt {
 		validCount = srcCol.ValidCount(start, end)
 		endOffset = valOffset + Int64SizeBytes*validCount
 		cv.Val = srcCol.Val[valOffset:endOffset]
@@ -361,6 +361,20 @@ func (cv *ColVal) AppendInteger(v int64) {
 	cv.Len++
 }
 
+func (cv *ColVal) AppendUnsigneds(values ...uint64) {
+	for _, v := range values {
+		cv.AppendUnsigned(v)
+	}
+}
+
+func (cv *ColVal) AppendUnsigned(v uint64) {
+	index := len(cv.Val)
+	cv.reserveVal(Uint64SizeBytes)
+	*(*uint64)(unsafe.Pointer(&cv.Val[index])) = v
+	cv.setBitMap(cv.Len)
+	cv.Len++
+}
+
 func (cv *ColVal) AppendFloats(values ...float64) {
 	for _, v := range values {
 		cv.AppendFloat(v)
@@ -421,6 +435,12 @@ func (cv *ColVal) AppendIntegerNulls(count int) {
 	}
 }
 
+func (cv *ColVal) AppendUnsignedNulls(count int) {
+	for i := 0; i < count; i++ {
+		cv.AppendUnsignedNull()
+	}
+}
+
 func (cv *ColVal) AppendFloatNulls(count int) {
 	for i := 0; i < count; i++ {
 		cv.AppendFloatNull()
@@ -445,6 +465,12 @@ func (cv *ColVal) AppendIntegerNull() {
 	cv.NilCount++
 }
 
+func (cv *ColVal) AppendUnsignedNull() {
+	cv.resetBitMap(cv.Len)
+	cv.Len++
+	cv.NilCount++
+}
+
 func (cv *ColVal) AppendFloatNull() {
 	cv.resetBitMap(cv.Len)
 	cv.Len++
@@ -469,6 +495,10 @@ func (cv *ColVal) IntegerValues() []int64 {
 	return Bytes2Int64Slice(cv.Val)
 }
 
+func (cv *ColVal) UnsignedValues() []uint64 {
+	return Bytes2Uint64Slice(cv.Val)
+}
+
 func (cv *ColVal) FloatValues() []float64 {
 	return Bytes2Float64Slice(cv.Val)
 }
@@ -519,7 +549,7 @@ func (cv *ColVal) getValIndexRange(bmStart, bmEnd int) (valStart, valEnd int) {
 
 func (cv *ColVal) calcColumnOffset(ty int, start int) int {
 	var colValOffset int
-	if ty == influx.Field_Type_Int {
+	if ty == influx.Field_Type_Int || ty == influx.Field_Type_UInt {
 		colValOffset, _ = cv.getValIndexRange(start, start)
 		colValOffset = colValOffset * Int64SizeBytes
 	} else if ty == influx.Field_Type_Float {
@@ -590,6 +620,50 @@ func (cv *ColVal) MaxIntegerValue(values []int64, start, end int) (int64, int) {
 	return max, row
 }
 
+func (cv *ColVal) MaxUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	if len(values) == 0 {
+		return 0, -1
+	}
+
+	var (
+		max        uint64
+		skip, vIdx int
+	)
+	row := -1
+	if cv.NilCount == 0 {
+		max = values[start]
+		row = start
+		for i := start; i < end; i++ {
+			if max < values[i] {
+				max = values[i]
+				row = i
+			}
+		}
+		return max, row
+	}
+
+	if cv.NilCount > 0 {
+		skip = cv.ValidCount(0, start)
+	}
+
+	vIdx = skip
+	for i := start; i < end && len(values[vIdx:]) > 0; i++ {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		if vIdx == skip {
+			max = values[vIdx]
+			row = i
+		} else if max < values[vIdx] {
+			max = values[vIdx]
+			row = i
+		}
+		vIdx++
+	}
+	return max, row
+}
+
 func (cv *ColVal) MaxFloatValue(values []float64, start, end int) (float64, int) {
 	if len(values) == 0 {
 		return 0, -1
@@ -722,6 +796,50 @@ func (cv *ColVal) MinIntegerValue(values []int64, start, end int) (int64, int) {
 	return min, row
 }
 
+func (cv *ColVal) MinUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	if len(values) == 0 {
+		return 0, -1
+	}
+
+	var (
+		min        uint64
+		skip, vIdx int
+	)
+	row := -1
+	if cv.NilCount == 0 {
+		min = values[start]
+		row = start
+		for i := start; i < end; i++ {
+			if min > values[i] {
+				min = values[i]
+				row = i
+			}
+		}
+		return min, row
+	}
+
+	if cv.NilCount > 0 {
+		skip = cv.ValidCount(0, start)
+	}
+
+	vIdx = skip
+	for i := start; i < end && len(values[vIdx:]) > 0; i++ {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		if vIdx == skip {
+			min = values[vIdx]
+			row = i
+		} else if min > values[vIdx] {
+			min = values[vIdx]
+			row = i
+		}
+		vIdx++
+	}
+	return min, row
+}
+
 func (cv *ColVal) MinFloatValue(values []float64, start, end int) (float64, int) {
 	if len(values) == 0 {
 		return 0, -1
@@ -843,6 +961,39 @@ func (cv *ColVal) FirstIntegerValue(values []int64, start, end int) (int64, int)
 	return first, row
 }
 
+func (cv *ColVal) FirstUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	if len(values) == 0 {
+		return 0, -1
+	}
+
+	var (
+		first      uint64
+		skip, vIdx int
+	)
+	row := -1
+	if cv.NilCount == 0 {
+		first = values[start]
+		row = start
+		return first, row
+	}
+
+	if cv.NilCount > 0 {
+		skip = cv.ValidCount(0, start)
+	}
+
+	vIdx = skip
+	for i := start; i < end && len(values[vIdx:]) > 0; i++ {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		first = values[vIdx]
+		row = i
+		break
+	}
+	return first, row
+}
+
 func (cv *ColVal) FirstFloatValue(values []float64, start, end int) (float64, int) {
 	if len(values) == 0 {
 		return 0, -1
@@ -971,6 +1122,35 @@ func (cv *ColVal) LastIntegerValue(values []int64, start, end int) (int64, int)
 	return last, row
 }
 
+func (cv *ColVal) LastUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	if len(values) == 0 {
+		return 0, -1
+	}
+
+	var last uint64
+	row := -1
+	if cv.NilCount == 0 {
+		last = values[end-1]
+		row = end - 1
+		return last, row
+	}
+
+	for i := end - 1; i >= start; i-- {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		row = i
+		break
+	}
+	if row < start {
+		return last, -1
+	}
+	vIdx := cv.ValidCount(0, row)
+	last = values[vIdx]
+	return last, row
+}
+
 func (cv *ColVal) LastFloatValue(values []float64, start, end int) (float64, int) {
 	if len(values) == 0 {
 		return 0, -1
@@ -1092,6 +1272,14 @@ func (cv *ColVal) IntegerValue(i int) (int64, bool) {
 	return cv.IntegerValues()[cv.ValidCount(0, i)], isNil
 }
 
+func (cv *ColVal) UnsignedValue(i int) (uint64, bool) {
+	isNil := cv.IsNil(i)
+	if isNil {
+		return 0, isNil
+	}
+	return cv.UnsignedValues()[cv.ValidCount(0, i)], isNil
+}
+
 func (cv *ColVal) FloatValue(i int) (float64, bool) {
 	isNil := cv.IsNil(i)
 	if isNil {
@@ -1225,6 +1413,57 @@ func (cv *ColVal) MaxIntegerValues(values []int64, start, end int) (int64, []int
 	return max, row
 }
 
+func (cv *ColVal) MaxUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	var row []int
+	if len(values) == 0 {
+		return 0, row
+	}
+
+	var (
+		max        uint64
+		skip, vIdx int
+	)
+
+	if cv.NilCount == 0 {
+		max = values[start]
+		row = append(row, start)
+		for i := start; i < end; i++ {
+			if max < values[i] {
+				max = values[i]
+				row = row[:0]
+				row = append(row, i)
+			} else if max == values[i] && i != start {
+				row = append(row, i)
+			}
+		}
+		return max, row
+	}
+
+	if cv.NilCount > 0 {
+		skip = cv.ValidCount(0, start)
+	}
+
+	vIdx = skip
+	for i := start; i < end && len(values[vIdx:]) > 0; i++ {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		if vIdx == skip {
+			max = values[vIdx]
+			row = append(row, i)
+		} else if max < values[vIdx] {
+			max = values[vIdx]
+			row = row[:0]
+			row = append(row, i)
+		} else if max == values[vIdx] {
+			row = append(row, i)
+		}
+		vIdx++
+	}
+	return max, row
+}
+
 func (cv *ColVal) MaxFloatValues(values []float64, start, end int) (float64, []int) {
 	var row []int
 	if len(values) == 0 {
@@ -1376,6 +1615,56 @@ func (cv *ColVal) MinIntegerValues(values []int64, start, end int) (int64, []int
 	return min, row
 }
 
+func (cv *ColVal) MinUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	var row []int
+	if len(values) == 0 {
+		return 0, row
+	}
+
+	var (
+		min        uint64
+		skip, vIdx int
+	)
+	if cv.NilCount == 0 {
+		min = values[start]
+		row = append(row, start)
+		for i := start; i < end; i++ {
+			if min > values[i] {
+				min = values[i]
+				row = row[:0]
+				row = append(row, i)
+			} else if min == values[i] && i != start {
+				row = append(row, i)
+			}
+		}
+		return min, row
+	}
+
+	if cv.NilCount > 0 {
+		skip = cv.ValidCount(0, start)
+	}
+
+	vIdx = skip
+	for i := start; i < end && len(values[vIdx:]) > 0; i++ {
+		idx := cv.BitMapOffset + i
+		if cv.Bitmap[idx>>3]&BitMask[idx&0x07] == 0 {
+			continue
+		}
+		if vIdx == skip {
+			min = values[vIdx]
+			row = append(row, i)
+		} else if min > values[vIdx] {
+			min = values[vIdx]
+			row = row[:0]
+			row = append(row, i)
+		} else if min == values[vIdx] {
+			row = append(row, i)
+		}
+		vIdx++
+	}
+	return min, row
+}
+
 func (cv *ColVal) MinFloatValues(values []float64, start, end int) (float64, []int) {
 	var row []int
 	if len(values) == 0 {
@@ -17,6 +17,7 @@ limitations under the License.
 package record_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/openGemini/openGemini/lib/record"
@@ -305,3 +306,57 @@ func TestAggValues(t *testing.T) {
 		}
 	}
 }
+
+func TestUnsignedValues(t *testing.T) {
+	col := &record.ColVal{}
+	col.AppendUnsigneds(1, math.MaxUint64)
+	col.AppendUnsignedNull()
+	col.AppendUnsigned(3)
+
+	if col.Len != 4 || col.NilCount != 1 {
+		t.Fatalf("unexpected len %d and nil count %d", col.Len, col.NilCount)
+	}
+	values := col.UnsignedValues()
+	if len(values) != 3 || values[1] != math.MaxUint64 || values[2] != 3 {
+		t.Fatalf("unexpected values %v", values)
+	}
+	if v, isNil := col.UnsignedValue(1); isNil || v != math.MaxUint64 {
+		t.Fatalf("unexpected value %d at 1", v)
+	}
+	if _, isNil := col.UnsignedValue(2); !isNil {
+		t.Fatalf("expect a nil value at 2")
+	}
+
+	dst := &record.ColVal{}
+	dst.AppendColVal(col, influx.Field_Type_UInt, 1, 4)
+	if v, isNil := dst.UnsignedValue(2); dst.Len != 3 || isNil || v != 3 {
+		t.Fatalf("unexpected appended column %v", dst.UnsignedValues())
+	}
+}
+
+func TestUnsignedAggValues(t *testing.T) {
+	col := &record.ColVal{}
+	col.AppendUnsigneds(7, math.MaxUint64)
+	col.AppendUnsignedNull()
+	col.AppendUnsigneds(3, math.MaxUint64)
+	values := col.UnsignedValues()
+
+	if v, row := col.MaxUnsignedValue(values, 0, col.Len); v != math.MaxUint64 || row != 1 {
+		t.Fatalf("unexpected max value %d at %d", v, row)
+	}
+	if v, row := col.MinUnsignedValue(values, 0, col.Len); v != 3 || row != 3 {
+		t.Fatalf("unexpected min value %d at %d", v, row)
+	}
+	if v, row := col.FirstUnsignedValue(values, 0, col.Len); v != 7 || row != 0 {
+		t.Fatalf("unexpected first value %d at %d", v, row)
+	}
+	if v, row := col.LastUnsignedValue(values, 0, col.Len); v != math.MaxUint64 || row != 4 {
+		t.Fatalf("unexpected last value %d at %d", v, row)
+	}
+	if v, rows := col.MaxUnsignedValues(values, 0, col.Len); v != math.MaxUint64 || len(rows) != 2 || rows[0] != 1 || rows[1] != 4 {
+		t.Fatalf("unexpected max values %d at %v", v, rows)
+	}
+	if v, rows := col.MinUnsignedValues(values, 0, col.Len); v != 3 || len(rows) != 1 || rows[0] != 3 {
+		t.Fatalf("unexpected min values %d at %v", v, rows)
+	}
+}
@@ -68,6 +68,51 @@ func getIntegerFirstLastImp(dscRe, re *Record, index int, compare func(a, b int6
 	}
 }
 
+func getUnsignedFirstLastImp(dscRe, re *Record, index int, compare func(a, b int64) bool) {
+	times := re.Times()
+	var row int
+	var value uint64
+	var t int64
+	defer func() {
+		dscRe.AppendRecForAggTagSet(re, row, row+1)
+	}()
+	values := re.ColVals[index].UnsignedValues()
+	if re.ColVals[index].NilCount != 0 {
+		for i := range times {
+			if v, isNil := re.ColVals[index].UnsignedValue(i); !isNil {
+				row = i
+				value = v
+				t = times[i]
+				break
+			}
+		}
+
+		start := row
+		for i := start; i < re.RowNums(); i++ {
+			isNil := re.ColVals[index].IsNil(i)
+			if !isNil {
+				v := values[re.ColVals[index].ValidCount(0, i)]
+				if compare(times[i], t) || (times[i] == t && value < v) {
+					row = i
+					value = v
+					t = times[i]
+				}
+			}
+		}
+		return
+	}
+	t = times[0]
+	value = values[0]
+	row = 0
+	for i := 1; i < re.RowNums(); i++ {
+		if compare(times[i], t) || (times[i] == t && value < values[i]) {
+			row = i
+			value = values[i]
+			t = times[i]
+		}
+	}
+}
+
 func getFloatFirstLastImp(dscRe, re *Record, index int, compare func(a, b int64) bool) {
 	times := re.Times()
 	var row int
@@ -206,6 +251,12 @@ func GetRecordIntegerLast(dscRe, re *Record, index int) {
 	})
 }
 
+func GetRecordUnsignedLast(dscRe, re *Record, index int) {
+	getUnsignedFirstLastImp(dscRe, re, index, func(a, b int64) bool {
+		return a > b
+	})
+}
+
 func GetRecordFloatLast(dscRe, re *Record, index int) {
 	getFloatFirstLastImp(dscRe, re, index, func(a, b int64) bool {
 		return a > b
@@ -229,6 +280,12 @@ func GetRecordIntegerFirst(dscRe, re *Record, index int) {
 	})
 }
 
+func GetRecordUnsignedFirst(dscRe, re *Record, index int) {
+	getUnsignedFirstLastImp(dscRe, re, index, func(a, b int64) bool {
+		return a < b
+	})
+}
+
 func GetRecordFloatFirst(dscRe, re *Record, index int) {
 	getFloatFirstLastImp(dscRe, re, index, func(a, b int64) bool {
 		return a < b
@@ -294,6 +351,55 @@ func getColumnIntegerFirstLastImp(dscRe, re *Record, index int, compare func(a,
 	dscRe.ColVals[index].AppendInteger(value)
 }
 
+func getColumnUnsignedFirstLastImp(dscRe, re *Record, index int, compare func(a, b int64) bool) {
+	times := re.RecMeta.Times[index]
+	var t int64
+	defer func() {
+		dscRe.RecMeta.Times[index] = append(dscRe.RecMeta.Times[index], t)
+	}()
+	values := re.ColVals[index].UnsignedValues()
+	var value uint64
+	var start int
+	if re.ColVals[index].NilCount != 0 {
+		for i := range times {
+			isNil := re.ColVals[index].IsNil(i)
+			if !isNil {
+				v := values[re.ColVals[index].ValidCount(0, i)]
+				value = v
+				t = times[i]
+				start = i
+				break
+			}
+			if i == len(times)-1 {
+				dscRe.ColVals[index].AppendUnsignedNull()
+				return
+			}
+		}
+		for i := start; i < re.RowNums(); i++ {
+			isNil := re.ColVals[index].IsNil(i)
+			if !isNil {
+				v := values[re.ColVals[index].ValidCount(0, i)]
+				if compare(times[i], t) || (times[i] == t && value < v) {
+					value = v
+					t = times[i]
+				}
+			}
+		}
+		dscRe.ColVals[index].AppendUnsigned(value)
+		return
+	}
+
+	t = times[0]
+	value = values[0]
+	for i := 1; i < re.RowNums(); i++ {
+		if compare(times[i], t) || (times[i] == t && value < values[i]) {
+			value = values[i]
+			t = times[i]
+		}
+	}
+	dscRe.ColVals[index].AppendUnsigned(value)
+}
+
 func getColumnFloatFirstLastImp(dscRe, re *Record, index int, compare func(a, b int64) bool) {
 	times := re.RecMeta.Times[index]
 	var t int64
@@ -443,6 +549,11 @@ func GetRecordColumnIntegerFirst(dscRe, re *Record, index int) {
 		return a < b
 	})
 }
+func GetRecordColumnUnsignedFirst(dscRe, re *Record, index int) {
+	getColumnUnsignedFirstLastImp(dscRe, re, index, func(a, b int64) bool {
+		return a < b
+	})
+}
 func GetRecordColumnFloatFirst(dscRe, re *Record, index int) {
 	getColumnFloatFirstLastImp(dscRe, re, index, func(a, b int64) bool {
 		return a < b
@@ -464,6 +575,11 @@ func GetRecordColumnIntegerLast(dscRe, re *Record, index int) {
 		return a > b
 	})
 }
+func GetRecordColumnUnsignedLast(dscRe, re *Record, index int) {
+	getColumnUnsignedFirstLastImp(dscRe, re, index, func(a, b int64) bool {
+		return a > b
+	})
+}
 func GetRecordColumnFloatLast(dscRe, re *Record, index int) {
 	getColumnFloatFirstLastImp(dscRe, re, index, func(a, b int64) bool {
 		return a > b
@@ -490,6 +606,16 @@ func GetRecordColumnIntegerMin(dstRe, re *Record, index int) {
 	dstRe.ColVals[index].AppendInteger(v)
 }
 
+func GetRecordColumnUnsignedMin(dstRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	v, row := re.ColVals[index].MinUnsignedValue(values, 0, re.RowNums())
+	if row == -1 {
+		dstRe.ColVals[index].AppendUnsignedNull()
+		return
+	}
+	dstRe.ColVals[index].AppendUnsigned(v)
+}
+
 func GetRecordColumnFloatMin(dstRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	v, row := re.ColVals[index].MinFloatValue(values, 0, re.RowNums())
@@ -519,6 +645,15 @@ func GetRecordColumnIntegerMax(dstRe, re *Record, index int) {
 	}
 	dstRe.ColVals[index].AppendInteger(v)
 }
+func GetRecordColumnUnsignedMax(dstRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	v, row := re.ColVals[index].MaxUnsignedValue(values, 0, re.RowNums())
+	if row == -1 {
+		dstRe.ColVals[index].AppendUnsignedNull()
+		return
+	}
+	dstRe.ColVals[index].AppendUnsigned(v)
+}
 func GetRecordColumnFloatMax(dstRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	v, row := re.ColVals[index].MaxFloatValue(values, 0, re.RowNums())
@@ -570,6 +705,12 @@ func GetRecordIntegerMin(dscRe, re *Record, index int) {
 	getRecordMinMaxImp(dscRe, re, rows)
 }
 
+func GetRecordUnsignedMin(dscRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	_, rows := re.ColVals[index].MinUnsignedValues(values, 0, re.RowNums())
+	getRecordMinMaxImp(dscRe, re, rows)
+}
+
 func GetRecordFloatMin(dscRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	_, rows := re.ColVals[index].MinFloatValues(values, 0, re.RowNums())
@@ -588,6 +729,12 @@ func GetRecordIntegerMax(dscRe, re *Record, index int) {
 	getRecordMinMaxImp(dscRe, re, rows)
 }
 
+func GetRecordUnsignedMax(dscRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	_, rows := re.ColVals[index].MaxUnsignedValues(values, 0, re.RowNums())
+	getRecordMinMaxImp(dscRe, re, rows)
+}
+
 func GetRecordFloatMax(dscRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	_, rows := re.ColVals[index].MaxFloatValues(values, 0, re.RowNums())
@@ -605,6 +752,12 @@ func GetRecordColumnIntegerSum(dstRe, re *Record, index int) {
 	dstRe.ColVals[index].AppendInteger(v)
 }
 
+func GetRecordColumnUnsignedSum(dstRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	v := unsignedSum(values)
+	dstRe.ColVals[index].AppendUnsigned(v)
+}
+
 func GetRecordColumnFloatSum(dstRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	v := floatSum(values)
@@ -627,6 +780,12 @@ func GetRecordIntegerSum(dscRe, re *Record, index int) {
 	dscRe.ColVals[index].AppendInteger(v)
 	dscRe.AppendTime(re.Time(0))
 }
+func GetRecordUnsignedSum(dscRe, re *Record, index int) {
+	values := re.ColVals[index].UnsignedValues()
+	v := unsignedSum(values)
+	dscRe.ColVals[index].AppendUnsigned(v)
+	dscRe.AppendTime(re.Time(0))
+}
 func GetRecordFloatSum(dscRe, re *Record, index int) {
 	values := re.ColVals[index].FloatValues()
 	v := floatSum(values)
@@ -667,3 +826,11 @@ func integerSum(values []int64) int64 {
 	}
 	return value
 }
+
+func unsignedSum(values []uint64) uint64 {
+	var value uint64
+	for _, v := range values {
+		value += v
+	}
+	return value
+}
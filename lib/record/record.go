@@ -347,6 +347,8 @@ func (rec *Record) ReserveSchemaAndColVal(size int) {
 func (rec *Record) ColumnAppendNull(colIdx int) {
 	if rec.Schema[colIdx].Type == influx.Field_Type_Int {
 		rec.ColVals[colIdx].AppendIntegerNull()
+	} else if rec.Schema[colIdx].Type == influx.Field_Type_UInt {
+		rec.ColVals[colIdx].AppendUnsignedNull()
 	} else if rec.Schema[colIdx].Type == influx.Field_Type_Float {
 		rec.ColVals[colIdx].AppendFloatNull()
 	} else if rec.Schema[colIdx].Type == influx.Field_Type_Boolean {
@@ -1284,6 +1286,8 @@ func (rec *Record) String() string {
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).BooleanValues())
 		case influx.Field_Type_Int:
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).IntegerValues())
+		case influx.Field_Type_UInt:
+			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).UnsignedValues())
 		}
 
 		sb.WriteString(line)
@@ -1298,7 +1302,7 @@ func (rec *Record) ReserveColumnRows(rows int) {
 		col := &rec.ColVals[i]
 		l := len(col.Val)
 		switch schema.Type {
-		case influx.Field_Type_Float, influx.Field_Type_Int:
+		case influx.Field_Type_Float, influx.Field_Type_Int, influx.Field_Type_UInt:
 			size := rows * 8
 			if cap(col.Val) < size {
 				newCol := make([]byte, size)
@@ -1402,6 +1406,8 @@ func (rec *Record) addColumn(f *Field) {
 		switch f.Type {
 		case influx.Field_Type_Int:
 			newCol.AppendIntegerNull()
+		case influx.Field_Type_UInt:
+			newCol.AppendUnsignedNull()
 		case influx.Field_Type_Float:
 			newCol.AppendFloatNull()
 		case influx.Field_Type_Boolean:
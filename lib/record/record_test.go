@@ -3077,3 +3077,39 @@ func TestGetFirstLastImp(t *testing.T) {
 	record.GetRecordBooleanLast(r2, rec, 3)
 	assert.Equal(t, r1.RecMeta.Times, r2.RecMeta.Times)
 }
+
+func TestGetRecordUnsignedAgg(t *testing.T) {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_UInt, Name: "unsigned"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	rec := record.NewRecord(schema, false)
+	rec.ColVals[0].AppendUnsigned(7)
+	rec.ColVals[0].AppendUnsignedNull()
+	rec.ColVals[0].AppendUnsigned(3)
+	rec.AppendTime(1, 2, 3)
+	rec.RecMeta = &record.RecMeta{Times: [][]int64{{1, 2, 3}}}
+
+	for _, c := range []struct {
+		fn    func(dst, re *record.Record, index int)
+		colFn func(dst, re *record.Record, index int)
+		value uint64
+		time  int64
+	}{
+		{record.GetRecordUnsignedFirst, record.GetRecordColumnUnsignedFirst, 7, 1},
+		{record.GetRecordUnsignedLast, record.GetRecordColumnUnsignedLast, 3, 3},
+		{record.GetRecordUnsignedMin, record.GetRecordColumnUnsignedMin, 3, 3},
+		{record.GetRecordUnsignedMax, record.GetRecordColumnUnsignedMax, 7, 1},
+		{record.GetRecordUnsignedSum, record.GetRecordColumnUnsignedSum, 10, 1},
+	} {
+		dst := record.NewRecord(schema, false)
+		c.fn(dst, rec, 0)
+		assert.Equal(t, dst.ColVals[0].UnsignedValues(), []uint64{c.value})
+		assert.Equal(t, dst.Times(), []int64{c.time})
+
+		dst = record.NewRecord(schema, false)
+		dst.RecMeta = &record.RecMeta{Times: make([][]int64, 1)}
+		c.colFn(dst, rec, 0)
+		assert.Equal(t, dst.ColVals[0].UnsignedValues(), []uint64{c.value})
+	}
+}
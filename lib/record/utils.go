@@ -64,6 +64,8 @@ func ToModelTypes(ty influxql.DataType) int {
 		return influx.Field_Type_String
 	case influxql.Integer:
 		return influx.Field_Type_Int
+	case influxql.Unsigned:
+		return influx.Field_Type_UInt
 	case influxql.Float:
 		return influx.Field_Type_Float
 	case influxql.Boolean:
@@ -83,6 +85,8 @@ func ToInfluxqlTypes(ty int) influxql.DataType {
 		return influxql.Tag
 	case influx.Field_Type_Int:
 		return influxql.Integer
+	case influx.Field_Type_UInt:
+		return influxql.Unsigned
 	case influx.Field_Type_Float:
 		return influxql.Float
 	case influx.Field_Type_Boolean:
@@ -47,6 +47,29 @@ type HandlerStatistics struct {
 	WriteCreateSgDuration        int64
 	WriteUnmarshalSkDuration     int64
 	WriteStoresDuration          int64
+	WriteRateLimited             int64
+	QueryRateLimited             int64
+	OTLPWriteRequests            int64
+
+	OpenTSDBConnsActive     int64
+	OpenTSDBHTTPRequests    int64
+	OpenTSDBPointsReceived  int64
+	OpenTSDBBadPoints       int64
+	OpenTSDBPointsWritten   int64
+	OpenTSDBPointsWriteFail int64
+
+	GraphiteConnsActive     int64
+	GraphitePointsReceived  int64
+	GraphiteBadLines        int64
+	GraphitePointsWritten   int64
+	GraphitePointsWriteFail int64
+
+	UDPPacketsReceived  int64
+	UDPBytesReceived    int64
+	UDPPacketsDropped   int64
+	UDPPacketsParseFail int64
+	UDPPointsWritten    int64
+	UDPPointsWriteFail  int64
 }
 
 const (
@@ -83,6 +106,29 @@ const (
 	statWriteCreateSgDuration        = "writeCreateSgDurationNs"
 	statWriteUnmarshalSkDuration     = "writeUnmarshalSkDurationNs"
 	statWriteWriteStoresDuration     = "writeStoresDurationNs"
+	statWriteRateLimited             = "writeRateLimited" // Number of write requests rejected by the rate limits.
+	statQueryRateLimited             = "queryRateLimited" // Number of query requests rejected by the rate limits.
+	statOTLPWriteRequest             = "otlpWriteReq"     // Number of OTLP/HTTP metrics export requests served.
+
+	statOpenTSDBConnsActive     = "openTSDBConnActive"      // Number of currently active OpenTSDB telnet connections.
+	statOpenTSDBHTTPRequests    = "openTSDBHTTPReq"         // Number of OpenTSDB /api/put requests served.
+	statOpenTSDBPointsReceived  = "openTSDBPointsRecv"      // Number of OpenTSDB points received.
+	statOpenTSDBBadPoints       = "openTSDBBadPoints"       // Number of OpenTSDB points that cannot be parsed.
+	statOpenTSDBPointsWritten   = "openTSDBPointsWritten"   // Number of OpenTSDB points written.
+	statOpenTSDBPointsWriteFail = "openTSDBPointsWriteFail" // Number of OpenTSDB points that failed to be written.
+
+	statGraphiteConnsActive     = "graphiteConnActive"      // Number of currently active Graphite TCP connections.
+	statGraphitePointsReceived  = "graphitePointsRecv"      // Number of Graphite lines received.
+	statGraphiteBadLines        = "graphiteBadLines"        // Number of Graphite lines that cannot be parsed.
+	statGraphitePointsWritten   = "graphitePointsWritten"   // Number of Graphite points written.
+	statGraphitePointsWriteFail = "graphitePointsWriteFail" // Number of Graphite points that failed to be written.
+
+	statUDPPacketsReceived  = "udpPacketsRecv"      // Number of UDP datagrams received.
+	statUDPBytesReceived    = "udpBytesRecv"        // Sum of all bytes in the UDP datagrams received.
+	statUDPPacketsDropped   = "udpPacketsDropped"   // Number of UDP datagrams dropped as too many were waiting to be parsed.
+	statUDPPacketsParseFail = "udpPacketsParseFail" // Number of UDP datagrams that cannot be parsed.
+	statUDPPointsWritten    = "udpPointsWritten"    // Number of UDP points written.
+	statUDPPointsWriteFail  = "udpPointsWriteFail"  // Number of UDP points that failed to be written.
 )
 
 var HandlerStat = NewHandlerStatistics()
@@ -133,6 +179,26 @@ func CollectHandlerStatistics(buffer []byte) ([]byte, error) {
 		statWriteCreateSgDuration:        atomic.LoadInt64(&HandlerStat.WriteCreateSgDuration),
 		statWriteUnmarshalSkDuration:     atomic.LoadInt64(&HandlerStat.WriteUnmarshalSkDuration),
 		statWriteWriteStoresDuration:     atomic.LoadInt64(&HandlerStat.WriteStoresDuration),
+		statWriteRateLimited:             atomic.LoadInt64(&HandlerStat.WriteRateLimited),
+		statQueryRateLimited:             atomic.LoadInt64(&HandlerStat.QueryRateLimited),
+		statOTLPWriteRequest:             atomic.LoadInt64(&HandlerStat.OTLPWriteRequests),
+		statOpenTSDBConnsActive:          atomic.LoadInt64(&HandlerStat.OpenTSDBConnsActive),
+		statOpenTSDBHTTPRequests:         atomic.LoadInt64(&HandlerStat.OpenTSDBHTTPRequests),
+		statOpenTSDBPointsReceived:       atomic.LoadInt64(&HandlerStat.OpenTSDBPointsReceived),
+		statOpenTSDBBadPoints:            atomic.LoadInt64(&HandlerStat.OpenTSDBBadPoints),
+		statOpenTSDBPointsWritten:        atomic.LoadInt64(&HandlerStat.OpenTSDBPointsWritten),
+		statOpenTSDBPointsWriteFail:      atomic.LoadInt64(&HandlerStat.OpenTSDBPointsWriteFail),
+		statGraphiteConnsActive:          atomic.LoadInt64(&HandlerStat.GraphiteConnsActive),
+		statGraphitePointsReceived:       atomic.LoadInt64(&HandlerStat.GraphitePointsReceived),
+		statGraphiteBadLines:             atomic.LoadInt64(&HandlerStat.GraphiteBadLines),
+		statGraphitePointsWritten:        atomic.LoadInt64(&HandlerStat.GraphitePointsWritten),
+		statGraphitePointsWriteFail:      atomic.LoadInt64(&HandlerStat.GraphitePointsWriteFail),
+		statUDPPacketsReceived:           atomic.LoadInt64(&HandlerStat.UDPPacketsReceived),
+		statUDPBytesReceived:             atomic.LoadInt64(&HandlerStat.UDPBytesReceived),
+		statUDPPacketsDropped:            atomic.LoadInt64(&HandlerStat.UDPPacketsDropped),
+		statUDPPacketsParseFail:          atomic.LoadInt64(&HandlerStat.UDPPacketsParseFail),
+		statUDPPointsWritten:             atomic.LoadInt64(&HandlerStat.UDPPointsWritten),
+		statUDPPointsWriteFail:           atomic.LoadInt64(&HandlerStat.UDPPointsWriteFail),
 	}
 
 	buffer = AddPointToBuffer(HandlerStatisticsName, HandlerTagMap, perfValueMap, buffer)
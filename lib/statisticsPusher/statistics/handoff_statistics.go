@@ -0,0 +1,104 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// HintedHandoffNodeStats counts the writes queued for a node that could not be reached
+type HintedHandoffNodeStats struct {
+	QueueBytes     int64
+	WritesQueued   int64
+	WritesReplayed int64
+	ReplayFailures int64
+	WritesDropped  int64
+}
+
+// HintedHandoffStatistics keeps statistics related to the hinted handoff queues
+type HintedHandoffStatistics struct {
+	mu    sync.RWMutex
+	stats map[uint64]*HintedHandoffNodeStats
+}
+
+const (
+	StatHintedHandoffNode = "node"
+
+	StatHintedHandoffQueueBytes     = "queueBytes"
+	StatHintedHandoffWritesQueued   = "writesQueued"
+	StatHintedHandoffWritesReplayed = "writesReplayed"
+	StatHintedHandoffReplayFailures = "replayFailures"
+	StatHintedHandoffWritesDropped  = "writesDropped"
+)
+
+var HintedHandoffStat = NewHintedHandoffStatistics()
+var HintedHandoffTagMap map[string]string
+var HintedHandoffStatisticsName = "hh"
+
+func NewHintedHandoffStatistics() *HintedHandoffStatistics {
+	return &HintedHandoffStatistics{
+		stats: make(map[uint64]*HintedHandoffNodeStats),
+	}
+}
+
+func InitHintedHandoffStatistics(tags map[string]string) {
+	HintedHandoffStat = NewHintedHandoffStatistics()
+	HintedHandoffTagMap = tags
+}
+
+// Register returns the statistics of the queue of a node, the same one is returned while it is registered.
+func (s *HintedHandoffStatistics) Register(nodeID uint64) *HintedHandoffNodeStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat, ok := s.stats[nodeID]
+	if !ok {
+		stat = &HintedHandoffNodeStats{}
+		s.stats[nodeID] = stat
+	}
+	return stat
+}
+
+// Unregister stops reporting the statistics of the queue of a node
+func (s *HintedHandoffStatistics) Unregister(nodeID uint64) {
+	s.mu.Lock()
+	delete(s.stats, nodeID)
+	s.mu.Unlock()
+}
+
+func CollectHintedHandoffStatistics(buffer []byte) ([]byte, error) {
+	HintedHandoffStat.mu.RLock()
+	defer HintedHandoffStat.mu.RUnlock()
+
+	for nodeID, stats := range HintedHandoffStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, HintedHandoffTagMap)
+		tagMap[StatHintedHandoffNode] = strconv.FormatUint(nodeID, 10)
+		valueMap := map[string]interface{}{
+			StatHintedHandoffQueueBytes:     atomic.LoadInt64(&stats.QueueBytes),
+			StatHintedHandoffWritesQueued:   atomic.LoadInt64(&stats.WritesQueued),
+			StatHintedHandoffWritesReplayed: atomic.LoadInt64(&stats.WritesReplayed),
+			StatHintedHandoffReplayFailures: atomic.LoadInt64(&stats.ReplayFailures),
+			StatHintedHandoffWritesDropped:  atomic.LoadInt64(&stats.WritesDropped),
+		}
+
+		buffer = AddPointToBuffer(HintedHandoffStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
@@ -52,6 +52,10 @@ type EngineStatus struct {
 	DropRPCount     int64
 	DropRPDurations int64
 
+	DropSeriesErrs      int64
+	DropSeriesCount     int64
+	DropSeriesDurations int64
+
 	Updated int64
 }
 
@@ -95,6 +99,10 @@ func CollectEngineStatStatistics(buffer []byte) ([]byte, error) {
 		"DropRPErrs":      atomic.LoadInt64(&EngineStat.DropRPErrs),
 		"DropRPCount":     atomic.LoadInt64(&EngineStat.DropRPCount),
 		"DropRPDurations": atomic.LoadInt64(&EngineStat.DropRPDurations),
+
+		"DropSeriesErrs":      atomic.LoadInt64(&EngineStat.DropSeriesErrs),
+		"DropSeriesCount":     atomic.LoadInt64(&EngineStat.DropSeriesCount),
+		"DropSeriesDurations": atomic.LoadInt64(&EngineStat.DropSeriesDurations),
 	}
 	atomic.StoreInt64(&EngineStat.Updated, 0)
 
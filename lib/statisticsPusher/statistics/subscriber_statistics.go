@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SubscriberDestStats counts the writes to a destination of a subscription
+type SubscriberDestStats struct {
+	PointsWritten int64
+	WritesOK      int64
+	WriteFailures int64
+	WritesDropped int64
+}
+
+// SubscriberStatistics keeps statistics related to the destinations of subscriptions
+type SubscriberStatistics struct {
+	mu    sync.RWMutex
+	stats map[subscriberDest]*SubscriberDestStats
+}
+
+type subscriberDest struct {
+	database    string
+	rp          string
+	name        string
+	destination string
+}
+
+const (
+	StatSubscriberDatabase        = "database"
+	StatSubscriberRetentionPolicy = "retention_policy"
+	StatSubscriberName            = "name"
+	StatSubscriberDestination     = "destination"
+
+	StatSubscriberPointsWritten = "pointsWritten"
+	StatSubscriberWritesOK      = "writesOK"
+	StatSubscriberWriteFailures = "writeFailures"
+	StatSubscriberWritesDropped = "writesDropped"
+)
+
+var SubscriberStat = NewSubscriberStatistics()
+var SubscriberTagMap map[string]string
+var SubscriberStatisticsName = "subscriber"
+
+func NewSubscriberStatistics() *SubscriberStatistics {
+	return &SubscriberStatistics{
+		stats: make(map[subscriberDest]*SubscriberDestStats),
+	}
+}
+
+func InitSubscriberStatistics(tags map[string]string) {
+	SubscriberStat = NewSubscriberStatistics()
+	SubscriberTagMap = tags
+}
+
+// Register returns the statistics of a destination, the same one is returned while it is registered.
+func (s *SubscriberStatistics) Register(database, rp, name, destination string) *SubscriberDestStats {
+	key := subscriberDest{database: database, rp: rp, name: name, destination: destination}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat, ok := s.stats[key]
+	if !ok {
+		stat = &SubscriberDestStats{}
+		s.stats[key] = stat
+	}
+	return stat
+}
+
+// Unregister stops reporting the statistics of a destination
+func (s *SubscriberStatistics) Unregister(database, rp, name, destination string) {
+	key := subscriberDest{database: database, rp: rp, name: name, destination: destination}
+
+	s.mu.Lock()
+	delete(s.stats, key)
+	s.mu.Unlock()
+}
+
+func CollectSubscriberStatistics(buffer []byte) ([]byte, error) {
+	SubscriberStat.mu.RLock()
+	defer SubscriberStat.mu.RUnlock()
+
+	for dest, stats := range SubscriberStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, SubscriberTagMap)
+		tagMap[StatSubscriberDatabase] = dest.database
+		tagMap[StatSubscriberRetentionPolicy] = dest.rp
+		tagMap[StatSubscriberName] = dest.name
+		tagMap[StatSubscriberDestination] = dest.destination
+		valueMap := map[string]interface{}{
+			StatSubscriberPointsWritten: atomic.LoadInt64(&stats.PointsWritten),
+			StatSubscriberWritesOK:      atomic.LoadInt64(&stats.WritesOK),
+			StatSubscriberWriteFailures: atomic.LoadInt64(&stats.WriteFailures),
+			StatSubscriberWritesDropped: atomic.LoadInt64(&stats.WritesDropped),
+		}
+
+		buffer = AddPointToBuffer(SubscriberStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
@@ -18,14 +18,18 @@ package syscontrol
 
 import (
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/fileops"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
 )
 
 type SysControl struct {
@@ -54,6 +58,16 @@ curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=snapshot&duration=30m'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=readonly&switchon=true&allnodes=y'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=readonly&switchon=true&host=127.0.0.1'
 
+Backup and restore cmd, the path must be shared by the sql and store nodes:
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=backup&path=/data/backup/20220601'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=backup&path=/data/backup/20220602&base=/data/backup/20220601'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=restore&path=/data/backup/20220601&database=db0&newdatabase=db1&rp=autogen:rp1&nodes=1:4,2:5'
+
+Rate limit cmd, the limits are kept in the meta data and a limit without any rate is removed:
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=ratelimit&db=db0&points=100000&bytes=10485760'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=ratelimit&user=bob&points=10000&bytes=1048576&queries=10'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=ratelimit'
+
 Sql cmd:
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=chunk_reader_parallel&limit=4'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=binary_tree_merge&enabled=1'
@@ -76,6 +90,9 @@ const (
 	Failpoint           = "failpoint"
 	Readonly            = "readonly"
 	LogRows             = "log_rows"
+	DataBackup          = "backup"
+	MetaRestore         = "restore"
+	RateLimit           = "ratelimit"
 )
 
 var (
@@ -149,6 +166,12 @@ func ProcessRequest(req netstorage.SysCtrlRequest, resp *strings.Builder) (err e
 		wg.Wait()
 	case Readonly:
 		return handleSelectedStoreCmd(req, resp)
+	case DataBackup:
+		return handleBackupCmd(req, resp)
+	case MetaRestore:
+		return handleRestoreCmd(req, resp)
+	case RateLimit:
+		return handleRateLimitCmd(req, resp)
 	case ChunkReaderParallel:
 		// sql SysCtrl cmd
 		limit, err := getIntValue(req.Param(), "limit")
@@ -254,6 +277,123 @@ func handleSelectedStoreCmd(req netstorage.SysCtrlRequest, resp *strings.Builder
 	return nil
 }
 
+// handleBackupCmd saves the meta data and then has every data node back up its shards into its own directory under
+// the path, the shards created after the meta data was saved are left out when the backup is restored. With a base
+// backup given, the data nodes only back up the TSSP files not kept by the base.
+func handleBackupCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) error {
+	dir, base := req.Param()["path"], req.Param()["base"]
+	if dir == "" {
+		return fmt.Errorf("no path in parameter")
+	}
+	if _, err := fileops.Stat(dir); err == nil {
+		return backup.ErrBackupExists
+	}
+	if base != "" {
+		if _, err := fileops.Stat(filepath.Join(base, backup.MetaFile)); err != nil {
+			return fmt.Errorf("invalid base backup: %v", err)
+		}
+	}
+	if err := fileops.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	data := SysCtrl.MetaClient.Data()
+	buf, err := data.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if err = fileops.WriteFile(filepath.Join(dir, backup.MetaFile), buf, 0640); err != nil {
+		return err
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, d := range data.DataNodes {
+		wg.Add(1)
+		go func(nid uint64, host string) {
+			defer wg.Done()
+			var nodeReq netstorage.SysCtrlRequest
+			nodeReq.SetMod(req.Mod())
+			param := map[string]string{"path": backup.NodeDir(dir, nid)}
+			if base != "" {
+				param["base"] = backup.NodeDir(base, nid)
+			}
+			nodeReq.SetParam(param)
+			res := sendCmdToStore(nodeReq, nid, host)
+			lock.Lock()
+			resp.WriteString(res)
+			lock.Unlock()
+		}(d.ID, d.Host)
+	}
+	wg.Wait()
+	return nil
+}
+
+// handleRestoreCmd imports a database of the backup into the meta data, the data nodes restore their files by the
+// remapping file written into the backup.
+func handleRestoreCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) error {
+	dir := req.Param()["path"]
+	if dir == "" {
+		return fmt.Errorf("no path in parameter")
+	}
+	r := &backup.Remapping{Database: req.Param()["database"], NewDatabase: req.Param()["newdatabase"]}
+	if r.Database == "" {
+		return fmt.Errorf("no database in parameter")
+	}
+	var err error
+	if r.RetentionPolicies, err = backup.ParseRenames(req.Param()["rp"]); err != nil {
+		return err
+	}
+	if r.Nodes, err = backup.ParseNodes(req.Param()["nodes"]); err != nil {
+		return err
+	}
+
+	data := SysCtrl.MetaClient.Data()
+	if err = backup.RestoreMeta(&data, dir, r); err != nil {
+		return err
+	}
+	if err = r.Write(dir); err != nil {
+		return err
+	}
+	if err = SysCtrl.MetaClient.SetData(&data); err != nil {
+		return err
+	}
+	resp.WriteString(fmt.Sprintf("\n\tsuccess, %d shards and %d indexes of %s restored as %s, remapping in %s",
+		len(r.Shards), len(r.Indexes), r.Database, r.NewDatabase, backup.RemappingFile(dir, r.NewDatabase)))
+	return nil
+}
+
+// handleRateLimitCmd sets the rate limit of a database or of a user, or lists the limits if neither is given.
+func handleRateLimitCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) error {
+	rl := meta2.RateLimitInfo{Database: req.Param()["db"], User: req.Param()["user"]}
+	if rl.Database == "" && rl.User == "" {
+		for _, l := range SysCtrl.MetaClient.RateLimits() {
+			resp.WriteString(fmt.Sprintf("\n\tdb=%s user=%s points=%d bytes=%d queries=%d",
+				l.Database, l.User, l.PointsPerSecond, l.BytesPerSecond, l.QueriesPerSecond))
+		}
+		return nil
+	}
+
+	var err error
+	for key, rate := range map[string]*int64{
+		"points":  &rl.PointsPerSecond,
+		"bytes":   &rl.BytesPerSecond,
+		"queries": &rl.QueriesPerSecond,
+	} {
+		if _, ok := req.Param()[key]; !ok {
+			continue
+		}
+		if *rate, err = getIntValue(req.Param(), key); err != nil {
+			return err
+		}
+	}
+	if err = SysCtrl.MetaClient.SetRateLimit(rl); err != nil {
+		return err
+	}
+	resp.WriteString("\n\tsuccess")
+	return nil
+}
+
 func handleLogRowsCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) error {
 	switchon, err := getBoolValue(req.Param(), "switchon")
 	if err != nil {
@@ -274,7 +414,12 @@ func handleLogRowsCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) erro
 
 func sendCmdToStore(req netstorage.SysCtrlRequest, nid uint64, host string) string {
 	var res string
-	_, err := SysCtrl.NetStore.SendSysCtrlOnNode(nid, req)
+	ret, err := SysCtrl.NetStore.SendSysCtrlOnNode(nid, req)
+	for _, v := range ret {
+		if v == "failure" {
+			err = fmt.Errorf("%s failed on the node", req.Mod())
+		}
+	}
 	if err != nil {
 		res = fmt.Sprintf("\n\t%v: failed,%v,", host, err)
 	} else {
@@ -17,11 +17,15 @@ limitations under the License.
 package syscontrol
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/influxdata/influxdb/pkg/testing/assert"
 	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/backup"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
@@ -49,6 +53,11 @@ func (mockMetaClient) DataNodes() ([]meta2.DataNode, error) {
 	}, nil
 }
 
+func (c mockMetaClient) Data() meta2.Data {
+	nodes, _ := c.DataNodes()
+	return meta2.Data{DataNodes: nodes}
+}
+
 type mockStorage struct {
 	netstorage.Storage
 }
@@ -145,3 +154,58 @@ func TestProcessRequest_ForceBroadcastQuery(t *testing.T) {
 	assert.Equal(t, executor.EnableForceBroadcastQuery, int64(0))
 	sb.Reset()
 }
+
+type backupStorage struct {
+	netstorage.Storage
+	mu    sync.Mutex
+	paths map[uint64]string
+	bases map[uint64]string
+}
+
+func (s *backupStorage) SendSysCtrlOnNode(nodID uint64, req netstorage.SysCtrlRequest) (map[string]string, error) {
+	s.mu.Lock()
+	s.paths[nodID] = req.Param()["path"]
+	s.bases[nodID] = req.Param()["base"]
+	s.mu.Unlock()
+	if nodID == 1 {
+		return map[string]string{"127.0.0.2:8401": "failure"}, nil
+	}
+	return map[string]string{"127.0.0.1:8401": "success"}, nil
+}
+
+func TestProcessRequest_Backup(t *testing.T) {
+	store := &backupStorage{paths: make(map[uint64]string), bases: make(map[uint64]string)}
+	SysCtrl.MetaClient = &mockMetaClient{}
+	SysCtrl.NetStore = store
+	dir := filepath.Join(t.TempDir(), "backup")
+
+	var req netstorage.SysCtrlRequest
+	req.SetMod("backup")
+	req.SetParam(map[string]string{})
+	var sb strings.Builder
+	require.EqualError(t, ProcessRequest(req, &sb), "no path in parameter")
+
+	req.SetParam(map[string]string{"path": dir})
+	require.NoError(t, ProcessRequest(req, &sb))
+	require.Contains(t, sb.String(), "127.0.0.1:8400: success,")
+	require.Contains(t, sb.String(), "127.0.0.2:8400: failed,backup failed on the node,")
+	require.Equal(t, map[uint64]string{0: backup.NodeDir(dir, 0), 1: backup.NodeDir(dir, 1)}, store.paths)
+	_, err := os.Stat(filepath.Join(dir, backup.MetaFile))
+	require.NoError(t, err)
+
+	sb.Reset()
+	require.EqualError(t, ProcessRequest(req, &sb), backup.ErrBackupExists.Error())
+
+	incr := filepath.Join(t.TempDir(), "incr")
+	req.SetParam(map[string]string{"path": incr, "base": filepath.Join(dir, "missing")})
+	require.Error(t, ProcessRequest(req, &sb))
+	req.SetParam(map[string]string{"path": incr, "base": dir})
+	require.NoError(t, ProcessRequest(req, &sb))
+	require.Equal(t, map[uint64]string{0: backup.NodeDir(dir, 0), 1: backup.NodeDir(dir, 1)}, store.bases)
+
+	req.SetMod("restore")
+	req.SetParam(map[string]string{"path": dir})
+	require.EqualError(t, ProcessRequest(req, &sb), "no database in parameter")
+	req.SetParam(map[string]string{"path": dir, "database": "db0", "nodes": "1:a"})
+	require.EqualError(t, ProcessRequest(req, &sb), `invalid node id "a"`)
+}
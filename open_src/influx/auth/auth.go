@@ -81,10 +81,12 @@ func NewWriteAuthorizer(c *metaclient.Client) *WriteAuthorizer {
 	return &WriteAuthorizer{Client: c}
 }
 
-// AuthorizeWrite returns nil if the user has permission to write to the database.
+// AuthorizeWrite returns nil if the user has permission to write to the database. A user whose roles only grant
+// writes to some measurements of the database is authorized here, the measurements of the rows are checked later.
 func (a WriteAuthorizer) AuthorizeWrite(username, database string) error {
 	u, err := a.Client.User(username)
-	if err != nil || u == nil || !u.AuthorizeDatabase(originql.WritePrivilege, database) {
+	if err != nil || u == nil ||
+		!u.AuthorizeDatabase(originql.WritePrivilege, database) && !u.HasRolePrivilege(influxql.WriteRolePrivilege, database) {
 		return &meta.ErrAuthorize{
 			Database: database,
 			Message:  fmt.Sprintf("%s not authorized to write to %s", username, database),
@@ -53,6 +53,13 @@ import (
 
 var dbStatCount int
 
+var errNoDatabaseInTarget = errors.New("no database in target")
+
+// pointsWriter writes the results of SELECT INTO statements back to the database.
+type pointsWriter interface {
+	WritePointRows(database, retentionPolicy string, rows []influx.Row) error
+}
+
 // StatementExecutor executes a statement in the query.
 type StatementExecutor struct {
 	MetaClient meta.MetaClient
@@ -68,6 +75,9 @@ type StatementExecutor struct {
 	// Holds monitoring data for SHOW STATS and SHOW DIAGNOSTICS.
 	MetaExecutor *coordinator.MetaExecutor
 
+	// PointsWriter writes the results of SELECT INTO statements.
+	PointsWriter pointsWriter
+
 	//Node *meta.Node
 
 	// Select statement limits
@@ -115,11 +125,21 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeAlterShardKeyStatement(stmt)
+	case *influxql.CreateContinuousQueryStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeCreateContinuousQueryStatement(stmt)
 	case *influxql.CreateDatabaseStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeCreateDatabaseStatement(stmt)
+	case *influxql.CreateDownSampleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeCreateDownSampleStatement(stmt)
 	case *influxql.CreateMeasurementStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -130,26 +150,47 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeCreateRetentionPolicyStatement(stmt)
+	case *influxql.CreateRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.CreateRole(stmt.Name)
+	case *influxql.CreateSubscriptionStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeCreateSubscriptionStatement(stmt)
 	case *influxql.CreateUserStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeCreateUserStatement(stmt)
 	case *influxql.DeleteSeriesStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
 		_, err = e.retryExecuteStatement(stmt, ctx)
+	case *influxql.DropContinuousQueryStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropContinuousQueryStatement(stmt)
 	case *influxql.DropDatabaseStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx)
+	case *influxql.DropDownSampleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropDownSampleStatement(stmt)
 	case *influxql.DropMeasurementStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx)
 	case *influxql.DropSeriesStatement:
-		return meta2.ErrUnsupportCommand
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
@@ -165,8 +206,12 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeDropShardStatement(stmt, ctx)
+	case *influxql.DropRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.DropRole(stmt.Name)
 	case *influxql.DropSubscriptionStatement:
-		return meta2.ErrUnsupportCommand
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
@@ -188,6 +233,16 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeGrantAdminStatement(stmt)
+	case *influxql.GrantRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.SetUserRole(stmt.User, stmt.Role, false)
+	case *influxql.GrantRolePrivilegeStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.SetRolePrivilege(stmt.Role, meta2.NewRoleGrant(stmt.Privilege, &stmt.On), false)
 	case *influxql.RevokeStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -204,10 +259,24 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeRevokeAdminStatement(stmt)
+	case *influxql.RevokeRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.SetUserRole(stmt.User, stmt.Role, true)
+	case *influxql.RevokeRolePrivilegeStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.MetaClient.SetRolePrivilege(stmt.Role, meta2.NewRoleGrant(stmt.Privilege, &stmt.On), true)
+	case *influxql.ShowContinuousQueriesStatement:
+		rows, err = e.executeShowContinuousQueriesStatement(stmt)
 	case *influxql.ShowDatabasesStatement:
 		rows, err = e.executeShowDatabasesStatement(stmt, ctx)
 	case *influxql.ShowDiagnosticsStatement:
 		return meta2.ErrUnsupportCommand
+	case *influxql.ShowDownSamplesStatement:
+		rows, err = e.executeShowDownSamplesStatement(stmt)
 	case *influxql.ShowGrantsForUserStatement:
 		rows, err = e.executeShowGrantsForUserStatement(stmt)
 	case *influxql.ShowMeasurementsStatement:
@@ -223,6 +292,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		rows, err = e.retryExecuteStatement(stmt, ctx)
 	case *influxql.ShowRetentionPoliciesStatement:
 		rows, err = e.executeShowRetentionPoliciesStatement(stmt)
+	case *influxql.ShowRolesStatement:
+		rows = e.MetaClient.ShowRoles()
 	case *influxql.ShowSeriesCardinalityStatement:
 		rows, err = e.retryExecuteStatement(stmt, ctx)
 	case *influxql.ShowShardsStatement:
@@ -230,7 +301,6 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	case *influxql.ShowShardGroupsStatement:
 		rows, err = e.executeShowShardGroupsStatement(stmt)
 	case *influxql.ShowSubscriptionsStatement:
-		return meta2.ErrUnsupportCommand
 		rows, err = e.executeShowSubscriptionsStatement(stmt)
 	case *influxql.ShowFieldKeysStatement:
 		_, err = e.retryExecuteStatement(stmt, ctx)
@@ -250,6 +320,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		return err
 	case *influxql.ShowTagValuesCardinalityStatement:
 		rows, err = e.retryExecuteStatement(stmt, ctx)
+	case *influxql.ShowTagCardinalityStatement:
+		rows, err = e.retryExecuteStatement(stmt, ctx)
 	case *influxql.ShowUsersStatement:
 		rows, err = e.executeShowUsersStatement(stmt)
 	case *influxql.SetPasswordUserStatement:
@@ -258,7 +330,6 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		err = e.executeSetPasswordUserStatement(stmt)
 	case *influxql.ShowQueriesStatement, *influxql.KillQueryStatement:
-		return meta2.ErrUnsupportCommand
 		// Send query related statements to the task manager.
 		return e.TaskManager.ExecuteStatement(stmt, ctx)
 	case *influxql.PrepareSnapshotStatement:
@@ -300,6 +371,10 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			err = e.executeDropDatabaseStatement(stmt)
 		case *influxql.DropMeasurementStatement:
 			err = e.executeDropMeasurementStatement(stmt, ctx.Database)
+		case *influxql.DropSeriesStatement:
+			err = e.executeDropSeriesStatement(stmt, ctx.Database)
+		case *influxql.DeleteSeriesStatement:
+			err = e.executeDeleteSeriesStatement(stmt, ctx.Database)
 		case *influxql.DropRetentionPolicyStatement:
 			err = e.executeDropRetentionPolicyStatement(stmt)
 		case *influxql.ShowTagKeysStatement:
@@ -318,6 +393,8 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			rows, err = e.executeShowSeriesCardinality(stmt)
 		case *influxql.ShowTagValuesCardinalityStatement:
 			rows, err = e.executeShowTagValuesCardinality(stmt)
+		case *influxql.ShowTagCardinalityStatement:
+			rows, err = e.executeShowTagCardinality(stmt)
 		case *influxql.ShowFieldKeysStatement:
 			err = e.executeShowFieldKeys(stmt, ctx)
 		case *influxql.ShowFieldKeyCardinalityStatement:
@@ -348,10 +425,9 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 }
 
 func (e *StatementExecutor) executeAlterRetentionPolicyStatement(stmt *influxql.AlterRetentionPolicyStatement) error {
-	oneReplication := 1
 	rpu := &meta2.RetentionPolicyUpdate{
 		Duration:           stmt.Duration,
-		ReplicaN:           &oneReplication,
+		ReplicaN:           stmt.Replication,
 		ShardGroupDuration: stmt.ShardGroupDuration,
 		HotDuration:        stmt.HotDuration,
 		WarmDuration:       stmt.WarmDuration,
@@ -437,11 +513,14 @@ func (e *StatementExecutor) executeCreateDatabaseStatement(stmt *influxql.Create
 		return err
 	}
 
-	oneReplication := 1
+	replicaN := 1
+	if stmt.RetentionPolicyReplication != nil {
+		replicaN = *stmt.RetentionPolicyReplication
+	}
 	spec := meta2.RetentionPolicySpec{
 		Name:               stmt.RetentionPolicyName,
 		Duration:           stmt.RetentionPolicyDuration,
-		ReplicaN:           &oneReplication,
+		ReplicaN:           &replicaN,
 		ShardGroupDuration: stmt.RetentionPolicyShardGroupDuration,
 		HotDuration:        &stmt.RetentionPolicyHotDuration,
 		WarmDuration:       &stmt.RetentionPolicyWarmDuration,
@@ -466,11 +545,14 @@ func (e *StatementExecutor) executeCreateRetentionPolicyStatement(stmt *influxql
 		return errors.New("THE TOTAL NUMBER OF RPs EXCEEDS THE LIMIT")
 	}
 
-	oneReplication := 1
+	replicaN := stmt.Replication
+	if replicaN == 0 {
+		replicaN = 1
+	}
 	spec := meta2.RetentionPolicySpec{
 		Name:               stmt.Name,
 		Duration:           &stmt.Duration,
-		ReplicaN:           &oneReplication,
+		ReplicaN:           &replicaN,
 		ShardGroupDuration: stmt.ShardGroupDuration,
 		HotDuration:        &stmt.HotDuration,
 		WarmDuration:       &stmt.WarmDuration,
@@ -482,6 +564,59 @@ func (e *StatementExecutor) executeCreateRetentionPolicyStatement(stmt *influxql
 	return err
 }
 
+func (e *StatementExecutor) executeCreateContinuousQueryStatement(q *influxql.CreateContinuousQueryStatement) error {
+	// Verify that retention policies exist.
+	var err error
+	verifyRPFn := func(n influxql.Node) {
+		if err != nil {
+			return
+		}
+		switch m := n.(type) {
+		case *influxql.Measurement:
+			var rp *meta2.RetentionPolicyInfo
+			if rp, err = e.MetaClient.RetentionPolicy(m.Database, m.RetentionPolicy); err != nil {
+				return
+			} else if rp == nil {
+				err = meta2.ErrRetentionPolicyNotFound(m.Database + "." + m.RetentionPolicy)
+			}
+		default:
+			return
+		}
+	}
+
+	influxql.WalkFunc(q, verifyRPFn)
+
+	if err != nil {
+		return err
+	}
+
+	return e.MetaClient.CreateContinuousQuery(q.Database, q.Name, q.String())
+}
+
+func (e *StatementExecutor) executeCreateDownSampleStatement(q *influxql.CreateDownSampleStatement) error {
+	if q.Database == "" {
+		return coordinator.ErrDatabaseNameRequired
+	}
+
+	policy := &meta2.DownSamplePolicyInfo{
+		Calls:  make([]meta2.DownSampleCall, 0, len(q.Calls)),
+		Levels: make([]meta2.DownSampleLevel, 0, len(q.SampleIntervals)),
+	}
+	for _, c := range q.Calls {
+		policy.Calls = append(policy.Calls, meta2.DownSampleCall{DataType: c.DataType, Ops: c.Ops})
+	}
+	for i := range q.SampleIntervals {
+		policy.Levels = append(policy.Levels, meta2.DownSampleLevel{
+			SampleInterval: q.SampleIntervals[i],
+			TimeInterval:   q.TimeIntervals[i],
+		})
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return e.MetaClient.CreateDownSamplePolicy(q.Database, q.RetentionPolicy, policy)
+}
+
 func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.CreateSubscriptionStatement) error {
 	return e.MetaClient.CreateSubscription(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations)
 }
@@ -519,6 +654,62 @@ func (e *StatementExecutor) executeDropMeasurementStatement(stmt *influxql.DropM
 	return e.MetaClient.MarkMeasurementDelete(database, stmt.Name)
 }
 
+func (e *StatementExecutor) executeDropSeriesStatement(stmt *influxql.DropSeriesStatement, database string) error {
+	if influxql.HasTimeExpr(stmt.Condition) {
+		return errors.New("DROP SERIES doesn't support time in WHERE clause")
+	}
+	return e.dropSeries(database, stmt.Sources, stmt.Condition)
+}
+
+func (e *StatementExecutor) executeDeleteSeriesStatement(stmt *influxql.DeleteSeriesStatement, database string) error {
+	return e.dropSeries(database, stmt.Sources, stmt.Condition)
+}
+
+// dropSeries sends the delete request to every store node owning a pt of the database.
+// Series and time ranges matching the condition are tombstoned by the store engines.
+func (e *StatementExecutor) dropSeries(database string, sources influxql.Sources, condition influxql.Expr) error {
+	if _, err := e.MetaClient.Database(database); err != nil {
+		return err
+	}
+
+	for _, ref := range influxql.ExprNames(condition) {
+		if ref.Val != "time" && ref.Type != influxql.Tag && ref.Type != influxql.Unknown {
+			return fmt.Errorf("fields not supported in WHERE clause during deletion: %s", ref.Val)
+		}
+	}
+	// evaluate now() on the sql node so that all store nodes delete the same time range
+	condition = influxql.Reduce(condition, &influxql.NowValuer{Now: time.Now().UTC()})
+
+	mis, err := e.MetaClient.MatchMeasurements(database, sources.Measurements())
+	if err != nil {
+		return err
+	}
+	if len(mis) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+	sort.Strings(names)
+
+	var dropErr error
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) {
+		_, err := e.NetStorage.DropSeries(nodeID, database, pts, names, condition)
+		if err != nil {
+			e.StmtExecLogger.Error("failed to drop series", zap.Uint64("node", nodeID), zap.Error(err))
+			lock.Lock()
+			dropErr = err
+			lock.Unlock()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return dropErr
+}
+
 func (e *StatementExecutor) executeDropShardStatement(stmt *influxql.DropShardStatement, ctx *query2.ExecutionContext) error {
 	db, rp, sg := e.MetaClient.ShardOwner(stmt.ID)
 	if len(db) == 0 || len(rp) == 0 || sg == nil {
@@ -584,6 +775,17 @@ func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.D
 	return nil
 }
 
+func (e *StatementExecutor) executeDropContinuousQueryStatement(q *influxql.DropContinuousQueryStatement) error {
+	return e.MetaClient.DropContinuousQuery(q.Database, q.Name)
+}
+
+func (e *StatementExecutor) executeDropDownSampleStatement(q *influxql.DropDownSampleStatement) error {
+	if q.Database == "" {
+		return coordinator.ErrDatabaseNameRequired
+	}
+	return e.MetaClient.DropDownSamplePolicy(q.Database, q.RetentionPolicy)
+}
+
 func (e *StatementExecutor) executeDropSubscriptionStatement(q *influxql.DropSubscriptionStatement) error {
 	return e.MetaClient.DropSubscription(q.Database, q.RetentionPolicy, q.Name)
 }
@@ -776,6 +978,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 
 	var rowsChan query2.RowsChan
 	var ok bool
+	var writeN int64
 	for {
 		select {
 		case rowsChan, ok = <-ctx.ExecutionOptions.RowsChan:
@@ -783,6 +986,17 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				closed = true
 				break
 			}
+			if stmt.Target != nil {
+				// Write the results into the target measurement instead of emitting them.
+				n, err := e.writeInto(stmt, rowsChan.Rows)
+				if err != nil {
+					pipelineExecutor.Abort()
+					e.StmtExecLogger.Error("write into target failed", zap.Error(err))
+					return err
+				}
+				writeN += n
+				continue
+			}
 			result := &query.Result{
 				Series:  rowsChan.Rows,
 				Partial: rowsChan.Partial,
@@ -810,6 +1024,16 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 		return err
 	}
 
+	if stmt.Target != nil {
+		return ctx.Send(&query.Result{
+			Series: []*models.Row{{
+				Name:    "result",
+				Columns: []string{"time", "written"},
+				Values:  [][]interface{}{{time.Unix(0, 0).UTC(), writeN}},
+			}},
+		})
+	}
+
 	// Always emit at least one result.
 	if !emitted {
 		return ctx.Send(&query.Result{
@@ -819,6 +1043,124 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	return nil
 }
 
+func (e *StatementExecutor) writeInto(stmt *influxql.SelectStatement, rows models.Rows) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	target := stmt.Target.Measurement
+	if target.Database == "" {
+		return 0, errNoDatabaseInTarget
+	}
+	if e.PointsWriter == nil {
+		return 0, errors.New("points writer is not set for SELECT INTO")
+	}
+
+	var points []influx.Row
+	var err error
+	for _, row := range rows {
+		// An empty target name means INTO :MEASUREMENT, keep the name of the source measurement
+		name := target.Name
+		if name == "" {
+			name = row.Name
+		}
+		points, err = convertRowToPoints(points, name, row)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if len(points) == 0 {
+		return 0, nil
+	}
+
+	if err = e.PointsWriter.WritePointRows(target.Database, target.RetentionPolicy, points); err != nil {
+		return 0, err
+	}
+	return int64(len(points)), nil
+}
+
+// convertRowToPoints converts a result row into points of measurement name and appends them to dst.
+// The tags of the row are kept as tags, all other columns except time become fields.
+func convertRowToPoints(dst []influx.Row, name string, row *models.Row) ([]influx.Row, error) {
+	timeIndex := -1
+	for i, c := range row.Columns {
+		if c == "time" {
+			timeIndex = i
+			break
+		}
+	}
+	if timeIndex == -1 {
+		return dst, errors.New("error finding time index in result")
+	}
+
+	tags := make(influx.PointTags, 0, len(row.Tags))
+	for k, v := range row.Tags {
+		if v == "" {
+			continue
+		}
+		tags = append(tags, influx.Tag{Key: k, Value: v})
+	}
+	sort.Sort(&tags)
+
+	for _, values := range row.Values {
+		tm, ok := values[timeIndex].(time.Time)
+		if !ok {
+			return dst, fmt.Errorf("invalid time value %v in result", values[timeIndex])
+		}
+
+		fields := make(influx.Fields, 0, len(values)-1)
+		for i, v := range values {
+			if i == timeIndex || v == nil {
+				continue
+			}
+			field, err := convertValueToField(row.Columns[i], v)
+			if err != nil {
+				return dst, err
+			}
+			fields = append(fields, field)
+		}
+		// rows without any non-null value are not written, the same as a point without fields
+		if len(fields) == 0 {
+			continue
+		}
+
+		dst = append(dst, influx.Row{
+			Name:      name,
+			Tags:      tags,
+			Fields:    fields,
+			Timestamp: tm.UnixNano(),
+		})
+	}
+	return dst, nil
+}
+
+func convertValueToField(key string, v interface{}) (influx.Field, error) {
+	field := influx.Field{Key: key}
+	switch val := v.(type) {
+	case float64:
+		field.Type = influx.Field_Type_Float
+		field.NumValue = val
+	case int64:
+		field.Type = influx.Field_Type_Int
+		field.NumValue = float64(val)
+	case uint64:
+		field.Type = influx.Field_Type_UInt
+		field.NumValue = float64(val)
+		field.UintValue = val
+	case string:
+		field.Type = influx.Field_Type_String
+		field.StrValue = val
+	case bool:
+		field.Type = influx.Field_Type_Boolean
+		if val {
+			field.NumValue = 1
+		}
+	default:
+		return field, fmt.Errorf("unsupported value type %T of column %s", v, key)
+	}
+	return field, nil
+}
+
 func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *influxql.SelectStatement, opt query2.ExecutionOptions) (pipelineExecutor *executor.PipelineExecutor, err error) {
 	sopt := query2.SelectOptions{
 		NodeID:                  opt.NodeID,
@@ -836,6 +1178,7 @@ func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *in
 		RowsChan:                opt.RowsChan,
 		ChunkSize:               opt.InnerChunkSize,
 		Traceid:                 opt.Traceid,
+		QueryID:                 opt.QueryID,
 		AbortChan:               opt.AbortCh,
 	}
 
@@ -858,6 +1201,9 @@ func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *in
 		return nil, e_tmp
 	}
 	pipelineExecutor, err = p.(*executor.PipelineExecutor), e_tmp
+	if opt.Workload != nil && opt.Workload.Memory() != nil {
+		pipelineExecutor.SetMemQuota(opt.Workload.Memory())
+	}
 
 	return pipelineExecutor, err
 }
@@ -871,6 +1217,9 @@ func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDataba
 		// Only include databases that the user is authorized to read or write.
 		if a.AuthorizeDatabase(originql.ReadPrivilege, di.Name) || a.AuthorizeDatabase(originql.WritePrivilege, di.Name) {
 			row.Values = append(row.Values, []interface{}{di.Name})
+		} else if u, ok := a.(meta2.User); ok && (u.HasRolePrivilege(influxql.ReadRolePrivilege, di.Name) ||
+			u.HasRolePrivilege(influxql.WriteRolePrivilege, di.Name)) {
+			row.Values = append(row.Values, []interface{}{di.Name})
 		}
 	}
 	sort.Slice(row.Values, func(i, j int) bool {
@@ -951,6 +1300,14 @@ func (e *StatementExecutor) executeShowRetentionPoliciesStatement(q *influxql.Sh
 	return e.MetaClient.ShowRetentionPolicies(q.Database)
 }
 
+func (e *StatementExecutor) executeShowDownSamplesStatement(q *influxql.ShowDownSamplesStatement) (models.Rows, error) {
+	if q.Database == "" {
+		return nil, coordinator.ErrDatabaseNameRequired
+	}
+
+	return e.MetaClient.ShowDownSamplePolicies(q.Database)
+}
+
 func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShardsStatement) (models.Rows, error) {
 	return e.MetaClient.ShowShards(), nil
 }
@@ -959,6 +1316,10 @@ func (e *StatementExecutor) executeShowShardGroupsStatement(stmt *influxql.ShowS
 	return e.MetaClient.ShowShardGroups(), nil
 }
 
+func (e *StatementExecutor) executeShowContinuousQueriesStatement(stmt *influxql.ShowContinuousQueriesStatement) (models.Rows, error) {
+	return e.MetaClient.ShowContinuousQueries(), nil
+}
+
 func (e *StatementExecutor) executeShowSubscriptionsStatement(stmt *influxql.ShowSubscriptionsStatement) (models.Rows, error) {
 	return e.MetaClient.ShowSubscriptions(), nil
 }
@@ -1190,6 +1551,20 @@ func (e *StatementExecutor) executeShowTagValuesCardinality(stmt *influxql.ShowT
 	return exec.Execute(newStmt)
 }
 
+func (e *StatementExecutor) executeShowTagCardinality(stmt *influxql.ShowTagCardinalityStatement) (models.Rows, error) {
+	exec := coordinator.NewShowTagValuesExecutor(e.StmtExecLogger, e.MetaClient, e.MetaExecutor, e.NetStorage)
+
+	newStmt := &influxql.ShowTagValuesStatement{
+		Database: stmt.Database,
+		Sources:  stmt.Sources,
+		Limit:    stmt.Limit,
+		Offset:   stmt.Offset,
+	}
+
+	exec.TagCardinality()
+	return exec.Execute(newStmt)
+}
+
 func (e *StatementExecutor) executeShowSeries(q *influxql.ShowSeriesStatement, ctx *query2.ExecutionContext) error {
 	mis, err := e.MetaClient.MatchMeasurements(q.Database, q.Sources.Measurements())
 	if err != nil {
@@ -1450,6 +1825,18 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.CreateDownSampleStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
+		case *influxql.DropDownSampleStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
+		case *influxql.ShowDownSamplesStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.ShowMeasurementsStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
@@ -1470,6 +1857,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.ShowTagCardinalityStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.ShowTagValuesStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
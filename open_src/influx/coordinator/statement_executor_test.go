@@ -0,0 +1,65 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertRowToPoints(t *testing.T) {
+	tm := time.Unix(0, 1e9).UTC()
+	row := &models.Row{
+		Name:    "cpu",
+		Tags:    map[string]string{"region": "east", "host": "h1", "empty": ""},
+		Columns: []string{"time", "mean", "count", "last", "up"},
+		Values: [][]interface{}{
+			{tm, 1.5, int64(3), "v", true},
+			{tm.Add(time.Second), nil, nil, nil, nil},
+			{tm.Add(2 * time.Second), nil, int64(1), nil, false},
+		},
+	}
+
+	points, err := convertRowToPoints(nil, "cpu_1h", row)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(points))
+
+	assert.Equal(t, "cpu_1h", points[0].Name)
+	assert.Equal(t, influx.PointTags{{Key: "host", Value: "h1"}, {Key: "region", Value: "east"}}, points[0].Tags)
+	assert.Equal(t, tm.UnixNano(), points[0].Timestamp)
+	assert.Equal(t, influx.Fields{
+		{Key: "mean", NumValue: 1.5, Type: influx.Field_Type_Float},
+		{Key: "count", NumValue: 3, Type: influx.Field_Type_Int},
+		{Key: "last", StrValue: "v", Type: influx.Field_Type_String},
+		{Key: "up", NumValue: 1, Type: influx.Field_Type_Boolean},
+	}, points[0].Fields)
+
+	assert.Equal(t, tm.Add(2*time.Second).UnixNano(), points[1].Timestamp)
+	assert.Equal(t, influx.Fields{
+		{Key: "count", NumValue: 1, Type: influx.Field_Type_Int},
+		{Key: "up", NumValue: 0, Type: influx.Field_Type_Boolean},
+	}, points[1].Fields)
+
+	row.Columns[0] = "ts"
+	_, err = convertRowToPoints(nil, "cpu_1h", row)
+	assert.Error(t, err)
+}
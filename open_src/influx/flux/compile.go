@@ -0,0 +1,915 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+)
+
+const (
+	DefaultResultName = "_result"
+
+	startColumn       = "_start"
+	stopColumn        = "_stop"
+	timeColumn        = "_time"
+	valueColumn       = "_value"
+	fieldColumn       = "_field"
+	measurementColumn = "_measurement"
+)
+
+// aggregates are the functions aggregateWindow accepts, mapped to the InfluxQL functions.
+var aggregates = map[string]string{
+	"mean":   "mean",
+	"sum":    "sum",
+	"count":  "count",
+	"min":    "min",
+	"max":    "max",
+	"first":  "first",
+	"last":   "last",
+	"median": "median",
+	"spread": "spread",
+	"stddev": "stddev",
+}
+
+// Query is a pipeline translated into a select statement,
+// the rows of the statement are turned into the tables of the pipeline by Tables.
+type Query struct {
+	Result          string
+	Database        string
+	RetentionPolicy string
+	// Statement is nil if the filters match nothing
+	Statement *influxql.SelectStatement
+
+	start, stop time.Time
+
+	// every is the window of aggregateWindow, zero if the pipeline is not aggregated
+	every       time.Duration
+	createEmpty bool
+
+	// columnPrefix is trimmed from the columns of an aggregate over all fields, like mean_
+	columnPrefix string
+
+	// groupBy is the group key set by group(), nil if the series are not regrouped
+	groupBy []string
+}
+
+// Compile translates each yielded pipeline of the program into a query, now is the time of now().
+func Compile(prog *Program, now time.Time) ([]*Query, error) {
+	c := &compiler{now: now, vars: make(map[string]Expr)}
+
+	var queries []*Query
+	results := make(map[string]bool)
+	for _, stmt := range prog.Statements {
+		if stmt.Name != "" {
+			c.vars[stmt.Name] = stmt.Expr
+			continue
+		}
+
+		q, err := c.compilePipeline(stmt.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if results[q.Result] {
+			return nil, fmt.Errorf("duplicate result name %q", q.Result)
+		}
+		results[q.Result] = true
+		queries = append(queries, q)
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no pipeline is yielded")
+	}
+	return queries, nil
+}
+
+type compiler struct {
+	now  time.Time
+	vars map[string]Expr
+}
+
+// calls flattens the pipe expression into the calls from the source to the sink.
+func (c *compiler) calls(expr Expr, depth int) ([]*CallExpr, error) {
+	if depth > len(c.vars) {
+		return nil, fmt.Errorf("circular variable reference")
+	}
+	switch expr := expr.(type) {
+	case *PipeExpr:
+		calls, err := c.calls(expr.Arg, depth)
+		if err != nil {
+			return nil, err
+		}
+		return append(calls, expr.Call), nil
+	case *CallExpr:
+		return []*CallExpr{expr}, nil
+	case *Identifier:
+		v, ok := c.vars[expr.Name]
+		if !ok {
+			return nil, fmt.Errorf("undefined identifier %s", expr.Name)
+		}
+		return c.calls(v, depth+1)
+	}
+	return nil, fmt.Errorf("expression is not a pipeline")
+}
+
+// pipeline collects the calls of a pipeline before the statement is built.
+type pipeline struct {
+	query *Query
+
+	hasRange     bool
+	measurements []string
+	measurementR *regexp.Regexp
+	fields       []string
+	tagCond      influxql.Expr
+	valueCond    influxql.Expr
+
+	// preMap and postMap are the map() before and after aggregateWindow,
+	// the value is referred by the _value variable
+	preMap  influxql.Expr
+	postMap influxql.Expr
+
+	aggregate     string
+	windowGroupBy []string
+	groupedBefore bool
+}
+
+func (c *compiler) compilePipeline(expr Expr) (*Query, error) {
+	calls, err := c.calls(expr, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pipeline{query: &Query{Result: DefaultResultName, stop: c.now}}
+	if err := c.from(p, calls[0]); err != nil {
+		return nil, err
+	}
+
+	for i, call := range calls[1:] {
+		name := calleeName(call)
+		if name == "yield" && i != len(calls)-2 {
+			return nil, fmt.Errorf("yield must be the last call of a pipeline")
+		}
+		if name != "range" && !p.hasRange {
+			return nil, fmt.Errorf("range must follow from")
+		}
+
+		var err error
+		switch name {
+		case "range":
+			err = c.rangeCall(p, call)
+		case "filter":
+			err = c.filter(p, call)
+		case "group":
+			err = c.group(p, call)
+		case "aggregateWindow":
+			err = c.aggregateWindow(p, call)
+		case "map":
+			err = c.mapCall(p, call)
+		case "yield":
+			err = c.yield(p, call)
+		default:
+			err = fmt.Errorf("function %s is not supported", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !p.hasRange {
+		return nil, fmt.Errorf("range must follow from")
+	}
+	return p.query, p.build()
+}
+
+func calleeName(call *CallExpr) string {
+	if id, ok := call.Callee.(*Identifier); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// args returns the arguments of the call, an error is returned for an unknown argument.
+func args(call *CallExpr, known ...string) (map[string]Expr, error) {
+	m := make(map[string]Expr, len(call.Args.Properties))
+	for _, prop := range call.Args.Properties {
+		found := false
+		for _, k := range known {
+			if k == prop.Key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s: unsupported argument %s", calleeName(call), prop.Key)
+		}
+		m[prop.Key] = prop.Value
+	}
+	return m, nil
+}
+
+func stringArg(call *CallExpr, expr Expr, name string) (string, error) {
+	s, ok := expr.(*StringLiteral)
+	if !ok {
+		return "", fmt.Errorf("%s: argument %s must be a string", calleeName(call), name)
+	}
+	return s.Val, nil
+}
+
+func (c *compiler) from(p *pipeline, call *CallExpr) error {
+	if calleeName(call) != "from" {
+		return fmt.Errorf("pipeline must start with from")
+	}
+	m, err := args(call, "bucket")
+	if err != nil {
+		return err
+	}
+	if m["bucket"] == nil {
+		return fmt.Errorf("from: missing required argument bucket")
+	}
+	bucket, err := stringArg(call, m["bucket"], "bucket")
+	if err != nil {
+		return err
+	}
+
+	// the bucket is a database and an optional retention policy, like db/rp
+	db, rp := bucket, ""
+	if i := strings.IndexByte(bucket, '/'); i >= 0 {
+		db, rp = bucket[:i], bucket[i+1:]
+	}
+	if db == "" {
+		return fmt.Errorf("from: invalid bucket %q", bucket)
+	}
+	p.query.Database, p.query.RetentionPolicy = db, rp
+	return nil
+}
+
+func (c *compiler) rangeCall(p *pipeline, call *CallExpr) error {
+	if p.hasRange {
+		return fmt.Errorf("range must be called once")
+	}
+	m, err := args(call, "start", "stop")
+	if err != nil {
+		return err
+	}
+	if m["start"] == nil {
+		return fmt.Errorf("range: missing required argument start")
+	}
+	if p.query.start, err = c.timeArg(m["start"]); err != nil {
+		return err
+	}
+	if m["stop"] != nil {
+		if p.query.stop, err = c.timeArg(m["stop"]); err != nil {
+			return err
+		}
+	}
+	if !p.query.start.Before(p.query.stop) {
+		return fmt.Errorf("range: start must be before stop")
+	}
+	p.hasRange = true
+	return nil
+}
+
+// timeArg evaluates a time, a duration relative to now or a unix timestamp in seconds.
+func (c *compiler) timeArg(expr Expr) (time.Time, error) {
+	switch expr := expr.(type) {
+	case *TimeLiteral:
+		return expr.Val, nil
+	case *DurationLiteral:
+		return c.now.Add(expr.Val), nil
+	case *IntegerLiteral:
+		return time.Unix(expr.Val, 0).UTC(), nil
+	case *CallExpr:
+		if calleeName(expr) == "now" && len(expr.Args.Properties) == 0 {
+			return c.now, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("range: invalid time")
+}
+
+func (c *compiler) filter(p *pipeline, call *CallExpr) error {
+	if p.aggregate != "" || p.preMap != nil || p.groupedBefore {
+		return fmt.Errorf("filter must come before group, aggregateWindow and map")
+	}
+	m, err := args(call, "fn")
+	if err != nil {
+		return err
+	}
+	fn, param, err := predicate(call, m["fn"])
+	if err != nil {
+		return err
+	}
+
+	for _, cond := range conjuncts(fn.Body, nil) {
+		if err := p.addCondition(param, cond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// predicate returns the function argument and the name of its only parameter.
+func predicate(call *CallExpr, expr Expr) (*FunctionExpr, string, error) {
+	fn, ok := expr.(*FunctionExpr)
+	if !ok || len(fn.Params) != 1 {
+		return nil, "", fmt.Errorf("%s: fn must be a function of one parameter", calleeName(call))
+	}
+	return fn, fn.Params[0], nil
+}
+
+func conjuncts(expr Expr, dst []Expr) []Expr {
+	if e, ok := expr.(*BinaryExpr); ok && e.Op == "and" {
+		return conjuncts(e.RHS, conjuncts(e.LHS, dst))
+	}
+	return append(dst, expr)
+}
+
+// addCondition classifies a condition of the filter by the columns it refers to.
+func (p *pipeline) addCondition(param string, cond Expr) error {
+	columns := make(map[string]bool)
+	if err := referredColumns(param, cond, columns); err != nil {
+		return err
+	}
+	special := func(column string) bool {
+		return columns[column] && len(columns) > 1
+	}
+	if special(measurementColumn) || special(fieldColumn) || special(valueColumn) {
+		return fmt.Errorf("filter: a condition on %s must not refer to other columns", keys(columns))
+	}
+	if columns[timeColumn] || columns[startColumn] || columns[stopColumn] {
+		return fmt.Errorf("filter: condition on time columns is not supported, use range instead")
+	}
+
+	switch {
+	case columns[measurementColumn]:
+		if re, ok := regexCondition(param, cond); ok {
+			if p.measurementR != nil || p.measurements != nil {
+				return fmt.Errorf("filter: only one condition on %s is supported", measurementColumn)
+			}
+			p.measurementR = re
+			return nil
+		}
+		names, err := equalities(param, cond)
+		if err != nil {
+			return err
+		}
+		if p.measurementR != nil {
+			return fmt.Errorf("filter: only one condition on %s is supported", measurementColumn)
+		}
+		p.measurements = intersect(p.measurements, names)
+	case columns[fieldColumn]:
+		names, err := equalities(param, cond)
+		if err != nil {
+			return err
+		}
+		p.fields = intersect(p.fields, names)
+	case columns[valueColumn]:
+		expr, err := condition(param, cond, true)
+		if err != nil {
+			return err
+		}
+		p.valueCond = and(p.valueCond, expr)
+	default:
+		expr, err := condition(param, cond, false)
+		if err != nil {
+			return err
+		}
+		p.tagCond = and(p.tagCond, expr)
+	}
+	return nil
+}
+
+func keys(m map[string]bool) string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return strings.Join(ks, ", ")
+}
+
+// intersect returns the names in both lists, the list is not restricted yet if it is nil.
+func intersect(dst, names []string) []string {
+	if dst == nil {
+		return names
+	}
+	res := make([]string, 0, len(dst))
+	for _, a := range dst {
+		for _, b := range names {
+			if a == b {
+				res = append(res, a)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func and(lhs, rhs influxql.Expr) influxql.Expr {
+	if lhs == nil {
+		return rhs
+	}
+	if rhs == nil {
+		return lhs
+	}
+	return &influxql.BinaryExpr{Op: influxql.AND, LHS: lhs, RHS: rhs}
+}
+
+func referredColumns(param string, expr Expr, columns map[string]bool) error {
+	switch expr := expr.(type) {
+	case *MemberExpr:
+		id, ok := expr.Object.(*Identifier)
+		if !ok || id.Name != param {
+			return fmt.Errorf("filter: only the columns of %s can be referred", param)
+		}
+		columns[expr.Property] = true
+	case *BinaryExpr:
+		if err := referredColumns(param, expr.LHS, columns); err != nil {
+			return err
+		}
+		return referredColumns(param, expr.RHS, columns)
+	case *UnaryExpr:
+		return referredColumns(param, expr.X, columns)
+	case *Identifier:
+		if expr.Name == param {
+			return fmt.Errorf("filter: %s must be referred by its columns", param)
+		}
+	}
+	return nil
+}
+
+func column(param string, expr Expr) (string, bool) {
+	m, ok := expr.(*MemberExpr)
+	if !ok {
+		return "", false
+	}
+	id, ok := m.Object.(*Identifier)
+	return m.Property, ok && id.Name == param
+}
+
+// equalities returns the names of r.column == "a" or r.column == "b".
+func equalities(param string, expr Expr) ([]string, error) {
+	e, ok := expr.(*BinaryExpr)
+	if ok && e.Op == "or" {
+		lhs, err := equalities(param, e.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := equalities(param, e.RHS)
+		return append(lhs, rhs...), err
+	}
+	if ok && e.Op == "==" {
+		lhs, rhs := e.LHS, e.RHS
+		if _, ok := column(param, rhs); ok {
+			lhs, rhs = rhs, lhs
+		}
+		if _, ok := column(param, lhs); ok {
+			if s, ok := rhs.(*StringLiteral); ok {
+				return []string{s.Val}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("filter: the condition on %s and %s must compare with strings by == and or",
+		measurementColumn, fieldColumn)
+}
+
+func regexCondition(param string, expr Expr) (*regexp.Regexp, bool) {
+	e, ok := expr.(*BinaryExpr)
+	if !ok || e.Op != "=~" {
+		return nil, false
+	}
+	if _, ok := column(param, e.LHS); !ok {
+		return nil, false
+	}
+	re, ok := e.RHS.(*RegexLiteral)
+	if !ok {
+		return nil, false
+	}
+	return re.Val, true
+}
+
+var comparisonTokens = map[string]influxql.Token{
+	"==": influxql.EQ,
+	"!=": influxql.NEQ,
+	"<":  influxql.LT,
+	"<=": influxql.LTE,
+	">":  influxql.GT,
+	">=": influxql.GTE,
+	"=~": influxql.EQREGEX,
+	"!~": influxql.NEQREGEX,
+}
+
+// swapped is the operator of a comparison whose operands are swapped
+var swapped = map[string]string{"==": "==", "!=": "!=", "<": ">", "<=": ">=", ">": "<", ">=": "<="}
+
+// condition translates a condition on tags, or on the value if value is set.
+// The value is referred by the _value variable.
+func condition(param string, expr Expr, value bool) (influxql.Expr, error) {
+	e, ok := expr.(*BinaryExpr)
+	if !ok {
+		return nil, fmt.Errorf("filter: unsupported condition")
+	}
+	switch e.Op {
+	case "and", "or":
+		lhs, err := condition(param, e.LHS, value)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := condition(param, e.RHS, value)
+		if err != nil {
+			return nil, err
+		}
+		var op influxql.Token = influxql.AND
+		if e.Op == "or" {
+			op = influxql.OR
+		}
+		return &influxql.ParenExpr{Expr: &influxql.BinaryExpr{Op: op, LHS: lhs, RHS: rhs}}, nil
+	}
+
+	op, ok := comparisonTokens[e.Op]
+	if !ok {
+		return nil, fmt.Errorf("filter: unsupported operator %s", e.Op)
+	}
+	lhs, rhs := e.LHS, e.RHS
+	if _, ok := column(param, rhs); ok {
+		if _, ok := swapped[e.Op]; !ok {
+			return nil, fmt.Errorf("filter: the column must be on the left of %s", e.Op)
+		}
+		lhs, rhs = rhs, lhs
+		op = comparisonTokens[swapped[e.Op]]
+	}
+	col, ok := column(param, lhs)
+	if !ok {
+		return nil, fmt.Errorf("filter: a condition must compare a column with a literal")
+	}
+
+	var lit influxql.Expr
+	switch v := rhs.(type) {
+	case *StringLiteral:
+		lit = &influxql.StringLiteral{Val: v.Val}
+	case *RegexLiteral:
+		lit = &influxql.RegexLiteral{Val: v.Val}
+	case *IntegerLiteral:
+		lit = &influxql.IntegerLiteral{Val: v.Val}
+	case *FloatLiteral:
+		lit = &influxql.NumberLiteral{Val: v.Val}
+	case *Identifier:
+		if v.Name != "true" && v.Name != "false" {
+			return nil, fmt.Errorf("filter: undefined identifier %s", v.Name)
+		}
+		lit = &influxql.BooleanLiteral{Val: v.Name == "true"}
+	default:
+		return nil, fmt.Errorf("filter: a condition must compare a column with a literal")
+	}
+	if _, isRegex := lit.(*influxql.RegexLiteral); isRegex != (op == influxql.EQREGEX || op == influxql.NEQREGEX) {
+		return nil, fmt.Errorf("filter: %s must be used with a regex", e.Op)
+	}
+
+	if !value {
+		switch lit.(type) {
+		case *influxql.StringLiteral, *influxql.RegexLiteral:
+		default:
+			return nil, fmt.Errorf("filter: tag %s must be compared with a string", col)
+		}
+		return &influxql.BinaryExpr{Op: op, LHS: &influxql.VarRef{Val: col}, RHS: lit}, nil
+	}
+	return &influxql.BinaryExpr{Op: op, LHS: &influxql.VarRef{Val: valueColumn}, RHS: lit}, nil
+}
+
+func (c *compiler) group(p *pipeline, call *CallExpr) error {
+	m, err := args(call, "columns", "mode")
+	if err != nil {
+		return err
+	}
+	if mode := m["mode"]; mode != nil {
+		s, err := stringArg(call, mode, "mode")
+		if err != nil {
+			return err
+		}
+		if s != "by" {
+			return fmt.Errorf("group: mode %s is not supported", s)
+		}
+	}
+
+	columns := []string{}
+	if m["columns"] != nil {
+		arr, ok := m["columns"].(*ArrayExpr)
+		if !ok {
+			return fmt.Errorf("group: columns must be an array of strings")
+		}
+		for _, elem := range arr.Elements {
+			s, err := stringArg(call, elem, "columns")
+			if err != nil {
+				return err
+			}
+			columns = append(columns, s)
+		}
+	}
+
+	p.query.groupBy = columns
+	if p.aggregate == "" {
+		p.groupedBefore = true
+		p.windowGroupBy = columns
+	}
+	return nil
+}
+
+func (c *compiler) aggregateWindow(p *pipeline, call *CallExpr) error {
+	if p.aggregate != "" {
+		return fmt.Errorf("aggregateWindow must be called once")
+	}
+	m, err := args(call, "every", "fn", "createEmpty")
+	if err != nil {
+		return err
+	}
+
+	every, ok := m["every"].(*DurationLiteral)
+	if !ok || every.Val <= 0 {
+		return fmt.Errorf("aggregateWindow: every must be a positive duration")
+	}
+	fn, ok := m["fn"].(*Identifier)
+	if !ok {
+		return fmt.Errorf("aggregateWindow: fn must be one of the aggregate functions")
+	}
+	agg, ok := aggregates[fn.Name]
+	if !ok {
+		return fmt.Errorf("aggregateWindow: function %s is not supported", fn.Name)
+	}
+
+	p.query.createEmpty = true
+	if m["createEmpty"] != nil {
+		b, ok := m["createEmpty"].(*Identifier)
+		if !ok || (b.Name != "true" && b.Name != "false") {
+			return fmt.Errorf("aggregateWindow: createEmpty must be a boolean")
+		}
+		p.query.createEmpty = b.Name == "true"
+	}
+	p.query.every = every.Val
+	p.aggregate = agg
+	return nil
+}
+
+func (c *compiler) mapCall(p *pipeline, call *CallExpr) error {
+	m, err := args(call, "fn")
+	if err != nil {
+		return err
+	}
+	fn, param, err := predicate(call, m["fn"])
+	if err != nil {
+		return err
+	}
+
+	// only the value may be mapped, like (r) => ({r with _value: r._value * 2.0})
+	obj, ok := fn.Body.(*ObjectExpr)
+	if !ok || obj.With == nil || obj.With.Name != param || len(obj.Properties) != 1 || obj.Properties[0].Key != valueColumn {
+		return fmt.Errorf("map: fn must return {%s with _value: ...}", param)
+	}
+	expr, err := arithmetic(param, obj.Properties[0].Value)
+	if err != nil {
+		return err
+	}
+
+	if p.aggregate == "" {
+		p.preMap = compose(p.preMap, expr)
+	} else {
+		p.postMap = compose(p.postMap, expr)
+	}
+	return nil
+}
+
+var arithmeticTokens = map[string]influxql.Token{
+	"+": influxql.ADD,
+	"-": influxql.SUB,
+	"*": influxql.MUL,
+	"/": influxql.DIV,
+	"%": influxql.MOD,
+}
+
+// arithmetic translates an arithmetic expression of the value, the value is referred by the _value variable.
+func arithmetic(param string, expr Expr) (influxql.Expr, error) {
+	switch e := expr.(type) {
+	case *IntegerLiteral:
+		return &influxql.IntegerLiteral{Val: e.Val}, nil
+	case *FloatLiteral:
+		return &influxql.NumberLiteral{Val: e.Val}, nil
+	case *MemberExpr:
+		if col, ok := column(param, e); ok && col == valueColumn {
+			return &influxql.VarRef{Val: valueColumn}, nil
+		}
+	case *UnaryExpr:
+		if e.Op == "-" {
+			x, err := arithmetic(param, e.X)
+			if err != nil {
+				return nil, err
+			}
+			return &influxql.BinaryExpr{Op: influxql.MUL, LHS: &influxql.IntegerLiteral{Val: -1}, RHS: x}, nil
+		}
+	case *BinaryExpr:
+		op, ok := arithmeticTokens[e.Op]
+		if !ok {
+			break
+		}
+		lhs, err := arithmetic(param, e.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := arithmetic(param, e.RHS)
+		if err != nil {
+			return nil, err
+		}
+		return &influxql.ParenExpr{Expr: &influxql.BinaryExpr{Op: op, LHS: lhs, RHS: rhs}}, nil
+	}
+	return nil, fmt.Errorf("map: _value must be an arithmetic expression of %s._value and numbers", param)
+}
+
+// compose returns the expression of next applied to the result of prev.
+func compose(prev, next influxql.Expr) influxql.Expr {
+	if prev == nil {
+		return next
+	}
+	if next == nil {
+		return prev
+	}
+	return substitute(next, prev)
+}
+
+// substitute replaces the _value variable of the template with the expression.
+func substitute(template, value influxql.Expr) influxql.Expr {
+	return influxql.RewriteExpr(influxql.CloneExpr(template), func(e influxql.Expr) influxql.Expr {
+		if ref, ok := e.(*influxql.VarRef); ok && ref.Val == valueColumn {
+			return influxql.CloneExpr(value)
+		}
+		return e
+	})
+}
+
+func (c *compiler) yield(p *pipeline, call *CallExpr) error {
+	m, err := args(call, "name")
+	if err != nil {
+		return err
+	}
+	if m["name"] != nil {
+		name, err := stringArg(call, m["name"], "name")
+		if err != nil {
+			return err
+		}
+		p.query.Result = name
+	}
+	return nil
+}
+
+// build translates the pipeline into the select statement:
+//
+//	SELECT map(field) AS field FROM m WHERE time AND tags AND value GROUP BY *
+//	SELECT map(agg(field)) AS field FROM m WHERE ... GROUP BY time(every), tags fill(null|none)
+//
+// A map before aggregateWindow is calculated by a subquery.
+func (p *pipeline) build() error {
+	q := p.query
+	if (p.measurements != nil && len(p.measurements) == 0) || (p.fields != nil && len(p.fields) == 0) {
+		// the filters match nothing
+		return nil
+	}
+	sources := p.sources()
+	cond := and(&influxql.BinaryExpr{
+		Op:  influxql.GTE,
+		LHS: &influxql.VarRef{Val: "time"},
+		RHS: &influxql.TimeLiteral{Val: q.start},
+	}, &influxql.BinaryExpr{
+		Op:  influxql.LT,
+		LHS: &influxql.VarRef{Val: "time"},
+		RHS: &influxql.TimeLiteral{Val: q.stop},
+	})
+	timeCond := cond
+	cond = and(cond, p.tagCond)
+
+	if p.valueCond != nil {
+		if len(p.fields) != 1 {
+			return fmt.Errorf("filter: a condition on %s requires exactly one %s", valueColumn, fieldColumn)
+		}
+		cond = and(cond, substitute(p.valueCond, &influxql.VarRef{Val: p.fields[0]}))
+	}
+
+	if p.aggregate == "" {
+		fields, err := p.selectFields(compose(p.preMap, p.postMap), nil)
+		if err != nil {
+			return err
+		}
+		q.Statement = &influxql.SelectStatement{
+			Fields:     fields,
+			Sources:    sources,
+			Condition:  cond,
+			Dimensions: influxql.Dimensions{{Expr: &influxql.Wildcard{}}},
+		}
+		return nil
+	}
+
+	if p.preMap != nil {
+		fields, err := p.selectFields(p.preMap, nil)
+		if err != nil {
+			return err
+		}
+		sources = influxql.Sources{&influxql.SubQuery{Statement: &influxql.SelectStatement{
+			Fields:     fields,
+			Sources:    sources,
+			Condition:  cond,
+			Dimensions: influxql.Dimensions{{Expr: &influxql.Wildcard{}}},
+		}}}
+		cond = timeCond
+	}
+
+	fields, err := p.selectFields(p.postMap, func(ref influxql.Expr) influxql.Expr {
+		return &influxql.Call{Name: p.aggregate, Args: []influxql.Expr{ref}}
+	})
+	if err != nil {
+		return err
+	}
+
+	dims := influxql.Dimensions{{Expr: &influxql.Call{
+		Name: "time",
+		Args: []influxql.Expr{&influxql.DurationLiteral{Val: q.every}},
+	}}}
+	if p.groupedBefore {
+		for _, col := range p.windowGroupBy {
+			switch col {
+			case measurementColumn, fieldColumn, startColumn, stopColumn:
+				// the series are always grouped by measurement and field
+			default:
+				dims = append(dims, &influxql.Dimension{Expr: &influxql.VarRef{Val: col}})
+			}
+		}
+	} else {
+		dims = append(dims, &influxql.Dimension{Expr: &influxql.Wildcard{}})
+	}
+
+	q.Statement = &influxql.SelectStatement{
+		Fields:     fields,
+		Sources:    sources,
+		Condition:  cond,
+		Dimensions: dims,
+	}
+	if !q.createEmpty {
+		q.Statement.Fill = influxql.NoFill
+	}
+	return nil
+}
+
+// sources returns the measurements of the pipeline, the database and retention policy are set when it is executed.
+func (p *pipeline) sources() influxql.Sources {
+	if p.measurements == nil {
+		re := p.measurementR
+		if re == nil {
+			re = regexp.MustCompile(`.*`)
+		}
+		return influxql.Sources{&influxql.Measurement{Regex: &influxql.RegexLiteral{Val: re}}}
+	}
+
+	sources := make(influxql.Sources, 0, len(p.measurements))
+	for _, name := range p.measurements {
+		sources = append(sources, &influxql.Measurement{Name: name})
+	}
+	return sources
+}
+
+// selectFields returns the fields selected as themselves, the aggregate of all fields is selected by a wildcard.
+func (p *pipeline) selectFields(mapExpr influxql.Expr, aggregate func(influxql.Expr) influxql.Expr) (influxql.Fields, error) {
+	if p.fields == nil {
+		if mapExpr != nil {
+			return nil, fmt.Errorf("map: a filter on %s is required", fieldColumn)
+		}
+		if aggregate == nil {
+			return influxql.Fields{{Expr: &influxql.Wildcard{Type: influxql.FIELD}}}, nil
+		}
+		p.query.columnPrefix = p.aggregate + "_"
+		return influxql.Fields{{Expr: aggregate(&influxql.Wildcard{})}}, nil
+	}
+
+	fields := make(influxql.Fields, 0, len(p.fields))
+	for _, name := range p.fields {
+		var expr influxql.Expr = &influxql.VarRef{Val: name}
+		if aggregate != nil {
+			expr = aggregate(expr)
+		}
+		if mapExpr != nil {
+			expr = substitute(mapExpr, expr)
+		}
+		fields = append(fields, &influxql.Field{Expr: expr, Alias: name})
+	}
+	return fields, nil
+}
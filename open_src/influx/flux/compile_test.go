@@ -0,0 +1,168 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/yacc"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+
+func compile(t *testing.T, script string) []*Query {
+	prog, err := Parse(script)
+	require.NoError(t, err)
+	queries, err := Compile(prog, testNow)
+	require.NoError(t, err)
+	return queries
+}
+
+func TestCompile(t *testing.T) {
+	const timeCond = `time >= '2022-01-01T00:00:00Z' AND time < '2022-01-01T01:00:00Z'`
+	tests := []struct {
+		script string
+		sql    string
+	}{
+		{
+			script: `from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")`,
+			sql:    `SELECT *::field FROM cpu WHERE ` + timeCond + ` GROUP BY *`,
+		},
+		{
+			script: `from(bucket: "db0/rp0")
+				|> range(start: 2022-01-01T00:00:00Z, stop: now())
+				// fields and tags
+				|> filter(fn: (r) => r._measurement == "cpu" and (r._field == "usage" or r["_field"] == "idle"))
+				|> filter(fn: (r) => r.host == "a" or r.region =~ /^cn-/)`,
+			sql: `SELECT usage AS usage, idle AS idle FROM cpu WHERE ` + timeCond + ` AND (host = 'a' OR region =~ /^cn-/) GROUP BY *`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: 1640995200, stop: 1640998800)
+				|> filter(fn: (r) => r._measurement =~ /cpu|mem/ and r._field == "usage" and r._value > 10)`,
+			sql: `SELECT usage AS usage FROM /cpu|mem/ WHERE ` + timeCond + ` AND usage > 10 GROUP BY *`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: -1h)
+				|> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage")
+				|> map(fn: (r) => ({r with _value: r._value * 2.0}))
+				|> map(fn: (r) => ({r with _value: -r._value + 1}))`,
+			sql: `SELECT (-1 * (usage * 2.000000000) + 1) AS usage FROM cpu WHERE ` + timeCond + ` GROUP BY *`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: -1h)
+				|> filter(fn: (r) => r._measurement == "cpu")
+				|> aggregateWindow(every: 10m, fn: mean, createEmpty: false)
+				|> yield(name: "mean")`,
+			sql: `SELECT mean(*) FROM cpu WHERE ` + timeCond + ` GROUP BY time(10m), * fill(none)`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: -1h)
+				|> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage")
+				|> group(columns: ["host", "_measurement"])
+				|> aggregateWindow(every: 1m, fn: max)
+				|> map(fn: (r) => ({r with _value: r._value / 100}))`,
+			sql: `SELECT (max(usage) / 100) AS usage FROM cpu WHERE ` + timeCond + ` GROUP BY time(1m), host`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: -1h)
+				|> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage")
+				|> map(fn: (r) => ({r with _value: r._value * 8}))
+				|> aggregateWindow(every: 1m, fn: sum)`,
+			sql: `SELECT sum(usage) AS usage FROM (SELECT (usage * 8) AS usage FROM cpu WHERE ` + timeCond +
+				` GROUP BY *) WHERE ` + timeCond + ` GROUP BY time(1m), *`,
+		},
+	}
+
+	for _, tt := range tests {
+		queries := compile(t, tt.script)
+		require.Equal(t, 1, len(queries))
+		require.Equal(t, tt.sql, queries[0].Statement.String(), tt.script)
+
+		// the statement is executed after it is parsed again
+		YyParser := &yacc.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(queries[0].Statement.String()))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		require.NoError(t, err, tt.sql)
+	}
+}
+
+func TestCompile_Program(t *testing.T) {
+	queries := compile(t, `
+		data = from(bucket: "db0/rp0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")
+		data |> yield(name: "raw")
+		data |> aggregateWindow(every: 5m, fn: count) |> yield(name: "count")
+		from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu" and r._field == "a" and r._field == "b")`)
+	require.Equal(t, 3, len(queries))
+	require.Equal(t, "raw", queries[0].Result)
+	require.Equal(t, "db0", queries[0].Database)
+	require.Equal(t, "rp0", queries[0].RetentionPolicy)
+	require.Equal(t, "count", queries[1].Result)
+	require.Equal(t, DefaultResultName, queries[2].Result)
+	require.Nil(t, queries[2].Statement)
+}
+
+func TestCompile_Error(t *testing.T) {
+	tests := map[string]string{
+		`from(bucket: "db0") |> filter(fn: (r) => r._measurement == "cpu")`:                                     "range must follow from",
+		`from(bucket: "db0") |> range(start: -1h) |> limit(n: 10)`:                                              "function limit is not supported",
+		`from(bucket: "db0") |> range(start: -1h) |> yield() |> yield()`:                                        "yield must be the last call of a pipeline",
+		`from(bucket: "db0") |> range(start: -1h) |> range(start: -2h)`:                                         "range must be called once",
+		`from(bucket: "db0") |> range(start: -1h, stop: -2h)`:                                                   "range: start must be before stop",
+		`from(bucket: "db0") |> range(start: -1mo)`:                                                             `unsupported duration unit "mo" in 1mo`,
+		`from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "a" or r.host == "b")`: "must not refer to other columns",
+		`from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._time > 0)`:                            "use range instead",
+		`from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r.host == 1)`:                            "tag host must be compared with a string",
+		`from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._value > 1)`:                           "requires exactly one _field",
+		`from(bucket: "db0") |> range(start: -1h) |> map(fn: (r) => ({r with _value: r._value * 2}))`:           "map: a filter on _field is required",
+		`from(bucket: "db0") |> range(start: -1h) |> map(fn: (r) => ({r with host: "a"}))`:                      "map: fn must return {r with _value: ...}",
+		`from(bucket: "db0") |> range(start: -1h) |> aggregateWindow(every: 1m, fn: mode)`:                      "function mode is not supported",
+		`from(bucket: "db0") |> range(start: -1h) |> group(columns: ["host"], mode: "except")`:                  "group: mode except is not supported",
+		`from(bucket: "db0") |> range(start: -1h) |> group() |> filter(fn: (r) => r.host == "a")`:               "filter must come before",
+		`a = from(bucket: "db0") |> range(start: -1h)`:                                                          "no pipeline is yielded",
+		`from(bucket: "db0") |> range(start: -1h) from(bucket: "db1") |> range(start: -1h)`:                     `duplicate result name "_result"`,
+	}
+	for script, msg := range tests {
+		prog, err := Parse(script)
+		if err == nil {
+			_, err = Compile(prog, testNow)
+		}
+		require.Error(t, err, script)
+		require.Contains(t, err.Error(), msg, script)
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	tests := map[string]string{
+		`import "strings"`:                 "import statement is not supported",
+		`from(bucket: "db0"`:               "expected )",
+		`from(bucket: "db0) |> range()`:    "unterminated string literal",
+		`from(bucket: "db0") |> range`:     "pipe destination must be a function call",
+		`f(fn: (r) => r.host =~ /a)`:       "unterminated regex literal",
+		`from(bucket: "db0") |> range(#)`:  "unexpected character '#'",
+		`from(bucket: "db0") |> range(1:)`: "expected property key",
+	}
+	for script, msg := range tests {
+		_, err := Parse(script)
+		require.Error(t, err, script)
+		require.Contains(t, err.Error(), msg, script)
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	AnnotationDatatype = "datatype"
+	AnnotationGroup    = "group"
+	AnnotationDefault  = "default"
+)
+
+// Dialect is the format of the annotated CSV.
+type Dialect struct {
+	Header      bool
+	Delimiter   rune
+	Annotations []string
+}
+
+// DefaultDialect writes the header and all annotations.
+func DefaultDialect() Dialect {
+	return Dialect{
+		Header:      true,
+		Delimiter:   ',',
+		Annotations: []string{AnnotationDatatype, AnnotationGroup, AnnotationDefault},
+	}
+}
+
+// ResultEncoder writes the tables of the results in annotated CSV,
+// the annotations and the header are written again whenever the schema of the tables changes.
+// The tables of a result may be encoded by several calls as they are read, the ids of the tables go on.
+type ResultEncoder struct {
+	dialect Dialect
+	w       *csv.Writer
+	written bool
+
+	result     string
+	table      int
+	prevSchema []string
+}
+
+func NewResultEncoder(w io.Writer, dialect Dialect) *ResultEncoder {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+	if dialect.Delimiter != 0 {
+		cw.Comma = dialect.Delimiter
+	}
+	return &ResultEncoder{dialect: dialect, w: cw}
+}
+
+// Encode writes the tables of a result, and flushes them to the underlying writer.
+func (e *ResultEncoder) Encode(result string, tables []*Table) error {
+	if result != e.result {
+		e.result = result
+		e.table = 0
+		e.prevSchema = nil
+	}
+	for _, t := range tables {
+		types := columnTypes(t)
+		schema := make([]string, 0, 2*len(t.Columns))
+		schema = append(schema, t.Columns...)
+		schema = append(schema, types...)
+		for _, c := range t.Columns {
+			schema = append(schema, strconv.FormatBool(t.isKey(c)))
+		}
+
+		if !equalStrings(schema, e.prevSchema) {
+			if err := e.writeSchema(result, t, types); err != nil {
+				return err
+			}
+			e.prevSchema = schema
+		}
+
+		record := make([]string, len(t.Columns)+3)
+		record[2] = strconv.Itoa(e.table)
+		e.table++
+		for _, rec := range t.Records {
+			for j, v := range rec {
+				record[j+3] = valueString(v)
+			}
+			if err := e.w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// EncodeError writes the error table which ends a response whose tables are partly written.
+func (e *ResultEncoder) EncodeError(err error) error {
+	if e.written {
+		e.w.Flush()
+		if err := e.w.Write(nil); err != nil {
+			return err
+		}
+	}
+	e.written = true
+	for _, record := range [][]string{
+		{"#" + AnnotationDatatype, "string", "string"},
+		{"#" + AnnotationGroup, "true", "true"},
+		{"#" + AnnotationDefault, "", ""},
+		{"", "error", "reference"},
+		{"", err.Error(), ""},
+	} {
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *ResultEncoder) writeSchema(result string, t *Table, types []string) error {
+	// blocks of tables are separated by an empty line
+	if e.written {
+		e.w.Flush()
+		if err := e.w.Error(); err != nil {
+			return err
+		}
+		if err := e.w.Write(nil); err != nil {
+			return err
+		}
+	}
+	e.written = true
+
+	n := len(t.Columns) + 3
+	for _, annotation := range e.dialect.Annotations {
+		record := make([]string, n)
+		record[0] = "#" + annotation
+		switch annotation {
+		case AnnotationDatatype:
+			record[1], record[2] = "string", "long"
+			copy(record[3:], types)
+		case AnnotationGroup:
+			record[1], record[2] = "false", "false"
+			for i, c := range t.Columns {
+				record[i+3] = strconv.FormatBool(t.isKey(c))
+			}
+		case AnnotationDefault:
+			record[1] = result
+		default:
+			return fmt.Errorf("unknown annotation %s", annotation)
+		}
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	if !e.dialect.Header {
+		return nil
+	}
+	header := make([]string, 0, n)
+	header = append(header, "", "result", "table")
+	return e.w.Write(append(header, t.Columns...))
+}
+
+// columnTypes returns the annotated types of the columns by their first non-null value.
+func columnTypes(t *Table) []string {
+	types := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		types[i] = "string"
+		if c == valueColumn {
+			types[i] = "double"
+		}
+		for _, rec := range t.Records {
+			if rec[i] != nil {
+				types[i] = valueType(rec[i])
+				break
+			}
+		}
+	}
+	return types
+}
+
+func valueType(v interface{}) string {
+	switch v.(type) {
+	case float64:
+		return "double"
+	case int64:
+		return "long"
+	case uint64:
+		return "unsignedLong"
+	case bool:
+		return "boolean"
+	case time.Time:
+		return "dateTime:RFC3339"
+	default:
+		return "string"
+	}
+}
+
+func valueString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/require"
+)
+
+func testTime(minute int) time.Time {
+	return time.Date(2022, 1, 1, 0, minute, 0, 0, time.UTC)
+}
+
+func encode(t *testing.T, dialect Dialect, q *Query, rows models.Rows) string {
+	var buf bytes.Buffer
+	require.NoError(t, NewResultEncoder(&buf, dialect).Encode(q.Result, q.Tables(rows)))
+	return strings.ReplaceAll(buf.String(), "\r\n", "\n")
+}
+
+func TestEncode_Raw(t *testing.T) {
+	q := compile(t, `from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")`)[0]
+	rows := models.Rows{
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "a"},
+			Columns: []string{"time", "usage", "state"},
+			Values: [][]interface{}{
+				{testTime(1), 1.5, "ok"},
+				{testTime(2), nil, "bad, \"really\""},
+			},
+		},
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "b"},
+			Columns: []string{"time", "usage", "state"},
+			Values:  [][]interface{}{{testTime(3), 2.5, nil}},
+		},
+	}
+
+	require.Equal(t, `#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:01:00Z,1.5,usage,cpu,a
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,1,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:01:00Z,ok,state,cpu,a
+,,1,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:02:00Z,"bad, ""really""",state,cpu,a
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,2,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:03:00Z,2.5,usage,cpu,b
+`, encode(t, DefaultDialect(), q, rows))
+}
+
+func TestEncode_Window(t *testing.T) {
+	q := compile(t, `from(bucket: "db0") |> range(start: -1h, stop: 2022-01-01T00:25:00Z)
+		|> filter(fn: (r) => r._measurement == "cpu")
+		|> aggregateWindow(every: 10m, fn: count)
+		|> yield(name: "count")`)[0]
+	rows := models.Rows{{
+		Name:    "cpu",
+		Tags:    map[string]string{"host": "a"},
+		Columns: []string{"time", "count_usage"},
+		Values: [][]interface{}{
+			{testTime(0), int64(3)},
+			{testTime(10), nil},
+			{testTime(20), int64(1)},
+		},
+	}}
+
+	// the time of a window is its stop, the last one is truncated by the range
+	dialect := Dialect{Header: true}
+	require.Equal(t, `,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,0,2022-01-01T00:00:00Z,2022-01-01T00:25:00Z,2022-01-01T00:10:00Z,3,usage,cpu,a
+,,0,2022-01-01T00:00:00Z,2022-01-01T00:25:00Z,2022-01-01T00:20:00Z,,usage,cpu,a
+,,0,2022-01-01T00:00:00Z,2022-01-01T00:25:00Z,2022-01-01T00:25:00Z,1,usage,cpu,a
+`, encode(t, dialect, q, rows))
+}
+
+func TestEncode_Group(t *testing.T) {
+	q := compile(t, `from(bucket: "db0") |> range(start: -1h)
+		|> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage")
+		|> group(columns: ["region"])`)[0]
+	rows := models.Rows{
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "a", "region": "east"},
+			Columns: []string{"time", "usage"},
+			Values:  [][]interface{}{{testTime(1), int64(1)}},
+		},
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "b", "region": "west"},
+			Columns: []string{"time", "usage"},
+			Values:  [][]interface{}{{testTime(2), int64(2)}},
+		},
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"region": "east", "zone": "z1"},
+			Columns: []string{"time", "usage"},
+			Values:  [][]interface{}{{testTime(3), int64(3)}},
+		},
+	}
+
+	dialect := Dialect{Header: true, Annotations: []string{AnnotationGroup}}
+	require.Equal(t, `#group,false,false,false,false,false,false,false,false,false,true,false
+,result,table,_start,_stop,_time,_value,_field,_measurement,host,region,zone
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:01:00Z,1,usage,cpu,a,east,
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:03:00Z,3,usage,cpu,,east,z1
+
+#group,false,false,false,false,false,false,false,false,false,true
+,result,table,_start,_stop,_time,_value,_field,_measurement,host,region
+,,1,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:02:00Z,2,usage,cpu,b,west
+`, encode(t, dialect, q, rows))
+}
+
+func TestEncode_Streamed(t *testing.T) {
+	q := compile(t, `from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")`)[0]
+	series := func(host string, minute int) models.Rows {
+		return models.Rows{{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": host},
+			Columns: []string{"time", "usage"},
+			Values:  [][]interface{}{{testTime(minute), int64(minute)}},
+		}}
+	}
+
+	// the tables encoded by the next call go on with the ids and the schema of the result
+	var buf bytes.Buffer
+	enc := NewResultEncoder(&buf, Dialect{Header: true})
+	require.NoError(t, enc.Encode(q.Result, q.Tables(series("a", 1))))
+	require.NoError(t, enc.Encode(q.Result, q.Tables(series("b", 2))))
+	require.NoError(t, enc.EncodeError(errors.New("query aborted")))
+	require.Equal(t, `,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:01:00Z,1,usage,cpu,a
+,,1,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:02:00Z,2,usage,cpu,b
+
+#datatype,string,string
+#group,true,true
+#default,,
+,error,reference
+,query aborted,
+`, strings.ReplaceAll(buf.String(), "\r\n", "\n"))
+}
@@ -0,0 +1,511 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Program is a parsed Flux script.
+type Program struct {
+	Statements []Statement
+}
+
+// Statement is either an assignment or an expression whose tables are yielded.
+type Statement struct {
+	Name string // set for an assignment
+	Expr Expr
+}
+
+// Expr is a node of a Flux expression.
+type Expr interface {
+	expr()
+}
+
+type (
+	Identifier      struct{ Name string }
+	StringLiteral   struct{ Val string }
+	IntegerLiteral  struct{ Val int64 }
+	FloatLiteral    struct{ Val float64 }
+	DurationLiteral struct{ Val time.Duration }
+	TimeLiteral     struct{ Val time.Time }
+	RegexLiteral    struct{ Val *regexp.Regexp }
+	ArrayExpr       struct{ Elements []Expr }
+
+	// ObjectExpr is an object like {a: 1} or {r with _value: 1}
+	ObjectExpr struct {
+		With       *Identifier
+		Properties []*Property
+	}
+
+	Property struct {
+		Key   string
+		Value Expr
+	}
+
+	// MemberExpr is a property access like r.host or r["host"]
+	MemberExpr struct {
+		Object   Expr
+		Property string
+	}
+
+	// CallExpr is a call with named arguments like range(start: -1h)
+	CallExpr struct {
+		Callee Expr
+		Args   *ObjectExpr
+	}
+
+	// PipeExpr passes the tables of Arg to Call
+	PipeExpr struct {
+		Arg  Expr
+		Call *CallExpr
+	}
+
+	FunctionExpr struct {
+		Params []string
+		Body   Expr
+	}
+
+	// BinaryExpr is an arithmetic, comparison or logical(and, or) expression
+	BinaryExpr struct {
+		Op  string
+		LHS Expr
+		RHS Expr
+	}
+
+	// UnaryExpr is -x or not x
+	UnaryExpr struct {
+		Op string
+		X  Expr
+	}
+)
+
+func (*Identifier) expr()      {}
+func (*StringLiteral) expr()   {}
+func (*IntegerLiteral) expr()  {}
+func (*FloatLiteral) expr()    {}
+func (*DurationLiteral) expr() {}
+func (*TimeLiteral) expr()     {}
+func (*RegexLiteral) expr()    {}
+func (*ArrayExpr) expr()       {}
+func (*ObjectExpr) expr()      {}
+func (*MemberExpr) expr()      {}
+func (*CallExpr) expr()        {}
+func (*PipeExpr) expr()        {}
+func (*FunctionExpr) expr()    {}
+func (*BinaryExpr) expr()      {}
+func (*UnaryExpr) expr()       {}
+
+// Parse parses the subset of Flux made of assignments and expressions, options and imports are not supported.
+func Parse(script string) (*Program, error) {
+	tokens, err := scan(script)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	prog := &Program{}
+	for p.peek().kind != tokEOF {
+		stmt, err := p.parseStatement()
+		if err != nil {
+			return nil, err
+		}
+		prog.Statements = append(prog.Statements, stmt)
+	}
+	return prog, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) peekN(n int) token {
+	if p.i+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.i+n]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *parser) isOp(op string) bool {
+	tok := p.peek()
+	return tok.kind == tokOp && tok.lit == op
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	tok := p.peek()
+	return tok.kind == tokIdent && tok.lit == kw
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.isOp(op) {
+		return p.unexpected(op)
+	}
+	p.i++
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	tok := p.peek()
+	return fmt.Errorf("found %s at %d, expected %s", tok, tok.pos, expected)
+}
+
+func (p *parser) parseStatement() (Statement, error) {
+	switch {
+	case p.isKeyword("option"), p.isKeyword("import"), p.isKeyword("package"):
+		return Statement{}, fmt.Errorf("%s statement is not supported", p.peek().lit)
+	case p.peek().kind == tokIdent && p.peekN(1).kind == tokOp && p.peekN(1).lit == "=":
+		name := p.next().lit
+		p.next()
+		expr, err := p.parseExpr()
+		return Statement{Name: name, Expr: expr}, err
+	}
+	expr, err := p.parseExpr()
+	return Statement{Expr: expr}, err
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseLogical(0)
+}
+
+// logicalOps are ordered by precedence, from low to high
+var logicalOps = []string{"or", "and"}
+
+func (p *parser) parseLogical(level int) (Expr, error) {
+	if level == len(logicalOps) {
+		return p.parseNot()
+	}
+
+	lhs, err := p.parseLogical(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword(logicalOps[level]) {
+		p.next()
+		rhs, err := p.parseLogical(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: logicalOps[level], LHS: lhs, RHS: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.isKeyword("not") {
+		p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryExpr{Op: "not", X: x}, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisonOps = map[string]bool{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true, "=~": true, "!~": true}
+
+func (p *parser) parseComparison() (Expr, error) {
+	lhs, err := p.parseBinary(additiveOps)
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.kind == tokOp && comparisonOps[tok.lit]; tok = p.peek() {
+		p.next()
+		rhs, err := p.parseBinary(additiveOps)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: tok.lit, LHS: lhs, RHS: rhs}
+	}
+	return lhs, nil
+}
+
+var (
+	additiveOps       = map[string]bool{"+": true, "-": true}
+	multiplicativeOps = map[string]bool{"*": true, "/": true, "%": true}
+)
+
+func (p *parser) parseBinary(ops map[string]bool) (Expr, error) {
+	parseOperand := func() (Expr, error) {
+		if ops["+"] {
+			return p.parseBinary(multiplicativeOps)
+		}
+		return p.parseUnary()
+	}
+
+	lhs, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.kind == tokOp && ops[tok.lit]; tok = p.peek() {
+		p.next()
+		rhs, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: tok.lit, LHS: lhs, RHS: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.isOp("-") || p.isOp("+") {
+		op := p.next().lit
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case *IntegerLiteral:
+			if op == "-" {
+				x.Val = -x.Val
+			}
+			return x, nil
+		case *FloatLiteral:
+			if op == "-" {
+				x.Val = -x.Val
+			}
+			return x, nil
+		case *DurationLiteral:
+			if op == "-" {
+				x.Val = -x.Val
+			}
+			return x, nil
+		}
+		return &UnaryExpr{Op: op, X: x}, nil
+	}
+	return p.parsePipe()
+}
+
+func (p *parser) parsePipe() (Expr, error) {
+	x, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("|>") {
+		p.next()
+		call, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		c, ok := call.(*CallExpr)
+		if !ok {
+			return nil, fmt.Errorf("pipe destination must be a function call")
+		}
+		x = &PipeExpr{Arg: x, Call: c}
+	}
+	return x, nil
+}
+
+func (p *parser) parsePostfix() (Expr, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isOp("."):
+			p.next()
+			tok := p.next()
+			if tok.kind != tokIdent {
+				p.i--
+				return nil, p.unexpected("identifier")
+			}
+			x = &MemberExpr{Object: x, Property: tok.lit}
+		case p.isOp("["):
+			p.next()
+			tok := p.next()
+			if tok.kind != tokString {
+				p.i--
+				return nil, p.unexpected("string")
+			}
+			if err := p.expectOp("]"); err != nil {
+				return nil, err
+			}
+			x = &MemberExpr{Object: x, Property: tok.lit}
+		case p.isOp("("):
+			p.next()
+			args, err := p.parseProperties(")")
+			if err != nil {
+				return nil, err
+			}
+			x = &CallExpr{Callee: x, Args: args}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokIdent:
+		p.next()
+		return &Identifier{Name: tok.lit}, nil
+	case tokString:
+		p.next()
+		return &StringLiteral{Val: tok.lit}, nil
+	case tokInt:
+		p.next()
+		v, err := strconv.ParseInt(tok.lit, 10, 64)
+		return &IntegerLiteral{Val: v}, err
+	case tokFloat:
+		p.next()
+		v, err := strconv.ParseFloat(tok.lit, 64)
+		return &FloatLiteral{Val: v}, err
+	case tokDuration:
+		p.next()
+		d, err := parseDuration(tok.lit)
+		return &DurationLiteral{Val: d}, err
+	case tokTime:
+		p.next()
+		t, err := parseTime(tok.lit)
+		return &TimeLiteral{Val: t}, err
+	case tokRegex:
+		p.next()
+		re, err := regexp.Compile(tok.lit)
+		return &RegexLiteral{Val: re}, err
+	}
+
+	switch {
+	case p.isOp("("):
+		if fn, ok, err := p.tryParseFunction(); ok || err != nil {
+			return fn, err
+		}
+		p.next()
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expectOp(")")
+	case p.isOp("["):
+		p.next()
+		arr := &ArrayExpr{}
+		for !p.isOp("]") {
+			elem, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			arr.Elements = append(arr.Elements, elem)
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		return arr, p.expectOp("]")
+	case p.isOp("{"):
+		p.next()
+		obj := &ObjectExpr{}
+		if p.peek().kind == tokIdent && p.peekN(1).kind == tokIdent && p.peekN(1).lit == "with" {
+			obj.With = &Identifier{Name: p.next().lit}
+			p.next()
+		}
+		props, err := p.parseProperties("}")
+		if err != nil {
+			return nil, err
+		}
+		obj.Properties = props.Properties
+		return obj, nil
+	}
+	return nil, p.unexpected("expression")
+}
+
+// parseProperties parses the properties until the closing op, like a: 1, b: 2)
+func (p *parser) parseProperties(closing string) (*ObjectExpr, error) {
+	obj := &ObjectExpr{}
+	for !p.isOp(closing) {
+		tok := p.next()
+		if tok.kind != tokIdent && tok.kind != tokString {
+			p.i--
+			return nil, p.unexpected("property key")
+		}
+		if err := p.expectOp(":"); err != nil {
+			return nil, err
+		}
+		val, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		obj.Properties = append(obj.Properties, &Property{Key: tok.lit, Value: val})
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	return obj, p.expectOp(closing)
+}
+
+// tryParseFunction parses a function like (r) => r._value > 0,
+// the position is restored if the parenthesis does not start a function.
+func (p *parser) tryParseFunction() (Expr, bool, error) {
+	start := p.i
+	p.next()
+
+	fn := &FunctionExpr{}
+	for !p.isOp(")") {
+		tok := p.next()
+		if tok.kind != tokIdent {
+			p.i = start
+			return nil, false, nil
+		}
+		fn.Params = append(fn.Params, tok.lit)
+		if p.isOp("=") {
+			p.i = start
+			return nil, false, fmt.Errorf("default value of a function parameter is not supported")
+		}
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	if !p.isOp(")") || p.peekN(1).kind != tokOp || p.peekN(1).lit != "=>" {
+		p.i = start
+		return nil, false, nil
+	}
+	p.i += 2
+
+	// a block body must be a single return statement
+	if p.isOp("{") && p.peekN(1).kind == tokIdent && p.peekN(1).lit == "return" {
+		p.i += 2
+		body, err := p.parseExpr()
+		if err != nil {
+			return nil, true, err
+		}
+		fn.Body = body
+		return fn, true, p.expectOp("}")
+	}
+
+	body, err := p.parseExpr()
+	fn.Body = body
+	return fn, true, err
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokDuration
+	tokTime
+	tokRegex
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	lit  string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "EOF"
+	}
+	return t.lit
+}
+
+// operators are matched longest first
+var operators = []string{
+	"|>", "=>", "==", "!=", "<=", ">=", "=~", "!~",
+	"<", ">", "=", "+", "-", "*", "/", "%", "(", ")", "[", "]", "{", "}", ",", ":", ".",
+}
+
+// scan splits the script into tokens, a regex literal is only expected after =~ and !~.
+func scan(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		start := i
+		var tok token
+		var err error
+		switch {
+		case c == '/' && len(tokens) > 0 && tokens[len(tokens)-1].kind == tokOp &&
+			(tokens[len(tokens)-1].lit == "=~" || tokens[len(tokens)-1].lit == "!~"):
+			tok, i, err = scanRegex(src, i)
+		case c == '"':
+			tok, i, err = scanString(src, i)
+		case c >= '0' && c <= '9':
+			tok, i, err = scanNumber(src, i)
+		case c == '_' || isLetter(src[i:]):
+			for i < len(src) && (src[i] == '_' || isLetter(src[i:]) || (src[i] >= '0' && src[i] <= '9')) {
+				_, n := utf8.DecodeRuneInString(src[i:])
+				i += n
+			}
+			tok = token{kind: tokIdent, lit: src[start:i]}
+		default:
+			for _, op := range operators {
+				if strings.HasPrefix(src[i:], op) {
+					tok = token{kind: tokOp, lit: op}
+					i += len(op)
+					break
+				}
+			}
+			if i == start {
+				return nil, fmt.Errorf("unexpected character %q at %d", c, start)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		tok.pos = start
+		tokens = append(tokens, tok)
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(src)}), nil
+}
+
+func isLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+func scanString(src string, i int) (token, int, error) {
+	var b strings.Builder
+	for i++; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '"':
+			return token{kind: tokString, lit: b.String()}, i + 1, nil
+		case '\\':
+			i++
+			if i == len(src) {
+				break
+			}
+			switch src[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(src[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return token{}, i, fmt.Errorf("unterminated string literal")
+}
+
+func scanRegex(src string, i int) (token, int, error) {
+	var b strings.Builder
+	for i++; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '/':
+			return token{kind: tokRegex, lit: b.String()}, i + 1, nil
+		case '\\':
+			if i+1 < len(src) && src[i+1] == '/' {
+				i++
+				b.WriteByte('/')
+				continue
+			}
+			b.WriteByte(c)
+		case '\n':
+			return token{}, i, fmt.Errorf("unterminated regex literal")
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return token{}, i, fmt.Errorf("unterminated regex literal")
+}
+
+// scanNumber scans an integer, a float, a duration like 1h30m or a time like 2022-01-01T00:00:00Z.
+func scanNumber(src string, i int) (token, int, error) {
+	start := i
+	i = skipDigits(src, i)
+
+	if i-start == 4 && i < len(src) && src[i] == '-' {
+		for i < len(src) && strings.IndexByte("0123456789-:.TZ+", src[i]) >= 0 {
+			i++
+		}
+		return token{kind: tokTime, lit: src[start:i]}, i, nil
+	}
+
+	if i+1 < len(src) && src[i] == '.' && src[i+1] >= '0' && src[i+1] <= '9' {
+		i = skipDigits(src, i+1)
+		return token{kind: tokFloat, lit: src[start:i]}, i, nil
+	}
+
+	if i < len(src) && isLetter(src[i:]) {
+		for i < len(src) {
+			j := i
+			for j < len(src) && isLetter(src[j:]) {
+				_, n := utf8.DecodeRuneInString(src[j:])
+				j += n
+			}
+			if j == i {
+				return token{}, i, fmt.Errorf("invalid duration %s", src[start:j])
+			}
+			i = j
+			if i == len(src) || src[i] < '0' || src[i] > '9' {
+				break
+			}
+			i = skipDigits(src, i)
+		}
+		return token{kind: tokDuration, lit: src[start:i]}, i, nil
+	}
+	return token{kind: tokInt, lit: src[start:i]}, i, nil
+}
+
+func skipDigits(src string, i int) int {
+	for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+// parseDuration parses a duration literal, the calendar units mo and y are not supported.
+func parseDuration(lit string) (time.Duration, error) {
+	var d time.Duration
+	for i := 0; i < len(lit); {
+		j := skipDigits(lit, i)
+		n := int64(0)
+		for _, c := range lit[i:j] {
+			n = n*10 + int64(c-'0')
+		}
+		i = j
+		for j < len(lit) && (lit[j] < '0' || lit[j] > '9') {
+			j++
+		}
+		unit, ok := durationUnits[lit[i:j]]
+		if !ok {
+			return 0, fmt.Errorf("unsupported duration unit %q in %s", lit[i:j], lit)
+		}
+		d += time.Duration(n) * unit
+		i = j
+	}
+	return d, nil
+}
+
+func parseTime(lit string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, lit); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", lit)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time literal %s", lit)
+	}
+	return t, nil
+}
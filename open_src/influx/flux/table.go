@@ -0,0 +1,185 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// Table is a Flux table, the records of a table share the values of the group key.
+type Table struct {
+	Columns []string
+	Key     []string
+	Records [][]interface{}
+}
+
+func (t *Table) isKey(column string) bool {
+	for _, k := range t.Key {
+		if k == column {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) index(column string) int {
+	for i, c := range t.Columns {
+		if c == column {
+			return i
+		}
+	}
+	return -1
+}
+
+// Tables turns the series returned by the statement into a table of each series and field,
+// the tables are regrouped if the pipeline calls group.
+func (q *Query) Tables(rows models.Rows) []*Table {
+	var tables []*Table
+	for _, row := range rows {
+		tagKeys := make([]string, 0, len(row.Tags))
+		for k := range row.Tags {
+			tagKeys = append(tagKeys, k)
+		}
+		sort.Strings(tagKeys)
+
+		columns := append([]string{startColumn, stopColumn, timeColumn, valueColumn, fieldColumn, measurementColumn}, tagKeys...)
+		key := append([]string{startColumn, stopColumn, fieldColumn, measurementColumn}, tagKeys...)
+
+		for ci := 1; ci < len(row.Columns); ci++ {
+			field := strings.TrimPrefix(row.Columns[ci], q.columnPrefix)
+			t := &Table{Columns: columns, Key: key}
+			for _, values := range row.Values {
+				if values[ci] == nil && !(q.every > 0 && q.createEmpty) {
+					continue
+				}
+				ts, ok := values[0].(time.Time)
+				if !ok {
+					continue
+				}
+				if q.every > 0 {
+					// the time of a window is its stop
+					ts = ts.Add(q.every)
+					if ts.After(q.stop) {
+						ts = q.stop
+					}
+				}
+
+				rec := make([]interface{}, 0, len(columns))
+				rec = append(rec, q.start, q.stop, ts, values[ci], field, row.Name)
+				for _, k := range tagKeys {
+					rec = append(rec, row.Tags[k])
+				}
+				t.Records = append(t.Records, rec)
+			}
+			if len(t.Records) > 0 {
+				tables = append(tables, t)
+			}
+		}
+	}
+
+	if q.groupBy != nil {
+		tables = regroup(tables, q.groupBy)
+	}
+	return tables
+}
+
+// regroup merges the tables by the values of the key, the columns missing in a table are null.
+func regroup(tables []*Table, key []string) []*Table {
+	var groups []*Table
+	byKey := make(map[string]*Table)
+	for _, t := range tables {
+		var sb strings.Builder
+		for _, k := range key {
+			if i := t.index(k); i >= 0 {
+				sb.WriteString(valueString(t.Records[0][i]))
+			}
+			sb.WriteByte(0)
+		}
+
+		g, ok := byKey[sb.String()]
+		if !ok {
+			g = &Table{Columns: append([]string(nil), key...), Key: key}
+			byKey[sb.String()] = g
+			groups = append(groups, g)
+		}
+		for _, c := range t.Columns {
+			if g.index(c) < 0 {
+				g.Columns = append(g.Columns, c)
+			}
+		}
+
+		for _, rec := range t.Records {
+			dst := make([]interface{}, len(g.Columns))
+			for i, c := range t.Columns {
+				dst[g.index(c)] = rec[i]
+			}
+			g.Records = append(g.Records, dst)
+		}
+	}
+
+	// the records added before a column is known are shorter
+	for _, g := range groups {
+		for i, rec := range g.Records {
+			if len(rec) < len(g.Columns) {
+				g.Records[i] = append(rec, make([]interface{}, len(g.Columns)-len(rec))...)
+			}
+		}
+		g.Columns = orderColumns(g.Columns, g.Records)
+	}
+	return groups
+}
+
+// columnOrder is the order of the columns of the tables returned by from, the tags follow them.
+var columnOrder = map[string]int{startColumn: 1, stopColumn: 2, timeColumn: 3, valueColumn: 4, fieldColumn: 5, measurementColumn: 6}
+
+// orderColumns puts the columns in the order of the tables returned by from, the records are reordered in place.
+func orderColumns(columns []string, records [][]interface{}) []string {
+	idx := make([]int, len(columns))
+	for i := range idx {
+		idx[i] = i
+	}
+	rank := func(c string) int {
+		if r, ok := columnOrder[c]; ok {
+			return r
+		}
+		return len(columnOrder) + 1
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		ri, rj := rank(columns[idx[i]]), rank(columns[idx[j]])
+		if ri != rj {
+			return ri < rj
+		}
+		return columns[idx[i]] < columns[idx[j]]
+	})
+
+	ordered := make([]string, len(columns))
+	for i, j := range idx {
+		ordered[i] = columns[j]
+	}
+	tmp := make([]interface{}, len(columns))
+	for _, rec := range records {
+		for i, j := range idx {
+			tmp[i] = rec[j]
+		}
+		copy(rec, tmp)
+	}
+	return ordered
+}
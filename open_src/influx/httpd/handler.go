@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math"
+	"mime"
 	"net/http"
 	"os"
 	"runtime/debug"
@@ -29,18 +30,22 @@ import (
 	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/audit"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/otlp"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/open_src/github.com/bmizerany/pat"
 	"github.com/openGemini/openGemini/open_src/influx/auth"
+	"github.com/openGemini/openGemini/open_src/influx/flux"
 	"github.com/openGemini/openGemini/open_src/influx/httpd/config"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/influx/promql"
 	query2 "github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/yacc"
@@ -72,6 +77,10 @@ const (
 
 	MaxDebugRequestsInterval = 6 * time.Hour
 
+	// the content types of the OTLP/HTTP requests
+	otlpProtobuf = "application/x-protobuf"
+	otlpJSON     = "application/json"
+
 	// fieldTagKey is the tag key that all field names use in the new storage processor
 	fieldTagKey = "_field"
 
@@ -122,6 +131,7 @@ type Handler struct {
 		AdminUserExists() bool
 		DataNodes() ([]meta2.DataNode, error)
 		ShowShards() models.Rows
+		RateLimits() []meta2.RateLimitInfo
 	}
 
 	QueryAuthorizer interface {
@@ -138,6 +148,9 @@ type Handler struct {
 
 	QueryExecutor *query2.Executor
 
+	// Records the sys ctrl calls and the failed logins, nil if the audit log is disabled.
+	Auditor audit.Recorder
+
 	Monitor interface {
 	}
 
@@ -154,6 +167,7 @@ type Handler struct {
 	requestTracker *httpd.RequestTracker
 	writeThrottler *Throttler
 	queryThrottler *Throttler
+	rateLimiter    *rateLimiter
 	slowQueries    chan *hybridqp.SelectDuration
 }
 
@@ -165,6 +179,7 @@ func NewHandler(c config.Config) *Handler {
 		Logger:         logger.NewLogger(errno.ModuleHTTP),
 		CLFLogger:      logger.GetLogger(),
 		requestTracker: httpd.NewRequestTracker(),
+		rateLimiter:    newRateLimiter(),
 		slowQueries:    make(chan *hybridqp.SelectDuration, 256),
 		QueryExecutor:  query2.NewExecutor(),
 	}
@@ -233,10 +248,42 @@ func NewHandler(c config.Config) *Handler {
 			"prometheus-write", // Prometheus remote write
 			"POST", "/api/v1/prom/write", false, true, h.servePromWrite,
 		},
+		Route{
+			"otlp-metrics", // OpenTelemetry OTLP/HTTP metrics export
+			"POST", "/v1/metrics", false, true, h.serveOTLPMetrics,
+		},
 		Route{
 			"prometheus-read", // Prometheus remote read
 			"POST", "/api/v1/prom/read", true, true, h.servePromRead,
 		},
+		Route{
+			"prometheus-query", // Prometheus instant query
+			"GET", "/api/v1/query", true, true, h.servePromQuery,
+		},
+		Route{
+			"prometheus-query", // Prometheus instant query
+			"POST", "/api/v1/query", true, true, h.servePromQuery,
+		},
+		Route{
+			"prometheus-query-range", // Prometheus range query
+			"GET", "/api/v1/query_range", true, true, h.servePromQueryRange,
+		},
+		Route{
+			"prometheus-query-range", // Prometheus range query
+			"POST", "/api/v1/query_range", true, true, h.servePromQueryRange,
+		},
+		Route{
+			"prometheus-labels", // Prometheus label names
+			"GET", "/api/v1/labels", true, true, h.servePromLabels,
+		},
+		Route{
+			"prometheus-label-values", // Prometheus label values
+			"GET", "/api/v1/label/:name/values", true, true, h.servePromLabelValues,
+		},
+		Route{
+			"prometheus-series", // Prometheus series
+			"GET", "/api/v1/series", true, true, h.servePromSeries,
+		},
 		Route{ // sysCtrl
 			"sysCtrl",
 			"POST", "/debug/ctrl", false, true, h.serveSysCtrl,
@@ -314,7 +361,7 @@ func (h *Handler) AddRoutes(routes ...Route) {
 			switch r.Pattern {
 			case "/write", "/api/v1/prom/write":
 				handler = h.writeThrottler.Handler(handler)
-			case "/query", "/api/v1/prom/query":
+			case "/query", "/api/v1/prom/query", "/api/v1/query", "/api/v1/query_range":
 				handler = h.queryThrottler.Handler(handler)
 			default:
 			}
@@ -322,7 +369,7 @@ func (h *Handler) AddRoutes(routes ...Route) {
 
 		if r.Method == http.MethodGet {
 			switch r.Pattern {
-			case "/query", "/api/v1/prom/query":
+			case "/query", "/api/v1/prom/query", "/api/v1/query", "/api/v1/query_range":
 				handler = h.queryThrottler.Handler(handler)
 			default:
 			}
@@ -383,6 +430,16 @@ func isInternalDatabase(dbName string) bool {
 
 func (h *Handler) serveSysCtrl(w http.ResponseWriter, r *http.Request, user meta2.User) {
 	h.requestTracker.Add(r, user)
+	start := time.Now()
+	event := audit.Event{
+		Time:      start,
+		Category:  audit.CategorySysCtrl,
+		Addr:      remoteAddr(r),
+		Statement: sysCtrlStatement(r),
+	}
+	if user != nil {
+		event.User = user.ID()
+	}
 
 	// Check authorization.
 	if h.Config.AuthEnabled {
@@ -390,17 +447,23 @@ func (h *Handler) serveSysCtrl(w http.ResponseWriter, r *http.Request, user meta
 			// no users in system
 			h.httpError(w, "error authorizing query: create admin user first or disable authentication", http.StatusForbidden)
 			h.Logger.Error("error authorizing query: create admin user first or disable authentication")
+			event.Err = errors.New("no admin user")
+			h.audit(event)
 			return
 		}
 		if !user.AuthorizeUnrestricted() {
 			h.httpError(w, "error authorizing, requires admin privilege only", http.StatusForbidden)
 			h.Logger.Error("exec error! authorizing query", zap.Any("r", r), zap.String("userID", user.ID()))
+			event.Err = errors.New("requires admin privilege")
+			h.audit(event)
 			return
 		}
 		h.Logger.Info("execute sys ctrl by admin user", zap.String("userID", user.ID()))
 	}
 
-	h.serveDebug(w, r)
+	event.Err = h.serveDebug(w, r)
+	event.Duration = time.Since(start)
+	h.audit(event)
 }
 
 // serveQuery parses an incoming query and, if valid, executes the query
@@ -414,6 +477,11 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	}()
 	h.requestTracker.Add(r, user)
 
+	if wait := h.rateLimiter.admitQuery(h.rateLimits(), userName(user)); wait > 0 {
+		h.rateLimited(w, wait, &statistics.HandlerStat.QueryRateLimited)
+		return
+	}
+
 	// Retrieve the underlying ResponseWriter or initialize our own.
 	rw, ok := w.(httpd.ResponseWriter)
 	if !ok {
@@ -542,6 +610,7 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 					zap.Stringer("query", err.Query),
 					zap.String("database", err.Database))
 			}
+			h.auditUnauthorized(r, userID, db, q, err)
 			h.httpError(rw, "error authorizing query: "+err.Error(), http.StatusForbidden)
 			h.Logger.Error("query error! authorizing query", zap.Error(err), zap.String("db", db), zap.Any("r", r), zap.String("userID", userID))
 			return
@@ -586,6 +655,10 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		Quiet:   true,
 		Traceid: traceId,
 	}
+	if user != nil {
+		opts.UserID = user.ID()
+	}
+	opts.RemoteAddr = remoteAddr(r)
 
 	if h.Config.AuthEnabled {
 		if user != nil && user.AuthorizeUnrestricted() {
@@ -780,6 +853,23 @@ func (h *Handler) async(q *influxql.Query, results <-chan *query.Result) {
 	}
 }
 
+// authorizeRows returns an authorization error if the user is not allowed to write to the measurement of a row,
+// which happens when the roles of the user only grant writes to some measurements of the database.
+func (h *Handler) authorizeRows(user meta2.User, database, rp string, rows []influx.Row) error {
+	if !h.Config.AuthEnabled || user == nil {
+		return nil
+	}
+	for i := range rows {
+		if !user.AuthorizeMeasurement(influxql.WriteRolePrivilege, database, rp, rows[i].Name) {
+			return &meta2.ErrAuthorize{
+				Database: database,
+				Message:  fmt.Sprintf("%s not authorized to write to measurement %q of %s", user.ID(), rows[i].Name, database),
+			}
+		}
+	}
+	return nil
+}
+
 func (h *Handler) logRowsIfNecessary(rows []influx.Row, ReqBuf []byte) {
 	syscontrol.MuLogRowsRule.RLock()
 	defer syscontrol.MuLogRowsRule.RUnlock()
@@ -860,6 +950,12 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		}
 	}
 
+	limits := h.rateLimits()
+	if wait := h.rateLimiter.admitWrite(limits, database, userName(user)); wait > 0 {
+		h.rateLimited(w, wait, &statistics.HandlerStat.WriteRateLimited)
+		return
+	}
+
 	body := r.Body
 	if h.Config.MaxBodySize > 0 {
 		body = truncateReader(body, int64(h.Config.MaxBodySize))
@@ -925,7 +1021,10 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			if atomic.LoadInt32(&syscontrol.LogRowsRuleSwitch) == 1 {
 				h.logRowsIfNecessary(rows, uw.ReqBuf)
 			}
-			if err = h.PointsWriter.WritePointRows(db, r.URL.Query().Get("rp"), rows); err != nil {
+			if err = h.authorizeRows(user, db, r.URL.Query().Get("rp"), rows); err == nil {
+				err = h.PointsWriter.WritePointRows(db, r.URL.Query().Get("rp"), rows)
+			}
+			if err != nil {
 				ctx.CallbackErrLock.Lock()
 				if ctx.CallbackErr == nil {
 					ctx.CallbackErr = err
@@ -933,6 +1032,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 				ctx.CallbackErrLock.Unlock()
 			} else {
 				atomic.AddInt64(&statistics.HandlerStat.PointsWrittenOK, int64(len(rows)))
+				h.rateLimiter.wrote(limits, db, userName(user), len(rows), 0)
 			}
 			ctx.Wg.Done()
 		}
@@ -940,6 +1040,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		uw.Db = database
 		uw.ReqBuf, ctx.ReqBuf = ctx.ReqBuf, uw.ReqBuf
 		atomic.AddInt64(&statistics.HandlerStat.WriteRequestBytesReceived, int64(len(uw.ReqBuf)))
+		h.rateLimiter.wrote(limits, database, userName(user), 0, len(uw.ReqBuf))
 
 		ctx.Wg.Add(1)
 		start := time.Now()
@@ -1101,39 +1202,130 @@ func (h *Handler) servePromWrite(w http.ResponseWriter, r *http.Request, user me
 	}(time.Now())
 	h.requestTracker.Add(r, user)
 
+	database, limits, body, ok := h.readRemoteWrite(w, r, user)
+	if !ok {
+		return
+	}
+
+	reqBuf, err := snappy.Decode(nil, body)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Convert the Prometheus remote write request to Influx Points
+	var req prompb.WriteRequest
+	if err := req.Unmarshal(reqBuf); err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.writePromRequest(w, r, user, database, limits, &req, len(body)) {
+		h.writeHeader(w, http.StatusNoContent)
+	}
+}
+
+// serveOTLPMetrics receives the metrics of an OpenTelemetry OTLP/HTTP export request, in protobuf or in JSON,
+// and writes them to the database as the Prometheus remote write does.
+func (h *Handler) serveOTLPMetrics(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.OTLPWriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequestBytesIn, r.ContentLength)
+	defer func(start time.Time) {
+		d := time.Since(start).Nanoseconds()
+		atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.WriteRequestDuration, d)
+	}(time.Now())
+	h.requestTracker.Add(r, user)
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if contentType != otlpProtobuf && contentType != otlpJSON {
+		h.httpError(w, fmt.Sprintf("unsupported content type %q", r.Header.Get("Content-Type")), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	database, limits, body, ok := h.readRemoteWrite(w, r, user)
+	if !ok {
+		return
+	}
+
+	var req otlp.ExportMetricsServiceRequest
+	var err error
+	if contentType == otlpJSON {
+		err = otlp.UnmarshalJSON(body, &req)
+	} else {
+		err = otlp.Unmarshal(body, &req)
+	}
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.writePromRequest(w, r, user, database, limits, otlp.ToWriteRequest(&req, time.Now()), len(body)) {
+		return
+	}
+	// the response is an empty ExportMetricsServiceResponse in the encoding of the request
+	w.Header().Set("Content-Type", contentType)
+	h.writeHeader(w, http.StatusOK)
+	if contentType == otlpJSON {
+		_, _ = w.Write([]byte("{}"))
+	}
+}
+
+// readRemoteWrite checks that the user may write to the database of a remote write request and reads its body.
+// The error response is written when it returns false.
+func (h *Handler) readRemoteWrite(w http.ResponseWriter, r *http.Request, user meta2.User) (string, []meta2.RateLimitInfo, []byte, bool) {
 	database := r.URL.Query().Get("db")
 	if database == "" {
 		h.httpError(w, "database is required", http.StatusBadRequest)
-		return
+		return "", nil, nil, false
 	}
 
 	if _, err := h.MetaClient.Database(database); err != nil {
 		h.httpError(w, fmt.Sprintf(err.Error()), http.StatusNotFound)
-		return
+		return "", nil, nil, false
 	}
 
 	if h.Config.AuthEnabled {
 		if user == nil {
 			h.httpError(w, fmt.Sprintf("user is required to write to database %q", database), http.StatusForbidden)
-			return
+			return "", nil, nil, false
 		}
 
 		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), database); err != nil {
 			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), database), http.StatusForbidden)
-			return
+			return "", nil, nil, false
 		}
 	}
 
+	limits := h.rateLimits()
+	if wait := h.rateLimiter.admitWrite(limits, database, userName(user)); wait > 0 {
+		h.rateLimited(w, wait, &statistics.HandlerStat.WriteRateLimited)
+		return "", nil, nil, false
+	}
+
 	body := r.Body
 	if h.Config.MaxBodySize > 0 {
 		body = truncateReader(body, int64(h.Config.MaxBodySize))
 	}
 
+	// Handle gzip decoding of the body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		b, err := GetGzipReader(body)
+		if err != nil {
+			h.httpError(w, err.Error(), http.StatusBadRequest)
+			return "", nil, nil, false
+		}
+		defer PutGzipReader(b)
+		body = b
+	}
+
 	var bs []byte
 	if r.ContentLength > 0 {
 		if h.Config.MaxBodySize > 0 && r.ContentLength > int64(h.Config.MaxBodySize) {
 			h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
-			return
+			return "", nil, nil, false
 		}
 
 		// This will just be an initial hint for the reader, as the
@@ -1146,34 +1338,27 @@ func (h *Handler) servePromWrite(w http.ResponseWriter, r *http.Request, user me
 	if err != nil {
 		if err == errTruncated {
 			h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
-			return
+			return "", nil, nil, false
 		}
 
 		if h.Config.WriteTracing {
 			h.Logger.Info("Prom write handler unable to read bytes from request body")
 		}
 		h.httpError(w, err.Error(), http.StatusBadRequest)
-		return
+		return "", nil, nil, false
 	}
 
 	if h.Config.WriteTracing {
 		h.Logger.Info("Prom write body received by handler", zap.ByteString("body", buf.Bytes()))
 	}
+	return database, limits, buf.Bytes(), true
+}
 
-	reqBuf, err := snappy.Decode(nil, buf.Bytes())
-	if err != nil {
-		h.httpError(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	// Convert the Prometheus remote write request to Influx Points
-	var req prompb.WriteRequest
-	if err := req.Unmarshal(reqBuf); err != nil {
-		h.httpError(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	points, err := prometheus.WriteRequestToPoints(&req)
+// writePromRequest converts the time series of a remote write request to rows and writes them.
+// The error response is written when it returns false.
+func (h *Handler) writePromRequest(w http.ResponseWriter, r *http.Request, user meta2.User, database string,
+	limits []meta2.RateLimitInfo, req *prompb.WriteRequest, bodyLen int) bool {
+	points, err := prometheus.WriteRequestToPoints(req)
 	if err != nil {
 		if h.Config.WriteTracing {
 			h.Logger.Info("Prom write handler", zap.Error(err))
@@ -1182,7 +1367,7 @@ func (h *Handler) servePromWrite(w http.ResponseWriter, r *http.Request, user me
 		// Check if the error was from something other than dropping invalid values.
 		if _, ok := err.(prometheus.DroppedValuesError); !ok {
 			h.httpError(w, err.Error(), http.StatusBadRequest)
-			return
+			return false
 		}
 	}
 	rows, e := Points2Rows(points)
@@ -1195,23 +1380,31 @@ func (h *Handler) servePromWrite(w http.ResponseWriter, r *http.Request, user me
 	if level != "" {
 		if err != nil {
 			h.httpError(w, err.Error(), http.StatusBadRequest)
-			return
+			return false
 		}
 	}
 
 	// Write points.
-	if err := h.PointsWriter.WritePointRows(database, r.URL.Query().Get("rp"), rows); influxdb.IsClientError(err) {
+	err = h.authorizeRows(user, database, r.URL.Query().Get("rp"), rows)
+	if err == nil {
+		err = h.PointsWriter.WritePointRows(database, r.URL.Query().Get("rp"), rows)
+	}
+	h.rateLimiter.wrote(limits, database, userName(user), len(rows), bodyLen)
+	if influxdb.IsClientError(err) {
 		h.httpError(w, err.Error(), http.StatusBadRequest)
-		return
+		return false
 	} else if influxdb.IsAuthorizationError(err) {
 		h.httpError(w, err.Error(), http.StatusForbidden)
-		return
+		return false
+	} else if werr, ok := err.(netstorage.PartialWriteError); ok {
+		atomic.AddInt64(&statistics.HandlerStat.PointsWrittenDropped, int64(werr.Dropped))
+		h.httpError(w, werr.Error(), http.StatusBadRequest)
+		return false
 	} else if err != nil {
 		h.httpError(w, err.Error(), http.StatusInternalServerError)
-		return
+		return false
 	}
-
-	h.writeHeader(w, http.StatusNoContent)
+	return true
 }
 
 // servePromRead will convert a Prometheus remote read request into a storage
@@ -1418,8 +1611,496 @@ func (h *Handler) servePromRead(w http.ResponseWriter, r *http.Request, user met
 	respond(resp)
 }
 
+// fluxRequest is the body of /api/v2/query, a body of the type application/vnd.flux is the script itself.
+type fluxRequest struct {
+	Query   string       `json:"query"`
+	Type    string       `json:"type"`
+	Dialect *fluxDialect `json:"dialect"`
+}
+
+type fluxDialect struct {
+	Header      *bool    `json:"header"`
+	Delimiter   string   `json:"delimiter"`
+	Annotations []string `json:"annotations"`
+}
+
+func parseFluxRequest(r *http.Request, body []byte) (*fluxRequest, flux.Dialect, error) {
+	dialect := flux.DefaultDialect()
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/vnd.flux") {
+		return &fluxRequest{Query: string(body)}, dialect, nil
+	}
+
+	req := &fluxRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, dialect, fmt.Errorf("invalid flux request: %s", err)
+	}
+	if req.Type != "" && req.Type != "flux" {
+		return nil, dialect, fmt.Errorf("unsupported query type %s", req.Type)
+	}
+	if d := req.Dialect; d != nil {
+		dialect.Annotations = d.Annotations
+		if d.Header != nil {
+			dialect.Header = *d.Header
+		}
+		if d.Delimiter != "" {
+			delimiter := []rune(d.Delimiter)
+			if len(delimiter) != 1 {
+				return nil, dialect, fmt.Errorf("invalid delimiter %q", d.Delimiter)
+			}
+			dialect.Delimiter = delimiter[0]
+		}
+	}
+	return req, dialect, nil
+}
+
+// serveFluxQuery translates each pipeline of the Flux script into a select statement,
+// and writes the tables of the statements in annotated CSV.
 func (h *Handler) serveFluxQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
-	h.httpError(w, "not implementation", http.StatusBadRequest)
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}()
+	h.requestTracker.Add(r, user)
+
+	var body io.Reader = r.Body
+	if h.Config.MaxBodySize > 0 {
+		body = truncateReader(body, int64(h.Config.MaxBodySize))
+	}
+	buf, err := ioutil.ReadAll(body)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req, dialect, err := parseFluxRequest(r, buf)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.Config.FluxLogEnabled {
+		h.Logger.Info("flux query", zap.String("query", req.Query))
+	}
+
+	prog, err := flux.Parse(req.Query)
+	if err != nil {
+		h.httpError(w, "error parsing flux query: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	queries, err := flux.Compile(prog, start)
+	if err != nil {
+		h.httpError(w, "error compiling flux query: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure if the client disconnects we signal the query to abort
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+		close(closing)
+	}()
+
+	// the tables are written as the series of each statement are read, the response starts with the first of them
+	out := &fluxResponseWriter{h: h, w: w}
+	enc := flux.NewResultEncoder(out, dialect)
+	fail := func(err error, code int) {
+		if !out.started {
+			h.httpError(w, err.Error(), code)
+			return
+		}
+		if err := enc.EncodeError(err); err != nil {
+			h.Logger.Error("write flux error table failed", zap.Error(err))
+		}
+	}
+	for _, fq := range queries {
+		if fq.Statement == nil {
+			if err := enc.Encode(fq.Result, fq.Tables(nil)); err != nil {
+				fail(err, http.StatusInternalServerError)
+				return
+			}
+			continue
+		}
+
+		// the chunks of a series are collected to write its tables as a whole
+		var pending *models.Row
+		encode := func(rows models.Rows) error {
+			for _, row := range rows {
+				if pending != nil && pending.SameSeries(row) {
+					pending.Values = append(pending.Values, row.Values...)
+					pending.Partial = row.Partial
+					continue
+				}
+				if pending != nil {
+					if err := enc.Encode(fq.Result, fq.Tables(models.Rows{pending})); err != nil {
+						return err
+					}
+					out.Flush()
+				}
+				pending = row
+			}
+			return nil
+		}
+		err = h.queryStatement(user, fq.Statement, fq.Database, fq.RetentionPolicy, closing, encode)
+		if err == nil && pending != nil {
+			err = enc.Encode(fq.Result, fq.Tables(models.Rows{pending}))
+		}
+		if err != nil {
+			code := http.StatusInternalServerError
+			if _, ok := err.(meta2.ErrAuthorize); ok {
+				code = http.StatusForbidden
+			}
+			fail(err, code)
+			return
+		}
+	}
+	out.Flush()
+}
+
+// fluxResponseWriter writes the header of a Flux response before its first table.
+type fluxResponseWriter struct {
+	h       *Handler
+	w       http.ResponseWriter
+	started bool
+}
+
+func (fw *fluxResponseWriter) start() {
+	if !fw.started {
+		fw.started = true
+		fw.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		fw.h.writeHeader(fw.w, http.StatusOK)
+	}
+}
+
+func (fw *fluxResponseWriter) Write(p []byte) (int, error) {
+	fw.start()
+	n, err := fw.w.Write(p)
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
+	return n, err
+}
+
+// Flush sends the tables written so far, a response without any table is still a valid CSV.
+func (fw *fluxResponseWriter) Flush() {
+	fw.start()
+	if f, ok := fw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// executeStatement executes a statement built by the Flux and PromQL APIs and returns all the rows of its result.
+func (h *Handler) executeStatement(user meta2.User, stmt influxql.Statement, db, rp string, closing chan struct{}) (models.Rows, error) {
+	var rows models.Rows
+	err := h.queryStatement(user, stmt, db, rp, closing, func(series models.Rows) error {
+		rows = append(rows, series...)
+		return nil
+	})
+	return rows, err
+}
+
+// queryStatement executes a statement built by the Flux and PromQL APIs and calls fn with the rows of each result
+// as they are read. The results left after fn fails are drained without calling it.
+func (h *Handler) queryStatement(user meta2.User, stmt influxql.Statement, db, rp string, closing chan struct{},
+	fn func(rows models.Rows) error) error {
+	// the statement is parsed again to be prepared the same as the statements of /query
+	YyParser := &yacc.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(stmt.String()))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	if err != nil {
+		return err
+	}
+
+	opts := query2.ExecutionOptions{
+		Database:        db,
+		RetentionPolicy: rp,
+		ChunkSize:       DefaultChunkSize,
+		ReadOnly:        true,
+		InnerChunkSize:  DefaultInnerChunkSize,
+		Quiet:           true,
+		AbortCh:         closing,
+	}
+	if h.Config.AuthEnabled {
+		if err := h.QueryAuthorizer.AuthorizeQuery(user, q, db); err != nil {
+			return err
+		}
+		if user != nil && user.AuthorizeUnrestricted() {
+			opts.Authorizer = query2.OpenAuthorizer
+		} else {
+			opts.Authorizer = user
+		}
+	} else {
+		opts.Authorizer = query2.OpenAuthorizer
+	}
+
+	var qErr error
+	for res := range h.QueryExecutor.ExecuteQuery(q, opts, closing, nil) {
+		if res == nil {
+			continue
+		}
+		if res.Err != nil && qErr == nil {
+			qErr = res.Err
+		}
+		if qErr == nil && len(res.Series) > 0 {
+			qErr = fn(res.Series)
+		}
+	}
+	return qErr
+}
+
+// promResponse is the response of the Prometheus query APIs.
+type promResponse struct {
+	Status    string      `json:"status"`
+	Data      interface{} `json:"data,omitempty"`
+	ErrorType string      `json:"errorType,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+const (
+	promErrorBadData   = "bad_data"
+	promErrorExecution = "execution"
+)
+
+func (h *Handler) promError(w http.ResponseWriter, errType string, err error) {
+	code := http.StatusBadRequest
+	if _, ok := err.(meta2.ErrAuthorize); ok {
+		code = http.StatusForbidden
+	} else if errType == promErrorExecution {
+		code = http.StatusUnprocessableEntity
+	}
+	h.writePromResponse(w, code, &promResponse{Status: "error", ErrorType: errType, Error: err.Error()})
+}
+
+func (h *Handler) writePromResponse(w http.ResponseWriter, code int, resp *promResponse) {
+	buf, err := json.Marshal(resp)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	h.writeHeader(w, code)
+	n, _ := w.Write(buf)
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
+}
+
+// parsePromTime parses a unix timestamp in seconds or a RFC3339 time, def is returned if s is empty.
+func parsePromTime(s string, def time.Time) (time.Time, error) {
+	if s == "" {
+		return def, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(math.Round(frac*1000))*int64(time.Millisecond)).UTC(), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+}
+
+// parsePromDuration parses a duration in seconds or a PromQL duration like 1m.
+func parsePromDuration(s string) (time.Duration, error) {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		if f <= 0 || f > float64(math.MaxInt64/int64(time.Second)) {
+			return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+		}
+		return time.Duration(f * float64(time.Second)), nil
+	}
+	d, err := promql.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+	}
+	return d, nil
+}
+
+// servePromQuery serves the PromQL instant queries of /api/v1/query.
+func (h *Handler) servePromQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	ts, err := parsePromTime(r.FormValue("time"), time.Now())
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	q, err := promql.NewInstantQuery(r.FormValue("query"), ts)
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	h.servePromQL(w, r, user, q)
+}
+
+// servePromQueryRange serves the PromQL range queries of /api/v1/query_range.
+func (h *Handler) servePromQueryRange(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	start, err := parsePromTime(r.FormValue("start"), time.Time{})
+	if err == nil && start.IsZero() {
+		err = fmt.Errorf("start is required")
+	}
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	end, err := parsePromTime(r.FormValue("end"), time.Time{})
+	if err == nil && end.IsZero() {
+		err = fmt.Errorf("end is required")
+	}
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	step, err := parsePromDuration(r.FormValue("step"))
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	q, err := promql.NewRangeQuery(r.FormValue("query"), start, end, step)
+	if err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	h.servePromQL(w, r, user, q)
+}
+
+func (h *Handler) servePromQL(w http.ResponseWriter, r *http.Request, user meta2.User, q *promql.Query) {
+	var results []models.Rows
+	ok := h.executePromStatements(w, r, user, len(q.Leaves), func(i int) influxql.Statement {
+		return q.Leaves[i].Statement
+	}, func(rows models.Rows) {
+		results = append(results, rows)
+	})
+	if !ok {
+		return
+	}
+
+	data, err := q.Eval(results)
+	if err != nil {
+		h.promError(w, promErrorExecution, err)
+		return
+	}
+	h.writePromResponse(w, http.StatusOK, &promResponse{Status: "success", Data: data})
+}
+
+// servePromLabels serves /api/v1/labels, the label names are the tag keys of all the measurements.
+func (h *Handler) servePromLabels(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	var rows models.Rows
+	ok := h.executePromStatements(w, r, user, 1, func(int) influxql.Statement {
+		return promql.LabelNamesStatement()
+	}, func(res models.Rows) {
+		rows = res
+	})
+	if ok {
+		h.writePromResponse(w, http.StatusOK, &promResponse{Status: "success", Data: promql.LabelNames(rows)})
+	}
+}
+
+// servePromLabelValues serves /api/v1/label/<name>/values, the values of __name__ are the measurements.
+func (h *Handler) servePromLabelValues(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	name := r.URL.Query().Get(":name")
+	if name == "" {
+		h.promError(w, promErrorBadData, fmt.Errorf("invalid label name %q", name))
+		return
+	}
+	var rows models.Rows
+	ok := h.executePromStatements(w, r, user, 1, func(int) influxql.Statement {
+		return promql.LabelValuesStatement(name)
+	}, func(res models.Rows) {
+		rows = res
+	})
+	if ok {
+		h.writePromResponse(w, http.StatusOK, &promResponse{Status: "success", Data: promql.LabelValues(rows)})
+	}
+}
+
+// servePromSeries serves /api/v1/series, the series of each match[] selector are listed by SHOW SERIES.
+func (h *Handler) servePromSeries(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if err := r.ParseForm(); err != nil {
+		h.promError(w, promErrorBadData, err)
+		return
+	}
+	matches := r.Form["match[]"]
+	if len(matches) == 0 {
+		h.promError(w, promErrorBadData, fmt.Errorf("no match[] parameter provided"))
+		return
+	}
+	stmts := make([]influxql.Statement, 0, len(matches))
+	for _, match := range matches {
+		vs, err := promql.ParseMetricSelector(match)
+		if err != nil {
+			h.promError(w, promErrorBadData, err)
+			return
+		}
+		stmt, err := promql.SeriesStatement(vs)
+		if err != nil {
+			h.promError(w, promErrorBadData, err)
+			return
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	var rows models.Rows
+	ok := h.executePromStatements(w, r, user, len(stmts), func(i int) influxql.Statement {
+		return stmts[i]
+	}, func(res models.Rows) {
+		rows = append(rows, res...)
+	})
+	if !ok {
+		return
+	}
+	series, err := promql.SeriesLabels(rows)
+	if err != nil {
+		h.promError(w, promErrorExecution, err)
+		return
+	}
+	if series == nil {
+		series = []map[string]string{}
+	}
+	h.writePromResponse(w, http.StatusOK, &promResponse{Status: "success", Data: series})
+}
+
+// executePromStatements executes the statements of the Prometheus query APIs in the database of the db parameter,
+// the error response is written if false is returned.
+func (h *Handler) executePromStatements(w http.ResponseWriter, r *http.Request, user meta2.User, n int,
+	stmt func(i int) influxql.Statement, onResult func(models.Rows)) bool {
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}()
+	h.requestTracker.Add(r, user)
+
+	db := r.FormValue("db")
+	if db == "" {
+		h.promError(w, promErrorBadData, fmt.Errorf("database name required"))
+		return false
+	}
+
+	// Make sure if the client disconnects we signal the query to abort
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+		close(closing)
+	}()
+
+	for i := 0; i < n; i++ {
+		rows, err := h.executeStatement(user, stmt(i), db, r.FormValue("rp"), closing)
+		if err != nil {
+			h.promError(w, promErrorExecution, err)
+			return false
+		}
+		onResult(rows)
+	}
+	return true
 }
 
 // serveDebugRequests will track requests for a period of time.
@@ -1596,6 +2277,7 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 			creds, err := parseCredentials(r)
 			if err != nil {
 				atomic.AddInt64(&statistics.HandlerStat.AuthenticationFailures, 1)
+				h.auditLogin(r, "", err)
 				h.httpError(w, err.Error(), http.StatusUnauthorized)
 				return
 			}
@@ -1608,6 +2290,7 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 					err := errno.NewError(errno.HttpUnauthorized)
 					log := logger.NewLogger(errno.ModuleHTTP)
 					log.Error(errMsg, zap.Error(err))
+					h.auditLogin(r, "", errors.New(errMsg))
 					h.httpError(w, errMsg, http.StatusUnauthorized)
 					return
 				}
@@ -1619,6 +2302,7 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 					if err == meta2.ErrUserLocked {
 						errMsg = err.Error()
 					}
+					h.auditLogin(r, creds.Username, err)
 					err := errno.NewError(errno.HttpUnauthorized)
 					log := logger.NewLogger(errno.ModuleHTTP)
 					log.Error(errMsg, zap.Error(err))
@@ -1642,9 +2326,11 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 				// Parse and validate the token.
 				token, err := jwt.Parse(creds.Token, keyLookupFn)
 				if err != nil {
+					h.auditLogin(r, "", err)
 					h.httpError(w, err.Error(), http.StatusUnauthorized)
 					return
 				} else if !token.Valid {
+					h.auditLogin(r, "", errors.New("invalid token"))
 					h.httpError(w, "invalid token", http.StatusUnauthorized)
 					return
 				}
@@ -1674,9 +2360,11 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 
 				// Lookup user in the metastore.
 				if user, err = h.MetaClient.User(username); err != nil {
+					h.auditLogin(r, username, err)
 					h.httpError(w, err.Error(), http.StatusUnauthorized)
 					return
 				} else if user == nil {
+					h.auditLogin(r, username, meta2.ErrUserNotFound)
 					h.httpError(w, meta2.ErrUserNotFound.Error(), http.StatusUnauthorized)
 					return
 				}
@@ -1689,6 +2377,38 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 	})
 }
 
+func (h *Handler) audit(e audit.Event) {
+	if h.Auditor != nil {
+		h.Auditor.Record(e)
+	}
+}
+
+// auditLogin records a failed login.
+func (h *Handler) auditLogin(r *http.Request, username string, err error) {
+	h.audit(audit.Event{
+		Category: audit.CategoryLogin,
+		User:     username,
+		Addr:     remoteAddr(r),
+		Err:      err,
+	})
+}
+
+// auditUnauthorized records the audited statements of a query that the user is not authorized to execute.
+func (h *Handler) auditUnauthorized(r *http.Request, username, db string, q *influxql.Query, err error) {
+	for _, stmt := range q.Statements {
+		if category := audit.StatementCategory(stmt); category != "" {
+			h.audit(audit.Event{
+				Category:  category,
+				User:      username,
+				Addr:      remoteAddr(r),
+				Database:  db,
+				Statement: stmt.String(),
+				Err:       err,
+			})
+		}
+	}
+}
+
 // cors responds to incoming requests and adds the appropriate cors headers
 // TODO: corylanou: add the ability to configure this in our config
 func cors(inner http.Handler) http.Handler {
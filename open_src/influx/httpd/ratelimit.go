@@ -0,0 +1,202 @@
+package httpd
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+)
+
+type rateKind int
+
+const (
+	ratePoints rateKind = iota
+	rateBytes
+	rateQueries
+)
+
+type rateBucketKey struct {
+	database string
+	user     string
+	kind     rateKind
+}
+
+// tokenBucket holds up to one second worth of tokens. A request is admitted as long as the bucket is not in debt,
+// and it may then take more tokens than the bucket holds, so that a batch larger than the rate slows the following
+// requests down instead of never being admitted.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: float64(rate), tokens: float64(rate), last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.rate, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+}
+
+// wait returns how long the bucket stays in debt, zero if a request can be admitted.
+func (b *tokenBucket) wait(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) take(now time.Time, n float64) {
+	b.mu.Lock()
+	b.refill(now)
+	b.tokens -= n
+	b.mu.Unlock()
+}
+
+// reserve takes n tokens unless the bucket holds less, in which case it returns how long it takes to hold n.
+func (b *tokenBucket) reserve(now time.Time, n float64) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.tokens >= n {
+		b.tokens -= n
+		return 0
+	}
+	return time.Duration((n - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) setRate(rate int64) {
+	b.mu.Lock()
+	b.rate = float64(rate)
+	b.tokens = math.Min(b.rate, b.tokens)
+	b.mu.Unlock()
+}
+
+// rateLimiter limits the points and the bytes written per second to each database and by each user, and the
+// queries per second of each user. The limits are kept in the meta data, so they can be changed at runtime and
+// apply to every ts-sql, each ts-sql enforcing them on its own requests.
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[rateBucketKey]*tokenBucket
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{buckets: make(map[rateBucketKey]*tokenBucket)}
+}
+
+// bucketsOf returns the buckets of the limits of the database and of the user for the kinds of rates.
+func (l *rateLimiter) bucketsOf(limits []meta2.RateLimitInfo, database, user string, now time.Time, kinds ...rateKind) []*tokenBucket {
+	var buckets []*tokenBucket
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range limits {
+		rl := &limits[i]
+		if !rl.Applies(database, user) {
+			continue
+		}
+		for _, kind := range kinds {
+			rate := limitOf(rl, kind)
+			if rate <= 0 {
+				continue
+			}
+			key := rateBucketKey{database: rl.Database, user: rl.User, kind: kind}
+			b, ok := l.buckets[key]
+			if !ok {
+				b = newTokenBucket(rate, now)
+				l.buckets[key] = b
+			} else if b.rate != float64(rate) {
+				b.setRate(rate)
+			}
+			buckets = append(buckets, b)
+		}
+	}
+	return buckets
+}
+
+func limitOf(rl *meta2.RateLimitInfo, kind rateKind) int64 {
+	switch kind {
+	case ratePoints:
+		return rl.PointsPerSecond
+	case rateBytes:
+		return rl.BytesPerSecond
+	default:
+		return rl.QueriesPerSecond
+	}
+}
+
+// admitWrite returns how long the client should wait before writing again, zero if the write is admitted.
+func (l *rateLimiter) admitWrite(limits []meta2.RateLimitInfo, database, user string) time.Duration {
+	if len(limits) == 0 {
+		return 0
+	}
+	now := time.Now()
+	var wait time.Duration
+	for _, b := range l.bucketsOf(limits, database, user, now, ratePoints, rateBytes) {
+		if w := b.wait(now); w > wait {
+			wait = w
+		}
+	}
+	return wait
+}
+
+// wrote takes the points and the bytes written from the buckets of the database and of the user.
+func (l *rateLimiter) wrote(limits []meta2.RateLimitInfo, database, user string, points, bytes int) {
+	if len(limits) == 0 {
+		return
+	}
+	now := time.Now()
+	for _, b := range l.bucketsOf(limits, database, user, now, ratePoints) {
+		b.take(now, float64(points))
+	}
+	for _, b := range l.bucketsOf(limits, database, user, now, rateBytes) {
+		b.take(now, float64(bytes))
+	}
+}
+
+// admitQuery takes a query from the bucket of the user, or returns how long the client should wait before querying
+// again.
+func (l *rateLimiter) admitQuery(limits []meta2.RateLimitInfo, user string) time.Duration {
+	if len(limits) == 0 || user == "" {
+		return 0
+	}
+	now := time.Now()
+	for _, b := range l.bucketsOf(limits, "", user, now, rateQueries) {
+		if w := b.reserve(now, 1); w > 0 {
+			return w
+		}
+	}
+	return 0
+}
+
+func (h *Handler) rateLimits() []meta2.RateLimitInfo {
+	if h.MetaClient == nil {
+		return nil
+	}
+	return h.MetaClient.RateLimits()
+}
+
+// rateLimited responds with 429 and the number of seconds the client should wait in Retry-After.
+func (h *Handler) rateLimited(w http.ResponseWriter, wait time.Duration, stat *int64) {
+	atomic.AddInt64(stat, 1)
+	w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10))
+	h.httpError(w, "rate limit exceeded", http.StatusTooManyRequests)
+}
+
+// userName returns the name of the user of the request, empty if authentication is disabled.
+func userName(user meta2.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.ID()
+}
@@ -0,0 +1,65 @@
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/httpd/config"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(10, now)
+	assert.Equal(t, time.Duration(0), b.wait(now))
+
+	// a batch larger than the rate is admitted, the next requests wait until the debt is paid
+	b.take(now, 25)
+	assert.Equal(t, 1500*time.Millisecond, b.wait(now))
+	assert.Equal(t, 500*time.Millisecond, b.wait(now.Add(time.Second)))
+	assert.Equal(t, time.Duration(0), b.wait(now.Add(2*time.Second)))
+
+	// the bucket never holds more than one second of tokens
+	b.take(now.Add(time.Hour), 15)
+	assert.True(t, b.wait(now.Add(time.Hour)) > 0)
+
+	b = newTokenBucket(2, now)
+	assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+	assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+	assert.Equal(t, 500*time.Millisecond, b.reserve(now, 1))
+}
+
+func TestRateLimiter(t *testing.T) {
+	limits := []meta2.RateLimitInfo{
+		{Database: "db0", PointsPerSecond: 10},
+		{User: "bob", BytesPerSecond: 100, QueriesPerSecond: 1},
+	}
+	l := newRateLimiter()
+	assert.Equal(t, time.Duration(0), l.admitWrite(limits, "db0", "alice"))
+	l.wrote(limits, "db0", "alice", 20, 1000)
+	assert.True(t, l.admitWrite(limits, "db0", "alice") > 0)
+	assert.Equal(t, time.Duration(0), l.admitWrite(limits, "db1", "alice"))
+
+	// bob is limited by his bytes on every database
+	l.wrote(limits, "db1", "bob", 1, 1000)
+	assert.True(t, l.admitWrite(limits, "db1", "bob") > 0)
+	assert.Equal(t, time.Duration(0), l.admitWrite(nil, "db1", "bob"))
+
+	assert.Equal(t, time.Duration(0), l.admitQuery(limits, "bob"))
+	assert.True(t, l.admitQuery(limits, "bob") > 0)
+	assert.Equal(t, time.Duration(0), l.admitQuery(limits, "alice"))
+}
+
+func TestHandler_RateLimited(t *testing.T) {
+	h := &Handler{Config: &config.Config{}}
+	var stat int64
+	w := httptest.NewRecorder()
+	h.rateLimited(w, 1500*time.Millisecond, &stat)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, int64(1), stat)
+}
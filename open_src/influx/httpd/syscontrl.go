@@ -1,6 +1,7 @@
 package httpd
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -9,13 +10,13 @@ import (
 	"github.com/openGemini/openGemini/lib/syscontrol"
 )
 
-func (h *Handler) serveDebug(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) serveDebug(w http.ResponseWriter, r *http.Request) error {
 	q := r.URL.Query()
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	mod := q.Get("mod")
 	if mod == "" {
 		h.httpError(w, "invalid mod", http.StatusBadRequest)
-		return
+		return errors.New("invalid mod")
 	}
 
 	var req netstorage.SysCtrlRequest
@@ -38,8 +39,18 @@ func (h *Handler) serveDebug(w http.ResponseWriter, r *http.Request) {
 	err := syscontrol.ProcessRequest(req, &sb)
 	if err != nil {
 		h.httpError(w, "sysctrl execute error: "+err.Error(), http.StatusBadRequest)
-		return
+		return err
 	}
 	sb.WriteString("\n}\n")
 	_, _ = fmt.Fprintln(w, sb.String())
+	return nil
+}
+
+// sysCtrlStatement returns the mod and the parameters of a sys ctrl call for the audit log, without the
+// credentials.
+func sysCtrlStatement(r *http.Request) string {
+	q := r.URL.Query()
+	q.Del("u")
+	q.Del("p")
+	return q.Encode()
 }
@@ -251,9 +251,11 @@ func (Statements) node() {}
 func (*AlterRetentionPolicyStatement) node()       {}
 func (*CreateContinuousQueryStatement) node()      {}
 func (*CreateDatabaseStatement) node()             {}
+func (*CreateDownSampleStatement) node()           {}
 func (*CreateMeasurementStatement) node()          {}
 func (*AlterShardKeyStatement) node()              {}
 func (*CreateRetentionPolicyStatement) node()      {}
+func (*CreateRoleStatement) node()                 {}
 func (*CreateSubscriptionStatement) node()         {}
 func (*CreateUserStatement) node()                 {}
 func (*Distinct) node()                            {}
@@ -261,8 +263,10 @@ func (*DeleteSeriesStatement) node()               {}
 func (*DeleteStatement) node()                     {}
 func (*DropContinuousQueryStatement) node()        {}
 func (*DropDatabaseStatement) node()               {}
+func (*DropDownSampleStatement) node()             {}
 func (*DropMeasurementStatement) node()            {}
 func (*DropRetentionPolicyStatement) node()        {}
+func (*DropRoleStatement) node()                   {}
 func (*DropSeriesStatement) node()                 {}
 func (*DropShardStatement) node()                  {}
 func (*DropSubscriptionStatement) node()           {}
@@ -270,17 +274,23 @@ func (*DropUserStatement) node()                   {}
 func (*ExplainStatement) node()                    {}
 func (*GrantStatement) node()                      {}
 func (*GrantAdminStatement) node()                 {}
+func (*GrantRoleStatement) node()                  {}
+func (*GrantRolePrivilegeStatement) node()         {}
 func (*KillQueryStatement) node()                  {}
 func (*RevokeStatement) node()                     {}
 func (*RevokeAdminStatement) node()                {}
+func (*RevokeRoleStatement) node()                 {}
+func (*RevokeRolePrivilegeStatement) node()        {}
 func (*SelectStatement) node()                     {}
 func (*SetPasswordUserStatement) node()            {}
 func (*ShowContinuousQueriesStatement) node()      {}
 func (*ShowGrantsForUserStatement) node()          {}
 func (*ShowDatabasesStatement) node()              {}
+func (*ShowDownSamplesStatement) node()            {}
 func (*ShowFieldKeyCardinalityStatement) node()    {}
 func (*ShowFieldKeysStatement) node()              {}
 func (*ShowRetentionPoliciesStatement) node()      {}
+func (*ShowRolesStatement) node()                  {}
 func (*ShowMeasurementCardinalityStatement) node() {}
 func (*ShowMeasurementsStatement) node()           {}
 func (*ShowQueriesStatement) node()                {}
@@ -291,6 +301,7 @@ func (*ShowShardsStatement) node()                 {}
 func (*ShowStatsStatement) node()                  {}
 func (*ShowSubscriptionsStatement) node()          {}
 func (*ShowDiagnosticsStatement) node()            {}
+func (*ShowTagCardinalityStatement) node()         {}
 func (*ShowTagKeyCardinalityStatement) node()      {}
 func (*ShowTagKeysStatement) node()                {}
 func (*ShowTagValuesCardinalityStatement) node()   {}
@@ -320,6 +331,7 @@ func (SortFields) node()                   {}
 func (Sources) node()                      {}
 func (*StringLiteral) node()               {}
 func (*SubQuery) node()                    {}
+func (*Join) node()                        {}
 func (*Target) node()                      {}
 func (*TimeLiteral) node()                 {}
 func (*VarRef) node()                      {}
@@ -390,33 +402,41 @@ type ExecutionPrivileges []ExecutionPrivilege
 func (*AlterRetentionPolicyStatement) stmt()       {}
 func (*CreateContinuousQueryStatement) stmt()      {}
 func (*CreateDatabaseStatement) stmt()             {}
+func (*CreateDownSampleStatement) stmt()           {}
 func (*CreateMeasurementStatement) stmt()          {}
 func (*AlterShardKeyStatement) stmt()              {}
 func (*CreateRetentionPolicyStatement) stmt()      {}
+func (*CreateRoleStatement) stmt()                 {}
 func (*CreateSubscriptionStatement) stmt()         {}
 func (*CreateUserStatement) stmt()                 {}
 func (*DeleteSeriesStatement) stmt()               {}
 func (*DeleteStatement) stmt()                     {}
 func (*DropContinuousQueryStatement) stmt()        {}
 func (*DropDatabaseStatement) stmt()               {}
+func (*DropDownSampleStatement) stmt()             {}
 func (*DropMeasurementStatement) stmt()            {}
 func (*DropRetentionPolicyStatement) stmt()        {}
+func (*DropRoleStatement) stmt()                   {}
 func (*DropSeriesStatement) stmt()                 {}
 func (*DropSubscriptionStatement) stmt()           {}
 func (*DropUserStatement) stmt()                   {}
 func (*ExplainStatement) stmt()                    {}
 func (*GrantStatement) stmt()                      {}
 func (*GrantAdminStatement) stmt()                 {}
+func (*GrantRoleStatement) stmt()                  {}
+func (*GrantRolePrivilegeStatement) stmt()         {}
 func (*KillQueryStatement) stmt()                  {}
 func (*ShowContinuousQueriesStatement) stmt()      {}
 func (*ShowGrantsForUserStatement) stmt()          {}
 func (*ShowDatabasesStatement) stmt()              {}
+func (*ShowDownSamplesStatement) stmt()            {}
 func (*ShowFieldKeyCardinalityStatement) stmt()    {}
 func (*ShowFieldKeysStatement) stmt()              {}
 func (*ShowMeasurementCardinalityStatement) stmt() {}
 func (*ShowMeasurementsStatement) stmt()           {}
 func (*ShowQueriesStatement) stmt()                {}
 func (*ShowRetentionPoliciesStatement) stmt()      {}
+func (*ShowRolesStatement) stmt()                  {}
 func (*ShowSeriesStatement) stmt()                 {}
 func (*ShowSeriesCardinalityStatement) stmt()      {}
 func (*ShowShardGroupsStatement) stmt()            {}
@@ -425,6 +445,7 @@ func (*ShowStatsStatement) stmt()                  {}
 func (*DropShardStatement) stmt()                  {}
 func (*ShowSubscriptionsStatement) stmt()          {}
 func (*ShowDiagnosticsStatement) stmt()            {}
+func (*ShowTagCardinalityStatement) stmt()         {}
 func (*ShowTagKeyCardinalityStatement) stmt()      {}
 func (*ShowTagKeysStatement) stmt()                {}
 func (*ShowTagValuesCardinalityStatement) stmt()   {}
@@ -432,6 +453,8 @@ func (*ShowTagValuesStatement) stmt()              {}
 func (*ShowUsersStatement) stmt()                  {}
 func (*RevokeStatement) stmt()                     {}
 func (*RevokeAdminStatement) stmt()                {}
+func (*RevokeRoleStatement) stmt()                 {}
+func (*RevokeRolePrivilegeStatement) stmt()        {}
 func (*SelectStatement) stmt()                     {}
 func (*SetPasswordUserStatement) stmt()            {}
 func (*PrepareSnapshotStatement) stmt()            {}
@@ -494,6 +517,7 @@ type Source interface {
 
 func (*Measurement) source() {}
 func (*SubQuery) source()    {}
+func (*Join) source()        {}
 
 // Sources represents a list of sources.
 type Sources []Source
@@ -522,6 +546,8 @@ func (a Sources) Measurements() []*Measurement {
 			mms = append(mms, src)
 		case *SubQuery:
 			mms = append(mms, src.Statement.Sources.Measurements()...)
+		case *Join:
+			mms = append(mms, Sources{src.LSrc, src.RSrc}.Measurements()...)
 		}
 	}
 	return mms
@@ -571,6 +597,12 @@ func (a Sources) RequiredPrivileges() (ExecutionPrivileges, error) {
 				return nil, err
 			}
 			ep = append(ep, privs...)
+		case *Join:
+			privs, err := Sources{source.LSrc, source.RSrc}.RequiredPrivileges()
+			if err != nil {
+				return nil, err
+			}
+			ep = append(ep, privs...)
 		default:
 			return nil, fmt.Errorf("invalid source: %s", source)
 		}
@@ -1072,6 +1104,256 @@ func (s *RevokeAdminStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
 }
 
+// RolePrivilege is a class of statements a role can be granted the right to execute.
+type RolePrivilege int
+
+const (
+	// NoRolePrivileges means no role privilege required / granted / revoked.
+	NoRolePrivileges RolePrivilege = iota
+	// ReadRolePrivilege allows queries and the SHOW statements reading data or schema.
+	ReadRolePrivilege
+	// WriteRolePrivilege allows writes, the INTO clause and deletes of series.
+	WriteRolePrivilege
+	// DDLRolePrivilege allows to create, alter and drop databases, retention policies, measurements and the
+	// objects attached to them.
+	DDLRolePrivilege
+	// AdminRolePrivilege allows the statements managing users, roles, shards and queries.
+	AdminRolePrivilege
+	// AllRolePrivileges means all of the role privileges.
+	AllRolePrivileges
+)
+
+// ParseRolePrivilege returns the role privilege named by s.
+func ParseRolePrivilege(s string) (RolePrivilege, bool) {
+	switch strings.ToLower(s) {
+	case "read":
+		return ReadRolePrivilege, true
+	case "write":
+		return WriteRolePrivilege, true
+	case "ddl":
+		return DDLRolePrivilege, true
+	case "admin":
+		return AdminRolePrivilege, true
+	case "all":
+		return AllRolePrivileges, true
+	}
+	return NoRolePrivileges, false
+}
+
+// String returns a string representation of a RolePrivilege.
+func (p RolePrivilege) String() string {
+	switch p {
+	case NoRolePrivileges:
+		return "NO PRIVILEGES"
+	case ReadRolePrivilege:
+		return "READ"
+	case WriteRolePrivilege:
+		return "WRITE"
+	case DDLRolePrivilege:
+		return "DDL"
+	case AdminRolePrivilege:
+		return "ADMIN"
+	case AllRolePrivileges:
+		return "ALL PRIVILEGES"
+	}
+	return ""
+}
+
+// Includes returns true if granting p also grants other.
+func (p RolePrivilege) Includes(other RolePrivilege) bool {
+	return p == other || p == AllRolePrivileges
+}
+
+// PrivilegeScope is the set of measurements a role privilege is granted on. An empty database or retention
+// policy covers all of them, and an empty measurement without a regex covers all the measurements.
+type PrivilegeScope struct {
+	Database        string
+	RetentionPolicy string
+	Measurement     string
+	Regex           *RegexLiteral
+}
+
+// String returns a string representation of the scope.
+func (s *PrivilegeScope) String() string {
+	if s.Database == "" {
+		return "*"
+	}
+	var buf bytes.Buffer
+	_, _ = buf.WriteString(QuoteIdent(s.Database))
+	if s.RetentionPolicy == "" && s.Measurement == "" && s.Regex == nil {
+		return buf.String()
+	}
+	_, _ = buf.WriteString(".")
+	if s.RetentionPolicy == "" {
+		_, _ = buf.WriteString("*")
+	} else {
+		_, _ = buf.WriteString(QuoteIdent(s.RetentionPolicy))
+	}
+	if s.Regex != nil {
+		_, _ = buf.WriteString(".")
+		_, _ = buf.WriteString(s.Regex.String())
+	} else if s.Measurement != "" {
+		_, _ = buf.WriteString(".")
+		_, _ = buf.WriteString(QuoteIdent(s.Measurement))
+	}
+	return buf.String()
+}
+
+// CreateRoleStatement represents a command for creating a role.
+type CreateRoleStatement struct {
+	// Name of the role to be created.
+	Name string
+}
+
+// String returns a string representation of the create role statement.
+func (s *CreateRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a CreateRoleStatement.
+func (s *CreateRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// DropRoleStatement represents a command for dropping a role.
+type DropRoleStatement struct {
+	// Name of the role to drop.
+	Name string
+}
+
+// String returns a string representation of the drop role statement.
+func (s *DropRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DROP ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a DropRoleStatement.
+func (s *DropRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// ShowRolesStatement represents a command for listing the roles and their privileges.
+type ShowRolesStatement struct{}
+
+// String returns a string representation of the show roles statement.
+func (s *ShowRolesStatement) String() string { return "SHOW ROLES" }
+
+// RequiredPrivileges returns the privilege required to execute a ShowRolesStatement.
+func (s *ShowRolesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// GrantRoleStatement represents a command for granting a role to a user.
+type GrantRoleStatement struct {
+	// The role to be granted.
+	Role string
+
+	// Who to grant the role to.
+	User string
+}
+
+// String returns a string representation of the grant role statement.
+func (s *GrantRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("GRANT ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	_, _ = buf.WriteString(" TO ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a GrantRoleStatement.
+func (s *GrantRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// RevokeRoleStatement represents a command for revoking a role from a user.
+type RevokeRoleStatement struct {
+	// The role to be revoked.
+	Role string
+
+	// Who to revoke the role from.
+	User string
+}
+
+// String returns a string representation of the revoke role statement.
+func (s *RevokeRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REVOKE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	_, _ = buf.WriteString(" FROM ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a RevokeRoleStatement.
+func (s *RevokeRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// GrantRolePrivilegeStatement represents a command for granting a privilege to a role.
+type GrantRolePrivilegeStatement struct {
+	// The privilege to be granted.
+	Privilege RolePrivilege
+
+	// Measurements to grant the privilege on.
+	On PrivilegeScope
+
+	// The role to grant the privilege to.
+	Role string
+}
+
+// String returns a string representation of the grant role privilege statement.
+func (s *GrantRolePrivilegeStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("GRANT ")
+	_, _ = buf.WriteString(s.Privilege.String())
+	_, _ = buf.WriteString(" ON ")
+	_, _ = buf.WriteString(s.On.String())
+	_, _ = buf.WriteString(" TO ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a GrantRolePrivilegeStatement.
+func (s *GrantRolePrivilegeStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// RevokeRolePrivilegeStatement represents a command for revoking a privilege from a role.
+type RevokeRolePrivilegeStatement struct {
+	// The privilege to be revoked.
+	Privilege RolePrivilege
+
+	// Measurements to revoke the privilege on.
+	On PrivilegeScope
+
+	// The role to revoke the privilege from.
+	Role string
+}
+
+// String returns a string representation of the revoke role privilege statement.
+func (s *RevokeRolePrivilegeStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REVOKE ")
+	_, _ = buf.WriteString(s.Privilege.String())
+	_, _ = buf.WriteString(" ON ")
+	_, _ = buf.WriteString(s.On.String())
+	_, _ = buf.WriteString(" FROM ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a RevokeRolePrivilegeStatement.
+func (s *RevokeRolePrivilegeStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
 // CreateRetentionPolicyStatement represents a command to create a retention policy.
 type CreateRetentionPolicyStatement struct {
 	// Name of policy to create.
@@ -1386,6 +1668,8 @@ func cloneSource(s Source) Source {
 		return s.Clone()
 	case *SubQuery:
 		return &SubQuery{Statement: s.Statement.Clone()}
+	case *Join:
+		return &Join{LSrc: cloneSource(s.LSrc), RSrc: cloneSource(s.RSrc), Condition: CloneExpr(s.Condition)}
 	default:
 		panic("unreachable")
 	}
@@ -1418,6 +1702,16 @@ func (s *SelectStatement) RewriteFields(m FieldMapper, batchEn bool) (*SelectSta
 				continue
 			}
 
+			if err != ErrDeclareEmptyCollection {
+				return nil, err
+			}
+		case *Join:
+			join, err := src.rewriteFields(m, batchEn)
+			if err == nil {
+				sources = append(sources, join)
+				continue
+			}
+
 			if err != ErrDeclareEmptyCollection {
 				return nil, err
 			}
@@ -2055,6 +2349,128 @@ func (s *SelectStatement) RewriteTopBottom() {
 	RewriteOpsNestFunc(s, RewriteTopBottomStatement)
 }
 
+// RewriteJoin rewrites both measurements of a join into subqueries selecting the fields the
+// statement reads from them. Fields of a measurement are referenced as measurement.field and
+// keep that name in the subquery, which is grouped by the join tag of the measurement.
+func (s *SelectStatement) RewriteJoin() error {
+	var join *Join
+	for _, src := range s.Sources {
+		if j, ok := src.(*Join); ok {
+			join = j
+		}
+	}
+	if join == nil {
+		return nil
+	}
+	if len(s.Sources) > 1 {
+		return errors.New("a join can not be combined with other sources")
+	}
+
+	lm, lok := join.LSrc.(*Measurement)
+	rm, rok := join.RSrc.(*Measurement)
+	if !lok || !rok || lm.Regex != nil || rm.Regex != nil {
+		return errors.New("only two measurements can be joined")
+	}
+	if lm.Name == rm.Name {
+		return errors.New("a measurement can not be joined with itself")
+	}
+	mms := [2]*Measurement{lm, rm}
+
+	lref, rref, err := join.Keys()
+	if err != nil {
+		return err
+	}
+	li, lkey := joinRefSide(mms, lref)
+	ri, rkey := joinRefSide(mms, rref)
+	if li < 0 {
+		li = 0
+	}
+	if ri < 0 {
+		ri = 1
+	}
+	if li == ri {
+		return fmt.Errorf("join condition must compare a tag of each measurement: %s", join.Condition)
+	}
+	keys := [2]string{lkey, rkey}
+	if li == 1 {
+		keys = [2]string{rkey, lkey}
+	}
+
+	var fields [2]Fields
+	seen := make(map[string]bool)
+	collect := func(n Node) {
+		if err != nil {
+			return
+		}
+		if _, ok := n.(*Wildcard); ok {
+			err = errors.New("wildcards are not supported in a join")
+			return
+		}
+		ref, ok := n.(*VarRef)
+		if !ok || strings.ToLower(ref.Val) == "time" || seen[ref.Val] {
+			return
+		}
+		i, name := joinRefSide(mms, ref.Val)
+		if i < 0 {
+			if ref.Val != keys[0] && ref.Val != keys[1] {
+				err = fmt.Errorf("%s must be qualified with its measurement in a join", ref.Val)
+			}
+			return
+		}
+		seen[ref.Val] = true
+		fields[i] = append(fields[i], &Field{Expr: &VarRef{Val: name, Type: ref.Type}, Alias: ref.Val})
+	}
+	WalkFunc(s.Fields, collect)
+	WalkFunc(s.Condition, collect)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range s.Dimensions {
+		switch expr := d.Expr.(type) {
+		case *Call:
+			if expr.Name == "time" {
+				continue
+			}
+		case *VarRef:
+			if expr.Val == keys[0] || expr.Val == keys[1] {
+				continue
+			}
+		}
+		return fmt.Errorf("a join can only be grouped by time and the join tags, got %s", d)
+	}
+
+	var sides [2]Source
+	for i, mm := range mms {
+		if len(fields[i]) == 0 {
+			return fmt.Errorf("no field of %s is selected in the join", mm.Name)
+		}
+		sides[i] = &SubQuery{Statement: &SelectStatement{
+			Fields:     fields[i],
+			Sources:    Sources{mm},
+			Dimensions: Dimensions{{Expr: &VarRef{Val: keys[i]}}},
+			IsRawQuery: true,
+			Location:   s.Location,
+		}}
+	}
+	join.LSrc, join.RSrc = sides[0], sides[1]
+	join.Condition = &BinaryExpr{Op: EQ, LHS: &VarRef{Val: keys[0]}, RHS: &VarRef{Val: keys[1]}}
+	return nil
+}
+
+// joinRefSide returns the index of the measurement a reference is qualified with and the
+// reference without the qualifier, the index is -1 if the reference is not qualified.
+func joinRefSide(mms [2]*Measurement, val string) (int, string) {
+	side, name := -1, val
+	for i, mm := range mms {
+		prefix := mm.Name + "."
+		if strings.HasPrefix(val, prefix) && (side < 0 || len(prefix) > len(mms[side].Name)+1) {
+			side, name = i, val[len(prefix):]
+		}
+	}
+	return side, name
+}
+
 // RewriteDistinct rewrites the expression to be a call for map/reduce to work correctly.
 // This method assumes all validation has passed.
 func (s *SelectStatement) RewriteDistinct() {
@@ -2192,6 +2608,12 @@ func (s *SelectStatement) Reduce(valuer Valuer) *SelectStatement {
 		switch source := source.(type) {
 		case *SubQuery:
 			source.Statement = source.Statement.Reduce(valuer)
+		case *Join:
+			for _, side := range []Source{source.LSrc, source.RSrc} {
+				if q, ok := side.(*SubQuery); ok {
+					q.Statement = q.Statement.Reduce(valuer)
+				}
+			}
 		}
 	}
 	return stmt
@@ -2925,11 +3347,20 @@ func (s *CreateContinuousQueryStatement) RequiredPrivileges() (ExecutionPrivileg
 	return ep, nil
 }
 
-func (s *CreateContinuousQueryStatement) validate() error {
+// Validate checks that the source of the continuous query writes into a target,
+// is grouped by time if it is aggregated, and resamples a long enough window.
+func (s *CreateContinuousQueryStatement) Validate() error {
+	if s.Source == nil || s.Source.Target == nil {
+		return errors.New("continuous query requires a SELECT INTO statement")
+	}
+
 	interval, err := s.Source.GroupByInterval()
 	if err != nil {
 		return err
 	}
+	if !s.Source.IsRawQuery && interval == 0 {
+		return errors.New("continuous query requires a GROUP BY time(...) for aggregate queries")
+	}
 
 	if s.ResampleFor != 0 {
 		if s.ResampleEvery != 0 && s.ResampleEvery > interval {
@@ -2963,6 +3394,134 @@ func (s *DropContinuousQueryStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// DownSampleCall is the aggregations kept by a down sample policy for the fields of a data type.
+type DownSampleCall struct {
+	DataType DataType
+	Ops      []string
+}
+
+// CreateDownSampleStatement represents a command for attaching a down sample policy to a retention policy.
+type CreateDownSampleStatement struct {
+	Database        string
+	RetentionPolicy string
+
+	Calls           []*DownSampleCall
+	SampleIntervals []time.Duration
+	TimeIntervals   []time.Duration
+}
+
+// String returns a string representation of the statement.
+func (s *CreateDownSampleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE DOWNSAMPLE")
+	writeDownSampleOn(&buf, s.Database, s.RetentionPolicy)
+	_, _ = buf.WriteString(" (")
+	for i, c := range s.Calls {
+		if i > 0 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString(c.DataType.String())
+		_, _ = buf.WriteString("(")
+		_, _ = buf.WriteString(strings.Join(c.Ops, ","))
+		_, _ = buf.WriteString(")")
+	}
+	_, _ = buf.WriteString(") WITH SAMPLEINTERVAL(")
+	writeDurations(&buf, s.SampleIntervals)
+	_, _ = buf.WriteString(") TIMEINTERVAL(")
+	writeDurations(&buf, s.TimeIntervals)
+	_, _ = buf.WriteString(")")
+	return buf.String()
+}
+
+// Validate checks that every sample interval has a time interval.
+func (s *CreateDownSampleStatement) Validate() error {
+	if len(s.SampleIntervals) != len(s.TimeIntervals) {
+		return fmt.Errorf("the number of SAMPLEINTERVAL and TIMEINTERVAL must be the same")
+	}
+	return nil
+}
+
+// RequiredPrivileges returns the privilege required to execute a CreateDownSampleStatement.
+func (s *CreateDownSampleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: WritePrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *CreateDownSampleStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// DropDownSampleStatement represents a command for removing the down sample policy of a retention policy.
+type DropDownSampleStatement struct {
+	Database        string
+	RetentionPolicy string
+}
+
+// String returns a string representation of the statement.
+func (s *DropDownSampleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DROP DOWNSAMPLE")
+	writeDownSampleOn(&buf, s.Database, s.RetentionPolicy)
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a DropDownSampleStatement.
+func (s *DropDownSampleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: WritePrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *DropDownSampleStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// ShowDownSamplesStatement represents a command for listing the down sample policies of a database.
+type ShowDownSamplesStatement struct {
+	Database string
+}
+
+// String returns a string representation of the statement.
+func (s *ShowDownSamplesStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW DOWNSAMPLES")
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowDownSamplesStatement.
+func (s *ShowDownSamplesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowDownSamplesStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+func writeDownSampleOn(buf *bytes.Buffer, db, rp string) {
+	if db == "" {
+		return
+	}
+	_, _ = buf.WriteString(" ON ")
+	_, _ = buf.WriteString(QuoteIdent(db))
+	if rp != "" {
+		_, _ = buf.WriteString(".")
+		_, _ = buf.WriteString(QuoteIdent(rp))
+	}
+}
+
+func writeDurations(buf *bytes.Buffer, durations []time.Duration) {
+	for i, d := range durations {
+		if i > 0 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString(FormatDuration(d))
+	}
+}
+
 // ShowMeasurementCardinalityStatement represents a command for listing measurement cardinality.
 type ShowMeasurementCardinalityStatement struct {
 	Exact         bool // If false then cardinality estimation will be used.
@@ -3371,6 +3930,47 @@ func (s *ShowTagKeysStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// ShowTagCardinalityStatement represents a command for listing the tag keys with the most values of each
+// measurement.
+type ShowTagCardinalityStatement struct {
+	Database      string
+	Sources       Sources
+	Limit, Offset int
+}
+
+// String returns a string representation of the statement.
+func (s *ShowTagCardinalityStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW TAG CARDINALITY")
+
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	if s.Sources != nil {
+		_, _ = buf.WriteString(" FROM ")
+		_, _ = buf.WriteString(s.Sources.String())
+	}
+	if s.Limit > 0 {
+		_, _ = fmt.Fprintf(&buf, " LIMIT %d", s.Limit)
+	}
+	if s.Offset > 0 {
+		_, _ = buf.WriteString(" OFFSET ")
+		_, _ = buf.WriteString(strconv.Itoa(s.Offset))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowTagCardinalityStatement.
+func (s *ShowTagCardinalityStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return s.Sources.RequiredPrivileges()
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowTagCardinalityStatement) DefaultDatabase() string {
+	return s.Database
+}
+
 // ShowTagKeyCardinalityStatement represents a command for listing tag key cardinality.
 type ShowTagKeyCardinalityStatement struct {
 	Database      string
@@ -3928,6 +4528,62 @@ func (s *SubQuery) String() string {
 	return fmt.Sprintf("(%s)", s.Statement.String())
 }
 
+// Join is a source which joins the rows of two sources on the value of a tag and the time.
+// Rows having no match on the other side are kept with the fields of the other side set to null.
+type Join struct {
+	LSrc      Source
+	RSrc      Source
+	Condition Expr
+}
+
+// String returns a string representation of the join.
+func (j *Join) String() string {
+	return fmt.Sprintf("%s FULL OUTER JOIN %s ON %s", j.LSrc.String(), j.RSrc.String(), j.Condition.String())
+}
+
+// Keys returns the tags of the left and the right source compared by the join condition.
+func (j *Join) Keys() (string, string, error) {
+	cond, ok := j.Condition.(*BinaryExpr)
+	if !ok || cond.Op != EQ {
+		return "", "", fmt.Errorf("join condition must be an equality of two tags: %s", j.Condition)
+	}
+	lhs, lok := cond.LHS.(*VarRef)
+	rhs, rok := cond.RHS.(*VarRef)
+	if !lok || !rok {
+		return "", "", fmt.Errorf("join condition must be an equality of two tags: %s", j.Condition)
+	}
+	return lhs.Val, rhs.Val, nil
+}
+
+// rewriteFields rewrites the fields of the subqueries on both sides. A side without any field
+// can not match any row, so the join is replaced by the other side.
+func (j *Join) rewriteFields(m FieldMapper, batchEn bool) (Source, error) {
+	lq, lok := j.LSrc.(*SubQuery)
+	rq, rok := j.RSrc.(*SubQuery)
+	if !lok || !rok {
+		return nil, errors.New("join sources must be rewritten to subqueries")
+	}
+
+	lstmt, lerr := lq.Statement.RewriteFields(m, batchEn)
+	if lerr != nil && lerr != ErrDeclareEmptyCollection {
+		return nil, lerr
+	}
+	rstmt, rerr := rq.Statement.RewriteFields(m, batchEn)
+	if rerr != nil && rerr != ErrDeclareEmptyCollection {
+		return nil, rerr
+	}
+
+	switch {
+	case lerr != nil && rerr != nil:
+		return nil, ErrDeclareEmptyCollection
+	case lerr != nil:
+		return &SubQuery{Statement: rstmt}, nil
+	case rerr != nil:
+		return &SubQuery{Statement: lstmt}, nil
+	}
+	return &Join{LSrc: &SubQuery{Statement: lstmt}, RSrc: &SubQuery{Statement: rstmt}, Condition: j.Condition}, nil
+}
+
 // VarRef represents a reference to a variable.
 type VarRef struct {
 	Val  string
@@ -4392,6 +5048,9 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
 
+	case *ShowTagCardinalityStatement:
+		Walk(v, n.Sources)
+
 	case *ShowTagKeyCardinalityStatement:
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
@@ -4427,6 +5086,11 @@ func Walk(v Visitor, node Node) {
 	case *SubQuery:
 		Walk(v, n.Statement)
 
+	case *Join:
+		Walk(v, n.LSrc)
+		Walk(v, n.RSrc)
+		Walk(v, n.Condition)
+
 	case Statements:
 		for _, s := range n {
 			Walk(v, s)
@@ -4483,6 +5147,10 @@ func Rewrite(r Rewriter, node Node) Node {
 	case *SubQuery:
 		n.Statement = Rewrite(r, n.Statement).(*SelectStatement)
 
+	case *Join:
+		n.LSrc = Rewrite(r, n.LSrc).(Source)
+		n.RSrc = Rewrite(r, n.RSrc).(Source)
+
 	case Fields:
 		for i, f := range n {
 			n[i] = Rewrite(r, f).(*Field)
@@ -4994,13 +5662,19 @@ func (v *ValuerEval) evalBinaryExpr(expr *BinaryExpr) interface{} {
 				return false
 			}
 			return !rhs.MatchString(lhs)
+		case MATCH:
+			rhs, ok := rhs.(string)
+			if !ok {
+				return false
+			}
+			return MatchText(lhs, rhs)
 		}
 	}
 
 	// The types were not comparable. If our operation was an equality operation,
 	// return false instead of true.
 	switch expr.Op {
-	case EQ, NEQ, LT, LTE, GT, GTE:
+	case EQ, NEQ, LT, LTE, GT, GTE, MATCH:
 		return false
 	}
 	return nil
@@ -5177,6 +5851,19 @@ func (v *TypeValuerEval) EvalTypeBatch(exprs map[string]Expr, schema *Schema, ba
 				}
 			}
 			fTypes = append(fTypes, fields)
+		case *Join:
+			valuer := TypeValuerEval{
+				TypeMapper: v.TypeMapper,
+				Sources:    Sources{src.LSrc, src.RSrc},
+			}
+			for k := range fields {
+				t, err := valuer.EvalType(&VarRef{Val: k}, false)
+				if err != nil {
+					return err
+				}
+				fields[k] = t
+			}
+			fTypes = append(fTypes, fields)
 		}
 	}
 
@@ -5251,6 +5938,16 @@ func (v *TypeValuerEval) evalVarRefExprType(expr *VarRef, batchEn bool) (DataTyp
 						}
 					}
 				}
+			case *Join:
+				valuer := TypeValuerEval{
+					TypeMapper: v.TypeMapper,
+					Sources:    Sources{src.LSrc, src.RSrc},
+				}
+				if t, err := valuer.EvalType(expr, batchEn); err != nil {
+					return Unknown, err
+				} else if typ.LessThan(t) {
+					typ = t
+				}
 			}
 		}
 	}
@@ -1819,7 +1819,7 @@ func (p *Parser) parseCreateContinuousQueryStatement() (*CreateContinuousQuerySt
 		return nil, newParseError(tokstr(tok, lit), []string{"END"}, pos)
 	}
 
-	if err := stmt.validate(); err != nil {
+	if err := stmt.Validate(); err != nil {
 		return nil, err
 	}
 
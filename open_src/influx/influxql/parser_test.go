@@ -51,3 +51,16 @@ func BenchmarkParseExpr(b *testing.B) {
 		_, _ = influxql.ParseExpr(cond)
 	}
 }
+
+func TestEvalMatch(t *testing.T) {
+	expr := &influxql.BinaryExpr{
+		Op:  influxql.MATCH,
+		LHS: &influxql.VarRef{Val: "msg"},
+		RHS: &influxql.StringLiteral{Val: "Read timeout"},
+	}
+	assert.Equal(t, true, influxql.EvalBool(expr, map[string]interface{}{"msg": "read tcp 10.0.0.1: i/o TIMEOUT, retry read"}))
+	assert.Equal(t, false, influxql.EvalBool(expr, map[string]interface{}{"msg": "read failed"}))
+	assert.Equal(t, false, influxql.EvalBool(expr, map[string]interface{}{"msg": "readtimeout"}))
+	assert.Equal(t, false, influxql.EvalBool(expr, map[string]interface{}{}))
+	assert.Equal(t, []string{"a1", "b", "ü"}, influxql.Tokenize("A1-b, Ü"))
+}
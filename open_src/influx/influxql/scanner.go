@@ -18,6 +18,11 @@ type Scanner struct {
 	r        *reader
 	preToken Token
 	checkDOT bool
+
+	// the ON of SUBSCRIPTION name ON is followed by db.rp
+	subscription bool
+	// the ON of GRANT and REVOKE is followed by db.rp.measurement
+	grant bool
 }
 
 // NewScanner returns a new instance of Scanner.
@@ -37,6 +42,8 @@ func (s *Scanner) reset(r io.Reader) {
 		s.r.buf[i].pos.Line = 0
 	}
 	s.r.eof = false
+	s.subscription = false
+	s.grant = false
 }
 
 // Scan returns the next token and position from the underlying reader.
@@ -44,14 +51,25 @@ func (s *Scanner) reset(r io.Reader) {
 // since these token types can have different literal representations.
 func (s *Scanner) Scan() (tok Token, pos Pos, lit string) {
 	defer func() {
-		if tok != WS {
-			s.preToken = tok
-		}
-		if tok >= FROM && tok <= MEASUREMENT {
+		// DOWNSAMPLE ON is followed by db.rp, FULL OUTER JOIN by db.rp.measurement, GRANT ON by db.rp.measurement
+		if (tok >= FROM && tok <= MEASUREMENT) || tok == INTO || tok == JOIN || (tok == ON && (s.preToken == DOWNSAMPLE || s.subscription || s.grant)) {
 			s.checkDOT = true
 		} else if tok > MEASUREMENT && tok <= ASC {
 			s.checkDOT = false
 		}
+		if tok == SUBSCRIPTION {
+			s.subscription = true
+		} else if tok == ON {
+			s.subscription = false
+		}
+		if tok == GRANT || tok == REVOKE {
+			s.grant = true
+		} else if tok == ON || tok == TO || tok == FROM {
+			s.grant = false
+		}
+		if tok != WS {
+			s.preToken = tok
+		}
 	}()
 	// Read next code point.
 	ch0, pos := s.r.read()
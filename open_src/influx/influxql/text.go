@@ -0,0 +1,49 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package influxql
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenize splits a text into lower case words, a word is a run of letters and digits.
+// The text index and the MATCH operator must tokenize in the same way.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// MatchText reports whether the text contains all words of the pattern, in any order.
+func MatchText(text, pattern string) bool {
+	words := Tokenize(pattern)
+	if len(words) == 0 {
+		return false
+	}
+
+	tokens := make(map[string]struct{})
+	for _, token := range Tokenize(text) {
+		tokens[token] = struct{}{}
+	}
+	for _, word := range words {
+		if _, ok := tokens[word]; !ok {
+			return false
+		}
+	}
+	return true
+}
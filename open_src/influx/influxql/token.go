@@ -130,6 +130,20 @@ const DIV = 57460
 const MOD = 57461
 const BITWISE_AND = 57462
 const UMINUS = 57463
+const INTO = 57464
+const COLON = 57465
+const BEGIN = 57466
+const RESAMPLE = 57467
+const EVERY = 57468
+const DOWNSAMPLE = 57469
+const DOWNSAMPLES = 57470
+const SAMPLEINTERVAL = 57471
+const TIMEINTERVAL = 57472
+const MATCH = 57473
+const ANY = 57474
+const DESTINATIONS = 57475
+const ROLE = 57476
+const ROLES = 57477
 
 // Token is a lexical token of the InfluxQL language.
 type Token int
@@ -185,7 +199,7 @@ const (
 	//LPAREN // (
 	//RPAREN // )
 	//COMMA       // ,
-	COLON // :
+	//COLON // :
 	//DOUBLECOLON // ::
 	//SEMICOLON   // ;
 	//DOT // .
@@ -195,10 +209,10 @@ const (
 	//ALL
 	//ALTER
 	//ANALYZE
-	ANY
+	//ANY
 	//AS
 	//ASC
-	BEGIN //CREATE CONTINUOUS QUERY ON "telegraf" BEGIN
+	//BEGIN //CREATE CONTINUOUS QUERY ON "telegraf" BEGIN
 	//BY
 	//CARDINALITY
 	//CREATE
@@ -208,14 +222,14 @@ const (
 	//DEFAULT
 	//DELETE
 	//DESC
-	DESTINATIONS
+	//DESTINATIONS
 
 	//DIAGNOSTICS  // SHOW DIAGNOSTICS
 	DISTINCT //distinct()
 	//DROP
 	//DURATION
 	//END
-	EVERY
+	//EVERY
 	//EXACT
 	//EXPLAIN
 	//FIELD
@@ -228,7 +242,7 @@ const (
 	//IN
 	INF
 	INSERT
-	INTO
+	//INTO
 	//KEY
 	//KEYS
 	KILL
@@ -247,9 +261,11 @@ const (
 	//QUERY
 	READ //privilege        = "ALL" [ "PRIVILEGES" ] | "READ" | "WRITE" .
 	//REPLICATION
-	RESAMPLE
+	//RESAMPLE
 	//RETENTION
 	//REVOKE
+	//ROLE
+	//ROLES
 	//SELECT
 	//SERIES
 	//SET
@@ -318,6 +334,7 @@ var tokens = [...]string{
 	LTE:      "<=",
 	GT:       ">",
 	GTE:      ">=",
+	MATCH:    "MATCH",
 
 	LPAREN:      "(",
 	RPAREN:      ")",
@@ -327,106 +344,112 @@ var tokens = [...]string{
 	SEMICOLON:   ";",
 	DOT:         ".",
 
-	ALL:           "ALL",
-	ALTER:         "ALTER",
-	ANALYZE:       "ANALYZE",
-	ANY:           "ANY",
-	AS:            "AS",
-	ASC:           "ASC",
-	BEGIN:         "BEGIN",
-	BY:            "BY",
-	CARDINALITY:   "CARDINALITY",
-	CREATE:        "CREATE",
-	CONTINUOUS:    "CONTINUOUS",
-	DATABASE:      "DATABASE",
-	DATABASES:     "DATABASES",
-	DEFAULT:       "DEFAULT",
-	DELETE:        "DELETE",
-	DESC:          "DESC",
-	DESTINATIONS:  "DESTINATIONS",
-	DIAGNOSTICS:   "DIAGNOSTICS",
-	DISTINCT:      "DISTINCT",
-	DROP:          "DROP",
-	DURATION:      "DURATION",
-	CASE:          "CASE",
-	WHEN:          "WHEN",
-	THEN:          "THEN",
-	ELSE:          "ELSE",
-	END:           "END",
-	EVERY:         "EVERY",
-	EXACT:         "EXACT",
-	EXPLAIN:       "EXPLAIN",
-	FIELD:         "FIELD",
-	FOR:           "FOR",
-	FROM:          "FROM",
-	GRANT:         "GRANT",
-	GRANTS:        "GRANTS",
-	GROUP:         "GROUP",
-	GROUPS:        "GROUPS",
-	IN:            "IN",
-	NOT:           "NOT",
-	EXISTS:        "EXISTS",
-	INF:           "INF",
-	INSERT:        "INSERT",
-	INTO:          "INTO",
-	KEY:           "KEY",
-	KEYS:          "KEYS",
-	KILL:          "KILL",
-	LIMIT:         "LIMIT",
-	MEASUREMENT:   "MEASUREMENT",
-	MEASUREMENTS:  "MEASUREMENTS",
-	NAME:          "NAME",
-	OFFSET:        "OFFSET",
-	ON:            "ON",
-	ORDER:         "ORDER",
-	PASSWORD:      "PASSWORD",
-	POLICY:        "POLICY",
-	POLICIES:      "POLICIES",
-	PRIVILEGES:    "PRIVILEGES",
-	QUERIES:       "QUERIES",
-	QUERY:         "QUERY",
-	READ:          "READ",
-	REPLICATION:   "REPLICATION",
-	RESAMPLE:      "RESAMPLE",
-	RETENTION:     "RETENTION",
-	REVOKE:        "REVOKE",
-	SELECT:        "SELECT",
-	SERIES:        "SERIES",
-	SET:           "SET",
-	SHOW:          "SHOW",
-	SHARD:         "SHARD",
-	SHARDKEY:      "SHARDKEY",
-	SHARDS:        "SHARDS",
-	SLIMIT:        "SLIMIT",
-	SOFFSET:       "SOFFSET",
-	STATS:         "STATS",
-	SUBSCRIPTION:  "SUBSCRIPTION",
-	SUBSCRIPTIONS: "SUBSCRIPTIONS",
-	TYPE:          "TYPE",
-	TAG:           "TAG",
-	TO:            "TO",
-	USER:          "USER",
-	USERS:         "USERS",
-	VALUES:        "VALUES",
-	WHERE:         "WHERE",
-	WITH:          "WITH",
-	WRITE:         "WRITE",
-	PARTITION:     "PARTITION",
-	PREPARE:       "PREPARE",
-	SNAPSHOT:      "SNAPSHOT",
-	GET:           "GET",
-	RUNTIMEINFO:   "RUNTIMEINFO",
-	HINT:          "HINT",
-	HOT:           "HOT",
-	WARM:          "WARM",
-	INDEX:         "INDEX",
-	FULL:          "FULL",
-	OUTER:         "OUTER",
-	JOIN:          "JOIN",
-	FILL:          "FILL",
-	REPLICANUM:    "REPLICANUM",
-	INDEXTYPE:     "INDEXTYPE",
-	INDEXLIST:     "INDEXLIST",
+	ALL:            "ALL",
+	ALTER:          "ALTER",
+	ANALYZE:        "ANALYZE",
+	ANY:            "ANY",
+	AS:             "AS",
+	ASC:            "ASC",
+	BEGIN:          "BEGIN",
+	BY:             "BY",
+	CARDINALITY:    "CARDINALITY",
+	CREATE:         "CREATE",
+	CONTINUOUS:     "CONTINUOUS",
+	DATABASE:       "DATABASE",
+	DATABASES:      "DATABASES",
+	DEFAULT:        "DEFAULT",
+	DELETE:         "DELETE",
+	DESC:           "DESC",
+	DESTINATIONS:   "DESTINATIONS",
+	DIAGNOSTICS:    "DIAGNOSTICS",
+	DISTINCT:       "DISTINCT",
+	DOWNSAMPLE:     "DOWNSAMPLE",
+	DOWNSAMPLES:    "DOWNSAMPLES",
+	DROP:           "DROP",
+	DURATION:       "DURATION",
+	CASE:           "CASE",
+	WHEN:           "WHEN",
+	THEN:           "THEN",
+	ELSE:           "ELSE",
+	END:            "END",
+	EVERY:          "EVERY",
+	EXACT:          "EXACT",
+	EXPLAIN:        "EXPLAIN",
+	FIELD:          "FIELD",
+	FOR:            "FOR",
+	FROM:           "FROM",
+	GRANT:          "GRANT",
+	GRANTS:         "GRANTS",
+	GROUP:          "GROUP",
+	GROUPS:         "GROUPS",
+	IN:             "IN",
+	NOT:            "NOT",
+	EXISTS:         "EXISTS",
+	INF:            "INF",
+	INSERT:         "INSERT",
+	INTO:           "INTO",
+	KEY:            "KEY",
+	KEYS:           "KEYS",
+	KILL:           "KILL",
+	LIMIT:          "LIMIT",
+	MEASUREMENT:    "MEASUREMENT",
+	MEASUREMENTS:   "MEASUREMENTS",
+	NAME:           "NAME",
+	OFFSET:         "OFFSET",
+	ON:             "ON",
+	ORDER:          "ORDER",
+	PASSWORD:       "PASSWORD",
+	POLICY:         "POLICY",
+	POLICIES:       "POLICIES",
+	PRIVILEGES:     "PRIVILEGES",
+	QUERIES:        "QUERIES",
+	QUERY:          "QUERY",
+	READ:           "READ",
+	REPLICATION:    "REPLICATION",
+	RESAMPLE:       "RESAMPLE",
+	RETENTION:      "RETENTION",
+	SAMPLEINTERVAL: "SAMPLEINTERVAL",
+	REVOKE:         "REVOKE",
+	ROLE:           "ROLE",
+	ROLES:          "ROLES",
+	SELECT:         "SELECT",
+	SERIES:         "SERIES",
+	SET:            "SET",
+	SHOW:           "SHOW",
+	SHARD:          "SHARD",
+	SHARDKEY:       "SHARDKEY",
+	SHARDS:         "SHARDS",
+	SLIMIT:         "SLIMIT",
+	SOFFSET:        "SOFFSET",
+	STATS:          "STATS",
+	SUBSCRIPTION:   "SUBSCRIPTION",
+	SUBSCRIPTIONS:  "SUBSCRIPTIONS",
+	TYPE:           "TYPE",
+	TAG:            "TAG",
+	TIMEINTERVAL:   "TIMEINTERVAL",
+	TO:             "TO",
+	USER:           "USER",
+	USERS:          "USERS",
+	VALUES:         "VALUES",
+	WHERE:          "WHERE",
+	WITH:           "WITH",
+	WRITE:          "WRITE",
+	PARTITION:      "PARTITION",
+	PREPARE:        "PREPARE",
+	SNAPSHOT:       "SNAPSHOT",
+	GET:            "GET",
+	RUNTIMEINFO:    "RUNTIMEINFO",
+	HINT:           "HINT",
+	HOT:            "HOT",
+	WARM:           "WARM",
+	INDEX:          "INDEX",
+	FULL:           "FULL",
+	OUTER:          "OUTER",
+	JOIN:           "JOIN",
+	FILL:           "FILL",
+	REPLICANUM:     "REPLICANUM",
+	INDEXTYPE:      "INDEXTYPE",
+	INDEXLIST:      "INDEXLIST",
 }
 
 var keywords map[string]int
@@ -436,7 +459,7 @@ func init() {
 	for tok := FROM; tok <= ASC; tok++ {
 		keywords[strings.ToLower(tokens[tok])] = tok
 	}
-	for _, tok := range []int{AND, OR} {
+	for _, tok := range []int{AND, OR, INTO, BEGIN, RESAMPLE, EVERY, DOWNSAMPLE, DOWNSAMPLES, SAMPLEINTERVAL, TIMEINTERVAL, MATCH, ANY, DESTINATIONS, ROLE, ROLES} {
 		keywords[strings.ToLower(tokens[tok])] = tok
 	}
 	/*	keywords["true"] = TRUE
@@ -462,6 +485,7 @@ var operatorMap = map[Token]int{
 	LTE:      LTE,
 	GT:       GT,
 	GTE:      GTE,
+	MATCH:    MATCH,
 	ADD:      ADD,
 	SUB:      SUB,
 	MUL:      MUL,
@@ -476,7 +500,7 @@ func (tok Token) Precedence() int {
 		return 1
 	case AND:
 		return 2
-	case EQ, NEQ, EQREGEX, NEQREGEX, LT, LTE, GT, GTE:
+	case EQ, NEQ, EQREGEX, NEQREGEX, LT, LTE, GT, GTE, MATCH:
 		return 3
 	case ADD, SUB, BITWISE_OR, BITWISE_XOR:
 		return 4
@@ -100,40 +100,62 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 	}
 	// Check each statement in the query.
 	for _, stmt := range query.Statements {
-		// Get the privileges required to execute the statement.
-		privs, err := stmt.RequiredPrivileges()
-		if err != nil {
-			return err
+		err := u.authorizeStatement(database, query, stmt)
+		if _, ok := err.(*ErrAuthorize); !ok || len(u.grants) == 0 {
+			if err != nil {
+				return err
+			}
+			continue
 		}
 
-		// Make sure the GetUser has the privileges required to execute
-		// each statement.
-		for _, p := range privs {
-			if p.Admin {
-				// Admin privilege already checked so statement requiring admin
-				// privilege cannot be run.
-				return &ErrAuthorize{
-					Query:    query,
-					User:     u.Name,
-					Database: database,
-					Message:  fmt.Sprintf("statement '%s', requires admin privilege", stmt),
-				}
+		// The roles of the user may grant what its database privileges do not.
+		required, rerr := statementPrivileges(stmt, database)
+		if rerr != nil {
+			return rerr
+		}
+		for _, req := range required {
+			if !u.authorizeRoles(req) {
+				return err
 			}
+		}
+	}
+	return nil
+}
 
-			// Use the db name specified by the statement or the db
-			// name passed by the caller if one wasn't specified by
-			// the statement.
-			db := p.Name
-			if db == "" {
-				db = database
+func (u *UserInfo) authorizeStatement(database string, query *influxql.Query, stmt influxql.Statement) error {
+	// Get the privileges required to execute the statement.
+	privs, err := stmt.RequiredPrivileges()
+	if err != nil {
+		return err
+	}
+
+	// Make sure the GetUser has the privileges required to execute
+	// each statement.
+	for _, p := range privs {
+		if p.Admin {
+			// Admin privilege already checked so statement requiring admin
+			// privilege cannot be run.
+			return &ErrAuthorize{
+				Query:    query,
+				User:     u.Name,
+				Database: database,
+				Message:  fmt.Sprintf("statement '%s', requires admin privilege", stmt),
 			}
-			if !u.AuthorizeDatabase(originql.Privilege(p.Privilege), db) {
-				return &ErrAuthorize{
-					Query:    query,
-					User:     u.Name,
-					Database: database,
-					Message:  fmt.Sprintf("statement '%s', requires %s on %s", stmt, p.Privilege.String(), db),
-				}
+		}
+
+		// Use the db name specified by the statement or the db
+		// name passed by the caller if one wasn't specified by
+		// the statement.
+		db := p.Name
+		if db == "" {
+			db = database
+		}
+		if !u.AuthorizeDatabase(originql.Privilege(p.Privilege), db) {
+			return &ErrAuthorize{
+				Query:    query,
+				User:     u.Name,
+				Database: database,
+				Message:  fmt.Sprintf("statement '%s', requires %s on %s", stmt, p.Privilege.String(), db),
 			}
 		}
 	}
@@ -155,3 +177,8 @@ func (e ErrAuthorize) Error() string {
 	}
 	return fmt.Sprintf("%s not authorized to execute %s", e.User, e.Message)
 }
+
+// AuthorizationFailed allows the error to be reported as a failed authorization.
+func (e ErrAuthorize) AuthorizationFailed() bool {
+	return true
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+)
+
+// ContinuousQueryInfo represents metadata about a continuous query.
+type ContinuousQueryInfo struct {
+	Name  string
+	Query string
+
+	// LastRunTime is the end of the last window the query has been executed for,
+	// it is zero if the query has never run.
+	LastRunTime time.Time
+}
+
+// marshal serializes to a protobuf representation.
+func (cqi ContinuousQueryInfo) marshal() *proto2.ContinuousQueryInfo {
+	pb := &proto2.ContinuousQueryInfo{
+		Name:  proto.String(cqi.Name),
+		Query: proto.String(cqi.Query),
+	}
+	if !cqi.LastRunTime.IsZero() {
+		pb.LastRunTime = proto.Int64(cqi.LastRunTime.UnixNano())
+	}
+	return pb
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (cqi *ContinuousQueryInfo) unmarshal(pb *proto2.ContinuousQueryInfo) {
+	cqi.Name = pb.GetName()
+	cqi.Query = pb.GetQuery()
+	if pb.LastRunTime != nil {
+		cqi.LastRunTime = time.Unix(0, pb.GetLastRunTime()).UTC()
+	}
+}
+
+// ContinuousQueryLease is held by the ts-sql which is in charge of running all continuous queries.
+type ContinuousQueryLease struct {
+	Owner      string
+	Expiration time.Time
+}
+
+// Expired reports whether the lease is no longer held at now.
+func (l *ContinuousQueryLease) Expired(now time.Time) bool {
+	return l.Owner == "" || !now.Before(l.Expiration)
+}
+
+// marshal serializes to a protobuf representation.
+func (l ContinuousQueryLease) marshal() *proto2.ContinuousQueryLease {
+	return &proto2.ContinuousQueryLease{
+		Owner:      proto.String(l.Owner),
+		Expiration: proto.Int64(l.Expiration.UnixNano()),
+	}
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (l *ContinuousQueryLease) unmarshal(pb *proto2.ContinuousQueryLease) {
+	l.Owner = pb.GetOwner()
+	l.Expiration = time.Unix(0, pb.GetExpiration()).UTC()
+}
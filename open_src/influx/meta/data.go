@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,6 +44,7 @@ import (
 	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/open_src/github.com/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
 	"go.uber.org/zap"
 )
@@ -88,8 +90,13 @@ type Data struct {
 
 	Databases     map[string]*DatabaseInfo
 	Users         []UserInfo
+	Roles         []RoleInfo
+	RateLimits    []RateLimitInfo
 	MigrateEvents map[string]*MigrateEventInfo
 
+	// CQLease is the lease of the ts-sql running continuous queries.
+	CQLease ContinuousQueryLease
+
 	// adminUserExists provides a constant time mechanism for determining
 	// if there is at least one admin GetUser.
 	AdminUserExists bool
@@ -136,7 +143,7 @@ func (data *Data) GetDurationInfos(ptIds []uint32) map[uint64]*ShardDurationInfo
 						return
 					}
 					sg.walkShards(func(sh *ShardInfo) {
-						if sh.Owners[0] == ptIds[i] {
+						if sh.OwnedBy(ptIds[i]) {
 							durationInfo := &ShardDurationInfo{}
 							durationInfo.Ident = ShardIdentifier{}
 							durationInfo.Ident.ShardID = sh.ID
@@ -166,7 +173,7 @@ func (data *Data) DurationInfos(ptIds []uint32) *ShardDurationResponse {
 			db.WalkRetentionPolicy(func(rp *RetentionPolicyInfo) {
 				rp.walkShardGroups(func(sg *ShardGroupInfo) {
 					sg.walkShards(func(sh *ShardInfo) {
-						if sh.Owners[0] == ptIds[i] {
+						if sh.OwnedBy(ptIds[i]) {
 							durationInfo := ShardDurationInfo{}
 							durationInfo.Ident = ShardIdentifier{}
 							durationInfo.Ident.ShardID = sh.ID
@@ -743,7 +750,7 @@ func (data *Data) ShardsOfDBPT(name string, ptID uint32) map[string][]uint64 {
 						continue
 					}
 					for _, sh := range sg.Shards {
-						if sh.Owners[0] == ptID {
+						if sh.OwnedBy(ptID) {
 							shardIds = append(shardIds, sh.ID)
 						}
 					}
@@ -930,6 +937,17 @@ func (data *Data) DropDatabase(name string) {
 	for i := range data.Users {
 		delete(data.Users[i].Privileges, name)
 	}
+	for i := range data.Roles {
+		grants := data.Roles[i].Grants[:0]
+		for _, g := range data.Roles[i].Grants {
+			if g.Database != name {
+				grants = append(grants, g)
+			}
+		}
+		data.Roles[i].Grants = grants
+	}
+	data.resolveRoles()
+	data.removeRateLimit(name, "")
 
 	if data.PtView != nil {
 		delete(data.PtView, name)
@@ -1220,6 +1238,14 @@ func (data *Data) UpdateRetentionPolicy(database, name string, rpu *RetentionPol
 		checkRpi.Name = rpi.Name
 	}
 
+	if rpu.ReplicaN != nil {
+		if *rpu.ReplicaN < 1 {
+			return ErrReplicationFactorTooLow
+		}
+		// the new replication factor applies to the shard groups created later
+		rpi.ReplicaN = *rpu.ReplicaN
+	}
+
 	rpi.updateWithOtherRetentionPolicy(checkRpi)
 
 	if makeDefault {
@@ -1380,6 +1406,9 @@ func (data *Data) CreateShardGroup(database, policy string, timestamp time.Time,
 	} else if replicaN > len(data.DataNodes) {
 		replicaN = len(data.DataNodes)
 	}
+	if data.ClusterPtNum > 0 && replicaN > int(data.ClusterPtNum) {
+		replicaN = int(data.ClusterPtNum)
+	}
 
 	// Determine shard count by node count divided by replication factor.
 	// This will ensure nodes will get distributed across nodes evenly and
@@ -1416,15 +1445,14 @@ func (data *Data) CreateShardGroup(database, policy string, timestamp time.Time,
 	for i := range sgi.Shards {
 		data.MaxShardID++
 		sgi.Shards[i] = ShardInfo{ID: data.MaxShardID, Tier: tier}
+		// the replicas of the shard are the consecutive pts of a replica group, which are placed on
+		// different nodes, the index of the first pt is the one of the shard
+		first := (i * replicaN) % (shardN * replicaN)
 		sgi.Shards[i].Owners = make([]uint32, 0, replicaN)
-		for ptId := 0; ptId < int(data.ClusterPtNum); ptId++ {
-			if ptId%shardN == i {
-				for j := 0; j < replicaN; j++ {
-					sgi.Shards[i].Owners = append(sgi.Shards[i].Owners, uint32(ptId+j))
-					sgi.Shards[i].IndexID = igi.Indexes[ptId].ID
-				}
-			}
+		for j := 0; j < replicaN; j++ {
+			sgi.Shards[i].Owners = append(sgi.Shards[i].Owners, uint32(first+j))
 		}
+		sgi.Shards[i].IndexID = igi.Indexes[first].ID
 		if lastSgi != nil {
 			sgi.Shards[i].Min = lastSgi.Shards[i].Min
 			sgi.Shards[i].Max = lastSgi.Shards[i].Max
@@ -1595,6 +1623,12 @@ func validateURL(input string) error {
 
 // CreateSubscription adds a named subscription to a database and retention policy.
 func (data *Data) CreateSubscription(database, rp, name, mode string, destinations []string) error {
+	if mode != SubscriptionModeAll && mode != SubscriptionModeAny {
+		return ErrInvalidSubscriptionMode
+	}
+	if len(destinations) == 0 {
+		return ErrSubscriptionDestinationsRequired
+	}
 	for _, d := range destinations {
 		if err := validateURL(d); err != nil {
 			return err
@@ -1639,6 +1673,171 @@ func (data *Data) DropSubscription(database, rp, name string) error {
 	return ErrSubscriptionNotFound
 }
 
+// CreateContinuousQuery adds a named continuous query to a database.
+func (data *Data) CreateContinuousQuery(database, name, query string) error {
+	di, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the name doesn't already exist.
+	for _, cq := range di.ContinuousQueries {
+		if cq.Name == name {
+			// If the query string is the same, we'll silently return,
+			// otherwise we'll assume the user might be trying to
+			// overwrite an existing CQ with a different query.
+			if strings.EqualFold(cq.Query, query) {
+				return nil
+			}
+			return ErrContinuousQueryExists
+		}
+	}
+
+	// Append new query.
+	di.ContinuousQueries = append(di.ContinuousQueries, ContinuousQueryInfo{
+		Name:  name,
+		Query: query,
+	})
+
+	return nil
+}
+
+// DropContinuousQuery removes a continuous query.
+func (data *Data) DropContinuousQuery(database, name string) error {
+	di, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+
+	for i := range di.ContinuousQueries {
+		if di.ContinuousQueries[i].Name == name {
+			di.ContinuousQueries = append(di.ContinuousQueries[:i], di.ContinuousQueries[i+1:]...)
+			return nil
+		}
+	}
+	return ErrContinuousQueryNotFound
+}
+
+// AcquireContinuousQueryLease grants or renews the continuous query lease to owner at time now.
+// The lease can only be taken over by another owner after it is expired.
+func (data *Data) AcquireContinuousQueryLease(owner string, now time.Time, d time.Duration) error {
+	if owner == "" || d <= 0 {
+		return ErrInvalidContinuousQueryLease
+	}
+	if data.CQLease.Owner != owner && !data.CQLease.Expired(now) {
+		return ErrContinuousQueryLeaseConflict
+	}
+
+	data.CQLease.Owner = owner
+	data.CQLease.Expiration = now.Add(d)
+	return nil
+}
+
+// SetContinuousQueryLastRun records the end of the last window executed by the continuous query.
+// Only the lease owner is allowed to update it, and the last run time never goes backwards.
+func (data *Data) SetContinuousQueryLastRun(owner, database, name string, lastRun time.Time) error {
+	if data.CQLease.Owner != owner {
+		return ErrContinuousQueryLeaseConflict
+	}
+
+	di := data.Database(database)
+	if di == nil {
+		return nil
+	}
+	for i := range di.ContinuousQueries {
+		cq := &di.ContinuousQueries[i]
+		if cq.Name == name {
+			if lastRun.After(cq.LastRunTime) {
+				cq.LastRunTime = lastRun
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func (data *Data) ShowContinuousQueries() models.Rows {
+	var rows models.Rows
+	data.WalkDatabases(func(db *DatabaseInfo) {
+		if db.MarkDeleted {
+			return
+		}
+		row := &models.Row{Columns: []string{"name", "query"}, Name: db.Name}
+		for _, cq := range db.ContinuousQueries {
+			row.Values = append(row.Values, []interface{}{cq.Name, cq.Query})
+		}
+		rows = append(rows, row)
+	})
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Name < rows[j].Name
+	})
+	return rows
+}
+
+// CreateDownSamplePolicy attaches a downsample policy to a retention policy.
+// Creating the same policy again is a no-op.
+func (data *Data) CreateDownSamplePolicy(database, name string, policy *DownSamplePolicyInfo) error {
+	rpi, err := data.RetentionPolicy(database, name)
+	if err != nil {
+		return err
+	}
+
+	if rpi.DownSamplePolicy != nil {
+		if rpi.DownSamplePolicy.Equal(policy) {
+			return nil
+		}
+		return ErrDownSamplePolicyExists
+	}
+
+	if err = policy.Validate(); err != nil {
+		return err
+	}
+	if rpi.Duration > 0 && policy.Levels[len(policy.Levels)-1].SampleInterval >= rpi.Duration {
+		return ErrIncompatibleDownSampleDuration
+	}
+
+	rpi.DownSamplePolicy = policy.Clone()
+	return nil
+}
+
+// DropDownSamplePolicy removes the downsample policy of a retention policy.
+// The data which has been down sampled is kept.
+func (data *Data) DropDownSamplePolicy(database, name string) error {
+	rpi, err := data.RetentionPolicy(database, name)
+	if err != nil {
+		return err
+	}
+
+	if rpi.DownSamplePolicy == nil {
+		return ErrDownSamplePolicyNotFound
+	}
+	rpi.DownSamplePolicy = nil
+	return nil
+}
+
+// ShowDownSamplePolicies returns the downsample policies of all retention policies in the database.
+func (data *Data) ShowDownSamplePolicies(database string) (models.Rows, error) {
+	di, err := data.GetDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Columns: []string{"rpName", "field_operator", "sampleInterval", "timeInterval"}}
+	di.WalkRetentionPolicy(func(rpi *RetentionPolicyInfo) {
+		if rpi.MarkDeleted || rpi.DownSamplePolicy == nil {
+			return
+		}
+		p := rpi.DownSamplePolicy
+		row.Values = append(row.Values, []interface{}{rpi.Name, p.CallsString(), p.SampleIntervalsString(), p.TimeIntervalsString()})
+	})
+
+	sort.Slice(row.Values, func(i, j int) bool {
+		return row.Values[i][0].(string) < row.Values[j][0].(string)
+	})
+	return models.Rows{row}, nil
+}
+
 func (data *Data) GetUser(username string) *UserInfo {
 	for i := range data.Users {
 		if data.Users[i].Name == username {
@@ -1698,6 +1897,7 @@ func (data *Data) DropUser(name string) error {
 	for i := range data.Users {
 		if data.Users[i].Name == name {
 			data.Users = append(data.Users[:i], data.Users[i+1:]...)
+			data.removeRateLimit("", name)
 			return nil
 		}
 	}
@@ -1793,6 +1993,215 @@ func (data *Data) UserPrivilege(name, database string) (*originql.Privilege, err
 	return originql.NewPrivilege(originql.NoPrivileges), nil
 }
 
+// Role returns a role by name.
+func (data *Data) Role(name string) *RoleInfo {
+	for i := range data.Roles {
+		if data.Roles[i].Name == name {
+			return &data.Roles[i]
+		}
+	}
+	return nil
+}
+
+// CreateRole creates a new role without privileges.
+func (data *Data) CreateRole(name string) error {
+	if name == "" {
+		return ErrRoleNameRequired
+	} else if data.Role(name) != nil {
+		return ErrRoleExists
+	}
+	data.Roles = append(data.Roles, RoleInfo{Name: name})
+	return nil
+}
+
+// DropRole removes a role by name and revokes it from its users.
+func (data *Data) DropRole(name string) error {
+	for i := range data.Roles {
+		if data.Roles[i].Name != name {
+			continue
+		}
+		data.Roles = append(data.Roles[:i], data.Roles[i+1:]...)
+		for j := range data.Users {
+			data.Users[j].Roles = removeRole(data.Users[j].Roles, name)
+		}
+		data.resolveRoles()
+		return nil
+	}
+	return ErrRoleNotFound
+}
+
+// SetUserRole grants a role to a user, or revokes it.
+func (data *Data) SetUserRole(username, role string, revoke bool) error {
+	ui := data.GetUser(username)
+	if ui == nil {
+		return ErrUserNotFound
+	}
+	if data.Role(role) == nil {
+		return ErrRoleNotFound
+	}
+
+	ui.Roles = removeRole(ui.Roles, role)
+	if !revoke {
+		ui.Roles = append(ui.Roles, role)
+	}
+	data.resolveRoles()
+	return nil
+}
+
+// SetRolePrivilege grants a privilege to a role, or revokes it. Revoking all privileges removes every privilege
+// granted on the same scope.
+func (data *Data) SetRolePrivilege(role string, grant RoleGrant, revoke bool) error {
+	ri := data.Role(role)
+	if ri == nil {
+		return ErrRoleNotFound
+	}
+	if grant.Database != "" && !revoke {
+		if _, err := data.GetDatabase(grant.Database); err != nil {
+			return err
+		}
+	}
+	if grant.Regex != "" && grant.re == nil {
+		re, err := regexp.Compile(grant.Regex)
+		if err != nil {
+			return err
+		}
+		grant.re = re
+	}
+
+	found := false
+	grants := ri.Grants[:0]
+	for _, g := range ri.Grants {
+		if g.sameScope(&grant) && (g.Privilege == grant.Privilege || revoke && grant.Privilege == influxql.AllRolePrivileges) {
+			found = true
+			if revoke {
+				continue
+			}
+		}
+		grants = append(grants, g)
+	}
+	if !found {
+		if revoke {
+			return ErrRolePrivilegeNotFound
+		}
+		grants = append(grants, grant)
+	}
+	ri.Grants = grants
+	data.resolveRoles()
+	return nil
+}
+
+// ShowRoles returns the roles with their privileges and users.
+func (data *Data) ShowRoles() models.Rows {
+	row := &models.Row{Columns: []string{"name", "privilege", "on", "users"}}
+	for i := range data.Roles {
+		ri := &data.Roles[i]
+		var users []string
+		for j := range data.Users {
+			for _, r := range data.Users[j].Roles {
+				if r == ri.Name {
+					users = append(users, data.Users[j].Name)
+				}
+			}
+		}
+		sort.Strings(users)
+		if len(ri.Grants) == 0 {
+			row.Values = append(row.Values, []interface{}{ri.Name, "", "", users})
+		}
+		for j := range ri.Grants {
+			row.Values = append(row.Values, []interface{}{ri.Name, ri.Grants[j].Privilege.String(), ri.Grants[j].Scope().String(), users})
+		}
+	}
+	sort.SliceStable(row.Values, func(i, j int) bool {
+		return row.Values[i][0].(string) < row.Values[j][0].(string)
+	})
+	return models.Rows{row}
+}
+
+// SetRateLimit sets the rate limit of a database or of a user, replacing the previous one. A limit without any
+// rate removes it.
+func (data *Data) SetRateLimit(rl RateLimitInfo) error {
+	if (rl.Database == "") == (rl.User == "") {
+		return ErrRateLimitTargetRequired
+	}
+	if rl.PointsPerSecond < 0 || rl.BytesPerSecond < 0 || rl.QueriesPerSecond < 0 {
+		return ErrInvalidRateLimit
+	}
+	if rl.Database != "" {
+		if _, err := data.GetDatabase(rl.Database); err != nil {
+			return err
+		}
+	} else if data.GetUser(rl.User) == nil {
+		return ErrUserNotFound
+	}
+
+	data.removeRateLimit(rl.Database, rl.User)
+	if !rl.unlimited() {
+		data.RateLimits = append(data.RateLimits, rl)
+	}
+	return nil
+}
+
+func (data *Data) removeRateLimit(database, user string) {
+	for i := range data.RateLimits {
+		if data.RateLimits[i].Database == database && data.RateLimits[i].User == user {
+			data.RateLimits = append(data.RateLimits[:i], data.RateLimits[i+1:]...)
+			return
+		}
+	}
+}
+
+// CloneRateLimits returns a copy of the rate limits.
+func (data *Data) CloneRateLimits() []RateLimitInfo {
+	if len(data.RateLimits) == 0 {
+		return nil
+	}
+	limits := make([]RateLimitInfo, len(data.RateLimits))
+	copy(limits, data.RateLimits)
+	return limits
+}
+
+// CloneRoles returns a copy of the role infos.
+func (data *Data) CloneRoles() []RoleInfo {
+	if len(data.Roles) == 0 {
+		return nil
+	}
+	roles := make([]RoleInfo, len(data.Roles))
+	for i := range data.Roles {
+		roles[i] = data.Roles[i].clone()
+	}
+	return roles
+}
+
+// resolveRoles gathers the privileges of the roles of each user, so that they are authorized without the roles
+// at hand.
+func (data *Data) resolveRoles() {
+	for i := range data.Users {
+		u := &data.Users[i]
+		u.grants = nil
+		for _, name := range u.Roles {
+			ri := data.Role(name)
+			if ri == nil {
+				continue
+			}
+			for _, g := range ri.Grants {
+				if dbi := data.Databases[g.Database]; dbi != nil {
+					g.defaultRP = g.RetentionPolicy == dbi.DefaultRetentionPolicy
+				}
+				u.grants = append(u.grants, g)
+			}
+		}
+	}
+}
+
+func removeRole(roles []string, role string) []string {
+	for i := range roles {
+		if roles[i] == role {
+			return append(roles[:i:i], roles[i+1:]...)
+		}
+	}
+	return roles
+}
+
 // Clone returns a copy of data with a new version.
 func (data *Data) Clone() *Data {
 	other := *data
@@ -1803,6 +2212,8 @@ func (data *Data) Clone() *Data {
 
 	other.Databases = data.CloneDatabases()
 	other.Users = data.CloneUsers()
+	other.Roles = data.CloneRoles()
+	other.RateLimits = data.CloneRateLimits()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
 	return &other
@@ -1862,12 +2273,26 @@ func (data *Data) Marshal() *proto2.Data {
 		pb.Users[i] = data.Users[i].marshal()
 	}
 
+	pb.Roles = make([]*proto2.RoleInfo, len(data.Roles))
+	for i := range data.Roles {
+		pb.Roles[i] = data.Roles[i].marshal()
+	}
+
+	pb.RateLimits = make([]*proto2.RateLimitInfo, len(data.RateLimits))
+	for i := range data.RateLimits {
+		pb.RateLimits[i] = data.RateLimits[i].Marshal()
+	}
+
 	pb.MigrateEvents = make([]*proto2.MigrateEventInfo, len(data.MigrateEvents))
 	i = 0
 	for eventStr := range data.MigrateEvents {
 		pb.MigrateEvents[i] = data.MigrateEvents[eventStr].marshal()
 		i++
 	}
+
+	if data.CQLease.Owner != "" {
+		pb.CQLease = data.CQLease.marshal()
+	}
 	return pb
 }
 
@@ -1917,12 +2342,34 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		data.Users[i].unmarshal(x)
 	}
 
+	data.Roles = nil
+	if len(pb.GetRoles()) > 0 {
+		data.Roles = make([]RoleInfo, len(pb.GetRoles()))
+		for i, x := range pb.GetRoles() {
+			data.Roles[i].unmarshal(x)
+		}
+	}
+	data.resolveRoles()
+
+	data.RateLimits = nil
+	if len(pb.GetRateLimits()) > 0 {
+		data.RateLimits = make([]RateLimitInfo, len(pb.GetRateLimits()))
+		for i, x := range pb.GetRateLimits() {
+			data.RateLimits[i].Unmarshal(x)
+		}
+	}
+
 	data.MigrateEvents = make(map[string]*MigrateEventInfo, len(pb.GetMigrateEvents()))
 	for _, me := range pb.GetMigrateEvents() {
 		mei := &MigrateEventInfo{}
 		mei.unmarshal(me)
 		data.MigrateEvents[mei.eventId] = mei
 	}
+
+	data.CQLease = ContinuousQueryLease{}
+	if pb.GetCQLease() != nil {
+		data.CQLease.unmarshal(pb.GetCQLease())
+	}
 	// Exhaustively determine if there is an admin GetUser. The marshalled cache
 	// value may not be correct.
 	data.AdminUserExists = data.HasAdminUser()
@@ -2073,6 +2520,130 @@ func (data *Data) importOneDB(other Data, backupDBName, restoreDBName, backupRPN
 	return restoreDBName, nil
 }
 
+// RestoreDatabase imports the database backupDBName of the backed up meta data as restoreDBName, with the
+// retention policies renamed as rps maps. The ids of the shard groups, shards, index groups and indexes are
+// shifted past the ids in use, and the pts keep their ids but are owned by the data nodes that nodes maps the
+// old owners to; an unmapped owner must still be a data node. The new ids of the shards and indexes are returned,
+// the files of the backup are restored by them.
+func (data *Data) RestoreDatabase(other *Data, backupDBName, restoreDBName string, rps map[string]string,
+	nodes map[uint64]uint64) (map[uint64]uint64, map[uint64]uint64, error) {
+	dbi := other.Database(backupDBName)
+	if dbi == nil || dbi.MarkDeleted {
+		return nil, nil, errno.NewError(errno.DatabaseNotFound, backupDBName)
+	}
+	if err := data.CheckCanCreateDatabase(restoreDBName); err != nil {
+		return nil, nil, err
+	}
+	for name := range rps {
+		if rpi := dbi.RetentionPolicy(name); rpi == nil || rpi.MarkDeleted {
+			return nil, nil, ErrRetentionPolicyNotFound(name)
+		}
+	}
+
+	ptView := make(DBPtInfos, 0, len(other.PtView[backupDBName]))
+	for _, pt := range other.PtView[backupDBName] {
+		owner := pt.Owner.NodeID
+		if id, ok := nodes[owner]; ok {
+			owner = id
+		}
+		if data.DataNode(owner) == nil {
+			return nil, nil, errno.NewError(errno.DataNodeNotFound, owner)
+		}
+		status := Online
+		if data.CheckDataNodeAlive(owner) != nil {
+			status = Offline
+		}
+		ptView = append(ptView, PtInfo{Owner: PtOwner{NodeID: owner}, Status: status, PtId: pt.PtId})
+	}
+	if len(ptView) == 0 {
+		return nil, nil, fmt.Errorf("no pt of database %s in the backup", backupDBName)
+	}
+
+	// the continuous queries are not restored, as influxdb does
+	restored := dbi.clone()
+	restored.Name = restoreDBName
+	restored.ContinuousQueries = nil
+	policies := restored.RetentionPolicies
+	restored.RetentionPolicies = make(map[string]*RetentionPolicyInfo, len(policies))
+	if name, ok := rps[restored.DefaultRetentionPolicy]; ok {
+		restored.DefaultRetentionPolicy = name
+	}
+
+	sgBase, shardBase, igBase, indexBase := data.MaxShardGroupID, data.MaxShardID, data.MaxIndexGroupID, data.MaxIndexID
+	shiftShardKey := func(ski *ShardKeyInfo) {
+		if ski.ShardGroup > 0 {
+			ski.ShardGroup += sgBase
+		}
+	}
+	shiftShardKey(&restored.ShardKey)
+
+	shards, indexes := make(map[uint64]uint64), make(map[uint64]uint64)
+	for _, rpi := range policies {
+		if rpi.MarkDeleted {
+			continue
+		}
+		if name, ok := rps[rpi.Name]; ok {
+			rpi.Name = name
+		}
+		if restored.RetentionPolicies[rpi.Name] != nil {
+			return nil, nil, ErrRetentionPolicyExists
+		}
+		restored.RetentionPolicies[rpi.Name] = rpi
+
+		sgs := rpi.ShardGroups[:0]
+		for _, sg := range rpi.ShardGroups {
+			if sg.Deleted() {
+				continue
+			}
+			sg.ID += sgBase
+			for i := range sg.Shards {
+				sh := &sg.Shards[i]
+				shards[sh.ID] = sh.ID + shardBase
+				sh.ID += shardBase
+				sh.IndexID += indexBase
+			}
+			sgs = append(sgs, sg)
+		}
+		rpi.ShardGroups = sgs
+
+		igs := rpi.IndexGroups[:0]
+		for _, ig := range rpi.IndexGroups {
+			if !ig.DeletedAt.IsZero() {
+				continue
+			}
+			ig.ID += igBase
+			for i := range ig.Indexes {
+				idx := &ig.Indexes[i]
+				indexes[idx.ID] = idx.ID + indexBase
+				idx.ID += indexBase
+			}
+			igs = append(igs, ig)
+		}
+		rpi.IndexGroups = igs
+
+		for _, msti := range rpi.Measurements {
+			for i := range msti.ShardKeys {
+				shiftShardKey(&msti.ShardKeys[i])
+			}
+		}
+	}
+
+	data.MaxShardGroupID += other.MaxShardGroupID
+	data.MaxShardID += other.MaxShardID
+	data.MaxIndexGroupID += other.MaxIndexGroupID
+	data.MaxIndexID += other.MaxIndexID
+
+	if data.Databases == nil {
+		data.Databases = make(map[string]*DatabaseInfo)
+	}
+	data.Databases[restoreDBName] = restored
+	if data.PtView == nil {
+		data.PtView = make(map[string]DBPtInfos)
+	}
+	data.PtView[restoreDBName] = ptView
+	return shards, indexes, nil
+}
+
 func (data *Data) GetPtsByNodeId(nid uint64) []uint32 {
 	var ptIds []uint32
 	for db := range data.PtView {
@@ -2103,6 +2674,73 @@ func (data *Data) UpdateShardInfoTier(shardID uint64, shardTier uint64, dbName,
 	return fmt.Errorf("cannot find shard %d for rp %s on database %s", shardID, rpName, dbName)
 }
 
+// SetReplicaLag marks the replica of a shard on pt as missing writes, or clears the mark once the replica has been
+// re-synced with the writes up to seq. The mark is kept if the replica missed another write since.
+func (data *Data) SetReplicaLag(database, policy string, shardID uint64, pt uint32, lagging bool, seq uint64) error {
+	rpi, err := data.RetentionPolicy(database, policy)
+	if err != nil {
+		return err
+	}
+
+	for i := range rpi.ShardGroups {
+		for j := range rpi.ShardGroups[i].Shards {
+			sh := &rpi.ShardGroups[i].Shards[j]
+			if sh.ID != shardID {
+				continue
+			}
+			if !sh.OwnedBy(pt) {
+				return ErrPtNotShardOwner
+			}
+			for k := range sh.Lagging {
+				if sh.Lagging[k].PtID != pt {
+					continue
+				}
+				if lagging {
+					sh.Lagging[k].Seq = data.Index + 1
+				} else if sh.Lagging[k].Seq == seq {
+					sh.Lagging = append(sh.Lagging[:k], sh.Lagging[k+1:]...)
+				}
+				return nil
+			}
+			if lagging {
+				sh.Lagging = append(sh.Lagging, ReplicaLag{PtID: pt, Seq: data.Index + 1})
+			}
+			return nil
+		}
+	}
+	return errno.NewError(errno.ShardMetaNotFound, shardID)
+}
+
+// LaggingReplica is a replica of a shard which missed writes.
+type LaggingReplica struct {
+	Database string
+	Policy   string
+	ShardID  uint64
+	ReplicaLag
+}
+
+// LaggingReplicas returns the replicas of the shards which missed writes.
+func (data *Data) LaggingReplicas() []LaggingReplica {
+	var replicas []LaggingReplica
+	for i := range data.Databases {
+		for _, rpi := range data.Databases[i].RetentionPolicies {
+			for j := range rpi.ShardGroups {
+				if rpi.ShardGroups[j].Deleted() {
+					continue
+				}
+				for k := range rpi.ShardGroups[j].Shards {
+					sh := &rpi.ShardGroups[j].Shards[k]
+					for _, lag := range sh.Lagging {
+						replicas = append(replicas, LaggingReplica{Database: data.Databases[i].Name, Policy: rpi.Name,
+							ShardID: sh.ID, ReplicaLag: lag})
+					}
+				}
+			}
+		}
+	}
+	return replicas
+}
+
 func (data *Data) UpdateNodeStatus(id uint64, status int32, lTime uint64, gossipAddr string) error {
 	dn := data.DataNode(id)
 	if dn == nil {
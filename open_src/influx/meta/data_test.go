@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"runtime"
 	"sort"
 	"testing"
@@ -215,11 +216,11 @@ func Test_Data_ReSharding(t *testing.T) {
 	}
 
 	shardgroups, err := data.ShardGroups("foo", "bar")
-	shards1 := []ShardInfo{{1, []uint32{0}, "", "", Hot, 1}}
+	shards1 := []ShardInfo{{1, []uint32{0}, "", "", Hot, 1, nil}}
 	sg1 := ShardGroupInfo{1, sg0.StartTime, sg0.EndTime,
 		sg0.DeletedAt, shards1, sg0.TruncatedAt}
-	shards2 := []ShardInfo{{2, []uint32{0}, "", "cpu,hostname=host_5", Hot, 3},
-		{3, []uint32{1}, "cpu,hostname=host_5", "", Hot, 4}}
+	shards2 := []ShardInfo{{2, []uint32{0}, "", "cpu,hostname=host_5", Hot, 3, nil},
+		{3, []uint32{1}, "cpu,hostname=host_5", "", Hot, 4, nil}}
 	sg2 := ShardGroupInfo{2, time.Unix(0, splitTime.UnixNano()+1).UTC(), sg0.EndTime,
 		sg0.DeletedAt, shards2, sg0.TruncatedAt}
 	expSgs := []ShardGroupInfo{sg1, sg2}
@@ -671,6 +672,83 @@ func TestData_CreateShardGroup(t *testing.T) {
 	}
 }
 
+func TestData_CreateShardGroupWithReplicas(t *testing.T) {
+	data := initData()
+	data.CreateDataNode("127.0.0.3:8086", "127.0.0.3:8188")
+	data.CreateDataNode("127.0.0.4:8086", "127.0.0.4:8188")
+	dbName, rpName := "test", "rp2"
+	require.NoError(t, data.CreateDatabase(dbName, nil, nil))
+	rpi := &RetentionPolicyInfo{Name: rpName, ReplicaN: 2, ShardGroupDuration: time.Hour, IndexGroupDuration: time.Hour}
+	require.NoError(t, data.CreateRetentionPolicy(dbName, rpi, true))
+	require.NoError(t, data.CreateMeasurement(dbName, rpName, "foo", nil, nil))
+
+	insertTime := mustParseTime(time.RFC3339Nano, "2022-06-08T09:00:00Z")
+	require.NoError(t, data.CreateShardGroup(dbName, rpName, insertTime, Hot))
+	sg, err := data.ShardGroupByTimestamp(dbName, rpName, insertTime)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sg.Shards))
+	igs := data.Database(dbName).RetentionPolicy(rpName).IndexGroups
+	for i, sh := range sg.Shards {
+		require.Equal(t, []uint32{uint32(2 * i), uint32(2*i + 1)}, sh.Owners)
+		require.Equal(t, igs[0].Indexes[2*i].ID, sh.IndexID)
+		// the replicas are placed on different nodes
+		view := data.DBPtView(dbName)
+		require.NotEqual(t, view[sh.Owners[0]].Owner.NodeID, view[sh.Owners[1]].Owner.NodeID)
+	}
+
+	// each replica pt loads the shard
+	require.Contains(t, data.GetDurationInfos([]uint32{3}), sg.Shards[1].ID)
+	require.Equal(t, []uint64{sg.Shards[0].ID}, data.ShardsOfDBPT(dbName, 1)[rpName])
+
+	replicaN := 3
+	require.NoError(t, data.UpdateRetentionPolicy(dbName, rpName, &RetentionPolicyUpdate{ReplicaN: &replicaN}, false))
+	require.Equal(t, 3, data.Database(dbName).RetentionPolicy(rpName).ReplicaN)
+	replicaN = 0
+	require.Equal(t, ErrReplicationFactorTooLow,
+		data.UpdateRetentionPolicy(dbName, rpName, &RetentionPolicyUpdate{ReplicaN: &replicaN}, false))
+}
+
+func TestData_SetReplicaLag(t *testing.T) {
+	data := initData()
+	data.CreateDataNode("127.0.0.3:8086", "127.0.0.3:8188")
+	dbName, rpName := "test", "rp2"
+	require.NoError(t, data.CreateDatabase(dbName, nil, nil))
+	rpi := &RetentionPolicyInfo{Name: rpName, ReplicaN: 2, ShardGroupDuration: time.Hour, IndexGroupDuration: time.Hour}
+	require.NoError(t, data.CreateRetentionPolicy(dbName, rpi, true))
+	require.NoError(t, data.CreateMeasurement(dbName, rpName, "foo", nil, nil))
+	insertTime := mustParseTime(time.RFC3339Nano, "2022-06-08T09:00:00Z")
+	require.NoError(t, data.CreateShardGroup(dbName, rpName, insertTime, Hot))
+	sg, err := data.ShardGroupByTimestamp(dbName, rpName, insertTime)
+	require.NoError(t, err)
+	sh := sg.Shards[0]
+
+	data.Index = 10
+	require.NoError(t, data.SetReplicaLag(dbName, rpName, sh.ID, sh.Owners[1], true, 0))
+	replicas := data.LaggingReplicas()
+	require.Equal(t, []LaggingReplica{{Database: dbName, Policy: rpName, ShardID: sh.ID,
+		ReplicaLag: ReplicaLag{PtID: sh.Owners[1], Seq: 11}}}, replicas)
+	sg, _ = data.ShardGroupByTimestamp(dbName, rpName, insertTime)
+	require.True(t, sg.Shards[0].LaggingOwner(sh.Owners[1]))
+	require.False(t, sg.Shards[0].LaggingOwner(sh.Owners[0]))
+
+	// the replica misses another write while it is re-synced, the mark is kept
+	data.Index = 20
+	require.NoError(t, data.SetReplicaLag(dbName, rpName, sh.ID, sh.Owners[1], true, 0))
+	require.NoError(t, data.SetReplicaLag(dbName, rpName, sh.ID, sh.Owners[1], false, 11))
+	require.Equal(t, uint64(21), data.LaggingReplicas()[0].Seq)
+	require.NoError(t, data.SetReplicaLag(dbName, rpName, sh.ID, sh.Owners[1], false, 21))
+	require.Empty(t, data.LaggingReplicas())
+
+	// the mark is saved with the shard
+	require.NoError(t, data.SetReplicaLag(dbName, rpName, sh.ID, sh.Owners[0], true, 0))
+	other := &Data{}
+	other.Unmarshal(data.Marshal())
+	require.Equal(t, data.LaggingReplicas(), other.LaggingReplicas())
+
+	require.Equal(t, ErrPtNotShardOwner, data.SetReplicaLag(dbName, rpName, sh.ID, 100, true, 0))
+	require.Error(t, data.SetReplicaLag(dbName, rpName, 1000, sh.Owners[0], true, 0))
+}
+
 func TestDatabase_Clone(t *testing.T) {
 	data := initDataWithDataNode()
 	dbName := "testDb"
@@ -1166,6 +1244,131 @@ func TestData_CreateMigrateEvent(t *testing.T) {
 	assert2.Equal(t, uint64(1), data.MigrateEvents[dbPt1.String()].opId)
 }
 
+func TestData_ContinuousQuery(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("db0", nil, nil))
+
+	query := `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) INTO db0.autogen.mst1 FROM db0.autogen.mst GROUP BY time(1h) END`
+	require.NoError(t, data.CreateContinuousQuery("db0", "cq0", query))
+	require.NoError(t, data.CreateContinuousQuery("db0", "cq0", query))
+	require.EqualError(t, data.CreateContinuousQuery("db0", "cq0", query+" "), ErrContinuousQueryExists.Error())
+	require.Error(t, data.CreateContinuousQuery("db_not_exists", "cq0", query))
+
+	now := time.Unix(0, 0).UTC()
+	require.NoError(t, data.AcquireContinuousQueryLease("sql0", now, time.Minute))
+	require.EqualError(t, data.AcquireContinuousQueryLease("sql1", now.Add(time.Second), time.Minute), ErrContinuousQueryLeaseConflict.Error())
+	require.NoError(t, data.AcquireContinuousQueryLease("sql0", now.Add(time.Second), time.Minute))
+	require.EqualError(t, data.SetContinuousQueryLastRun("sql1", "db0", "cq0", now), ErrContinuousQueryLeaseConflict.Error())
+
+	lastRun := now.Add(time.Hour)
+	require.NoError(t, data.SetContinuousQueryLastRun("sql0", "db0", "cq0", lastRun))
+	require.NoError(t, data.SetContinuousQueryLastRun("sql0", "db0", "cq0", now))
+	assert2.Equal(t, lastRun, data.Database("db0").ContinuousQueries[0].LastRunTime)
+
+	// the lease is taken over after it is expired
+	require.NoError(t, data.AcquireContinuousQueryLease("sql1", now.Add(2*time.Minute), time.Minute))
+
+	other := &Data{}
+	other.Unmarshal(data.Clone().Marshal())
+	assert2.Equal(t, data.CQLease, other.CQLease)
+	assert2.Equal(t, data.Database("db0").ContinuousQueries, other.Database("db0").ContinuousQueries)
+
+	rows := other.ShowContinuousQueries()
+	assert2.Equal(t, 1, len(rows))
+	assert2.Equal(t, [][]interface{}{{"cq0", query}}, rows[0].Values)
+
+	require.NoError(t, data.DropContinuousQuery("db0", "cq0"))
+	require.EqualError(t, data.DropContinuousQuery("db0", "cq0"), ErrContinuousQueryNotFound.Error())
+}
+
+func TestData_DownSamplePolicy(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("db0", NewRetentionPolicyInfo("autogen"), nil))
+
+	policy := &DownSamplePolicyInfo{
+		Calls: []DownSampleCall{
+			{DataType: influxql.Float, Ops: []string{"mean", "max"}},
+			{DataType: influxql.Integer, Ops: []string{"sum"}},
+		},
+		Levels: []DownSampleLevel{
+			{SampleInterval: 24 * time.Hour, TimeInterval: time.Minute},
+			{SampleInterval: 7 * 24 * time.Hour, TimeInterval: 10 * time.Minute},
+		},
+	}
+	require.NoError(t, data.CreateDownSamplePolicy("db0", "", policy))
+	require.NoError(t, data.CreateDownSamplePolicy("db0", "autogen", policy.Clone()))
+
+	other := policy.Clone()
+	other.Calls[0].Ops = []string{"min"}
+	require.EqualError(t, data.CreateDownSamplePolicy("db0", "autogen", other), ErrDownSamplePolicyExists.Error())
+	require.Error(t, data.CreateDownSamplePolicy("db_not_exists", "autogen", policy))
+
+	assert2.Equal(t, 0, policy.Level(time.Hour))
+	assert2.Equal(t, 1, policy.Level(2*24*time.Hour))
+	assert2.Equal(t, 2, policy.Level(30*24*time.Hour))
+
+	restored := &Data{}
+	restored.Unmarshal(data.Clone().Marshal())
+	rpi, err := restored.RetentionPolicy("db0", "autogen")
+	require.NoError(t, err)
+	assert2.True(t, policy.Equal(rpi.DownSamplePolicy))
+
+	rows, err := restored.ShowDownSamplePolicies("db0")
+	require.NoError(t, err)
+	assert2.Equal(t, [][]interface{}{{"autogen", "float(mean,max),integer(sum)", "1d,1w", "1m,10m"}}, rows[0].Values)
+
+	require.NoError(t, data.DropDownSamplePolicy("db0", "autogen"))
+	require.EqualError(t, data.DropDownSamplePolicy("db0", "autogen"), ErrDownSamplePolicyNotFound.Error())
+}
+
+func TestData_Subscription(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("db0", NewRetentionPolicyInfo("autogen"), nil))
+
+	dests := []string{"http://127.0.0.1:9092", "udp://127.0.0.1:9093"}
+	require.NoError(t, data.CreateSubscription("db0", "autogen", "sub0", SubscriptionModeAll, dests))
+	require.EqualError(t, data.CreateSubscription("db0", "autogen", "sub0", SubscriptionModeAll, dests), ErrSubscriptionExists.Error())
+	require.EqualError(t, data.CreateSubscription("db0", "autogen", "sub1", "SOME", dests), ErrInvalidSubscriptionMode.Error())
+	require.EqualError(t, data.CreateSubscription("db0", "autogen", "sub1", SubscriptionModeAny, nil), ErrSubscriptionDestinationsRequired.Error())
+	require.Error(t, data.CreateSubscription("db0", "autogen", "sub1", SubscriptionModeAny, []string{"tcp://127.0.0.1:9092"}))
+	require.Error(t, data.CreateSubscription("db0", "autogen", "sub1", SubscriptionModeAny, []string{"http://127.0.0.1"}))
+	require.NoError(t, data.CreateSubscription("db0", "autogen", "sub1", SubscriptionModeAny, dests[:1]))
+
+	other := &Data{}
+	other.Unmarshal(data.Clone().Marshal())
+	rows := other.ShowSubscriptions()
+	require.Equal(t, 1, len(rows))
+	require.Equal(t, 2, len(rows[0].Values))
+	assert2.Equal(t, []interface{}{"autogen", "sub0", SubscriptionModeAll, dests}, rows[0].Values[0])
+
+	clone := data.Clone()
+	require.NoError(t, data.DropSubscription("db0", "autogen", "sub0"))
+	require.EqualError(t, data.DropSubscription("db0", "autogen", "sub0"), ErrSubscriptionNotFound.Error())
+	assert2.Equal(t, 1, len(data.ShowSubscriptions()[0].Values))
+	assert2.Equal(t, 2, len(clone.ShowSubscriptions()[0].Values))
+}
+
+func TestDownSamplePolicyInfo_Validate(t *testing.T) {
+	levels := []DownSampleLevel{{SampleInterval: time.Hour, TimeInterval: time.Minute}}
+	calls := []DownSampleCall{{DataType: influxql.Float, Ops: []string{"mean"}}}
+
+	assert2.NoError(t, (&DownSamplePolicyInfo{Calls: calls, Levels: levels}).Validate())
+	assert2.Error(t, (&DownSamplePolicyInfo{Levels: levels}).Validate())
+	assert2.Error(t, (&DownSamplePolicyInfo{Calls: calls}).Validate())
+	assert2.Error(t, (&DownSamplePolicyInfo{
+		Calls:  []DownSampleCall{{DataType: influxql.Integer, Ops: []string{"mean"}}},
+		Levels: levels,
+	}).Validate())
+	assert2.Error(t, (&DownSamplePolicyInfo{
+		Calls:  []DownSampleCall{{DataType: influxql.String, Ops: []string{"first"}}},
+		Levels: levels,
+	}).Validate())
+	assert2.Error(t, (&DownSamplePolicyInfo{
+		Calls:  calls,
+		Levels: append(levels, DownSampleLevel{SampleInterval: 2 * time.Hour, TimeInterval: 90 * time.Second}),
+	}).Validate())
+}
+
 func PrintMemUsage() {
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
@@ -1179,3 +1382,142 @@ func PrintMemUsage() {
 func bToMb(b uint64) float32 {
 	return float32(b) / 1024. / 1024.
 }
+
+func TestData_RestoreDatabase(t *testing.T) {
+	createShardGroup := func(data *Data, db, rp string) {
+		require.NoError(t, data.CreateDatabase(db, nil, nil))
+		rpi := &RetentionPolicyInfo{Name: rp, ReplicaN: 1, ShardGroupDuration: time.Hour, IndexGroupDuration: time.Hour}
+		require.NoError(t, data.CreateRetentionPolicy(db, rpi, true))
+		require.NoError(t, data.CreateMeasurement(db, rp, "cpu",
+			&proto2.ShardKeyInfo{ShardKey: []string{"hostname"}, Type: proto.String(influxql.RANGE)}, nil))
+		require.NoError(t, data.CreateShardGroup(db, rp, mustParseTime(time.RFC3339Nano, "2022-06-08T09:00:00Z"), Hot))
+	}
+	backup := initData()
+	createShardGroup(backup, "db0", "rp0")
+	data := initData()
+	createShardGroup(data, "db1", "rp1")
+
+	_, _, err := data.RestoreDatabase(backup, "db0", "db1", nil, nil)
+	require.EqualError(t, err, ErrDatabaseExists.Error())
+	_, _, err = data.RestoreDatabase(backup, "db0", "db2", map[string]string{"rp1": "rp2"}, nil)
+	require.EqualError(t, err, ErrRetentionPolicyNotFound("rp1").Error())
+	_, _, err = data.RestoreDatabase(backup, "db0", "db2", nil, map[uint64]uint64{1: 100})
+	require.Error(t, err)
+
+	sgBase, shardBase, igBase := data.MaxShardGroupID, data.MaxShardID, data.MaxIndexGroupID
+	shards, indexes, err := data.RestoreDatabase(backup, "db0", "db2", map[string]string{"rp0": "rp2"}, map[uint64]uint64{1: 2})
+	require.NoError(t, err)
+	dbi := data.Database("db2")
+	require.NotNil(t, dbi)
+	require.Equal(t, "rp2", dbi.DefaultRetentionPolicy)
+	rpi := dbi.RetentionPolicy("rp2")
+	require.NotNil(t, rpi)
+
+	old := backup.Database("db0").RetentionPolicy("rp0")
+	sg, oldSg := rpi.ShardGroups[0], old.ShardGroups[0]
+	require.Equal(t, oldSg.ID+sgBase, sg.ID)
+	require.NotEqual(t, 0, len(sg.Shards))
+	require.Equal(t, len(oldSg.Shards), len(sg.Shards))
+	for i := range sg.Shards {
+		require.Equal(t, oldSg.Shards[i].ID+shardBase, sg.Shards[i].ID)
+		require.Equal(t, sg.Shards[i].ID, shards[oldSg.Shards[i].ID])
+		require.Equal(t, oldSg.Shards[i].Owners, sg.Shards[i].Owners)
+		require.Equal(t, indexes[oldSg.Shards[i].IndexID], sg.Shards[i].IndexID)
+	}
+	require.Equal(t, old.IndexGroups[0].ID+igBase, rpi.IndexGroups[0].ID)
+	require.Equal(t, sgBase+backup.MaxShardGroupID, data.MaxShardGroupID)
+	require.Equal(t, shardBase+backup.MaxShardID, data.MaxShardID)
+
+	for _, pt := range data.PtView["db2"] {
+		require.Equal(t, uint64(2), pt.Owner.NodeID)
+	}
+	require.Equal(t, len(backup.PtView["db0"]), len(data.PtView["db2"]))
+	// the backup is not changed
+	require.Equal(t, "rp0", backup.Database("db0").DefaultRetentionPolicy)
+}
+
+func TestData_Roles(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("db0", NewRetentionPolicyInfo("autogen"), nil))
+	require.NoError(t, data.CreateUser("bob", "xxxxhashxxxx", false, false))
+	require.EqualError(t, data.CreateRole(""), ErrRoleNameRequired.Error())
+	require.NoError(t, data.CreateRole("reader"))
+	require.EqualError(t, data.CreateRole("reader"), ErrRoleExists.Error())
+	require.EqualError(t, data.SetUserRole("alice", "reader", false), ErrUserNotFound.Error())
+	require.EqualError(t, data.SetUserRole("bob", "writer", false), ErrRoleNotFound.Error())
+
+	cpu := NewRoleGrant(influxql.ReadRolePrivilege, &influxql.PrivilegeScope{Database: "db0", RetentionPolicy: "autogen", Measurement: "cpu"})
+	mem := NewRoleGrant(influxql.WriteRolePrivilege, &influxql.PrivilegeScope{Database: "db0",
+		Regex: &influxql.RegexLiteral{Val: regexp.MustCompile("^mem")}})
+	require.Error(t, data.SetRolePrivilege("reader", NewRoleGrant(influxql.ReadRolePrivilege, &influxql.PrivilegeScope{Database: "db1"}), false))
+	require.NoError(t, data.SetRolePrivilege("reader", cpu, false))
+	require.NoError(t, data.SetRolePrivilege("reader", mem, false))
+	require.NoError(t, data.SetUserRole("bob", "reader", false))
+
+	selectFrom := func(db, rp, name string) *influxql.Query {
+		return &influxql.Query{Statements: influxql.Statements{&influxql.SelectStatement{
+			Fields:  influxql.Fields{{Expr: &influxql.Wildcard{}}},
+			Sources: influxql.Sources{&influxql.Measurement{Database: db, RetentionPolicy: rp, Name: name}},
+		}}}
+	}
+	// the grants must survive a snapshot of the meta data
+	buf, err := data.Clone().MarshalBinary()
+	require.NoError(t, err)
+	other := &Data{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	for _, d := range []*Data{data, other} {
+		bob := d.GetUser("bob")
+		require.NoError(t, bob.AuthorizeQuery("db0", selectFrom("", "", "cpu")))
+		require.NoError(t, bob.AuthorizeQuery("", selectFrom("db0", "autogen", "cpu")))
+		require.Error(t, bob.AuthorizeQuery("db0", selectFrom("", "", "disk")))
+		require.Error(t, bob.AuthorizeQuery("db0", selectFrom("", "", "mem0")))
+
+		assert2.True(t, bob.AuthorizeMeasurement(influxql.WriteRolePrivilege, "db0", "", "mem0"))
+		assert2.False(t, bob.AuthorizeMeasurement(influxql.WriteRolePrivilege, "db0", "", "cpu"))
+		assert2.True(t, bob.HasRolePrivilege(influxql.WriteRolePrivilege, "db0"))
+		assert2.False(t, bob.HasRolePrivilege(influxql.DDLRolePrivilege, "db0"))
+	}
+	rows := other.ShowRoles()
+	require.Equal(t, 2, len(rows[0].Values))
+	assert2.Equal(t, []interface{}{"reader", "READ", "db0.autogen.cpu", []string{"bob"}}, rows[0].Values[0])
+	assert2.Equal(t, []interface{}{"reader", "WRITE", "db0.*./^mem/", []string{"bob"}}, rows[0].Values[1])
+
+	require.EqualError(t, data.SetRolePrivilege("reader", NewRoleGrant(influxql.ReadRolePrivilege, mem.Scope()), true),
+		ErrRolePrivilegeNotFound.Error())
+	require.NoError(t, data.SetRolePrivilege("reader", NewRoleGrant(influxql.AllRolePrivileges, mem.Scope()), true))
+	assert2.False(t, data.GetUser("bob").AuthorizeMeasurement(influxql.WriteRolePrivilege, "db0", "", "mem0"))
+
+	data.DropDatabase("db0")
+	assert2.Equal(t, 0, len(data.Role("reader").Grants))
+	require.NoError(t, data.DropRole("reader"))
+	require.EqualError(t, data.DropRole("reader"), ErrRoleNotFound.Error())
+	assert2.Equal(t, 0, len(data.GetUser("bob").Roles))
+}
+
+func TestData_RateLimits(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("db0", nil, nil))
+	require.NoError(t, data.CreateUser("bob", "xxxxhashxxxx", false, false))
+	require.EqualError(t, data.SetRateLimit(RateLimitInfo{PointsPerSecond: 1}), ErrRateLimitTargetRequired.Error())
+	require.EqualError(t, data.SetRateLimit(RateLimitInfo{Database: "db0", User: "bob"}), ErrRateLimitTargetRequired.Error())
+	require.EqualError(t, data.SetRateLimit(RateLimitInfo{Database: "db0", BytesPerSecond: -1}), ErrInvalidRateLimit.Error())
+	require.Error(t, data.SetRateLimit(RateLimitInfo{Database: "db1", PointsPerSecond: 1}))
+	require.EqualError(t, data.SetRateLimit(RateLimitInfo{User: "alice", QueriesPerSecond: 1}), ErrUserNotFound.Error())
+
+	require.NoError(t, data.SetRateLimit(RateLimitInfo{Database: "db0", PointsPerSecond: 100}))
+	require.NoError(t, data.SetRateLimit(RateLimitInfo{User: "bob", QueriesPerSecond: 10}))
+	require.NoError(t, data.SetRateLimit(RateLimitInfo{Database: "db0", PointsPerSecond: 200, BytesPerSecond: 1000}))
+	require.Equal(t, []RateLimitInfo{{User: "bob", QueriesPerSecond: 10}, {Database: "db0", PointsPerSecond: 200, BytesPerSecond: 1000}}, data.RateLimits)
+
+	buf, err := data.Clone().MarshalBinary()
+	require.NoError(t, err)
+	other := &Data{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, data.RateLimits, other.RateLimits)
+
+	// an unlimited rate removes the limit, as does dropping its target
+	require.NoError(t, data.SetRateLimit(RateLimitInfo{User: "bob"}))
+	require.Equal(t, 1, len(data.RateLimits))
+	data.DropDatabase("db0")
+	require.Equal(t, 0, len(data.RateLimits))
+}
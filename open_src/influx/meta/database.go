@@ -19,6 +19,7 @@ type DatabaseInfo struct {
 	Name                   string
 	DefaultRetentionPolicy string
 	RetentionPolicies      map[string]*RetentionPolicyInfo
+	ContinuousQueries      []ContinuousQueryInfo
 	MarkDeleted            bool
 	ShardKey               ShardKeyInfo
 }
@@ -99,6 +100,12 @@ func (di DatabaseInfo) clone() *DatabaseInfo {
 		}
 	}
 
+	// Copy continuous queries.
+	if di.ContinuousQueries != nil {
+		other.ContinuousQueries = make([]ContinuousQueryInfo, len(di.ContinuousQueries))
+		copy(other.ContinuousQueries, di.ContinuousQueries)
+	}
+
 	return &other
 }
 
@@ -115,6 +122,11 @@ func (di DatabaseInfo) marshal() *proto2.DatabaseInfo {
 		i++
 	}
 
+	pb.ContinuousQueries = make([]*proto2.ContinuousQueryInfo, len(di.ContinuousQueries))
+	for i := range di.ContinuousQueries {
+		pb.ContinuousQueries[i] = di.ContinuousQueries[i].marshal()
+	}
+
 	pb.MarkDeleted = proto.Bool(di.MarkDeleted)
 	if di.ShardKey.ShardKey != nil {
 		pb.ShardKey = di.ShardKey.Marshal()
@@ -137,6 +149,13 @@ func (di *DatabaseInfo) unmarshal(pb *proto2.DatabaseInfo) {
 		}
 	}
 
+	if len(pb.GetContinuousQueries()) > 0 {
+		di.ContinuousQueries = make([]ContinuousQueryInfo, len(pb.GetContinuousQueries()))
+		for i, x := range pb.GetContinuousQueries() {
+			di.ContinuousQueries[i].unmarshal(x)
+		}
+	}
+
 	di.MarkDeleted = pb.GetMarkDeleted()
 	if pb.ShardKey != nil {
 		di.ShardKey.unmarshal(pb.GetShardKey())
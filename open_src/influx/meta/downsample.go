@@ -0,0 +1,231 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+)
+
+// downSampleOps are the aggregations which can be kept for each data type.
+// The result of an aggregation over the down sampled windows must be the same
+// aggregation over the raw data, so that queries can be served by the down sampled data.
+// Mean is kept as the sum and the count of each window.
+var downSampleOps = map[influxql.DataType]map[string]bool{
+	influxql.Float:   {"mean": true, "sum": true, "min": true, "max": true, "first": true, "last": true},
+	influxql.Integer: {"sum": true, "min": true, "max": true, "first": true, "last": true},
+}
+
+// DownSampleCall is the aggregations kept for the fields of a data type.
+type DownSampleCall struct {
+	DataType influxql.DataType
+	Ops      []string
+}
+
+// DownSampleLevel aggregates the data older than SampleInterval into windows of TimeInterval.
+type DownSampleLevel struct {
+	SampleInterval time.Duration
+	TimeInterval   time.Duration
+}
+
+// DownSamplePolicyInfo represents how the data of a retention policy is down sampled as it ages.
+// Fields whose data type has no calls are dropped from the down sampled data.
+type DownSamplePolicyInfo struct {
+	Calls  []DownSampleCall
+	Levels []DownSampleLevel
+}
+
+// Validate returns an error if the calls or the levels of the policy are invalid.
+func (p *DownSamplePolicyInfo) Validate() error {
+	if len(p.Calls) == 0 {
+		return fmt.Errorf("downsample policy requires at least one call")
+	}
+	types := make(map[influxql.DataType]bool, len(p.Calls))
+	for _, c := range p.Calls {
+		ops, ok := downSampleOps[c.DataType]
+		if !ok {
+			return fmt.Errorf("downsample is not supported for %s fields", c.DataType)
+		}
+		if types[c.DataType] {
+			return fmt.Errorf("duplicate downsample calls for %s fields", c.DataType)
+		}
+		types[c.DataType] = true
+
+		if len(c.Ops) == 0 {
+			return fmt.Errorf("downsample policy requires at least one call for %s fields", c.DataType)
+		}
+		seen := make(map[string]bool, len(c.Ops))
+		for _, op := range c.Ops {
+			if !ops[op] {
+				return fmt.Errorf("downsample call %s is not supported for %s fields", op, c.DataType)
+			}
+			if seen[op] {
+				return fmt.Errorf("duplicate downsample call %s for %s fields", op, c.DataType)
+			}
+			seen[op] = true
+		}
+	}
+
+	if len(p.Levels) == 0 {
+		return fmt.Errorf("downsample policy requires at least one level")
+	}
+	for i, lv := range p.Levels {
+		if lv.SampleInterval <= 0 || lv.TimeInterval <= 0 {
+			return fmt.Errorf("downsample sample interval and time interval must be greater than 0")
+		}
+		if i == 0 {
+			continue
+		}
+		prev := p.Levels[i-1]
+		if lv.SampleInterval <= prev.SampleInterval {
+			return fmt.Errorf("downsample sample intervals must be increasing")
+		}
+		if lv.TimeInterval <= prev.TimeInterval || lv.TimeInterval%prev.TimeInterval != 0 {
+			return fmt.Errorf("downsample time interval %s must be a multiple of the previous time interval %s",
+				influxql.FormatDuration(lv.TimeInterval), influxql.FormatDuration(prev.TimeInterval))
+		}
+	}
+	return nil
+}
+
+// Ops returns the aggregations kept for the fields of typ.
+func (p *DownSamplePolicyInfo) Ops(typ influxql.DataType) []string {
+	for _, c := range p.Calls {
+		if c.DataType == typ {
+			return c.Ops
+		}
+	}
+	return nil
+}
+
+// Level returns the number of levels whose sample interval has passed for data of the given age,
+// 0 means the data is kept as it is.
+func (p *DownSamplePolicyInfo) Level(age time.Duration) int {
+	n := 0
+	for _, lv := range p.Levels {
+		if age < lv.SampleInterval {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// Equal reports whether p and other have the same calls and levels.
+func (p *DownSamplePolicyInfo) Equal(other *DownSamplePolicyInfo) bool {
+	return other != nil && p.String() == other.String()
+}
+
+// CallsString formats the calls as float(mean,max),integer(max).
+func (p *DownSamplePolicyInfo) CallsString() string {
+	calls := make([]string, 0, len(p.Calls))
+	for _, c := range p.Calls {
+		calls = append(calls, fmt.Sprintf("%s(%s)", c.DataType, strings.Join(c.Ops, ",")))
+	}
+	return strings.Join(calls, ",")
+}
+
+// SampleIntervalsString formats the sample intervals of all levels as a comma separated list.
+func (p *DownSamplePolicyInfo) SampleIntervalsString() string {
+	intervals := make([]string, 0, len(p.Levels))
+	for _, lv := range p.Levels {
+		intervals = append(intervals, influxql.FormatDuration(lv.SampleInterval))
+	}
+	return strings.Join(intervals, ",")
+}
+
+// TimeIntervalsString formats the time intervals of all levels as a comma separated list.
+func (p *DownSamplePolicyInfo) TimeIntervalsString() string {
+	intervals := make([]string, 0, len(p.Levels))
+	for _, lv := range p.Levels {
+		intervals = append(intervals, influxql.FormatDuration(lv.TimeInterval))
+	}
+	return strings.Join(intervals, ",")
+}
+
+func (p *DownSamplePolicyInfo) String() string {
+	return fmt.Sprintf("(%s) WITH SAMPLEINTERVAL(%s) TIMEINTERVAL(%s)",
+		p.CallsString(), p.SampleIntervalsString(), p.TimeIntervalsString())
+}
+
+// Clone returns a deep copy of p.
+func (p *DownSamplePolicyInfo) Clone() *DownSamplePolicyInfo {
+	if p == nil {
+		return nil
+	}
+	other := &DownSamplePolicyInfo{
+		Calls:  make([]DownSampleCall, len(p.Calls)),
+		Levels: make([]DownSampleLevel, len(p.Levels)),
+	}
+	for i, c := range p.Calls {
+		other.Calls[i] = DownSampleCall{DataType: c.DataType, Ops: append([]string(nil), c.Ops...)}
+	}
+	copy(other.Levels, p.Levels)
+	return other
+}
+
+// Marshal serializes to a protobuf representation.
+func (p *DownSamplePolicyInfo) Marshal() *proto2.DownSamplePolicyInfo {
+	pb := &proto2.DownSamplePolicyInfo{
+		Calls:  make([]*proto2.DownSampleCall, len(p.Calls)),
+		Levels: make([]*proto2.DownSampleLevel, len(p.Levels)),
+	}
+	for i, c := range p.Calls {
+		pb.Calls[i] = &proto2.DownSampleCall{
+			DataType: proto.Int64(int64(c.DataType)),
+			Ops:      c.Ops,
+		}
+	}
+	for i, lv := range p.Levels {
+		pb.Levels[i] = &proto2.DownSampleLevel{
+			SampleInterval: proto.Int64(int64(lv.SampleInterval)),
+			TimeInterval:   proto.Int64(int64(lv.TimeInterval)),
+		}
+	}
+	return pb
+}
+
+// Unmarshal deserializes from a protobuf representation.
+func (p *DownSamplePolicyInfo) Unmarshal(pb *proto2.DownSamplePolicyInfo) {
+	p.Calls = make([]DownSampleCall, len(pb.GetCalls()))
+	for i, c := range pb.GetCalls() {
+		p.Calls[i] = DownSampleCall{
+			DataType: influxql.DataType(c.GetDataType()),
+			Ops:      c.GetOps(),
+		}
+	}
+	p.Levels = make([]DownSampleLevel, len(pb.GetLevels()))
+	for i, lv := range pb.GetLevels() {
+		p.Levels[i] = DownSampleLevel{
+			SampleInterval: time.Duration(lv.GetSampleInterval()),
+			TimeInterval:   time.Duration(lv.GetTimeInterval()),
+		}
+	}
+}
+
+// ShardDownSampleInfo is the down sample state of a shard reported by the engine.
+type ShardDownSampleInfo struct {
+	Ident   ShardIdentifier
+	EndTime time.Time
+	// Level is the level the shard has been down sampled to, 0 if the shard keeps the raw data.
+	Level int
+}
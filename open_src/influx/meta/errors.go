@@ -137,6 +137,9 @@ var (
 	// the last copy of a shard present and the force keyword was not used
 	ErrShardNotReplicated = errors.New("shard not replicated")
 
+	// ErrPtNotShardOwner is returned when marking the replica of a shard on a pt which does not own the shard.
+	ErrPtNotShardOwner = errors.New("pt does not own the shard")
+
 	ErrIndexGroupNotFound = errors.New("index group not found")
 
 	ErrMeasurementNotFound = errno.NewError(errno.ErrMeasurementNotFound)
@@ -151,6 +154,23 @@ var (
 
 	// ErrSameContinuosQueryName is returned when creating an already existing continuous query name.
 	ErrSameContinuosQueryName = errors.New("continuous query name already exists")
+
+	// ErrContinuousQueryLeaseConflict is returned when the continuous query lease is held by another ts-sql.
+	ErrContinuousQueryLeaseConflict = errors.New("continuous query lease is held by another node")
+
+	// ErrInvalidContinuousQueryLease is returned when acquiring a lease without owner or duration.
+	ErrInvalidContinuousQueryLease = errors.New("invalid continuous query lease")
+)
+
+var (
+	// ErrDownSamplePolicyExists is returned when creating a downsample policy on a retention policy which already has one.
+	ErrDownSamplePolicyExists = errors.New("downsample policy already exists")
+
+	// ErrDownSamplePolicyNotFound is returned when dropping a downsample policy that doesn't exist.
+	ErrDownSamplePolicyNotFound = errors.New("downsample policy not found")
+
+	// ErrIncompatibleDownSampleDuration is returned when the data would expire before it is down sampled.
+	ErrIncompatibleDownSampleDuration = errors.New("downsample sample interval must be lower than the retention policy duration")
 )
 
 var (
@@ -159,6 +179,12 @@ var (
 
 	// ErrSubscriptionNotFound is returned when removing a subscription that doesn't exist.
 	ErrSubscriptionNotFound = errors.New("subscription not found")
+
+	// ErrInvalidSubscriptionMode is returned when the subscription mode is neither ALL nor ANY.
+	ErrInvalidSubscriptionMode = errors.New("subscription mode must be ALL or ANY")
+
+	// ErrSubscriptionDestinationsRequired is returned when creating a subscription without any destination.
+	ErrSubscriptionDestinationsRequired = errors.New("subscription requires at least one destination")
 )
 
 // ErrInvalidSubscriptionURL is returned when the subscription's destination URL is invalid.
@@ -166,6 +192,28 @@ func ErrInvalidSubscriptionURL(url string) error {
 	return fmt.Errorf("invalid subscription URL: %s", url)
 }
 
+var (
+	// ErrRoleExists is returned when creating an already existing role.
+	ErrRoleExists = errors.New("role already exists")
+
+	// ErrRoleNotFound is returned when mutating a role that doesn't exist.
+	ErrRoleNotFound = errors.New("role not found")
+
+	// ErrRoleNameRequired is returned when creating a role without a name.
+	ErrRoleNameRequired = errors.New("role name required")
+
+	// ErrRolePrivilegeNotFound is returned when revoking a privilege that is not granted to the role.
+	ErrRolePrivilegeNotFound = errors.New("privilege not granted to the role")
+)
+
+var (
+	// ErrRateLimitTargetRequired is returned when setting a rate limit without either a database or a user.
+	ErrRateLimitTargetRequired = errors.New("rate limit requires either a database or a user")
+
+	// ErrInvalidRateLimit is returned when setting a negative rate limit.
+	ErrInvalidRateLimit = errors.New("rate limit can not be negative")
+)
+
 var (
 	// ErrUserExists is returned when creating an already existing GetUser.
 	ErrUserExists = errors.New("user already exists")
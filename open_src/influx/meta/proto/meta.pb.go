@@ -38,6 +38,8 @@ const (
 	Command_SetPrivilegeCommand              Command_Type = 16
 	Command_SetDataCommand                   Command_Type = 17
 	Command_SetAdminPrivilegeCommand         Command_Type = 18
+	Command_CreateContinuousQueryCommand     Command_Type = 19
+	Command_DropContinuousQueryCommand       Command_Type = 20
 	Command_CreateSubscriptionCommand        Command_Type = 21
 	Command_DropSubscriptionCommand          Command_Type = 22
 	Command_CreateMetaNodeCommand            Command_Type = 24
@@ -67,6 +69,16 @@ const (
 	Command_UpdateEventCommand               Command_Type = 66
 	Command_UpdatePtInfoCommand              Command_Type = 67
 	Command_RemoveEventCommand               Command_Type = 68
+	Command_ContinuousQueryLeaseCommand      Command_Type = 69
+	Command_ContinuousQueryReportCommand     Command_Type = 70
+	Command_CreateDownSamplePolicyCommand    Command_Type = 71
+	Command_DropDownSamplePolicyCommand      Command_Type = 72
+	Command_CreateRoleCommand                Command_Type = 73
+	Command_DropRoleCommand                  Command_Type = 74
+	Command_SetUserRoleCommand               Command_Type = 75
+	Command_SetRolePrivilegeCommand          Command_Type = 76
+	Command_SetRateLimitCommand              Command_Type = 77
+	Command_ReplicaLagCommand                Command_Type = 78
 )
 
 var Command_Type_name = map[int32]string{
@@ -84,6 +96,8 @@ var Command_Type_name = map[int32]string{
 	16: "SetPrivilegeCommand",
 	17: "SetDataCommand",
 	18: "SetAdminPrivilegeCommand",
+	19: "CreateContinuousQueryCommand",
+	20: "DropContinuousQueryCommand",
 	21: "CreateSubscriptionCommand",
 	22: "DropSubscriptionCommand",
 	24: "CreateMetaNodeCommand",
@@ -113,6 +127,16 @@ var Command_Type_name = map[int32]string{
 	66: "UpdateEventCommand",
 	67: "UpdatePtInfoCommand",
 	68: "RemoveEventCommand",
+	69: "ContinuousQueryLeaseCommand",
+	70: "ContinuousQueryReportCommand",
+	71: "CreateDownSamplePolicyCommand",
+	72: "DropDownSamplePolicyCommand",
+	73: "CreateRoleCommand",
+	74: "DropRoleCommand",
+	75: "SetUserRoleCommand",
+	76: "SetRolePrivilegeCommand",
+	77: "SetRateLimitCommand",
+	78: "ReplicaLagCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -130,6 +154,8 @@ var Command_Type_value = map[string]int32{
 	"SetPrivilegeCommand":              16,
 	"SetDataCommand":                   17,
 	"SetAdminPrivilegeCommand":         18,
+	"CreateContinuousQueryCommand":     19,
+	"DropContinuousQueryCommand":       20,
 	"CreateSubscriptionCommand":        21,
 	"DropSubscriptionCommand":          22,
 	"CreateMetaNodeCommand":            24,
@@ -159,6 +185,16 @@ var Command_Type_value = map[string]int32{
 	"UpdateEventCommand":               66,
 	"UpdatePtInfoCommand":              67,
 	"RemoveEventCommand":               68,
+	"ContinuousQueryLeaseCommand":      69,
+	"ContinuousQueryReportCommand":     70,
+	"CreateDownSamplePolicyCommand":    71,
+	"DropDownSamplePolicyCommand":      72,
+	"CreateRoleCommand":                73,
+	"DropRoleCommand":                  74,
+	"SetUserRoleCommand":               75,
+	"SetRolePrivilegeCommand":          76,
+	"SetRateLimitCommand":              77,
+	"ReplicaLagCommand":                78,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -181,32 +217,35 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (Command_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{19, 0}
+	return fileDescriptor_4aed0c02de55ead8, []int{28, 0}
 }
 
 type Data struct {
-	Term                 *uint64              `protobuf:"varint,1,req,name=Term" json:"Term,omitempty"`
-	Index                *uint64              `protobuf:"varint,2,req,name=Index" json:"Index,omitempty"`
-	ClusterID            *uint64              `protobuf:"varint,3,req,name=ClusterID" json:"ClusterID,omitempty"`
-	Nodes                []*NodeInfo          `protobuf:"bytes,4,rep,name=Nodes" json:"Nodes,omitempty"`
-	Databases            []*DatabaseInfo      `protobuf:"bytes,5,rep,name=Databases" json:"Databases,omitempty"`
-	Users                []*UserInfo          `protobuf:"bytes,6,rep,name=Users" json:"Users,omitempty"`
-	MaxNodeID            *uint64              `protobuf:"varint,7,req,name=MaxNodeID" json:"MaxNodeID,omitempty"`
-	MaxShardGroupID      *uint64              `protobuf:"varint,8,req,name=MaxShardGroupID" json:"MaxShardGroupID,omitempty"`
-	MaxShardID           *uint64              `protobuf:"varint,9,req,name=MaxShardID" json:"MaxShardID,omitempty"`
-	DataNodes            []*DataNode          `protobuf:"bytes,10,rep,name=DataNodes" json:"DataNodes,omitempty"`
-	MetaNodes            []*NodeInfo          `protobuf:"bytes,11,rep,name=MetaNodes" json:"MetaNodes,omitempty"`
-	ClusterPtNum         *uint32              `protobuf:"varint,14,req,name=ClusterPtNum" json:"ClusterPtNum,omitempty"`
-	PtView               map[string]*DBPtInfo `protobuf:"bytes,15,rep,name=PtView" json:"PtView,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	PtNumPerNode         *uint32              `protobuf:"varint,16,opt,name=PtNumPerNode" json:"PtNumPerNode,omitempty"`
-	MaxIndexGroupID      *uint64              `protobuf:"varint,17,req,name=MaxIndexGroupID" json:"MaxIndexGroupID,omitempty"`
-	MaxIndexID           *uint64              `protobuf:"varint,18,req,name=MaxIndexID" json:"MaxIndexID,omitempty"`
-	MaxEventOpId         *uint64              `protobuf:"varint,19,opt,name=MaxEventOpId" json:"MaxEventOpId,omitempty"`
-	TakeOverEnabled      *bool                `protobuf:"varint,20,opt,name=TakeOverEnabled" json:"TakeOverEnabled,omitempty"`
-	MigrateEvents        []*MigrateEventInfo  `protobuf:"bytes,21,rep,name=MigrateEvents" json:"MigrateEvents,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Term                 *uint64               `protobuf:"varint,1,req,name=Term" json:"Term,omitempty"`
+	Index                *uint64               `protobuf:"varint,2,req,name=Index" json:"Index,omitempty"`
+	ClusterID            *uint64               `protobuf:"varint,3,req,name=ClusterID" json:"ClusterID,omitempty"`
+	Nodes                []*NodeInfo           `protobuf:"bytes,4,rep,name=Nodes" json:"Nodes,omitempty"`
+	Databases            []*DatabaseInfo       `protobuf:"bytes,5,rep,name=Databases" json:"Databases,omitempty"`
+	Users                []*UserInfo           `protobuf:"bytes,6,rep,name=Users" json:"Users,omitempty"`
+	MaxNodeID            *uint64               `protobuf:"varint,7,req,name=MaxNodeID" json:"MaxNodeID,omitempty"`
+	MaxShardGroupID      *uint64               `protobuf:"varint,8,req,name=MaxShardGroupID" json:"MaxShardGroupID,omitempty"`
+	MaxShardID           *uint64               `protobuf:"varint,9,req,name=MaxShardID" json:"MaxShardID,omitempty"`
+	DataNodes            []*DataNode           `protobuf:"bytes,10,rep,name=DataNodes" json:"DataNodes,omitempty"`
+	MetaNodes            []*NodeInfo           `protobuf:"bytes,11,rep,name=MetaNodes" json:"MetaNodes,omitempty"`
+	ClusterPtNum         *uint32               `protobuf:"varint,14,req,name=ClusterPtNum" json:"ClusterPtNum,omitempty"`
+	PtView               map[string]*DBPtInfo  `protobuf:"bytes,15,rep,name=PtView" json:"PtView,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PtNumPerNode         *uint32               `protobuf:"varint,16,opt,name=PtNumPerNode" json:"PtNumPerNode,omitempty"`
+	MaxIndexGroupID      *uint64               `protobuf:"varint,17,req,name=MaxIndexGroupID" json:"MaxIndexGroupID,omitempty"`
+	MaxIndexID           *uint64               `protobuf:"varint,18,req,name=MaxIndexID" json:"MaxIndexID,omitempty"`
+	MaxEventOpId         *uint64               `protobuf:"varint,19,opt,name=MaxEventOpId" json:"MaxEventOpId,omitempty"`
+	TakeOverEnabled      *bool                 `protobuf:"varint,20,opt,name=TakeOverEnabled" json:"TakeOverEnabled,omitempty"`
+	MigrateEvents        []*MigrateEventInfo   `protobuf:"bytes,21,rep,name=MigrateEvents" json:"MigrateEvents,omitempty"`
+	CQLease              *ContinuousQueryLease `protobuf:"bytes,22,opt,name=CQLease" json:"CQLease,omitempty"`
+	Roles                []*RoleInfo           `protobuf:"bytes,23,rep,name=Roles" json:"Roles,omitempty"`
+	RateLimits           []*RateLimitInfo      `protobuf:"bytes,24,rep,name=RateLimits" json:"RateLimits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -366,6 +405,27 @@ func (m *Data) GetMigrateEvents() []*MigrateEventInfo {
 	return nil
 }
 
+func (m *Data) GetCQLease() *ContinuousQueryLease {
+	if m != nil {
+		return m.CQLease
+	}
+	return nil
+}
+
+func (m *Data) GetRoles() []*RoleInfo {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *Data) GetRateLimits() []*RateLimitInfo {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 type PtOwner struct {
 	NodeID               *uint64  `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -624,6 +684,7 @@ type DatabaseInfo struct {
 	Name                   *string                `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	DefaultRetentionPolicy *string                `protobuf:"bytes,2,req,name=DefaultRetentionPolicy" json:"DefaultRetentionPolicy,omitempty"`
 	RetentionPolicies      []*RetentionPolicyInfo `protobuf:"bytes,3,rep,name=RetentionPolicies" json:"RetentionPolicies,omitempty"`
+	ContinuousQueries      []*ContinuousQueryInfo `protobuf:"bytes,4,rep,name=ContinuousQueries" json:"ContinuousQueries,omitempty"`
 	MarkDeleted            *bool                  `protobuf:"varint,5,opt,name=MarkDeleted" json:"MarkDeleted,omitempty"`
 	ShardKey               *ShardKeyInfo          `protobuf:"bytes,6,opt,name=ShardKey" json:"ShardKey,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
@@ -676,6 +737,13 @@ func (m *DatabaseInfo) GetRetentionPolicies() []*RetentionPolicyInfo {
 	return nil
 }
 
+func (m *DatabaseInfo) GetContinuousQueries() []*ContinuousQueryInfo {
+	if m != nil {
+		return m.ContinuousQueries
+	}
+	return nil
+}
+
 func (m *DatabaseInfo) GetMarkDeleted() bool {
 	if m != nil && m.MarkDeleted != nil {
 		return *m.MarkDeleted
@@ -823,21 +891,22 @@ func (m *MeasurementInfo) GetIndexRelations() []*IndexRelation {
 }
 
 type RetentionPolicyInfo struct {
-	Name                 *string             `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Duration             *int64              `protobuf:"varint,2,req,name=Duration" json:"Duration,omitempty"`
-	ShardGroupDuration   *int64              `protobuf:"varint,3,req,name=ShardGroupDuration" json:"ShardGroupDuration,omitempty"`
-	ReplicaN             *uint32             `protobuf:"varint,4,req,name=ReplicaN" json:"ReplicaN,omitempty"`
-	Measurements         []*MeasurementInfo  `protobuf:"bytes,5,rep,name=Measurements" json:"Measurements,omitempty"`
-	ShardGroups          []*ShardGroupInfo   `protobuf:"bytes,6,rep,name=ShardGroups" json:"ShardGroups,omitempty"`
-	Subscriptions        []*SubscriptionInfo `protobuf:"bytes,7,rep,name=Subscriptions" json:"Subscriptions,omitempty"`
-	MarkDeleted          *bool               `protobuf:"varint,8,opt,name=MarkDeleted" json:"MarkDeleted,omitempty"`
-	HotDuration          *int64              `protobuf:"varint,9,req,name=HotDuration" json:"HotDuration,omitempty"`
-	WarmDuration         *int64              `protobuf:"varint,10,req,name=WarmDuration" json:"WarmDuration,omitempty"`
-	IndexGroupDuration   *int64              `protobuf:"varint,11,req,name=IndexGroupDuration" json:"IndexGroupDuration,omitempty"`
-	IndexGroups          []*IndexGroupInfo   `protobuf:"bytes,12,rep,name=IndexGroups" json:"IndexGroups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Name                 *string               `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Duration             *int64                `protobuf:"varint,2,req,name=Duration" json:"Duration,omitempty"`
+	ShardGroupDuration   *int64                `protobuf:"varint,3,req,name=ShardGroupDuration" json:"ShardGroupDuration,omitempty"`
+	ReplicaN             *uint32               `protobuf:"varint,4,req,name=ReplicaN" json:"ReplicaN,omitempty"`
+	Measurements         []*MeasurementInfo    `protobuf:"bytes,5,rep,name=Measurements" json:"Measurements,omitempty"`
+	ShardGroups          []*ShardGroupInfo     `protobuf:"bytes,6,rep,name=ShardGroups" json:"ShardGroups,omitempty"`
+	Subscriptions        []*SubscriptionInfo   `protobuf:"bytes,7,rep,name=Subscriptions" json:"Subscriptions,omitempty"`
+	MarkDeleted          *bool                 `protobuf:"varint,8,opt,name=MarkDeleted" json:"MarkDeleted,omitempty"`
+	HotDuration          *int64                `protobuf:"varint,9,req,name=HotDuration" json:"HotDuration,omitempty"`
+	WarmDuration         *int64                `protobuf:"varint,10,req,name=WarmDuration" json:"WarmDuration,omitempty"`
+	IndexGroupDuration   *int64                `protobuf:"varint,11,req,name=IndexGroupDuration" json:"IndexGroupDuration,omitempty"`
+	IndexGroups          []*IndexGroupInfo     `protobuf:"bytes,12,rep,name=IndexGroups" json:"IndexGroups,omitempty"`
+	DownSamplePolicy     *DownSamplePolicyInfo `protobuf:"bytes,13,opt,name=DownSamplePolicy" json:"DownSamplePolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *RetentionPolicyInfo) Reset()         { *m = RetentionPolicyInfo{} }
@@ -948,6 +1017,151 @@ func (m *RetentionPolicyInfo) GetIndexGroups() []*IndexGroupInfo {
 	return nil
 }
 
+func (m *RetentionPolicyInfo) GetDownSamplePolicy() *DownSamplePolicyInfo {
+	if m != nil {
+		return m.DownSamplePolicy
+	}
+	return nil
+}
+
+type DownSampleCall struct {
+	DataType             *int64   `protobuf:"varint,1,req,name=DataType" json:"DataType,omitempty"`
+	Ops                  []string `protobuf:"bytes,2,rep,name=Ops" json:"Ops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownSampleCall) Reset()         { *m = DownSampleCall{} }
+func (m *DownSampleCall) String() string { return proto.CompactTextString(m) }
+func (*DownSampleCall) ProtoMessage()    {}
+func (*DownSampleCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{10}
+}
+func (m *DownSampleCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSampleCall.Unmarshal(m, b)
+}
+func (m *DownSampleCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSampleCall.Marshal(b, m, deterministic)
+}
+func (m *DownSampleCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSampleCall.Merge(m, src)
+}
+func (m *DownSampleCall) XXX_Size() int {
+	return xxx_messageInfo_DownSampleCall.Size(m)
+}
+func (m *DownSampleCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSampleCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownSampleCall proto.InternalMessageInfo
+
+func (m *DownSampleCall) GetDataType() int64 {
+	if m != nil && m.DataType != nil {
+		return *m.DataType
+	}
+	return 0
+}
+
+func (m *DownSampleCall) GetOps() []string {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+type DownSampleLevel struct {
+	SampleInterval       *int64   `protobuf:"varint,1,req,name=SampleInterval" json:"SampleInterval,omitempty"`
+	TimeInterval         *int64   `protobuf:"varint,2,req,name=TimeInterval" json:"TimeInterval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownSampleLevel) Reset()         { *m = DownSampleLevel{} }
+func (m *DownSampleLevel) String() string { return proto.CompactTextString(m) }
+func (*DownSampleLevel) ProtoMessage()    {}
+func (*DownSampleLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{11}
+}
+func (m *DownSampleLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSampleLevel.Unmarshal(m, b)
+}
+func (m *DownSampleLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSampleLevel.Marshal(b, m, deterministic)
+}
+func (m *DownSampleLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSampleLevel.Merge(m, src)
+}
+func (m *DownSampleLevel) XXX_Size() int {
+	return xxx_messageInfo_DownSampleLevel.Size(m)
+}
+func (m *DownSampleLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSampleLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownSampleLevel proto.InternalMessageInfo
+
+func (m *DownSampleLevel) GetSampleInterval() int64 {
+	if m != nil && m.SampleInterval != nil {
+		return *m.SampleInterval
+	}
+	return 0
+}
+
+func (m *DownSampleLevel) GetTimeInterval() int64 {
+	if m != nil && m.TimeInterval != nil {
+		return *m.TimeInterval
+	}
+	return 0
+}
+
+type DownSamplePolicyInfo struct {
+	Calls                []*DownSampleCall  `protobuf:"bytes,1,rep,name=Calls" json:"Calls,omitempty"`
+	Levels               []*DownSampleLevel `protobuf:"bytes,2,rep,name=Levels" json:"Levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
+func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
+func (*DownSamplePolicyInfo) ProtoMessage()    {}
+func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{12}
+}
+func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
+}
+func (m *DownSamplePolicyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSamplePolicyInfo.Marshal(b, m, deterministic)
+}
+func (m *DownSamplePolicyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSamplePolicyInfo.Merge(m, src)
+}
+func (m *DownSamplePolicyInfo) XXX_Size() int {
+	return xxx_messageInfo_DownSamplePolicyInfo.Size(m)
+}
+func (m *DownSamplePolicyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSamplePolicyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownSamplePolicyInfo proto.InternalMessageInfo
+
+func (m *DownSamplePolicyInfo) GetCalls() []*DownSampleCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *DownSamplePolicyInfo) GetLevels() []*DownSampleLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
 type ShardGroupInfo struct {
 	ID                   *uint64      `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
 	StartTime            *int64       `protobuf:"varint,2,req,name=StartTime" json:"StartTime,omitempty"`
@@ -964,7 +1178,7 @@ func (m *ShardGroupInfo) Reset()         { *m = ShardGroupInfo{} }
 func (m *ShardGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardGroupInfo) ProtoMessage()    {}
 func (*ShardGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{10}
+	return fileDescriptor_4aed0c02de55ead8, []int{13}
 }
 func (m *ShardGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardGroupInfo.Unmarshal(m, b)
@@ -1027,22 +1241,23 @@ func (m *ShardGroupInfo) GetTruncatedAt() int64 {
 }
 
 type ShardInfo struct {
-	ID                   *uint64  `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
-	OwnerIDs             []uint32 `protobuf:"varint,2,rep,name=OwnerIDs" json:"OwnerIDs,omitempty"` // Deprecated: Do not use.
-	Min                  *string  `protobuf:"bytes,3,req,name=Min" json:"Min,omitempty"`
-	Max                  *string  `protobuf:"bytes,4,req,name=Max" json:"Max,omitempty"`
-	Tier                 *uint64  `protobuf:"varint,5,req,name=Tier" json:"Tier,omitempty"`
-	IndexID              *uint64  `protobuf:"varint,6,req,name=IndexID" json:"IndexID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID                   *uint64       `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
+	OwnerIDs             []uint32      `protobuf:"varint,2,rep,name=OwnerIDs" json:"OwnerIDs,omitempty"` // Deprecated: Do not use.
+	Min                  *string       `protobuf:"bytes,3,req,name=Min" json:"Min,omitempty"`
+	Max                  *string       `protobuf:"bytes,4,req,name=Max" json:"Max,omitempty"`
+	Tier                 *uint64       `protobuf:"varint,5,req,name=Tier" json:"Tier,omitempty"`
+	IndexID              *uint64       `protobuf:"varint,6,req,name=IndexID" json:"IndexID,omitempty"`
+	Lagging              []*ReplicaLag `protobuf:"bytes,7,rep,name=Lagging" json:"Lagging,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ShardInfo) Reset()         { *m = ShardInfo{} }
 func (m *ShardInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardInfo) ProtoMessage()    {}
 func (*ShardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{11}
+	return fileDescriptor_4aed0c02de55ead8, []int{14}
 }
 func (m *ShardInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardInfo.Unmarshal(m, b)
@@ -1105,6 +1320,59 @@ func (m *ShardInfo) GetIndexID() uint64 {
 	return 0
 }
 
+func (m *ShardInfo) GetLagging() []*ReplicaLag {
+	if m != nil {
+		return m.Lagging
+	}
+	return nil
+}
+
+type ReplicaLag struct {
+	PtID                 *uint32  `protobuf:"varint,1,req,name=PtID" json:"PtID,omitempty"`
+	Seq                  *uint64  `protobuf:"varint,2,req,name=Seq" json:"Seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaLag) Reset()         { *m = ReplicaLag{} }
+func (m *ReplicaLag) String() string { return proto.CompactTextString(m) }
+func (*ReplicaLag) ProtoMessage()    {}
+func (*ReplicaLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{15}
+}
+func (m *ReplicaLag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaLag.Unmarshal(m, b)
+}
+func (m *ReplicaLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaLag.Marshal(b, m, deterministic)
+}
+func (m *ReplicaLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaLag.Merge(m, src)
+}
+func (m *ReplicaLag) XXX_Size() int {
+	return xxx_messageInfo_ReplicaLag.Size(m)
+}
+func (m *ReplicaLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaLag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaLag proto.InternalMessageInfo
+
+func (m *ReplicaLag) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *ReplicaLag) GetSeq() uint64 {
+	if m != nil && m.Seq != nil {
+		return *m.Seq
+	}
+	return 0
+}
+
 type ShardKeyInfo struct {
 	ShardKey             []string `protobuf:"bytes,1,rep,name=ShardKey" json:"ShardKey,omitempty"`
 	Type                 *string  `protobuf:"bytes,2,opt,name=Type" json:"Type,omitempty"`
@@ -1118,7 +1386,7 @@ func (m *ShardKeyInfo) Reset()         { *m = ShardKeyInfo{} }
 func (m *ShardKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardKeyInfo) ProtoMessage()    {}
 func (*ShardKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{12}
+	return fileDescriptor_4aed0c02de55ead8, []int{16}
 }
 func (m *ShardKeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardKeyInfo.Unmarshal(m, b)
@@ -1159,6 +1427,106 @@ func (m *ShardKeyInfo) GetSgID() uint64 {
 	return 0
 }
 
+type ContinuousQueryInfo struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Query                *string  `protobuf:"bytes,2,req,name=Query" json:"Query,omitempty"`
+	LastRunTime          *int64   `protobuf:"varint,3,opt,name=LastRunTime" json:"LastRunTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContinuousQueryInfo) Reset()         { *m = ContinuousQueryInfo{} }
+func (m *ContinuousQueryInfo) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryInfo) ProtoMessage()    {}
+func (*ContinuousQueryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{17}
+}
+func (m *ContinuousQueryInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryInfo.Unmarshal(m, b)
+}
+func (m *ContinuousQueryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryInfo.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryInfo.Merge(m, src)
+}
+func (m *ContinuousQueryInfo) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryInfo.Size(m)
+}
+func (m *ContinuousQueryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryInfo proto.InternalMessageInfo
+
+func (m *ContinuousQueryInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ContinuousQueryInfo) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
+	}
+	return ""
+}
+
+func (m *ContinuousQueryInfo) GetLastRunTime() int64 {
+	if m != nil && m.LastRunTime != nil {
+		return *m.LastRunTime
+	}
+	return 0
+}
+
+type ContinuousQueryLease struct {
+	Owner                *string  `protobuf:"bytes,1,req,name=Owner" json:"Owner,omitempty"`
+	Expiration           *int64   `protobuf:"varint,2,req,name=Expiration" json:"Expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContinuousQueryLease) Reset()         { *m = ContinuousQueryLease{} }
+func (m *ContinuousQueryLease) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryLease) ProtoMessage()    {}
+func (*ContinuousQueryLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{18}
+}
+func (m *ContinuousQueryLease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryLease.Unmarshal(m, b)
+}
+func (m *ContinuousQueryLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryLease.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryLease.Merge(m, src)
+}
+func (m *ContinuousQueryLease) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryLease.Size(m)
+}
+func (m *ContinuousQueryLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryLease proto.InternalMessageInfo
+
+func (m *ContinuousQueryLease) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *ContinuousQueryLease) GetExpiration() int64 {
+	if m != nil && m.Expiration != nil {
+		return *m.Expiration
+	}
+	return 0
+}
+
 type SubscriptionInfo struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	Mode                 *string  `protobuf:"bytes,2,req,name=Mode" json:"Mode,omitempty"`
@@ -1172,7 +1540,7 @@ func (m *SubscriptionInfo) Reset()         { *m = SubscriptionInfo{} }
 func (m *SubscriptionInfo) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionInfo) ProtoMessage()    {}
 func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{13}
+	return fileDescriptor_4aed0c02de55ead8, []int{19}
 }
 func (m *SubscriptionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriptionInfo.Unmarshal(m, b)
@@ -1224,7 +1592,7 @@ func (m *ShardOwner) Reset()         { *m = ShardOwner{} }
 func (m *ShardOwner) String() string { return proto.CompactTextString(m) }
 func (*ShardOwner) ProtoMessage()    {}
 func (*ShardOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{14}
+	return fileDescriptor_4aed0c02de55ead8, []int{20}
 }
 func (m *ShardOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardOwner.Unmarshal(m, b)
@@ -1257,6 +1625,7 @@ type UserInfo struct {
 	Admin                *bool            `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
 	RwUser               *bool            `protobuf:"varint,4,opt,name=RwUser" json:"RwUser,omitempty"`
 	Privileges           []*UserPrivilege `protobuf:"bytes,5,rep,name=Privileges" json:"Privileges,omitempty"`
+	Roles                []string         `protobuf:"bytes,6,rep,name=Roles" json:"Roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1266,7 +1635,7 @@ func (m *UserInfo) Reset()         { *m = UserInfo{} }
 func (m *UserInfo) String() string { return proto.CompactTextString(m) }
 func (*UserInfo) ProtoMessage()    {}
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{15}
+	return fileDescriptor_4aed0c02de55ead8, []int{21}
 }
 func (m *UserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
@@ -1321,6 +1690,13 @@ func (m *UserInfo) GetPrivileges() []*UserPrivilege {
 	return nil
 }
 
+func (m *UserInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type UserPrivilege struct {
 	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Privilege            *int32   `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
@@ -1333,7 +1709,7 @@ func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
 func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
 func (*UserPrivilege) ProtoMessage()    {}
 func (*UserPrivilege) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{16}
+	return fileDescriptor_4aed0c02de55ead8, []int{22}
 }
 func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
@@ -1367,80 +1743,266 @@ func (m *UserPrivilege) GetPrivilege() int32 {
 	return 0
 }
 
-type IndexRelation struct {
-	Rid                  *uint32      `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
-	Oid                  *uint32      `protobuf:"varint,2,req,name=Oid" json:"Oid,omitempty"`
-	IndexName            []string     `protobuf:"bytes,3,rep,name=IndexName" json:"IndexName,omitempty"`
-	IndexLists           []*IndexList `protobuf:"bytes,4,rep,name=IndexLists" json:"IndexLists,omitempty"`
+type RoleInfo struct {
+	Name                 *string      `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Grants               []*RoleGrant `protobuf:"bytes,2,rep,name=Grants" json:"Grants,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
-func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
-func (*IndexRelation) ProtoMessage()    {}
-func (*IndexRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{17}
-}
-func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{23}
 }
-func (m *IndexRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexRelation.Marshal(b, m, deterministic)
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
 }
-func (m *IndexRelation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexRelation.Merge(m, src)
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
 }
-func (m *IndexRelation) XXX_Size() int {
-	return xxx_messageInfo_IndexRelation.Size(m)
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
 }
-func (m *IndexRelation) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexRelation.DiscardUnknown(m)
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
 }
-
-var xxx_messageInfo_IndexRelation proto.InternalMessageInfo
-
-func (m *IndexRelation) GetRid() uint32 {
-	if m != nil && m.Rid != nil {
-		return *m.Rid
-	}
-	return 0
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
 }
 
-func (m *IndexRelation) GetOid() uint32 {
-	if m != nil && m.Oid != nil {
-		return *m.Oid
-	}
-	return 0
-}
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
 
-func (m *IndexRelation) GetIndexName() []string {
-	if m != nil {
-		return m.IndexName
+func (m *RoleInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *IndexRelation) GetIndexLists() []*IndexList {
+func (m *RoleInfo) GetGrants() []*RoleGrant {
 	if m != nil {
-		return m.IndexLists
+		return m.Grants
 	}
 	return nil
 }
 
-type IndexList struct {
-	IList                []string `protobuf:"bytes,1,rep,name=IList" json:"IList,omitempty"`
+type RoleGrant struct {
+	Privilege            *int32   `protobuf:"varint,1,req,name=Privilege" json:"Privilege,omitempty"`
+	Database             *string  `protobuf:"bytes,2,opt,name=Database" json:"Database,omitempty"`
+	RetentionPolicy      *string  `protobuf:"bytes,3,opt,name=RetentionPolicy" json:"RetentionPolicy,omitempty"`
+	Measurement          *string  `protobuf:"bytes,4,opt,name=Measurement" json:"Measurement,omitempty"`
+	Regex                *string  `protobuf:"bytes,5,opt,name=Regex" json:"Regex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexList) Reset()         { *m = IndexList{} }
-func (m *IndexList) String() string { return proto.CompactTextString(m) }
-func (*IndexList) ProtoMessage()    {}
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{24}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleGrant.Unmarshal(m, b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return xxx_messageInfo_RoleGrant.Size(m)
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+func (m *RoleGrant) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetRetentionPolicy() string {
+	if m != nil && m.RetentionPolicy != nil {
+		return *m.RetentionPolicy
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetRegex() string {
+	if m != nil && m.Regex != nil {
+		return *m.Regex
+	}
+	return ""
+}
+
+type RateLimitInfo struct {
+	Database             *string  `protobuf:"bytes,1,opt,name=Database" json:"Database,omitempty"`
+	User                 *string  `protobuf:"bytes,2,opt,name=User" json:"User,omitempty"`
+	PointsPerSecond      *int64   `protobuf:"varint,3,opt,name=PointsPerSecond" json:"PointsPerSecond,omitempty"`
+	BytesPerSecond       *int64   `protobuf:"varint,4,opt,name=BytesPerSecond" json:"BytesPerSecond,omitempty"`
+	QueriesPerSecond     *int64   `protobuf:"varint,5,opt,name=QueriesPerSecond" json:"QueriesPerSecond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitInfo) Reset()         { *m = RateLimitInfo{} }
+func (m *RateLimitInfo) String() string { return proto.CompactTextString(m) }
+func (*RateLimitInfo) ProtoMessage()    {}
+func (*RateLimitInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{25}
+}
+func (m *RateLimitInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitInfo.Unmarshal(m, b)
+}
+func (m *RateLimitInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitInfo.Marshal(b, m, deterministic)
+}
+func (m *RateLimitInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitInfo.Merge(m, src)
+}
+func (m *RateLimitInfo) XXX_Size() int {
+	return xxx_messageInfo_RateLimitInfo.Size(m)
+}
+func (m *RateLimitInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitInfo proto.InternalMessageInfo
+
+func (m *RateLimitInfo) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *RateLimitInfo) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *RateLimitInfo) GetPointsPerSecond() int64 {
+	if m != nil && m.PointsPerSecond != nil {
+		return *m.PointsPerSecond
+	}
+	return 0
+}
+
+func (m *RateLimitInfo) GetBytesPerSecond() int64 {
+	if m != nil && m.BytesPerSecond != nil {
+		return *m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *RateLimitInfo) GetQueriesPerSecond() int64 {
+	if m != nil && m.QueriesPerSecond != nil {
+		return *m.QueriesPerSecond
+	}
+	return 0
+}
+
+type IndexRelation struct {
+	Rid                  *uint32      `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
+	Oid                  *uint32      `protobuf:"varint,2,req,name=Oid" json:"Oid,omitempty"`
+	IndexName            []string     `protobuf:"bytes,3,rep,name=IndexName" json:"IndexName,omitempty"`
+	IndexLists           []*IndexList `protobuf:"bytes,4,rep,name=IndexLists" json:"IndexLists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
+func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
+func (*IndexRelation) ProtoMessage()    {}
+func (*IndexRelation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{26}
+}
+func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
+}
+func (m *IndexRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexRelation.Marshal(b, m, deterministic)
+}
+func (m *IndexRelation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRelation.Merge(m, src)
+}
+func (m *IndexRelation) XXX_Size() int {
+	return xxx_messageInfo_IndexRelation.Size(m)
+}
+func (m *IndexRelation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRelation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexRelation proto.InternalMessageInfo
+
+func (m *IndexRelation) GetRid() uint32 {
+	if m != nil && m.Rid != nil {
+		return *m.Rid
+	}
+	return 0
+}
+
+func (m *IndexRelation) GetOid() uint32 {
+	if m != nil && m.Oid != nil {
+		return *m.Oid
+	}
+	return 0
+}
+
+func (m *IndexRelation) GetIndexName() []string {
+	if m != nil {
+		return m.IndexName
+	}
+	return nil
+}
+
+func (m *IndexRelation) GetIndexLists() []*IndexList {
+	if m != nil {
+		return m.IndexLists
+	}
+	return nil
+}
+
+type IndexList struct {
+	IList                []string `protobuf:"bytes,1,rep,name=IList" json:"IList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexList) Reset()         { *m = IndexList{} }
+func (m *IndexList) String() string { return proto.CompactTextString(m) }
+func (*IndexList) ProtoMessage()    {}
 func (*IndexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{18}
+	return fileDescriptor_4aed0c02de55ead8, []int{27}
 }
 func (m *IndexList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexList.Unmarshal(m, b)
@@ -1479,7 +2041,7 @@ func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{19}
+	return fileDescriptor_4aed0c02de55ead8, []int{28}
 }
 
 var extRange_Command = []proto.ExtensionRange{
@@ -1529,7 +2091,7 @@ func (m *CreateDatabaseCommand) Reset()         { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()    {}
 func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{20}
+	return fileDescriptor_4aed0c02de55ead8, []int{29}
 }
 func (m *CreateDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDatabaseCommand.Unmarshal(m, b)
@@ -1597,7 +2159,7 @@ func (m *DropDatabaseCommand) Reset()         { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()    {}
 func (*DropDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{21}
+	return fileDescriptor_4aed0c02de55ead8, []int{30}
 }
 func (m *DropDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDatabaseCommand.Unmarshal(m, b)
@@ -1646,7 +2208,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{22}
+	return fileDescriptor_4aed0c02de55ead8, []int{31}
 }
 func (m *CreateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRetentionPolicyCommand.Unmarshal(m, b)
@@ -1708,7 +2270,7 @@ func (m *DropRetentionPolicyCommand) Reset()         { *m = DropRetentionPolicyC
 func (m *DropRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()    {}
 func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{23}
+	return fileDescriptor_4aed0c02de55ead8, []int{32}
 }
 func (m *DropRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropRetentionPolicyCommand.Unmarshal(m, b)
@@ -1763,7 +2325,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{24}
+	return fileDescriptor_4aed0c02de55ead8, []int{33}
 }
 func (m *SetDefaultRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDefaultRetentionPolicyCommand.Unmarshal(m, b)
@@ -1826,7 +2388,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{25}
+	return fileDescriptor_4aed0c02de55ead8, []int{34}
 }
 func (m *UpdateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRetentionPolicyCommand.Unmarshal(m, b)
@@ -1939,7 +2501,7 @@ func (m *CreateShardGroupCommand) Reset()         { *m = CreateShardGroupCommand
 func (m *CreateShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()    {}
 func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{26}
+	return fileDescriptor_4aed0c02de55ead8, []int{35}
 }
 func (m *CreateShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateShardGroupCommand.Unmarshal(m, b)
@@ -2009,7 +2571,7 @@ func (m *DeleteShardGroupCommand) Reset()         { *m = DeleteShardGroupCommand
 func (m *DeleteShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()    {}
 func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{27}
+	return fileDescriptor_4aed0c02de55ead8, []int{36}
 }
 func (m *DeleteShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteShardGroupCommand.Unmarshal(m, b)
@@ -2073,7 +2635,7 @@ func (m *CreateUserCommand) Reset()         { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()    {}
 func (*CreateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{28}
+	return fileDescriptor_4aed0c02de55ead8, []int{37}
 }
 func (m *CreateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserCommand.Unmarshal(m, b)
@@ -2141,7 +2703,7 @@ func (m *DropUserCommand) Reset()         { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()    {}
 func (*DropUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{29}
+	return fileDescriptor_4aed0c02de55ead8, []int{38}
 }
 func (m *DropUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropUserCommand.Unmarshal(m, b)
@@ -2189,7 +2751,7 @@ func (m *UpdateUserCommand) Reset()         { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()    {}
 func (*UpdateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{30}
+	return fileDescriptor_4aed0c02de55ead8, []int{39}
 }
 func (m *UpdateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUserCommand.Unmarshal(m, b)
@@ -2245,7 +2807,7 @@ func (m *SetPrivilegeCommand) Reset()         { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()    {}
 func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{31}
+	return fileDescriptor_4aed0c02de55ead8, []int{40}
 }
 func (m *SetPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPrivilegeCommand.Unmarshal(m, b)
@@ -2306,7 +2868,7 @@ func (m *SetDataCommand) Reset()         { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()    {}
 func (*SetDataCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{32}
+	return fileDescriptor_4aed0c02de55ead8, []int{41}
 }
 func (m *SetDataCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDataCommand.Unmarshal(m, b)
@@ -2354,7 +2916,7 @@ func (m *SetAdminPrivilegeCommand) Reset()         { *m = SetAdminPrivilegeComma
 func (m *SetAdminPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()    {}
 func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{33}
+	return fileDescriptor_4aed0c02de55ead8, []int{42}
 }
 func (m *SetAdminPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAdminPrivilegeCommand.Unmarshal(m, b)
@@ -2397,6 +2959,124 @@ var E_SetAdminPrivilegeCommand_Command = &proto.ExtensionDesc{
 	Filename:      "open_src/influx/meta/proto/meta.proto",
 }
 
+type CreateContinuousQueryCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Name                 *string  `protobuf:"bytes,2,req,name=Name" json:"Name,omitempty"`
+	Query                *string  `protobuf:"bytes,3,req,name=Query" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQueryCommand{} }
+func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateContinuousQueryCommand) ProtoMessage()    {}
+func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{43}
+}
+func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
+}
+func (m *CreateContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateContinuousQueryCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateContinuousQueryCommand.Merge(m, src)
+}
+func (m *CreateContinuousQueryCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Size(m)
+}
+func (m *CreateContinuousQueryCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateContinuousQueryCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateContinuousQueryCommand proto.InternalMessageInfo
+
+func (m *CreateContinuousQueryCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *CreateContinuousQueryCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *CreateContinuousQueryCommand) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
+	}
+	return ""
+}
+
+var E_CreateContinuousQueryCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateContinuousQueryCommand)(nil),
+	Field:         119,
+	Name:          "proto.CreateContinuousQueryCommand.command",
+	Tag:           "bytes,119,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type DropContinuousQueryCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryCommand{} }
+func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
+func (*DropContinuousQueryCommand) ProtoMessage()    {}
+func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{44}
+}
+func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
+}
+func (m *DropContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropContinuousQueryCommand.Marshal(b, m, deterministic)
+}
+func (m *DropContinuousQueryCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropContinuousQueryCommand.Merge(m, src)
+}
+func (m *DropContinuousQueryCommand) XXX_Size() int {
+	return xxx_messageInfo_DropContinuousQueryCommand.Size(m)
+}
+func (m *DropContinuousQueryCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropContinuousQueryCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropContinuousQueryCommand proto.InternalMessageInfo
+
+func (m *DropContinuousQueryCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *DropContinuousQueryCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+var E_DropContinuousQueryCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropContinuousQueryCommand)(nil),
+	Field:         120,
+	Name:          "proto.DropContinuousQueryCommand.command",
+	Tag:           "bytes,120,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
 type CreateSubscriptionCommand struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
@@ -2412,7 +3092,7 @@ func (m *CreateSubscriptionCommand) Reset()         { *m = CreateSubscriptionCom
 func (m *CreateSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()    {}
 func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{34}
+	return fileDescriptor_4aed0c02de55ead8, []int{45}
 }
 func (m *CreateSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSubscriptionCommand.Unmarshal(m, b)
@@ -2489,7 +3169,7 @@ func (m *DropSubscriptionCommand) Reset()         { *m = DropSubscriptionCommand
 func (m *DropSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()    {}
 func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{35}
+	return fileDescriptor_4aed0c02de55ead8, []int{46}
 }
 func (m *DropSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropSubscriptionCommand.Unmarshal(m, b)
@@ -2553,7 +3233,7 @@ func (m *CreateMetaNodeCommand) Reset()         { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()    {}
 func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{36}
+	return fileDescriptor_4aed0c02de55ead8, []int{47}
 }
 func (m *CreateMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMetaNodeCommand.Unmarshal(m, b)
@@ -2622,7 +3302,7 @@ func (m *CreateDataNodeCommand) Reset()         { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()    {}
 func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{37}
+	return fileDescriptor_4aed0c02de55ead8, []int{48}
 }
 func (m *CreateDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDataNodeCommand.Unmarshal(m, b)
@@ -2678,7 +3358,7 @@ func (m *DataNodeEvent) Reset()         { *m = DataNodeEvent{} }
 func (m *DataNodeEvent) String() string { return proto.CompactTextString(m) }
 func (*DataNodeEvent) ProtoMessage()    {}
 func (*DataNodeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{38}
+	return fileDescriptor_4aed0c02de55ead8, []int{49}
 }
 func (m *DataNodeEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataNodeEvent.Unmarshal(m, b)
@@ -2730,7 +3410,7 @@ func (m *DeleteMetaNodeCommand) Reset()         { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()    {}
 func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{39}
+	return fileDescriptor_4aed0c02de55ead8, []int{50}
 }
 func (m *DeleteMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMetaNodeCommand.Unmarshal(m, b)
@@ -2777,7 +3457,7 @@ func (m *DeleteDataNodeCommand) Reset()         { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()    {}
 func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{40}
+	return fileDescriptor_4aed0c02de55ead8, []int{51}
 }
 func (m *DeleteDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDataNodeCommand.Unmarshal(m, b)
@@ -2826,7 +3506,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{41}
+	return fileDescriptor_4aed0c02de55ead8, []int{52}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -2883,7 +3563,7 @@ func (m *SetMetaNodeCommand) Reset()         { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()    {}
 func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{42}
+	return fileDescriptor_4aed0c02de55ead8, []int{53}
 }
 func (m *SetMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMetaNodeCommand.Unmarshal(m, b)
@@ -2951,7 +3631,7 @@ func (m *DropShardCommand) Reset()         { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()    {}
 func (*DropShardCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{43}
+	return fileDescriptor_4aed0c02de55ead8, []int{54}
 }
 func (m *DropShardCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropShardCommand.Unmarshal(m, b)
@@ -2998,7 +3678,7 @@ func (m *MarkDatabaseDeleteCommand) Reset()         { *m = MarkDatabaseDeleteCom
 func (m *MarkDatabaseDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkDatabaseDeleteCommand) ProtoMessage()    {}
 func (*MarkDatabaseDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{44}
+	return fileDescriptor_4aed0c02de55ead8, []int{55}
 }
 func (m *MarkDatabaseDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkDatabaseDeleteCommand.Unmarshal(m, b)
@@ -3048,7 +3728,7 @@ func (m *UpdateShardOwnerCommand) Reset()         { *m = UpdateShardOwnerCommand
 func (m *UpdateShardOwnerCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardOwnerCommand) ProtoMessage()    {}
 func (*UpdateShardOwnerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{45}
+	return fileDescriptor_4aed0c02de55ead8, []int{56}
 }
 func (m *UpdateShardOwnerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardOwnerCommand.Unmarshal(m, b)
@@ -3117,7 +3797,7 @@ func (m *MarkRetentionPolicyDeleteCommand) Reset()         { *m = MarkRetentionP
 func (m *MarkRetentionPolicyDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkRetentionPolicyDeleteCommand) ProtoMessage()    {}
 func (*MarkRetentionPolicyDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{46}
+	return fileDescriptor_4aed0c02de55ead8, []int{57}
 }
 func (m *MarkRetentionPolicyDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkRetentionPolicyDeleteCommand.Unmarshal(m, b)
@@ -3175,7 +3855,7 @@ func (m *CreateMeasurementCommand) Reset()         { *m = CreateMeasurementComma
 func (m *CreateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMeasurementCommand) ProtoMessage()    {}
 func (*CreateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{47}
+	return fileDescriptor_4aed0c02de55ead8, []int{58}
 }
 func (m *CreateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMeasurementCommand.Unmarshal(m, b)
@@ -3253,7 +3933,7 @@ func (m *AlterShardKeyCmd) Reset()         { *m = AlterShardKeyCmd{} }
 func (m *AlterShardKeyCmd) String() string { return proto.CompactTextString(m) }
 func (*AlterShardKeyCmd) ProtoMessage()    {}
 func (*AlterShardKeyCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{48}
+	return fileDescriptor_4aed0c02de55ead8, []int{59}
 }
 func (m *AlterShardKeyCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlterShardKeyCmd.Unmarshal(m, b)
@@ -3325,7 +4005,7 @@ func (m *UpdateDbPtStatusCommand) Reset()         { *m = UpdateDbPtStatusCommand
 func (m *UpdateDbPtStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateDbPtStatusCommand) ProtoMessage()    {}
 func (*UpdateDbPtStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{49}
+	return fileDescriptor_4aed0c02de55ead8, []int{60}
 }
 func (m *UpdateDbPtStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDbPtStatusCommand.Unmarshal(m, b)
@@ -3404,7 +4084,7 @@ func (m *ReShardingCommand) Reset()         { *m = ReShardingCommand{} }
 func (m *ReShardingCommand) String() string { return proto.CompactTextString(m) }
 func (*ReShardingCommand) ProtoMessage()    {}
 func (*ReShardingCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{50}
+	return fileDescriptor_4aed0c02de55ead8, []int{61}
 }
 func (m *ReShardingCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReShardingCommand.Unmarshal(m, b)
@@ -3482,7 +4162,7 @@ func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
 func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSchemaCommand) ProtoMessage()    {}
 func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{51}
+	return fileDescriptor_4aed0c02de55ead8, []int{62}
 }
 func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
@@ -3551,7 +4231,7 @@ func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
 func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()    {}
 func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{52}
+	return fileDescriptor_4aed0c02de55ead8, []int{63}
 }
 func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
@@ -3597,7 +4277,7 @@ func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
 func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexInfo) ProtoMessage()    {}
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{53}
+	return fileDescriptor_4aed0c02de55ead8, []int{64}
 }
 func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
@@ -3646,7 +4326,7 @@ func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
 func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexGroupInfo) ProtoMessage()    {}
 func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{54}
+	return fileDescriptor_4aed0c02de55ead8, []int{65}
 }
 func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
@@ -3715,7 +4395,7 @@ func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
 func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
 func (*ShardStatus) ProtoMessage()    {}
 func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{55}
+	return fileDescriptor_4aed0c02de55ead8, []int{66}
 }
 func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
@@ -3775,7 +4455,7 @@ func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
 func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
 func (*RpShardStatus) ProtoMessage()    {}
 func (*RpShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{56}
+	return fileDescriptor_4aed0c02de55ead8, []int{67}
 }
 func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
@@ -3822,7 +4502,7 @@ func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
 func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
 func (*DBPtStatus) ProtoMessage()    {}
 func (*DBPtStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{57}
+	return fileDescriptor_4aed0c02de55ead8, []int{68}
 }
 func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
@@ -3874,7 +4554,7 @@ func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand
 func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
 func (*ReportShardsLoadCommand) ProtoMessage()    {}
 func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{58}
+	return fileDescriptor_4aed0c02de55ead8, []int{69}
 }
 func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
@@ -3921,7 +4601,7 @@ func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
 func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*PruneGroupsCommand) ProtoMessage()    {}
 func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{59}
+	return fileDescriptor_4aed0c02de55ead8, []int{70}
 }
 func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
@@ -3970,7 +4650,7 @@ func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDel
 func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
 func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{60}
+	return fileDescriptor_4aed0c02de55ead8, []int{71}
 }
 func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
@@ -4033,7 +4713,7 @@ func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{}
 func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*DropMeasurementCommand) ProtoMessage()    {}
 func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{61}
+	return fileDescriptor_4aed0c02de55ead8, []int{72}
 }
 func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
@@ -4098,7 +4778,7 @@ func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
 func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeStartInfo) ProtoMessage()    {}
 func (*NodeStartInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{62}
+	return fileDescriptor_4aed0c02de55ead8, []int{73}
 }
 func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
@@ -4166,7 +4846,7 @@ func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
 func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeCommand) ProtoMessage()    {}
 func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{63}
+	return fileDescriptor_4aed0c02de55ead8, []int{74}
 }
 func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
@@ -4228,7 +4908,7 @@ func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
 func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationCommand) ProtoMessage()    {}
 func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{64}
+	return fileDescriptor_4aed0c02de55ead8, []int{75}
 }
 func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
@@ -4284,7 +4964,7 @@ func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
 func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
 func (*DurationDescriptor) ProtoMessage()    {}
 func (*DurationDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{65}
+	return fileDescriptor_4aed0c02de55ead8, []int{76}
 }
 func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
@@ -4341,7 +5021,7 @@ func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
 func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
 func (*ShardIdentifier) ProtoMessage()    {}
 func (*ShardIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{66}
+	return fileDescriptor_4aed0c02de55ead8, []int{77}
 }
 func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
@@ -4415,7 +5095,7 @@ func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
 func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeInfo) ProtoMessage()    {}
 func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{67}
+	return fileDescriptor_4aed0c02de55ead8, []int{78}
 }
 func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
@@ -4462,7 +5142,7 @@ func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
 func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
 func (*IndexDescriptor) ProtoMessage()    {}
 func (*IndexDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{68}
+	return fileDescriptor_4aed0c02de55ead8, []int{79}
 }
 func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
@@ -4515,7 +5195,7 @@ func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
 func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationInfo) ProtoMessage()    {}
 func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{69}
+	return fileDescriptor_4aed0c02de55ead8, []int{80}
 }
 func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
@@ -4563,7 +5243,7 @@ func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
 func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardTimeRangeInfo) ProtoMessage()    {}
 func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{70}
+	return fileDescriptor_4aed0c02de55ead8, []int{81}
 }
 func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
@@ -4623,7 +5303,7 @@ func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
 func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationResponse) ProtoMessage()    {}
 func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{71}
+	return fileDescriptor_4aed0c02de55ead8, []int{82}
 }
 func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
@@ -4670,7 +5350,7 @@ func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand
 func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteIndexGroupCommand) ProtoMessage()    {}
 func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{72}
+	return fileDescriptor_4aed0c02de55ead8, []int{83}
 }
 func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
@@ -4734,7 +5414,7 @@ func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierC
 func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
 func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{73}
+	return fileDescriptor_4aed0c02de55ead8, []int{84}
 }
 func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
@@ -4803,7 +5483,7 @@ func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
 func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*CardinalityInfo) ProtoMessage()    {}
 func (*CardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{74}
+	return fileDescriptor_4aed0c02de55ead8, []int{85}
 }
 func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
@@ -4849,7 +5529,7 @@ func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinali
 func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementCardinalityInfo) ProtoMessage()    {}
 func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{75}
+	return fileDescriptor_4aed0c02de55ead8, []int{86}
 }
 func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
@@ -4895,7 +5575,7 @@ func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
 func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
 func (*CardinalityResponse) ProtoMessage()    {}
 func (*CardinalityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{76}
+	return fileDescriptor_4aed0c02de55ead8, []int{87}
 }
 func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
@@ -4943,7 +5623,7 @@ func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand
 func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{77}
+	return fileDescriptor_4aed0c02de55ead8, []int{88}
 }
 func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
@@ -5013,7 +5693,7 @@ func (m *DbPt) Reset()         { *m = DbPt{} }
 func (m *DbPt) String() string { return proto.CompactTextString(m) }
 func (*DbPt) ProtoMessage()    {}
 func (*DbPt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{78}
+	return fileDescriptor_4aed0c02de55ead8, []int{89}
 }
 func (m *DbPt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DbPt.Unmarshal(m, b)
@@ -5072,7 +5752,7 @@ func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
 func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrateEventInfo) ProtoMessage()    {}
 func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{79}
+	return fileDescriptor_4aed0c02de55ead8, []int{90}
 }
 func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
@@ -5159,7 +5839,7 @@ func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
 func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateEventCommand) ProtoMessage()    {}
 func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{80}
+	return fileDescriptor_4aed0c02de55ead8, []int{91}
 }
 func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
@@ -5206,7 +5886,7 @@ func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
 func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateEventCommand) ProtoMessage()    {}
 func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{81}
+	return fileDescriptor_4aed0c02de55ead8, []int{92}
 }
 func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
@@ -5256,7 +5936,7 @@ func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
 func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtInfoCommand) ProtoMessage()    {}
 func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{82}
+	return fileDescriptor_4aed0c02de55ead8, []int{93}
 }
 func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
@@ -5324,7 +6004,7 @@ func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
 func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventCommand) ProtoMessage()    {}
 func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4aed0c02de55ead8, []int{83}
+	return fileDescriptor_4aed0c02de55ead8, []int{94}
 }
 func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
@@ -5360,6 +6040,650 @@ var E_RemoveEventCommand_Command = &proto.ExtensionDesc{
 	Filename:      "open_src/influx/meta/proto/meta.proto",
 }
 
+type ContinuousQueryLeaseCommand struct {
+	Owner                *string  `protobuf:"bytes,1,req,name=Owner" json:"Owner,omitempty"`
+	Time                 *int64   `protobuf:"varint,2,req,name=Time" json:"Time,omitempty"`
+	Duration             *int64   `protobuf:"varint,3,req,name=Duration" json:"Duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContinuousQueryLeaseCommand) Reset()         { *m = ContinuousQueryLeaseCommand{} }
+func (m *ContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryLeaseCommand) ProtoMessage()    {}
+func (*ContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{95}
+}
+func (m *ContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryLeaseCommand.Unmarshal(m, b)
+}
+func (m *ContinuousQueryLeaseCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryLeaseCommand.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryLeaseCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryLeaseCommand.Merge(m, src)
+}
+func (m *ContinuousQueryLeaseCommand) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryLeaseCommand.Size(m)
+}
+func (m *ContinuousQueryLeaseCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryLeaseCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryLeaseCommand proto.InternalMessageInfo
+
+func (m *ContinuousQueryLeaseCommand) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *ContinuousQueryLeaseCommand) GetTime() int64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+func (m *ContinuousQueryLeaseCommand) GetDuration() int64 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return 0
+}
+
+var E_ContinuousQueryLeaseCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ContinuousQueryLeaseCommand)(nil),
+	Field:         169,
+	Name:          "proto.ContinuousQueryLeaseCommand.command",
+	Tag:           "bytes,169,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type ContinuousQueryReport struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Name                 *string  `protobuf:"bytes,2,req,name=Name" json:"Name,omitempty"`
+	LastRunTime          *int64   `protobuf:"varint,3,req,name=LastRunTime" json:"LastRunTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContinuousQueryReport) Reset()         { *m = ContinuousQueryReport{} }
+func (m *ContinuousQueryReport) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryReport) ProtoMessage()    {}
+func (*ContinuousQueryReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{96}
+}
+func (m *ContinuousQueryReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryReport.Unmarshal(m, b)
+}
+func (m *ContinuousQueryReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryReport.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryReport.Merge(m, src)
+}
+func (m *ContinuousQueryReport) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryReport.Size(m)
+}
+func (m *ContinuousQueryReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryReport proto.InternalMessageInfo
+
+func (m *ContinuousQueryReport) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *ContinuousQueryReport) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ContinuousQueryReport) GetLastRunTime() int64 {
+	if m != nil && m.LastRunTime != nil {
+		return *m.LastRunTime
+	}
+	return 0
+}
+
+type ContinuousQueryReportCommand struct {
+	Owner                *string                  `protobuf:"bytes,1,req,name=Owner" json:"Owner,omitempty"`
+	Reports              []*ContinuousQueryReport `protobuf:"bytes,2,rep,name=Reports" json:"Reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryReportCommand{} }
+func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryReportCommand) ProtoMessage()    {}
+func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{97}
+}
+func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
+}
+func (m *ContinuousQueryReportCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryReportCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryReportCommand.Merge(m, src)
+}
+func (m *ContinuousQueryReportCommand) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Size(m)
+}
+func (m *ContinuousQueryReportCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryReportCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryReportCommand proto.InternalMessageInfo
+
+func (m *ContinuousQueryReportCommand) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *ContinuousQueryReportCommand) GetReports() []*ContinuousQueryReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+var E_ContinuousQueryReportCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ContinuousQueryReportCommand)(nil),
+	Field:         170,
+	Name:          "proto.ContinuousQueryReportCommand.command",
+	Tag:           "bytes,170,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type CreateDownSamplePolicyCommand struct {
+	Database             *string               `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RetentionPolicy      *string               `protobuf:"bytes,2,req,name=RetentionPolicy" json:"RetentionPolicy,omitempty"`
+	Policy               *DownSamplePolicyInfo `protobuf:"bytes,3,req,name=Policy" json:"Policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSamplePolicyCommand{} }
+func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
+func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{98}
+}
+func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
+}
+func (m *CreateDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDownSamplePolicyCommand.Merge(m, src)
+}
+func (m *CreateDownSamplePolicyCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Size(m)
+}
+func (m *CreateDownSamplePolicyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDownSamplePolicyCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDownSamplePolicyCommand proto.InternalMessageInfo
+
+func (m *CreateDownSamplePolicyCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *CreateDownSamplePolicyCommand) GetRetentionPolicy() string {
+	if m != nil && m.RetentionPolicy != nil {
+		return *m.RetentionPolicy
+	}
+	return ""
+}
+
+func (m *CreateDownSamplePolicyCommand) GetPolicy() *DownSamplePolicyInfo {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+var E_CreateDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateDownSamplePolicyCommand)(nil),
+	Field:         171,
+	Name:          "proto.CreateDownSamplePolicyCommand.command",
+	Tag:           "bytes,171,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type DropDownSamplePolicyCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RetentionPolicy      *string  `protobuf:"bytes,2,req,name=RetentionPolicy" json:"RetentionPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolicyCommand{} }
+func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
+func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
+func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{99}
+}
+func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
+}
+func (m *DropDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Marshal(b, m, deterministic)
+}
+func (m *DropDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDownSamplePolicyCommand.Merge(m, src)
+}
+func (m *DropDownSamplePolicyCommand) XXX_Size() int {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Size(m)
+}
+func (m *DropDownSamplePolicyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDownSamplePolicyCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDownSamplePolicyCommand proto.InternalMessageInfo
+
+func (m *DropDownSamplePolicyCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *DropDownSamplePolicyCommand) GetRetentionPolicy() string {
+	if m != nil && m.RetentionPolicy != nil {
+		return *m.RetentionPolicy
+	}
+	return ""
+}
+
+var E_DropDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropDownSamplePolicyCommand)(nil),
+	Field:         172,
+	Name:          "proto.DropDownSamplePolicyCommand.command",
+	Tag:           "bytes,172,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type CreateRoleCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleCommand) Reset()         { *m = CreateRoleCommand{} }
+func (m *CreateRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleCommand) ProtoMessage()    {}
+func (*CreateRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{100}
+}
+func (m *CreateRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleCommand.Unmarshal(m, b)
+}
+func (m *CreateRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleCommand.Merge(m, src)
+}
+func (m *CreateRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleCommand.Size(m)
+}
+func (m *CreateRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleCommand proto.InternalMessageInfo
+
+func (m *CreateRoleCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_CreateRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateRoleCommand)(nil),
+	Field:         173,
+	Name:          "proto.CreateRoleCommand.command",
+	Tag:           "bytes,173,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type DropRoleCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRoleCommand) Reset()         { *m = DropRoleCommand{} }
+func (m *DropRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*DropRoleCommand) ProtoMessage()    {}
+func (*DropRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{101}
+}
+func (m *DropRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleCommand.Unmarshal(m, b)
+}
+func (m *DropRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *DropRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleCommand.Merge(m, src)
+}
+func (m *DropRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_DropRoleCommand.Size(m)
+}
+func (m *DropRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleCommand proto.InternalMessageInfo
+
+func (m *DropRoleCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_DropRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropRoleCommand)(nil),
+	Field:         174,
+	Name:          "proto.DropRoleCommand.command",
+	Tag:           "bytes,174,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type SetUserRoleCommand struct {
+	Username             *string  `protobuf:"bytes,1,req,name=Username" json:"Username,omitempty"`
+	Role                 *string  `protobuf:"bytes,2,req,name=Role" json:"Role,omitempty"`
+	Revoke               *bool    `protobuf:"varint,3,req,name=Revoke" json:"Revoke,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRoleCommand) Reset()         { *m = SetUserRoleCommand{} }
+func (m *SetUserRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*SetUserRoleCommand) ProtoMessage()    {}
+func (*SetUserRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{102}
+}
+func (m *SetUserRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRoleCommand.Unmarshal(m, b)
+}
+func (m *SetUserRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *SetUserRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRoleCommand.Merge(m, src)
+}
+func (m *SetUserRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_SetUserRoleCommand.Size(m)
+}
+func (m *SetUserRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRoleCommand proto.InternalMessageInfo
+
+func (m *SetUserRoleCommand) GetUsername() string {
+	if m != nil && m.Username != nil {
+		return *m.Username
+	}
+	return ""
+}
+
+func (m *SetUserRoleCommand) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *SetUserRoleCommand) GetRevoke() bool {
+	if m != nil && m.Revoke != nil {
+		return *m.Revoke
+	}
+	return false
+}
+
+var E_SetUserRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetUserRoleCommand)(nil),
+	Field:         175,
+	Name:          "proto.SetUserRoleCommand.command",
+	Tag:           "bytes,175,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type SetRolePrivilegeCommand struct {
+	Role                 *string    `protobuf:"bytes,1,req,name=Role" json:"Role,omitempty"`
+	Grant                *RoleGrant `protobuf:"bytes,2,req,name=Grant" json:"Grant,omitempty"`
+	Revoke               *bool      `protobuf:"varint,3,req,name=Revoke" json:"Revoke,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetRolePrivilegeCommand) Reset()         { *m = SetRolePrivilegeCommand{} }
+func (m *SetRolePrivilegeCommand) String() string { return proto.CompactTextString(m) }
+func (*SetRolePrivilegeCommand) ProtoMessage()    {}
+func (*SetRolePrivilegeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{103}
+}
+func (m *SetRolePrivilegeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRolePrivilegeCommand.Unmarshal(m, b)
+}
+func (m *SetRolePrivilegeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRolePrivilegeCommand.Marshal(b, m, deterministic)
+}
+func (m *SetRolePrivilegeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRolePrivilegeCommand.Merge(m, src)
+}
+func (m *SetRolePrivilegeCommand) XXX_Size() int {
+	return xxx_messageInfo_SetRolePrivilegeCommand.Size(m)
+}
+func (m *SetRolePrivilegeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRolePrivilegeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRolePrivilegeCommand proto.InternalMessageInfo
+
+func (m *SetRolePrivilegeCommand) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *SetRolePrivilegeCommand) GetGrant() *RoleGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func (m *SetRolePrivilegeCommand) GetRevoke() bool {
+	if m != nil && m.Revoke != nil {
+		return *m.Revoke
+	}
+	return false
+}
+
+var E_SetRolePrivilegeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetRolePrivilegeCommand)(nil),
+	Field:         176,
+	Name:          "proto.SetRolePrivilegeCommand.command",
+	Tag:           "bytes,176,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type SetRateLimitCommand struct {
+	Limit                *RateLimitInfo `protobuf:"bytes,1,req,name=Limit" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetRateLimitCommand) Reset()         { *m = SetRateLimitCommand{} }
+func (m *SetRateLimitCommand) String() string { return proto.CompactTextString(m) }
+func (*SetRateLimitCommand) ProtoMessage()    {}
+func (*SetRateLimitCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{104}
+}
+func (m *SetRateLimitCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRateLimitCommand.Unmarshal(m, b)
+}
+func (m *SetRateLimitCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRateLimitCommand.Marshal(b, m, deterministic)
+}
+func (m *SetRateLimitCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRateLimitCommand.Merge(m, src)
+}
+func (m *SetRateLimitCommand) XXX_Size() int {
+	return xxx_messageInfo_SetRateLimitCommand.Size(m)
+}
+func (m *SetRateLimitCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRateLimitCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRateLimitCommand proto.InternalMessageInfo
+
+func (m *SetRateLimitCommand) GetLimit() *RateLimitInfo {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+var E_SetRateLimitCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetRateLimitCommand)(nil),
+	Field:         177,
+	Name:          "proto.SetRateLimitCommand.command",
+	Tag:           "bytes,177,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
+type ReplicaLagCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,3,req,name=ShardID" json:"ShardID,omitempty"`
+	PtID                 *uint32  `protobuf:"varint,4,req,name=PtID" json:"PtID,omitempty"`
+	Lagging              *bool    `protobuf:"varint,5,req,name=Lagging" json:"Lagging,omitempty"`
+	Seq                  *uint64  `protobuf:"varint,6,opt,name=Seq" json:"Seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaLagCommand) Reset()         { *m = ReplicaLagCommand{} }
+func (m *ReplicaLagCommand) String() string { return proto.CompactTextString(m) }
+func (*ReplicaLagCommand) ProtoMessage()    {}
+func (*ReplicaLagCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aed0c02de55ead8, []int{105}
+}
+func (m *ReplicaLagCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaLagCommand.Unmarshal(m, b)
+}
+func (m *ReplicaLagCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaLagCommand.Marshal(b, m, deterministic)
+}
+func (m *ReplicaLagCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaLagCommand.Merge(m, src)
+}
+func (m *ReplicaLagCommand) XXX_Size() int {
+	return xxx_messageInfo_ReplicaLagCommand.Size(m)
+}
+func (m *ReplicaLagCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaLagCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaLagCommand proto.InternalMessageInfo
+
+func (m *ReplicaLagCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *ReplicaLagCommand) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
+	}
+	return ""
+}
+
+func (m *ReplicaLagCommand) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+func (m *ReplicaLagCommand) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *ReplicaLagCommand) GetLagging() bool {
+	if m != nil && m.Lagging != nil {
+		return *m.Lagging
+	}
+	return false
+}
+
+func (m *ReplicaLagCommand) GetSeq() uint64 {
+	if m != nil && m.Seq != nil {
+		return *m.Seq
+	}
+	return 0
+}
+
+var E_ReplicaLagCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ReplicaLagCommand)(nil),
+	Field:         178,
+	Name:          "proto.ReplicaLagCommand.command",
+	Tag:           "bytes,178,opt,name=command",
+	Filename:      "open_src/influx/meta/proto/meta.proto",
+}
+
 func init() {
 	proto.RegisterEnum("proto.Command_Type", Command_Type_name, Command_Type_value)
 	proto.RegisterType((*Data)(nil), "proto.Data")
@@ -5374,13 +6698,22 @@ func init() {
 	proto.RegisterType((*MeasurementInfo)(nil), "proto.MeasurementInfo")
 	proto.RegisterMapType((map[string]int32)(nil), "proto.MeasurementInfo.SchemaEntry")
 	proto.RegisterType((*RetentionPolicyInfo)(nil), "proto.RetentionPolicyInfo")
+	proto.RegisterType((*DownSampleCall)(nil), "proto.DownSampleCall")
+	proto.RegisterType((*DownSampleLevel)(nil), "proto.DownSampleLevel")
+	proto.RegisterType((*DownSamplePolicyInfo)(nil), "proto.DownSamplePolicyInfo")
 	proto.RegisterType((*ShardGroupInfo)(nil), "proto.ShardGroupInfo")
 	proto.RegisterType((*ShardInfo)(nil), "proto.ShardInfo")
+	proto.RegisterType((*ReplicaLag)(nil), "proto.ReplicaLag")
 	proto.RegisterType((*ShardKeyInfo)(nil), "proto.ShardKeyInfo")
+	proto.RegisterType((*ContinuousQueryInfo)(nil), "proto.ContinuousQueryInfo")
+	proto.RegisterType((*ContinuousQueryLease)(nil), "proto.ContinuousQueryLease")
 	proto.RegisterType((*SubscriptionInfo)(nil), "proto.SubscriptionInfo")
 	proto.RegisterType((*ShardOwner)(nil), "proto.ShardOwner")
 	proto.RegisterType((*UserInfo)(nil), "proto.UserInfo")
 	proto.RegisterType((*UserPrivilege)(nil), "proto.UserPrivilege")
+	proto.RegisterType((*RoleInfo)(nil), "proto.RoleInfo")
+	proto.RegisterType((*RoleGrant)(nil), "proto.RoleGrant")
+	proto.RegisterType((*RateLimitInfo)(nil), "proto.RateLimitInfo")
 	proto.RegisterType((*IndexRelation)(nil), "proto.IndexRelation")
 	proto.RegisterType((*IndexList)(nil), "proto.IndexList")
 	proto.RegisterType((*Command)(nil), "proto.Command")
@@ -5412,6 +6745,10 @@ func init() {
 	proto.RegisterType((*SetDataCommand)(nil), "proto.SetDataCommand")
 	proto.RegisterExtension(E_SetAdminPrivilegeCommand_Command)
 	proto.RegisterType((*SetAdminPrivilegeCommand)(nil), "proto.SetAdminPrivilegeCommand")
+	proto.RegisterExtension(E_CreateContinuousQueryCommand_Command)
+	proto.RegisterType((*CreateContinuousQueryCommand)(nil), "proto.CreateContinuousQueryCommand")
+	proto.RegisterExtension(E_DropContinuousQueryCommand_Command)
+	proto.RegisterType((*DropContinuousQueryCommand)(nil), "proto.DropContinuousQueryCommand")
 	proto.RegisterExtension(E_CreateSubscriptionCommand_Command)
 	proto.RegisterType((*CreateSubscriptionCommand)(nil), "proto.CreateSubscriptionCommand")
 	proto.RegisterExtension(E_DropSubscriptionCommand_Command)
@@ -5492,6 +6829,27 @@ func init() {
 	proto.RegisterType((*UpdatePtInfoCommand)(nil), "proto.UpdatePtInfoCommand")
 	proto.RegisterExtension(E_RemoveEventCommand_Command)
 	proto.RegisterType((*RemoveEventCommand)(nil), "proto.RemoveEventCommand")
+	proto.RegisterExtension(E_ContinuousQueryLeaseCommand_Command)
+	proto.RegisterType((*ContinuousQueryLeaseCommand)(nil), "proto.ContinuousQueryLeaseCommand")
+	proto.RegisterType((*ContinuousQueryReport)(nil), "proto.ContinuousQueryReport")
+	proto.RegisterExtension(E_ContinuousQueryReportCommand_Command)
+	proto.RegisterType((*ContinuousQueryReportCommand)(nil), "proto.ContinuousQueryReportCommand")
+	proto.RegisterExtension(E_CreateDownSamplePolicyCommand_Command)
+	proto.RegisterType((*CreateDownSamplePolicyCommand)(nil), "proto.CreateDownSamplePolicyCommand")
+	proto.RegisterExtension(E_DropDownSamplePolicyCommand_Command)
+	proto.RegisterType((*DropDownSamplePolicyCommand)(nil), "proto.DropDownSamplePolicyCommand")
+	proto.RegisterExtension(E_CreateRoleCommand_Command)
+	proto.RegisterType((*CreateRoleCommand)(nil), "proto.CreateRoleCommand")
+	proto.RegisterExtension(E_DropRoleCommand_Command)
+	proto.RegisterType((*DropRoleCommand)(nil), "proto.DropRoleCommand")
+	proto.RegisterExtension(E_SetUserRoleCommand_Command)
+	proto.RegisterType((*SetUserRoleCommand)(nil), "proto.SetUserRoleCommand")
+	proto.RegisterExtension(E_SetRolePrivilegeCommand_Command)
+	proto.RegisterType((*SetRolePrivilegeCommand)(nil), "proto.SetRolePrivilegeCommand")
+	proto.RegisterExtension(E_SetRateLimitCommand_Command)
+	proto.RegisterType((*SetRateLimitCommand)(nil), "proto.SetRateLimitCommand")
+	proto.RegisterExtension(E_ReplicaLagCommand_Command)
+	proto.RegisterType((*ReplicaLagCommand)(nil), "proto.ReplicaLagCommand")
 }
 
 func init() {
@@ -5499,252 +6857,307 @@ func init() {
 }
 
 var fileDescriptor_4aed0c02de55ead8 = []byte{
-	// 3947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x5d, 0x8f, 0x5c, 0xc7,
-	0x52, 0xea, 0x33, 0x33, 0xbb, 0x33, 0xbd, 0x9e, 0xdd, 0x75, 0xdb, 0x5e, 0x1f, 0x6f, 0xd6, 0xf6,
-	0xf8, 0x5c, 0x47, 0x59, 0x5d, 0xb8, 0x6b, 0x32, 0xba, 0xd7, 0xc9, 0x35, 0x37, 0xf7, 0x5e, 0xef,
-	0x8e, 0x63, 0x4f, 0xec, 0x5d, 0x0f, 0x67, 0x37, 0x44, 0x02, 0x09, 0x72, 0x76, 0xa7, 0x6d, 0x4f,
-	0xbc, 0xf3, 0xc1, 0x39, 0x67, 0xec, 0x75, 0x14, 0x14, 0x87, 0x48, 0xf0, 0x80, 0x78, 0x88, 0x50,
-	0x12, 0x82, 0x14, 0x3e, 0x42, 0x12, 0xe0, 0x01, 0x41, 0x9e, 0x00, 0xf1, 0x21, 0x11, 0x78, 0x40,
-	0xbc, 0xf2, 0x0a, 0xfc, 0x02, 0x90, 0x78, 0x8b, 0x78, 0x43, 0x55, 0xdd, 0x7d, 0xba, 0xfb, 0x7c,
-	0xed, 0xda, 0x92, 0xf3, 0x34, 0xd3, 0x55, 0xd5, 0xdd, 0x55, 0xd5, 0xd5, 0x55, 0x5d, 0xd5, 0x7d,
-	0xe8, 0xf3, 0xe3, 0x09, 0x1f, 0xfd, 0x6a, 0x14, 0xee, 0x5d, 0x1a, 0x8c, 0xee, 0xec, 0x4f, 0x0f,
-	0x2e, 0x0d, 0x79, 0x1c, 0x5c, 0x9a, 0x84, 0xe3, 0x78, 0x8c, 0x7f, 0xd7, 0xf0, 0x2f, 0xab, 0xe1,
-	0x8f, 0xf7, 0xd5, 0x0c, 0xad, 0x76, 0x82, 0x38, 0x60, 0x8c, 0x56, 0x77, 0x78, 0x38, 0x74, 0x49,
-	0xcb, 0x59, 0xad, 0xfa, 0xf8, 0x9f, 0x9d, 0xa4, 0xb5, 0xee, 0xa8, 0xcf, 0x0f, 0x5c, 0x07, 0x81,
-	0xa2, 0xc1, 0x56, 0x68, 0x63, 0x63, 0x7f, 0x1a, 0xc5, 0x3c, 0xec, 0x76, 0xdc, 0x0a, 0x62, 0x34,
-	0x80, 0x3d, 0x4f, 0x6b, 0x5b, 0xe3, 0x3e, 0x8f, 0xdc, 0x6a, 0xab, 0xb2, 0x3a, 0xd7, 0x5e, 0x10,
-	0xd3, 0xad, 0x01, 0xac, 0x3b, 0xba, 0x33, 0xf6, 0x05, 0x96, 0xbd, 0x48, 0x1b, 0x30, 0xed, 0x6e,
-	0x10, 0xf1, 0xc8, 0xad, 0x21, 0xe9, 0x09, 0x49, 0xaa, 0xe0, 0x48, 0xae, 0xa9, 0x60, 0xe4, 0xd7,
-	0x23, 0x1e, 0x46, 0xee, 0x8c, 0x35, 0x32, 0xc0, 0xc4, 0xc8, 0x88, 0x05, 0xf6, 0x36, 0x83, 0x03,
-	0x9c, 0xaf, 0xe3, 0xce, 0x0a, 0xf6, 0x12, 0x00, 0x5b, 0xa5, 0x0b, 0x9b, 0xc1, 0xc1, 0xf6, 0xbd,
-	0x20, 0xec, 0x5f, 0x0f, 0xc7, 0xd3, 0x49, 0xb7, 0xe3, 0xd6, 0x91, 0x26, 0x0d, 0x66, 0xe7, 0x28,
-	0x55, 0xa0, 0x6e, 0xc7, 0x6d, 0x20, 0x91, 0x01, 0x61, 0xdf, 0x13, 0x12, 0x08, 0x61, 0xa9, 0xc5,
-	0x92, 0x82, 0xfb, 0x9a, 0x02, 0xc8, 0x37, 0xb9, 0x22, 0x9f, 0xcb, 0xd7, 0x8d, 0xa6, 0x60, 0x1e,
-	0x3d, 0x26, 0x75, 0xda, 0x8b, 0xb7, 0xa6, 0x43, 0x77, 0xbe, 0xe5, 0xac, 0x36, 0x7d, 0x0b, 0xc6,
-	0x2e, 0xd1, 0x99, 0x5e, 0xfc, 0x8b, 0x03, 0xfe, 0xd0, 0x5d, 0xc0, 0xf1, 0x4e, 0x1b, 0xd3, 0xaf,
-	0x09, 0xcc, 0xb5, 0x51, 0x1c, 0x3e, 0xf2, 0x25, 0x19, 0x0c, 0x8a, 0x3d, 0x7b, 0x3c, 0x84, 0x59,
-	0xdc, 0xc5, 0x16, 0x81, 0x41, 0x4d, 0x98, 0x54, 0x10, 0xae, 0xb4, 0x52, 0xd0, 0xf1, 0x44, 0x41,
-	0x26, 0x58, 0x2a, 0x08, 0x41, 0xdd, 0x8e, 0xcb, 0x12, 0x05, 0x49, 0x08, 0xcc, 0xb6, 0x19, 0x1c,
-	0x5c, 0x7b, 0xc0, 0x47, 0xf1, 0xed, 0x49, 0xb7, 0xef, 0x9e, 0x68, 0x91, 0xd5, 0xaa, 0x6f, 0xc1,
-	0x60, 0xb6, 0x9d, 0xe0, 0x3e, 0xbf, 0xfd, 0x80, 0x87, 0xd7, 0x46, 0xc1, 0xee, 0x3e, 0xef, 0xbb,
-	0x27, 0x5b, 0x64, 0xb5, 0xee, 0xa7, 0xc1, 0xec, 0x15, 0xda, 0xdc, 0x1c, 0xdc, 0x0d, 0x83, 0x98,
-	0x63, 0xef, 0xc8, 0x3d, 0x65, 0xc9, 0x6c, 0xe2, 0x50, 0x97, 0x36, 0xf5, 0xf2, 0x6b, 0x74, 0xce,
-	0xd0, 0x08, 0x5b, 0xa4, 0x95, 0xfb, 0xfc, 0x91, 0x4b, 0x5a, 0x64, 0xb5, 0xe1, 0xc3, 0x5f, 0xb0,
-	0xae, 0x07, 0xc1, 0xfe, 0x94, 0xbb, 0x4e, 0x8b, 0x98, 0x4b, 0xb9, 0xde, 0x13, 0xe3, 0x09, 0xec,
-	0x15, 0xe7, 0x65, 0xe2, 0x5d, 0xa0, 0xb3, 0xbd, 0xf8, 0xf6, 0xc3, 0x11, 0x0f, 0xd9, 0x12, 0x9d,
-	0x91, 0x96, 0x26, 0xf6, 0x8d, 0x6c, 0x79, 0xbf, 0x04, 0x4b, 0x03, 0xfd, 0xd8, 0x45, 0x5a, 0x43,
-	0x52, 0x24, 0x98, 0x6b, 0xcf, 0xcb, 0x71, 0xe5, 0x00, 0x7e, 0x2d, 0x19, 0x67, 0x3b, 0x0e, 0xe2,
-	0x69, 0x84, 0x5b, 0xad, 0xe9, 0xcb, 0x16, 0xec, 0xca, 0x5e, 0xdc, 0xed, 0xe3, 0x36, 0x6b, 0xfa,
-	0xf8, 0xdf, 0xfb, 0x1e, 0xad, 0x2b, 0xae, 0xd8, 0x05, 0x5a, 0xed, 0xec, 0xf6, 0x62, 0x97, 0xa0,
-	0x32, 0x9a, 0xc9, 0xe0, 0xc8, 0x32, 0xa2, 0xbc, 0xbf, 0x22, 0xb4, 0xae, 0x2c, 0x8c, 0xcd, 0x53,
-	0x27, 0xe1, 0xd5, 0xe9, 0x76, 0x60, 0xfc, 0x1b, 0xe3, 0x28, 0xc6, 0x59, 0x1b, 0x3e, 0xfe, 0x67,
-	0x2e, 0x9d, 0xf5, 0x7b, 0x1b, 0x57, 0xfb, 0xfd, 0xd0, 0xad, 0xa1, 0x7e, 0x54, 0x13, 0x30, 0x3b,
-	0x1b, 0x3d, 0xec, 0x50, 0x11, 0x18, 0xd9, 0x34, 0xf8, 0xaf, 0xb6, 0x9c, 0xd5, 0x4a, 0xc2, 0xff,
-	0x49, 0x5a, 0xbb, 0xb5, 0x33, 0x18, 0x72, 0x77, 0x46, 0x78, 0x10, 0x6c, 0x80, 0xe5, 0x5c, 0x1f,
-	0x47, 0xd1, 0x60, 0x82, 0x93, 0xcc, 0xe2, 0xdc, 0x06, 0xc4, 0xfb, 0x19, 0x5a, 0x57, 0x1b, 0x87,
-	0x9d, 0xa7, 0xce, 0xd6, 0x40, 0x2a, 0x2f, 0xb3, 0x61, 0x9c, 0xad, 0x81, 0xf7, 0x0d, 0xa1, 0xc7,
-	0x4c, 0x97, 0x01, 0x32, 0x6d, 0x05, 0x43, 0x8e, 0x7d, 0x1a, 0x3e, 0xfe, 0x67, 0x97, 0xe9, 0x52,
-	0x87, 0xdf, 0x09, 0xa6, 0xfb, 0xb1, 0xcf, 0x63, 0x3e, 0x8a, 0x07, 0xe3, 0x51, 0x6f, 0xbc, 0x3f,
-	0xd8, 0x7b, 0x24, 0x25, 0x2f, 0xc0, 0xb2, 0x1b, 0xf4, 0xb8, 0x0d, 0x1a, 0xf0, 0xc8, 0xad, 0xa0,
-	0xb2, 0x97, 0x25, 0x33, 0xa9, 0x2e, 0xc8, 0x57, 0xb6, 0x13, 0x6b, 0xd1, 0xb9, 0xcd, 0x20, 0xbc,
-	0xdf, 0xe1, 0xfb, 0x3c, 0xe6, 0x7d, 0xd4, 0x6c, 0xdd, 0x37, 0x41, 0xec, 0x12, 0xad, 0xa3, 0x6f,
-	0xb9, 0xc9, 0x1f, 0xb9, 0x33, 0x68, 0x84, 0xca, 0x23, 0x2a, 0x30, 0x8e, 0x9d, 0x10, 0x79, 0x1f,
-	0x10, 0x7a, 0x22, 0x35, 0xfb, 0xf6, 0x84, 0xef, 0x19, 0x0a, 0x20, 0x89, 0x02, 0x96, 0x69, 0xbd,
-	0x33, 0x0d, 0x03, 0xa0, 0x44, 0x0b, 0xaf, 0xf8, 0x49, 0x9b, 0xad, 0x51, 0xa6, 0x3d, 0x5f, 0x42,
-	0x55, 0x41, 0xaa, 0x1c, 0x0c, 0x8c, 0xe5, 0xf3, 0xc9, 0xfe, 0x60, 0x2f, 0xd8, 0x72, 0xab, 0xe8,
-	0x42, 0x92, 0xb6, 0xf7, 0x97, 0x0e, 0x5d, 0xd8, 0xe4, 0x41, 0x34, 0x0d, 0xf9, 0x50, 0x6e, 0xc5,
-	0xdc, 0x05, 0x79, 0x91, 0x36, 0x94, 0x1c, 0x60, 0xf3, 0x95, 0x22, 0x69, 0x35, 0x15, 0xbb, 0x42,
-	0x67, 0xb6, 0xf7, 0xee, 0xf1, 0x61, 0x20, 0x17, 0xc0, 0x53, 0x5b, 0xdf, 0x9e, 0x6e, 0x4d, 0x10,
-	0x49, 0xcf, 0x27, 0x1a, 0x69, 0xed, 0x57, 0xb3, 0xda, 0xff, 0x11, 0x9d, 0x1f, 0x80, 0xe3, 0xf2,
-	0xf9, 0x3e, 0x4a, 0xa9, 0xa2, 0xd2, 0x49, 0x39, 0x4b, 0xd7, 0x44, 0xfa, 0x29, 0xda, 0xe5, 0x1f,
-	0xd2, 0x39, 0x63, 0xda, 0x1c, 0xf7, 0x72, 0xd2, 0x74, 0x2f, 0x35, 0xd3, 0x9b, 0x7c, 0x54, 0xcd,
-	0xac, 0x62, 0xa1, 0xd6, 0xec, 0x55, 0x74, 0x8e, 0xb4, 0x8a, 0xce, 0x91, 0x56, 0xd1, 0x31, 0x57,
-	0x91, 0x5d, 0xa1, 0xc7, 0x0c, 0xad, 0x2a, 0x55, 0x2c, 0xe5, 0x2b, 0xdc, 0xb7, 0x68, 0xd9, 0x4b,
-	0x74, 0x4e, 0xcf, 0xa6, 0x82, 0xf5, 0x29, 0x73, 0x6d, 0x45, 0x00, 0x81, 0x9e, 0x26, 0x25, 0x78,
-	0xf8, 0xed, 0xe9, 0x6e, 0xb4, 0x17, 0x0e, 0x26, 0x62, 0x01, 0x66, 0x2d, 0x0f, 0x6f, 0xe2, 0x84,
-	0x87, 0xb7, 0xa8, 0xd3, 0x4b, 0x5c, 0xcf, 0x2e, 0x71, 0x8b, 0xce, 0xdd, 0x18, 0xc7, 0x89, 0x6a,
-	0x1a, 0xa8, 0x1a, 0x13, 0x04, 0x21, 0xeb, 0x8d, 0x20, 0x1c, 0x26, 0x24, 0x14, 0x49, 0x2c, 0x18,
-	0xe8, 0x59, 0x87, 0xc1, 0x84, 0x72, 0x4e, 0xe8, 0x39, 0x8b, 0x01, 0x7d, 0x68, 0x68, 0xe4, 0x1e,
-	0xb3, 0xf4, 0x61, 0x04, 0x54, 0xd4, 0x87, 0x41, 0xe9, 0x7d, 0x4d, 0xe8, 0xbc, 0xad, 0xaf, 0x8c,
-	0xfb, 0x5e, 0xa1, 0x8d, 0xed, 0x38, 0x08, 0x63, 0x74, 0xb1, 0xc2, 0x20, 0x34, 0x00, 0xdc, 0xf5,
-	0xb5, 0x51, 0x1f, 0x71, 0xc2, 0x0c, 0x54, 0x13, 0xfa, 0x49, 0xa5, 0x5c, 0x8d, 0xa5, 0xc7, 0xd6,
-	0x00, 0xb6, 0x4a, 0x67, 0x70, 0x5e, 0xb5, 0xee, 0x8b, 0xe6, 0xe2, 0x21, 0x9f, 0x12, 0x0f, 0x1a,
-	0xdd, 0x09, 0xa7, 0xa3, 0xbd, 0x40, 0x8c, 0x34, 0x83, 0x2e, 0xc3, 0x04, 0x79, 0xbf, 0x43, 0xe4,
-	0x46, 0xcf, 0xe5, 0xff, 0x1c, 0xad, 0x63, 0xfc, 0xeb, 0x76, 0x84, 0x13, 0x68, 0xae, 0x3b, 0x2e,
-	0xf1, 0x13, 0x18, 0xec, 0xa3, 0xcd, 0x81, 0x30, 0xe2, 0x86, 0x0f, 0x7f, 0x11, 0x12, 0x1c, 0x20,
-	0xcf, 0x00, 0x09, 0x0e, 0xf0, 0xe0, 0x3a, 0xe0, 0x10, 0xab, 0xc4, 0xc1, 0x75, 0xc0, 0x31, 0x50,
-	0xa9, 0x73, 0x89, 0x08, 0x3c, 0xaa, 0xe9, 0xf9, 0xf4, 0x98, 0xe9, 0x5f, 0x60, 0x17, 0x24, 0x4e,
-	0x17, 0x82, 0x68, 0x43, 0xfb, 0x57, 0x1c, 0xf9, 0xd1, 0x44, 0x6c, 0xd9, 0x86, 0x8f, 0xff, 0x01,
-	0xb6, 0x7d, 0x17, 0xcf, 0xbd, 0x70, 0x98, 0xc1, 0xff, 0xde, 0xaf, 0xd0, 0xc5, 0xb4, 0x71, 0xe6,
-	0xee, 0x5e, 0x46, 0xab, 0x9b, 0x70, 0xec, 0x92, 0xc1, 0x16, 0xfe, 0x83, 0xc5, 0x75, 0x78, 0x14,
-	0x0f, 0x46, 0xd2, 0xe9, 0x54, 0x90, 0x07, 0x0b, 0xe6, 0x5d, 0xa4, 0x14, 0x79, 0x2a, 0x3f, 0x72,
-	0x7c, 0x44, 0x68, 0x5d, 0x9d, 0x85, 0x8b, 0xa6, 0xbf, 0x11, 0x44, 0xf7, 0x92, 0x58, 0x1f, 0x44,
-	0xf7, 0xc0, 0x2d, 0x5d, 0xed, 0x0f, 0xa5, 0x8a, 0xeb, 0xbe, 0x68, 0xc0, 0x14, 0xfe, 0x43, 0x18,
-	0x4b, 0x3a, 0x4a, 0xd9, 0x62, 0xdf, 0xa7, 0xb4, 0x17, 0x0e, 0x1e, 0x0c, 0xf6, 0xf9, 0x5d, 0x9e,
-	0xf6, 0x8f, 0x40, 0x90, 0x20, 0x7d, 0x83, 0xce, 0xeb, 0xd2, 0xa6, 0x85, 0x44, 0x2f, 0x26, 0x03,
-	0xb6, 0x64, 0x30, 0x69, 0x83, 0x65, 0x26, 0x84, 0xc8, 0x69, 0xcd, 0xd7, 0x00, 0xef, 0x7d, 0x42,
-	0x9b, 0x96, 0x23, 0x06, 0x7b, 0xf0, 0x07, 0x7d, 0x1c, 0xa6, 0xe9, 0xc3, 0x5f, 0x80, 0xdc, 0x1e,
-	0xf4, 0xe5, 0x39, 0x0a, 0xfe, 0xc2, 0x98, 0xd8, 0x09, 0x35, 0x22, 0x14, 0xac, 0x01, 0xec, 0xe7,
-	0x28, 0xc5, 0xc6, 0xad, 0x41, 0x14, 0xab, 0xac, 0x65, 0xd1, 0xdc, 0x9e, 0x80, 0xf0, 0x0d, 0x1a,
-	0xef, 0x82, 0x1c, 0x0f, 0x5a, 0x98, 0x23, 0xc1, 0x1f, 0x69, 0x3d, 0xa2, 0xe1, 0xfd, 0x47, 0x83,
-	0xce, 0x6e, 0x8c, 0x87, 0xc3, 0x60, 0xd4, 0x67, 0x2f, 0xd0, 0x6a, 0x0c, 0x66, 0x04, 0x3c, 0xce,
-	0x27, 0x51, 0x4e, 0x62, 0xd7, 0xc0, 0xaa, 0x7c, 0x24, 0xf0, 0x3e, 0x6d, 0x08, 0x83, 0x63, 0x67,
-	0xe8, 0xa9, 0x8d, 0x90, 0x07, 0x31, 0x57, 0x6a, 0x91, 0xc4, 0x8b, 0x15, 0x76, 0x9a, 0x9e, 0xe8,
-	0x84, 0xe3, 0x49, 0x1a, 0x51, 0x65, 0x2d, 0xba, 0x22, 0xfa, 0xa4, 0x62, 0x89, 0xa2, 0xa8, 0xb1,
-	0x73, 0x74, 0x19, 0xba, 0x16, 0xe0, 0x67, 0xd8, 0x45, 0xda, 0xda, 0xe6, 0x71, 0xfe, 0x41, 0x48,
-	0x51, 0xcd, 0xc2, 0x3c, 0xaf, 0x4f, 0xfa, 0xc5, 0xf3, 0xd4, 0xd9, 0x73, 0xf4, 0xb4, 0xe0, 0x44,
-	0x3b, 0x2f, 0x85, 0x6c, 0x00, 0x52, 0x38, 0x9a, 0x2c, 0x92, 0xb2, 0x53, 0xf4, 0xb8, 0xe8, 0x09,
-	0xf6, 0xa2, 0xc0, 0x4d, 0x76, 0x82, 0x2e, 0x00, 0xe3, 0x26, 0x70, 0x1e, 0x68, 0x05, 0x1f, 0x26,
-	0x78, 0x01, 0xf4, 0xb3, 0xcd, 0xe3, 0xc4, 0x62, 0x14, 0x62, 0x91, 0x31, 0x3a, 0x0f, 0xd2, 0x05,
-	0x71, 0xa0, 0x60, 0xc7, 0xd9, 0x0a, 0x75, 0xb7, 0x79, 0x8c, 0x36, 0x9f, 0xe9, 0xc1, 0xd8, 0x59,
-	0x7a, 0x46, 0xca, 0x61, 0x6c, 0x6e, 0x85, 0x3e, 0x85, 0x92, 0x84, 0xe3, 0x49, 0x1e, 0x72, 0x49,
-	0xaf, 0xa0, 0xca, 0xe8, 0x14, 0xca, 0xb5, 0x17, 0xd7, 0x44, 0x9d, 0x01, 0x94, 0x90, 0x29, 0x8d,
-	0x5a, 0x06, 0x94, 0xd0, 0x5b, 0x7a, 0xc0, 0xe7, 0x34, 0x2a, 0xdd, 0x6b, 0x85, 0x2d, 0x51, 0xb6,
-	0xcd, 0xe3, 0x74, 0x97, 0xb3, 0xec, 0x24, 0x5d, 0x44, 0xde, 0x61, 0x0d, 0x14, 0xf4, 0x1c, 0x08,
-	0x8c, 0xe1, 0x52, 0xda, 0x96, 0x18, 0x54, 0xa1, 0xcf, 0x83, 0xc0, 0x82, 0x3b, 0xed, 0x8c, 0x14,
-	0xf2, 0x3b, 0x60, 0x3c, 0xd0, 0x37, 0x65, 0x14, 0xf6, 0x10, 0x2f, 0x80, 0xc2, 0x95, 0x5a, 0x92,
-	0x13, 0x83, 0xc2, 0xbe, 0x08, 0x5c, 0x5d, 0xdd, 0x8f, 0x79, 0xa8, 0x1c, 0xf0, 0xc6, 0xb0, 0xbf,
-	0xd8, 0x86, 0x85, 0xf6, 0xc5, 0x94, 0x83, 0xd1, 0x5d, 0x45, 0xfc, 0x7d, 0x58, 0x68, 0xc9, 0x0d,
-	0x9e, 0xbb, 0x14, 0xe2, 0x07, 0x80, 0xf0, 0xf9, 0x64, 0x1c, 0xc6, 0x22, 0x46, 0x29, 0xc4, 0x65,
-	0x50, 0x46, 0x2f, 0x9c, 0x8e, 0xb8, 0x08, 0xaf, 0x0a, 0xfe, 0x43, 0xb0, 0x68, 0x60, 0xdd, 0x60,
-	0xc9, 0x66, 0xfb, 0x0a, 0x5b, 0xa6, 0x4b, 0xa0, 0xae, 0x1c, 0xa6, 0x7f, 0x1e, 0x98, 0x86, 0x90,
-	0xea, 0x07, 0x23, 0x6d, 0x3b, 0x3f, 0x62, 0x2e, 0x3d, 0x89, 0xd3, 0xab, 0x53, 0x80, 0xc2, 0xbc,
-	0xa2, 0x37, 0x80, 0x0e, 0xf5, 0x0a, 0xf9, 0x63, 0xd8, 0xa2, 0x86, 0x8a, 0xc1, 0x93, 0x43, 0x38,
-	0x53, 0xf8, 0x9f, 0xe8, 0x25, 0x80, 0xe5, 0x14, 0x29, 0x96, 0x42, 0xfe, 0x14, 0xe4, 0x13, 0xca,
-	0xc5, 0x94, 0x57, 0xc1, 0xaf, 0x02, 0x5c, 0x74, 0xb2, 0xe0, 0xeb, 0x5a, 0x83, 0x22, 0x5d, 0x54,
-	0x88, 0x0d, 0xe8, 0xe0, 0xf3, 0xe1, 0xf8, 0x81, 0xdd, 0xa1, 0xf3, 0xdd, 0x7a, 0xbd, 0xbf, 0xf8,
-	0xf8, 0xf1, 0xe3, 0xc7, 0x8e, 0xf7, 0xd8, 0x29, 0xf0, 0x50, 0xb9, 0x81, 0xa7, 0x43, 0x17, 0xb2,
-	0x59, 0x17, 0x39, 0x24, 0x85, 0x4a, 0x77, 0x81, 0xa4, 0x51, 0x9d, 0x4f, 0xa7, 0x43, 0x8c, 0xbf,
-	0x4d, 0xdf, 0x80, 0xb0, 0xe7, 0x69, 0x65, 0xfb, 0xfe, 0x00, 0x23, 0x56, 0x41, 0x2e, 0x01, 0xf8,
-	0xf6, 0xab, 0x74, 0x76, 0x4f, 0xf2, 0x3a, 0x6f, 0xbb, 0x62, 0xf7, 0x2e, 0x76, 0x5d, 0x51, 0xd0,
-	0x3c, 0xf9, 0x7c, 0xd5, 0xd9, 0x1b, 0xe7, 0x3a, 0xe2, 0x3c, 0xf9, 0xdb, 0x9d, 0xe2, 0x29, 0xef,
-	0x59, 0x7a, 0xc8, 0x19, 0x50, 0x4f, 0xf8, 0x3f, 0xa4, 0xdc, 0xc3, 0x97, 0x86, 0xd5, 0xdc, 0x25,
-	0x70, 0x9e, 0x74, 0x09, 0xf0, 0xd8, 0x28, 0xc2, 0x43, 0x4f, 0x9e, 0x18, 0x34, 0xa0, 0xbd, 0x59,
-	0x2c, 0xe6, 0x00, 0xc5, 0xfc, 0x8e, 0xa5, 0xd9, 0x7c, 0x29, 0xb4, 0xbc, 0x9f, 0x90, 0xb2, 0x78,
-	0x55, 0x2a, 0xad, 0x5a, 0x04, 0xc7, 0x58, 0x84, 0x9b, 0xc5, 0xdc, 0xbd, 0x85, 0xdc, 0x5d, 0x30,
-	0x16, 0xe1, 0x30, 0xde, 0xbe, 0x20, 0x87, 0xc7, 0xca, 0x27, 0xe6, 0xf0, 0x17, 0x8a, 0x39, 0xbc,
-	0x8f, 0x1c, 0xbe, 0xa0, 0x8c, 0xfa, 0x90, 0x99, 0x35, 0x9f, 0x7f, 0x5d, 0x29, 0x8f, 0xd6, 0x4f,
-	0xca, 0x23, 0x1c, 0xac, 0xb7, 0xf8, 0x43, 0x79, 0x90, 0xc2, 0x0a, 0x90, 0x6c, 0x5a, 0xa9, 0x69,
-	0x35, 0x55, 0x60, 0x30, 0x53, 0xcd, 0x9a, 0x5d, 0x30, 0x28, 0x48, 0x5b, 0x67, 0x0a, 0x8b, 0x0f,
-	0x98, 0xe6, 0xdd, 0xe7, 0x52, 0x01, 0x58, 0x3c, 0xc2, 0x34, 0x2f, 0x01, 0x65, 0xd3, 0x3c, 0x72,
-	0x78, 0x9a, 0x47, 0x8e, 0x9c, 0xe6, 0x91, 0xfc, 0x34, 0xaf, 0xcc, 0xfa, 0xf7, 0x2d, 0xeb, 0x2f,
-	0x5b, 0x0f, 0xbd, 0x72, 0xff, 0x4e, 0x0a, 0x4f, 0x51, 0xa5, 0x8b, 0xb6, 0x44, 0x67, 0xac, 0xc2,
-	0xd6, 0x8c, 0xde, 0xba, 0x10, 0xa6, 0xa2, 0x38, 0x18, 0x4e, 0x64, 0x36, 0xa8, 0x01, 0x98, 0x47,
-	0xc2, 0x34, 0x98, 0x48, 0x55, 0x45, 0xcd, 0x3c, 0x01, 0xb4, 0x6f, 0x14, 0x8b, 0x36, 0x44, 0xd1,
-	0xce, 0x59, 0x1b, 0x3b, 0xc3, 0xb0, 0x96, 0xea, 0xef, 0x48, 0xe1, 0xf1, 0xef, 0xa9, 0xa4, 0xf2,
-	0x64, 0x36, 0xa7, 0x2a, 0xd5, 0xe2, 0x36, 0xc2, 0x82, 0x95, 0x71, 0x3f, 0xb2, 0xb8, 0x2f, 0x60,
-	0x4c, 0x73, 0xff, 0x17, 0x24, 0xe7, 0x7c, 0xfa, 0x6c, 0x52, 0xad, 0xf6, 0x7a, 0x31, 0xd7, 0xbf,
-	0x86, 0x5c, 0xbb, 0x96, 0xce, 0x0d, 0x86, 0x34, 0xbf, 0x77, 0x33, 0xe7, 0xe6, 0xdc, 0xf0, 0xf4,
-	0xd3, 0xe2, 0xa9, 0x42, 0x9c, 0x6a, 0xc9, 0xf0, 0x8c, 0xb9, 0x13, 0xbd, 0x9b, 0x73, 0x16, 0x3f,
-	0xaa, 0x5e, 0xca, 0x24, 0x8d, 0x2c, 0x49, 0x33, 0x53, 0x68, 0x06, 0xbe, 0x22, 0xb9, 0xc7, 0x7e,
-	0xb0, 0x29, 0xa0, 0x1f, 0x69, 0x3e, 0x92, 0xb6, 0x65, 0x6f, 0x4e, 0x59, 0x16, 0x5a, 0x49, 0x65,
-	0xa1, 0x65, 0xf1, 0x3c, 0xb6, 0xe2, 0x79, 0x0e, 0x4b, 0x9a, 0xe7, 0x30, 0x9d, 0x90, 0xb0, 0xf3,
-	0xe2, 0x2a, 0x4e, 0x16, 0xbb, 0xe7, 0x8c, 0xdb, 0x1c, 0x1f, 0x11, 0xed, 0x9f, 0x14, 0x4f, 0x3c,
-	0xc5, 0x89, 0x4f, 0x19, 0x11, 0x42, 0x0f, 0xac, 0xe7, 0xfc, 0x98, 0x14, 0x67, 0x3c, 0xa5, 0xca,
-	0x4a, 0x8c, 0xd7, 0x31, 0x8c, 0xb7, 0xdd, 0x2d, 0xe6, 0xe7, 0x01, 0xf2, 0x73, 0x5e, 0xf3, 0x93,
-	0x3b, 0xa7, 0xe6, 0xec, 0xff, 0x48, 0x49, 0xb6, 0x55, 0x58, 0x0b, 0x2d, 0x5a, 0xbf, 0xd5, 0xec,
-	0x71, 0x47, 0xd4, 0x90, 0x32, 0x47, 0x1a, 0x55, 0x93, 0xa9, 0x96, 0xd4, 0x64, 0x6a, 0xd9, 0x9a,
-	0x4c, 0xfb, 0xb5, 0x62, 0xd1, 0x1f, 0xa1, 0xe8, 0x2d, 0xdb, 0x27, 0x66, 0x85, 0xd2, 0xb2, 0xff,
-	0x03, 0x29, 0x4c, 0x25, 0x9f, 0x9d, 0xe4, 0x65, 0x7e, 0xf1, 0x6d, 0xdb, 0x2f, 0xe6, 0xb3, 0xa6,
-	0xf9, 0xff, 0x67, 0x52, 0x90, 0xed, 0x02, 0xa7, 0x37, 0x76, 0x76, 0x7a, 0x78, 0xcd, 0x23, 0x4d,
-	0x4a, 0xb5, 0xcd, 0x6b, 0x26, 0xa1, 0xfc, 0xd4, 0x35, 0x13, 0x62, 0x84, 0x78, 0xaa, 0x09, 0xda,
-	0xf0, 0x81, 0x41, 0xe1, 0xe7, 0xf1, 0x7f, 0xd9, 0x81, 0xfe, 0x9d, 0x9c, 0x03, 0x7d, 0x8a, 0x45,
-	0x2d, 0xc5, 0x87, 0xa4, 0x20, 0x31, 0x3f, 0x4c, 0x8a, 0x7c, 0x5e, 0xcb, 0xf8, 0xfa, 0xf5, 0x82,
-	0x44, 0x23, 0x97, 0xaf, 0x37, 0x68, 0x53, 0xe1, 0x30, 0x1f, 0x4b, 0xee, 0xec, 0x80, 0x95, 0x63,
-	0xf2, 0xce, 0x6e, 0x85, 0x36, 0x10, 0x29, 0xeb, 0x95, 0x18, 0xde, 0x13, 0x80, 0xbe, 0x85, 0xab,
-	0x18, 0xb7, 0x70, 0xde, 0xb8, 0xa0, 0xa4, 0x90, 0xae, 0xd2, 0x96, 0x49, 0xf2, 0xae, 0x25, 0x49,
-	0xee, 0x70, 0x5a, 0x92, 0x49, 0x41, 0xa1, 0x22, 0x33, 0xe1, 0xf5, 0xe2, 0x09, 0x1f, 0x93, 0x9c,
-	0x19, 0x0b, 0x75, 0xf7, 0x2a, 0x1c, 0x3c, 0xa3, 0xc9, 0x78, 0x14, 0x71, 0x98, 0xe4, 0xf6, 0x4d,
-	0x9c, 0xa4, 0xee, 0x3b, 0xb7, 0x6f, 0x82, 0x52, 0xae, 0x85, 0xe1, 0x38, 0x94, 0xe5, 0x5d, 0xd1,
-	0xd0, 0x4f, 0x1e, 0x44, 0x81, 0x57, 0x34, 0xbc, 0x7f, 0x24, 0x79, 0x85, 0x94, 0x6f, 0xc5, 0xbc,
-	0x4b, 0x82, 0xcd, 0x7b, 0x42, 0x17, 0x67, 0xb4, 0x93, 0x2d, 0x54, 0xfd, 0x9d, 0x6c, 0xc1, 0x27,
-	0xa3, 0xf5, 0x92, 0x40, 0xfc, 0x1b, 0x62, 0xa6, 0xd3, 0xa6, 0x47, 0x30, 0x86, 0xd2, 0xf3, 0xbc,
-	0x53, 0x52, 0x42, 0xca, 0x3d, 0x7c, 0x94, 0xa4, 0x65, 0xef, 0x13, 0xcb, 0x91, 0x16, 0x8e, 0xab,
-	0x67, 0xff, 0x57, 0x52, 0x58, 0xa2, 0x02, 0xad, 0x8b, 0xa2, 0x8a, 0x28, 0x16, 0x57, 0x7c, 0xd5,
-	0x04, 0x8c, 0xb8, 0x70, 0xe8, 0xcb, 0x9d, 0xa3, 0x9a, 0x70, 0x38, 0xeb, 0xec, 0xca, 0x64, 0x07,
-	0x8f, 0x9d, 0xa2, 0x85, 0x87, 0xb6, 0x09, 0xc2, 0xc5, 0xd2, 0xca, 0x56, 0x59, 0x3c, 0xfc, 0x2d,
-	0x62, 0xf9, 0xd4, 0x02, 0x2e, 0xb5, 0x28, 0x5f, 0x92, 0xc3, 0x0b, 0x6a, 0x4f, 0x9c, 0x61, 0xfa,
-	0xc5, 0xfc, 0xfd, 0x36, 0xb1, 0x52, 0xcc, 0xc3, 0xa6, 0xd6, 0x8c, 0x7e, 0x43, 0x8a, 0x6b, 0x7a,
-	0xa8, 0xc0, 0x75, 0x63, 0xcd, 0x65, 0xcb, 0x50, 0xa0, 0x63, 0x2a, 0x30, 0x61, 0xba, 0x62, 0x44,
-	0xbb, 0xa3, 0xd5, 0x75, 0xd8, 0x45, 0xea, 0x74, 0x7d, 0xcc, 0x2e, 0x8b, 0xee, 0x6c, 0x9d, 0xae,
-	0x5f, 0x16, 0xb6, 0x3f, 0x24, 0xd6, 0x91, 0xa5, 0x48, 0x26, 0x2d, 0xf9, 0x3f, 0x91, 0x6c, 0xbd,
-	0xf2, 0x5b, 0x94, 0xb8, 0x6c, 0xbf, 0x7e, 0x64, 0xef, 0xd7, 0x34, 0x97, 0x5a, 0x86, 0x7f, 0x4b,
-	0x76, 0x4c, 0x67, 0xb7, 0x17, 0x5b, 0x15, 0x45, 0x60, 0x79, 0x27, 0x88, 0xee, 0xeb, 0x8b, 0x26,
-	0xd1, 0x4a, 0x2e, 0xa0, 0xfa, 0xf2, 0x59, 0x98, 0x6c, 0x81, 0x3f, 0xe9, 0xac, 0x4b, 0x41, 0x9c,
-	0xce, 0x3a, 0xb4, 0x7b, 0x3b, 0xf2, 0x6a, 0xd9, 0xe9, 0xed, 0x68, 0x87, 0x5b, 0x33, 0x1c, 0x6e,
-	0xd9, 0x9e, 0xf9, 0x38, 0x6f, 0xcf, 0x64, 0xf8, 0xd4, 0xc2, 0xfc, 0x2f, 0xc9, 0x29, 0x15, 0x1f,
-	0x96, 0x57, 0xe6, 0xae, 0xca, 0x11, 0xf2, 0x4a, 0xcc, 0x99, 0x27, 0xfb, 0x03, 0x71, 0xf7, 0x2a,
-	0xef, 0x50, 0x13, 0x00, 0x6b, 0xc9, 0x5b, 0xf0, 0xf5, 0xf1, 0x74, 0xd4, 0x57, 0x47, 0x48, 0x13,
-	0xd4, 0xde, 0x28, 0x16, 0xfc, 0xf7, 0x88, 0x95, 0xf8, 0x64, 0x64, 0xd2, 0x22, 0xff, 0x37, 0xc9,
-	0x2d, 0x83, 0x3f, 0x95, 0xd0, 0x2d, 0x3a, 0x67, 0x98, 0xbb, 0x5c, 0x48, 0x13, 0xc4, 0x5e, 0xa6,
-	0xcd, 0x57, 0x07, 0x7c, 0xbf, 0xbf, 0x33, 0x16, 0xbb, 0x43, 0xde, 0x96, 0x31, 0xc9, 0x27, 0xe2,
-	0x04, 0x1f, 0xbe, 0x4d, 0xd8, 0xbe, 0x56, 0x2c, 0xec, 0x27, 0xc4, 0xca, 0x99, 0x72, 0xa4, 0xd1,
-	0xe2, 0x76, 0xe9, 0x9c, 0x31, 0x09, 0x2c, 0x01, 0x36, 0x8d, 0xfd, 0xa6, 0x01, 0x09, 0x36, 0x39,
-	0x13, 0xd5, 0x7c, 0x0d, 0xf0, 0x5e, 0x92, 0x97, 0x78, 0xb9, 0xf7, 0xd2, 0xcb, 0xe9, 0x7b, 0x69,
-	0x7d, 0x27, 0xed, 0x7d, 0x46, 0xe8, 0xbc, 0x7d, 0x6d, 0xff, 0x2d, 0x5d, 0xcb, 0x7f, 0x57, 0x5e,
-	0x6a, 0xf3, 0xf4, 0xbd, 0x7c, 0x22, 0x87, 0xaf, 0x08, 0xbc, 0xf7, 0x88, 0xb4, 0x3f, 0xf9, 0x0e,
-	0x2b, 0x89, 0x7e, 0x8a, 0x4d, 0xd5, 0x4c, 0x4a, 0x3f, 0xdb, 0x83, 0xb7, 0xb9, 0xdc, 0xd0, 0x1a,
-	0x80, 0x66, 0xcc, 0xc3, 0x01, 0x8f, 0x36, 0xc6, 0x53, 0x69, 0x13, 0x35, 0xdf, 0x04, 0xc1, 0xc8,
-	0x9b, 0xc1, 0x81, 0xb1, 0x09, 0x54, 0xd3, 0xfb, 0x65, 0xda, 0xf4, 0x27, 0x26, 0x13, 0xda, 0xf0,
-	0x88, 0x65, 0x78, 0x6d, 0x79, 0xbf, 0x0d, 0x64, 0x91, 0xac, 0x4b, 0x33, 0xd3, 0xed, 0x89, 0xfe,
-	0xbe, 0x41, 0xe5, 0xbd, 0x49, 0x69, 0x67, 0x5d, 0x79, 0x02, 0xe9, 0x7a, 0x48, 0xe2, 0x7a, 0xc4,
-	0xb3, 0xb9, 0x8e, 0xbc, 0x04, 0xc6, 0xff, 0x6c, 0x8d, 0xce, 0xfa, 0x13, 0x31, 0x45, 0xc5, 0xba,
-	0xb9, 0xb6, 0x98, 0xf4, 0x15, 0x91, 0xf7, 0xbb, 0x84, 0x9e, 0x36, 0x2f, 0x92, 0x6e, 0x8d, 0x83,
-	0xe4, 0xe8, 0x24, 0x9e, 0xe0, 0xed, 0x00, 0xa1, 0x7c, 0x7a, 0x77, 0xdc, 0x78, 0x2f, 0x28, 0x47,
-	0x4a, 0x48, 0xca, 0x7c, 0xdc, 0xef, 0xdb, 0x3e, 0xae, 0x60, 0x42, 0xbd, 0x03, 0xde, 0xce, 0xbb,
-	0xc4, 0x62, 0xe7, 0xa4, 0x06, 0x11, 0x2a, 0xcf, 0xb8, 0x06, 0xa4, 0xec, 0x10, 0xf9, 0xa9, 0x7d,
-	0x88, 0xcc, 0x0e, 0xae, 0xe7, 0xfe, 0x17, 0x52, 0x7e, 0x53, 0xf6, 0x54, 0x25, 0xbc, 0x43, 0xbd,
-	0x4e, 0x7b, 0xab, 0x98, 0xf9, 0x3f, 0x20, 0x56, 0x69, 0xb5, 0x8c, 0x39, 0x2d, 0xc6, 0xdf, 0x90,
-	0xa2, 0xeb, 0xbc, 0x67, 0x24, 0x40, 0x49, 0xa6, 0xfd, 0x87, 0x42, 0x80, 0xb3, 0xc6, 0xc1, 0xba,
-	0xec, 0xc8, 0xf1, 0xe7, 0x84, 0x36, 0xe5, 0xd5, 0x5f, 0x28, 0xde, 0xd6, 0xad, 0x88, 0x57, 0xc8,
-	0x22, 0x67, 0x11, 0x5b, 0x5b, 0x03, 0x8c, 0xb7, 0x22, 0x66, 0xa8, 0xee, 0x40, 0x28, 0xee, 0xc5,
-	0xdd, 0xbe, 0xd8, 0x09, 0x4d, 0x5f, 0x34, 0xd8, 0x65, 0xda, 0x50, 0xe5, 0x6c, 0xf5, 0x10, 0xc2,
-	0x35, 0xb7, 0xa1, 0x42, 0xca, 0x87, 0xd9, 0x8a, 0x54, 0xa7, 0x97, 0x35, 0x33, 0xbd, 0xfc, 0x9c,
-	0x64, 0x6f, 0x46, 0x9f, 0x4a, 0xc1, 0x86, 0xef, 0xaa, 0x58, 0xbe, 0xab, 0xec, 0x04, 0xf4, 0x47,
-	0xf6, 0x09, 0x28, 0xcd, 0x88, 0x56, 0xe9, 0x6f, 0x92, 0xfc, 0xab, 0x5a, 0x9d, 0x09, 0x12, 0xf3,
-	0xf1, 0xfb, 0x22, 0xad, 0xf4, 0x62, 0x15, 0x14, 0xe0, 0x6f, 0x59, 0x76, 0xfc, 0xc7, 0x82, 0x89,
-	0xe7, 0xf2, 0x94, 0x98, 0x93, 0x1d, 0x33, 0x85, 0xeb, 0x70, 0x51, 0x6c, 0x19, 0x87, 0xa0, 0xb0,
-	0x9d, 0x01, 0x0f, 0x77, 0xd4, 0x03, 0x92, 0xaa, 0x9f, 0xb4, 0xe1, 0x94, 0x02, 0xff, 0x53, 0x2f,
-	0x02, 0x2d, 0x98, 0x75, 0x2d, 0x53, 0xb1, 0x5f, 0x0c, 0x7a, 0x7f, 0x4b, 0xe8, 0x82, 0x4c, 0x82,
-	0xe0, 0xa0, 0x7f, 0x47, 0xbe, 0x9c, 0x2a, 0x08, 0x14, 0xe9, 0x33, 0x91, 0x93, 0x73, 0x26, 0x52,
-	0xa9, 0x54, 0x67, 0x57, 0xee, 0x03, 0xd5, 0x4c, 0x30, 0xbd, 0x58, 0x9e, 0x08, 0x55, 0xd3, 0x58,
-	0xf6, 0x5a, 0xfa, 0xc6, 0x42, 0x5c, 0x41, 0x80, 0xe8, 0x33, 0x22, 0xb8, 0x27, 0x00, 0xef, 0x3a,
-	0x6d, 0x26, 0x6b, 0xaa, 0x36, 0x82, 0x8e, 0xb9, 0xa4, 0x24, 0xe6, 0x3a, 0x56, 0xcc, 0xf5, 0xde,
-	0x27, 0x74, 0x01, 0x97, 0xd6, 0x50, 0xba, 0xf1, 0x7c, 0x8c, 0x58, 0xcf, 0xc7, 0x40, 0x09, 0xd6,
-	0xd3, 0x78, 0xa9, 0x04, 0xeb, 0x5d, 0x7c, 0x5b, 0x5c, 0xb5, 0x20, 0x6b, 0xa8, 0x06, 0x1d, 0x6a,
-	0x2c, 0x96, 0x7d, 0x4d, 0xe6, 0x3d, 0x26, 0xf4, 0x78, 0x66, 0x8f, 0xb1, 0x9f, 0xa5, 0x35, 0x5c,
-	0x1a, 0x97, 0x58, 0x75, 0xf8, 0xd4, 0x9a, 0xf9, 0x82, 0x88, 0xbd, 0x42, 0x8f, 0x99, 0xbd, 0x65,
-	0x20, 0x55, 0x8e, 0x3d, 0x6b, 0x5b, 0xbe, 0x45, 0xee, 0xfd, 0x17, 0x91, 0x37, 0x71, 0xb6, 0x5e,
-	0x2d, 0x69, 0xc8, 0x91, 0xa4, 0x61, 0x97, 0x29, 0x15, 0xc7, 0xa5, 0xe4, 0xe3, 0x11, 0xcd, 0x7c,
-	0x4a, 0xd7, 0xbe, 0x41, 0xc9, 0x7e, 0x4c, 0x9b, 0x96, 0x12, 0xa4, 0xf6, 0x8a, 0x9d, 0x90, 0x4d,
-	0x6e, 0x9b, 0x4c, 0x15, 0xb3, 0x0c, 0xc3, 0x64, 0x86, 0xf4, 0x94, 0x45, 0x9e, 0x54, 0x86, 0xca,
-	0x7d, 0xa8, 0xe5, 0x15, 0x9d, 0x23, 0x7b, 0x45, 0xef, 0xef, 0x49, 0xe1, 0x4b, 0x8f, 0xa7, 0xbd,
-	0xeb, 0xb2, 0x4c, 0xaf, 0x92, 0x35, 0xbd, 0xb2, 0x83, 0xc6, 0x67, 0x24, 0xe7, 0xb2, 0x2b, 0xc3,
-	0x99, 0x55, 0x4b, 0x29, 0x79, 0x8b, 0x52, 0xe2, 0x27, 0xd4, 0x7b, 0x4c, 0xc7, 0x78, 0x8f, 0xf9,
-	0xa4, 0x85, 0x94, 0x5b, 0xc5, 0x72, 0xfc, 0x09, 0xb1, 0x6e, 0xeb, 0x8b, 0x59, 0xb4, 0xee, 0xc1,
-	0x36, 0x30, 0x7f, 0x0a, 0xf6, 0x07, 0xf1, 0xa3, 0xa7, 0xb6, 0xea, 0x16, 0x9d, 0x33, 0x86, 0x91,
-	0xf2, 0x99, 0x20, 0xef, 0x2d, 0xba, 0x6c, 0x46, 0xef, 0xd4, 0x9c, 0x79, 0xa5, 0xfc, 0x97, 0xd3,
-	0x63, 0x9a, 0xef, 0xac, 0x53, 0x03, 0xd8, 0x73, 0xbd, 0x49, 0x4f, 0x18, 0xcd, 0xc4, 0x96, 0x5f,
-	0x82, 0xa8, 0x75, 0x67, 0x1c, 0xc9, 0x63, 0xe9, 0x85, 0xec, 0x93, 0xed, 0xf4, 0xa8, 0x82, 0x1e,
-	0x02, 0xdb, 0xb5, 0x50, 0x15, 0x43, 0xe1, 0xaf, 0xf7, 0x35, 0x29, 0x7c, 0x6d, 0x94, 0xc9, 0x78,
-	0xec, 0xef, 0x57, 0x6a, 0xd6, 0xf7, 0x1f, 0xb1, 0x59, 0x79, 0x8e, 0xb3, 0xdf, 0x7f, 0x54, 0xd3,
-	0xdf, 0x7f, 0x94, 0x99, 0xf1, 0xe7, 0x79, 0x35, 0x81, 0x0c, 0x7f, 0xd6, 0x8d, 0x33, 0x7e, 0x06,
-	0x83, 0x29, 0xc2, 0x6e, 0x92, 0x22, 0xec, 0xb2, 0xb3, 0xd4, 0xe9, 0xc5, 0xd2, 0x37, 0xa5, 0xbe,
-	0x9b, 0x71, 0x7a, 0x31, 0xbb, 0x94, 0xbc, 0x81, 0xae, 0xd8, 0xdf, 0x56, 0xed, 0xf6, 0x62, 0xb1,
-	0xef, 0x23, 0xf5, 0x85, 0x01, 0x36, 0x96, 0xb7, 0x65, 0xc2, 0x15, 0x65, 0xbe, 0x00, 0xa8, 0x8a,
-	0x2f, 0x00, 0xd6, 0xec, 0x0f, 0x8c, 0x8a, 0x7d, 0x88, 0xf1, 0x6d, 0xc0, 0x7f, 0x12, 0xba, 0x98,
-	0xfe, 0xb2, 0x09, 0xb6, 0x1e, 0xc7, 0x46, 0x5f, 0x7e, 0x60, 0xa0, 0x9a, 0xe0, 0xc8, 0xb8, 0x71,
-	0x0b, 0x40, 0x20, 0xe3, 0x4d, 0x00, 0x60, 0x7f, 0xe3, 0x09, 0x7e, 0x4b, 0x84, 0x4f, 0x97, 0xe1,
-	0x3f, 0x3b, 0x4b, 0x2b, 0x93, 0x58, 0x95, 0x9a, 0xe6, 0x0c, 0x19, 0x7d, 0x80, 0xc3, 0x80, 0x7b,
-	0xd3, 0x30, 0x04, 0xdd, 0x72, 0x2c, 0xdb, 0xd4, 0x7c, 0x0d, 0x00, 0x2f, 0x36, 0x09, 0xb9, 0x40,
-	0xce, 0x20, 0x32, 0x69, 0x83, 0xfc, 0x51, 0xb8, 0xe7, 0xce, 0x0a, 0xf9, 0xa3, 0x10, 0xbf, 0x4a,
-	0xe9, 0xf3, 0x28, 0xc6, 0x87, 0xf9, 0x55, 0x1f, 0xff, 0x7b, 0x1f, 0x90, 0xbc, 0x37, 0x6b, 0xec,
-	0x07, 0x52, 0x0e, 0x0c, 0x63, 0x62, 0x77, 0x16, 0x7e, 0xe7, 0xa5, 0x29, 0xcb, 0xb2, 0x9c, 0x2f,
-	0xec, 0x2c, 0x27, 0x3b, 0xa7, 0xb6, 0x18, 0xe0, 0x29, 0xfb, 0x5e, 0xee, 0x19, 0xf0, 0xf4, 0xa5,
-	0xcd, 0x53, 0x76, 0x4e, 0xab, 0xd4, 0x98, 0xf7, 0x56, 0xef, 0x49, 0x8d, 0x7a, 0x85, 0x36, 0x30,
-	0xda, 0xe2, 0xc7, 0x7f, 0xc2, 0x0c, 0x34, 0xc0, 0xfa, 0x86, 0x8b, 0xe8, 0x6f, 0xd0, 0xca, 0x6a,
-	0x37, 0x7f, 0x9a, 0x57, 0xbb, 0xb1, 0x58, 0xd4, 0x32, 0xc4, 0x79, 0xaf, 0x0a, 0x6d, 0x63, 0x76,
-	0x0c, 0x63, 0x2e, 0xd3, 0xdc, 0x9f, 0xd9, 0x9a, 0xcb, 0x0e, 0x9b, 0xcc, 0xfa, 0xff, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x5a, 0x80, 0x21, 0xa5, 0x0e, 0x3b, 0x00, 0x00,
+	// 4826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x5b, 0x8c, 0x1c, 0xc7,
+	0x71, 0xe8, 0x7d, 0xdc, 0xdd, 0xf6, 0x3d, 0xd9, 0x3c, 0x92, 0xa3, 0xd3, 0x91, 0x5a, 0x8e, 0x25,
+	0xeb, 0x20, 0xc5, 0x54, 0xb4, 0xb1, 0x28, 0x59, 0xb1, 0x64, 0x93, 0xb7, 0x14, 0xb9, 0xe2, 0x1d,
+	0xb9, 0xea, 0x3d, 0xc7, 0x40, 0x82, 0x24, 0x1e, 0xde, 0x36, 0x8f, 0x6b, 0xee, 0x4b, 0x33, 0xb3,
+	0xe4, 0x9d, 0xe0, 0xc0, 0x74, 0x0c, 0x24, 0x01, 0xf2, 0x15, 0x04, 0x8e, 0xe3, 0x00, 0x79, 0x29,
+	0x7e, 0xc4, 0x89, 0x1d, 0x3f, 0x02, 0xe4, 0x69, 0x07, 0xb0, 0x93, 0x00, 0x41, 0x7e, 0xf3, 0x9d,
+	0xe4, 0x2f, 0x3f, 0x49, 0x80, 0xfc, 0x19, 0xf9, 0x33, 0xaa, 0xba, 0x7b, 0xba, 0x7b, 0x5e, 0x47,
+	0x12, 0x96, 0xbe, 0x6e, 0xbb, 0xaa, 0xa6, 0xbb, 0xaa, 0xba, 0xbb, 0xaa, 0xba, 0xba, 0xfa, 0xe8,
+	0x33, 0x93, 0xa9, 0x18, 0xff, 0x72, 0x14, 0xee, 0xbf, 0x30, 0x18, 0xdf, 0x1e, 0xce, 0x0e, 0x5f,
+	0x18, 0x89, 0x38, 0x78, 0x61, 0x1a, 0x4e, 0xe2, 0x09, 0xfe, 0xbc, 0x80, 0x3f, 0x59, 0x1d, 0xff,
+	0xf8, 0xff, 0x3c, 0x4f, 0x6b, 0xed, 0x20, 0x0e, 0x18, 0xa3, 0xb5, 0x3d, 0x11, 0x8e, 0x3c, 0xd2,
+	0xac, 0x6c, 0xd5, 0x38, 0xfe, 0x66, 0xeb, 0xb4, 0xde, 0x19, 0xf7, 0xc5, 0xa1, 0x57, 0x41, 0xa0,
+	0x6c, 0xb0, 0x4d, 0xda, 0xd8, 0x1e, 0xce, 0xa2, 0x58, 0x84, 0x9d, 0xb6, 0x57, 0x45, 0x8c, 0x01,
+	0xb0, 0x67, 0x68, 0xfd, 0xc6, 0xa4, 0x2f, 0x22, 0xaf, 0xd6, 0xac, 0x6e, 0x2d, 0xb6, 0x56, 0xe5,
+	0x70, 0x17, 0x00, 0xd6, 0x19, 0xdf, 0x9e, 0x70, 0x89, 0x65, 0x2f, 0xd2, 0x06, 0x0c, 0x7b, 0x2b,
+	0x88, 0x44, 0xe4, 0xd5, 0x91, 0xf4, 0xa4, 0x22, 0xd5, 0x70, 0x24, 0x37, 0x54, 0xd0, 0xf3, 0x27,
+	0x22, 0x11, 0x46, 0xde, 0x9c, 0xd3, 0x33, 0xc0, 0x64, 0xcf, 0x88, 0x05, 0xf6, 0x76, 0x83, 0x43,
+	0x1c, 0xaf, 0xed, 0xcd, 0x4b, 0xf6, 0x12, 0x00, 0xdb, 0xa2, 0xab, 0xbb, 0xc1, 0x61, 0xef, 0x4e,
+	0x10, 0xf6, 0xaf, 0x86, 0x93, 0xd9, 0xb4, 0xd3, 0xf6, 0x16, 0x90, 0x26, 0x0d, 0x66, 0xe7, 0x28,
+	0xd5, 0xa0, 0x4e, 0xdb, 0x6b, 0x20, 0x91, 0x05, 0x61, 0x1f, 0x92, 0x12, 0x48, 0x61, 0xa9, 0xc3,
+	0x92, 0x86, 0x73, 0x43, 0x01, 0xe4, 0xbb, 0x42, 0x93, 0x2f, 0xe6, 0xeb, 0xc6, 0x50, 0x30, 0x9f,
+	0x2e, 0x29, 0x9d, 0x76, 0xe3, 0x1b, 0xb3, 0x91, 0xb7, 0xd2, 0xac, 0x6c, 0x2d, 0x73, 0x07, 0xc6,
+	0x5e, 0xa0, 0x73, 0xdd, 0xf8, 0xe7, 0x06, 0xe2, 0xbe, 0xb7, 0x8a, 0xfd, 0x9d, 0xb1, 0x86, 0xbf,
+	0x20, 0x31, 0x57, 0xc6, 0x71, 0x78, 0xc4, 0x15, 0x19, 0x74, 0x8a, 0x5f, 0x76, 0x45, 0x08, 0xa3,
+	0x78, 0x6b, 0x4d, 0x02, 0x9d, 0xda, 0x30, 0xa5, 0x20, 0x9c, 0x69, 0xad, 0xa0, 0x13, 0x89, 0x82,
+	0x6c, 0xb0, 0x52, 0x10, 0x82, 0x3a, 0x6d, 0x8f, 0x25, 0x0a, 0x52, 0x10, 0x18, 0x6d, 0x37, 0x38,
+	0xbc, 0x72, 0x4f, 0x8c, 0xe3, 0x9b, 0xd3, 0x4e, 0xdf, 0x3b, 0xd9, 0x24, 0x5b, 0x35, 0xee, 0xc0,
+	0x60, 0xb4, 0xbd, 0xe0, 0xae, 0xb8, 0x79, 0x4f, 0x84, 0x57, 0xc6, 0xc1, 0xad, 0xa1, 0xe8, 0x7b,
+	0xeb, 0x4d, 0xb2, 0xb5, 0xc0, 0xd3, 0x60, 0xf6, 0x1a, 0x5d, 0xde, 0x1d, 0x1c, 0x84, 0x41, 0x2c,
+	0xf0, 0xeb, 0xc8, 0x3b, 0xe5, 0xc8, 0x6c, 0xe3, 0x50, 0x97, 0x2e, 0x35, 0x7b, 0x89, 0xce, 0x6f,
+	0xbf, 0xb5, 0x23, 0x82, 0x48, 0x78, 0xa7, 0x9b, 0x64, 0x6b, 0xb1, 0xf5, 0xa4, 0xfa, 0x70, 0x7b,
+	0x32, 0x8e, 0x07, 0xe3, 0xd9, 0x64, 0x16, 0xbd, 0x35, 0x13, 0xe1, 0x11, 0x92, 0x70, 0x4d, 0x0b,
+	0x6b, 0x8e, 0x4f, 0x86, 0x22, 0xf2, 0xce, 0x38, 0x33, 0x06, 0x30, 0xb9, 0xe6, 0x10, 0xcb, 0x3e,
+	0x4c, 0x29, 0x0f, 0x62, 0xb1, 0x33, 0x18, 0x0d, 0xe2, 0xc8, 0xf3, 0x90, 0x76, 0x5d, 0xd3, 0x6a,
+	0x04, 0x7e, 0x60, 0xd1, 0x6d, 0xbc, 0x49, 0x17, 0xad, 0x59, 0x62, 0x6b, 0xb4, 0x7a, 0x57, 0x1c,
+	0x79, 0xa4, 0x49, 0xb6, 0x1a, 0x1c, 0x7e, 0xc2, 0xe8, 0xf7, 0x82, 0xe1, 0x4c, 0x78, 0x95, 0x26,
+	0xb1, 0x46, 0x6f, 0x5f, 0xee, 0xca, 0xce, 0x24, 0xf6, 0xd5, 0xca, 0x2b, 0xc4, 0x3f, 0x4f, 0xe7,
+	0xbb, 0xf1, 0xcd, 0xfb, 0x63, 0x11, 0xb2, 0xd3, 0x74, 0x4e, 0xad, 0x7e, 0xb9, 0x97, 0x55, 0xcb,
+	0xff, 0x79, 0x3a, 0x27, 0xbf, 0x63, 0x4f, 0xd3, 0x3a, 0x92, 0x22, 0xc1, 0x62, 0x6b, 0x45, 0xf5,
+	0xab, 0x3a, 0xe0, 0xf5, 0xa4, 0x9f, 0x5e, 0x1c, 0xc4, 0xb3, 0x08, 0xb7, 0xff, 0x32, 0x57, 0x2d,
+	0xb0, 0x14, 0xdd, 0xb8, 0xd3, 0xc7, 0xad, 0xbf, 0xcc, 0xf1, 0xb7, 0xff, 0x21, 0xba, 0xa0, 0xb9,
+	0x62, 0xe7, 0x69, 0xad, 0x7d, 0xab, 0x1b, 0x7b, 0x04, 0xd5, 0xb0, 0x9c, 0x74, 0x8e, 0x2c, 0x23,
+	0xca, 0xff, 0x36, 0xa1, 0x0b, 0x7a, 0xd5, 0xb3, 0x15, 0x5a, 0x49, 0x78, 0xad, 0x74, 0xda, 0xd0,
+	0xff, 0xb5, 0x49, 0x14, 0xe3, 0xa8, 0x0d, 0x8e, 0xbf, 0x99, 0x47, 0xe7, 0x79, 0x77, 0xfb, 0x52,
+	0xbf, 0x1f, 0x7a, 0x75, 0xd4, 0x8f, 0x6e, 0x02, 0x66, 0x6f, 0xbb, 0x8b, 0x1f, 0x54, 0x25, 0x46,
+	0x35, 0x2d, 0xfe, 0x6b, 0xcd, 0xca, 0x56, 0x35, 0xe1, 0x7f, 0x9d, 0xd6, 0x77, 0xf6, 0x06, 0x23,
+	0xe1, 0xcd, 0x49, 0xab, 0x86, 0x0d, 0x58, 0xcd, 0x57, 0x27, 0x51, 0x34, 0x98, 0xe2, 0x20, 0xf3,
+	0x38, 0xb6, 0x05, 0xf1, 0x9f, 0xa7, 0x0b, 0x7a, 0x33, 0xb3, 0xa7, 0x68, 0xe5, 0xc6, 0x40, 0x29,
+	0x2f, 0xb3, 0x89, 0x2b, 0x37, 0x06, 0xfe, 0x0f, 0x2a, 0x74, 0xc9, 0x36, 0x63, 0x20, 0xd3, 0x8d,
+	0x60, 0x24, 0xf0, 0x9b, 0x06, 0xc7, 0xdf, 0xec, 0x22, 0x3d, 0xdd, 0x16, 0xb7, 0x83, 0xd9, 0x30,
+	0xe6, 0x22, 0x16, 0xe3, 0x78, 0x30, 0x19, 0x77, 0x27, 0xc3, 0xc1, 0xfe, 0x91, 0x92, 0xbc, 0x00,
+	0xcb, 0xae, 0xd1, 0x13, 0x2e, 0x68, 0x20, 0x22, 0xaf, 0x8a, 0xca, 0xde, 0xd0, 0x6b, 0xce, 0xfd,
+	0x04, 0xf9, 0xca, 0x7e, 0x04, 0x3d, 0xb9, 0xcb, 0x7f, 0x90, 0xd8, 0xed, 0x8d, 0xfc, 0xed, 0x21,
+	0x7b, 0xca, 0x7c, 0xc4, 0x9a, 0x74, 0x71, 0x37, 0x08, 0xef, 0xb6, 0xc5, 0x50, 0xc4, 0xa2, 0x8f,
+	0x73, 0xb4, 0xc0, 0x6d, 0x10, 0x7b, 0x81, 0x2e, 0xa0, 0xe5, 0xbc, 0x2e, 0x8e, 0xbc, 0xb9, 0x26,
+	0xb1, 0xec, 0xbd, 0x06, 0x63, 0xdf, 0x09, 0x91, 0xff, 0x5b, 0x84, 0x9e, 0x4c, 0xc9, 0xd1, 0x9b,
+	0x8a, 0x7d, 0x4b, 0x95, 0x24, 0x51, 0xe5, 0x06, 0x5d, 0x68, 0xcf, 0xc2, 0x00, 0x28, 0x71, 0xaf,
+	0x54, 0x79, 0xd2, 0x66, 0x17, 0x28, 0x33, 0x76, 0x3d, 0xa1, 0xaa, 0x22, 0x55, 0x0e, 0x06, 0xfa,
+	0xe2, 0x62, 0x3a, 0x1c, 0xec, 0x07, 0x37, 0xbc, 0x1a, 0x1a, 0xc8, 0xa4, 0xed, 0x7f, 0xab, 0x42,
+	0x57, 0x77, 0x45, 0x10, 0xcd, 0x42, 0x31, 0x52, 0x86, 0x26, 0x77, 0x6a, 0x5f, 0xa4, 0x0d, 0x2d,
+	0x07, 0xec, 0x9e, 0x6a, 0x91, 0xb4, 0x86, 0x8a, 0xbd, 0x4a, 0xe7, 0x7a, 0xfb, 0x77, 0xc4, 0x28,
+	0x50, 0x53, 0xe9, 0x6b, 0xc3, 0xe6, 0x0e, 0x77, 0x41, 0x12, 0x29, 0xbb, 0x2e, 0x1b, 0x69, 0xed,
+	0xd7, 0xb2, 0xda, 0xff, 0x28, 0x5d, 0x19, 0x80, 0x59, 0xe6, 0x62, 0x88, 0x52, 0x6a, 0x9f, 0xab,
+	0x8d, 0x54, 0xc7, 0x46, 0xf2, 0x14, 0xed, 0xc6, 0x47, 0xe8, 0xa2, 0x35, 0x6c, 0x8e, 0xa1, 0x5a,
+	0xb7, 0x0d, 0x55, 0xdd, 0xb6, 0x4b, 0xff, 0x59, 0xcb, 0xcc, 0x62, 0xa1, 0xd6, 0xdc, 0x59, 0xac,
+	0x3c, 0xd4, 0x2c, 0x56, 0x1e, 0x6a, 0x16, 0x2b, 0xf6, 0x2c, 0xb2, 0x57, 0xe9, 0x92, 0xa5, 0x55,
+	0xad, 0x8a, 0xd3, 0xf9, 0x0a, 0xe7, 0x0e, 0x2d, 0x7b, 0x99, 0x2e, 0x9a, 0xd1, 0x74, 0x28, 0x72,
+	0xca, 0x9e, 0x5b, 0xc4, 0xe0, 0x97, 0x36, 0x25, 0xf8, 0xaf, 0xde, 0xec, 0x56, 0xb4, 0x1f, 0x0e,
+	0xa6, 0x72, 0x02, 0xe6, 0x1d, 0xff, 0x65, 0xe3, 0xa4, 0xff, 0x72, 0xa8, 0xd3, 0x53, 0xbc, 0x90,
+	0x9d, 0xe2, 0x26, 0x5d, 0xbc, 0x36, 0x89, 0x13, 0xd5, 0x34, 0x50, 0x35, 0x36, 0x08, 0x1c, 0xf2,
+	0x27, 0x83, 0x70, 0x94, 0x90, 0x50, 0x24, 0x71, 0x60, 0xa0, 0x67, 0xe3, 0xe4, 0x13, 0xca, 0x45,
+	0xa9, 0xe7, 0x2c, 0x06, 0xf4, 0x61, 0xa0, 0x91, 0xb7, 0xe4, 0xe8, 0xc3, 0x60, 0xa4, 0x3e, 0x2c,
+	0x4a, 0x76, 0x95, 0xae, 0xb5, 0x27, 0xf7, 0xc7, 0xbd, 0x60, 0x34, 0x1d, 0x0a, 0x65, 0xf7, 0x96,
+	0x1d, 0xcf, 0x9c, 0x46, 0x63, 0x1f, 0x99, 0x8f, 0xfc, 0xd7, 0xe9, 0x8a, 0x81, 0x6d, 0x07, 0xc3,
+	0x21, 0xae, 0xa3, 0x20, 0x0e, 0xf6, 0x8e, 0xa6, 0x72, 0x7d, 0x55, 0x79, 0xd2, 0x86, 0xb5, 0x7b,
+	0x73, 0x2a, 0xf7, 0x64, 0x83, 0xc3, 0x4f, 0xff, 0x17, 0xe9, 0xaa, 0xf9, 0x7e, 0x47, 0xdc, 0x13,
+	0x43, 0xf6, 0x41, 0xba, 0x22, 0x9b, 0x9d, 0x71, 0x2c, 0xc2, 0x7b, 0xc1, 0x50, 0x75, 0x93, 0x82,
+	0x82, 0x42, 0xc1, 0x77, 0x24, 0x54, 0x72, 0xd1, 0x3a, 0x30, 0x3f, 0xa2, 0xeb, 0x79, 0x82, 0xb0,
+	0xe7, 0x69, 0x1d, 0x98, 0x8d, 0x3c, 0xe2, 0xa8, 0xcc, 0x15, 0x85, 0x4b, 0x1a, 0x76, 0x81, 0xce,
+	0x21, 0x67, 0xda, 0x98, 0x9c, 0xce, 0x50, 0x23, 0x9a, 0x2b, 0x2a, 0xff, 0x87, 0x84, 0xae, 0xb8,
+	0x8b, 0x31, 0xe3, 0x65, 0x37, 0x69, 0xa3, 0x17, 0x07, 0x61, 0x8c, 0x9e, 0x50, 0x32, 0x6e, 0x00,
+	0xe0, 0x55, 0xaf, 0x8c, 0xfb, 0x88, 0x93, 0x7b, 0x4c, 0x37, 0xe1, 0x3b, 0xb5, 0xe2, 0x2e, 0xc5,
+	0xca, 0xb1, 0x1a, 0x00, 0xdb, 0xa2, 0x73, 0x38, 0xae, 0xde, 0x54, 0x6b, 0xf6, 0xce, 0xc0, 0x09,
+	0x54, 0x78, 0x58, 0xae, 0x7b, 0xe1, 0x6c, 0xbc, 0x1f, 0xc8, 0x9e, 0xe6, 0xd0, 0x1e, 0xdb, 0x20,
+	0xff, 0xef, 0x08, 0x6d, 0x24, 0xdf, 0x65, 0xf8, 0x3f, 0x47, 0x17, 0x30, 0x4c, 0xe9, 0xb4, 0xa5,
+	0x52, 0x96, 0x2f, 0x57, 0x3c, 0xc2, 0x13, 0x18, 0x4c, 0xf4, 0xee, 0x40, 0x5a, 0x88, 0x06, 0x87,
+	0x9f, 0x08, 0x09, 0x0e, 0xbd, 0x9a, 0x82, 0x04, 0x87, 0x78, 0xe6, 0x19, 0x08, 0x08, 0x29, 0xe4,
+	0x99, 0x67, 0x20, 0x30, 0x9e, 0xd0, 0x21, 0xad, 0x8c, 0x0f, 0x74, 0x93, 0x3d, 0x4f, 0xe7, 0x77,
+	0x82, 0x83, 0x83, 0xc1, 0xf8, 0x40, 0xed, 0xdd, 0x13, 0x89, 0xb7, 0x45, 0xc3, 0xb2, 0x13, 0x1c,
+	0x70, 0x4d, 0xe1, 0xb7, 0x28, 0x35, 0x60, 0x15, 0x32, 0x49, 0xf6, 0x65, 0xc8, 0xd4, 0x06, 0x76,
+	0x7a, 0xe2, 0x6d, 0x75, 0xb4, 0x82, 0x9f, 0x3e, 0xa7, 0x4b, 0xb6, 0x77, 0x80, 0x75, 0xac, 0xdb,
+	0xb8, 0x4a, 0x1a, 0xc6, 0x3b, 0x22, 0xeb, 0x47, 0x53, 0x69, 0x70, 0x1b, 0x1c, 0x7f, 0x03, 0xac,
+	0x77, 0x80, 0x67, 0x32, 0x08, 0xb4, 0xf1, 0xb7, 0x1f, 0xd0, 0x93, 0x39, 0x2e, 0x3c, 0xd7, 0xfc,
+	0xae, 0xd3, 0x3a, 0x12, 0xa8, 0xf0, 0x43, 0x36, 0x60, 0x9e, 0x76, 0x82, 0x28, 0xe6, 0xb3, 0xb1,
+	0x5a, 0x0d, 0x38, 0x4f, 0x16, 0xc8, 0xdf, 0xa1, 0xeb, 0x79, 0x41, 0x34, 0xf4, 0x67, 0xa2, 0xcc,
+	0x86, 0x8e, 0x2a, 0xcf, 0x51, 0x7a, 0xe5, 0x70, 0x3a, 0x70, 0xcc, 0xbc, 0x05, 0xf1, 0x7f, 0x89,
+	0xae, 0xa5, 0x6d, 0x61, 0x2e, 0xb7, 0x8c, 0xd6, 0x76, 0xe1, 0x0c, 0xa3, 0xa2, 0x44, 0xf8, 0x0d,
+	0xfb, 0xb1, 0x2d, 0xa2, 0x78, 0x30, 0x56, 0x3e, 0xae, 0x8a, 0x4a, 0x73, 0x60, 0xfe, 0xd3, 0x94,
+	0xa2, 0x12, 0xcb, 0x63, 0xe5, 0x6f, 0x10, 0xba, 0xa0, 0x0f, 0x96, 0x45, 0xc3, 0x5f, 0x0b, 0xa2,
+	0x3b, 0x49, 0x90, 0x1a, 0x44, 0x77, 0x40, 0xe0, 0x4b, 0xfd, 0x91, 0x5a, 0x74, 0x0b, 0x5c, 0x36,
+	0x60, 0x08, 0x7e, 0x1f, 0xfa, 0x52, 0x7e, 0x59, 0xb5, 0xe0, 0xcc, 0xd0, 0x0d, 0x07, 0xf7, 0x06,
+	0x43, 0x71, 0x20, 0xd2, 0xee, 0x18, 0x08, 0x12, 0x24, 0xb7, 0xe8, 0x60, 0x0c, 0x79, 0x20, 0x99,
+	0x43, 0xd9, 0x64, 0xc3, 0xef, 0xd0, 0x65, 0xe7, 0x13, 0x6d, 0x02, 0x21, 0xfe, 0x54, 0x6c, 0x27,
+	0x6d, 0xd8, 0xc1, 0x09, 0x21, 0xf2, 0x5f, 0xe7, 0x06, 0xe0, 0x5f, 0xa3, 0x0b, 0xfa, 0x74, 0x93,
+	0x2b, 0xf8, 0x16, 0x9d, 0xbb, 0x1a, 0x06, 0xe3, 0x58, 0xee, 0x3a, 0xb3, 0xc3, 0xe1, 0x23, 0x44,
+	0x70, 0x85, 0xf7, 0xbf, 0x46, 0x68, 0x23, 0x81, 0xba, 0xa3, 0x92, 0xd4, 0xa8, 0x0e, 0xbf, 0x72,
+	0x49, 0x1b, 0x7e, 0xb7, 0xe8, 0x6a, 0x3a, 0x40, 0x96, 0x91, 0x7e, 0x1a, 0x8c, 0x4e, 0xd2, 0x38,
+	0x6b, 0xd4, 0x77, 0x83, 0xdb, 0x20, 0x54, 0x9f, 0x38, 0x10, 0x87, 0xea, 0x14, 0x21, 0x1b, 0xfe,
+	0xf7, 0x09, 0x5d, 0x76, 0x8e, 0x69, 0x29, 0xfd, 0xb9, 0xfc, 0x30, 0x5a, 0xc3, 0xe9, 0x54, 0x5b,
+	0x0f, 0x7e, 0x03, 0x8f, 0xdd, 0xc9, 0x60, 0x1c, 0x47, 0x5d, 0x11, 0xf6, 0xc4, 0xfe, 0x64, 0xdc,
+	0x57, 0x3b, 0x25, 0x0d, 0x06, 0xdf, 0x72, 0xf9, 0x28, 0x16, 0x16, 0x61, 0x0d, 0x09, 0x53, 0x50,
+	0xf6, 0x1c, 0x5d, 0x53, 0xc1, 0xb5, 0xa1, 0xac, 0x23, 0x65, 0x06, 0xee, 0x7f, 0x9e, 0xd0, 0x65,
+	0x27, 0x82, 0x03, 0xe3, 0xc2, 0x07, 0x7d, 0x65, 0x6f, 0xe0, 0x27, 0x3a, 0xbe, 0x41, 0x5f, 0x1d,
+	0xe5, 0xe0, 0x27, 0xcc, 0x08, 0x7e, 0x84, 0x53, 0x2c, 0xb7, 0x8a, 0x01, 0xb0, 0x9f, 0xa6, 0x14,
+	0x1b, 0x3b, 0x83, 0x28, 0xd6, 0x87, 0x82, 0x35, 0xdb, 0xaf, 0x03, 0x82, 0x5b, 0x34, 0xfe, 0x79,
+	0xda, 0x48, 0x5a, 0x98, 0x3a, 0x82, 0x1f, 0xca, 0x70, 0xc9, 0x86, 0xff, 0x57, 0x4b, 0x74, 0x7e,
+	0x7b, 0x32, 0x1a, 0x05, 0xe3, 0x3e, 0x7b, 0x96, 0xd6, 0x62, 0xed, 0xa1, 0x57, 0x92, 0xf0, 0x58,
+	0x61, 0x2f, 0x80, 0x41, 0xe3, 0x48, 0xe0, 0xff, 0x68, 0x51, 0xda, 0x3a, 0xf6, 0x04, 0x3d, 0xb5,
+	0x1d, 0x8a, 0x20, 0x16, 0x7a, 0x2a, 0x14, 0xf1, 0x5a, 0x95, 0x9d, 0xa1, 0x27, 0xdb, 0xe1, 0x64,
+	0x9a, 0x46, 0xd4, 0x58, 0x93, 0x6e, 0xca, 0x6f, 0x52, 0x6b, 0x45, 0x53, 0xd4, 0xd9, 0x39, 0xba,
+	0x01, 0x9f, 0x16, 0xe0, 0xe7, 0xd8, 0xd3, 0xb4, 0xd9, 0x13, 0x71, 0xfe, 0x59, 0x4c, 0x53, 0xcd,
+	0xc3, 0x38, 0x9f, 0x98, 0xf6, 0x8b, 0xc7, 0x59, 0x60, 0x4f, 0xd2, 0x33, 0x92, 0x13, 0xe3, 0x98,
+	0x35, 0xb2, 0x01, 0x48, 0xe9, 0x44, 0xb3, 0x48, 0xca, 0x4e, 0xd1, 0x13, 0xf2, 0x4b, 0x58, 0x6a,
+	0x1a, 0xbc, 0xcc, 0x4e, 0xd2, 0x55, 0x60, 0xdc, 0x06, 0xae, 0x00, 0xad, 0xe4, 0xc3, 0x06, 0xaf,
+	0x82, 0x7e, 0x7a, 0x22, 0x4e, 0xf6, 0x9b, 0x46, 0xac, 0x31, 0x46, 0x57, 0x40, 0xba, 0x20, 0x0e,
+	0x34, 0xec, 0x04, 0xdb, 0xa4, 0x5e, 0x4f, 0xc4, 0x68, 0xbd, 0x32, 0x5f, 0x30, 0xa3, 0xd1, 0x94,
+	0xd1, 0xd7, 0x14, 0x27, 0xb5, 0x46, 0x0b, 0xf0, 0xeb, 0xec, 0x2c, 0x7d, 0x42, 0x69, 0xc2, 0x32,
+	0xf4, 0x1a, 0x7d, 0x0a, 0x75, 0x11, 0x4e, 0xa6, 0x79, 0xc8, 0xd3, 0x66, 0x0d, 0xe8, 0x54, 0x99,
+	0x46, 0x79, 0xee, 0xf2, 0xb0, 0x51, 0x4f, 0x00, 0x4a, 0x6a, 0x25, 0x8d, 0xda, 0x00, 0x94, 0xd4,
+	0x7c, 0xba, 0xc3, 0x27, 0x0d, 0x2a, 0xfd, 0xd5, 0x26, 0x3b, 0x4d, 0x59, 0x4f, 0xc4, 0xe9, 0x4f,
+	0xce, 0xb2, 0x75, 0xba, 0x86, 0xbc, 0xc3, 0x2c, 0x6a, 0xe8, 0x39, 0x10, 0x18, 0x23, 0x75, 0xb5,
+	0x3a, 0x65, 0xa7, 0x1a, 0xfd, 0x14, 0x08, 0x2c, 0xb9, 0x33, 0x8e, 0x49, 0x23, 0x3f, 0x00, 0xcb,
+	0x0f, 0xbe, 0x4d, 0x2d, 0x2b, 0xb7, 0x8b, 0x67, 0x61, 0xca, 0xb4, 0x5a, 0x12, 0x63, 0xa7, 0xb1,
+	0x2f, 0x02, 0x57, 0x97, 0x86, 0xb1, 0x08, 0x75, 0xf4, 0xb0, 0x3d, 0xea, 0xaf, 0xb5, 0x60, 0xa9,
+	0x70, 0x39, 0xe4, 0x60, 0x7c, 0xa0, 0x89, 0x3f, 0x0c, 0x4b, 0x45, 0x71, 0x83, 0x47, 0x3e, 0x8d,
+	0x78, 0x09, 0x10, 0x5c, 0x4c, 0x27, 0x61, 0x8c, 0xdf, 0x44, 0x1a, 0x71, 0x11, 0x94, 0xd1, 0x0d,
+	0x67, 0x63, 0x21, 0x23, 0x7b, 0x0d, 0xff, 0x08, 0xac, 0x14, 0x60, 0xdd, 0x62, 0xc9, 0x65, 0xfb,
+	0x55, 0xb6, 0x41, 0x4f, 0x83, 0xba, 0x72, 0x98, 0xfe, 0x59, 0x60, 0x1a, 0xc2, 0x0b, 0x1e, 0x8c,
+	0xcd, 0xea, 0xfb, 0x28, 0xf3, 0xe8, 0x3a, 0x0e, 0xaf, 0x0f, 0x20, 0x1a, 0xf3, 0x9a, 0xd9, 0x42,
+	0xe6, 0x94, 0xa1, 0x91, 0xaf, 0xc3, 0x92, 0xb4, 0x54, 0x0c, 0x26, 0x1e, 0x82, 0x3d, 0x8d, 0xff,
+	0x98, 0x99, 0x02, 0x98, 0x4e, 0x99, 0x27, 0xd2, 0xc8, 0x8f, 0x83, 0x7c, 0x52, 0xb9, 0x98, 0x4b,
+	0xd4, 0xf0, 0x4b, 0x00, 0x97, 0x1f, 0x39, 0xf0, 0xcb, 0x46, 0x83, 0x32, 0xe7, 0xa5, 0x11, 0xdb,
+	0xf0, 0x01, 0x17, 0xa3, 0xc9, 0x3d, 0xf7, 0x83, 0x36, 0x7b, 0x8a, 0x3e, 0x99, 0x17, 0x41, 0x69,
+	0x82, 0x2b, 0xb8, 0xe7, 0x5c, 0x02, 0x39, 0x13, 0x9a, 0xe2, 0x0d, 0x76, 0x9e, 0x9e, 0x55, 0x8b,
+	0x3f, 0x75, 0xd8, 0xd0, 0x24, 0x57, 0x61, 0x14, 0xb4, 0x91, 0x05, 0x04, 0xd7, 0x8c, 0x9d, 0x01,
+	0xaf, 0xad, 0xc1, 0x1d, 0x6d, 0x67, 0x6c, 0xe0, 0x9b, 0x6a, 0x03, 0x80, 0x91, 0xb1, 0xe1, 0xd7,
+	0x41, 0x91, 0x3d, 0x11, 0x03, 0x2c, 0x63, 0x3a, 0x76, 0x94, 0x15, 0x4a, 0x3c, 0xad, 0x46, 0xec,
+	0xca, 0xa5, 0xa8, 0xa3, 0x65, 0x0d, 0xbe, 0xf1, 0xdc, 0xc2, 0x42, 0x7f, 0xed, 0xc1, 0x83, 0x07,
+	0x0f, 0x2a, 0xfe, 0x83, 0x4a, 0x81, 0xed, 0xcf, 0x8d, 0x51, 0xda, 0xd9, 0x88, 0x41, 0x66, 0x50,
+	0xcb, 0xf2, 0x63, 0xe9, 0x4f, 0x20, 0x52, 0xd5, 0x29, 0x83, 0xd9, 0x08, 0xdd, 0xf9, 0x32, 0xb7,
+	0x20, 0xec, 0x19, 0x5a, 0xed, 0xdd, 0x1d, 0xa0, 0xfb, 0x2e, 0x48, 0xef, 0x00, 0xbe, 0xf5, 0x06,
+	0x9d, 0xdf, 0x57, 0xbc, 0xae, 0xb8, 0x4e, 0xce, 0x3b, 0xc0, 0x4f, 0x37, 0x35, 0x34, 0x4f, 0x3e,
+	0xae, 0x3f, 0xf6, 0x27, 0xb9, 0x2e, 0x2e, 0x4f, 0xfe, 0x56, 0xbb, 0x78, 0xc8, 0x3b, 0x8e, 0x1e,
+	0x72, 0x3a, 0x34, 0x03, 0xfe, 0x2f, 0x29, 0xf7, 0x9d, 0xa5, 0x41, 0x66, 0xee, 0x14, 0x54, 0x1e,
+	0x75, 0x0a, 0xf0, 0xb0, 0x29, 0x1d, 0x6f, 0x57, 0x45, 0xd5, 0x06, 0xd0, 0xda, 0x2d, 0x16, 0x73,
+	0x80, 0x62, 0x7e, 0xc0, 0xd1, 0x6c, 0xbe, 0x14, 0x46, 0xde, 0x2f, 0x91, 0xb2, 0x48, 0xa0, 0x54,
+	0x5a, 0x3d, 0x09, 0x15, 0x6b, 0x12, 0xae, 0x17, 0x73, 0xf7, 0x69, 0xe4, 0xee, 0xbc, 0x35, 0x09,
+	0xc7, 0xf1, 0xf6, 0x15, 0x72, 0x7c, 0x14, 0xf2, 0xc8, 0x1c, 0xbe, 0x55, 0xcc, 0xe1, 0x5d, 0xe4,
+	0xf0, 0x59, 0xbd, 0xa8, 0x8f, 0x19, 0xd9, 0xf0, 0xf9, 0xd7, 0xd5, 0xf2, 0x38, 0xe8, 0x51, 0x79,
+	0x84, 0xe3, 0xf8, 0x0d, 0x71, 0x5f, 0x85, 0xa8, 0x98, 0xde, 0x57, 0x4d, 0x27, 0x5b, 0x58, 0x4b,
+	0xe5, 0x7c, 0xed, 0xec, 0x5f, 0xdd, 0xcd, 0xe1, 0x16, 0x64, 0x12, 0xe7, 0x0a, 0xf3, 0xc1, 0x98,
+	0x79, 0xbb, 0x2b, 0x94, 0x02, 0xf0, 0x66, 0x60, 0x81, 0xdb, 0xa0, 0x6c, 0xe6, 0x8d, 0x1c, 0x9f,
+	0x79, 0x23, 0x0f, 0x9d, 0x79, 0x23, 0xf9, 0x99, 0xb7, 0xb2, 0xd5, 0x3f, 0x74, 0x56, 0x7f, 0xd9,
+	0x7c, 0x98, 0x99, 0xfb, 0x37, 0x52, 0x18, 0x9f, 0x96, 0x4e, 0xda, 0x69, 0x3a, 0xe7, 0xdc, 0x5a,
+	0xcc, 0x99, 0xad, 0x0b, 0xee, 0x3b, 0x8a, 0x83, 0xd1, 0x54, 0xe5, 0x90, 0x0c, 0x00, 0xb0, 0x38,
+	0x0c, 0xa6, 0x5f, 0x6a, 0xf2, 0x92, 0x36, 0x01, 0xb4, 0xae, 0x15, 0x8b, 0x36, 0x42, 0xd1, 0xce,
+	0x39, 0x1b, 0x3b, 0xc3, 0xb0, 0x91, 0xea, 0xef, 0x49, 0x61, 0x60, 0xfd, 0x58, 0x52, 0xf9, 0x74,
+	0xc9, 0x74, 0x94, 0x5c, 0x7f, 0x3b, 0xb0, 0x32, 0xee, 0xc7, 0x0e, 0xf7, 0x05, 0x8c, 0x19, 0xee,
+	0xbf, 0x49, 0x72, 0x22, 0xff, 0xf7, 0x26, 0x1d, 0xd1, 0xba, 0x5c, 0xcc, 0xf5, 0xdb, 0xc8, 0xb5,
+	0xe7, 0xe8, 0xdc, 0x62, 0xc8, 0xf0, 0x7b, 0x90, 0x39, 0x91, 0xe4, 0xba, 0xa7, 0x8f, 0x17, 0x0f,
+	0x15, 0x36, 0x89, 0x9d, 0xde, 0x74, 0x3b, 0x33, 0x03, 0x7d, 0x36, 0xe7, 0x94, 0xf3, 0xb0, 0x7a,
+	0x29, 0x93, 0x34, 0x72, 0x24, 0xcd, 0x0c, 0x61, 0x18, 0xf8, 0x0e, 0xc9, 0x3d, 0x50, 0xc1, 0x9a,
+	0x02, 0xfa, 0xb1, 0xe1, 0x23, 0x69, 0xa7, 0x72, 0x1c, 0x25, 0x39, 0x99, 0x6a, 0x2a, 0x3b, 0x52,
+	0xe6, 0xcf, 0x63, 0xc7, 0x9f, 0xe7, 0xb0, 0x64, 0x78, 0x0e, 0xd3, 0x47, 0x3d, 0xf6, 0x94, 0xac,
+	0xfd, 0x50, 0x37, 0x99, 0x8b, 0x56, 0xf9, 0x00, 0x47, 0x44, 0xeb, 0x63, 0xc5, 0x03, 0xcf, 0x9a,
+	0xc4, 0x4a, 0x5b, 0xbb, 0x1d, 0x9b, 0x31, 0xbf, 0x48, 0x8a, 0xcf, 0x92, 0xa5, 0xca, 0x4a, 0x16,
+	0x6f, 0xc5, 0x5a, 0xbc, 0xad, 0x4e, 0x31, 0x3f, 0xf7, 0x90, 0x9f, 0xa7, 0x0c, 0x3f, 0xb9, 0x63,
+	0x1a, 0xce, 0xfe, 0x92, 0x94, 0x9f, 0x63, 0x1f, 0xd9, 0x53, 0x25, 0xe9, 0xd3, 0xaa, 0x95, 0x3e,
+	0x2d, 0xb3, 0xd2, 0xf7, 0x73, 0x62, 0x94, 0x7c, 0x5e, 0xb2, 0x31, 0x4a, 0x01, 0xcf, 0x45, 0xb7,
+	0x6a, 0x05, 0xcb, 0xae, 0x2c, 0x46, 0x39, 0xcc, 0xc4, 0x28, 0xc7, 0xf1, 0xf6, 0xff, 0xa4, 0xe4,
+	0x5c, 0xff, 0xa8, 0xac, 0xe5, 0x67, 0xfd, 0x2a, 0x79, 0x59, 0x3f, 0x9d, 0x09, 0xae, 0x95, 0x64,
+	0x82, 0xeb, 0xd9, 0x4c, 0x70, 0xeb, 0xcd, 0x62, 0xe1, 0x8f, 0x50, 0xf8, 0xa6, 0xeb, 0x65, 0xb2,
+	0x42, 0x19, 0xd9, 0xbf, 0x4f, 0x0a, 0x93, 0x16, 0xef, 0x9d, 0xe4, 0x65, 0x9e, 0xe6, 0x1d, 0xd7,
+	0xd3, 0xe4, 0xb3, 0x66, 0xf8, 0xff, 0x47, 0x52, 0x90, 0x57, 0x01, 0x4e, 0xaf, 0xed, 0xed, 0x75,
+	0xb1, 0x2a, 0x42, 0x6d, 0x03, 0xdd, 0xb6, 0xab, 0x32, 0xa4, 0xf2, 0x53, 0x55, 0x19, 0x88, 0x91,
+	0xe2, 0xe9, 0x26, 0x68, 0x83, 0x07, 0xe3, 0xbe, 0xf2, 0x9c, 0xf8, 0xbb, 0xec, 0x88, 0xf4, 0x99,
+	0x9c, 0x23, 0x52, 0x8a, 0x45, 0x23, 0xc5, 0x17, 0x48, 0x41, 0x0a, 0xe8, 0x38, 0x29, 0xf2, 0x79,
+	0x2d, 0xe3, 0xeb, 0x57, 0x0a, 0x8e, 0x6e, 0xb9, 0x7c, 0x7d, 0x92, 0x2e, 0x6b, 0x1c, 0x9e, 0xfc,
+	0x93, 0x12, 0x17, 0x60, 0x65, 0x49, 0x95, 0xb8, 0x6c, 0xd2, 0x06, 0x22, 0xd5, 0xb5, 0x0e, 0x06,
+	0x4c, 0x09, 0xc0, 0x14, 0xad, 0x54, 0xad, 0xa2, 0x15, 0x7f, 0x52, 0x90, 0xbc, 0x4a, 0xdf, 0x96,
+	0x95, 0x49, 0xf2, 0x59, 0x47, 0x92, 0xdc, 0xee, 0x8c, 0x24, 0xd3, 0x82, 0x94, 0x58, 0x66, 0xc0,
+	0xab, 0xc5, 0x03, 0x3e, 0x20, 0x39, 0x23, 0x16, 0xea, 0xee, 0x0d, 0x08, 0xe5, 0xa3, 0xe9, 0x64,
+	0x1c, 0x09, 0x18, 0xe4, 0xe6, 0x75, 0x1c, 0x64, 0x81, 0x57, 0x6e, 0x5e, 0x07, 0xa5, 0x5c, 0x09,
+	0xc3, 0x89, 0x4e, 0xc5, 0xcb, 0x86, 0xa9, 0x5a, 0x94, 0xf7, 0x60, 0xb2, 0xe1, 0xff, 0x03, 0xc9,
+	0x4b, 0xd9, 0xbd, 0x2f, 0xcb, 0xbb, 0xc4, 0x7d, 0x7f, 0x4e, 0xea, 0xe2, 0x09, 0xe3, 0xb6, 0x0a,
+	0x55, 0x7f, 0x3b, 0x9b, 0x5a, 0xcc, 0x68, 0xbd, 0x24, 0xb4, 0xf9, 0x55, 0x39, 0xd2, 0x19, 0xdb,
+	0x22, 0x58, 0x5d, 0x99, 0x71, 0x3e, 0x53, 0x92, 0xac, 0xcc, 0x0d, 0xe7, 0x4a, 0x9c, 0xc8, 0xe7,
+	0x89, 0x63, 0x48, 0x0b, 0xfb, 0x35, 0xa3, 0xff, 0x0b, 0x29, 0x4c, 0x86, 0x82, 0xd6, 0x11, 0xd8,
+	0xe9, 0xab, 0xfb, 0x78, 0xdd, 0x04, 0x0c, 0x52, 0x76, 0xfa, 0x6a, 0xe7, 0xe8, 0x26, 0x84, 0xbb,
+	0xed, 0x5b, 0xea, 0xf8, 0x88, 0x81, 0xbc, 0x6c, 0x01, 0x9c, 0x4f, 0x11, 0x2e, 0xa7, 0x56, 0xb5,
+	0xca, 0x22, 0x8c, 0x5f, 0x27, 0x8e, 0x4d, 0x2d, 0xe0, 0xd2, 0x88, 0xf2, 0x55, 0x72, 0x7c, 0xea,
+	0xf6, 0x91, 0xcf, 0xec, 0xbc, 0x98, 0xbf, 0xdf, 0x24, 0xce, 0xa1, 0xfd, 0xb8, 0xa1, 0x0d, 0xa3,
+	0x3f, 0x22, 0xc5, 0xd9, 0x63, 0x54, 0xe0, 0x65, 0x6b, 0xce, 0x55, 0xcb, 0x52, 0x60, 0xc5, 0x56,
+	0x60, 0xc2, 0x74, 0xd5, 0xf2, 0x76, 0x0f, 0x97, 0x29, 0x63, 0x4f, 0xd3, 0x4a, 0x87, 0xe3, 0x79,
+	0xbd, 0xa8, 0x30, 0xa9, 0xd2, 0xe1, 0x65, 0x6e, 0xfb, 0x0b, 0xc4, 0x09, 0x02, 0x8b, 0x64, 0x32,
+	0x92, 0xff, 0x80, 0x64, 0x33, 0xe3, 0xef, 0xa3, 0xc4, 0x65, 0xfb, 0xf5, 0x77, 0xdc, 0xfd, 0x9a,
+	0xe6, 0xd2, 0xc8, 0xf0, 0xaf, 0xc9, 0x8e, 0x81, 0xd2, 0x4a, 0x27, 0x77, 0x0d, 0x2c, 0xef, 0x05,
+	0xd1, 0x5d, 0x73, 0xbd, 0x2d, 0x5b, 0xc9, 0xb5, 0x77, 0x5f, 0x95, 0x1f, 0xa8, 0x16, 0xd8, 0x93,
+	0xf6, 0x65, 0x25, 0x48, 0xa5, 0x7d, 0x19, 0xda, 0xdd, 0x3d, 0x55, 0x3f, 0x55, 0xe9, 0xee, 0x19,
+	0x83, 0x5b, 0xb7, 0x0c, 0x6e, 0xd9, 0x9e, 0xf9, 0x62, 0xde, 0x9e, 0xc9, 0xf0, 0x69, 0x84, 0xf9,
+	0x3f, 0x92, 0x73, 0x29, 0x71, 0xdc, 0x49, 0x3d, 0x77, 0x56, 0x1e, 0xe2, 0xa4, 0x8e, 0x59, 0x88,
+	0xe9, 0x70, 0x20, 0x6b, 0x60, 0x54, 0x2d, 0x4b, 0x02, 0x80, 0xb4, 0x0e, 0x52, 0x5f, 0x9e, 0xcc,
+	0xc6, 0x7d, 0x1d, 0x42, 0xda, 0xa0, 0xd6, 0x76, 0xb1, 0xe0, 0xbf, 0x4b, 0x9c, 0xa3, 0x64, 0x46,
+	0x26, 0x23, 0xf2, 0xff, 0x90, 0xdc, 0x0b, 0x97, 0xc7, 0x12, 0x3a, 0x75, 0x01, 0x2e, 0x27, 0xd2,
+	0x06, 0xb1, 0x57, 0xe8, 0xf2, 0x1b, 0x03, 0x31, 0xec, 0xef, 0x4d, 0xe4, 0xee, 0x50, 0x37, 0xbb,
+	0x4c, 0xf1, 0x89, 0x38, 0xc9, 0x07, 0x77, 0x09, 0x5b, 0x57, 0x8a, 0x85, 0xfd, 0x12, 0x71, 0x4e,
+	0xa1, 0x39, 0xd2, 0x18, 0x71, 0x3b, 0x74, 0xd1, 0x1a, 0x04, 0xa6, 0x00, 0x9b, 0xd6, 0x7e, 0x33,
+	0x80, 0x04, 0x9b, 0xc4, 0x44, 0x75, 0x6e, 0x00, 0xfe, 0xcb, 0xea, 0xc2, 0x39, 0xb7, 0x3e, 0x68,
+	0x23, 0x5d, 0x1f, 0x64, 0x6a, 0x83, 0xfc, 0x77, 0x09, 0x5d, 0x71, 0x6b, 0xd3, 0xde, 0xa7, 0xf2,
+	0xa8, 0xe7, 0x54, 0x71, 0x91, 0x48, 0xd7, 0x47, 0x25, 0x72, 0x70, 0x4d, 0xe0, 0x7f, 0x8e, 0xa8,
+	0xf5, 0xa7, 0xca, 0x96, 0x13, 0xef, 0xa7, 0xd9, 0xd4, 0xcd, 0x24, 0x99, 0xd6, 0x1b, 0xbc, 0x23,
+	0xd4, 0x86, 0x36, 0x00, 0x5c, 0xc6, 0x58, 0x2f, 0xb0, 0x3d, 0x99, 0xa9, 0x35, 0x51, 0xe7, 0x36,
+	0x08, 0x7a, 0xde, 0x0d, 0x0e, 0xad, 0x4d, 0xa0, 0x9b, 0xfe, 0x2f, 0xd0, 0x65, 0x3e, 0xb5, 0x99,
+	0x30, 0x0b, 0x8f, 0x38, 0x0b, 0xaf, 0x45, 0x69, 0x42, 0x16, 0xa9, 0x4c, 0x3f, 0xb3, 0xcd, 0x9e,
+	0xfc, 0x9e, 0x5b, 0x54, 0xfe, 0xa7, 0x28, 0x85, 0x9a, 0x71, 0xd5, 0xb3, 0x34, 0x3d, 0x24, 0x31,
+	0x3d, 0xba, 0x64, 0xaa, 0x62, 0x95, 0x4c, 0x5d, 0xa0, 0xf3, 0x7c, 0x2a, 0x87, 0xa8, 0xba, 0x35,
+	0xf6, 0x36, 0x93, 0x5c, 0x13, 0xf9, 0xbf, 0x4d, 0xe8, 0x19, 0xfb, 0xca, 0x72, 0x67, 0x12, 0x24,
+	0xa1, 0x93, 0xac, 0x58, 0xdf, 0x03, 0x42, 0x8f, 0x38, 0xe5, 0x5c, 0x86, 0x29, 0x9e, 0x90, 0x94,
+	0xd9, 0xb8, 0xdf, 0x73, 0x6d, 0x5c, 0xc1, 0x80, 0x66, 0x07, 0xbc, 0x93, 0x77, 0x5d, 0x0a, 0xb7,
+	0x4d, 0xc6, 0x36, 0xa9, 0x18, 0xd7, 0x82, 0x94, 0x05, 0x91, 0xbf, 0xef, 0x06, 0x91, 0xd9, 0xce,
+	0xcd, 0xd8, 0xff, 0x44, 0xca, 0xef, 0x64, 0x1f, 0x2b, 0x29, 0x7a, 0xac, 0xd5, 0x69, 0xdd, 0x28,
+	0x66, 0xfe, 0x0f, 0x88, 0x93, 0x06, 0x29, 0x63, 0xce, 0x88, 0xf1, 0x37, 0xa4, 0xe8, 0xe2, 0xf8,
+	0x3d, 0x12, 0xa0, 0xe4, 0xa4, 0xfd, 0x87, 0x52, 0x80, 0xb3, 0x56, 0x60, 0x5d, 0x16, 0x72, 0x7c,
+	0x9d, 0xd0, 0x65, 0x75, 0xc9, 0x1c, 0xca, 0x5a, 0xa3, 0x4d, 0xf9, 0x90, 0x48, 0x9e, 0x59, 0xe4,
+	0xd6, 0x36, 0x00, 0xab, 0x42, 0xcd, 0x76, 0xd5, 0x6d, 0x70, 0xc5, 0xf0, 0xf2, 0x42, 0xee, 0x84,
+	0x65, 0x2e, 0x1b, 0xec, 0x22, 0x6d, 0xe8, 0x0b, 0x02, 0x5d, 0xb4, 0xe3, 0xd9, 0xdb, 0x50, 0x23,
+	0xd5, 0xdb, 0x2a, 0x4d, 0x6a, 0x8e, 0x97, 0x75, 0xfb, 0x78, 0xf9, 0x65, 0x92, 0xbd, 0x83, 0x7f,
+	0x2c, 0x05, 0x5b, 0xb6, 0xab, 0xea, 0xd8, 0xae, 0xb2, 0x08, 0xe8, 0x8f, 0xdc, 0x08, 0x28, 0xcd,
+	0x88, 0x51, 0xe9, 0xaf, 0x91, 0xfc, 0xa2, 0x00, 0x73, 0x12, 0x24, 0xf6, 0xfb, 0xb5, 0x35, 0x5a,
+	0xed, 0xc6, 0xda, 0x29, 0xc0, 0xcf, 0xb2, 0xd3, 0xf1, 0x1f, 0x13, 0xa7, 0x26, 0x39, 0x6f, 0x18,
+	0xfb, 0x74, 0xcc, 0x34, 0xae, 0x2d, 0x64, 0xb2, 0x65, 0x12, 0x82, 0xc2, 0xe0, 0x56, 0x23, 0x29,
+	0x47, 0xae, 0xf1, 0xa4, 0x2d, 0x2b, 0x88, 0x45, 0x98, 0x2a, 0x7b, 0x77, 0x60, 0xce, 0x45, 0x57,
+	0xd5, 0x2d, 0x8b, 0xf7, 0xff, 0x96, 0xd0, 0x55, 0x75, 0x08, 0x82, 0x40, 0xff, 0xb6, 0xaa, 0x60,
+	0x2d, 0x70, 0x14, 0xe9, 0x98, 0xa8, 0x92, 0x13, 0x13, 0xe9, 0xa3, 0x54, 0xfb, 0x96, 0xda, 0x07,
+	0xba, 0x99, 0x60, 0xba, 0xb1, 0x8a, 0x08, 0x75, 0xd3, 0x9a, 0xf6, 0x7a, 0xfa, 0x0e, 0x48, 0x5e,
+	0xea, 0x80, 0xe8, 0x73, 0x88, 0x32, 0x00, 0xff, 0x2a, 0x5d, 0x4e, 0xe6, 0x54, 0x6f, 0x04, 0xe3,
+	0x73, 0x49, 0x89, 0xcf, 0xad, 0x38, 0x3e, 0x17, 0xca, 0xdf, 0x56, 0x71, 0x6a, 0x2d, 0xa5, 0x5b,
+	0x65, 0xbc, 0xc4, 0x2d, 0xe3, 0xf5, 0xe9, 0x92, 0xf3, 0xba, 0x4d, 0x29, 0xc1, 0x86, 0xb1, 0x16,
+	0x6d, 0x24, 0xac, 0xa1, 0x1a, 0x8c, 0xab, 0x71, 0x58, 0xe6, 0x86, 0xcc, 0x7f, 0x40, 0xe8, 0x89,
+	0xcc, 0x1e, 0x63, 0x3f, 0x45, 0xeb, 0x38, 0x35, 0x1e, 0x71, 0x6e, 0x36, 0x52, 0x73, 0xc6, 0x25,
+	0x11, 0x7b, 0x8d, 0x2e, 0xd9, 0x5f, 0x2b, 0x47, 0xaa, 0x0d, 0x7b, 0x76, 0x6d, 0x71, 0x87, 0xdc,
+	0xff, 0x0f, 0xa2, 0xee, 0x36, 0x5d, 0xbd, 0x3a, 0xd2, 0x90, 0x87, 0x92, 0x86, 0x5d, 0xa4, 0x54,
+	0x86, 0x4b, 0xc9, 0xfb, 0x4f, 0xc3, 0x7c, 0x4a, 0xd7, 0xdc, 0xa2, 0x64, 0xaf, 0xd3, 0x65, 0x47,
+	0x09, 0x4a, 0x7b, 0xc5, 0x46, 0xc8, 0x25, 0x77, 0x97, 0x8c, 0x2c, 0xe0, 0xb4, 0x96, 0xcc, 0x88,
+	0x9e, 0x72, 0xc8, 0x93, 0xcc, 0x50, 0xb9, 0x0d, 0x75, 0xac, 0x62, 0xe5, 0xa1, 0xad, 0xa2, 0xff,
+	0x3d, 0x52, 0x58, 0x53, 0xf4, 0xb8, 0xb7, 0x87, 0xce, 0xd2, 0xab, 0x66, 0x97, 0x5e, 0x59, 0xa0,
+	0xf1, 0x2e, 0xc9, 0xb9, 0x3e, 0xcc, 0x70, 0xe6, 0xe4, 0x52, 0x4a, 0xaa, 0x9e, 0x4a, 0xec, 0x84,
+	0xae, 0x8b, 0xaf, 0x58, 0x75, 0xf1, 0x8f, 0x9a, 0x48, 0xd9, 0x29, 0x96, 0xe3, 0x4f, 0x88, 0x73,
+	0xb7, 0x50, 0xcc, 0xa2, 0x73, 0xb3, 0xb8, 0x8d, 0xe7, 0xa7, 0x60, 0x38, 0x88, 0x8f, 0x1e, 0x7b,
+	0x55, 0x37, 0xe9, 0xa2, 0xd5, 0x8d, 0x92, 0xcf, 0x06, 0xf9, 0x9f, 0xa6, 0x1b, 0xb6, 0xf7, 0x4e,
+	0x8d, 0x99, 0x97, 0xca, 0x7f, 0x25, 0xdd, 0xa7, 0xfd, 0x40, 0x23, 0xd5, 0x81, 0x3b, 0xd6, 0xa7,
+	0xe8, 0x49, 0xab, 0x99, 0xac, 0xe5, 0x97, 0xc1, 0x6b, 0xdd, 0x9e, 0xe8, 0x97, 0x21, 0xe7, 0xb3,
+	0xef, 0x92, 0xd2, 0xbd, 0x4a, 0x7a, 0x70, 0x6c, 0x57, 0x42, 0x9d, 0x0c, 0x85, 0x9f, 0xfe, 0x0f,
+	0x93, 0xdc, 0x40, 0xa6, 0xae, 0x2d, 0x73, 0xe2, 0x71, 0x9f, 0x7b, 0xd6, 0x9d, 0xe7, 0x92, 0xb1,
+	0x9d, 0x79, 0x8e, 0xb3, 0xcf, 0x25, 0x6b, 0xe9, 0xe7, 0x92, 0x65, 0xcb, 0xf8, 0xcb, 0x79, 0x39,
+	0x81, 0x0c, 0x7f, 0xce, 0x1d, 0x3e, 0xbe, 0x1a, 0xc5, 0x23, 0xc2, 0xad, 0xe4, 0x88, 0x70, 0x8b,
+	0x9d, 0xa5, 0x95, 0x6e, 0xac, 0x6c, 0x53, 0xea, 0x99, 0x69, 0xa5, 0x1b, 0xc3, 0xf3, 0x68, 0xf5,
+	0x16, 0xa5, 0xea, 0x3e, 0x8f, 0xbe, 0xd5, 0x8d, 0xe5, 0xbe, 0x8f, 0xf4, 0x33, 0x3a, 0x6c, 0x6c,
+	0xf4, 0xe8, 0xa2, 0x05, 0xb6, 0x9f, 0xb9, 0xd5, 0xe4, 0x33, 0xb7, 0x0b, 0xee, 0x7b, 0xdc, 0x62,
+	0x1b, 0x62, 0x3d, 0x80, 0xfb, 0x77, 0x42, 0xd7, 0xd2, 0x8f, 0x93, 0x61, 0xeb, 0x09, 0x6c, 0xf4,
+	0x55, 0x75, 0xb9, 0x6e, 0x82, 0x21, 0x13, 0xd6, 0x2d, 0x00, 0xbc, 0xa6, 0x33, 0x00, 0x58, 0x7f,
+	0x93, 0x69, 0xa7, 0xaf, 0x5f, 0x78, 0xc0, 0x6f, 0x76, 0x96, 0x56, 0xa7, 0xb1, 0x4e, 0x35, 0x2d,
+	0x5a, 0x32, 0x72, 0x80, 0x43, 0x87, 0xfb, 0xb3, 0x30, 0x04, 0xdd, 0x0a, 0x4c, 0xdb, 0xd4, 0xb9,
+	0x01, 0x80, 0x15, 0x9b, 0x86, 0x42, 0x22, 0xe7, 0x10, 0x99, 0xb4, 0x41, 0xfe, 0x28, 0xdc, 0xf7,
+	0xe6, 0xa5, 0xfc, 0x51, 0x88, 0x4f, 0x2f, 0xfb, 0x22, 0x8a, 0xf1, 0xf5, 0x59, 0x8d, 0xe3, 0x6f,
+	0x78, 0xa6, 0x99, 0x53, 0x1d, 0xc9, 0x5e, 0x52, 0x72, 0xa0, 0x1b, 0x93, 0xbb, 0xb3, 0xf0, 0xa9,
+	0xb6, 0xa1, 0x2c, 0x3b, 0xe5, 0x7c, 0xc5, 0x3d, 0xe5, 0x64, 0xc7, 0x34, 0x2b, 0x06, 0x78, 0xca,
+	0x56, 0x66, 0xbe, 0x07, 0x3c, 0x7d, 0xd5, 0xe5, 0x29, 0x3b, 0xa6, 0x93, 0x6a, 0xcc, 0xab, 0x0a,
+	0x7d, 0xd4, 0x45, 0xbd, 0x49, 0x1b, 0xe8, 0x6d, 0xf1, 0xfd, 0xbe, 0x5c, 0x06, 0x06, 0xe0, 0x3c,
+	0x79, 0x26, 0xe6, 0xc9, 0x76, 0x59, 0xee, 0xe6, 0x6b, 0x79, 0xb9, 0x1b, 0x87, 0x45, 0x23, 0x43,
+	0x9c, 0x57, 0xbf, 0xea, 0x2e, 0xe6, 0x8a, 0xb5, 0x98, 0xcb, 0x34, 0xf7, 0xa7, 0xae, 0xe6, 0xb2,
+	0xdd, 0x9a, 0x51, 0xbf, 0x4b, 0x4a, 0xcb, 0x63, 0x0b, 0xde, 0x19, 0xa1, 0x0f, 0x4b, 0x62, 0x45,
+	0xfc, 0x5d, 0x16, 0x49, 0x97, 0x5d, 0xd4, 0x7f, 0x5d, 0xf2, 0xea, 0x97, 0xfc, 0xc7, 0x80, 0x0c,
+	0xd3, 0x03, 0x7a, 0x2a, 0xb7, 0x62, 0xf7, 0x91, 0xcb, 0x0a, 0x32, 0xef, 0xaf, 0x2a, 0xe9, 0xf7,
+	0x57, 0xdf, 0x23, 0xe5, 0xd5, 0xc1, 0x05, 0x0a, 0xba, 0x48, 0xe7, 0x25, 0x99, 0x0e, 0x89, 0x36,
+	0xf3, 0xe5, 0x93, 0x44, 0x5c, 0x13, 0x97, 0x9d, 0xe5, 0xff, 0xcc, 0x3d, 0xcb, 0x97, 0x31, 0x65,
+	0x34, 0xf5, 0xdf, 0xe4, 0x98, 0xd2, 0xe5, 0x52, 0x95, 0x6d, 0xe5, 0xd7, 0x99, 0xe6, 0x94, 0x09,
+	0xfc, 0x4c, 0x12, 0x94, 0xc9, 0x90, 0xb4, 0xf4, 0x99, 0xa9, 0x22, 0x6d, 0xdd, 0x2c, 0x16, 0xf6,
+	0xcf, 0xa5, 0xb0, 0x4f, 0xbb, 0x57, 0xc0, 0xf9, 0x22, 0xb8, 0x8b, 0xb9, 0xa4, 0x0a, 0xfb, 0x27,
+	0x23, 0x6b, 0xd9, 0x62, 0xfe, 0x86, 0xbb, 0x98, 0x4b, 0x78, 0x31, 0x4c, 0x0f, 0x73, 0x0a, 0xc3,
+	0x73, 0xaf, 0x02, 0x4b, 0x12, 0xe2, 0xdf, 0x24, 0x39, 0x55, 0x64, 0x56, 0x7f, 0x66, 0xb4, 0x3b,
+	0x99, 0x7a, 0xf3, 0xdc, 0xb1, 0x2e, 0x15, 0x8f, 0xf5, 0x17, 0x24, 0x53, 0x46, 0x96, 0x3b, 0xd2,
+	0xbb, 0x24, 0xaf, 0x8a, 0xbd, 0xb4, 0x2e, 0x09, 0xee, 0x77, 0x27, 0xc3, 0x64, 0x8b, 0xc2, 0x6f,
+	0x0c, 0x81, 0xc5, 0xbd, 0xc9, 0x5d, 0xa1, 0x2a, 0xed, 0x54, 0xab, 0xcc, 0xfc, 0x7d, 0x2b, 0x73,
+	0xef, 0x9b, 0x62, 0xc2, 0xc9, 0x75, 0x15, 0x95, 0xd4, 0x27, 0xdc, 0x10, 0x8b, 0x9b, 0x0f, 0xd2,
+	0x3a, 0xbe, 0xb8, 0x53, 0x3e, 0x24, 0xfb, 0x3e, 0x4f, 0xa2, 0x0b, 0xb9, 0x2e, 0x89, 0xdc, 0xbe,
+	0xed, 0x46, 0x6e, 0x05, 0x5c, 0x19, 0xd6, 0x7f, 0x83, 0xe4, 0x16, 0xfc, 0xb3, 0xe7, 0x68, 0x1d,
+	0xdb, 0xa9, 0xb0, 0xdd, 0xfd, 0x4f, 0x29, 0x92, 0xa4, 0xcc, 0x75, 0x7d, 0x87, 0xa4, 0x8b, 0xdf,
+	0xd2, 0x23, 0x19, 0x56, 0xfe, 0x8b, 0xe4, 0x3c, 0x31, 0xf8, 0xc9, 0xe6, 0xb2, 0x92, 0x94, 0x75,
+	0xcd, 0x4a, 0x59, 0x7b, 0xe6, 0xd1, 0x70, 0x1d, 0xd5, 0xac, 0x9b, 0xfa, 0xfd, 0xef, 0x9c, 0x0c,
+	0xaf, 0x7a, 0xe2, 0xed, 0xb2, 0xdd, 0xf3, 0xdd, 0xf4, 0x75, 0x52, 0x4a, 0x92, 0x44, 0xd0, 0x1f,
+	0x0f, 0x00, 0xc4, 0x01, 0xce, 0x49, 0xff, 0x49, 0x00, 0x00,
 }
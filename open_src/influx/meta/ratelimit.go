@@ -0,0 +1,61 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"github.com/gogo/protobuf/proto"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+)
+
+// RateLimitInfo limits the writes and the queries of either a database or a user. A zero rate means unlimited.
+type RateLimitInfo struct {
+	Database string
+	User     string
+
+	PointsPerSecond  int64
+	BytesPerSecond   int64
+	QueriesPerSecond int64
+}
+
+func (rl *RateLimitInfo) unlimited() bool {
+	return rl.PointsPerSecond == 0 && rl.BytesPerSecond == 0 && rl.QueriesPerSecond == 0
+}
+
+// Applies returns true if the limit is the one of the database or of the user.
+func (rl *RateLimitInfo) Applies(database, user string) bool {
+	return rl.Database != "" && rl.Database == database || rl.User != "" && rl.User == user
+}
+
+// Marshal serializes to a protobuf representation.
+func (rl RateLimitInfo) Marshal() *proto2.RateLimitInfo {
+	return &proto2.RateLimitInfo{
+		Database:         proto.String(rl.Database),
+		User:             proto.String(rl.User),
+		PointsPerSecond:  proto.Int64(rl.PointsPerSecond),
+		BytesPerSecond:   proto.Int64(rl.BytesPerSecond),
+		QueriesPerSecond: proto.Int64(rl.QueriesPerSecond),
+	}
+}
+
+// Unmarshal deserializes from a protobuf representation.
+func (rl *RateLimitInfo) Unmarshal(pb *proto2.RateLimitInfo) {
+	rl.Database = pb.GetDatabase()
+	rl.User = pb.GetUser()
+	rl.PointsPerSecond = pb.GetPointsPerSecond()
+	rl.BytesPerSecond = pb.GetBytesPerSecond()
+	rl.QueriesPerSecond = pb.GetQueriesPerSecond()
+}
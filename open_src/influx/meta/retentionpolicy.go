@@ -29,6 +29,7 @@ type RetentionPolicyInfo struct {
 	Measurements       map[string]*MeasurementInfo
 	ShardGroups        []ShardGroupInfo
 	Subscriptions      []SubscriptionInfo
+	DownSamplePolicy   *DownSamplePolicyInfo
 	MarkDeleted        bool
 }
 
@@ -310,6 +311,10 @@ func (rpi *RetentionPolicyInfo) Marshal() *proto2.RetentionPolicyInfo {
 		}
 	}
 
+	if rpi.DownSamplePolicy != nil {
+		pb.DownSamplePolicy = rpi.DownSamplePolicy.Marshal()
+	}
+
 	return pb
 }
 
@@ -353,6 +358,11 @@ func (rpi *RetentionPolicyInfo) unmarshal(pb *proto2.RetentionPolicyInfo) {
 			rpi.Subscriptions[i].unmarshal(x)
 		}
 	}
+
+	if pb.DownSamplePolicy != nil {
+		rpi.DownSamplePolicy = &DownSamplePolicyInfo{}
+		rpi.DownSamplePolicy.Unmarshal(pb.GetDownSamplePolicy())
+	}
 }
 
 // Clone returns a deep copy of rpi.
@@ -380,6 +390,15 @@ func (rpi RetentionPolicyInfo) Clone() *RetentionPolicyInfo {
 		}
 	}
 
+	if rpi.Subscriptions != nil {
+		other.Subscriptions = make([]SubscriptionInfo, len(rpi.Subscriptions))
+		for i := range rpi.Subscriptions {
+			other.Subscriptions[i] = rpi.Subscriptions[i].clone()
+		}
+	}
+
+	other.DownSamplePolicy = rpi.DownSamplePolicy.Clone()
+
 	return &other
 }
 
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"regexp"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+)
+
+// RoleInfo represents metadata about a role, a named set of privileges granted to users.
+type RoleInfo struct {
+	Name   string
+	Grants []RoleGrant
+}
+
+// RoleGrant is a privilege granted to a role on the measurements of a scope. An empty database or retention policy
+// covers all of them, and an empty measurement without a regex covers all the measurements.
+type RoleGrant struct {
+	Privilege       influxql.RolePrivilege
+	Database        string
+	RetentionPolicy string
+	Measurement     string
+	Regex           string
+
+	re *regexp.Regexp
+	// the retention policy of the grant is the default one of its database, set when the grant is resolved for
+	// the users of the role
+	defaultRP bool
+}
+
+// NewRoleGrant returns the grant of the privilege on the scope.
+func NewRoleGrant(p influxql.RolePrivilege, scope *influxql.PrivilegeScope) RoleGrant {
+	g := RoleGrant{
+		Privilege:       p,
+		Database:        scope.Database,
+		RetentionPolicy: scope.RetentionPolicy,
+		Measurement:     scope.Measurement,
+	}
+	if scope.Regex != nil {
+		g.Regex = scope.Regex.Val.String()
+		g.re = scope.Regex.Val
+	}
+	return g
+}
+
+// Scope returns the scope the privilege is granted on.
+func (g *RoleGrant) Scope() *influxql.PrivilegeScope {
+	scope := &influxql.PrivilegeScope{
+		Database:        g.Database,
+		RetentionPolicy: g.RetentionPolicy,
+		Measurement:     g.Measurement,
+	}
+	if g.re != nil {
+		scope.Regex = &influxql.RegexLiteral{Val: g.re}
+	}
+	return scope
+}
+
+func (g *RoleGrant) sameScope(other *RoleGrant) bool {
+	return g.Database == other.Database && g.RetentionPolicy == other.RetentionPolicy &&
+		g.Measurement == other.Measurement && g.Regex == other.Regex
+}
+
+// covers reports whether the grant allows what the statement requires.
+func (g *RoleGrant) covers(req scopedPrivilege) bool {
+	if !g.Privilege.Includes(req.privilege) {
+		return false
+	}
+	if g.Database != "" && g.Database != req.database {
+		return false
+	}
+	if g.RetentionPolicy != "" {
+		if req.defaultRP && !g.defaultRP || !req.defaultRP && g.RetentionPolicy != req.rp {
+			return false
+		}
+	}
+	if g.re != nil {
+		return req.measurement != "" && g.re.MatchString(req.measurement)
+	}
+	return g.Measurement == "" || g.Measurement == req.measurement
+}
+
+// clone returns a deep copy of ri.
+func (ri RoleInfo) clone() RoleInfo {
+	other := ri
+	if ri.Grants != nil {
+		other.Grants = make([]RoleGrant, len(ri.Grants))
+		copy(other.Grants, ri.Grants)
+	}
+	return other
+}
+
+// marshal serializes to a protobuf representation.
+func (ri RoleInfo) marshal() *proto2.RoleInfo {
+	pb := &proto2.RoleInfo{
+		Name:   proto.String(ri.Name),
+		Grants: make([]*proto2.RoleGrant, len(ri.Grants)),
+	}
+	for i := range ri.Grants {
+		pb.Grants[i] = ri.Grants[i].Marshal()
+	}
+	return pb
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (ri *RoleInfo) unmarshal(pb *proto2.RoleInfo) {
+	ri.Name = pb.GetName()
+	ri.Grants = make([]RoleGrant, len(pb.GetGrants()))
+	for i, g := range pb.GetGrants() {
+		ri.Grants[i].Unmarshal(g)
+	}
+}
+
+// Marshal serializes to a protobuf representation.
+func (g RoleGrant) Marshal() *proto2.RoleGrant {
+	return &proto2.RoleGrant{
+		Privilege:       proto.Int32(int32(g.Privilege)),
+		Database:        proto.String(g.Database),
+		RetentionPolicy: proto.String(g.RetentionPolicy),
+		Measurement:     proto.String(g.Measurement),
+		Regex:           proto.String(g.Regex),
+	}
+}
+
+// Unmarshal deserializes from a protobuf representation.
+func (g *RoleGrant) Unmarshal(pb *proto2.RoleGrant) {
+	g.Privilege = influxql.RolePrivilege(pb.GetPrivilege())
+	g.Database = pb.GetDatabase()
+	g.RetentionPolicy = pb.GetRetentionPolicy()
+	g.Measurement = pb.GetMeasurement()
+	g.Regex = pb.GetRegex()
+	g.re = nil
+	if g.Regex != "" {
+		// the regex was validated when it was granted
+		g.re, _ = regexp.Compile(g.Regex)
+	}
+}
+
+// scopedPrivilege is a privilege a statement requires on a measurement. An empty database, retention policy or
+// measurement stands for all of them, except when the statement leaves the retention policy to the default one
+// of the database.
+type scopedPrivilege struct {
+	privilege   influxql.RolePrivilege
+	database    string
+	rp          string
+	defaultRP   bool
+	measurement string
+}
+
+// statementPrivileges returns the role privileges required to execute the statement on the database.
+func statementPrivileges(stmt influxql.Statement, database string) ([]scopedPrivilege, error) {
+	dbOr := func(db string) string {
+		if db == "" {
+			return database
+		}
+		return db
+	}
+	onDatabase := func(p influxql.RolePrivilege, db string) []scopedPrivilege {
+		return []scopedPrivilege{{privilege: p, database: dbOr(db)}}
+	}
+
+	switch s := stmt.(type) {
+	case *influxql.SelectStatement:
+		return selectPrivileges(s, database), nil
+	case *influxql.ExplainStatement:
+		return selectPrivileges(s.Statement, database), nil
+	case *influxql.ShowMeasurementsStatement:
+		return onDatabase(influxql.ReadRolePrivilege, s.Database), nil
+	case *influxql.ShowRetentionPoliciesStatement:
+		return onDatabase(influxql.ReadRolePrivilege, s.Database), nil
+	case *influxql.ShowDownSamplesStatement:
+		return onDatabase(influxql.ReadRolePrivilege, s.Database), nil
+	case *influxql.ShowSeriesStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowTagKeysStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowTagValuesStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowFieldKeysStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowSeriesCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowMeasurementCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowTagKeyCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowTagCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowTagValuesCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.ShowFieldKeyCardinalityStatement:
+		return sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, dbOr(s.Database)), nil
+	case *influxql.DeleteSeriesStatement:
+		return sourcesPrivileges(influxql.WriteRolePrivilege, s.Sources, database), nil
+	case *influxql.DropSeriesStatement:
+		return sourcesPrivileges(influxql.WriteRolePrivilege, s.Sources, database), nil
+	case *influxql.CreateDatabaseStatement:
+		return onDatabase(influxql.DDLRolePrivilege, s.Name), nil
+	case *influxql.DropDatabaseStatement:
+		return onDatabase(influxql.DDLRolePrivilege, s.Name), nil
+	case *influxql.CreateRetentionPolicyStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.Name}}, nil
+	case *influxql.AlterRetentionPolicyStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.Name}}, nil
+	case *influxql.DropRetentionPolicyStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.Name}}, nil
+	case *influxql.CreateMeasurementStatement:
+		return []scopedPrivilege{measurementPrivilege(influxql.DDLRolePrivilege, dbOr(s.Database), s.RetentionPolicy, s.Name)}, nil
+	case *influxql.AlterShardKeyStatement:
+		return []scopedPrivilege{measurementPrivilege(influxql.DDLRolePrivilege, dbOr(s.Database), s.RetentionPolicy, s.Name)}, nil
+	case *influxql.DropMeasurementStatement:
+		// the measurement is dropped from all the retention policies
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: database, measurement: s.Name}}, nil
+	case *influxql.CreateContinuousQueryStatement:
+		return onDatabase(influxql.DDLRolePrivilege, s.Database), nil
+	case *influxql.DropContinuousQueryStatement:
+		return onDatabase(influxql.DDLRolePrivilege, s.Database), nil
+	case *influxql.CreateDownSampleStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.RetentionPolicy}}, nil
+	case *influxql.DropDownSampleStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.RetentionPolicy}}, nil
+	case *influxql.CreateSubscriptionStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.RetentionPolicy}}, nil
+	case *influxql.DropSubscriptionStatement:
+		return []scopedPrivilege{{privilege: influxql.DDLRolePrivilege, database: dbOr(s.Database), rp: s.RetentionPolicy}}, nil
+	}
+
+	privs, err := stmt.RequiredPrivileges()
+	if err != nil {
+		return nil, err
+	}
+	var required []scopedPrivilege
+	for _, p := range privs {
+		switch {
+		case p.Admin:
+			required = append(required, scopedPrivilege{privilege: influxql.AdminRolePrivilege})
+		case p.Privilege == influxql.ReadPrivilege:
+			required = append(required, onDatabase(influxql.ReadRolePrivilege, p.Name)...)
+		case p.Privilege == influxql.WritePrivilege:
+			required = append(required, onDatabase(influxql.WriteRolePrivilege, p.Name)...)
+		case p.Privilege == influxql.AllPrivileges:
+			required = append(required, onDatabase(influxql.AllRolePrivileges, p.Name)...)
+		}
+	}
+	return required, nil
+}
+
+func measurementPrivilege(p influxql.RolePrivilege, database, rp, name string) scopedPrivilege {
+	return scopedPrivilege{privilege: p, database: database, rp: rp, defaultRP: rp == "", measurement: name}
+}
+
+// selectPrivileges returns the privileges to read the sources of the statement and to write into its target.
+func selectPrivileges(s *influxql.SelectStatement, database string) []scopedPrivilege {
+	required := sourcesPrivileges(influxql.ReadRolePrivilege, s.Sources, database)
+	if s.Target != nil && s.Target.Measurement != nil {
+		m := s.Target.Measurement
+		db := m.Database
+		if db == "" {
+			db = database
+		}
+		required = append(required, measurementPrivilege(influxql.WriteRolePrivilege, db, m.RetentionPolicy, m.Name))
+	}
+	return required
+}
+
+// sourcesPrivileges returns the privilege on each measurement of the sources, or on the whole database if there is
+// no source. A regex source requires the privilege on all the measurements of its retention policy.
+func sourcesPrivileges(p influxql.RolePrivilege, sources influxql.Sources, database string) []scopedPrivilege {
+	if len(sources) == 0 {
+		return []scopedPrivilege{{privilege: p, database: database}}
+	}
+	var required []scopedPrivilege
+	for _, source := range sources {
+		switch source := source.(type) {
+		case *influxql.Measurement:
+			db := source.Database
+			if db == "" {
+				db = database
+			}
+			name := source.Name
+			if source.Regex != nil {
+				name = ""
+			}
+			required = append(required, measurementPrivilege(p, db, source.RetentionPolicy, name))
+		case *influxql.SubQuery:
+			required = append(required, selectPrivileges(source.Statement, database)...)
+		case *influxql.Join:
+			required = append(required, sourcesPrivileges(p, influxql.Sources{source.LSrc, source.RSrc}, database)...)
+		}
+	}
+	return required
+}
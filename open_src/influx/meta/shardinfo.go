@@ -403,6 +403,34 @@ type ShardInfo struct {
 	Max     string
 	Tier    uint64
 	IndexID uint64
+	// Lagging are the owners whose replicas missed writes and are not read until they are re-synced
+	Lagging []ReplicaLag
+}
+
+// ReplicaLag marks the replica of a shard on a pt as missing writes, Seq identifies the last missed write.
+type ReplicaLag struct {
+	PtID uint32
+	Seq  uint64
+}
+
+// OwnedBy reports whether pt keeps a replica of the shard.
+func (si ShardInfo) OwnedBy(pt uint32) bool {
+	for _, owner := range si.Owners {
+		if owner == pt {
+			return true
+		}
+	}
+	return false
+}
+
+// LaggingOwner reports whether the replica of the shard on pt missed writes.
+func (si ShardInfo) LaggingOwner(pt uint32) bool {
+	for i := range si.Lagging {
+		if si.Lagging[i].PtID == pt {
+			return true
+		}
+	}
+	return false
 }
 
 func (si ShardInfo) Contain(shardKey string) bool {
@@ -434,6 +462,11 @@ func (si ShardInfo) clone() ShardInfo {
 		other.Owners[i] = si.Owners[i]
 	}
 
+	if len(si.Lagging) > 0 {
+		other.Lagging = make([]ReplicaLag, len(si.Lagging))
+		copy(other.Lagging, si.Lagging)
+	}
+
 	return other
 }
 
@@ -450,6 +483,12 @@ func (si ShardInfo) marshal() *proto2.ShardInfo {
 	for i := range si.Owners {
 		pb.OwnerIDs[i] = si.Owners[i]
 	}
+	for i := range si.Lagging {
+		pb.Lagging = append(pb.Lagging, &proto2.ReplicaLag{
+			PtID: proto.Uint32(si.Lagging[i].PtID),
+			Seq:  proto.Uint64(si.Lagging[i].Seq),
+		})
+	}
 
 	return pb
 }
@@ -476,6 +515,10 @@ func (si *ShardInfo) unmarshal(pb *proto2.ShardInfo) {
 	for i, x := range pb.GetOwnerIDs() {
 		si.Owners[i] = uint32(x)
 	}
+	si.Lagging = nil
+	for _, x := range pb.GetLagging() {
+		si.Lagging = append(si.Lagging, ReplicaLag{PtID: x.GetPtID(), Seq: x.GetSeq()})
+	}
 }
 
 // ShardOwner represents a node that owns a shard.
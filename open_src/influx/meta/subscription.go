@@ -10,6 +10,13 @@ import (
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
 )
 
+const (
+	// SubscriptionModeAll writes the points to all destinations of a subscription
+	SubscriptionModeAll = "ALL"
+	// SubscriptionModeAny writes the points to one of the destinations of a subscription in turn
+	SubscriptionModeAny = "ANY"
+)
+
 // SubscriptionInfo holds the subscription information.
 type SubscriptionInfo struct {
 	Name         string
@@ -41,3 +48,12 @@ func (si *SubscriptionInfo) unmarshal(pb *proto2.SubscriptionInfo) {
 		copy(si.Destinations, pb.GetDestinations())
 	}
 }
+
+func (si SubscriptionInfo) clone() SubscriptionInfo {
+	other := si
+	if si.Destinations != nil {
+		other.Destinations = make([]string, len(si.Destinations))
+		copy(other.Destinations, si.Destinations)
+	}
+	return other
+}
@@ -32,6 +32,12 @@ type UserInfo struct {
 
 	// Map of database name to granted privilege.
 	Privileges map[string]originql.Privilege
+
+	// Names of the roles granted to the user.
+	Roles []string
+
+	// privileges of the roles of the user, resolved when the roles or the user change
+	grants []RoleGrant
 }
 
 type User interface {
@@ -41,6 +47,13 @@ type User interface {
 	// AuthorizeQuery returns an error if the query cannot be executed
 	AuthorizeQuery(database string, query *influxql.Query) error
 
+	// AuthorizeMeasurement indicates whether the given privilege is authorized on the measurement of the
+	// retention policy, an empty retention policy being the default one of the database.
+	AuthorizeMeasurement(p influxql.RolePrivilege, database, rp, measurement string) bool
+
+	// HasRolePrivilege indicates whether a role of the user grants the privilege on any part of the database.
+	HasRolePrivilege(p influxql.RolePrivilege, database string) bool
+
 	query.FineAuthorizer
 	ID() string
 	AuthorizeUnrestricted() bool
@@ -59,6 +72,45 @@ func (u *UserInfo) AuthorizeDatabase(privilege originql.Privilege, database stri
 	return ok && (p == privilege || p == originql.AllPrivileges)
 }
 
+// AuthorizeMeasurement returns true if the user is authorized for the given privilege on the measurement, either
+// by the privilege on its database or by a role.
+func (u *UserInfo) AuthorizeMeasurement(p influxql.RolePrivilege, database, rp, measurement string) bool {
+	if u.Admin || u.Rwuser {
+		return true
+	}
+	switch p {
+	case influxql.ReadRolePrivilege:
+		if u.AuthorizeDatabase(originql.ReadPrivilege, database) {
+			return true
+		}
+	case influxql.WriteRolePrivilege:
+		if u.AuthorizeDatabase(originql.WritePrivilege, database) {
+			return true
+		}
+	}
+	return u.authorizeRoles(scopedPrivilege{privilege: p, database: database, rp: rp, defaultRP: rp == "", measurement: measurement})
+}
+
+// HasRolePrivilege returns true if a role of the user grants the privilege on the database or on a part of it.
+func (u *UserInfo) HasRolePrivilege(p influxql.RolePrivilege, database string) bool {
+	for i := range u.grants {
+		g := &u.grants[i]
+		if g.Privilege.Includes(p) && (g.Database == "" || g.Database == database) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UserInfo) authorizeRoles(req scopedPrivilege) bool {
+	for i := range u.grants {
+		if u.grants[i].covers(req) {
+			return true
+		}
+	}
+	return false
+}
+
 func (u *UserInfo) IsOpen() bool {
 	return true
 }
@@ -88,6 +140,10 @@ func (u UserInfo) clone() UserInfo {
 			other.Privileges[k] = v
 		}
 	}
+	if u.Roles != nil {
+		other.Roles = make([]string, len(u.Roles))
+		copy(other.Roles, u.Roles)
+	}
 
 	return other
 }
@@ -99,6 +155,7 @@ func (u UserInfo) marshal() *proto2.UserInfo {
 		Hash:   proto.String(u.Hash),
 		Admin:  proto.Bool(u.Admin),
 		RwUser: proto.Bool(u.Rwuser),
+		Roles:  u.Roles,
 	}
 
 	for database, privilege := range u.Privileges {
@@ -117,6 +174,7 @@ func (u *UserInfo) unmarshal(pb *proto2.UserInfo) {
 	u.Hash = pb.GetHash()
 	u.Admin = pb.GetAdmin()
 	u.Rwuser = pb.GetRwUser()
+	u.Roles = pb.GetRoles()
 
 	if len(pb.Privileges) > 0 {
 		u.Privileges = make(map[string]originql.Privilege)
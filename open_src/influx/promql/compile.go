@@ -0,0 +1,430 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+)
+
+const (
+	// LookbackDelta is how far back an instant query looks for the latest sample of a series.
+	LookbackDelta = 5 * time.Minute
+
+	// MaxPoints is the maximum number of points of each series of a range query.
+	MaxPoints = 11000
+
+	// valueField is the field written by the Prometheus remote write API.
+	valueField = "value"
+)
+
+// rangeFunctions are the functions over range vectors, they are executed by the InfluxQL function.
+var rangeFunctions = map[string]string{
+	"irate":           "irate",
+	"avg_over_time":   "mean",
+	"sum_over_time":   "sum",
+	"min_over_time":   "min",
+	"max_over_time":   "max",
+	"count_over_time": "count",
+	"last_over_time":  "last",
+}
+
+// extrapolatedFunctions are the functions over range vectors evaluated on the raw samples like Prometheus,
+// the counter resets are corrected and the change is extrapolated to the bounds of the range.
+var extrapolatedFunctions = map[string]bool{
+	"rate":     true,
+	"increase": true,
+}
+
+func isRangeFunction(name string) bool {
+	_, ok := rangeFunctions[name]
+	return ok || extrapolatedFunctions[name]
+}
+
+// pushdownAggregates are the aggregations executed by InfluxQL, the others are evaluated on the results.
+var pushdownAggregates = map[string]string{
+	"sum":   "sum",
+	"avg":   "mean",
+	"min":   "min",
+	"max":   "max",
+	"count": "count",
+}
+
+// Query is a PromQL expression evaluated at the times from Start to End by Step, the Start and End of an instant query are the same.
+//
+// The selectors, the functions over range vectors and the aggregations by labels directly over them are executed
+// by the statements of the leaves, one statement per leaf. The samples of a selector are aggregated in windows
+// ending at the evaluation times, the window of a range query is the larger of Step and the largest range in the
+// expression, and a range shorter than it is rejected. The raw samples of rate and increase are returned and
+// evaluated in the windows.
+type Query struct {
+	Expr   Expr
+	Start  time.Time
+	End    time.Time
+	Step   time.Duration
+	Leaves []*Leaf
+
+	leaves map[Expr]*Leaf
+}
+
+// Leaf is a part of the expression executed by a statement, the database and retention policy are set when it is executed.
+type Leaf struct {
+	Statement *influxql.SelectStatement
+
+	window   time.Duration
+	offset   time.Duration
+	scale    float64
+	keepName bool
+	// function is the extrapolated function evaluated on the raw samples
+	function string
+}
+
+// NewInstantQuery returns a query evaluated at ts.
+func NewInstantQuery(input string, ts time.Time) (*Query, error) {
+	return newQuery(input, ts, ts, 0)
+}
+
+// NewRangeQuery returns a query evaluated from start to end by step.
+func NewRangeQuery(input string, start, end time.Time, step time.Duration) (*Query, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("zero or negative query resolution step widths are not accepted")
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end timestamp must not be before start time")
+	}
+	if end.Sub(start)/step > MaxPoints {
+		return nil, fmt.Errorf("exceeded maximum resolution of %d points per timeseries", MaxPoints)
+	}
+	return newQuery(input, start, end, step)
+}
+
+func newQuery(input string, start, end time.Time, step time.Duration) (*Query, error) {
+	expr, err := ParseExpr(input)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{
+		Expr:   expr,
+		Start:  start.Truncate(time.Millisecond),
+		End:    end.Truncate(time.Millisecond),
+		Step:   step,
+		leaves: make(map[Expr]*Leaf),
+	}
+	if err := q.compile(expr); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// interval is the distance of the evaluation times, all the leaves of a range query share the window.
+func (q *Query) interval() time.Duration {
+	if q.Step == 0 {
+		return 0
+	}
+	interval := q.Step
+	walk(q.Expr, func(expr Expr) {
+		if ms, ok := expr.(*MatrixSelector); ok && ms.Range > interval {
+			interval = ms.Range
+		}
+	})
+	return interval
+}
+
+// times are the evaluation times of the query in milliseconds.
+func (q *Query) times() []int64 {
+	interval := q.interval()
+	if interval == 0 {
+		return []int64{q.End.UnixNano() / int64(time.Millisecond)}
+	}
+	var ts []int64
+	for t := q.Start; !t.After(q.End); t = t.Add(interval) {
+		ts = append(ts, t.UnixNano()/int64(time.Millisecond))
+	}
+	return ts
+}
+
+func walk(expr Expr, fn func(Expr)) {
+	fn(expr)
+	switch e := expr.(type) {
+	case *MatrixSelector:
+		walk(e.Vector, fn)
+	case *Call:
+		for _, arg := range e.Args {
+			walk(arg, fn)
+		}
+	case *AggregateExpr:
+		walk(e.Expr, fn)
+	case *BinaryExpr:
+		walk(e.LHS, fn)
+		walk(e.RHS, fn)
+	case *UnaryExpr:
+		walk(e.Expr, fn)
+	}
+}
+
+func (q *Query) compile(expr Expr) error {
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return nil
+	case *StringLiteral:
+		return fmt.Errorf("string literals are only supported as arguments of functions")
+	case *VectorSelector:
+		return q.addLeaf(e, e, nil)
+	case *MatrixSelector:
+		return fmt.Errorf("range vectors are only supported as arguments of functions like rate")
+	case *Call:
+		return q.compileCall(e)
+	case *AggregateExpr:
+		if canPushdown(e) {
+			return q.addLeaf(e, e.Expr, e)
+		}
+		return q.compile(e.Expr)
+	case *BinaryExpr:
+		if err := q.compile(e.LHS); err != nil {
+			return err
+		}
+		return q.compile(e.RHS)
+	case *UnaryExpr:
+		return q.compile(e.Expr)
+	default:
+		return fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+func (q *Query) compileCall(call *Call) error {
+	if isRangeFunction(call.Func) {
+		if len(call.Args) != 1 {
+			return fmt.Errorf("expected 1 argument in call to %s, got %d", call.Func, len(call.Args))
+		}
+		if _, ok := call.Args[0].(*MatrixSelector); !ok {
+			return fmt.Errorf("expected type range vector in call to function %s", call.Func)
+		}
+		return q.addLeaf(call, call, nil)
+	}
+
+	switch {
+	case mathFunctions[call.Func] != nil:
+		if len(call.Args) != 1 {
+			return fmt.Errorf("expected 1 argument in call to %s, got %d", call.Func, len(call.Args))
+		}
+		return q.compile(call.Args[0])
+	case call.Func == "histogram_quantile":
+		if len(call.Args) != 2 {
+			return fmt.Errorf("expected 2 arguments in call to %s, got %d", call.Func, len(call.Args))
+		}
+		if _, ok := call.Args[0].(*NumberLiteral); !ok {
+			return fmt.Errorf("the quantile of %s must be a number", call.Func)
+		}
+		return q.compile(call.Args[1])
+	}
+	return fmt.Errorf("function %s is not supported", call.Func)
+}
+
+// canPushdown tells whether the aggregation is executed by InfluxQL, which can only group by tags.
+func canPushdown(agg *AggregateExpr) bool {
+	if agg.Without || pushdownAggregates[agg.Op] == "" {
+		return false
+	}
+	for _, label := range agg.Grouping {
+		if label == MetricNameLabel {
+			return false
+		}
+	}
+	switch e := agg.Expr.(type) {
+	case *VectorSelector:
+		return true
+	case *Call:
+		// the raw samples of the extrapolated functions are evaluated before they are aggregated
+		_, ok := rangeFunctions[e.Func]
+		if ok && len(e.Args) == 1 {
+			_, ok = e.Args[0].(*MatrixSelector)
+			return ok
+		}
+	}
+	return false
+}
+
+// addLeaf adds the statement executing node, src is a selector or a function over a range vector and
+// agg is set if the aggregation over src is executed too.
+func (q *Query) addLeaf(node Expr, src Expr, agg *AggregateExpr) error {
+	vs, ok := src.(*VectorSelector)
+	fn := "last"
+	var rng time.Duration
+	if call, isCall := src.(*Call); isCall {
+		ms := call.Args[0].(*MatrixSelector)
+		vs, rng, fn, ok = ms.Vector, ms.Range, rangeFunctions[call.Func], true
+	}
+	if !ok {
+		return fmt.Errorf("unsupported expression %T", src)
+	}
+
+	leaf := &Leaf{
+		window:   q.interval(),
+		offset:   vs.Offset,
+		scale:    1,
+		keepName: node == src && fn == "last" && rng == 0,
+	}
+	if rng > 0 && rng < leaf.window {
+		return fmt.Errorf("range %s is shorter than the window %s of the query, ranges shorter than the step or the largest range are not supported",
+			rng, leaf.window)
+	}
+	if leaf.window == 0 {
+		// each leaf of an instant query has its own window
+		leaf.window = rng
+		if rng == 0 {
+			leaf.window = LookbackDelta
+		}
+	}
+
+	// irate returns the change in a window, the per-second rate is scaled from it
+	if call, isCall := src.(*Call); isCall {
+		switch {
+		case call.Func == "irate":
+			leaf.scale = float64(time.Second) / float64(leaf.window)
+		case extrapolatedFunctions[call.Func]:
+			leaf.function = call.Func
+		}
+	}
+
+	sources, cond, err := selectorCondition(vs)
+	if err != nil {
+		return err
+	}
+	timeCond, dims := q.window(leaf)
+	if leaf.function != "" {
+		leaf.Statement = &influxql.SelectStatement{
+			Fields:     influxql.Fields{{Expr: &influxql.VarRef{Val: valueField}}},
+			Sources:    sources,
+			Condition:  and(timeCond, cond),
+			Dimensions: influxql.Dimensions{{Expr: &influxql.Wildcard{}}},
+		}
+		q.Leaves = append(q.Leaves, leaf)
+		q.leaves[node] = leaf
+		return nil
+	}
+	stmt := &influxql.SelectStatement{
+		Fields: influxql.Fields{{
+			Expr:  &influxql.Call{Name: fn, Args: []influxql.Expr{&influxql.VarRef{Val: valueField}}},
+			Alias: valueField,
+		}},
+		Sources:    sources,
+		Condition:  and(timeCond, cond),
+		Dimensions: append(dims, &influxql.Dimension{Expr: &influxql.Wildcard{}}),
+		Fill:       influxql.NoFill,
+	}
+
+	if agg != nil {
+		if agg.Op == "count" {
+			leaf.scale = 1
+		}
+		_, dims := q.window(leaf)
+		for _, label := range agg.Grouping {
+			dims = append(dims, &influxql.Dimension{Expr: &influxql.VarRef{Val: label}})
+		}
+		stmt = &influxql.SelectStatement{
+			Fields: influxql.Fields{{
+				Expr:  &influxql.Call{Name: pushdownAggregates[agg.Op], Args: []influxql.Expr{&influxql.VarRef{Val: valueField}}},
+				Alias: valueField,
+			}},
+			Sources:    influxql.Sources{&influxql.SubQuery{Statement: stmt}},
+			Condition:  timeCond,
+			Dimensions: dims,
+			Fill:       influxql.NoFill,
+		}
+	}
+
+	leaf.Statement = stmt
+	q.Leaves = append(q.Leaves, leaf)
+	q.leaves[node] = leaf
+	return nil
+}
+
+// window returns the time condition and the time dimension of the leaf, the window [t-window, t) of each
+// evaluation time t is a bucket of GROUP BY time.
+func (q *Query) window(leaf *Leaf) (influxql.Expr, influxql.Dimensions) {
+	times := q.times()
+	last := time.Unix(0, times[len(times)-1]*int64(time.Millisecond))
+	start := q.Start.Add(-leaf.offset - leaf.window)
+	end := last.Add(-leaf.offset)
+
+	timeCond := and(&influxql.BinaryExpr{
+		Op:  influxql.GTE,
+		LHS: &influxql.VarRef{Val: "time"},
+		RHS: &influxql.TimeLiteral{Val: start.UTC()},
+	}, &influxql.BinaryExpr{
+		Op:  influxql.LT,
+		LHS: &influxql.VarRef{Val: "time"},
+		RHS: &influxql.TimeLiteral{Val: end.UTC()},
+	})
+
+	args := []influxql.Expr{&influxql.DurationLiteral{Val: leaf.window}}
+	offset := time.Duration(start.UnixNano() % int64(leaf.window))
+	if offset < 0 {
+		offset += leaf.window
+	}
+	if offset != 0 {
+		args = append(args, &influxql.DurationLiteral{Val: offset})
+	}
+	return timeCond, influxql.Dimensions{{Expr: &influxql.Call{Name: "time", Args: args}}}
+}
+
+// selectorCondition returns the measurements and the tag condition of the selector.
+func selectorCondition(vs *VectorSelector) (influxql.Sources, influxql.Expr, error) {
+	var sources influxql.Sources
+	if vs.Name != "" {
+		sources = influxql.Sources{&influxql.Measurement{Name: vs.Name}}
+	}
+
+	var cond influxql.Expr
+	for _, m := range vs.Matchers {
+		if m.Name == MetricNameLabel {
+			if m.Type != MatchRegexp || sources != nil {
+				return nil, nil, fmt.Errorf("only one equality or regex matcher on %s is supported", MetricNameLabel)
+			}
+			sources = influxql.Sources{&influxql.Measurement{Regex: &influxql.RegexLiteral{Val: m.re}}}
+			continue
+		}
+
+		expr := &influxql.BinaryExpr{LHS: &influxql.VarRef{Val: m.Name}}
+		switch m.Type {
+		case MatchEqual:
+			expr.Op, expr.RHS = influxql.EQ, &influxql.StringLiteral{Val: m.Value}
+		case MatchNotEqual:
+			expr.Op, expr.RHS = influxql.NEQ, &influxql.StringLiteral{Val: m.Value}
+		case MatchRegexp:
+			expr.Op, expr.RHS = influxql.EQREGEX, &influxql.RegexLiteral{Val: m.re}
+		default:
+			expr.Op, expr.RHS = influxql.NEQREGEX, &influxql.RegexLiteral{Val: m.re}
+		}
+		cond = and(cond, expr)
+	}
+	return sources, cond, nil
+}
+
+func and(lhs, rhs influxql.Expr) influxql.Expr {
+	if lhs == nil {
+		return rhs
+	}
+	if rhs == nil {
+		return lhs
+	}
+	var op influxql.Token = influxql.AND
+	return &influxql.BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+}
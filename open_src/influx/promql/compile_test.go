@@ -0,0 +1,150 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/yacc"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+
+func statements(t *testing.T, q *Query) []string {
+	var stmts []string
+	for _, leaf := range q.Leaves {
+		stmt := leaf.Statement.String()
+		stmts = append(stmts, stmt)
+
+		// the statements are executed after they are parsed again
+		YyParser := &yacc.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(stmt))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		require.NoError(t, err, stmt)
+	}
+	return stmts
+}
+
+func TestInstantQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		sql   []string
+	}{
+		{
+			query: `up`,
+			sql: []string{`SELECT last(value) AS value FROM up WHERE time >= '2022-01-01T00:55:00Z' AND time < '2022-01-01T01:00:00Z' ` +
+				`GROUP BY time(5m), * fill(none)`},
+		},
+		{
+			query: `rate(http_requests_total{job="api", code=~"5.."}[1m])`,
+			// the raw samples are returned
+			sql: []string{`SELECT value FROM http_requests_total WHERE time >= '2022-01-01T00:59:00Z' AND time < '2022-01-01T01:00:00Z' ` +
+				`AND job = 'api' AND code =~ /^(?:5..)$/ GROUP BY *`},
+		},
+		{
+			query: `{__name__=~"cpu|mem", host!="a", zone!~"z1"} offset 1h`,
+			sql: []string{`SELECT last(value) AS value FROM /^(?:cpu|mem)$/ WHERE time >= '2021-12-31T23:55:00Z' AND time < '2022-01-01T00:00:00Z' ` +
+				`AND host != 'a' AND zone !~ /^(?:z1)$/ GROUP BY time(5m), * fill(none)`},
+		},
+		{
+			query: `sum by (job) (max_over_time(http_requests_total[10m])) / on_call`,
+			sql: []string{
+				`SELECT sum(value) AS value FROM (SELECT max(value) AS value FROM http_requests_total WHERE time >= '2022-01-01T00:50:00Z' AND time < '2022-01-01T01:00:00Z' ` +
+					`GROUP BY time(10m), * fill(none)) WHERE time >= '2022-01-01T00:50:00Z' AND time < '2022-01-01T01:00:00Z' GROUP BY time(10m), job fill(none)`,
+				`SELECT last(value) AS value FROM on_call WHERE time >= '2022-01-01T00:55:00Z' AND time < '2022-01-01T01:00:00Z' GROUP BY time(5m), * fill(none)`,
+			},
+		},
+		{
+			// aggregations without labels are evaluated on the results
+			query: `histogram_quantile(0.9, sum without (instance) (irate(req_bucket[5m])))`,
+			sql: []string{`SELECT irate(value) AS value FROM req_bucket WHERE time >= '2022-01-01T00:55:00Z' AND time < '2022-01-01T01:00:00Z' ` +
+				`GROUP BY time(5m), * fill(none)`},
+		},
+		{
+			// the aggregations over rate and increase are evaluated on the results
+			query: `sum by (job) (increase(http_requests_total[10m]))`,
+			sql: []string{`SELECT value FROM http_requests_total WHERE time >= '2022-01-01T00:50:00Z' AND time < '2022-01-01T01:00:00Z' ` +
+				`GROUP BY *`},
+		},
+	}
+
+	for _, tt := range tests {
+		q, err := NewInstantQuery(tt.query, testNow)
+		require.NoError(t, err, tt.query)
+		require.Equal(t, tt.sql, statements(t, q), tt.query)
+	}
+}
+
+func TestRangeQuery(t *testing.T) {
+	// the windows are aligned to the start which is not a multiple of the step
+	start := testNow.Add(-time.Hour + 10*time.Second)
+	q, err := NewRangeQuery(`max_over_time(cpu[2m]) > bool 0.5`, start, testNow, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, []string{`SELECT max(value) AS value FROM cpu WHERE time >= '2021-12-31T23:58:10Z' AND time < '2022-01-01T00:58:10Z' ` +
+		`GROUP BY time(2m, 10s), * fill(none)`}, statements(t, q))
+	require.Equal(t, 30, len(q.times()))
+
+	q, err = NewRangeQuery(`avg(mem)`, start, testNow, 15*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []string{`SELECT mean(value) AS value FROM (SELECT last(value) AS value FROM mem WHERE time >= '2021-12-31T23:59:55Z' ` +
+		`AND time < '2022-01-01T00:59:55Z' GROUP BY time(15s, 10s), * fill(none)) WHERE time >= '2021-12-31T23:59:55Z' AND time < '2022-01-01T00:59:55Z' ` +
+		`GROUP BY time(15s, 10s) fill(none)`}, statements(t, q))
+}
+
+func TestQuery_Error(t *testing.T) {
+	tests := map[string]string{
+		`rate(cpu)`:                              "expected type range vector in call to function rate",
+		`cpu[5m]`:                                "range vectors are only supported as arguments",
+		`rate(cpu[5m:1m])`:                       "subqueries are not supported",
+		`label_replace(cpu, "a", "b", "c", "d")`: "function label_replace is not supported",
+		`topk(3, cpu)`:                           "aggregation topk is not supported",
+		`sum(cpu, 1)`:                            "aggregation sum does not take a parameter",
+		`cpu / on(host) mem`:                     "vector matching with on is not supported",
+		`cpu and mem`:                            "set operator and is not supported",
+		`cpu + bool 1`:                           "bool modifier can only be used on comparison operators",
+		`{host="a"}`:                             "vector selector must contain a metric name",
+		`{__name__!="cpu", host="a"}`:            "only one equality or regex matcher on __name__ is supported",
+		`cpu{host="a}`:                           "unterminated string literal",
+		`cpu{host=~"("}`:                         "missing closing )",
+		`rate(cpu[5mo])`:                         `unsupported duration unit "mo" in 5mo`,
+		`histogram_quantile(x, cpu)`:             "the quantile of histogram_quantile must be a number",
+		`cpu{host="a"`:                           "found EOF at 12, expected }",
+	}
+	for query, msg := range tests {
+		_, err := NewInstantQuery(query, testNow)
+		require.Error(t, err, query)
+		require.Contains(t, err.Error(), msg, query)
+	}
+
+	_, err := NewRangeQuery(`cpu`, testNow, testNow.Add(-time.Minute), time.Second)
+	require.EqualError(t, err, "end timestamp must not be before start time")
+	_, err = NewRangeQuery(`cpu`, testNow, testNow.Add(time.Hour), 0)
+	require.EqualError(t, err, "zero or negative query resolution step widths are not accepted")
+	_, err = NewRangeQuery(`cpu`, testNow, testNow.Add(24*time.Hour), time.Second)
+	require.EqualError(t, err, "exceeded maximum resolution of 11000 points per timeseries")
+
+	// a range is not widened to the window of the query
+	_, err = NewRangeQuery(`rate(cpu[30s])`, testNow, testNow.Add(time.Hour), time.Minute)
+	require.EqualError(t, err, "range 30s is shorter than the window 1m0s of the query, ranges shorter than the step or the largest range are not supported")
+	_, err = NewRangeQuery(`rate(cpu[1m]) / rate(mem[5m])`, testNow, testNow.Add(time.Hour), time.Minute)
+	require.Error(t, err)
+}
@@ -0,0 +1,722 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+const (
+	ValueTypeScalar = "scalar"
+	ValueTypeVector = "vector"
+	ValueTypeMatrix = "matrix"
+)
+
+// Point is a sample of a result, T is in milliseconds.
+type Point struct {
+	T int64
+	V float64
+}
+
+// MarshalJSON writes the point as [<unix seconds>, "<value>"] like Prometheus.
+func (p Point) MarshalJSON() ([]byte, error) {
+	ts := strconv.FormatFloat(float64(p.T)/1000, 'f', -1, 64)
+	return []byte(`[` + ts + `,"` + strconv.FormatFloat(p.V, 'f', -1, 64) + `"]`), nil
+}
+
+// Series is a series of a range query.
+type Series struct {
+	Metric map[string]string `json:"metric"`
+	Points []Point           `json:"values"`
+}
+
+// Sample is a series of an instant query.
+type Sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  Point             `json:"value"`
+}
+
+type Matrix []*Series
+
+// Data is the data of a response of the query APIs.
+type Data struct {
+	ResultType string      `json:"resultType"`
+	Result     interface{} `json:"result"`
+}
+
+var mathFunctions = map[string]func(float64) float64{
+	"abs":   math.Abs,
+	"ceil":  math.Ceil,
+	"floor": math.Floor,
+	"exp":   math.Exp,
+	"sqrt":  math.Sqrt,
+	"ln":    math.Log,
+	"log2":  math.Log2,
+	"log10": math.Log10,
+}
+
+// Eval evaluates the expression with the rows returned by the statements of the leaves, in the same order.
+func (q *Query) Eval(results []models.Rows) (*Data, error) {
+	if len(results) != len(q.Leaves) {
+		return nil, fmt.Errorf("expected the results of %d statements, got %d", len(q.Leaves), len(results))
+	}
+	ev := &evaluator{q: q, times: q.times(), results: make(map[*Leaf]models.Rows, len(results))}
+	for i, leaf := range q.Leaves {
+		ev.results[leaf] = results[i]
+	}
+
+	v, err := ev.eval(q.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if s, ok := v.(float64); ok {
+		if q.Step == 0 {
+			return &Data{ResultType: ValueTypeScalar, Result: Point{T: ev.times[0], V: s}}, nil
+		}
+		series := &Series{Metric: map[string]string{}}
+		for _, t := range ev.times {
+			series.Points = append(series.Points, Point{T: t, V: s})
+		}
+		return &Data{ResultType: ValueTypeMatrix, Result: Matrix{series}}, nil
+	}
+
+	m := v.(Matrix)
+	sort.Slice(m, func(i, j int) bool {
+		return signature(m[i].Metric) < signature(m[j].Metric)
+	})
+	if q.Step == 0 {
+		samples := make([]Sample, 0, len(m))
+		for _, s := range m {
+			if len(s.Points) > 0 {
+				samples = append(samples, Sample{Metric: s.Metric, Value: s.Points[len(s.Points)-1]})
+			}
+		}
+		return &Data{ResultType: ValueTypeVector, Result: samples}, nil
+	}
+
+	matrix := make(Matrix, 0, len(m))
+	for _, s := range m {
+		if len(s.Points) > 0 {
+			matrix = append(matrix, s)
+		}
+	}
+	return &Data{ResultType: ValueTypeMatrix, Result: matrix}, nil
+}
+
+type evaluator struct {
+	q       *Query
+	times   []int64
+	results map[*Leaf]models.Rows
+}
+
+// eval returns a float64 for a scalar and a Matrix for a vector.
+func (ev *evaluator) eval(expr Expr) (interface{}, error) {
+	if leaf, ok := ev.q.leaves[expr]; ok {
+		return ev.leafMatrix(leaf), nil
+	}
+
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return e.Val, nil
+	case *UnaryExpr:
+		v, err := ev.eval(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := v.(float64); ok {
+			return -s, nil
+		}
+		return mapValues(v.(Matrix), func(v float64) float64 { return -v }), nil
+	case *BinaryExpr:
+		return ev.binary(e)
+	case *AggregateExpr:
+		return ev.aggregate(e)
+	case *Call:
+		if e.Func == "histogram_quantile" {
+			return ev.histogramQuantile(e)
+		}
+		v, err := ev.eval(e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		m, ok := v.(Matrix)
+		if !ok {
+			return nil, fmt.Errorf("expected type instant vector in call to function %s", e.Func)
+		}
+		return mapValues(m, mathFunctions[e.Func]), nil
+	}
+	return nil, fmt.Errorf("unsupported expression %T", expr)
+}
+
+// leafMatrix returns the series of the rows of the leaf, the time of a window is its end.
+func (ev *evaluator) leafMatrix(leaf *Leaf) Matrix {
+	shift := leaf.window + leaf.offset
+	if leaf.function != "" {
+		// the rows are the raw samples
+		shift = 0
+	}
+
+	var m Matrix
+	bySignature := make(map[string]*Series)
+	for _, row := range ev.results[leaf] {
+		metric := make(map[string]string, len(row.Tags)+1)
+		for k, v := range row.Tags {
+			if v != "" {
+				metric[k] = v
+			}
+		}
+		if leaf.keepName {
+			metric[MetricNameLabel] = row.Name
+		}
+
+		// the rows of a series may be split into chunks
+		sig := signature(metric)
+		s, ok := bySignature[sig]
+		if !ok {
+			s = &Series{Metric: metric}
+			bySignature[sig] = s
+			m = append(m, s)
+		}
+		for _, values := range row.Values {
+			if len(values) < 2 {
+				continue
+			}
+			ts, ok := values[0].(time.Time)
+			v, isNumber := toFloat(values[1])
+			if !ok || !isNumber {
+				continue
+			}
+			t := ts.Add(shift).UnixNano() / int64(time.Millisecond)
+			s.Points = append(s.Points, Point{T: t, V: v * leaf.scale})
+		}
+	}
+	for _, s := range m {
+		sort.Slice(s.Points, func(i, j int) bool { return s.Points[i].T < s.Points[j].T })
+		if leaf.function != "" {
+			s.Points = ev.extrapolatedRate(leaf, s.Points)
+		}
+	}
+	return m
+}
+
+// extrapolatedRate evaluates rate or increase over the samples in the window [t-window, t) of each evaluation time t.
+func (ev *evaluator) extrapolatedRate(leaf *Leaf, samples []Point) []Point {
+	window := int64(leaf.window / time.Millisecond)
+	offset := int64(leaf.offset / time.Millisecond)
+	var dst []Point
+	lo := 0
+	for _, t := range ev.times {
+		end := t - offset
+		start := end - window
+		for lo < len(samples) && samples[lo].T < start {
+			lo++
+		}
+		hi := lo
+		for hi < len(samples) && samples[hi].T < end {
+			hi++
+		}
+		if v, ok := extrapolate(samples[lo:hi], start, end); ok {
+			if leaf.function == "rate" {
+				v /= leaf.window.Seconds()
+			}
+			dst = append(dst, Point{T: t, V: v})
+		}
+	}
+	return dst
+}
+
+// extrapolate returns the increase of the counter in the range from start to end like Prometheus. The value
+// before a reset is added to the increase, and the increase between the first and the last samples is extrapolated
+// to the bounds of the range unless they are much further than the average interval of the samples, or to the
+// time the counter would have been zero.
+func extrapolate(samples []Point, start, end int64) (float64, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	result := last.V - first.V
+	for i := 1; i < len(samples); i++ {
+		if samples[i].V < samples[i-1].V {
+			result += samples[i-1].V
+		}
+	}
+
+	sampledInterval := float64(last.T - first.T)
+	if sampledInterval == 0 {
+		return 0, false
+	}
+	averageInterval := sampledInterval / float64(len(samples)-1)
+	durationToStart := float64(first.T - start)
+	durationToEnd := float64(end - last.T)
+	if result > 0 && first.V >= 0 {
+		if durationToZero := sampledInterval * (first.V / result); durationToZero < durationToStart {
+			durationToStart = durationToZero
+		}
+	}
+
+	threshold := averageInterval * 1.1
+	extrapolateTo := sampledInterval
+	if durationToStart < threshold {
+		extrapolateTo += durationToStart
+	} else {
+		extrapolateTo += averageInterval / 2
+	}
+	if durationToEnd < threshold {
+		extrapolateTo += durationToEnd
+	} else {
+		extrapolateTo += averageInterval / 2
+	}
+	return result * (extrapolateTo / sampledInterval), true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// signature identifies the labels of a series, the labels in exclude are ignored.
+func signature(metric map[string]string, exclude ...string) string {
+	keys := make([]string, 0, len(metric))
+outer:
+	for k := range metric {
+		for _, e := range exclude {
+			if k == e {
+				continue outer
+			}
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteByte(0xfe)
+		sb.WriteString(metric[k])
+		sb.WriteByte(0xff)
+	}
+	return sb.String()
+}
+
+func dropLabels(metric map[string]string, labels ...string) map[string]string {
+	dst := make(map[string]string, len(metric))
+	for k, v := range metric {
+		dst[k] = v
+	}
+	for _, label := range labels {
+		delete(dst, label)
+	}
+	return dst
+}
+
+// mapValues applies fn to the values, the metric name is dropped as the meaning of the values changes.
+func mapValues(m Matrix, fn func(float64) float64) Matrix {
+	dst := make(Matrix, 0, len(m))
+	for _, s := range m {
+		points := make([]Point, len(s.Points))
+		for i, p := range s.Points {
+			points[i] = Point{T: p.T, V: fn(p.V)}
+		}
+		dst = append(dst, &Series{Metric: dropLabels(s.Metric, MetricNameLabel), Points: points})
+	}
+	return dst
+}
+
+// binop returns the result of an arithmetic operator, or lhs and whether the comparison is true.
+func binop(op string, lhs, rhs float64) (float64, bool) {
+	switch op {
+	case "+":
+		return lhs + rhs, true
+	case "-":
+		return lhs - rhs, true
+	case "*":
+		return lhs * rhs, true
+	case "/":
+		return lhs / rhs, true
+	case "%":
+		return math.Mod(lhs, rhs), true
+	case "^":
+		return math.Pow(lhs, rhs), true
+	case "==":
+		return lhs, lhs == rhs
+	case "!=":
+		return lhs, lhs != rhs
+	case ">":
+		return lhs, lhs > rhs
+	case "<":
+		return lhs, lhs < rhs
+	case ">=":
+		return lhs, lhs >= rhs
+	default:
+		return lhs, lhs <= rhs
+	}
+}
+
+// result returns the value of the sample and whether it is kept, the vector value is kept by a filtering comparison.
+func (e *BinaryExpr) result(lhs, rhs, vectorValue float64) (float64, bool) {
+	v, keep := binop(e.Op, lhs, rhs)
+	if !comparisonOps[e.Op] {
+		return v, true
+	}
+	if e.ReturnBool {
+		if keep {
+			return 1, true
+		}
+		return 0, true
+	}
+	return vectorValue, keep
+}
+
+// resultMetric returns the labels of the result, only a filtering comparison keeps the metric name.
+func (e *BinaryExpr) resultMetric(metric map[string]string) map[string]string {
+	if comparisonOps[e.Op] && !e.ReturnBool {
+		return metric
+	}
+	return dropLabels(metric, MetricNameLabel)
+}
+
+func (ev *evaluator) binary(e *BinaryExpr) (interface{}, error) {
+	lhs, err := ev.eval(e.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := ev.eval(e.RHS)
+	if err != nil {
+		return nil, err
+	}
+
+	ls, lhsScalar := lhs.(float64)
+	rs, rhsScalar := rhs.(float64)
+	switch {
+	case lhsScalar && rhsScalar:
+		if comparisonOps[e.Op] && !e.ReturnBool {
+			return nil, fmt.Errorf("comparisons between scalars must use bool modifier")
+		}
+		v, _ := e.result(ls, rs, 0)
+		return v, nil
+	case lhsScalar || rhsScalar:
+		m, _ := lhs.(Matrix)
+		if lhsScalar {
+			m = rhs.(Matrix)
+		}
+		dst := make(Matrix, 0, len(m))
+		for _, s := range m {
+			out := &Series{Metric: e.resultMetric(s.Metric)}
+			for _, p := range s.Points {
+				l, r := p.V, rs
+				if lhsScalar {
+					l, r = ls, p.V
+				}
+				if v, keep := e.result(l, r, p.V); keep {
+					out.Points = append(out.Points, Point{T: p.T, V: v})
+				}
+			}
+			dst = append(dst, out)
+		}
+		return dst, nil
+	}
+	return vectorBinary(e, lhs.(Matrix), rhs.(Matrix))
+}
+
+// vectorBinary matches the series of both sides one-to-one by all labels except the metric name.
+func vectorBinary(e *BinaryExpr, lhs, rhs Matrix) (Matrix, error) {
+	rhsBySig := make(map[string]*Series, len(rhs))
+	for _, s := range rhs {
+		sig := signature(s.Metric, MetricNameLabel)
+		if _, ok := rhsBySig[sig]; ok {
+			return nil, fmt.Errorf("found duplicate series for the match group on the right hand-side of the operation")
+		}
+		rhsBySig[sig] = s
+	}
+
+	seen := make(map[string]bool, len(lhs))
+	dst := make(Matrix, 0, len(lhs))
+	for _, s := range lhs {
+		sig := signature(s.Metric, MetricNameLabel)
+		if seen[sig] {
+			return nil, fmt.Errorf("found duplicate series for the match group on the left hand-side of the operation")
+		}
+		seen[sig] = true
+		other, ok := rhsBySig[sig]
+		if !ok {
+			continue
+		}
+
+		rhsValues := make(map[int64]float64, len(other.Points))
+		for _, p := range other.Points {
+			rhsValues[p.T] = p.V
+		}
+		out := &Series{Metric: e.resultMetric(s.Metric)}
+		for _, p := range s.Points {
+			r, ok := rhsValues[p.T]
+			if !ok {
+				continue
+			}
+			if v, keep := e.result(p.V, r, p.V); keep {
+				out.Points = append(out.Points, Point{T: p.T, V: v})
+			}
+		}
+		dst = append(dst, out)
+	}
+	return dst, nil
+}
+
+type group struct {
+	metric map[string]string
+	values map[int64][]float64
+}
+
+// groupMetric returns the labels of the group of a series, without drops the metric name as well.
+func groupMetric(metric map[string]string, grouping []string, without bool) map[string]string {
+	if without {
+		dst := dropLabels(metric, grouping...)
+		delete(dst, MetricNameLabel)
+		return dst
+	}
+	dst := make(map[string]string, len(grouping))
+	for _, label := range grouping {
+		if v, ok := metric[label]; ok {
+			dst[label] = v
+		}
+	}
+	return dst
+}
+
+func groupPoints(m Matrix, metric func(map[string]string) map[string]string) []*group {
+	var groups []*group
+	bySig := make(map[string]*group)
+	for _, s := range m {
+		gm := metric(s.Metric)
+		sig := signature(gm)
+		g, ok := bySig[sig]
+		if !ok {
+			g = &group{metric: gm, values: make(map[int64][]float64)}
+			bySig[sig] = g
+			groups = append(groups, g)
+		}
+		for _, p := range s.Points {
+			g.values[p.T] = append(g.values[p.T], p.V)
+		}
+	}
+	return groups
+}
+
+func (g *group) series(fn func([]float64) float64) *Series {
+	s := &Series{Metric: g.metric}
+	for t, values := range g.values {
+		s.Points = append(s.Points, Point{T: t, V: fn(values)})
+	}
+	sort.Slice(s.Points, func(i, j int) bool { return s.Points[i].T < s.Points[j].T })
+	return s
+}
+
+var aggregateFunctions = map[string]func([]float64) float64{
+	"sum": func(values []float64) float64 {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum
+	},
+	"avg": func(values []float64) float64 {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values))
+	},
+	"min": func(values []float64) float64 {
+		min := values[0]
+		for _, v := range values[1:] {
+			if v < min || math.IsNaN(min) {
+				min = v
+			}
+		}
+		return min
+	},
+	"max": func(values []float64) float64 {
+		max := values[0]
+		for _, v := range values[1:] {
+			if v > max || math.IsNaN(max) {
+				max = v
+			}
+		}
+		return max
+	},
+	"count": func(values []float64) float64 {
+		return float64(len(values))
+	},
+	"stddev": func(values []float64) float64 {
+		return math.Sqrt(variance(values))
+	},
+	"stdvar": variance,
+}
+
+// variance is the population variance like Prometheus.
+func variance(values []float64) float64 {
+	var sum, sumSquares float64
+	for _, v := range values {
+		sum += v
+		sumSquares += v * v
+	}
+	n := float64(len(values))
+	mean := sum / n
+	return sumSquares/n - mean*mean
+}
+
+func (ev *evaluator) aggregate(e *AggregateExpr) (interface{}, error) {
+	v, err := ev.eval(e.Expr)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(Matrix)
+	if !ok {
+		return nil, fmt.Errorf("expected type instant vector in aggregation %s", e.Op)
+	}
+
+	groups := groupPoints(m, func(metric map[string]string) map[string]string {
+		return groupMetric(metric, e.Grouping, e.Without)
+	})
+	dst := make(Matrix, 0, len(groups))
+	for _, g := range groups {
+		dst = append(dst, g.series(aggregateFunctions[e.Op]))
+	}
+	return dst, nil
+}
+
+// bucketLabel is the upper bound of a bucket of a histogram.
+const bucketLabel = "le"
+
+type bucket struct {
+	upperBound float64
+	count      float64
+}
+
+func (ev *evaluator) histogramQuantile(e *Call) (interface{}, error) {
+	phi := e.Args[0].(*NumberLiteral).Val
+	v, err := ev.eval(e.Args[1])
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(Matrix)
+	if !ok {
+		return nil, fmt.Errorf("expected type instant vector in call to function %s", e.Func)
+	}
+
+	// the series without a valid upper bound are ignored
+	buckets := make(Matrix, 0, len(m))
+	for _, s := range m {
+		if _, err := strconv.ParseFloat(s.Metric[bucketLabel], 64); err == nil {
+			buckets = append(buckets, s)
+		}
+	}
+
+	type histogram struct {
+		metric  map[string]string
+		buckets map[int64][]bucket
+	}
+	var histograms []*histogram
+	bySig := make(map[string]*histogram)
+	for _, s := range buckets {
+		metric := dropLabels(s.Metric, bucketLabel, MetricNameLabel)
+		sig := signature(metric)
+		h, ok := bySig[sig]
+		if !ok {
+			h = &histogram{metric: metric, buckets: make(map[int64][]bucket)}
+			bySig[sig] = h
+			histograms = append(histograms, h)
+		}
+		upperBound, _ := strconv.ParseFloat(s.Metric[bucketLabel], 64)
+		for _, p := range s.Points {
+			h.buckets[p.T] = append(h.buckets[p.T], bucket{upperBound: upperBound, count: p.V})
+		}
+	}
+
+	dst := make(Matrix, 0, len(histograms))
+	for _, h := range histograms {
+		s := &Series{Metric: h.metric}
+		for t, b := range h.buckets {
+			s.Points = append(s.Points, Point{T: t, V: bucketQuantile(phi, b)})
+		}
+		sort.Slice(s.Points, func(i, j int) bool { return s.Points[i].T < s.Points[j].T })
+		dst = append(dst, s)
+	}
+	return dst, nil
+}
+
+// bucketQuantile interpolates the quantile linearly within the bucket it falls in, like Prometheus.
+// The buckets must contain the +Inf bucket and the counts are cumulative.
+func bucketQuantile(q float64, buckets []bucket) float64 {
+	if q < 0 {
+		return math.Inf(-1)
+	}
+	if q > 1 {
+		return math.Inf(1)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].upperBound < buckets[j].upperBound })
+	if !math.IsInf(buckets[len(buckets)-1].upperBound, 1) {
+		return math.NaN()
+	}
+
+	// the counts may decrease because of the scrape timing, they are made monotonic
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i].count < buckets[i-1].count {
+			buckets[i].count = buckets[i-1].count
+		}
+	}
+	if len(buckets) < 2 {
+		return math.NaN()
+	}
+
+	observations := buckets[len(buckets)-1].count
+	if observations == 0 {
+		return math.NaN()
+	}
+	rank := q * observations
+	b := sort.Search(len(buckets)-1, func(i int) bool { return buckets[i].count >= rank })
+
+	if b == len(buckets)-1 {
+		return buckets[len(buckets)-2].upperBound
+	}
+	if b == 0 && buckets[0].upperBound <= 0 {
+		return buckets[0].upperBound
+	}
+	var bucketStart float64
+	bucketEnd := buckets[b].upperBound
+	count := buckets[b].count
+	if b > 0 {
+		bucketStart = buckets[b-1].upperBound
+		count -= buckets[b-1].count
+		rank -= buckets[b-1].count
+	}
+	return bucketStart + (bucketEnd-bucketStart)*(rank/count)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/require"
+)
+
+// row returns a series of the values in the windows from start by step
+func row(name string, tags map[string]string, start time.Time, step time.Duration, values ...float64) *models.Row {
+	r := &models.Row{Name: name, Tags: tags, Columns: []string{"time", valueField}}
+	for i, v := range values {
+		r.Values = append(r.Values, []interface{}{start.Add(time.Duration(i) * step), v})
+	}
+	return r
+}
+
+func evalJSON(t *testing.T, q *Query, results ...models.Rows) string {
+	data, err := q.Eval(results)
+	require.NoError(t, err)
+	buf, err := json.Marshal(data)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestEval_InstantVector(t *testing.T) {
+	q, err := NewInstantQuery(`up`, testNow)
+	require.NoError(t, err)
+
+	window := testNow.Add(-LookbackDelta)
+	got := evalJSON(t, q, models.Rows{
+		row("up", map[string]string{"job": "b", "zone": ""}, window, time.Minute, 0),
+		row("up", map[string]string{"job": "a"}, window, time.Minute, 1),
+	})
+	require.Equal(t, `{"resultType":"vector","result":[`+
+		`{"metric":{"__name__":"up","job":"a"},"value":[1640998800,"1"]},`+
+		`{"metric":{"__name__":"up","job":"b"},"value":[1640998800,"0"]}]}`, got)
+}
+
+func TestEval_RangeRate(t *testing.T) {
+	q, err := NewRangeQuery(`sum by (job) (rate(http_requests_total[1m]))`, testNow.Add(-2*time.Minute), testNow, time.Minute)
+	require.NoError(t, err)
+
+	// the raw samples of a series are split into chunks, the counters are scraped every 15 seconds
+	start := testNow.Add(-3 * time.Minute)
+	counter := func(instance string, from int, perSecond float64) *models.Row {
+		values := make([]float64, 6)
+		for i := range values {
+			values[i] = float64(from+i) * 15 * perSecond
+		}
+		return row("http_requests_total", map[string]string{"job": "api", "instance": instance},
+			start.Add(time.Duration(from)*15*time.Second), 15*time.Second, values...)
+	}
+	got := evalJSON(t, q, models.Rows{
+		counter("a", 0, 1), counter("b", 0, 2), counter("a", 6, 1), counter("b", 6, 2),
+	})
+	require.Equal(t, `{"resultType":"matrix","result":[{"metric":{"job":"api"},`+
+		`"values":[[1640998680,"3"],[1640998740,"3"],[1640998800,"3"]]}]}`, got)
+}
+
+func TestEval_CounterReset(t *testing.T) {
+	// the counter is reset from 30 to 5, the sample before the range is ignored
+	samples := models.Rows{
+		row("c", nil, testNow.Add(-75*time.Second), 15*time.Second, 1000, 10, 30, 5, 25),
+	}
+	tests := map[string]string{
+		// the increase of 45 in 45 seconds is extrapolated to the end of the range
+		`increase(c[1m])`: `{"resultType":"vector","result":[{"metric":{},"value":[1640998800,"60"]}]}`,
+		`rate(c[1m])`:     `{"resultType":"vector","result":[{"metric":{},"value":[1640998800,"1"]}]}`,
+	}
+	for query, expect := range tests {
+		q, err := NewInstantQuery(query, testNow)
+		require.NoError(t, err, query)
+		require.Equal(t, expect, evalJSON(t, q, samples), query)
+	}
+
+	// a single sample has no rate
+	q, err := NewInstantQuery(`rate(c[1m])`, testNow)
+	require.NoError(t, err)
+	got := evalJSON(t, q, models.Rows{row("c", nil, testNow.Add(-time.Minute), time.Minute, 10)})
+	require.Equal(t, `{"resultType":"vector","result":[]}`, got)
+}
+
+func TestEval_BinaryExpr(t *testing.T) {
+	window := testNow.Add(-LookbackDelta)
+	lhs := models.Rows{
+		row("used", map[string]string{"host": "x"}, window, time.Minute, 10),
+		row("used", map[string]string{"host": "y"}, window, time.Minute, 5),
+	}
+	rhs := models.Rows{
+		row("total", map[string]string{"host": "x"}, window, time.Minute, 4),
+	}
+
+	tests := []struct {
+		query   string
+		results []models.Rows
+		expect  string
+	}{
+		{
+			// the series are matched by the labels except the metric name
+			query:   `used / total`,
+			results: []models.Rows{lhs, rhs},
+			expect:  `{"resultType":"vector","result":[{"metric":{"host":"x"},"value":[1640998800,"2.5"]}]}`,
+		},
+		{
+			query:   `used > 6`,
+			results: []models.Rows{lhs},
+			expect:  `{"resultType":"vector","result":[{"metric":{"__name__":"used","host":"x"},"value":[1640998800,"10"]}]}`,
+		},
+		{
+			query:   `used > bool 6`,
+			results: []models.Rows{lhs},
+			expect: `{"resultType":"vector","result":[{"metric":{"host":"x"},"value":[1640998800,"1"]},` +
+				`{"metric":{"host":"y"},"value":[1640998800,"0"]}]}`,
+		},
+		{
+			query:   `-used * 2`,
+			results: []models.Rows{lhs},
+			expect: `{"resultType":"vector","result":[{"metric":{"host":"x"},"value":[1640998800,"-20"]},` +
+				`{"metric":{"host":"y"},"value":[1640998800,"-10"]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		q, err := NewInstantQuery(tt.query, testNow)
+		require.NoError(t, err, tt.query)
+		require.Equal(t, tt.expect, evalJSON(t, q, tt.results...), tt.query)
+	}
+}
+
+func TestEval_HistogramQuantile(t *testing.T) {
+	q, err := NewInstantQuery(`histogram_quantile(0.5, sum without (instance) (rate(req_bucket[5m])))`, testNow)
+	require.NoError(t, err)
+
+	// the counters of the buckets are scraped every minute, the rate is the count per second
+	window := testNow.Add(-5 * time.Minute)
+	bucket := func(instance, le string, count float64) *models.Row {
+		return row("req_bucket", map[string]string{"job": "api", "instance": instance, "le": le}, window, time.Minute,
+			0, count*60, count*120, count*180, count*240)
+	}
+	got := evalJSON(t, q, models.Rows{
+		bucket("i1", "1", 1), bucket("i1", "2", 3), bucket("i1", "+Inf", 4),
+		bucket("i2", "1", 1), bucket("i2", "2", 1), bucket("i2", "+Inf", 2),
+	})
+	require.Equal(t, `{"resultType":"vector","result":[{"metric":{"job":"api"},"value":[1640998800,"1.5"]}]}`, got)
+}
+
+func TestEval_Scalar(t *testing.T) {
+	q, err := NewInstantQuery(`1 + 2 * 3`, testNow)
+	require.NoError(t, err)
+	require.Equal(t, `{"resultType":"scalar","result":[1640998800,"7"]}`, evalJSON(t, q))
+
+	q, err = NewRangeQuery(`2 ^ 3 ^ 2 / 64`, testNow.Add(-time.Minute), testNow, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, `{"resultType":"matrix","result":[{"metric":{},"values":[[1640998740,"8"],[1640998800,"8"]]}]}`, evalJSON(t, q))
+}
+
+func TestEval_Error(t *testing.T) {
+	q, err := NewInstantQuery(`1 > 2`, testNow)
+	require.NoError(t, err)
+	_, err = q.Eval(nil)
+	require.EqualError(t, err, "comparisons between scalars must use bool modifier")
+
+	q, err = NewInstantQuery(`up`, testNow)
+	require.NoError(t, err)
+	_, err = q.Eval(nil)
+	require.EqualError(t, err, "expected the results of 1 statements, got 0")
+}
+
+func TestBucketQuantile(t *testing.T) {
+	buckets := func() []bucket {
+		return []bucket{{upperBound: 0.5, count: 10}, {upperBound: 1, count: 20}, {upperBound: math.Inf(1), count: 20}}
+	}
+	require.Equal(t, 0.25, bucketQuantile(0.25, buckets()))
+	require.Equal(t, 0.75, bucketQuantile(0.75, buckets()))
+	// the quantile in the +Inf bucket is the upper bound of the second highest bucket
+	require.Equal(t, 1.0, bucketQuantile(1, []bucket{{upperBound: 1, count: 1}, {upperBound: math.Inf(1), count: 2}}))
+	require.True(t, math.IsNaN(bucketQuantile(0.5, []bucket{{upperBound: 1, count: 1}})))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+)
+
+// LabelNamesStatement returns the statement of the label names API, the metric name is added by LabelNames.
+func LabelNamesStatement() influxql.Statement {
+	return &influxql.ShowTagKeysStatement{}
+}
+
+// LabelNames returns the sorted tag keys returned by SHOW TAG KEYS and the metric name label.
+func LabelNames(rows models.Rows) []string {
+	return distinctValues(rows, "tagKey", MetricNameLabel)
+}
+
+// LabelValuesStatement returns the statement of the label values API, the values of the metric name are the measurements.
+func LabelValuesStatement(name string) influxql.Statement {
+	if name == MetricNameLabel {
+		return &influxql.ShowMeasurementsStatement{}
+	}
+	return &influxql.ShowTagValuesStatement{
+		Op:         influxql.EQ,
+		TagKeyExpr: &influxql.StringLiteral{Val: name},
+	}
+}
+
+// LabelValues returns the sorted values returned by the statement of LabelValuesStatement.
+func LabelValues(rows models.Rows) []string {
+	values := distinctValues(rows, "value")
+	if len(values) == 0 {
+		values = distinctValues(rows, "name")
+	}
+	return values
+}
+
+// SeriesStatement returns the statement listing the series matched by the selector.
+func SeriesStatement(vs *VectorSelector) (influxql.Statement, error) {
+	sources, cond, err := selectorCondition(vs)
+	if err != nil {
+		return nil, err
+	}
+	return &influxql.ShowSeriesStatement{Sources: sources, Condition: cond}, nil
+}
+
+// SeriesLabels returns the labels of the series keys returned by SHOW SERIES.
+func SeriesLabels(rows models.Rows) ([]map[string]string, error) {
+	var series []map[string]string
+	for _, key := range distinctValues(rows, "key") {
+		name, tags := models.ParseKey([]byte(key))
+		if name == "" {
+			return nil, fmt.Errorf("invalid series key %s", key)
+		}
+		metric := tags.Map()
+		metric[MetricNameLabel] = name
+		series = append(series, metric)
+	}
+	return series, nil
+}
+
+// distinctValues returns the sorted string values of the column, the extra values are added.
+func distinctValues(rows models.Rows, column string, extra ...string) []string {
+	seen := make(map[string]bool)
+	for _, v := range extra {
+		seen[v] = true
+	}
+	for _, row := range rows {
+		idx := -1
+		for i, c := range row.Columns {
+			if c == column {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		for _, values := range row.Values {
+			if s, ok := values[idx].(string); ok {
+				seen[s] = true
+			}
+		}
+	}
+
+	values := make([]string, 0, len(seen))
+	for v := range seen {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataStatements(t *testing.T) {
+	require.Equal(t, `SHOW TAG KEYS`, LabelNamesStatement().String())
+	require.Equal(t, `SHOW MEASUREMENTS`, LabelValuesStatement(MetricNameLabel).String())
+	require.Equal(t, `SHOW TAG VALUES WITH KEY = job`, LabelValuesStatement("job").String())
+
+	vs, err := ParseMetricSelector(`{__name__=~"cpu.*", host="a"}`)
+	require.NoError(t, err)
+	stmt, err := SeriesStatement(vs)
+	require.NoError(t, err)
+	require.Equal(t, `SHOW SERIES FROM /^(?:cpu.*)$/ WHERE host = 'a'`, stmt.String())
+
+	_, err = ParseMetricSelector(`rate(cpu[1m])`)
+	require.EqualError(t, err, "invalid series selector rate(cpu[1m])")
+}
+
+func TestMetadataResults(t *testing.T) {
+	tagKeys := models.Rows{
+		{Name: "cpu", Columns: []string{"tagKey"}, Values: [][]interface{}{{"host"}, {"zone"}}},
+		{Name: "mem", Columns: []string{"tagKey"}, Values: [][]interface{}{{"host"}}},
+	}
+	require.Equal(t, []string{"__name__", "host", "zone"}, LabelNames(tagKeys))
+
+	measurements := models.Rows{{Name: "measurements", Columns: []string{"name"}, Values: [][]interface{}{{"mem"}, {"cpu"}}}}
+	require.Equal(t, []string{"cpu", "mem"}, LabelValues(measurements))
+	tagValues := models.Rows{
+		{Name: "cpu", Columns: []string{"key", "value"}, Values: [][]interface{}{{"host", "b"}, {"host", "a"}}},
+		{Name: "mem", Columns: []string{"key", "value"}, Values: [][]interface{}{{"host", "a"}}},
+	}
+	require.Equal(t, []string{"a", "b"}, LabelValues(tagValues))
+
+	series, err := SeriesLabels(models.Rows{{Columns: []string{"key"}, Values: [][]interface{}{{`cpu,host=a\,b`}, {"mem"}}}})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]string{{"__name__": "cpu", "host": "a,b"}, {"__name__": "mem"}}, series)
+}
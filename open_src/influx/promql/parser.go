@@ -0,0 +1,521 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricNameLabel is the label of the metric name, it is the measurement of the series.
+const MetricNameLabel = "__name__"
+
+// Expr is a node of a PromQL expression.
+type Expr interface {
+	expr()
+}
+
+type MatchType int
+
+const (
+	MatchEqual MatchType = iota
+	MatchNotEqual
+	MatchRegexp
+	MatchNotRegexp
+)
+
+// Matcher is a label matcher like host="a" or host=~"a|b".
+type Matcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+	re    *regexp.Regexp
+}
+
+type (
+	NumberLiteral struct{ Val float64 }
+	StringLiteral struct{ Val string }
+
+	// VectorSelector selects the latest sample of each series, Name is empty if the metric name is matched by a regex
+	VectorSelector struct {
+		Name     string
+		Matchers []*Matcher
+		Offset   time.Duration
+	}
+
+	// MatrixSelector selects the samples of a range like cpu[5m]
+	MatrixSelector struct {
+		Vector *VectorSelector
+		Range  time.Duration
+	}
+
+	Call struct {
+		Func string
+		Args []Expr
+	}
+
+	// AggregateExpr is an aggregation like sum by (host) (cpu)
+	AggregateExpr struct {
+		Op       string
+		Expr     Expr
+		Grouping []string
+		Without  bool
+	}
+
+	// BinaryExpr is an arithmetic or comparison expression, the comparisons filter unless ReturnBool is set
+	BinaryExpr struct {
+		Op         string
+		LHS        Expr
+		RHS        Expr
+		ReturnBool bool
+	}
+
+	UnaryExpr struct {
+		Op   string
+		Expr Expr
+	}
+)
+
+func (*NumberLiteral) expr()  {}
+func (*StringLiteral) expr()  {}
+func (*VectorSelector) expr() {}
+func (*MatrixSelector) expr() {}
+func (*Call) expr()           {}
+func (*AggregateExpr) expr()  {}
+func (*BinaryExpr) expr()     {}
+func (*UnaryExpr) expr()      {}
+
+var aggregateOps = map[string]bool{
+	"sum": true, "avg": true, "min": true, "max": true, "count": true, "stddev": true, "stdvar": true,
+}
+
+var unsupportedAggregateOps = map[string]bool{
+	"topk": true, "bottomk": true, "quantile": true, "count_values": true, "group": true,
+}
+
+// ParseExpr parses a PromQL expression, subqueries, set operators and vector matching are not supported.
+func ParseExpr(input string) (Expr, error) {
+	tokens, err := scan(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		if tok.kind == tokIdent && (tok.lit == "and" || tok.lit == "or" || tok.lit == "unless") {
+			return nil, fmt.Errorf("set operator %s is not supported", tok.lit)
+		}
+		return nil, p.unexpected("EOF")
+	}
+	return expr, nil
+}
+
+// ParseMetricSelector parses a selector like cpu{host="a"} of the match[] parameter of the series API.
+func ParseMetricSelector(input string) (*VectorSelector, error) {
+	expr, err := ParseExpr(input)
+	if err != nil {
+		return nil, err
+	}
+	vs, ok := expr.(*VectorSelector)
+	if !ok || vs.Offset != 0 {
+		return nil, fmt.Errorf("invalid series selector %s", input)
+	}
+	return vs, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *parser) isOp(op string) bool {
+	tok := p.peek()
+	return tok.kind == tokOp && tok.lit == op
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	tok := p.peek()
+	return tok.kind == tokIdent && tok.lit == kw
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.isOp(op) {
+		return p.unexpected(op)
+	}
+	p.i++
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	tok := p.peek()
+	return fmt.Errorf("found %s at %d, expected %s", tok, tok.pos, expected)
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseBinary(0)
+}
+
+// binaryOps are ordered by precedence, from low to high
+var binaryOps = []map[string]bool{
+	{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true},
+	{"+": true, "-": true},
+	{"*": true, "/": true, "%": true},
+}
+
+var comparisonOps = binaryOps[0]
+
+func (p *parser) parseBinary(level int) (Expr, error) {
+	if level == len(binaryOps) {
+		return p.parseUnary()
+	}
+
+	lhs, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.kind == tokOp && binaryOps[level][tok.lit]; tok = p.peek() {
+		p.next()
+		expr := &BinaryExpr{Op: tok.lit, LHS: lhs}
+		if err := p.parseModifiers(expr); err != nil {
+			return nil, err
+		}
+		if expr.RHS, err = p.parseBinary(level + 1); err != nil {
+			return nil, err
+		}
+		lhs = expr
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseModifiers(expr *BinaryExpr) error {
+	if p.isKeyword("bool") {
+		if !comparisonOps[expr.Op] {
+			return fmt.Errorf("bool modifier can only be used on comparison operators")
+		}
+		p.next()
+		expr.ReturnBool = true
+	}
+	for _, kw := range []string{"on", "ignoring", "group_left", "group_right"} {
+		if p.isKeyword(kw) {
+			return fmt.Errorf("vector matching with %s is not supported", kw)
+		}
+	}
+	return nil
+}
+
+// parseUnary parses -x, the unary operators bind less tightly than ^
+func (p *parser) parseUnary() (Expr, error) {
+	if p.isOp("-") || p.isOp("+") {
+		op := p.next().lit
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if n, ok := x.(*NumberLiteral); ok {
+			if op == "-" {
+				n.Val = -n.Val
+			}
+			return n, nil
+		}
+		if op == "+" {
+			return x, nil
+		}
+		return &UnaryExpr{Op: op, Expr: x}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *parser) parsePower() (Expr, error) {
+	lhs, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp("^") {
+		return lhs, nil
+	}
+	p.next()
+	// ^ is right associative
+	rhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryExpr{Op: "^", LHS: lhs, RHS: rhs}, nil
+}
+
+func (p *parser) parsePostfix() (Expr, error) {
+	expr, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isOp("[") {
+		p.next()
+		vs, ok := expr.(*VectorSelector)
+		if !ok {
+			return nil, fmt.Errorf("ranges are only allowed for vector selectors, subqueries are not supported")
+		}
+		tok := p.next()
+		if tok.kind != tokDuration {
+			return nil, fmt.Errorf("found %s at %d, expected duration", tok, tok.pos)
+		}
+		if strings.Contains(tok.lit, ":") {
+			return nil, fmt.Errorf("subqueries are not supported")
+		}
+		d, err := ParseDuration(tok.lit)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectOp("]"); err != nil {
+			return nil, err
+		}
+		expr = &MatrixSelector{Vector: vs, Range: d}
+	}
+
+	if p.isKeyword("offset") {
+		p.next()
+		tok := p.next()
+		if tok.kind != tokDuration {
+			return nil, fmt.Errorf("found %s at %d, expected duration", tok, tok.pos)
+		}
+		d, err := ParseDuration(tok.lit)
+		if err != nil {
+			return nil, err
+		}
+		switch e := expr.(type) {
+		case *VectorSelector:
+			e.Offset = d
+		case *MatrixSelector:
+			e.Vector.Offset = d
+		default:
+			return nil, fmt.Errorf("offset modifier must be preceded by a selector")
+		}
+	}
+	return expr, nil
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokNumber:
+		p.next()
+		v, err := strconv.ParseFloat(tok.lit, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s at %d", tok.lit, tok.pos)
+		}
+		return &NumberLiteral{Val: v}, nil
+	case tokString:
+		p.next()
+		return &StringLiteral{Val: tok.lit}, nil
+	case tokIdent:
+		switch {
+		case strings.EqualFold(tok.lit, "Inf"):
+			p.next()
+			return &NumberLiteral{Val: math.Inf(1)}, nil
+		case strings.EqualFold(tok.lit, "NaN"):
+			p.next()
+			return &NumberLiteral{Val: math.NaN()}, nil
+		case aggregateOps[tok.lit] && p.isAggregation():
+			return p.parseAggregation()
+		case unsupportedAggregateOps[tok.lit] && p.isAggregation():
+			return nil, fmt.Errorf("aggregation %s is not supported", tok.lit)
+		case p.tokens[p.i+1].kind == tokOp && p.tokens[p.i+1].lit == "(":
+			return p.parseCall()
+		}
+		return p.parseSelector()
+	case tokOp:
+		switch tok.lit {
+		case "(":
+			p.next()
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expectOp(")")
+		case "{":
+			return p.parseSelector()
+		}
+	}
+	return nil, p.unexpected("expression")
+}
+
+// isAggregation tells whether the identifier is followed by ( or a grouping, otherwise it is a metric name
+func (p *parser) isAggregation() bool {
+	next := p.tokens[p.i+1]
+	return (next.kind == tokOp && next.lit == "(") || (next.kind == tokIdent && (next.lit == "by" || next.lit == "without"))
+}
+
+func (p *parser) parseCall() (Expr, error) {
+	call := &Call{Func: p.next().lit}
+	p.next()
+	for !p.isOp(")") {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	return call, p.expectOp(")")
+}
+
+func (p *parser) parseAggregation() (Expr, error) {
+	agg := &AggregateExpr{Op: p.next().lit}
+	if err := p.parseGrouping(agg); err != nil {
+		return nil, err
+	}
+	if err := p.expectOp("("); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp(",") {
+		return nil, fmt.Errorf("aggregation %s does not take a parameter", agg.Op)
+	}
+	if err := p.expectOp(")"); err != nil {
+		return nil, err
+	}
+	agg.Expr = expr
+
+	if agg.Grouping == nil && !agg.Without {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+func (p *parser) parseGrouping(agg *AggregateExpr) error {
+	if !p.isKeyword("by") && !p.isKeyword("without") {
+		return nil
+	}
+	agg.Without = p.next().lit == "without"
+	if err := p.expectOp("("); err != nil {
+		return err
+	}
+	agg.Grouping = []string{}
+	for !p.isOp(")") {
+		tok := p.next()
+		if tok.kind != tokIdent {
+			return fmt.Errorf("found %s at %d, expected label", tok, tok.pos)
+		}
+		agg.Grouping = append(agg.Grouping, tok.lit)
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	return p.expectOp(")")
+}
+
+func (p *parser) parseSelector() (Expr, error) {
+	vs := &VectorSelector{}
+	if p.peek().kind == tokIdent {
+		vs.Name = p.next().lit
+	}
+
+	if p.isOp("{") {
+		p.next()
+		for !p.isOp("}") {
+			m, err := p.parseMatcher()
+			if err != nil {
+				return nil, err
+			}
+			if m.Name == MetricNameLabel && m.Type == MatchEqual && vs.Name == "" {
+				vs.Name = m.Value
+			} else {
+				vs.Matchers = append(vs.Matchers, m)
+			}
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expectOp("}"); err != nil {
+			return nil, err
+		}
+	}
+
+	if vs.Name == "" {
+		hasNameMatcher := false
+		for _, m := range vs.Matchers {
+			hasNameMatcher = hasNameMatcher || m.Name == MetricNameLabel
+		}
+		if !hasNameMatcher {
+			return nil, fmt.Errorf("vector selector must contain a metric name")
+		}
+	}
+	return vs, nil
+}
+
+func (p *parser) parseMatcher() (*Matcher, error) {
+	name := p.next()
+	if name.kind != tokIdent {
+		return nil, fmt.Errorf("found %s at %d, expected label", name, name.pos)
+	}
+	op := p.next()
+	value := p.next()
+	if value.kind != tokString {
+		return nil, fmt.Errorf("found %s at %d, expected string", value, value.pos)
+	}
+
+	m := &Matcher{Name: name.lit, Value: value.lit}
+	switch op.lit {
+	case "=":
+		m.Type = MatchEqual
+	case "!=":
+		m.Type = MatchNotEqual
+	case "=~":
+		m.Type = MatchRegexp
+	case "!~":
+		m.Type = MatchNotRegexp
+	default:
+		return nil, fmt.Errorf("found %s at %d, expected label matching operator", op, op.pos)
+	}
+	if m.Type == MatchRegexp || m.Type == MatchNotRegexp {
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return nil, err
+		}
+		m.re = re
+	}
+	return m, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokDuration
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	lit  string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "EOF"
+	}
+	return t.lit
+}
+
+// operators are matched longest first
+var operators = []string{
+	"==", "!=", "<=", ">=", "=~", "!~",
+	"<", ">", "=", "+", "-", "*", "/", "%", "^", "(", ")", "[", "]", "{", "}", ",",
+}
+
+// scan splits the expression into tokens, a number directly followed by a unit is a duration.
+func scan(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		start := i
+		var tok token
+		var err error
+		switch {
+		case isIdentStart(c):
+			for i < len(src) && isIdentChar(src[i]) {
+				i++
+			}
+			tok = token{kind: tokIdent, lit: src[start:i]}
+		case isDigit(c) || (c == '.' && i+1 < len(src) && isDigit(src[i+1])):
+			tok, i, err = scanNumber(src, i)
+		case c == '"' || c == '\'' || c == '`':
+			tok, i, err = scanString(src, i)
+		default:
+			for _, op := range operators {
+				if strings.HasPrefix(src[i:], op) {
+					tok = token{kind: tokOp, lit: op}
+					i += len(op)
+					break
+				}
+			}
+			if tok.lit == "" {
+				return nil, fmt.Errorf("unexpected character %q at %d", c, i)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		tok.pos = start
+		tokens = append(tokens, tok)
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(src)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func scanNumber(src string, i int) (token, int, error) {
+	start := i
+	for i < len(src) && (isDigit(src[i]) || src[i] == '.') {
+		i++
+	}
+	// exponent of a float
+	if i < len(src) && (src[i] == 'e' || src[i] == 'E') && i+1 < len(src) &&
+		(isDigit(src[i+1]) || ((src[i+1] == '+' || src[i+1] == '-') && i+2 < len(src) && isDigit(src[i+2]))) {
+		i += 2
+		for i < len(src) && isDigit(src[i]) {
+			i++
+		}
+	}
+	if i == len(src) || !isIdentStart(src[i]) {
+		return token{kind: tokNumber, lit: src[start:i]}, i, nil
+	}
+
+	// a duration is made of numbers and units like 1h30m
+	for i < len(src) && isIdentChar(src[i]) {
+		i++
+	}
+	return token{kind: tokDuration, lit: src[start:i]}, i, nil
+}
+
+func scanString(src string, i int) (token, int, error) {
+	quote := src[i]
+	start := i
+	i++
+	for i < len(src) && src[i] != quote {
+		if src[i] == '\\' && quote != '`' {
+			i++
+		}
+		i++
+	}
+	if i >= len(src) {
+		return token{}, i, fmt.Errorf("unterminated string literal at %d", start)
+	}
+	i++
+
+	lit := src[start:i]
+	if quote == '\'' {
+		// single quotes are unquoted like double quotes
+		lit = `"` + strings.ReplaceAll(strings.ReplaceAll(lit[1:len(lit)-1], `\'`, `'`), `"`, `\"`) + `"`
+	}
+	s, err := strconv.Unquote(lit)
+	if err != nil {
+		return token{}, i, fmt.Errorf("invalid string literal at %d: %v", start, err)
+	}
+	return token{kind: tokString, lit: s}, i, nil
+}
+
+var durationUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	"y":  365 * 24 * time.Hour,
+}
+
+// ParseDuration parses a PromQL duration like 5m or 1h30m.
+func ParseDuration(s string) (time.Duration, error) {
+	var d time.Duration
+	rest := s
+	for rest != "" {
+		i := 0
+		for i < len(rest) && isDigit(rest[i]) {
+			i++
+		}
+		j := i
+		for j < len(rest) && !isDigit(rest[j]) {
+			j++
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("invalid duration %s", s)
+		}
+		n, err := strconv.ParseInt(rest[:i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %s", s)
+		}
+		unit, ok := durationUnits[rest[i:j]]
+		if !ok {
+			return 0, fmt.Errorf("unsupported duration unit %q in %s", rest[i:j], s)
+		}
+		d += time.Duration(n) * unit
+		rest = rest[j:]
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive: %s", s)
+	}
+	return d, nil
+}
@@ -116,6 +116,10 @@ func newCompiler(opt CompileOptions) *compiledStatement {
 func Compile(stmt *influxql.SelectStatement, opt CompileOptions) (Statement, error) {
 	c := newCompiler(opt)
 	c.stmt = stmt.Clone()
+	// Rewrite the measurements of a join into subqueries before they are compiled.
+	if err := c.stmt.RewriteJoin(); err != nil {
+		return nil, err
+	}
 	if err := c.preprocess(c.stmt); err != nil {
 		return nil, err
 	}
@@ -201,6 +205,14 @@ func (c *compiledStatement) compile(stmt *influxql.SelectStatement) error {
 			if err := c.subquery(source.Statement); err != nil {
 				return err
 			}
+		case *influxql.Join:
+			for _, side := range []influxql.Source{source.LSrc, source.RSrc} {
+				subQuery := side.(*influxql.SubQuery)
+				subQuery.Statement.OmitTime = true
+				if err := c.subquery(subQuery.Statement); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -21,6 +21,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	query2 "github.com/influxdata/influxdb/query"
 	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/audit"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
@@ -184,6 +185,18 @@ type ExecutionOptions struct {
 
 	Traceid uint64
 
+	// The ID assigned to the query by the TaskManager.
+	QueryID uint64
+
+	// The user running the query, empty if authentication is disabled.
+	UserID string
+
+	// The address of the client, recorded by the audit log.
+	RemoteAddr string
+
+	// The workload group limiting the query, assigned by the TaskManager.
+	Workload *WorkloadGroup
+
 	// The results of the query executor
 	RowsChan chan RowsChan
 }
@@ -223,6 +236,9 @@ type Executor struct {
 	// Used for tracking running queries.
 	TaskManager *TaskManager
 
+	// Records the statements changing the schema, the users or the privileges, nil if the audit log is disabled.
+	Auditor audit.Recorder
+
 	// Logger to use for all logging.
 	// Defaults to discarding all log output.
 	Logger *zap.Logger
@@ -269,6 +285,14 @@ func (e *Executor) executeQuery(query *influxql.Query, opt ExecutionOptions, clo
 	}
 	defer detach()
 
+	if err = e.TaskManager.Admit(ctx); err != nil {
+		select {
+		case results <- &query2.Result{Err: err}:
+		case <-opt.AbortCh:
+		}
+		return
+	}
+
 	// Setup the execution context that will be used when executing statements.
 	ctx.Results = results
 
@@ -347,6 +371,7 @@ LOOP:
 		}
 
 		// Send any other statements to the underlying statement executor.
+		stmtStart := time.Now()
 		err = e.StatementExecutor.ExecuteStatement(stmt, ctx)
 		if err == ErrQueryInterrupted {
 			// Query was interrupted so retrieve the real interrupt error from
@@ -355,6 +380,18 @@ LOOP:
 				err = qerr
 			}
 		}
+		if category := audit.StatementCategory(stmt); category != "" && e.Auditor != nil {
+			e.Auditor.Record(audit.Event{
+				Time:      stmtStart,
+				Category:  category,
+				User:      opt.UserID,
+				Addr:      opt.RemoteAddr,
+				Database:  defaultDB,
+				Statement: stmt.String(),
+				Err:       err,
+				Duration:  time.Since(stmtStart),
+			})
+		}
 
 		// Send an error for this result if it failed for some reason.
 		if err != nil {
@@ -441,6 +478,10 @@ type Task struct {
 	monitorCh chan error
 	err       error
 	mu        sync.Mutex
+
+	// the workload group of the query, and whether the query holds a slot of the group
+	group    *WorkloadGroup
+	admitted bool
 }
 
 // Monitor starts a new goroutine that will monitor a query. The function
@@ -485,6 +526,15 @@ func (q *Task) close() {
 	q.mu.Unlock()
 }
 
+// setStatus changes the status of a query that is not killed.
+func (q *Task) setStatus(status TaskStatus) {
+	q.mu.Lock()
+	if q.status != KilledTask {
+		q.status = status
+	}
+	q.mu.Unlock()
+}
+
 func (q *Task) kill() error {
 	q.mu.Lock()
 	if q.status == KilledTask {
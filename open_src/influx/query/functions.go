@@ -74,7 +74,20 @@ func (FunctionTypeMapper) MapTypeBatch(measurement *influxql.Measurement, field
 	return nil
 }
 
+// unsignedCalls are the aggregates implemented for unsigned values by the executor and the store.
+var unsignedCalls = map[string]bool{
+	"count": true,
+	"sum":   true,
+	"min":   true,
+	"max":   true,
+	"first": true,
+	"last":  true,
+}
+
 func (m FunctionTypeMapper) CallType(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if len(args) > 0 && args[0] == influxql.Unsigned && !unsignedCalls[name] && !isMathFunction(&influxql.Call{Name: name}) {
+		return influxql.Unknown, fmt.Errorf("unsigned fields are not supported in %s()", name)
+	}
 	if typ, err := m.CallTypeMapper.CallType(name, args); typ != influxql.Unknown || err != nil {
 		return typ, err
 	}
@@ -47,3 +47,34 @@ func TestFunctionTypeMapper(t *testing.T) {
 		assert.Equal(t, dataType, influxql.String)
 	}
 }
+
+func TestFunctionTypeMapper_Unsigned(t *testing.T) {
+	m := query.FunctionTypeMapper{}
+
+	if dataType, err := m.CallType("abs", []influxql.DataType{influxql.Unsigned}); err != nil {
+		t.Fatalf("raise error: %s", err.Error())
+	} else {
+		assert.Equal(t, dataType, influxql.Unsigned)
+	}
+
+	for name, expected := range map[string]influxql.DataType{
+		"count": influxql.Integer,
+		"sum":   influxql.Unsigned,
+		"min":   influxql.Unsigned,
+		"max":   influxql.Unsigned,
+		"first": influxql.Unsigned,
+		"last":  influxql.Unsigned,
+	} {
+		if dataType, err := m.CallType(name, []influxql.DataType{influxql.Unsigned}); err != nil {
+			t.Fatalf("raise error: %s", err.Error())
+		} else {
+			assert.Equal(t, dataType, expected)
+		}
+	}
+
+	for _, name := range []string{"mean", "spread", "difference"} {
+		if _, err := m.CallType(name, []influxql.DataType{influxql.Unsigned}); err == nil {
+			t.Fatalf("expect an error for %s() of an unsigned field", name)
+		}
+	}
+}
@@ -1211,6 +1211,8 @@ type RemoteQuery struct {
 	NodeID    uint64   `protobuf:"varint,5,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
 	Analyze   bool     `protobuf:"varint,6,opt,name=analyze,proto3" json:"analyze,omitempty"`
 	QueryNode []byte   `protobuf:"bytes,7,opt,name=QueryNode,proto3" json:"QueryNode,omitempty"`
+	QueryID   uint64   `protobuf:"varint,8,opt,name=QueryID,proto3" json:"QueryID,omitempty"`
+	QueryHost string   `protobuf:"bytes,9,opt,name=QueryHost,proto3" json:"QueryHost,omitempty"`
 }
 
 func (x *RemoteQuery) Reset() {
@@ -1294,6 +1296,20 @@ func (x *RemoteQuery) GetQueryNode() []byte {
 	return nil
 }
 
+func (x *RemoteQuery) GetQueryID() uint64 {
+	if x != nil {
+		return x.QueryID
+	}
+	return 0
+}
+
+func (x *RemoteQuery) GetQueryHost() string {
+	if x != nil {
+		return x.QueryHost
+	}
+	return ""
+}
+
 var File_internal_proto protoreflect.FileDescriptor
 
 var file_internal_proto_rawDesc = []byte{
@@ -1469,7 +1485,7 @@ var file_internal_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6d, 0x69,
 	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74,
 	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
 	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
 	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
@@ -1481,8 +1497,12 @@ var file_internal_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
 	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e,
 	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x0c, 0x5a, 0x0a,
+	0x2e, 0x3b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -62,6 +62,9 @@ type SelectOptions struct {
 
 	Traceid uint64
 
+	// The ID of the query on the SQL node, sent to the stores to identify the query.
+	QueryID uint64
+
 	AbortChan <-chan struct{}
 	RowsChan  chan RowsChan
 
@@ -209,6 +212,9 @@ type ProcessorOptions struct {
 
 	Traceid uint64
 
+	// The ID of the query on the SQL node, it is not marshaled.
+	QueryID uint64
+
 	// hint supported (need to marshal)
 	HintType hybridqp.HintType
 
@@ -292,6 +298,7 @@ func NewProcessorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 	opt.ChunkSize = sopt.ChunkSize
 
 	opt.Traceid = sopt.Traceid
+	opt.QueryID = sopt.QueryID
 
 	opt.MaxParallel = sopt.MaxQueryParallel
 	opt.AbortChan = sopt.AbortChan
@@ -10,11 +10,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/query"
+	"github.com/openGemini/openGemini/lib/machine"
 	statistics "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"go.uber.org/zap"
@@ -35,6 +38,9 @@ const (
 	// KilledTask is set when the task is killed, but resources are still
 	// being used.
 	KilledTask
+
+	// QueuedTask is set when the task waits for its workload group.
+	QueuedTask
 )
 
 func (t TaskStatus) String() string {
@@ -43,6 +49,8 @@ func (t TaskStatus) String() string {
 		return "running"
 	case KilledTask:
 		return "killed"
+	case QueuedTask:
+		return "queued"
 	default:
 		return "unknown"
 	}
@@ -58,6 +66,8 @@ func (t *TaskStatus) UnmarshalJSON(data []byte) error {
 		*t = RunningTask
 	} else if bytes.Equal(data, []byte("killed")) {
 		*t = KilledTask
+	} else if bytes.Equal(data, []byte("queued")) {
+		*t = QueuedTask
 	} else if bytes.Equal(data, []byte("unknown")) {
 		*t = TaskStatus(0)
 	} else {
@@ -66,6 +76,28 @@ func (t *TaskStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// StorePipeline is a pipeline a store runs for a query of a SQL node.
+type StorePipeline struct {
+	NodeID   uint64
+	Host     string
+	QueryID  uint64
+	Query    string
+	Database string
+	PtID     uint32
+	Shards   int
+	Chunks   int64
+	Duration time.Duration
+}
+
+// ClusterQueries reaches the pipelines running on all stores.
+type ClusterQueries interface {
+	// Pipelines returns the pipelines running on the stores that can be reached.
+	Pipelines() []StorePipeline
+	// KillQuery aborts the pipelines of a query of a SQL node on all stores
+	// and returns the number of the pipelines aborted.
+	KillQuery(host string, qid uint64) (int, error)
+}
+
 // TaskManager takes care of all aspects related to managing running queries.
 type TaskManager struct {
 	// Query execution timeout.
@@ -82,6 +114,14 @@ type TaskManager struct {
 	// Defaults to discarding all log output.
 	Logger *zap.Logger
 
+	// Workloads maps the users to the workload groups limiting their queries.
+	// If nil, the queries are only limited by the settings above.
+	Workloads *WorkloadGroups
+
+	// Cluster reaches the pipelines the stores run for the queries of all
+	// SQL nodes. If nil, only the queries of this node are managed.
+	Cluster ClusterQueries
+
 	// Used for managing and tracking running queries.
 	queries  map[uint64]*Task
 	nextID   uint64
@@ -130,37 +170,152 @@ func (t *TaskManager) ExecuteStatement(stmt influxql.Statement, ctx *ExecutionCo
 }
 
 func (t *TaskManager) executeKillQueryStatement(stmt *influxql.KillQueryStatement) error {
-	return t.KillQuery(stmt.QueryID)
+	host := machine.GetMachineAddr()
+	if stmt.Host == "" || stmt.Host == host {
+		if err := t.KillQuery(stmt.QueryID); err != nil {
+			return err
+		}
+		// the pipelines on the stores are aborted by the query itself, this
+		// only stops them sooner
+		if t.Cluster != nil {
+			if _, err := t.Cluster.KillQuery(host, stmt.QueryID); err != nil {
+				t.Logger.Warn("failed to kill the query on the stores", zap.Uint64("qid", stmt.QueryID), zap.Error(err))
+			}
+		}
+		return nil
+	}
+
+	if t.Cluster == nil {
+		return fmt.Errorf("no such query id: %d on %s", stmt.QueryID, stmt.Host)
+	}
+	n, err := t.Cluster.KillQuery(stmt.Host, stmt.QueryID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("no such query id: %d on %s", stmt.QueryID, stmt.Host)
+	}
+	return nil
 }
 
 func (t *TaskManager) executeShowQueriesStatement(q *influxql.ShowQueriesStatement) (models.Rows, error) {
-	t.mu.RLock()
-	defer t.mu.RUnlock()
+	host := machine.GetMachineAddr()
+	var pipelines map[queryKey][]StorePipeline
+	if t.Cluster != nil {
+		pipelines = groupPipelines(t.Cluster.Pipelines())
+	}
 
+	t.mu.RLock()
 	now := time.Now()
-
 	values := make([][]interface{}, 0, len(t.queries))
 	for id, qi := range t.queries {
-		d := now.Sub(qi.startTime)
-
-		switch {
-		case d >= time.Second:
-			d = d - (d % time.Second)
-		case d >= time.Millisecond:
-			d = d - (d % time.Millisecond)
-		case d >= time.Microsecond:
-			d = d - (d % time.Microsecond)
+		key := queryKey{host: host, id: id}
+		var group string
+		if qi.group != nil {
+			group = qi.group.Name()
 		}
+		values = append(values, []interface{}{id, host, qi.query, qi.database, roundDuration(now.Sub(qi.startTime)).String(),
+			qi.status.String(), group, pipelinesSummary(pipelines[key])})
+		delete(pipelines, key)
+	}
+	t.mu.RUnlock()
 
-		values = append(values, []interface{}{id, qi.query, qi.database, d.String(), qi.status.String()})
+	// the queries of the other SQL nodes are only known by their pipelines,
+	// they run at least as long as the oldest one
+	remotes := make([]queryKey, 0, len(pipelines))
+	for key := range pipelines {
+		if key.host != host {
+			remotes = append(remotes, key)
+		}
+	}
+	sort.Slice(remotes, func(i, j int) bool {
+		if remotes[i].host != remotes[j].host {
+			return remotes[i].host < remotes[j].host
+		}
+		return remotes[i].id < remotes[j].id
+	})
+	for _, key := range remotes {
+		ps := pipelines[key]
+		var d time.Duration
+		for i := range ps {
+			if ps[i].Duration > d {
+				d = ps[i].Duration
+			}
+		}
+		values = append(values, []interface{}{key.id, key.host, ps[0].Query, ps[0].Database, roundDuration(d).String(),
+			RunningTask.String(), "", pipelinesSummary(ps)})
 	}
 
 	return []*models.Row{{
-		Columns: []string{"qid", "query", "database", "duration", "status"},
+		Columns: []string{"qid", "host", "query", "database", "duration", "status", "group", "stores"},
 		Values:  values,
 	}}, nil
 }
 
+type queryKey struct {
+	host string
+	id   uint64
+}
+
+func groupPipelines(pipelines []StorePipeline) map[queryKey][]StorePipeline {
+	m := make(map[queryKey][]StorePipeline)
+	for _, p := range pipelines {
+		key := queryKey{host: p.Host, id: p.QueryID}
+		m[key] = append(m[key], p)
+	}
+	return m
+}
+
+// pipelinesSummary describes the progress of a query on each store, e.g. "node=1 pts=2 shards=4 chunks=10 duration=1s".
+func pipelinesSummary(pipelines []StorePipeline) string {
+	type progress struct {
+		pts      int
+		shards   int
+		chunks   int64
+		duration time.Duration
+	}
+	nodes := make(map[uint64]*progress)
+	for _, p := range pipelines {
+		pg, ok := nodes[p.NodeID]
+		if !ok {
+			pg = &progress{}
+			nodes[p.NodeID] = pg
+		}
+		pg.pts++
+		pg.shards += p.Shards
+		pg.chunks += p.Chunks
+		if p.Duration > pg.duration {
+			pg.duration = p.Duration
+		}
+	}
+
+	ids := make([]uint64, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		pg := nodes[id]
+		items = append(items, fmt.Sprintf("node=%d pts=%d shards=%d chunks=%d duration=%s",
+			id, pg.pts, pg.shards, pg.chunks, roundDuration(pg.duration)))
+	}
+	return strings.Join(items, "; ")
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		d = d - (d % time.Second)
+	case d >= time.Millisecond:
+		d = d - (d % time.Millisecond)
+	case d >= time.Microsecond:
+		d = d - (d % time.Microsecond)
+	}
+	return d
+}
+
 func (t *TaskManager) queryError(qid uint64, err error) {
 	t.mu.RLock()
 	query := t.queries[qid]
@@ -197,10 +352,17 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		startTime: time.Now(),
 		closing:   make(chan struct{}),
 		monitorCh: make(chan error),
+		group:     t.Workloads.Group(opt.UserID),
 	}
 	t.queries[qid] = query
+	opt.QueryID = qid
+	opt.Workload = query.group
 
-	go t.waitForQuery(qid, query.closing, interrupt, query.monitorCh)
+	timeout := t.QueryTimeout
+	if query.group != nil && query.group.Timeout() != 0 {
+		timeout = query.group.Timeout()
+	}
+	go t.waitForQuery(qid, timeout, query.closing, interrupt, query.monitorCh)
 	if t.LogQueriesAfter != 0 {
 		go query.monitor(func(closing <-chan struct{}) error {
 			timer := time.NewTimer(t.LogQueriesAfter)
@@ -228,6 +390,31 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 	return ctx, func() { t.DetachQuery(qid) }, nil
 }
 
+// Admit waits until the workload group of a query lets it run, the query
+// is shown as queued meanwhile. The query holds its slot of the group until
+// it is detached.
+func (t *TaskManager) Admit(ctx *ExecutionContext) error {
+	task := ctx.task
+	if task == nil || task.group == nil {
+		return nil
+	}
+
+	task.setStatus(QueuedTask)
+	admitted, err := task.group.acquire(ctx.Done())
+	if err != nil {
+		if qerr := ctx.Err(); qerr != nil {
+			err = qerr
+		}
+		return err
+	}
+
+	task.mu.Lock()
+	task.admitted = admitted
+	task.mu.Unlock()
+	task.setStatus(RunningTask)
+	return nil
+}
+
 // KillQuery enters a query into the killed state and closes the channel
 // from the TaskManager. This method can be used to forcefully terminate a
 // running query.
@@ -254,6 +441,12 @@ func (t *TaskManager) DetachQuery(qid uint64) error {
 	}
 
 	query.close()
+	query.mu.Lock()
+	if query.admitted {
+		query.admitted = false
+		query.group.release()
+	}
+	query.mu.Unlock()
 	delete(t.queries, qid)
 	return nil
 }
@@ -286,10 +479,10 @@ func (t *TaskManager) Queries() []QueryInfo {
 	return queries
 }
 
-func (t *TaskManager) waitForQuery(qid uint64, interrupt <-chan struct{}, closing <-chan struct{}, monitorCh <-chan error) {
+func (t *TaskManager) waitForQuery(qid uint64, timeout time.Duration, interrupt <-chan struct{}, closing <-chan struct{}, monitorCh <-chan error) {
 	var timerCh <-chan time.Time
-	if t.QueryTimeout != 0 {
-		timer := time.NewTimer(t.QueryTimeout)
+	if timeout != 0 {
+		timer := time.NewTimer(timeout)
 		timerCh = timer.C
 		defer timer.Stop()
 	}
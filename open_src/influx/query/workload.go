@@ -0,0 +1,136 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/bucket"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// defaultMemoryWait is how long a pipeline executor waits for the memory of its group if the group has no timeout.
+const defaultMemoryWait = 300 * time.Second
+
+// WorkloadGroup limits the queries of a set of users.
+type WorkloadGroup struct {
+	name     string
+	maxQueue int64
+	timeout  time.Duration
+
+	// a query holds a slot while it runs, nil if the concurrency is unlimited
+	slots  chan struct{}
+	queued int64
+	memory bucket.ResourceBucket
+}
+
+func newWorkloadGroup(conf config.WorkloadGroup) *WorkloadGroup {
+	g := &WorkloadGroup{
+		name:     conf.Name,
+		maxQueue: int64(conf.MaxQueue),
+		timeout:  time.Duration(conf.Timeout),
+	}
+	if conf.MaxConcurrency > 0 {
+		g.slots = make(chan struct{}, conf.MaxConcurrency)
+	}
+	if conf.MaxMemory > 0 {
+		wait := g.timeout
+		if wait == 0 {
+			wait = defaultMemoryWait
+		}
+		g.memory = bucket.NewInt64Bucket(wait, int64(conf.MaxMemory))
+	}
+	return g
+}
+
+func (g *WorkloadGroup) Name() string {
+	return g.name
+}
+
+// Timeout returns the timeout of the queries of the group, zero if the group does not override the query timeout.
+func (g *WorkloadGroup) Timeout() time.Duration {
+	return g.timeout
+}
+
+// Memory returns the memory budget shared by the pipeline executors of the group, nil if it is unlimited.
+func (g *WorkloadGroup) Memory() bucket.ResourceBucket {
+	return g.memory
+}
+
+// acquire waits for a query of the group to run, until done is closed. A query that would exceed the queue length
+// fails at once.
+func (g *WorkloadGroup) acquire(done <-chan struct{}) (bool, error) {
+	if g.slots == nil {
+		return false, nil
+	}
+	select {
+	case g.slots <- struct{}{}:
+		return true, nil
+	default:
+	}
+
+	if n := atomic.AddInt64(&g.queued, 1); g.maxQueue > 0 && n > g.maxQueue {
+		atomic.AddInt64(&g.queued, -1)
+		return false, errno.NewError(errno.WorkloadGroupQueueFull, g.name, n-1, g.maxQueue)
+	}
+	defer atomic.AddInt64(&g.queued, -1)
+
+	select {
+	case g.slots <- struct{}{}:
+		return true, nil
+	case <-done:
+		return false, ErrQueryInterrupted
+	}
+}
+
+func (g *WorkloadGroup) release() {
+	<-g.slots
+}
+
+// WorkloadGroups maps the users to their workload groups.
+type WorkloadGroups struct {
+	groups map[string]*WorkloadGroup
+	users  map[string]*WorkloadGroup
+}
+
+func NewWorkloadGroups(conf config.WorkloadGroups) *WorkloadGroups {
+	w := &WorkloadGroups{
+		groups: make(map[string]*WorkloadGroup, len(conf)),
+		users:  make(map[string]*WorkloadGroup),
+	}
+	for i := range conf {
+		g := newWorkloadGroup(conf[i])
+		w.groups[g.name] = g
+		for _, u := range conf[i].Users {
+			w.users[u] = g
+		}
+	}
+	return w
+}
+
+// Group returns the workload group of a user, nil if the user is not limited by any group.
+func (w *WorkloadGroups) Group(user string) *WorkloadGroup {
+	if w == nil {
+		return nil
+	}
+	if g, ok := w.users[user]; ok {
+		return g
+	}
+	return w.groups[config.DefaultWorkloadGroup]
+}
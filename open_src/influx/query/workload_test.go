@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWorkloadTaskManager() *query.TaskManager {
+	tm := query.NewTaskManager()
+	tm.Workloads = query.NewWorkloadGroups(config.WorkloadGroups{
+		{Name: "reports", Users: []string{"batch"}, MaxConcurrency: 1, MaxQueue: 1, MaxMemory: toml.Size(1024)},
+		{Name: config.DefaultWorkloadGroup, MaxConcurrency: 2},
+	})
+	return tm
+}
+
+func attachQuery(t *testing.T, tm *query.TaskManager, user string) (*query.ExecutionContext, func()) {
+	q, err := influxql.ParseQuery("SELECT * FROM cpu")
+	require.NoError(t, err)
+	ctx, detach, err := tm.AttachQuery(q, query.ExecutionOptions{UserID: user}, nil, nil)
+	require.NoError(t, err)
+	return ctx, detach
+}
+
+func queryStatus(tm *query.TaskManager, qid uint64) query.TaskStatus {
+	for _, qi := range tm.Queries() {
+		if qi.ID == qid {
+			return qi.Status
+		}
+	}
+	return 0
+}
+
+func TestTaskManager_WorkloadGroups(t *testing.T) {
+	tm := newWorkloadTaskManager()
+	defer tm.Close()
+
+	ctx1, detach1 := attachQuery(t, tm, "batch")
+	require.NotNil(t, ctx1.Workload)
+	assert.Equal(t, "reports", ctx1.Workload.Name())
+	assert.NotNil(t, ctx1.Workload.Memory())
+	require.NoError(t, tm.Admit(ctx1))
+
+	// the second query of the group waits in the queue until the first one is done
+	ctx2, detach2 := attachQuery(t, tm, "batch")
+	admitted := make(chan error, 1)
+	go func() {
+		admitted <- tm.Admit(ctx2)
+	}()
+	assert.Eventually(t, func() bool {
+		return queryStatus(tm, ctx2.QueryID) == query.QueuedTask
+	}, time.Second, 10*time.Millisecond)
+
+	// the queue is full
+	ctx3, detach3 := attachQuery(t, tm, "batch")
+	err := tm.Admit(ctx3)
+	require.Error(t, err)
+	assert.True(t, errno.Equal(err, errno.WorkloadGroupQueueFull))
+	detach3()
+
+	// other users are limited by the default group
+	ctx4, detach4 := attachQuery(t, tm, "grafana")
+	assert.Equal(t, config.DefaultWorkloadGroup, ctx4.Workload.Name())
+	require.NoError(t, tm.Admit(ctx4))
+	detach4()
+
+	detach1()
+	require.NoError(t, <-admitted)
+	assert.Equal(t, query.RunningTask, queryStatus(tm, ctx2.QueryID))
+
+	// a killed query leaves the queue
+	ctx5, detach5 := attachQuery(t, tm, "batch")
+	go func() {
+		admitted <- tm.Admit(ctx5)
+	}()
+	assert.Eventually(t, func() bool {
+		return queryStatus(tm, ctx5.QueryID) == query.QueuedTask
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, tm.KillQuery(ctx5.QueryID))
+	require.Error(t, <-admitted)
+	detach5()
+	detach2()
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -162,6 +163,7 @@ func (r *Row) Copy(p *Row) {
 	}
 	for i := range p.Fields {
 		r.Fields[i].NumValue = p.Fields[i].NumValue
+		r.Fields[i].UintValue = p.Fields[i].UintValue
 		r.Fields[i].StrValue = p.Fields[i].StrValue
 		r.Fields[i].Type = p.Fields[i].Type
 		r.Fields[i].Key = p.Fields[i].Key
@@ -414,6 +416,8 @@ func (r *Row) marshalFields(dst []byte) ([]byte, error) {
 		if r.Fields[i].Type == Field_Type_String {
 			dst = encoding.MarshalUint64(dst, uint64(len(r.Fields[i].StrValue)))
 			dst = append(dst, r.Fields[i].StrValue...)
+		} else if r.Fields[i].Type == Field_Type_UInt {
+			dst = encoding.MarshalUint64(dst, r.Fields[i].UintValue)
 		} else {
 			dst = numberenc.MarshalFloat64(dst, r.Fields[i].NumValue)
 		}
@@ -469,7 +473,12 @@ func (r *Row) unmarshalFields(src []byte, fieldpool []Field) ([]byte, []Field, e
 				fieldpool = fieldpool[:len(fieldpool)-1]
 				return nil, fieldpool, errors.New("too small for field")
 			}
-			fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			if fd.Type == Field_Type_UInt {
+				fd.UintValue = encoding.UnmarshalUint64(src[:8])
+				fd.NumValue = float64(fd.UintValue)
+			} else {
+				fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			}
 			src = src[8:]
 		}
 	}
@@ -922,6 +931,8 @@ func FieldType2Val(fieldType int) (interface{}, error) {
 		return (*float64)(nil), nil
 	case Field_Type_Int:
 		return (*int64)(nil), nil
+	case Field_Type_UInt:
+		return (*uint64)(nil), nil
 	case Field_Type_String:
 		return (*string)(nil), nil
 	case Field_Type_Boolean:
@@ -951,11 +962,13 @@ func FieldTypeString(fieldType int32) string {
 }
 
 // Field represents influx field.
+// The value of an unsigned field is kept in UintValue, NumValue only holds its closest float64.
 type Field struct {
-	Key      string
-	NumValue float64
-	StrValue string
-	Type     int32
+	Key       string
+	NumValue  float64
+	UintValue uint64
+	StrValue  string
+	Type      int32
 }
 
 type Fields []Field
@@ -975,6 +988,7 @@ func (fs Fields) Swap(i, j int) {
 func (f *Field) Reset() {
 	f.Key = ""
 	f.NumValue = 0
+	f.UintValue = 0
 	f.StrValue = ""
 	f.Type = Field_Type_Unknown
 }
@@ -998,6 +1012,16 @@ func (f *Field) unmarshal(s string, noEscapeChars, hasQuotedFields bool) error {
 		f.Type = Field_Type_String
 		return nil
 	}
+	if vstr := s[n+1:]; len(vstr) > 0 && vstr[len(vstr)-1] == 'u' {
+		u, err := parseFieldUintValue(vstr[:len(vstr)-1])
+		if err != nil {
+			return fmt.Errorf("cannot parse field value for %q: %w", f.Key, err)
+		}
+		f.UintValue = u
+		f.NumValue = float64(u)
+		f.Type = Field_Type_UInt
+		return nil
+	}
 	v, t, err := parseFieldNumValue(s[n+1:])
 	if err != nil {
 		return fmt.Errorf("cannot parse field value for %q: %w", f.Key, err)
@@ -1146,10 +1170,6 @@ func parseFieldNumValue(s string) (float64, int32, error) {
 		}
 		return float64(n), Field_Type_Int, nil
 	}
-	if ch == 'u' {
-		// Unsigned integer value
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid number")
-	}
 	if ch == 'f' {
 		// Unsigned integer value
 		ss := s[:len(s)-1]
@@ -1175,6 +1195,14 @@ func parseFieldNumValue(s string) (float64, int32, error) {
 	return f, Field_Type_Float, nil
 }
 
+func parseFieldUintValue(s string) (uint64, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid unsigned number %q", s)
+	}
+	return n, nil
+}
+
 func parseFieldStrValue(s string) (string, error) {
 	if len(s) == 0 {
 		return "", fmt.Errorf("field value cannot be empty")
@@ -104,3 +104,32 @@ func TestIndexOptionsMarshalUnmarshal(t *testing.T) {
 	var indexOpt []IndexOption
 	funcMarshal(dst, rows, indexOpt)
 }
+
+func TestUnmarshalRows_Unsigned(t *testing.T) {
+	rows, _, _, err := unmarshalRows(nil, "mst,host=a v=18446744073709551615u,i=-1i 1622851200000000000\n", nil, nil)
+	if err != nil || len(rows) != 1 {
+		t.Fatalf("unexpected rows %v, err %v", rows, err)
+	}
+	fd := rows[0].Fields[0]
+	if fd.Type != Field_Type_UInt || fd.UintValue != 18446744073709551615 {
+		t.Fatalf("unexpected field %+v; want unsigned 18446744073709551615", fd)
+	}
+
+	for _, s := range []string{"mst v=-1u 1\n", "mst v=18446744073709551616u 1\n", "mst v=1.5u 1\n"} {
+		if _, _, _, err = unmarshalRows(nil, s, nil, nil); err == nil {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+
+	buf, err := rows[0].marshalFields(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var row Row
+	if _, _, err = row.unmarshalFields(buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if row.Fields[0].Type != Field_Type_UInt || row.Fields[0].UintValue != fd.UintValue || row.Fields[1].NumValue != -1 {
+		t.Fatalf("unexpected fields %+v after unmarshal", row.Fields)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlog
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/audit"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+
+	// events kept while the audit database cannot be written, the oldest ones are dropped beyond it
+	maxPendingEvents = 10000
+)
+
+// Service records the audit events to a rotating log file, one JSON object per line, and optionally to the audit
+// measurement of a database. The events are written to the database in batches, so they are in the log file
+// first and may be lost from the database if the process exits.
+type Service struct {
+	MetaClient interface {
+		CreateDatabase(name string) (*meta2.DatabaseInfo, error)
+	}
+
+	PointsWriter interface {
+		WritePointRows(database, retentionPolicy string, rows []influx.Row) error
+	}
+
+	conf config.Audit
+	file *lumberjack.Logger
+	log  *zap.Logger
+
+	mu        sync.Mutex
+	pending   []influx.Row
+	dbCreated bool
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+	logger  *logger.Logger
+}
+
+func NewService(c config.Audit) *Service {
+	s := &Service{
+		conf: c,
+		file: &lumberjack.Logger{
+			Filename:   c.Path,
+			MaxSize:    int(c.MaxSize / (1024 * 1024)),
+			MaxBackups: c.MaxNum,
+			MaxAge:     c.MaxAge,
+			Compress:   c.CompressEnabled,
+		},
+		closing: make(chan struct{}),
+		logger:  logger.NewLogger(errno.ModuleHTTP).With(zap.String("service", "audit")),
+	}
+	if s.file.MaxSize == 0 {
+		s.file.MaxSize = 1
+	}
+	encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+		TimeKey:        "time",
+		MessageKey:     "category",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeTime:     zapcore.RFC3339NanoTimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+	})
+	s.log = zap.New(zapcore.NewCore(encoder, zapcore.AddSync(s.file), zapcore.InfoLevel))
+	return s
+}
+
+// Open starts writing the events to the audit database if it is configured.
+func (s *Service) Open() error {
+	if s.conf.Database == "" {
+		return nil
+	}
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+// Close writes the pending events and closes the log file.
+func (s *Service) Close() error {
+	close(s.closing)
+	s.wg.Wait()
+	_ = s.log.Sync()
+	return s.file.Close()
+}
+
+// Record records an event.
+func (s *Service) Record(e audit.Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	result, errMsg := resultSuccess, ""
+	if e.Err != nil {
+		result, errMsg = resultFailure, e.Err.Error()
+	}
+	s.log.Info(e.Category,
+		zap.String("user", e.User),
+		zap.String("addr", e.Addr),
+		zap.String("db", e.Database),
+		zap.String("statement", e.Statement),
+		zap.String("result", result),
+		zap.String("error", errMsg),
+		zap.Duration("duration", e.Duration),
+	)
+
+	if s.conf.Database == "" {
+		return
+	}
+	row := newRow(&e, result, errMsg)
+	s.mu.Lock()
+	if len(s.pending) >= maxPendingEvents {
+		s.pending = s.pending[1:]
+	}
+	s.pending = append(s.pending, row)
+	s.mu.Unlock()
+}
+
+func newRow(e *audit.Event, result, errMsg string) influx.Row {
+	tags := influx.PointTags{
+		{Key: "category", Value: e.Category},
+		{Key: "result", Value: result},
+	}
+	if e.User != "" {
+		tags = append(tags, influx.Tag{Key: "user", Value: e.User})
+	}
+	fields := influx.Fields{
+		{Key: "addr", Type: influx.Field_Type_String, StrValue: e.Addr},
+		{Key: "database", Type: influx.Field_Type_String, StrValue: e.Database},
+		{Key: "duration", Type: influx.Field_Type_Int, NumValue: float64(e.Duration.Microseconds())},
+		{Key: "error", Type: influx.Field_Type_String, StrValue: errMsg},
+		{Key: "statement", Type: influx.Field_Type_String, StrValue: e.Statement},
+	}
+	sort.Sort(&tags)
+	return influx.Row{
+		Name:      config.DefaultAuditMeasurement,
+		Tags:      tags,
+		Fields:    fields,
+		Timestamp: e.Time.UnixNano(),
+	}
+}
+
+func (s *Service) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(time.Duration(s.conf.WriteInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closing:
+			s.flush()
+			return
+		case <-ticker.C:
+			s.flush()
+		}
+	}
+}
+
+// flush writes the pending events to the audit database. They are kept for the next round if the write fails.
+func (s *Service) flush() {
+	s.mu.Lock()
+	rows := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	if len(rows) == 0 {
+		return
+	}
+
+	err := s.createDatabase()
+	if err == nil {
+		err = s.PointsWriter.WritePointRows(s.conf.Database, "", rows)
+	}
+	if err == nil {
+		return
+	}
+	s.logger.Warn("write audit events failed", zap.String("db", s.conf.Database), zap.Int("events", len(rows)), zap.Error(err))
+	s.mu.Lock()
+	rows = append(rows, s.pending...)
+	if len(rows) > maxPendingEvents {
+		rows = rows[len(rows)-maxPendingEvents:]
+	}
+	s.pending = rows
+	s.mu.Unlock()
+}
+
+func (s *Service) createDatabase() error {
+	if s.dbCreated {
+		return nil
+	}
+	if _, err := s.MetaClient.CreateDatabase(s.conf.Database); err != nil {
+		return err
+	}
+	s.dbCreated = true
+	return nil
+}
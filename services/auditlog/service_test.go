@@ -0,0 +1,104 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlog
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/audit"
+	"github.com/openGemini/openGemini/lib/config"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct {
+	created []string
+}
+
+func (c *mockMetaClient) CreateDatabase(name string) (*meta2.DatabaseInfo, error) {
+	c.created = append(c.created, name)
+	return &meta2.DatabaseInfo{Name: name}, nil
+}
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	fail bool
+	rows []influx.Row
+}
+
+func (w *mockPointsWriter) WritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.fail {
+		return errors.New("shard not available")
+	}
+	w.rows = append(w.rows, rows...)
+	return nil
+}
+
+func TestService(t *testing.T) {
+	conf := config.NewAudit()
+	conf.Enabled = true
+	conf.Path = filepath.Join(t.TempDir(), "audit.log")
+	conf.Database = "_audit"
+	conf.WriteInterval = config.NewAudit().WriteInterval
+	require.NoError(t, conf.Validate())
+
+	mc := &mockMetaClient{}
+	pw := &mockPointsWriter{fail: true}
+	s := NewService(conf)
+	s.MetaClient = mc
+	s.PointsWriter = pw
+	require.NoError(t, s.Open())
+
+	s.Record(audit.Event{Category: audit.CategoryDDL, User: "bob", Addr: "127.0.0.1", Database: "db0",
+		Statement: "DROP DATABASE db0", Duration: time.Millisecond})
+	s.Record(audit.Event{Category: audit.CategoryLogin, Addr: "127.0.0.1", Err: errors.New("authorization failed")})
+
+	// the events are kept until the database can be written
+	s.flush()
+	assert.Equal(t, 2, len(s.pending))
+	pw.fail = false
+	require.NoError(t, s.Close())
+	assert.Equal(t, []string{"_audit"}, mc.created)
+	require.Equal(t, 2, len(pw.rows))
+	assert.Equal(t, config.DefaultAuditMeasurement, pw.rows[0].Name)
+	assert.Equal(t, "result", pw.rows[1].Tags[1].Key)
+	assert.Equal(t, "failure", pw.rows[1].Tags[1].Value)
+
+	buf, err := os.ReadFile(conf.Path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	require.Equal(t, 2, len(lines))
+	var event map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.Equal(t, audit.CategoryDDL, event["category"])
+	assert.Equal(t, "bob", event["user"])
+	assert.Equal(t, "DROP DATABASE db0", event["statement"])
+	assert.Equal(t, "success", event["result"])
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, "authorization failed", event["error"])
+}
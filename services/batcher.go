@@ -0,0 +1,122 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// BatchKey identifies the rows written together by a RowBatcher.
+type BatchKey struct {
+	Database        string
+	RetentionPolicy string
+}
+
+// RowBatcher groups the rows of the same database and retention policy into batches for the ingestion
+// listeners. A batch is written once it holds size rows, and at most timeout after it got its first row.
+type RowBatcher struct {
+	size    int
+	timeout time.Duration
+	write   func(key BatchKey, rows []influx.Row)
+
+	mu      sync.Mutex
+	batches map[BatchKey][]influx.Row
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewRowBatcher returns a RowBatcher calling write for each batch. write may be called concurrently, and it owns
+// the rows it is given.
+func NewRowBatcher(size int, timeout time.Duration, write func(key BatchKey, rows []influx.Row)) *RowBatcher {
+	return &RowBatcher{
+		size:    size,
+		timeout: timeout,
+		write:   write,
+		batches: make(map[BatchKey][]influx.Row),
+		closing: make(chan struct{}),
+	}
+}
+
+// Start starts writing the batches on timeout.
+func (b *RowBatcher) Start() {
+	b.wg.Add(1)
+	go b.run()
+}
+
+// Stop writes the pending batches, no row may be added after it.
+func (b *RowBatcher) Stop() {
+	close(b.closing)
+	b.wg.Wait()
+	b.Flush()
+}
+
+// Add adds the rows to the batch of key, and writes the batch if it is full. The rows must not be modified
+// afterwards.
+func (b *RowBatcher) Add(key BatchKey, rows ...influx.Row) {
+	for len(rows) > 0 {
+		b.mu.Lock()
+		batch := b.batches[key]
+		if batch == nil {
+			batch = make([]influx.Row, 0, b.size)
+		}
+		n := b.size - len(batch)
+		if n > len(rows) {
+			n = len(rows)
+		}
+		batch = append(batch, rows[:n]...)
+		rows = rows[n:]
+		if len(batch) < b.size {
+			b.batches[key] = batch
+			b.mu.Unlock()
+			return
+		}
+		delete(b.batches, key)
+		b.mu.Unlock()
+
+		b.write(key, batch)
+	}
+}
+
+// Flush writes all the pending batches.
+func (b *RowBatcher) Flush() {
+	b.mu.Lock()
+	batches := b.batches
+	b.batches = make(map[BatchKey][]influx.Row, len(batches))
+	b.mu.Unlock()
+
+	for key, batch := range batches {
+		b.write(key, batch)
+	}
+}
+
+func (b *RowBatcher) run() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(b.timeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.closing:
+			return
+		case <-ticker.C:
+			b.Flush()
+		}
+	}
+}
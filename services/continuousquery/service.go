@@ -0,0 +1,415 @@
+/*
+Copyright (c) 2018 InfluxData
+This code is originally from: https://github.com/influxdata/influxdb/blob/1.7/services/continuous_querier/service.go
+
+2022.01.23 Run continuous queries on the ts-sql holding the lease of meta,
+and persist the last run time in meta so that another ts-sql can take over.
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+*/
+
+package continuousquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	log "github.com/influxdata/influxdb/logger"
+	query2 "github.com/influxdata/influxdb/query"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/openGemini/openGemini/services"
+	"github.com/openGemini/openGemini/yacc"
+	"go.uber.org/zap"
+)
+
+const idDelimiter = string(rune(0))
+
+// Service runs the continuous queries of all databases on their RESAMPLE EVERY/FOR windows.
+// Only the ts-sql holding the continuous query lease of meta executes them.
+type Service struct {
+	services.Base
+
+	MetaClient interface {
+		Databases() map[string]*meta.DatabaseInfo
+		AcquireContinuousQueryLease(owner string, d time.Duration) error
+		ReportContinuousQueries(owner string, reports []*proto2.ContinuousQueryReport) error
+	}
+
+	QueryExecutor interface {
+		ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query2.Result
+	}
+
+	owner         string
+	leaseDuration time.Duration
+
+	// lastRuns caches the last run time of queries executed by this node,
+	// which may be newer than the one in the meta cache before it is refreshed.
+	lastRuns map[string]time.Time
+}
+
+func NewService(owner string, interval, leaseDuration time.Duration) *Service {
+	s := &Service{
+		owner:         owner,
+		leaseDuration: leaseDuration,
+		lastRuns:      make(map[string]time.Time),
+	}
+	s.Init("continuous query", interval, s.handle)
+	return s
+}
+
+func (s *Service) handle() {
+	dbs := s.MetaClient.Databases()
+	if !hasContinuousQueries(dbs) {
+		return
+	}
+
+	if err := s.MetaClient.AcquireContinuousQueryLease(s.owner, s.leaseDuration); err != nil {
+		if !isLeaseConflict(err) {
+			s.Logger.Warn("acquire continuous query lease failed", zap.Error(err))
+		}
+		// Another node runs the queries, forget the local state so that the
+		// last run time in meta is used if this node takes over.
+		s.lastRuns = make(map[string]time.Time)
+		return
+	}
+
+	now := time.Now()
+	var reports []*proto2.ContinuousQueryReport
+	for _, db := range dbs {
+		if db.MarkDeleted {
+			continue
+		}
+		for i := range db.ContinuousQueries {
+			cqi := &db.ContinuousQueries[i]
+			id := db.Name + idDelimiter + cqi.Name
+			lastRun := cqi.LastRunTime
+			if t, ok := s.lastRuns[id]; ok && t.After(lastRun) {
+				lastRun = t
+			}
+
+			_, newLastRun, err := s.ExecuteContinuousQuery(db, cqi, lastRun, now)
+			if err != nil {
+				s.Logger.Error("execute continuous query failed", log.Database(db.Name),
+					zap.String("name", cqi.Name), zap.Error(err))
+				continue
+			}
+			if !newLastRun.After(lastRun) {
+				continue
+			}
+
+			lastRun = newLastRun
+			s.lastRuns[id] = lastRun
+			reports = append(reports, &proto2.ContinuousQueryReport{
+				Database:    proto.String(db.Name),
+				Name:        proto.String(cqi.Name),
+				LastRunTime: proto.Int64(lastRun.UnixNano()),
+			})
+		}
+	}
+
+	if err := s.MetaClient.ReportContinuousQueries(s.owner, reports); err != nil {
+		s.Logger.Warn("report continuous queries failed", zap.Error(err))
+	}
+}
+
+// ExecuteContinuousQuery may execute a single CQ and returns the new last run time of it.
+// This will return false if there were no errors and the CQ was not run.
+func (s *Service) ExecuteContinuousQuery(dbi *meta.DatabaseInfo, cqi *meta.ContinuousQueryInfo, lastRun, now time.Time) (bool, time.Time, error) {
+	cq, err := NewContinuousQuery(dbi.Name, cqi)
+	if err != nil {
+		return false, lastRun, err
+	}
+	cq.LastRun, cq.HasRun = lastRun, !lastRun.IsZero()
+	if cq.HasRun && cq.q.Location != nil {
+		cq.LastRun = lastRun.In(cq.q.Location)
+	}
+
+	// Set the retention policy to default if it wasn't specified in the query.
+	if cq.intoRP() == "" {
+		cq.setIntoRP(dbi.DefaultRetentionPolicy)
+	}
+
+	// The last run time is moved forward even if there is no time interval to run,
+	// so that the next window starts from it.
+	startTime, endTime, ok, err := cq.window(now)
+	if err != nil || !ok {
+		return false, cq.LastRun, err
+	}
+
+	if err := cq.q.SetTimeRange(startTime, endTime); err != nil {
+		return false, lastRun, fmt.Errorf("unable to set time range: %s", err)
+	}
+
+	logger, logEnd := log.NewOperation(s.Logger.GetZapLogger(), "Continuous query execution", "continuous_query_execute")
+	defer logEnd()
+
+	logger.Info("Executing continuous query",
+		zap.String("name", cqi.Name),
+		log.Database(dbi.Name),
+		zap.Time("start", startTime),
+		zap.Time("end", endTime))
+
+	res := s.runContinuousQueryAndWriteResult(cq)
+	if res.Err != nil {
+		return false, lastRun, res.Err
+	}
+
+	// extract number of points written from SELECT ... INTO result
+	var written int64 = -1
+	if len(res.Series) == 1 && len(res.Series[0].Values) == 1 {
+		if n, ok := res.Series[0].Values[0][1].(int64); ok {
+			written = n
+		}
+	}
+
+	logger.Info("Finished continuous query",
+		zap.String("name", cqi.Name),
+		log.Database(dbi.Name),
+		zap.Int64("written", written),
+		zap.Time("start", startTime),
+		zap.Time("end", endTime))
+
+	return true, cq.LastRun, nil
+}
+
+// runContinuousQueryAndWriteResult will run the query against the cluster and write the results back in
+func (s *Service) runContinuousQueryAndWriteResult(cq *ContinuousQuery) *query2.Result {
+	// Wrap the CQ's inner SELECT statement in a Query for the Executor.
+	q := &influxql.Query{
+		Statements: influxql.Statements([]influxql.Statement{cq.q}),
+	}
+
+	closing := make(chan struct{})
+	defer close(closing)
+
+	// Execute the SELECT.
+	ch := s.QueryExecutor.ExecuteQuery(q, query.ExecutionOptions{
+		Database: cq.Database,
+		Quiet:    true,
+	}, closing, nil)
+
+	// There is only one statement, so we will only ever receive one result
+	res, ok := <-ch
+	if !ok {
+		return &query2.Result{Err: errors.New("result channel was closed")}
+	}
+	return res
+}
+
+func hasContinuousQueries(dbs map[string]*meta.DatabaseInfo) bool {
+	for _, db := range dbs {
+		if !db.MarkDeleted && len(db.ContinuousQueries) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isLeaseConflict reports whether err means that the lease is held by another node,
+// the error returned by meta only keeps the message.
+func isLeaseConflict(err error) bool {
+	return err == meta.ErrContinuousQueryLeaseConflict ||
+		strings.Contains(err.Error(), meta.ErrContinuousQueryLeaseConflict.Error())
+}
+
+// ContinuousQuery is a local wrapper / helper around continuous queries.
+type ContinuousQuery struct {
+	Database string
+	Info     *meta.ContinuousQueryInfo
+	HasRun   bool
+	LastRun  time.Time
+	Resample ResampleOptions
+	q        *influxql.SelectStatement
+}
+
+func (cq *ContinuousQuery) intoRP() string      { return cq.q.Target.Measurement.RetentionPolicy }
+func (cq *ContinuousQuery) setIntoRP(rp string) { cq.q.Target.Measurement.RetentionPolicy = rp }
+
+// ResampleOptions controls the resampling intervals and duration of this continuous query.
+type ResampleOptions struct {
+	// The query will be resampled at this time interval. The first query will be
+	// performed at this time interval. If this option is not given, the resample
+	// interval is set to the group by interval.
+	Every time.Duration
+
+	// The query will continue being resampled for this time duration. If this
+	// option is not given, the resample duration is the same as the group by
+	// interval. A bucket's time is calculated based on the bucket's start time,
+	// so a 40m resample duration with a group by interval of 10m will resample
+	// the bucket 4 times (using the default time interval).
+	For time.Duration
+}
+
+// NewContinuousQuery returns a ContinuousQuery object with a parsed influxql.CreateContinuousQueryStatement.
+func NewContinuousQuery(database string, cqi *meta.ContinuousQueryInfo) (*ContinuousQuery, error) {
+	p := yacc.NewYyParser(influxql.NewScanner(strings.NewReader(cqi.Query)))
+	p.ParseTokens()
+	q, err := p.GetQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(q.Statements) != 1 {
+		return nil, errors.New("query isn't a valid continuous query")
+	}
+	stmt, ok := q.Statements[0].(*influxql.CreateContinuousQueryStatement)
+	if !ok || stmt.Source.Target == nil || stmt.Source.Target.Measurement == nil {
+		return nil, errors.New("query isn't a valid continuous query")
+	}
+
+	cquery := &ContinuousQuery{
+		Database: database,
+		Info:     cqi,
+		Resample: ResampleOptions{
+			Every: stmt.ResampleEvery,
+			For:   stmt.ResampleFor,
+		},
+		q: stmt.Source,
+	}
+
+	return cquery, nil
+}
+
+// window returns the time range the CQ should be run for at now, and sets the last run time of the CQ.
+// It returns false if the CQ does not need to be run.
+func (cq *ContinuousQuery) window(now time.Time) (time.Time, time.Time, bool, error) {
+	// Set the time zone on the now time if the CQ has one. Otherwise, force UTC.
+	now = now.UTC()
+	if cq.q.Location != nil {
+		now = now.In(cq.q.Location)
+	}
+
+	// Get the group by interval.
+	interval, err := cq.q.GroupByInterval()
+	if err != nil || interval == 0 {
+		return time.Time{}, time.Time{}, false, err
+	}
+
+	// Get the group by offset.
+	offset, err := cq.q.GroupByOffset()
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+
+	// See if this query needs to be run.
+	run, nextRun, err := cq.shouldRunContinuousQuery(now, interval)
+	if err != nil || !run {
+		return time.Time{}, time.Time{}, false, err
+	}
+
+	resampleEvery := interval
+	if cq.Resample.Every != 0 {
+		resampleEvery = cq.Resample.Every
+	}
+
+	// We're about to run the query so store the current time closest to the nearest interval.
+	// If all is going well, this time should be the same as nextRun.
+	cq.LastRun = truncate(now.Add(-offset), resampleEvery).Add(offset)
+
+	// Retrieve the oldest interval we should calculate based on the next time
+	// interval. We do this instead of using the current time just in case any
+	// time intervals were missed. The start time of the oldest interval is what
+	// we use as the start time.
+	resampleFor := interval
+	if cq.Resample.For != 0 {
+		resampleFor = cq.Resample.For
+	} else if interval < resampleEvery {
+		resampleFor = resampleEvery
+	}
+
+	// If the resample interval is greater than the interval of the query, use the
+	// query interval instead.
+	if interval < resampleEvery {
+		resampleEvery = interval
+	}
+
+	// Calculate and set the time range for the query.
+	startTime := truncate(nextRun.Add(interval-resampleFor-offset-1), interval).Add(offset)
+	endTime := truncate(now.Add(interval-resampleEvery-offset), interval).Add(offset)
+	if !endTime.After(startTime) {
+		// Exit early since there is no time interval.
+		return time.Time{}, time.Time{}, false, nil
+	}
+	return startTime, endTime, true, nil
+}
+
+// shouldRunContinuousQuery returns true if the CQ should be schedule to run. It will use the
+// lastRunTime of the CQ and the rules for when to run set through the query to determine
+// if this CQ should be run.
+func (cq *ContinuousQuery) shouldRunContinuousQuery(now time.Time, interval time.Duration) (bool, time.Time, error) {
+	// If it's not aggregated, do not run the query.
+	if cq.q.IsRawQuery {
+		return false, cq.LastRun, errors.New("continuous queries must be aggregate queries")
+	}
+
+	// Override the query's default run interval with the resample options.
+	resampleEvery := interval
+	if cq.Resample.Every != 0 {
+		resampleEvery = cq.Resample.Every
+	}
+
+	// Determine if we should run the continuous query based on the last time it ran.
+	// If the query never ran, execute it using the current time.
+	if cq.HasRun {
+		// Retrieve the zone offset for the previous window.
+		_, startOffset := cq.LastRun.Add(-1).Zone()
+		nextRun := cq.LastRun.Add(resampleEvery)
+		// Retrieve the end zone offset for the end of the current interval.
+		if _, endOffset := nextRun.Add(-1).Zone(); startOffset != endOffset {
+			diff := int64(startOffset-endOffset) * int64(time.Second)
+			if abs(diff) < int64(resampleEvery) {
+				nextRun = nextRun.Add(time.Duration(diff))
+			}
+		}
+		if nextRun.UnixNano() <= now.UnixNano() {
+			return true, nextRun, nil
+		}
+	} else {
+		// Retrieve the location from the CQ.
+		loc := cq.q.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		return true, now.In(loc), nil
+	}
+
+	return false, cq.LastRun, nil
+}
+
+// truncate truncates the time based on the unix timestamp instead of the
+// Go time library. The Go time library has the start of the week on Monday
+// while the start of the week for the unix timestamp is a Thursday.
+func truncate(ts time.Time, d time.Duration) time.Time {
+	t := ts.UnixNano()
+	offset := zone(ts)
+	dt := (t + offset) % int64(d)
+	if dt < 0 {
+		// Negative modulo rounds up instead of down, so offset
+		// with the duration.
+		dt += int64(d)
+	}
+	ts = time.Unix(0, t-dt).In(ts.Location())
+	if adjustedOffset := zone(ts); adjustedOffset != offset {
+		diff := offset - adjustedOffset
+		if abs(diff) < int64(d) {
+			ts = ts.Add(time.Duration(diff))
+		}
+	}
+	return ts
+}
+
+func zone(ts time.Time) int64 {
+	_, offset := ts.Zone()
+	return int64(offset) * int64(time.Second)
+}
+
+func abs(v int64) int64 {
+	sign := v >> 63
+	return (v ^ sign) - sign
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package continuousquery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	query2 "github.com/influxdata/influxdb/query"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct {
+	dbs      map[string]*meta.DatabaseInfo
+	leaseErr error
+	reports  []*proto2.ContinuousQueryReport
+}
+
+func (c *mockMetaClient) Databases() map[string]*meta.DatabaseInfo {
+	return c.dbs
+}
+
+func (c *mockMetaClient) AcquireContinuousQueryLease(owner string, d time.Duration) error {
+	return c.leaseErr
+}
+
+func (c *mockMetaClient) ReportContinuousQueries(owner string, reports []*proto2.ContinuousQueryReport) error {
+	c.reports = append(c.reports, reports...)
+	return nil
+}
+
+type mockQueryExecutor struct {
+	stmts []*influxql.SelectStatement
+}
+
+func (e *mockQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query2.Result {
+	e.stmts = append(e.stmts, q.Statements[0].(*influxql.SelectStatement))
+	ch := make(chan *query2.Result, 1)
+	ch <- &query2.Result{Series: models.Rows{{
+		Name:    "result",
+		Columns: []string{"time", "written"},
+		Values:  [][]interface{}{{time.Unix(0, 0).UTC(), int64(1)}},
+	}}}
+	close(ch)
+	return ch
+}
+
+func newTestService(query string) (*Service, *mockMetaClient, *mockQueryExecutor) {
+	mc := &mockMetaClient{dbs: map[string]*meta.DatabaseInfo{
+		"db0": {
+			Name:                   "db0",
+			DefaultRetentionPolicy: "autogen",
+			ContinuousQueries:      []meta.ContinuousQueryInfo{{Name: "cq0", Query: query}},
+		},
+	}}
+	qe := &mockQueryExecutor{}
+	s := NewService("sql0", time.Second, time.Minute)
+	s.MetaClient = mc
+	s.QueryExecutor = qe
+	return s, mc, qe
+}
+
+func TestExecuteContinuousQuery(t *testing.T) {
+	s, _, qe := newTestService(`CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(1h) END`)
+	dbi := s.MetaClient.Databases()["db0"]
+	cqi := &dbi.ContinuousQueries[0]
+
+	// the first run only records the last run time since no interval is completed
+	now := time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC)
+	ran, lastRun, err := s.ExecuteContinuousQuery(dbi, cqi, time.Time{}, now)
+	require.NoError(t, err)
+	require.False(t, ran)
+	assert.Equal(t, time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC), lastRun)
+
+	// not run again until the next interval
+	ran, _, err = s.ExecuteContinuousQuery(dbi, cqi, lastRun, now.Add(10*time.Minute))
+	require.NoError(t, err)
+	require.False(t, ran)
+	require.Equal(t, 0, len(qe.stmts))
+
+	ran, lastRun, err = s.ExecuteContinuousQuery(dbi, cqi, lastRun, now.Add(30*time.Minute+time.Second))
+	require.NoError(t, err)
+	require.True(t, ran)
+	assert.Equal(t, time.Date(2022, 1, 1, 11, 0, 0, 0, time.UTC), lastRun)
+
+	require.Equal(t, 1, len(qe.stmts))
+	assert.Equal(t, "autogen", qe.stmts[0].Target.Measurement.RetentionPolicy)
+	cond := qe.stmts[0].Condition.String()
+	assert.Contains(t, cond, "2022-01-01T10:00:00Z")
+	assert.Contains(t, cond, "2022-01-01T11:00:00Z")
+}
+
+func TestContinuousQueryResample(t *testing.T) {
+	cq, err := NewContinuousQuery("db0", &meta.ContinuousQueryInfo{
+		Name:  "cq0",
+		Query: `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m FOR 2h BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(30m) END`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, cq.Resample.Every)
+	assert.Equal(t, 2*time.Hour, cq.Resample.For)
+
+	cq.HasRun = true
+	cq.LastRun = time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	start, end, ok, err := cq.window(time.Date(2022, 1, 1, 10, 10, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2022, 1, 1, 8, 30, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC), end)
+	assert.Equal(t, time.Date(2022, 1, 1, 10, 10, 0, 0, time.UTC), cq.LastRun)
+
+	_, err = NewContinuousQuery("db0", &meta.ContinuousQueryInfo{Name: "cq0", Query: "SELECT * FROM mst"})
+	require.Error(t, err)
+}
+
+func TestService_Handle(t *testing.T) {
+	s, mc, qe := newTestService(`CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(1h) END`)
+
+	mc.leaseErr = meta.ErrContinuousQueryLeaseConflict
+	s.handle()
+	assert.Equal(t, 0, len(qe.stmts))
+	assert.Equal(t, 0, len(mc.reports))
+
+	mc.leaseErr = errors.New(meta.ErrContinuousQueryLeaseConflict.Error())
+	s.handle()
+	assert.Equal(t, 0, len(qe.stmts))
+
+	mc.leaseErr = nil
+	s.handle()
+	require.Equal(t, 1, len(mc.reports))
+	assert.Equal(t, "cq0", mc.reports[0].GetName())
+
+	// the local last run time prevents reporting again before the next interval
+	s.handle()
+	assert.Equal(t, 1, len(mc.reports))
+	assert.Equal(t, 0, len(qe.stmts))
+}
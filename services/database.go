@@ -0,0 +1,39 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import "sync"
+
+// DatabaseCreator creates the database of an ingestion listener before its first batch is written.
+type DatabaseCreator struct {
+	mu      sync.Mutex
+	created bool
+}
+
+// Create calls create unless a previous call succeeded, so a failed creation is retried by the next batch.
+func (c *DatabaseCreator) Create(create func() error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.created {
+		return nil
+	}
+	if err := create(); err != nil {
+		return err
+	}
+	c.created = true
+	return nil
+}
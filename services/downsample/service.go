@@ -0,0 +1,66 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downsample
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+// Service down samples the shards whose data has aged past the sample intervals of
+// the downsample policies of their retention policies.
+type Service struct {
+	services.Base
+
+	MetaClient interface {
+		RetentionPolicy(database, name string) (*meta.RetentionPolicyInfo, error)
+	}
+
+	Engine interface {
+		FetchShardsDownSampleInfo() []*meta.ShardDownSampleInfo
+		DownSampleShard(db string, ptId uint32, shardID uint64, level int, policy *meta.DownSamplePolicyInfo) error
+	}
+}
+
+func NewService(interval time.Duration) *Service {
+	s := &Service{}
+	s.Init("downsample", interval, s.handle)
+	return s
+}
+
+func (s *Service) handle() {
+	now := time.Now().UTC()
+	for _, sh := range s.Engine.FetchShardsDownSampleInfo() {
+		rp, err := s.MetaClient.RetentionPolicy(sh.Ident.OwnerDb, sh.Ident.Policy)
+		if err != nil || rp == nil || rp.DownSamplePolicy == nil {
+			continue
+		}
+
+		level := rp.DownSamplePolicy.Level(now.Sub(sh.EndTime))
+		if level <= sh.Level {
+			continue
+		}
+
+		if err = s.Engine.DownSampleShard(sh.Ident.OwnerDb, sh.Ident.OwnerPt, sh.Ident.ShardID, level, rp.DownSamplePolicy); err != nil {
+			s.Logger.Error("fail to downsample shard", zap.Uint64("shardID", sh.Ident.ShardID),
+				zap.Int("level", level), zap.Error(err))
+		}
+	}
+}
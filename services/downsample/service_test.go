@@ -0,0 +1,93 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downsample
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct {
+	rps map[string]*meta.RetentionPolicyInfo
+}
+
+func (c *mockMetaClient) RetentionPolicy(database, name string) (*meta.RetentionPolicyInfo, error) {
+	return c.rps[database+"."+name], nil
+}
+
+type downSampled struct {
+	shardID uint64
+	level   int
+}
+
+type mockEngine struct {
+	shards      []*meta.ShardDownSampleInfo
+	downSampled []downSampled
+}
+
+func (e *mockEngine) FetchShardsDownSampleInfo() []*meta.ShardDownSampleInfo {
+	return e.shards
+}
+
+func (e *mockEngine) DownSampleShard(db string, ptId uint32, shardID uint64, level int, policy *meta.DownSamplePolicyInfo) error {
+	e.downSampled = append(e.downSampled, downSampled{shardID: shardID, level: level})
+	return nil
+}
+
+func TestService_Handle(t *testing.T) {
+	policy := &meta.DownSamplePolicyInfo{
+		Calls: []meta.DownSampleCall{{DataType: influxql.Float, Ops: []string{"mean"}}},
+		Levels: []meta.DownSampleLevel{
+			{SampleInterval: 24 * time.Hour, TimeInterval: time.Minute},
+			{SampleInterval: 7 * 24 * time.Hour, TimeInterval: 10 * time.Minute},
+		},
+	}
+	mc := &mockMetaClient{rps: map[string]*meta.RetentionPolicyInfo{
+		"db0.rp0": {Name: "rp0", DownSamplePolicy: policy},
+		"db0.rp1": {Name: "rp1"},
+	}}
+
+	now := time.Now().UTC()
+	shard := func(id uint64, rp string, age time.Duration, level int) *meta.ShardDownSampleInfo {
+		return &meta.ShardDownSampleInfo{
+			Ident:   meta.ShardIdentifier{ShardID: id, OwnerDb: "db0", Policy: rp},
+			EndTime: now.Add(-age),
+			Level:   level,
+		}
+	}
+	engine := &mockEngine{shards: []*meta.ShardDownSampleInfo{
+		shard(1, "rp0", time.Hour, 0),
+		shard(2, "rp0", 2*24*time.Hour, 0),
+		shard(3, "rp0", 8*24*time.Hour, 0),
+		shard(4, "rp0", 8*24*time.Hour, 2),
+		shard(5, "rp1", 8*24*time.Hour, 0),
+	}}
+
+	s := NewService(time.Minute)
+	s.MetaClient = mc
+	s.Engine = engine
+	s.handle()
+
+	require.Equal(t, 2, len(engine.downSampled))
+	assert.Equal(t, downSampled{shardID: 2, level: 1}, engine.downSampled[0])
+	assert.Equal(t, downSampled{shardID: 3, level: 2}, engine.downSampled[1])
+}
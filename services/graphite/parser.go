@@ -0,0 +1,277 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+const (
+	partMeasurement         = "measurement"
+	partMeasurementWildcard = "measurement*"
+	partField               = "field"
+	partFieldWildcard       = "field*"
+
+	defaultField = "value"
+)
+
+// template maps the parts of the metrics matched by its filter to a point.
+type template struct {
+	filter []string // parts of the filter, empty for the default template
+	parts  []string
+	tags   map[string]string
+}
+
+// parseTemplate parses "[filter] template [tag1=value1,tag2=value2]", the parts of the filter and the template
+// are separated by dots like the metrics.
+func parseTemplate(s string) (*template, error) {
+	items := strings.Fields(s)
+	t := &template{}
+	switch len(items) {
+	case 1:
+		t.parts = strings.Split(items[0], ".")
+	case 2:
+		if strings.Contains(items[1], "=") {
+			t.parts = strings.Split(items[0], ".")
+			t.tags = make(map[string]string)
+			if err := parseTags(items[1], t.tags); err != nil {
+				return nil, fmt.Errorf("invalid template %q: %s", s, err)
+			}
+		} else {
+			t.filter = strings.Split(items[0], ".")
+			t.parts = strings.Split(items[1], ".")
+		}
+	case 3:
+		t.filter = strings.Split(items[0], ".")
+		t.parts = strings.Split(items[1], ".")
+		t.tags = make(map[string]string)
+		if err := parseTags(items[2], t.tags); err != nil {
+			return nil, fmt.Errorf("invalid template %q: %s", s, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid template %q, expect [filter] template [tag1=value1,tag2=value2]", s)
+	}
+
+	for _, f := range t.filter {
+		if _, err := path.Match(f, ""); err != nil {
+			return nil, fmt.Errorf("invalid template filter %q: %s", s, err)
+		}
+	}
+	hasMeasurement := false
+	for i, part := range t.parts {
+		switch part {
+		case partMeasurementWildcard, partFieldWildcard:
+			if i != len(t.parts)-1 {
+				return nil, fmt.Errorf("invalid template %q, %s must be the last part", s, part)
+			}
+		}
+		if part == partMeasurement || part == partMeasurementWildcard {
+			hasMeasurement = true
+		}
+	}
+	if !hasMeasurement {
+		return nil, fmt.Errorf("invalid template %q, no measurement part", s)
+	}
+	return t, nil
+}
+
+func parseTags(s string, dst map[string]string) error {
+	for _, kv := range strings.Split(s, ",") {
+		i := strings.IndexByte(kv, '=')
+		if i <= 0 || i == len(kv)-1 {
+			return fmt.Errorf("invalid tag %q, expect tag=value", kv)
+		}
+		dst[kv[:i]] = kv[i+1:]
+	}
+	return nil
+}
+
+// match returns true if the filter of the template matches the parts of a metric. A filter matches the metrics
+// with at least as many parts, each part of the filter is a glob.
+func (t *template) match(metric []string) bool {
+	if len(metric) < len(t.filter) {
+		return false
+	}
+	for i, f := range t.filter {
+		if ok, _ := path.Match(f, metric[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// moreSpecific returns true if the filter of t is more specific than the one of o: at the first part where they
+// differ a literal beats a glob, and a longer filter beats its prefix.
+func (t *template) moreSpecific(o *template) bool {
+	for i := 0; i < len(t.filter) && i < len(o.filter); i++ {
+		tGlob, oGlob := isGlob(t.filter[i]), isGlob(o.filter[i])
+		if tGlob != oGlob {
+			return !tGlob
+		}
+	}
+	return len(t.filter) > len(o.filter)
+}
+
+func isGlob(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+// apply returns the measurement, the field and the tags of a metric.
+func (t *template) apply(metric []string, separator string) (string, string, map[string]string) {
+	var measurement, field []string
+	tags := make(map[string][]string)
+	for i, part := range t.parts {
+		if i >= len(metric) {
+			break
+		}
+		switch part {
+		case "":
+		case partMeasurement:
+			measurement = append(measurement, metric[i])
+		case partMeasurementWildcard:
+			measurement = append(measurement, metric[i:]...)
+		case partField:
+			field = append(field, metric[i])
+		case partFieldWildcard:
+			field = append(field, metric[i:]...)
+		default:
+			tags[part] = append(tags[part], metric[i])
+		}
+	}
+
+	tagValues := make(map[string]string, len(tags))
+	for k, v := range tags {
+		tagValues[k] = strings.Join(v, separator)
+	}
+	return strings.Join(measurement, separator), strings.Join(field, separator), tagValues
+}
+
+// Parser converts the lines of the plaintext protocol to rows with the templates.
+type Parser struct {
+	separator string
+	templates []*template // the most specific filter first
+	fallback  *template
+	tags      map[string]string
+}
+
+// NewParser returns a Parser for the templates and the tags of a listener.
+func NewParser(templates []string, tags []string, separator string) (*Parser, error) {
+	p := &Parser{separator: separator, tags: make(map[string]string)}
+	for _, s := range templates {
+		t, err := parseTemplate(s)
+		if err != nil {
+			return nil, err
+		}
+		if len(t.filter) == 0 {
+			if p.fallback != nil {
+				return nil, fmt.Errorf("invalid template %q, there is already a template without filter", s)
+			}
+			p.fallback = t
+			continue
+		}
+		p.templates = append(p.templates, t)
+	}
+	sort.SliceStable(p.templates, func(i, j int) bool {
+		return p.templates[i].moreSpecific(p.templates[j])
+	})
+	if p.fallback == nil {
+		p.fallback = &template{parts: []string{partMeasurementWildcard}}
+	}
+
+	for _, tag := range tags {
+		if err := parseTags(tag, p.tags); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Parse parses a line "metric value [timestamp]", the timestamp is in seconds and defaults to now.
+func (p *Parser) Parse(line string, now time.Time) (influx.Row, error) {
+	items := strings.Fields(line)
+	if len(items) != 2 && len(items) != 3 {
+		return influx.Row{}, errors.New("expect metric value [timestamp]")
+	}
+
+	value, err := strconv.ParseFloat(items[1], 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return influx.Row{}, fmt.Errorf("invalid value %q", items[1])
+	}
+
+	timestamp := now.UnixNano()
+	if len(items) == 3 && items[2] != "-1" {
+		ts, err := strconv.ParseFloat(items[2], 64)
+		if err != nil || ts <= 0 || ts > math.MaxInt64/1e9 {
+			return influx.Row{}, fmt.Errorf("invalid timestamp %q", items[2])
+		}
+		timestamp = int64(ts * 1e9)
+	}
+
+	metric := strings.Split(items[0], ".")
+	t := p.fallback
+	for _, candidate := range p.templates {
+		if candidate.match(metric) {
+			t = candidate
+			break
+		}
+	}
+	measurement, field, extracted := t.apply(metric, p.separator)
+	if measurement == "" {
+		measurement = items[0]
+	}
+	if field == "" {
+		field = defaultField
+	}
+
+	tags := make(influx.PointTags, 0, len(p.tags)+len(t.tags)+len(extracted))
+	for _, src := range []map[string]string{p.tags, t.tags, extracted} {
+		for k, v := range src {
+			tags = setTag(tags, k, v)
+		}
+	}
+	sort.Sort(&tags)
+
+	return influx.Row{
+		Name:      measurement,
+		Tags:      tags,
+		Fields:    influx.Fields{{Key: field, NumValue: value, Type: influx.Field_Type_Float}},
+		Timestamp: timestamp,
+	}, nil
+}
+
+// setTag sets the value of a tag, the later sources override the earlier ones.
+func setTag(tags influx.PointTags, key, value string) influx.PointTags {
+	if value == "" {
+		return tags
+	}
+	for i := range tags {
+		if tags[i].Key == key {
+			tags[i].Value = value
+			return tags
+		}
+	}
+	return append(tags, influx.Tag{Key: key, Value: value})
+}
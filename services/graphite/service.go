@@ -0,0 +1,272 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphite
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+const (
+	// lines longer than it are dropped
+	maxLineSize = 64 * 1024
+
+	// the largest UDP payload
+	maxUDPPayload = 64 * 1024
+
+	// an idle TCP connection is closed after it
+	readTimeout = 5 * time.Minute
+)
+
+// Service accepts the Graphite plaintext protocol on TCP or UDP and writes the points in batches to the database
+// of its config. The lines are acknowledged by nothing, so a sender is not told about the points that cannot be
+// parsed or written.
+type Service struct {
+	MetaClient interface {
+		CreateDatabase(name string) (*meta2.DatabaseInfo, error)
+	}
+
+	PointsWriter interface {
+		WritePointRows(database, retentionPolicy string, rows []influx.Row) error
+	}
+
+	conf    config.Graphite
+	parser  *Parser
+	batcher *services.RowBatcher
+	key     services.BatchKey
+
+	ln        net.Listener
+	udpConn   net.PacketConn
+	mu        sync.Mutex
+	conns     map[net.Conn]struct{}
+	dbCreator services.DatabaseCreator
+
+	wg     sync.WaitGroup
+	logger *logger.Logger
+}
+
+// NewService returns a listener for the config, the unset options take their defaults.
+func NewService(c config.Graphite) (*Service, error) {
+	c = c.WithDefaults()
+	parser, err := NewParser(c.Templates, c.Tags, c.Separator)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{
+		conf:   c,
+		parser: parser,
+		key:    services.BatchKey{Database: c.Database, RetentionPolicy: c.RetentionPolicy},
+		conns:  make(map[net.Conn]struct{}),
+		logger: logger.NewLogger(errno.ModuleWrite).With(zap.String("service", "graphite"),
+			zap.String("addr", c.BindAddress), zap.String("protocol", c.Protocol)),
+	}
+	s.batcher = services.NewRowBatcher(c.BatchSize, time.Duration(c.BatchTimeout), s.writeRows)
+	return s, nil
+}
+
+// Open starts listening on the bind address.
+func (s *Service) Open() error {
+	if s.conf.Protocol == "udp" {
+		conn, err := net.ListenPacket("udp", s.conf.BindAddress)
+		if err != nil {
+			return err
+		}
+		if s.conf.UDPReadBuffer > 0 {
+			if err = conn.(*net.UDPConn).SetReadBuffer(int(s.conf.UDPReadBuffer)); err != nil {
+				_ = conn.Close()
+				return err
+			}
+		}
+		s.udpConn = conn
+		s.logger.Info("Listening on Graphite", zap.String("local", conn.LocalAddr().String()))
+		s.batcher.Start()
+		s.wg.Add(1)
+		go s.serveUDP()
+		return nil
+	}
+
+	ln, err := net.Listen("tcp", s.conf.BindAddress)
+	if err != nil {
+		return err
+	}
+	s.ln = ln
+	s.logger.Info("Listening on Graphite", zap.String("local", ln.Addr().String()))
+	s.batcher.Start()
+	s.wg.Add(1)
+	go s.serveTCP()
+	return nil
+}
+
+// Close closes the listener and the connections, and writes the pending points.
+func (s *Service) Close() error {
+	var err error
+	switch {
+	case s.udpConn != nil:
+		err = s.udpConn.Close()
+	case s.ln != nil:
+		err = s.ln.Close()
+	default:
+		return nil
+	}
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.conns = nil
+	s.mu.Unlock()
+	s.wg.Wait()
+	s.batcher.Stop()
+	return err
+}
+
+// Addr returns the address the service listens on.
+func (s *Service) Addr() net.Addr {
+	if s.udpConn != nil {
+		return s.udpConn.LocalAddr()
+	}
+	return s.ln.Addr()
+}
+
+func (s *Service) serveTCP() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+		if !s.track(conn) {
+			_ = conn.Close()
+			return
+		}
+		s.wg.Add(1)
+		go s.handleTCPConn(conn)
+	}
+}
+
+func (s *Service) handleTCPConn(conn net.Conn) {
+	defer s.wg.Done()
+	defer s.untrack(conn)
+	atomic.AddInt64(&statistics.HandlerStat.GraphiteConnsActive, 1)
+	defer atomic.AddInt64(&statistics.HandlerStat.GraphiteConnsActive, -1)
+
+	r := bufio.NewReaderSize(conn, maxLineSize)
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+		line, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			s.logger.Warn("Graphite line too long", zap.String("remote", conn.RemoteAddr().String()))
+			return
+		}
+		if row, ok := s.parseLine(string(line), time.Now()); ok {
+			s.batcher.Add(s.key, row)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Service) serveUDP() {
+	defer s.wg.Done()
+	buf := make([]byte, maxUDPPayload)
+	for {
+		n, _, err := s.udpConn.ReadFrom(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+
+		now := time.Now()
+		rows := make([]influx.Row, 0, 8)
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			if row, ok := s.parseLine(line, now); ok {
+				rows = append(rows, row)
+			}
+		}
+		s.batcher.Add(s.key, rows...)
+	}
+}
+
+func (s *Service) parseLine(line string, now time.Time) (influx.Row, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return influx.Row{}, false
+	}
+	atomic.AddInt64(&statistics.HandlerStat.GraphitePointsReceived, 1)
+	row, err := s.parser.Parse(line, now)
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.GraphiteBadLines, 1)
+		if s.conf.LogPointErrors {
+			s.logger.Info("bad Graphite line", zap.String("line", line), zap.Error(err))
+		}
+		return influx.Row{}, false
+	}
+	return row, true
+}
+
+func (s *Service) track(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conns == nil {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Service) untrack(conn net.Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+	_ = conn.Close()
+}
+
+// writeRows writes a batch, the database is created before the first batch.
+func (s *Service) writeRows(key services.BatchKey, rows []influx.Row) {
+	err := s.dbCreator.Create(func() error {
+		_, err := s.MetaClient.CreateDatabase(s.conf.Database)
+		return err
+	})
+	if err == nil {
+		err = s.PointsWriter.WritePointRows(key.Database, key.RetentionPolicy, rows)
+	}
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.GraphitePointsWriteFail, int64(len(rows)))
+		s.logger.Error("write Graphite points failed", zap.String("db", key.Database),
+			zap.String("rp", key.RetentionPolicy), zap.Int("points", len(rows)), zap.Error(err))
+		return
+	}
+	atomic.AddInt64(&statistics.HandlerStat.GraphitePointsWritten, int64(len(rows)))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphite
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser(t *testing.T) {
+	p, err := NewParser([]string{
+		"*.app env.service.resource.measurement",
+		"stats.* .host.measurement.field* region=us-west",
+		"stats.local.* ..zone.measurement",
+		"measurement.measurement.field",
+	}, []string{"dc=dc1,region=us-east"}, "_")
+	require.NoError(t, err)
+	now := time.Unix(100, 0)
+
+	row, err := p.Parse("prod.app.api.requests 12 1356998400", now)
+	require.NoError(t, err)
+	assert.Equal(t, "requests", row.Name)
+	assert.Equal(t, influx.PointTags{{Key: "dc", Value: "dc1"}, {Key: "env", Value: "prod"},
+		{Key: "region", Value: "us-east"}, {Key: "resource", Value: "api"}, {Key: "service", Value: "app"}}, row.Tags)
+	assert.Equal(t, influx.Fields{{Key: "value", NumValue: 12, Type: influx.Field_Type_Float}}, row.Fields)
+	assert.Equal(t, int64(1356998400)*1e9, row.Timestamp)
+
+	row, err = p.Parse("stats.web01.cpu.load.1m 0.5", now)
+	require.NoError(t, err)
+	assert.Equal(t, "cpu", row.Name)
+	assert.Equal(t, influx.PointTags{{Key: "dc", Value: "dc1"}, {Key: "host", Value: "web01"},
+		{Key: "region", Value: "us-west"}}, row.Tags)
+	assert.Equal(t, "load_1m", row.Fields[0].Key)
+	assert.Equal(t, now.UnixNano(), row.Timestamp)
+
+	// the literal part of the filter is more specific than the glob
+	row, err = p.Parse("stats.local.z1.disk 3 -1", now)
+	require.NoError(t, err)
+	assert.Equal(t, "disk", row.Name)
+	assert.Equal(t, "value", row.Fields[0].Key)
+	assert.Equal(t, now.UnixNano(), row.Timestamp)
+
+	row, err = p.Parse("servers.mem.free 1 1356998400.5", now)
+	require.NoError(t, err)
+	assert.Equal(t, "servers_mem", row.Name)
+	assert.Equal(t, "free", row.Fields[0].Key)
+	assert.Equal(t, int64(1356998400500000000), row.Timestamp)
+
+	for _, line := range []string{"a.b", "a.b c", "a.b 1 c", "a.b NaN", "a.b 1 2 3", "a.b 1 -5"} {
+		_, err = p.Parse(line, now)
+		assert.Error(t, err, line)
+	}
+
+	for _, templates := range [][]string{
+		{"host.field"},
+		{"measurement*.host"},
+		{"a.* measurement tag"},
+		{"measurement", "measurement.field"},
+		{"[ measurement"},
+	} {
+		_, err = NewParser(templates, nil, ".")
+		assert.Error(t, err, templates)
+	}
+}
+
+func TestService(t *testing.T) {
+	for _, protocol := range []string{"tcp", "udp"} {
+		writer := &mocks.PointsWriter{}
+		s, err := NewService(config.Graphite{
+			Enabled:      true,
+			BindAddress:  "127.0.0.1:0",
+			Protocol:     protocol,
+			BatchTimeout: toml.Duration(10 * time.Millisecond),
+		})
+		require.NoError(t, err)
+		s.MetaClient = &mocks.MetaClient{}
+		s.PointsWriter = writer
+		require.NoError(t, s.Open())
+
+		conn, err := net.Dial(protocol, s.Addr().String())
+		require.NoError(t, err)
+		_, err = conn.Write([]byte("cpu.load 1 1356998400\nbad\nmem.free 2 1356998400\n"))
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		require.Eventually(t, func() bool {
+			return len(writer.Rows(config.DefaultGraphiteDatabase)) == 2
+		}, 5*time.Second, 10*time.Millisecond, protocol)
+		require.NoError(t, s.Close())
+		assert.Equal(t, "cpu.load", writer.Rows(config.DefaultGraphiteDatabase)[0].Name)
+	}
+}
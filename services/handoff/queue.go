@@ -0,0 +1,369 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handoff
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	numenc "github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+)
+
+const (
+	segmentSuffix = ".seg"
+
+	// every record starts with the length and the crc32 of its payload
+	recordHeaderSize = 8
+)
+
+var (
+	errQueueFull = errors.New("queue is full")
+	errCorrupted = errors.New("corrupted record")
+)
+
+// queue is an on-disk FIFO of records, kept in segment files named after their sequence number. Records are
+// appended to the last segment, and a segment is removed once all its records are consumed. The read position
+// is not persisted, so the records of a partially consumed segment are read again after a restart.
+type queue struct {
+	dir         string
+	maxSize     int64
+	segmentSize int64
+
+	mu       sync.Mutex
+	segments []*segment
+	size     int64
+
+	// read position in the first segment and the size of the record returned by the last peek
+	offset int64
+	next   int64
+
+	head *os.File // the first segment opened for reading
+	tail *os.File // the last segment opened for appending
+}
+
+type segment struct {
+	seq     uint64
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func openQueue(dir string, maxSize, segmentSize int64) (*queue, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &queue{dir: dir, maxSize: maxSize, segmentSize: segmentSize}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		q.segments = append(q.segments, &segment{
+			seq:     seq,
+			path:    filepath.Join(dir, name),
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		})
+		q.size += info.Size()
+	}
+	sort.Slice(q.segments, func(i, j int) bool {
+		return q.segments[i].seq < q.segments[j].seq
+	})
+
+	// the last record of the last segment may be torn by a crash, the segment is appended to after it
+	if len(q.segments) > 0 {
+		seg := q.segments[len(q.segments)-1]
+		size, err := validSize(seg.path, seg.size)
+		if err != nil {
+			return nil, err
+		}
+		if size < seg.size {
+			if err := os.Truncate(seg.path, size); err != nil {
+				return nil, err
+			}
+			q.size -= seg.size - size
+			seg.size = size
+		}
+	}
+	return q, nil
+}
+
+// validSize returns the size of the records of the segment up to the first incomplete or corrupted one.
+func validSize(path string, size int64) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var header [recordHeaderSize]byte
+	var off int64
+	for off+recordHeaderSize <= size {
+		if _, err := f.ReadAt(header[:], off); err != nil {
+			return 0, err
+		}
+		n := int64(numenc.UnmarshalUint32(header[:4]))
+		if size-off-recordHeaderSize < n {
+			break
+		}
+		b := make([]byte, n)
+		if _, err := f.ReadAt(b, off+recordHeaderSize); err != nil {
+			return 0, err
+		}
+		if crc32.ChecksumIEEE(b) != numenc.UnmarshalUint32(header[4:]) {
+			break
+		}
+		off += recordHeaderSize + n
+	}
+	return off, nil
+}
+
+// Size returns the size of the segments on disk.
+func (q *queue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// Append adds a record to the end of the queue, it fails with errQueueFull if the queue cannot grow any more.
+func (q *queue) Append(b []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := int64(recordHeaderSize + len(b))
+	if q.size+n > q.maxSize {
+		return errQueueFull
+	}
+	if len(q.segments) == 0 || q.segments[len(q.segments)-1].size >= q.segmentSize {
+		if err := q.rollover(); err != nil {
+			return err
+		}
+	}
+	seg := q.segments[len(q.segments)-1]
+	if q.tail == nil {
+		f, err := os.OpenFile(seg.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+		if err != nil {
+			return err
+		}
+		q.tail = f
+	}
+
+	buf := make([]byte, 0, n)
+	buf = numenc.MarshalUint32(buf, uint32(len(b)))
+	buf = numenc.MarshalUint32(buf, crc32.ChecksumIEEE(b))
+	buf = append(buf, b...)
+	if _, err := q.tail.Write(buf); err != nil {
+		// drop the partial record, the segment is read up to its size only
+		_ = q.tail.Truncate(seg.size)
+		return err
+	}
+	seg.size += n
+	seg.modTime = time.Now()
+	q.size += n
+	return nil
+}
+
+func (q *queue) rollover() error {
+	var seq uint64
+	if len(q.segments) > 0 {
+		seq = q.segments[len(q.segments)-1].seq + 1
+	}
+	if q.tail != nil {
+		if err := q.tail.Close(); err != nil {
+			return err
+		}
+		q.tail = nil
+	}
+	q.segments = append(q.segments, &segment{
+		seq:     seq,
+		path:    filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, segmentSuffix)),
+		modTime: time.Now(),
+	})
+	return nil
+}
+
+// Peek returns the first record of the queue without consuming it, or io.EOF if the queue is empty. If the
+// record is corrupted, the rest of its segment is skipped and errCorrupted is returned.
+func (q *queue) Peek() ([]byte, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if len(q.segments) == 0 {
+			return nil, io.EOF
+		}
+		seg := q.segments[0]
+		if q.offset >= seg.size {
+			if len(q.segments) == 1 {
+				return nil, io.EOF
+			}
+			if err := q.removeHead(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if q.head == nil {
+			f, err := os.Open(seg.path)
+			if err != nil {
+				return nil, err
+			}
+			q.head = f
+		}
+		b, err := q.read(seg)
+		if err == errCorrupted {
+			q.offset = seg.size
+		}
+		return b, err
+	}
+}
+
+func (q *queue) read(seg *segment) ([]byte, error) {
+	var header [recordHeaderSize]byte
+	if seg.size-q.offset < recordHeaderSize {
+		return nil, errCorrupted
+	}
+	if _, err := q.head.ReadAt(header[:], q.offset); err != nil {
+		return nil, err
+	}
+	n := int64(numenc.UnmarshalUint32(header[:4]))
+	if seg.size-q.offset-recordHeaderSize < n {
+		return nil, errCorrupted
+	}
+	b := make([]byte, n)
+	if _, err := q.head.ReadAt(b, q.offset+recordHeaderSize); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(b) != numenc.UnmarshalUint32(header[4:]) {
+		return nil, errCorrupted
+	}
+	q.next = recordHeaderSize + n
+	return b, nil
+}
+
+// Advance consumes the record returned by the last Peek.
+func (q *queue) Advance() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.segments) == 0 || q.next == 0 {
+		return nil
+	}
+	q.offset += q.next
+	q.next = 0
+	if q.offset < q.segments[0].size {
+		return nil
+	}
+	return q.removeHead()
+}
+
+// removeHead removes the first segment, the last segment is removed too if it is fully consumed.
+func (q *queue) removeHead() error {
+	if q.head != nil {
+		_ = q.head.Close()
+		q.head = nil
+	}
+	if len(q.segments) == 1 && q.tail != nil {
+		_ = q.tail.Close()
+		q.tail = nil
+	}
+	seg := q.segments[0]
+	if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	q.segments = q.segments[1:]
+	q.size -= seg.size
+	q.offset = 0
+	q.next = 0
+	return nil
+}
+
+// PurgeOlderThan removes the segments, except the last one, that were last written before t. It returns the
+// number of the unconsumed records removed.
+func (q *queue) PurgeOlderThan(t time.Time) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	purged := 0
+	for len(q.segments) > 1 && q.segments[0].modTime.Before(t) {
+		if q.head == nil {
+			f, err := os.Open(q.segments[0].path)
+			if err != nil {
+				return purged, err
+			}
+			q.head = f
+		}
+		purged += q.countRecords(q.segments[0])
+		if err := q.removeHead(); err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// countRecords counts the records of the first segment from the read position.
+func (q *queue) countRecords(seg *segment) int {
+	var header [recordHeaderSize]byte
+	n := 0
+	for off := q.offset; off+recordHeaderSize <= seg.size; n++ {
+		if _, err := q.head.ReadAt(header[:], off); err != nil {
+			break
+		}
+		off += recordHeaderSize + int64(numenc.UnmarshalUint32(header[:4]))
+	}
+	return n
+}
+
+func (q *queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var err error
+	if q.head != nil {
+		err = q.head.Close()
+		q.head = nil
+	}
+	if q.tail != nil {
+		if e := q.tail.Close(); e != nil {
+			err = e
+		}
+		q.tail = nil
+	}
+	return err
+}
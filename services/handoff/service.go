@@ -0,0 +1,376 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handoff
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	numenc "github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// Service queues the writes to the ts-store nodes that cannot be reached, one queue per node, and replays them
+// once the pts of the writes are online again. The queues are replayed periodically and whenever the meta data
+// changes, which is when the serf member events of the nodes are applied to the pt view.
+type Service struct {
+	MetaClient interface {
+		DBPtView(database string) (meta.DBPtInfos, error)
+		WaitForDataChanged() chan struct{}
+	}
+
+	TSDBStore interface {
+		WriteRows(nodeID uint64, database, rp string, pt uint32, shard uint64, rows *[]influx.Row, timeout time.Duration) error
+	}
+
+	conf    config.HintedHandoff
+	timeout time.Duration
+
+	mu     sync.RWMutex
+	queues map[uint64]*nodeQueue
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+	logger  *logger.Logger
+}
+
+type nodeQueue struct {
+	nodeID uint64
+	queue  *queue
+	stat   *statistics.HintedHandoffNodeStats
+}
+
+func NewService(c config.HintedHandoff, timeout time.Duration) *Service {
+	return &Service{
+		conf:    c,
+		timeout: timeout,
+		queues:  make(map[uint64]*nodeQueue),
+		logger:  logger.NewLogger(errno.ModuleCoordinator).With(zap.String("service", "hinted-handoff")),
+	}
+}
+
+// Open loads the queues left by the previous run and starts replaying them.
+func (s *Service) Open() error {
+	if err := os.MkdirAll(s.conf.Dir, 0750); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(s.conf.Dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		nodeID, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		if _, err := s.nodeQueue(nodeID); err != nil {
+			return err
+		}
+	}
+
+	s.closing = make(chan struct{})
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+func (s *Service) Close() error {
+	close(s.closing)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var err error
+	for nodeID, nq := range s.queues {
+		if e := nq.queue.Close(); e != nil {
+			err = e
+		}
+		statistics.HintedHandoffStat.Unregister(nodeID)
+	}
+	s.queues = make(map[uint64]*nodeQueue)
+	return err
+}
+
+func (s *Service) nodeQueue(nodeID uint64) (*nodeQueue, error) {
+	s.mu.RLock()
+	nq, ok := s.queues[nodeID]
+	s.mu.RUnlock()
+	if ok {
+		return nq, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if nq, ok = s.queues[nodeID]; ok {
+		return nq, nil
+	}
+	q, err := openQueue(filepath.Join(s.conf.Dir, strconv.FormatUint(nodeID, 10)), int64(s.conf.MaxSize), int64(s.conf.SegmentSize))
+	if err != nil {
+		return nil, err
+	}
+	nq = &nodeQueue{nodeID: nodeID, queue: q, stat: statistics.HintedHandoffStat.Register(nodeID)}
+	atomic.StoreInt64(&nq.stat.QueueBytes, q.Size())
+	s.queues[nodeID] = nq
+	return nq, nil
+}
+
+// WriteShard queues the rows written to a shard on a node that cannot be reached.
+func (s *Service) WriteShard(nodeID uint64, database, rp string, pt uint32, shard uint64, rows []influx.Row) error {
+	nq, err := s.nodeQueue(nodeID)
+	if err != nil {
+		return err
+	}
+
+	w := shardWrite{time: time.Now().UnixNano(), database: database, rp: rp, pt: pt, shard: shard}
+	b, err := w.marshal(nil, rows)
+	if err != nil {
+		return err
+	}
+	err = nq.queue.Append(b)
+	if err == errQueueFull {
+		atomic.AddInt64(&nq.stat.WritesDropped, 1)
+		return errno.NewError(errno.HintedHandoffQueueFull, nodeID, nq.queue.Size())
+	} else if err != nil {
+		return err
+	}
+	atomic.AddInt64(&nq.stat.WritesQueued, 1)
+	atomic.StoreInt64(&nq.stat.QueueBytes, nq.queue.Size())
+	return nil
+}
+
+func (s *Service) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(time.Duration(s.conf.RetryInterval))
+	defer ticker.Stop()
+	changed := s.MetaClient.WaitForDataChanged()
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-changed:
+			changed = s.MetaClient.WaitForDataChanged()
+		case <-ticker.C:
+		}
+		s.ReplayAll()
+	}
+}
+
+// ReplayAll drops the expired writes of all queues and replays the rest, the replay of a queue stops at the
+// first write whose pt is not online or cannot be written for now. The writes rejected by the stores are dropped.
+func (s *Service) ReplayAll() {
+	s.mu.RLock()
+	queues := make([]*nodeQueue, 0, len(s.queues))
+	for _, nq := range s.queues {
+		queues = append(queues, nq)
+	}
+	s.mu.RUnlock()
+
+	for _, nq := range queues {
+		purged, err := nq.queue.PurgeOlderThan(time.Now().Add(-time.Duration(s.conf.MaxAge)))
+		if err != nil {
+			s.logger.Error("purge hinted handoff queue failed", zap.Uint64("node", nq.nodeID), zap.Error(err))
+		}
+		atomic.AddInt64(&nq.stat.WritesDropped, int64(purged))
+
+		n, err := s.replay(nq)
+		if err != nil {
+			atomic.AddInt64(&nq.stat.ReplayFailures, 1)
+			s.logger.Warn("replay hinted handoff queue failed", zap.Uint64("node", nq.nodeID), zap.Error(err))
+		}
+		if n > 0 {
+			s.logger.Info("hinted handoff queue replayed", zap.Uint64("node", nq.nodeID), zap.Int("writes", n))
+		}
+		atomic.StoreInt64(&nq.stat.QueueBytes, nq.queue.Size())
+	}
+}
+
+// replay writes the queued rows to the nodes owning their pts now, which are not the queue's node if the pts
+// were moved meanwhile. It returns the number of writes replayed.
+func (s *Service) replay(nq *nodeQueue) (int, error) {
+	var w shardWrite
+	var rows []influx.Row
+	replayed := 0
+	for {
+		select {
+		case <-s.closing:
+			return replayed, nil
+		default:
+		}
+
+		b, err := nq.queue.Peek()
+		if err == io.EOF {
+			return replayed, nil
+		} else if err == errCorrupted {
+			s.logger.Error("skip corrupted hinted handoff segment", zap.Uint64("node", nq.nodeID))
+			atomic.AddInt64(&nq.stat.WritesDropped, 1)
+			continue
+		} else if err != nil {
+			return replayed, err
+		}
+
+		rows, err = w.unmarshal(b, rows[:0])
+		if err != nil || time.Since(time.Unix(0, w.time)) > time.Duration(s.conf.MaxAge) {
+			atomic.AddInt64(&nq.stat.WritesDropped, 1)
+			if err = nq.queue.Advance(); err != nil {
+				return replayed, err
+			}
+			continue
+		}
+
+		ptView, err := s.MetaClient.DBPtView(w.database)
+		if errno.Equal(err, errno.DatabaseNotFound) {
+			atomic.AddInt64(&nq.stat.WritesDropped, 1)
+			if err = nq.queue.Advance(); err != nil {
+				return replayed, err
+			}
+			continue
+		} else if err != nil {
+			return replayed, err
+		}
+		if int(w.pt) >= len(ptView) || ptView[w.pt].Status != meta.Online {
+			return replayed, nil
+		}
+
+		err = s.TSDBStore.WriteRows(ptView[w.pt].Owner.NodeID, w.database, w.rp, w.pt, w.shard, &rows, s.timeout)
+		if isPermanent(err) {
+			// the store rejects the rows, retrying would block the rest of the queue forever
+			s.logger.Error("drop hinted handoff write rejected by the store", zap.Uint64("node", nq.nodeID),
+				zap.String("db", w.database), zap.Uint64("shard", w.shard), zap.Int("rows", len(rows)), zap.Error(err))
+			atomic.AddInt64(&nq.stat.WritesDropped, 1)
+			if err = nq.queue.Advance(); err != nil {
+				return replayed, err
+			}
+			continue
+		} else if err != nil {
+			return replayed, err
+		}
+		atomic.AddInt64(&nq.stat.WritesReplayed, 1)
+		replayed++
+		if err = nq.queue.Advance(); err != nil {
+			return replayed, err
+		}
+	}
+}
+
+var errShortWrite = errors.New("short hinted handoff write")
+
+// permanentErrors are the messages of the write errors returned by a store which rejects the rows themselves,
+// the store returns them as text
+var permanentErrors = []string{
+	"field type conflict",
+	"database not found",
+	"retention policy not found",
+	"meta not found",
+	"limit exceeded",
+}
+
+// isPermanent reports whether a replayed write failed because the store rejects its rows, so that it fails the
+// same way however often it is retried. The other errors, such as the store being unreachable, are retryable.
+func isPermanent(err error) bool {
+	if err == nil {
+		return false
+	}
+	if _, ok := err.(netstorage.PartialWriteError); ok {
+		return true
+	}
+	var e *errno.Error
+	if errors.As(err, &e) {
+		switch e.Errno() {
+		case errno.FieldTypeConflict, errno.DatabaseNotFound, errno.ShardMetaNotFound, errno.SeriesLimitExceeded,
+			errno.MeasurementSeriesLimitExceeded, errno.TagValuesLimitExceeded:
+			return true
+		}
+		return false
+	}
+	msg := err.Error()
+	for _, s := range permanentErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// shardWrite is the header of a queued batch of rows written to a shard.
+type shardWrite struct {
+	time     int64
+	database string
+	rp       string
+	pt       uint32
+	shard    uint64
+}
+
+func (w *shardWrite) marshal(dst []byte, rows []influx.Row) ([]byte, error) {
+	dst = numenc.MarshalInt64(dst, w.time)
+	dst = numenc.MarshalUint16(dst, uint16(len(w.database)))
+	dst = append(dst, w.database...)
+	dst = numenc.MarshalUint16(dst, uint16(len(w.rp)))
+	dst = append(dst, w.rp...)
+	dst = numenc.MarshalUint32(dst, w.pt)
+	dst = numenc.MarshalUint64(dst, w.shard)
+	return influx.FastMarshalMultiRows(dst, rows)
+}
+
+func (w *shardWrite) unmarshal(src []byte, rows []influx.Row) ([]influx.Row, error) {
+	if len(src) < 10 {
+		return rows, errShortWrite
+	}
+	w.time = numenc.UnmarshalInt64(src)
+	src = src[8:]
+
+	var err error
+	if w.database, src, err = unmarshalString(src); err != nil {
+		return rows, err
+	}
+	if w.rp, src, err = unmarshalString(src); err != nil {
+		return rows, err
+	}
+	if len(src) < 16 {
+		return rows, errShortWrite
+	}
+	w.pt = numenc.UnmarshalUint32(src)
+	w.shard = numenc.UnmarshalUint64(src[4:])
+
+	rows, _, _, _, _, err = influx.FastUnmarshalMultiRows(src[12:], rows, nil, nil, nil, nil)
+	return rows, err
+}
+
+func unmarshalString(src []byte) (string, []byte, error) {
+	if len(src) < 2 {
+		return "", src, errShortWrite
+	}
+	n := int(numenc.UnmarshalUint16(src))
+	src = src[2:]
+	if len(src) < n {
+		return "", src, errShortWrite
+	}
+	return string(src[:n]), src[n:], nil
+}
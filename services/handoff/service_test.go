@@ -0,0 +1,304 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handoff
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openQueue(dir, 100, 30)
+	require.NoError(t, err)
+
+	// every record takes 18 bytes, a segment holds two of them
+	for i := 0; i < 5; i++ {
+		require.NoError(t, q.Append([]byte{'a' + byte(i), 1, 2, 3, 4, 5, 6, 7, 8, 9}))
+	}
+	assert.Equal(t, errQueueFull, q.Append(make([]byte, 10)))
+	assert.Equal(t, int64(90), q.Size())
+
+	for i := 0; i < 3; i++ {
+		b, err := q.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, 'a'+byte(i), b[0])
+		require.NoError(t, q.Advance())
+	}
+	// the first segment is removed once consumed
+	assert.Equal(t, int64(54), q.Size())
+	require.NoError(t, q.Close())
+
+	// the partially consumed segment is read again after a restart
+	q, err = openQueue(dir, 100, 30)
+	require.NoError(t, err)
+	b, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, byte('c'), b[0])
+	for i := 0; i < 3; i++ {
+		require.NoError(t, q.Advance())
+		_, err = q.Peek()
+	}
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, int64(0), q.Size())
+	require.NoError(t, q.Append([]byte("f")))
+	require.NoError(t, q.Close())
+}
+
+func TestQueue_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openQueue(dir, 1000, 20)
+	require.NoError(t, err)
+	require.NoError(t, q.Append([]byte("first")))
+	require.NoError(t, q.Append([]byte("second")))
+	require.NoError(t, q.Append([]byte("third")))
+	require.NoError(t, q.Close())
+
+	// flip a byte of the first record, the second one in the same segment is skipped too
+	path := filepath.Join(dir, "00000000000000000000"+segmentSuffix)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[recordHeaderSize] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0640))
+
+	q, err = openQueue(dir, 1000, 20)
+	require.NoError(t, err)
+	defer q.Close()
+	_, err = q.Peek()
+	assert.Equal(t, errCorrupted, err)
+	b, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "third", string(b))
+}
+
+func TestQueue_TornTail(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openQueue(dir, 1000, 100)
+	require.NoError(t, err)
+	require.NoError(t, q.Append([]byte("first")))
+	require.NoError(t, q.Append([]byte("second")))
+	require.NoError(t, q.Close())
+
+	// a crash leaves half of the second record
+	path := filepath.Join(dir, "00000000000000000000"+segmentSuffix)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-3], 0640))
+
+	// the torn record is dropped and the records appended after it are read
+	q, err = openQueue(dir, 1000, 100)
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, int64(recordHeaderSize+5), q.Size())
+	require.NoError(t, q.Append([]byte("third")))
+	for _, exp := range []string{"first", "third"} {
+		b, err := q.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, exp, string(b))
+		require.NoError(t, q.Advance())
+	}
+	_, err = q.Peek()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestQueue_PurgeOlderThan(t *testing.T) {
+	q, err := openQueue(t.TempDir(), 1000, 20)
+	require.NoError(t, err)
+	defer q.Close()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, q.Append(make([]byte, 12)))
+	}
+
+	// the last segment is kept
+	purged, err := q.PurgeOlderThan(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, purged)
+	assert.Equal(t, int64(20), q.Size())
+}
+
+type mockMetaClient struct {
+	mu     sync.Mutex
+	status meta.PtStatus
+}
+
+func (c *mockMetaClient) DBPtView(database string) (meta.DBPtInfos, error) {
+	if database != "db0" {
+		return nil, errno.NewError(errno.DatabaseNotFound, database)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return meta.DBPtInfos{{PtId: 0, Owner: meta.PtOwner{NodeID: 2}, Status: c.status}}, nil
+}
+
+func (c *mockMetaClient) WaitForDataChanged() chan struct{} {
+	return make(chan struct{})
+}
+
+func (c *mockMetaClient) setStatus(status meta.PtStatus) {
+	c.mu.Lock()
+	c.status = status
+	c.mu.Unlock()
+}
+
+type mockStore struct {
+	err     error
+	written map[uint64]int
+}
+
+func (s *mockStore) WriteRows(nodeID uint64, database, rp string, pt uint32, shard uint64, rows *[]influx.Row, timeout time.Duration) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.written[nodeID] += len(*rows)
+	return nil
+}
+
+func testRows() []influx.Row {
+	row := influx.Row{
+		Name:      "cpu",
+		Tags:      influx.PointTags{{Key: "host", Value: "a"}},
+		Fields:    influx.Fields{{Key: "value", NumValue: 1.5, Type: influx.Field_Type_Float}},
+		Timestamp: 100,
+	}
+	row.UnmarshalIndexKeys(nil)
+	return []influx.Row{row}
+}
+
+func newTestService(t *testing.T, maxAge time.Duration) (*Service, *mockMetaClient, *mockStore) {
+	conf := config.NewHintedHandoff()
+	conf.Enabled = true
+	conf.Dir = t.TempDir()
+	conf.MaxSize = toml.Size(1024)
+	conf.SegmentSize = toml.Size(128)
+	conf.MaxAge = toml.Duration(maxAge)
+	conf.RetryInterval = toml.Duration(time.Hour)
+	require.NoError(t, conf.Validate())
+
+	mc := &mockMetaClient{status: meta.Offline}
+	store := &mockStore{written: map[uint64]int{}}
+	s := NewService(conf, time.Second)
+	s.MetaClient = mc
+	s.TSDBStore = store
+	require.NoError(t, s.Open())
+	return s, mc, store
+}
+
+func TestService_Replay(t *testing.T) {
+	s, mc, store := newTestService(t, time.Hour)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.WriteShard(1, "db0", "rp0", 0, 1, testRows()))
+	}
+	require.NoError(t, s.WriteShard(1, "db1", "rp0", 0, 1, testRows()))
+	stat := s.queues[1].stat
+	assert.Equal(t, int64(4), stat.WritesQueued)
+	assert.True(t, stat.QueueBytes > 0)
+
+	// the pt is offline, nothing is replayed
+	s.ReplayAll()
+	assert.Equal(t, 0, store.written[2])
+
+	// the pt is online but its node is not reachable yet
+	mc.setStatus(meta.Online)
+	store.err = errors.New("connection refused")
+	s.ReplayAll()
+	assert.Equal(t, int64(1), stat.ReplayFailures)
+
+	// the rows go to the node owning the pt now, the write to the dropped database is discarded
+	store.err = nil
+	s.ReplayAll()
+	assert.Equal(t, 3, store.written[2])
+	assert.Equal(t, int64(3), stat.WritesReplayed)
+	assert.Equal(t, int64(1), stat.WritesDropped)
+	assert.Equal(t, int64(0), stat.QueueBytes)
+}
+
+func TestService_ReplayRejected(t *testing.T) {
+	s, mc, store := newTestService(t, time.Hour)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.WriteShard(1, "db0", "rp0", 0, 1, testRows()))
+	}
+	stat := s.queues[1].stat
+	mc.setStatus(meta.Online)
+
+	// the write rejected by the store is dropped, the others are replayed
+	store.err = errors.New(`field type conflict: input field "value" on measurement "cpu" is type float, already exists as type integer`)
+	n, err := s.replay(s.queues[1])
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, int64(3), stat.WritesDropped)
+
+	require.NoError(t, s.WriteShard(1, "db0", "rp0", 0, 1, testRows()))
+	store.err = netstorage.PartialWriteError{Reason: errno.NewError(errno.SeriesLimitExceeded, "db0", 1), Dropped: 1}
+	s.ReplayAll()
+	assert.Equal(t, int64(4), stat.WritesDropped)
+	assert.Equal(t, int64(0), stat.ReplayFailures)
+
+	require.NoError(t, s.WriteShard(1, "db0", "rp0", 0, 1, testRows()))
+	store.err = errors.New("shard(id=1) meta not found")
+	s.ReplayAll()
+	assert.Equal(t, int64(5), stat.WritesDropped)
+
+	// a timeout is retried
+	require.NoError(t, s.WriteShard(1, "db0", "rp0", 0, 1, testRows()))
+	store.err = errno.NewError(errno.SessionSelectTimeout, time.Second)
+	s.ReplayAll()
+	assert.Equal(t, int64(5), stat.WritesDropped)
+	assert.Equal(t, int64(1), stat.ReplayFailures)
+	store.err = nil
+	s.ReplayAll()
+	assert.Equal(t, 1, store.written[2])
+	assert.Equal(t, int64(0), stat.QueueBytes)
+}
+
+func TestService_Limits(t *testing.T) {
+	s, mc, store := newTestService(t, time.Millisecond)
+
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		err = s.WriteShard(1, "db0", "rp0", 0, 1, testRows())
+	}
+	require.True(t, errno.Equal(err, errno.HintedHandoffQueueFull))
+	queued := s.queues[1].stat.WritesQueued
+	require.NoError(t, s.Close())
+
+	// the queue is loaded again on open, and its writes are expired
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.Open())
+	defer s.Close()
+	mc.setStatus(meta.Online)
+	s.ReplayAll()
+	assert.Equal(t, 0, store.written[2])
+	assert.Equal(t, queued, s.queues[1].stat.WritesDropped)
+}
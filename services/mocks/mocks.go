@@ -0,0 +1,54 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mocks
+
+import (
+	"sync"
+
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// MetaClient is the meta client of the ingestion listeners under test.
+type MetaClient struct{}
+
+func (c *MetaClient) CreateDatabase(name string) (*meta2.DatabaseInfo, error) {
+	return &meta2.DatabaseInfo{Name: name}, nil
+}
+
+// PointsWriter is the points writer of the ingestion listeners under test, it keeps the rows by database.
+type PointsWriter struct {
+	mu   sync.Mutex
+	rows map[string][]influx.Row
+}
+
+func (w *PointsWriter) WritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.rows == nil {
+		w.rows = make(map[string][]influx.Row)
+	}
+	w.rows[database] = append(w.rows[database], rows...)
+	return nil
+}
+
+// Rows returns the rows written to the database.
+func (w *PointsWriter) Rows(database string) []influx.Row {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rows[database]
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentsdb
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+)
+
+const (
+	// bodies larger than it are rejected by /api/put
+	maxBodySize = 32 * 1024 * 1024
+
+	// errors returned to a client at most
+	maxErrors = 10
+)
+
+// handler serves /api/put, the points are written to the configured database and retention policy.
+type handler struct {
+	service *Service
+}
+
+// putResult is returned to the client if some points cannot be parsed.
+type putResult struct {
+	Success int      `json:"success"`
+	Failed  int      `json:"failed"`
+	Errors  []string `json:"errors"`
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/put" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	atomic.AddInt64(&statistics.HandlerStat.OpenTSDBHTTPRequests, 1)
+
+	body := io.Reader(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := gzip.NewReader(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gr.Close()
+		body = gr
+	}
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	points, err := parsePoints(buf)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid points: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s := h.service
+	key := services.BatchKey{Database: s.conf.Database, RetentionPolicy: s.conf.RetentionPolicy}
+
+	atomic.AddInt64(&statistics.HandlerStat.OpenTSDBPointsReceived, int64(len(points)))
+	rows := make([]influx.Row, 0, len(points))
+	var res putResult
+	for i := range points {
+		row, err := s.mapper.pointRow(&points[i])
+		if err != nil {
+			s.badPoint(points[i].Metric, err)
+			res.Failed++
+			if len(res.Errors) < maxErrors {
+				res.Errors = append(res.Errors, fmt.Sprintf("%s: %s", points[i].Metric, err))
+			}
+			continue
+		}
+		rows = append(rows, row)
+	}
+	s.batcher.Add(key, rows...)
+
+	if res.Failed == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	res.Success = len(rows)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(&res)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentsdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// timestamps below it are in seconds, the others in milliseconds
+const maxSecondTimestamp = 1e10
+
+// mapper converts the OpenTSDB points to rows.
+type mapper struct {
+	field string
+	rules []config.OpenTSDBRule // the longest prefix first
+}
+
+func newMapper(c config.OpenTSDB) *mapper {
+	rules := append([]config.OpenTSDBRule(nil), c.Rules...)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return len(rules[i].Prefix) > len(rules[j].Prefix)
+	})
+	return &mapper{field: c.Field, rules: rules}
+}
+
+// measurement returns the measurement and the field a metric is written to.
+func (m *mapper) measurement(metric string) (string, string) {
+	for _, r := range m.rules {
+		if !strings.HasPrefix(metric, r.Prefix) {
+			continue
+		}
+		rest := metric[len(r.Prefix):]
+		if rest == "" {
+			return r.Measurement, m.field
+		}
+		if rest[0] == '.' && len(rest) > 1 {
+			return r.Measurement, rest[1:]
+		}
+	}
+	return metric, m.field
+}
+
+func (m *mapper) row(metric string, timestamp int64, value float64, tags influx.PointTags) (influx.Row, error) {
+	if metric == "" {
+		return influx.Row{}, errors.New("missing metric")
+	}
+	if timestamp <= 0 {
+		return influx.Row{}, fmt.Errorf("invalid timestamp %d", timestamp)
+	}
+	if timestamp < maxSecondTimestamp {
+		timestamp *= 1e9
+	} else {
+		timestamp *= 1e6
+	}
+	for i := range tags {
+		if tags[i].Key == "" || tags[i].Value == "" {
+			return influx.Row{}, fmt.Errorf("invalid tag %s=%s", tags[i].Key, tags[i].Value)
+		}
+	}
+	sort.Sort(&tags)
+
+	name, field := m.measurement(metric)
+	return influx.Row{
+		Name:      name,
+		Tags:      tags,
+		Fields:    influx.Fields{{Key: field, NumValue: value, Type: influx.Field_Type_Float}},
+		Timestamp: timestamp,
+	}, nil
+}
+
+// parsePut parses a put line of the telnet protocol:
+//
+//	put <metric> <timestamp> <value> <tagk1=tagv1 ...>
+func (m *mapper) parsePut(line string) (influx.Row, error) {
+	items := strings.Fields(line)
+	if len(items) < 4 || items[0] != "put" {
+		return influx.Row{}, errors.New("expect put <metric> <timestamp> <value> <tagk1=tagv1 ...>")
+	}
+	timestamp, err := strconv.ParseInt(items[2], 10, 64)
+	if err != nil {
+		return influx.Row{}, fmt.Errorf("invalid timestamp %q", items[2])
+	}
+	value, err := strconv.ParseFloat(items[3], 64)
+	if err != nil {
+		return influx.Row{}, fmt.Errorf("invalid value %q", items[3])
+	}
+
+	tags := make(influx.PointTags, 0, len(items)-4)
+	for _, item := range items[4:] {
+		i := strings.IndexByte(item, '=')
+		if i < 0 {
+			return influx.Row{}, fmt.Errorf("invalid tag %q", item)
+		}
+		tags = append(tags, influx.Tag{Key: item[:i], Value: item[i+1:]})
+	}
+	return m.row(items[1], timestamp, value, tags)
+}
+
+// point is a data point of /api/put, its timestamp and value may be numbers or strings.
+type point struct {
+	Metric    string            `json:"metric"`
+	Timestamp json.Number       `json:"timestamp"`
+	Value     json.Number       `json:"value"`
+	Tags      map[string]string `json:"tags"`
+}
+
+func (m *mapper) pointRow(p *point) (influx.Row, error) {
+	timestamp, err := p.Timestamp.Int64()
+	if err != nil {
+		return influx.Row{}, fmt.Errorf("invalid timestamp %q", p.Timestamp)
+	}
+	value, err := p.Value.Float64()
+	if err != nil {
+		return influx.Row{}, fmt.Errorf("invalid value %q", p.Value)
+	}
+	tags := make(influx.PointTags, 0, len(p.Tags))
+	for k, v := range p.Tags {
+		tags = append(tags, influx.Tag{Key: k, Value: v})
+	}
+	return m.row(p.Metric, timestamp, value, tags)
+}
+
+// parsePoints parses the body of /api/put, which is a point or an array of points.
+func parsePoints(body []byte) ([]point, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, errors.New("empty body")
+	}
+	if body[0] != '[' {
+		var p point
+		if err := json.Unmarshal(body, &p); err != nil {
+			return nil, err
+		}
+		return []point{p}, nil
+	}
+	var points []point
+	if err := json.Unmarshal(body, &points); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
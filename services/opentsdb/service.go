@@ -0,0 +1,305 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentsdb
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+const (
+	// lines longer than it are rejected by the telnet protocol
+	maxLineSize = 64 * 1024
+
+	// an idle telnet connection is closed after it
+	readTimeout = 5 * time.Minute
+)
+
+// Service accepts OpenTSDB points and writes them in batches. The connections beginning with put speak the
+// telnet protocol, the others are served as HTTP. The points are acknowledged once they are parsed, so a client
+// is not told about the batches that cannot be written. The listener does not authenticate its clients.
+type Service struct {
+	MetaClient interface {
+		CreateDatabase(name string) (*meta2.DatabaseInfo, error)
+	}
+
+	PointsWriter interface {
+		WritePointRows(database, retentionPolicy string, rows []influx.Row) error
+	}
+
+	conf    config.OpenTSDB
+	mapper  *mapper
+	batcher *services.RowBatcher
+
+	ln         net.Listener
+	httpLn     *chanListener
+	httpServer *http.Server
+
+	mu        sync.Mutex
+	conns     map[net.Conn]struct{}
+	dbCreator services.DatabaseCreator
+
+	wg     sync.WaitGroup
+	logger *logger.Logger
+}
+
+func NewService(c config.OpenTSDB) *Service {
+	s := &Service{
+		conf:   c,
+		mapper: newMapper(c),
+		conns:  make(map[net.Conn]struct{}),
+		logger: logger.NewLogger(errno.ModuleWrite).With(zap.String("service", "opentsdb")),
+	}
+	s.batcher = services.NewRowBatcher(c.BatchSize, time.Duration(c.BatchTimeout), s.writeRows)
+	s.httpServer = &http.Server{Handler: &handler{service: s}}
+	return s
+}
+
+// Open starts listening on the bind address.
+func (s *Service) Open() error {
+	ln, err := net.Listen("tcp", s.conf.BindAddress)
+	if err != nil {
+		return err
+	}
+	s.ln = ln
+	s.httpLn = newChanListener(ln.Addr())
+	s.logger.Info("Listening on OpenTSDB", zap.String("addr", ln.Addr().String()))
+
+	s.batcher.Start()
+	s.wg.Add(2)
+	go s.serve()
+	go func() {
+		defer s.wg.Done()
+		_ = s.httpServer.Serve(s.httpLn)
+	}()
+	return nil
+}
+
+// Close closes the listener and the connections, and writes the pending points.
+func (s *Service) Close() error {
+	if s.ln == nil {
+		return nil
+	}
+	err := s.ln.Close()
+	_ = s.httpServer.Close()
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.conns = nil
+	s.mu.Unlock()
+	s.wg.Wait()
+	s.batcher.Stop()
+	return err
+}
+
+// Addr returns the address the service listens on.
+func (s *Service) Addr() net.Addr {
+	return s.ln.Addr()
+}
+
+func (s *Service) serve() {
+	defer s.wg.Done()
+	defer s.httpLn.Close()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+		s.wg.Add(1)
+		go s.handleConn(conn)
+	}
+}
+
+// handleConn serves a connection as telnet if it begins with put, and hands it to the HTTP server otherwise.
+func (s *Service) handleConn(conn net.Conn) {
+	defer s.wg.Done()
+	r := bufio.NewReaderSize(conn, maxLineSize)
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	prefix, err := r.Peek(4)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	if string(prefix) != "put " {
+		if !s.httpLn.put(&readerConn{Conn: conn, r: r}) {
+			_ = conn.Close()
+		}
+		return
+	}
+
+	if !s.track(conn) {
+		_ = conn.Close()
+		return
+	}
+	defer s.untrack(conn)
+	atomic.AddInt64(&statistics.HandlerStat.OpenTSDBConnsActive, 1)
+	defer atomic.AddInt64(&statistics.HandlerStat.OpenTSDBConnsActive, -1)
+	s.handleTelnet(conn, r)
+}
+
+func (s *Service) handleTelnet(conn net.Conn, r *bufio.Reader) {
+	key := services.BatchKey{Database: s.conf.Database, RetentionPolicy: s.conf.RetentionPolicy}
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+		line, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			s.logger.Warn("OpenTSDB line too long", zap.String("remote", conn.RemoteAddr().String()))
+			return
+		}
+		s.handleLine(conn, key, strings.TrimSpace(string(line)))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Service) handleLine(conn net.Conn, key services.BatchKey, cmd string) {
+	switch {
+	case cmd == "":
+	case strings.HasPrefix(cmd, "put "):
+		atomic.AddInt64(&statistics.HandlerStat.OpenTSDBPointsReceived, 1)
+		row, err := s.mapper.parsePut(cmd)
+		if err != nil {
+			s.badPoint(cmd, err)
+			return
+		}
+		s.batcher.Add(key, row)
+	case cmd == "version":
+		_, _ = conn.Write([]byte("openGemini OpenTSDB listener\n"))
+	default:
+		s.badPoint(cmd, errors.New("unsupported command"))
+	}
+}
+
+func (s *Service) badPoint(point string, err error) {
+	atomic.AddInt64(&statistics.HandlerStat.OpenTSDBBadPoints, 1)
+	if s.conf.LogPointErrors {
+		s.logger.Info("bad OpenTSDB point", zap.String("point", point), zap.Error(err))
+	}
+}
+
+func (s *Service) track(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conns == nil {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Service) untrack(conn net.Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+	_ = conn.Close()
+}
+
+// writeRows writes a batch, the configured database is created before its first batch.
+func (s *Service) writeRows(key services.BatchKey, rows []influx.Row) {
+	err := s.dbCreator.Create(func() error {
+		_, err := s.MetaClient.CreateDatabase(s.conf.Database)
+		return err
+	})
+	if err == nil {
+		err = s.PointsWriter.WritePointRows(key.Database, key.RetentionPolicy, rows)
+	}
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.OpenTSDBPointsWriteFail, int64(len(rows)))
+		s.logger.Error("write OpenTSDB points failed", zap.String("db", key.Database),
+			zap.String("rp", key.RetentionPolicy), zap.Int("points", len(rows)), zap.Error(err))
+		return
+	}
+	atomic.AddInt64(&statistics.HandlerStat.OpenTSDBPointsWritten, int64(len(rows)))
+}
+
+// readerConn is a connection whose first bytes were read into r.
+type readerConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *readerConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// chanListener is a listener accepting the connections put to it.
+type chanListener struct {
+	addr    net.Addr
+	ch      chan net.Conn
+	closing chan struct{}
+	once    sync.Once
+}
+
+func newChanListener(addr net.Addr) *chanListener {
+	return &chanListener{
+		addr:    addr,
+		ch:      make(chan net.Conn),
+		closing: make(chan struct{}),
+	}
+}
+
+// put hands a connection to Accept, it returns false if the listener is closed.
+func (l *chanListener) put(conn net.Conn) bool {
+	select {
+	case l.ch <- conn:
+		return true
+	case <-l.closing:
+		return false
+	}
+}
+
+func (l *chanListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.ch:
+		return conn, nil
+	case <-l.closing:
+		return nil, errors.New("listener closed")
+	}
+}
+
+func (l *chanListener) Close() error {
+	l.once.Do(func() {
+		close(l.closing)
+	})
+	return nil
+}
+
+func (l *chanListener) Addr() net.Addr {
+	return l.addr
+}
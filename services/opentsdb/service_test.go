@@ -0,0 +1,140 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentsdb
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig() config.OpenTSDB {
+	c := config.NewOpenTSDB()
+	c.Enabled = true
+	c.BindAddress = "127.0.0.1:0"
+	c.BatchTimeout = toml.Duration(10 * time.Millisecond)
+	c.Rules = []config.OpenTSDBRule{
+		{Prefix: "sys", Measurement: "system"},
+		{Prefix: "sys.cpu", Measurement: "cpu"},
+	}
+	return c
+}
+
+func TestMapper_ParsePut(t *testing.T) {
+	m := newMapper(newTestConfig())
+
+	row, err := m.parsePut("put sys.cpu.user 1356998400 42.5 host=web01 cpu=0")
+	require.NoError(t, err)
+	assert.Equal(t, "cpu", row.Name)
+	assert.Equal(t, influx.PointTags{{Key: "cpu", Value: "0"}, {Key: "host", Value: "web01"}}, row.Tags)
+	assert.Equal(t, influx.Fields{{Key: "user", NumValue: 42.5, Type: influx.Field_Type_Float}}, row.Fields)
+	assert.Equal(t, int64(1356998400)*1e9, row.Timestamp)
+
+	row, err = m.parsePut("put sys.cpu 1356998400123 1")
+	require.NoError(t, err)
+	assert.Equal(t, "cpu", row.Name)
+	assert.Equal(t, "value", row.Fields[0].Key)
+	assert.Equal(t, int64(1356998400123)*1e6, row.Timestamp)
+
+	row, err = m.parsePut("put sys.cpus 1356998400 1")
+	require.NoError(t, err)
+	assert.Equal(t, "system", row.Name)
+	assert.Equal(t, "cpus", row.Fields[0].Key)
+
+	row, err = m.parsePut("put mem.free 1356998400 1")
+	require.NoError(t, err)
+	assert.Equal(t, "mem.free", row.Name)
+	assert.Equal(t, "value", row.Fields[0].Key)
+
+	for _, line := range []string{
+		"put sys.cpu 1356998400",
+		"put sys.cpu abc 1",
+		"put sys.cpu 1356998400 abc",
+		"put sys.cpu 1356998400 1 host",
+		"put sys.cpu 1356998400 1 host=",
+	} {
+		_, err = m.parsePut(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	points, err := parsePoints([]byte(`{"metric":"sys.cpu.user","timestamp":1356998400,"value":"18","tags":{"host":"web01"}}`))
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+
+	points, err = parsePoints([]byte(` [{"metric":"a","timestamp":1,"value":1.5}, {"metric":"b","timestamp":"2","value":2}]`))
+	require.NoError(t, err)
+	require.Len(t, points, 2)
+
+	m := newMapper(newTestConfig())
+	row, err := m.pointRow(&points[1])
+	require.NoError(t, err)
+	assert.Equal(t, "b", row.Name)
+	assert.Equal(t, int64(2e9), row.Timestamp)
+
+	_, err = parsePoints([]byte(`{"metric":`))
+	assert.Error(t, err)
+	_, err = parsePoints(nil)
+	assert.Error(t, err)
+}
+
+func TestService(t *testing.T) {
+	writer := &mocks.PointsWriter{}
+	s := NewService(newTestConfig())
+	s.MetaClient = &mocks.MetaClient{}
+	s.PointsWriter = writer
+	require.NoError(t, s.Open())
+	defer s.Close()
+
+	conn, err := net.Dial("tcp", s.Addr().String())
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("put sys.cpu.user 1356998400 42.5 host=web01\nput bad\nput mem.free 1356998400 1"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	body := `[{"metric":"sys.cpu.user","timestamp":1356998400,"value":1,"tags":{"host":"web02"}},{"metric":"","timestamp":1,"value":1}]`
+	resp, err := http.Post("http://"+s.Addr().String()+"/api/put?db=db1&rp=rp1", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post("http://"+s.Addr().String()+"/api/put?db=db1&rp=rp1", "application/json", strings.NewReader(body[1:strings.Index(body, "},")+1]))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	require.Eventually(t, func() bool {
+		return len(writer.Rows(config.DefaultOpenTSDBDatabase)) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	// the db parameter of /api/put does not override the configured database
+	assert.Empty(t, writer.Rows("db1"))
+	names := make([]string, 0, 4)
+	for _, row := range writer.Rows(config.DefaultOpenTSDBDatabase) {
+		names = append(names, row.Name)
+	}
+	assert.ElementsMatch(t, []string{"cpu", "mem.free", "cpu", "cpu"}, names)
+}
@@ -0,0 +1,354 @@
+/*
+Copyright (c) 2018 InfluxData
+This code is originally from: https://github.com/influxdata/influxdb/blob/1.7/services/subscriber/service.go
+
+2022.01.23 Forward the rows accepted by PointsWriter, buffer the writes of each destination
+and drop them once the buffer is full instead of blocking the writes.
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+*/
+
+package subscriber
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+// WriteRequest is a batch of rows written to a retention policy, encoded in line protocol.
+type WriteRequest struct {
+	Database        string
+	RetentionPolicy string
+	Data            []byte
+	Points          int
+}
+
+// PointsWriter writes the requests to a destination.
+type PointsWriter interface {
+	WritePoints(req *WriteRequest) error
+}
+
+// Service forwards the rows written to a retention policy to the destinations of its subscriptions.
+// The subscriptions are reloaded from meta periodically.
+type Service struct {
+	services.Base
+
+	MetaClient interface {
+		Databases() map[string]*meta.DatabaseInfo
+	}
+
+	conf config.Subscriber
+
+	mu   sync.RWMutex
+	subs map[subscriptionKey]*subscription
+	rps  map[rpKey][]*subscription
+
+	// newWriter creates the writer of a destination, replaced in tests
+	newWriter func(u *url.URL) (PointsWriter, error)
+}
+
+type rpKey struct {
+	database string
+	rp       string
+}
+
+type subscriptionKey struct {
+	rpKey
+	name string
+}
+
+func NewService(c config.Subscriber) *Service {
+	s := &Service{
+		conf: c,
+		subs: make(map[subscriptionKey]*subscription),
+		rps:  make(map[rpKey][]*subscription),
+	}
+	s.newWriter = s.defaultNewWriter
+	s.Init("subscriber", time.Duration(c.SyncInterval), s.handle)
+	return s
+}
+
+func (s *Service) Open() error {
+	s.handle()
+	return s.Base.Open()
+}
+
+func (s *Service) Close() error {
+	if err := s.Base.Close(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	subs := s.subs
+	s.subs = make(map[subscriptionKey]*subscription)
+	s.rps = make(map[rpKey][]*subscription)
+	s.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.close()
+	}
+	return nil
+}
+
+// Subscribed reports whether the rows written to the retention policy are forwarded.
+func (s *Service) Subscribed(database, retentionPolicy string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.rps[rpKey{database: database, rp: retentionPolicy}]) > 0
+}
+
+// Send forwards the rows to the subscriptions of the retention policy without waiting for the writes.
+func (s *Service) Send(database, retentionPolicy string, rows []*influx.Row) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subs := s.rps[rpKey{database: database, rp: retentionPolicy}]
+	if len(subs) == 0 || len(rows) == 0 {
+		return
+	}
+
+	req := &WriteRequest{
+		Database:        database,
+		RetentionPolicy: retentionPolicy,
+		Data:            AppendRows(nil, rows),
+		Points:          len(rows),
+	}
+	for _, sub := range subs {
+		sub.send(req)
+	}
+}
+
+// handle reloads the subscriptions, the destinations of an unchanged subscription are kept.
+func (s *Service) handle() {
+	wanted := make(map[subscriptionKey]*meta.SubscriptionInfo)
+	for _, db := range s.MetaClient.Databases() {
+		if db.MarkDeleted {
+			continue
+		}
+		for _, rp := range db.RetentionPolicies {
+			if rp.MarkDeleted {
+				continue
+			}
+			for i := range rp.Subscriptions {
+				key := subscriptionKey{rpKey: rpKey{database: db.Name, rp: rp.Name}, name: rp.Subscriptions[i].Name}
+				wanted[key] = &rp.Subscriptions[i]
+			}
+		}
+	}
+
+	s.mu.RLock()
+	changed := len(wanted) != len(s.subs)
+	for key, si := range wanted {
+		if sub, ok := s.subs[key]; !ok || !sub.equal(si) {
+			changed = true
+			break
+		}
+	}
+	s.mu.RUnlock()
+	if !changed {
+		return
+	}
+
+	subs := make(map[subscriptionKey]*subscription, len(wanted))
+	var closing []*subscription
+	s.mu.RLock()
+	for key, sub := range s.subs {
+		if si, ok := wanted[key]; ok && sub.equal(si) {
+			subs[key] = sub
+			continue
+		}
+		closing = append(closing, sub)
+	}
+	s.mu.RUnlock()
+
+	for key, si := range wanted {
+		if _, ok := subs[key]; ok {
+			continue
+		}
+		sub, err := s.newSubscription(key, si)
+		if err != nil {
+			s.Logger.Error("create subscription failed", zap.String("db", key.database),
+				zap.String("rp", key.rp), zap.String("name", key.name), zap.Error(err))
+			continue
+		}
+		subs[key] = sub
+	}
+
+	rps := make(map[rpKey][]*subscription)
+	for key, sub := range subs {
+		rps[key.rpKey] = append(rps[key.rpKey], sub)
+	}
+
+	s.mu.Lock()
+	s.subs, s.rps = subs, rps
+	s.mu.Unlock()
+
+	for _, sub := range closing {
+		sub.close()
+	}
+}
+
+func (s *Service) newSubscription(key subscriptionKey, si *meta.SubscriptionInfo) (*subscription, error) {
+	sub := &subscription{
+		key:   key,
+		mode:  si.Mode,
+		dests: make([]*destination, 0, len(si.Destinations)),
+	}
+	for _, dest := range si.Destinations {
+		u, err := url.Parse(dest)
+		if err != nil {
+			sub.close()
+			return nil, err
+		}
+		w, err := s.newWriter(u)
+		if err != nil {
+			sub.close()
+			return nil, err
+		}
+		sub.dests = append(sub.dests, newDestination(key, dest, w, s.conf.WriteBufferSize, s.conf.WriteConcurrency, s.Logger))
+	}
+	return sub, nil
+}
+
+func (s *Service) defaultNewWriter(u *url.URL) (PointsWriter, error) {
+	switch strings.ToLower(u.Scheme) {
+	case "udp":
+		return NewUDP(u.Host)
+	case "http", "https":
+		return NewHTTP(u, s.conf)
+	default:
+		return nil, fmt.Errorf("unknown destination scheme %s", u.Scheme)
+	}
+}
+
+// subscription dispatches the writes to its destinations by its mode.
+type subscription struct {
+	key   subscriptionKey
+	mode  string
+	dests []*destination
+	next  uint32
+}
+
+func (sub *subscription) equal(si *meta.SubscriptionInfo) bool {
+	if sub.mode != si.Mode || len(sub.dests) != len(si.Destinations) {
+		return false
+	}
+	for i := range sub.dests {
+		if sub.dests[i].url != si.Destinations[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (sub *subscription) send(req *WriteRequest) {
+	if len(sub.dests) == 0 {
+		return
+	}
+
+	if sub.mode == meta.SubscriptionModeAll {
+		for _, d := range sub.dests {
+			if !d.trySend(req) {
+				atomic.AddInt64(&d.stat.WritesDropped, 1)
+			}
+		}
+		return
+	}
+
+	// ANY: write to the destinations in turn, skip the ones whose buffer is full
+	start := int(atomic.AddUint32(&sub.next, 1)) % len(sub.dests)
+	for i := range sub.dests {
+		if sub.dests[(start+i)%len(sub.dests)].trySend(req) {
+			return
+		}
+	}
+	atomic.AddInt64(&sub.dests[start].stat.WritesDropped, 1)
+}
+
+func (sub *subscription) close() {
+	for _, d := range sub.dests {
+		d.close()
+	}
+}
+
+// destination writes the buffered requests by a fixed number of goroutines.
+type destination struct {
+	key    subscriptionKey
+	url    string
+	writer PointsWriter
+	stat   *statistics.SubscriberDestStats
+	logger *logger.Logger
+
+	ch      chan *WriteRequest
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newDestination(key subscriptionKey, dest string, w PointsWriter, bufferSize, concurrency int, lg *logger.Logger) *destination {
+	d := &destination{
+		key:     key,
+		url:     dest,
+		writer:  w,
+		stat:    statistics.SubscriberStat.Register(key.database, key.rp, key.name, dest),
+		logger:  lg,
+		ch:      make(chan *WriteRequest, bufferSize),
+		closing: make(chan struct{}),
+	}
+	d.wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go d.run()
+	}
+	return d
+}
+
+func (d *destination) trySend(req *WriteRequest) bool {
+	select {
+	case d.ch <- req:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *destination) run() {
+	defer d.wg.Done()
+	for {
+		select {
+		case req := <-d.ch:
+			d.write(req)
+		case <-d.closing:
+			return
+		}
+	}
+}
+
+func (d *destination) write(req *WriteRequest) {
+	if err := d.writer.WritePoints(req); err != nil {
+		atomic.AddInt64(&d.stat.WriteFailures, 1)
+		d.logger.Warn("write to subscription destination failed", zap.String("name", d.key.name),
+			zap.String("destination", d.url), zap.Error(err))
+		return
+	}
+	atomic.AddInt64(&d.stat.WritesOK, 1)
+	atomic.AddInt64(&d.stat.PointsWritten, int64(req.Points))
+}
+
+func (d *destination) close() {
+	close(d.closing)
+	d.wg.Wait()
+	if c, ok := d.writer.(interface{ Close() error }); ok {
+		_ = c.Close()
+	}
+	statistics.SubscriberStat.Unregister(d.key.database, d.key.rp, d.key.name, d.url)
+}
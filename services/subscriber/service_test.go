@@ -0,0 +1,224 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriber
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct {
+	dbs map[string]*meta.DatabaseInfo
+}
+
+func (c *mockMetaClient) Databases() map[string]*meta.DatabaseInfo {
+	return c.dbs
+}
+
+func newMetaClient(mode string, dests ...string) *mockMetaClient {
+	return &mockMetaClient{dbs: map[string]*meta.DatabaseInfo{
+		"db0": {
+			Name: "db0",
+			RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+				"rp0": {
+					Name:          "rp0",
+					Subscriptions: []meta.SubscriptionInfo{{Name: "sub0", Mode: mode, Destinations: dests}},
+				},
+			},
+		},
+	}}
+}
+
+type blockingWriter struct {
+	unblock chan struct{}
+	written int64
+}
+
+func (w *blockingWriter) WritePoints(req *WriteRequest) error {
+	<-w.unblock
+	atomic.AddInt64(&w.written, 1)
+	return nil
+}
+
+func testRows() []*influx.Row {
+	return []*influx.Row{{
+		Name: "cpu load",
+		Tags: influx.PointTags{{Key: "host", Value: "a=1,b"}},
+		Fields: influx.Fields{
+			{Key: "value", NumValue: 1.5, Type: influx.Field_Type_Float},
+			{Key: "count", NumValue: 3, Type: influx.Field_Type_Int},
+			{Key: "ok", NumValue: 1, Type: influx.Field_Type_Boolean},
+			{Key: "msg", StrValue: `say "hi"`, Type: influx.Field_Type_String},
+		},
+		Timestamp: 100,
+	}}
+}
+
+func TestAppendRows(t *testing.T) {
+	require.Equal(t, "cpu\\ load,host=a\\=1\\,b value=1.5,count=3i,ok=true,msg=\"say \\\"hi\\\"\" 100\n",
+		string(AppendRows(nil, testRows())))
+}
+
+func TestService_HTTP(t *testing.T) {
+	received := make(chan *http.Request, 4)
+	bodies := make(chan string, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	u.User = url.UserPassword("user", "pwd")
+	s := NewService(config.NewSubscriber())
+	s.MetaClient = newMetaClient(meta.SubscriptionModeAll, u.String())
+	require.NoError(t, s.Open())
+	defer s.Close()
+
+	require.True(t, s.Subscribed("db0", "rp0"))
+	require.False(t, s.Subscribed("db0", "autogen"))
+	s.Send("db0", "rp0", testRows())
+
+	select {
+	case r := <-received:
+		require.Equal(t, "/write", r.URL.Path)
+		require.Equal(t, "db0", r.URL.Query().Get("db"))
+		require.Equal(t, "rp0", r.URL.Query().Get("rp"))
+		username, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "user", username)
+		require.Equal(t, "pwd", password)
+		require.Equal(t, string(AppendRows(nil, testRows())), <-bodies)
+	case <-time.After(5 * time.Second):
+		t.Fatal("write is not forwarded")
+	}
+
+	stat := statistics.SubscriberStat.Register("db0", "rp0", "sub0", u.String())
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&stat.WritesOK) == 1 && atomic.LoadInt64(&stat.PointsWritten) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestService_UDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	s := NewService(config.NewSubscriber())
+	s.MetaClient = newMetaClient(meta.SubscriptionModeAny, "udp://"+conn.LocalAddr().String())
+	require.NoError(t, s.Open())
+	defer s.Close()
+
+	s.Send("db0", "rp0", testRows())
+
+	buf := make([]byte, maxUDPPayload)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Equal(t, string(AppendRows(nil, testRows())), string(buf[:n]))
+}
+
+func TestService_Modes(t *testing.T) {
+	conf := config.NewSubscriber()
+	conf.WriteConcurrency = 1
+	conf.WriteBufferSize = 1
+
+	writers := map[string]*blockingWriter{
+		"udp://a:1": {unblock: make(chan struct{})},
+		"udp://b:1": {unblock: make(chan struct{})},
+	}
+	newService := func(mode string) *Service {
+		s := NewService(conf)
+		s.newWriter = func(u *url.URL) (PointsWriter, error) {
+			return writers[u.String()], nil
+		}
+		s.MetaClient = newMetaClient(mode, "udp://a:1", "udp://b:1")
+		require.NoError(t, s.Open())
+		return s
+	}
+
+	// ANY: each write goes to one destination, it is dropped once every buffer is full
+	s := newService(meta.SubscriptionModeAny)
+	sub := s.subs[subscriptionKey{rpKey: rpKey{database: "db0", rp: "rp0"}, name: "sub0"}]
+	s.Send("db0", "rp0", testRows())
+	s.Send("db0", "rp0", testRows())
+	require.Eventually(t, func() bool {
+		return len(sub.dests[0].ch) == 0 && len(sub.dests[1].ch) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		s.Send("db0", "rp0", testRows())
+	}
+	statA := statistics.SubscriberStat.Register("db0", "rp0", "sub0", "udp://a:1")
+	statB := statistics.SubscriberStat.Register("db0", "rp0", "sub0", "udp://b:1")
+	require.Equal(t, int64(1), atomic.LoadInt64(&statA.WritesDropped)+atomic.LoadInt64(&statB.WritesDropped))
+	for _, w := range writers {
+		close(w.unblock)
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&writers["udp://a:1"].written)+atomic.LoadInt64(&writers["udp://b:1"].written) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, s.Close())
+
+	// ALL: each write goes to every destination
+	for k := range writers {
+		writers[k] = &blockingWriter{unblock: make(chan struct{})}
+		close(writers[k].unblock)
+	}
+	s = newService(meta.SubscriptionModeAll)
+	defer s.Close()
+	s.Send("db0", "rp0", testRows())
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&writers["udp://a:1"].written) == 1 && atomic.LoadInt64(&writers["udp://b:1"].written) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestService_Reload(t *testing.T) {
+	s := NewService(config.NewSubscriber())
+	s.newWriter = func(u *url.URL) (PointsWriter, error) {
+		return &blockingWriter{unblock: make(chan struct{})}, nil
+	}
+	mc := newMetaClient(meta.SubscriptionModeAll, "udp://a:1")
+	s.MetaClient = mc
+	require.NoError(t, s.Open())
+	defer s.Close()
+
+	sub := s.subs[subscriptionKey{rpKey: rpKey{database: "db0", rp: "rp0"}, name: "sub0"}]
+	require.NotNil(t, sub)
+
+	// unchanged subscriptions are kept
+	s.handle()
+	require.Same(t, sub, s.subs[subscriptionKey{rpKey: rpKey{database: "db0", rp: "rp0"}, name: "sub0"}])
+
+	mc.dbs["db0"].MarkDeleted = true
+	s.handle()
+	require.False(t, s.Subscribed("db0", "rp0"))
+	require.Empty(t, s.subs)
+}
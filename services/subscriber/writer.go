@@ -0,0 +1,207 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriber
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+// maxUDPPayload is the max size of a datagram written to a UDP destination
+const maxUDPPayload = 64 * 1024
+
+// HTTP writes to the /write endpoint of an HTTP destination.
+type HTTP struct {
+	url      string
+	username string
+	password string
+	client   *http.Client
+}
+
+func NewHTTP(u *url.URL, c config.Subscriber) (*HTTP, error) {
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify},
+	}
+	if c.CaCerts != "" {
+		pem, err := ioutil.ReadFile(c.CaCerts)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate in %s", c.CaCerts)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	w := &HTTP{
+		client: &http.Client{Timeout: time.Duration(c.HTTPTimeout), Transport: transport},
+	}
+	if u.User != nil {
+		w.username = u.User.Username()
+		w.password, _ = u.User.Password()
+	}
+	dst := *u
+	dst.User = nil
+	dst.Path += "/write"
+	w.url = dst.String()
+	return w, nil
+}
+
+func (w *HTTP) WritePoints(req *WriteRequest) error {
+	params := url.Values{}
+	params.Set("db", req.Database)
+	params.Set("rp", req.RetentionPolicy)
+
+	r, err := http.NewRequest(http.MethodPost, w.url+"?"+params.Encode(), bytes.NewReader(req.Data))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if w.username != "" {
+		r.SetBasicAuth(w.username, w.password)
+	}
+
+	resp, err := w.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func (w *HTTP) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// UDP writes the lines to a UDP destination, a datagram carries whole lines only.
+type UDP struct {
+	conn net.Conn
+}
+
+func NewUDP(addr string) (*UDP, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &UDP{conn: conn}, nil
+}
+
+func (w *UDP) WritePoints(req *WriteRequest) error {
+	data := req.Data
+	for len(data) > 0 {
+		n := len(data)
+		if n > maxUDPPayload {
+			n = bytes.LastIndexByte(data[:maxUDPPayload], '\n') + 1
+			if n == 0 {
+				return fmt.Errorf("line exceeds the max UDP payload %d", maxUDPPayload)
+			}
+		}
+		if _, err := w.conn.Write(data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+func (w *UDP) Close() error {
+	return w.conn.Close()
+}
+
+// AppendRows appends the rows to dst in line protocol, one line each.
+func AppendRows(dst []byte, rows []*influx.Row) []byte {
+	for _, r := range rows {
+		dst = appendEscaped(dst, r.Name, &measurementEscaper)
+		for i := range r.Tags {
+			dst = append(dst, ',')
+			dst = appendEscaped(dst, r.Tags[i].Key, &keyEscaper)
+			dst = append(dst, '=')
+			dst = appendEscaped(dst, r.Tags[i].Value, &keyEscaper)
+		}
+		for i := range r.Fields {
+			if i == 0 {
+				dst = append(dst, ' ')
+			} else {
+				dst = append(dst, ',')
+			}
+			dst = appendField(dst, &r.Fields[i])
+		}
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, r.Timestamp, 10)
+		dst = append(dst, '\n')
+	}
+	return dst
+}
+
+func appendField(dst []byte, f *influx.Field) []byte {
+	dst = appendEscaped(dst, f.Key, &keyEscaper)
+	dst = append(dst, '=')
+	switch f.Type {
+	case influx.Field_Type_Int:
+		dst = strconv.AppendInt(dst, int64(f.NumValue), 10)
+		dst = append(dst, 'i')
+	case influx.Field_Type_UInt:
+		dst = strconv.AppendUint(dst, f.UintValue, 10)
+		dst = append(dst, 'u')
+	case influx.Field_Type_Boolean:
+		dst = strconv.AppendBool(dst, f.NumValue != 0)
+	case influx.Field_Type_String:
+		dst = append(dst, '"')
+		dst = appendEscaped(dst, f.StrValue, &stringEscaper)
+		dst = append(dst, '"')
+	default:
+		dst = strconv.AppendFloat(dst, f.NumValue, 'g', -1, 64)
+	}
+	return dst
+}
+
+var (
+	measurementEscaper = [256]bool{',': true, ' ': true}
+	keyEscaper         = [256]bool{',': true, '=': true, ' ': true}
+	stringEscaper      = [256]bool{'"': true, '\\': true}
+)
+
+func appendEscaped(dst []byte, s string, escaper *[256]bool) []byte {
+	for i := 0; i < len(s); i++ {
+		if escaper[s[i]] {
+			dst = append(dst, '\\')
+		}
+		dst = append(dst, s[i])
+	}
+	return dst
+}
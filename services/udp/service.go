@@ -0,0 +1,242 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udp
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/open_src/influx/meta"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+const (
+	// the largest UDP payload
+	maxUDPPayload = 64 * 1024
+
+	// larger than a datagram, so that a datagram is parsed in one block
+	readBlockSize = 2 * maxUDPPayload
+)
+
+// Service receives the line protocol in UDP datagrams and writes the points in batches to the database of its
+// config. The datagrams are parsed by the unmarshal workers of the HTTP service, which must be started before the
+// service is opened and stopped after it is closed.
+type Service struct {
+	MetaClient interface {
+		CreateDatabase(name string) (*meta2.DatabaseInfo, error)
+	}
+
+	PointsWriter interface {
+		WritePointRows(database, retentionPolicy string, rows []influx.Row) error
+	}
+
+	conf         config.UDP
+	tsMultiplier int64
+	batcher      *services.RowBatcher
+	key          services.BatchKey
+
+	conn    *net.UDPConn
+	packets chan []byte
+
+	dbCreator services.DatabaseCreator
+
+	// wg tracks the reading and the parsing goroutines, pending the datagrams scheduled to the unmarshal workers
+	wg      sync.WaitGroup
+	pending sync.WaitGroup
+	logger  *logger.Logger
+}
+
+// NewService returns a listener for the config, the unset options take their defaults.
+func NewService(c config.UDP) *Service {
+	c = c.WithDefaults()
+	s := &Service{
+		conf:         c,
+		tsMultiplier: precisionMultiplier(c.Precision),
+		key:          services.BatchKey{Database: c.Database, RetentionPolicy: c.RetentionPolicy},
+		logger: logger.NewLogger(errno.ModuleWrite).With(zap.String("service", "udp"),
+			zap.String("addr", c.BindAddress)),
+	}
+	s.batcher = services.NewRowBatcher(c.BatchSize, time.Duration(c.BatchTimeout), s.writeRows)
+	return s
+}
+
+// precisionMultiplier returns the multiplier of the timestamps to nanoseconds.
+func precisionMultiplier(precision string) int64 {
+	switch precision {
+	case "u", "us":
+		return 1e3
+	case "ms":
+		return 1e6
+	case "s":
+		return 1e9
+	case "m":
+		return 1e9 * 60
+	case "h":
+		return 1e9 * 3600
+	}
+	return 1
+}
+
+// Open starts listening on the bind address.
+func (s *Service) Open() error {
+	addr, err := net.ResolveUDPAddr("udp", s.conf.BindAddress)
+	if err != nil {
+		return err
+	}
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return err
+	}
+	if s.conf.ReadBuffer > 0 {
+		if err = conn.SetReadBuffer(int(s.conf.ReadBuffer)); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+	s.conn = conn
+	s.packets = make(chan []byte, s.conf.PendingPackets)
+	s.logger.Info("Listening on UDP", zap.String("local", conn.LocalAddr().String()))
+
+	s.batcher.Start()
+	s.wg.Add(2)
+	go s.serve()
+	go s.parse()
+	return nil
+}
+
+// Close closes the listener, parses the datagrams waiting and writes the pending points.
+func (s *Service) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.wg.Wait()
+	s.pending.Wait()
+	s.batcher.Stop()
+	return err
+}
+
+// Addr returns the address the service listens on.
+func (s *Service) Addr() net.Addr {
+	return s.conn.LocalAddr()
+}
+
+// serve reads the datagrams and queues them to be parsed, they are dropped when the queue is full.
+func (s *Service) serve() {
+	defer s.wg.Done()
+	defer close(s.packets)
+	buf := make([]byte, maxUDPPayload)
+	for {
+		n, _, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+		atomic.AddInt64(&statistics.HandlerStat.UDPPacketsReceived, 1)
+		atomic.AddInt64(&statistics.HandlerStat.UDPBytesReceived, int64(n))
+
+		packet := make([]byte, n)
+		copy(packet, buf[:n])
+		select {
+		case s.packets <- packet:
+		default:
+			atomic.AddInt64(&statistics.HandlerStat.UDPPacketsDropped, 1)
+		}
+	}
+}
+
+func (s *Service) parse() {
+	defer s.wg.Done()
+	for packet := range s.packets {
+		s.parsePacket(packet)
+	}
+}
+
+func (s *Service) parsePacket(packet []byte) {
+	ctx := influx.GetStreamContext(bytes.NewReader(packet))
+	defer influx.PutStreamContext(ctx)
+
+	for ctx.Read(readBlockSize) {
+		uw := influx.GetUnmarshalWork()
+		uw.Callback = func(db string, rows []influx.Row, err error) {
+			defer s.pending.Done()
+			if err != nil {
+				atomic.AddInt64(&statistics.HandlerStat.UDPPacketsParseFail, 1)
+				if s.conf.LogPointErrors {
+					s.logger.Info("bad UDP datagram", zap.ByteString("packet", uw.ReqBuf), zap.Error(err))
+				}
+				return
+			}
+			s.batcher.Add(s.key, cloneRows(rows)...)
+			// the names, the keys and the values of the rows point into the buffer, keep it from being reused
+			uw.ReqBuf = nil
+		}
+		uw.TsMultiplier = s.tsMultiplier
+		uw.Db = s.conf.Database
+		uw.ReqBuf, ctx.ReqBuf = ctx.ReqBuf, uw.ReqBuf
+		s.pending.Add(1)
+		influx.ScheduleUnmarshalWork(uw)
+	}
+	if err := ctx.Error(); err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.UDPPacketsParseFail, 1)
+		if s.conf.LogPointErrors {
+			s.logger.Info("bad UDP datagram", zap.Error(err))
+		}
+	}
+}
+
+// cloneRows copies the rows out of the pools of the unmarshal work, which are reused once the callback returns.
+func cloneRows(rows []influx.Row) []influx.Row {
+	dst := make([]influx.Row, len(rows))
+	for i := range rows {
+		dst[i].Clone(&rows[i])
+		dst[i].Tags = append(influx.PointTags(nil), rows[i].Tags...)
+		dst[i].Fields = append(influx.Fields(nil), rows[i].Fields...)
+		dst[i].ShardKey = nil
+		dst[i].IndexKey = nil
+	}
+	return dst
+}
+
+// writeRows writes a batch, the database is created before the first batch.
+func (s *Service) writeRows(key services.BatchKey, rows []influx.Row) {
+	err := s.dbCreator.Create(func() error {
+		_, err := s.MetaClient.CreateDatabase(s.conf.Database)
+		return err
+	})
+	if err == nil {
+		err = s.PointsWriter.WritePointRows(key.Database, key.RetentionPolicy, rows)
+	}
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.UDPPointsWriteFail, int64(len(rows)))
+		s.logger.Error("write UDP points failed", zap.String("db", key.Database),
+			zap.String("rp", key.RetentionPolicy), zap.Int("points", len(rows)), zap.Error(err))
+		return
+	}
+	atomic.AddInt64(&statistics.HandlerStat.UDPPointsWritten, int64(len(rows)))
+}
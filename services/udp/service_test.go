@@ -0,0 +1,84 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udp
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	influx.StartUnmarshalWorkers()
+	defer influx.StopUnmarshalWorkers()
+
+	writer := &mocks.PointsWriter{}
+	s := NewService(config.UDP{
+		Enabled:      true,
+		BindAddress:  "127.0.0.1:0",
+		Precision:    "s",
+		BatchTimeout: toml.Duration(10 * time.Millisecond),
+	})
+	s.MetaClient = &mocks.MetaClient{}
+	s.PointsWriter = writer
+	require.NoError(t, s.Open())
+
+	parseFail := atomic.LoadInt64(&statistics.HandlerStat.UDPPacketsParseFail)
+	conn, err := net.Dial("udp", s.Addr().String())
+	require.NoError(t, err)
+	for _, packet := range []string{
+		"cpu,host=a value=1 1356998400\nmem,host=b free=2i 1356998400\n",
+		"disk used=3",
+		"bad line",
+	} {
+		_, err = conn.Write([]byte(packet))
+		require.NoError(t, err)
+	}
+	require.NoError(t, conn.Close())
+
+	require.Eventually(t, func() bool {
+		return len(writer.Rows(config.DefaultUDPDatabase)) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&statistics.HandlerStat.UDPPacketsParseFail) == parseFail+1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, s.Close())
+
+	rows := make(map[string]influx.Row)
+	for _, row := range writer.Rows(config.DefaultUDPDatabase) {
+		rows[row.Name] = row
+	}
+	assert.Equal(t, influx.PointTags{{Key: "host", Value: "a"}}, rows["cpu"].Tags)
+	assert.Equal(t, int64(1356998400)*1e9, rows["cpu"].Timestamp)
+	assert.Equal(t, "free", rows["mem"].Fields[0].Key)
+	assert.NotZero(t, rows["disk"].Timestamp)
+}
+
+func TestPrecisionMultiplier(t *testing.T) {
+	assert.Equal(t, int64(1), precisionMultiplier(""))
+	assert.Equal(t, int64(1e6), precisionMultiplier("ms"))
+	assert.Equal(t, int64(3600e9), precisionMultiplier("h"))
+}
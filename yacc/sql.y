@@ -34,6 +34,47 @@ func setParseTree(yylex interface{}, stmts influxql.Statements) {
     }
 }
 
+// checkJoinSources reports an error if a statement other than SELECT reads from a join.
+func checkJoinSources(yylex interface{}, stmts influxql.Statements) {
+    for _, stmt := range stmts {
+        switch stmt.(type) {
+        case *influxql.SelectStatement, *influxql.CreateContinuousQueryStatement, *influxql.ExplainStatement:
+            continue
+        }
+        influxql.WalkFunc(stmt, func(n influxql.Node) {
+            if _, ok := n.(*influxql.Join); ok {
+                yylex.(*YyParser).Error("FULL OUTER JOIN is only supported in SELECT statements")
+            }
+        })
+    }
+}
+
+// databasePrivilege returns the privilege of a GRANT or REVOKE statement on the database of a user. Users are only
+// granted privileges on whole databases, the finer scopes are granted to roles.
+func databasePrivilege(yylex interface{}, priv string, on *influxql.PrivilegeScope) influxql.Privilege {
+    if on.Database == "" || on.RetentionPolicy != "" || on.Measurement != "" || on.Regex != nil {
+        yylex.(*YyParser).Error("privileges on retention policies and measurements can only be granted to roles")
+    }
+    switch strings.ToLower(priv) {
+    case "read":
+        return influxql.ReadPrivilege
+    case "write":
+        return influxql.WritePrivilege
+    case "all":
+        return influxql.AllPrivileges
+    }
+    yylex.(*YyParser).Error("wrong Privilege")
+    return influxql.NoPrivileges
+}
+
+func rolePrivilege(yylex interface{}, priv string) influxql.RolePrivilege {
+    p, ok := influxql.ParseRolePrivilege(priv)
+    if !ok {
+        yylex.(*YyParser).Error("wrong Privilege")
+    }
+    return p
+}
+
 func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
 	switch fill.(type){
 	case string:
@@ -72,6 +113,9 @@ func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
     sortfs              influxql.SortFields
     sortf               *influxql.SortField
     ment                *influxql.Measurement
+    source              influxql.Source
+    joins               []*influxql.Join
+    join                *influxql.Join
     subQuery            *influxql.SubQuery
     dimens              influxql.Dimensions
     dimen               *influxql.Dimension
@@ -90,6 +134,12 @@ func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
     strSlice            []string
     location            *time.Location
     indexType           *IndexType
+    target              *influxql.Target
+    cqsp                *cqSamplePolicyInfo
+    dsCalls             []*influxql.DownSampleCall
+    dsCall              *influxql.DownSampleCall
+    durationSlice       []time.Duration
+    scope               *influxql.PrivilegeScope
 }
 
 %token <str>    FROM MEASUREMENT ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -113,6 +163,13 @@ func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
 %left  <int>  MUL DIV MOD BITWISE_AND
 %right UMINUS
 
+%token <str>    INTO COLON
+%token <str>    BEGIN RESAMPLE EVERY
+%token <str>    DOWNSAMPLE DOWNSAMPLES SAMPLEINTERVAL TIMEINTERVAL
+%token <int>    MATCH
+%token <str>    ANY DESTINATIONS
+%token <str>    ROLE ROLES
+
 %type <stmt>                        STATEMENT SHOW_DATABASES_STATEMENT CREATE_DATABASE_STATEMENT WITH_CLAUSES CREATE_USER_STATEMENT
                                     SELECT_STATEMENT SHOW_MEASUREMENTS_STATEMENT SHOW_RETENTION_POLICIES_STATEMENT
                                     CREATE_RENTRENTION_POLICY_STATEMENT RP_DURATION_OPTIONS SHOW_SERIES_STATEMENT
@@ -121,14 +178,22 @@ func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
                                     DROP_RETENTION_POLICY_STATEMENT DROP_USER_STATEMENT GRANT_STATEMENT REVOKE_STATEMENT
                                     GRANT_ADMIN_STATEMENT REVOKE_ADMIN_STATEMENT SHOW_TAG_KEYS_STATEMENT SHOW_FIELD_KEYS_STATEMENT SHOW_TAG_VALUES_STATEMENT
                                     TAG_VALUES_WITH  EXPLAIN_STATEMENT SHOW_TAG_KEY_CARDINALITY_STATEMENT SHOW_TAG_VALUES_CARDINALITY_STATEMENT
+                                    SHOW_TAG_CARDINALITY_STATEMENT
                                     SHOW_FIELD_KEY_CARDINALITY_STATEMENT CREATE_MEASUREMENT_STATEMENT DROP_SHARD_STATEMENT SET_PASSWORD_USER_STATEMENT
                                     SHOW_GRANTS_FOR_USER_STATEMENT SHOW_MEASUREMENT_CARDINALITY_STATEMENT SHOW_SERIES_CARDINALITY_STATEMENT SHOW_SHARDS_STATEMENT
                                     ALTER_SHARD_KEY_STATEMENT SHOW_SHARD_GROUPS_STATEMENT DROP_MEASUREMENT_STATEMENT
+                                    CREATE_CONTINUOUS_QUERY_STATEMENT DROP_CONTINUOUS_QUERY_STATEMENT SHOW_CONTINUOUS_QUERIES_STATEMENT
+                                    CREATE_DOWNSAMPLE_STATEMENT DROP_DOWNSAMPLE_STATEMENT SHOW_DOWNSAMPLES_STATEMENT
+                                    CREATE_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTIONS_STATEMENT
+                                    CREATE_ROLE_STATEMENT DROP_ROLE_STATEMENT SHOW_ROLES_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
 %type <sources>                     FROM_CLAUSE TABLE_NAMES SUBQUERY_CLAUSE
-%type <ment>                        TABLE_OPTION JOIN_CLAUSES JOIN_CLAUSE TABLE_NAME_WITH_OPTION TABLE_CASE MEASUREMENT_WITH
+%type <ment>                        TABLE_OPTION TABLE_CASE MEASUREMENT_WITH
+%type <source>                      TABLE_NAME_WITH_OPTION
+%type <joins>                       JOIN_CLAUSES
+%type <join>                        JOIN_CLAUSE
 %type <expr>                        WHERE_CLAUSE CONDITION OPERATION_EQUAL COLUMN_VAREF COLUMN CONDITION_COLUMN TAG_KEYS
 				    CASE_WHEN_CASE CASE_WHEN_CASES
 %type <int>                         CONDITION_OPERATOR
@@ -144,11 +209,23 @@ func deal_Fill (fill interface{})  (influxql.FillOption , interface{},bool) {
 %type <strSlice>                    SHARDKEYLIST INDEX_LIST
 %type <location>                    TIME_ZONE
 %type <indexType>                   INDEX_TYPE INDEX_TYPES
+%type <target>                      INTO_CLAUSE
+%type <ment>                        TARGET_MEASUREMENT TARGET_NAME
+%type <cqsp>                        SAMPLE_POLICY
+%type <strSlice>                    DOWNSAMPLE_ON DOWNSAMPLE_OPS
+%type <dsCalls>                     DOWNSAMPLE_CALLS
+%type <dsCall>                      DOWNSAMPLE_CALL
+%type <durationSlice>               DURATIONS
+%type <str>                         SUBSCRIPTION_MODE
+%type <strSlice>                    SUBSCRIPTION_DESTINATIONS
+%type <str>                         GRANT_PRIVILEGE SCOPE_RETENTION_POLICY
+%type <scope>                       PRIVILEGE_SCOPE
 %%
 
 ALL_QUERIES:
         ALL_QUERY
         {
+            checkJoinSources(yylex, $1)
             setParseTree(yylex, $1)
         }
 
@@ -250,6 +327,18 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |CREATE_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DROP_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_ROLES_STATEMENT
+    {
+    	$$ = $1
+    }
     |SHOW_TAG_KEYS_STATEMENT
     {
         $$ = $1
@@ -270,6 +359,10 @@ STATEMENT:
     {
         $$ = $1
     }
+    |SHOW_TAG_CARDINALITY_STATEMENT
+    {
+        $$ = $1
+    }
     |SHOW_TAG_VALUES_CARDINALITY_STATEMENT
     {
         $$ = $1
@@ -318,24 +411,61 @@ STATEMENT:
     {
         $$ = $1
     }
+    |CREATE_CONTINUOUS_QUERY_STATEMENT
+    {
+        $$ = $1
+    }
+    |DROP_CONTINUOUS_QUERY_STATEMENT
+    {
+        $$ = $1
+    }
+    |SHOW_CONTINUOUS_QUERIES_STATEMENT
+    {
+        $$ = $1
+    }
+    |CREATE_DOWNSAMPLE_STATEMENT
+    {
+        $$ = $1
+    }
+    |DROP_DOWNSAMPLE_STATEMENT
+    {
+        $$ = $1
+    }
+    |SHOW_DOWNSAMPLES_STATEMENT
+    {
+        $$ = $1
+    }
+    |CREATE_SUBSCRIPTION_STATEMENT
+    {
+        $$ = $1
+    }
+    |DROP_SUBSCRIPTION_STATEMENT
+    {
+        $$ = $1
+    }
+    |SHOW_SUBSCRIPTIONS_STATEMENT
+    {
+        $$ = $1
+    }
 
 
 
 SELECT_STATEMENT:
-    SELECT COLUMN_CLAUSES FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
+    SELECT COLUMN_CLAUSES INTO_CLAUSE FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
     {
         stmt := &influxql.SelectStatement{}
         stmt.Fields = $2
-        stmt.Sources = $3
-        stmt.Dimensions = $5
-        stmt.Condition = $4
-        stmt.SortFields = $7
-        stmt.Limit = $8[0]
-        stmt.Offset = $8[1]
-        stmt.SLimit = $8[2]
-        stmt.SOffset = $8[3]
+        stmt.Target = $3
+        stmt.Sources = $4
+        stmt.Dimensions = $6
+        stmt.Condition = $5
+        stmt.SortFields = $8
+        stmt.Limit = $9[0]
+        stmt.Offset = $9[1]
+        stmt.SLimit = $9[2]
+        stmt.SOffset = $9[3]
 
-        tempfill,tempfillvalue,fillflag := deal_Fill($6)
+        tempfill,tempfillvalue,fillflag := deal_Fill($7)
         if fillflag==false{
             yylex.Error("Invalid characters in fill")
         }else{
@@ -347,24 +477,25 @@ SELECT_STATEMENT:
 			stmt.IsRawQuery = false
 		}
 	})
-        stmt.Location = $9
+        stmt.Location = $10
         $$ = stmt
     }
-    |SELECT HINT COLUMN_CLAUSES FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
+    |SELECT HINT COLUMN_CLAUSES INTO_CLAUSE FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
     {
         stmt := &influxql.SelectStatement{}
         stmt.Hints = $2
         stmt.Fields = $3
-        stmt.Sources = $4
-        stmt.Dimensions = $6
-        stmt.Condition = $5
-        stmt.SortFields = $8
-        stmt.Limit = $9[0]
-        stmt.Offset = $9[1]
-        stmt.SLimit = $9[2]
-        stmt.SOffset = $9[3]
+        stmt.Target = $4
+        stmt.Sources = $5
+        stmt.Dimensions = $7
+        stmt.Condition = $6
+        stmt.SortFields = $9
+        stmt.Limit = $10[0]
+        stmt.Offset = $10[1]
+        stmt.SLimit = $10[2]
+        stmt.SOffset = $10[3]
 
-        tempfill,tempfillvalue,fillflag := deal_Fill($7)
+        tempfill,tempfillvalue,fillflag := deal_Fill($8)
         if fillflag==false{
             yylex.Error("Invalid characters in fill")
         }else{
@@ -376,12 +507,63 @@ SELECT_STATEMENT:
 			stmt.IsRawQuery = false
 		}
 	})
-        stmt.Location = $10
+        stmt.Location = $11
         $$ = stmt
     }
 
 
 
+INTO_CLAUSE:
+    INTO TARGET_MEASUREMENT
+    {
+        $$ = &influxql.Target{Measurement: $2}
+    }
+    |
+    {
+        $$ = nil
+    }
+
+TARGET_MEASUREMENT:
+    IDENT DOT IDENT DOT TARGET_NAME
+    {
+        mst := $5
+        mst.Database = $1
+        mst.RetentionPolicy = $3
+        $$ = mst
+    }
+    |IDENT DOT DOT TARGET_NAME
+    {
+        mst := $4
+        mst.Database = $1
+        $$ = mst
+    }
+    |IDENT DOT TARGET_NAME
+    {
+        mst := $3
+        mst.RetentionPolicy = $1
+        $$ = mst
+    }
+    |TARGET_NAME
+    {
+        $$ = $1
+    }
+
+TARGET_NAME:
+    IDENT
+    {
+        $$ = &influxql.Measurement{Name: $1, IsTarget: true}
+    }
+    |STRING
+    {
+        $$ = &influxql.Measurement{Name: $1, IsTarget: true}
+    }
+    |COLON MEASUREMENT
+    {
+        $$ = &influxql.Measurement{IsTarget: true}
+    }
+
+
+
 COLUMN_CLAUSES:
     COLUMN_CLAUSE
     {
@@ -577,7 +759,12 @@ SUBQUERY_CLAUSE:
 TABLE_NAME_WITH_OPTION:
     TABLE_CASE JOIN_CLAUSES
     {
-        $$ = $1
+        var src influxql.Source = $1
+        for _, join := range $2 {
+            join.LSrc = src
+            src = join
+        }
+        $$ = src
     }
 
 TABLE_CASE:
@@ -633,17 +820,20 @@ TABLE_OPTION:
 JOIN_CLAUSES:
     JOIN_CLAUSE JOIN_CLAUSES
     {
-    	$$ = &influxql.Measurement{}
+    	$$ = append([]*influxql.Join{$1}, $2...)
     }
     |
     {
-    	$$ = &influxql.Measurement{}
+    	$$ = nil
     }
 
 JOIN_CLAUSE:
-    FULL OUTER JOIN IDENT ON IDENT CONDITION_OPERATOR IDENT
+    FULL OUTER JOIN TABLE_CASE ON IDENT CONDITION_OPERATOR IDENT
     {
-    	$$ = &influxql.Measurement{}
+    	$$ = &influxql.Join{
+    	    RSrc:      $4,
+    	    Condition: &influxql.BinaryExpr{Op: influxql.Token($7), LHS: &influxql.VarRef{Val: $6}, RHS: &influxql.VarRef{Val: $8}},
+    	}
     }
 
 GROUP_BY_CLAUSE:
@@ -830,6 +1020,13 @@ OPERATION_EQUAL:
     			yylex.Error("expected regular expression")
     		}
     	}
+    	if $2 == influxql.MATCH{
+    		switch $3.(type){
+    		case *influxql.StringLiteral:
+    		default:
+    			yylex.Error("expected string after MATCH")
+    		}
+    	}
         $$ = &influxql.BinaryExpr{Op:influxql.Token($2),LHS:$1,RHS:$3}
     }
 
@@ -876,6 +1073,10 @@ CONDITION_OPERATOR:
     {
         $$ = influxql.NEQREGEX
     }
+    |MATCH
+    {
+        $$ = influxql.MATCH
+    }
 
 REGULAR_EXPRESSION:
     REGEX
@@ -1551,36 +1752,75 @@ DROP_RETENTION_POLICY_STATEMENT:
     }
 
 GRANT_STATEMENT:
-    GRANT ALL ON IDENT TO IDENT
+    GRANT GRANT_PRIVILEGE ON PRIVILEGE_SCOPE TO IDENT
     {
     	stmt := &influxql.GrantStatement{}
-    	stmt.Privilege = influxql.AllPrivileges
-    	stmt.On = $4
+    	stmt.Privilege = databasePrivilege(yylex, $2, $4)
+    	stmt.On = $4.Database
     	stmt.User = $6
     	$$ = stmt
     }
-    |GRANT ALL PRIVILEGES ON IDENT TO IDENT
+    |GRANT GRANT_PRIVILEGE ON PRIVILEGE_SCOPE TO ROLE IDENT
     {
-    	stmt := &influxql.GrantStatement{}
-    	stmt.Privilege = influxql.AllPrivileges
-    	stmt.On = $5
-    	stmt.User = $7
+    	stmt := &influxql.GrantRolePrivilegeStatement{}
+    	stmt.Privilege = rolePrivilege(yylex, $2)
+    	stmt.On = *$4
+    	stmt.Role = $7
     	$$ = stmt
     }
-    |GRANT IDENT ON IDENT TO IDENT
+    |GRANT IDENT TO IDENT
     {
-    	stmt := &influxql.GrantStatement{}
-    	switch strings.ToLower($2){
-    	case "read":
-    	    stmt.Privilege = influxql.ReadPrivilege
-    	case "write":
-    	    stmt.Privilege = influxql.WritePrivilege
-    	default:
-    	    yylex.Error("wrong Privilege")
+    	$$ = &influxql.GrantRoleStatement{Role: $2, User: $4}
+    }
+
+GRANT_PRIVILEGE:
+    ALL
+    {
+    	$$ = "all"
+    }
+    |ALL PRIVILEGES
+    {
+    	$$ = "all"
+    }
+    |IDENT
+    {
+    	$$ = $1
+    }
+
+PRIVILEGE_SCOPE:
+    MUL
+    {
+    	$$ = &influxql.PrivilegeScope{}
+    }
+    |IDENT
+    {
+    	$$ = &influxql.PrivilegeScope{Database: $1}
+    }
+    |IDENT DOT SCOPE_RETENTION_POLICY
+    {
+    	$$ = &influxql.PrivilegeScope{Database: $1, RetentionPolicy: $3}
+    }
+    |IDENT DOT SCOPE_RETENTION_POLICY DOT IDENT
+    {
+    	$$ = &influxql.PrivilegeScope{Database: $1, RetentionPolicy: $3, Measurement: $5}
+    }
+    |IDENT DOT SCOPE_RETENTION_POLICY DOT REGULAR_EXPRESSION
+    {
+    	re, err := regexp.Compile($5)
+    	if err != nil {
+    	    yylex.Error("Invalid regexprs")
     	}
-    	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
+    	$$ = &influxql.PrivilegeScope{Database: $1, RetentionPolicy: $3, Regex: &influxql.RegexLiteral{Val: re}}
+    }
+
+SCOPE_RETENTION_POLICY:
+    IDENT
+    {
+    	$$ = $1
+    }
+    |MUL
+    {
+    	$$ = ""
     }
 
 GRANT_ADMIN_STATEMENT:
@@ -1594,36 +1834,25 @@ GRANT_ADMIN_STATEMENT:
     }
 
 REVOKE_STATEMENT:
-    REVOKE ALL ON IDENT FROM IDENT
+    REVOKE GRANT_PRIVILEGE ON PRIVILEGE_SCOPE FROM IDENT
     {
     	stmt := &influxql.RevokeStatement{}
-    	stmt.Privilege = influxql.AllPrivileges
-    	stmt.On = $4
+    	stmt.Privilege = databasePrivilege(yylex, $2, $4)
+    	stmt.On = $4.Database
     	stmt.User = $6
     	$$ = stmt
     }
-    |REVOKE ALL PRIVILEGES ON IDENT FROM IDENT
+    |REVOKE GRANT_PRIVILEGE ON PRIVILEGE_SCOPE FROM ROLE IDENT
     {
-    	stmt := &influxql.RevokeStatement{}
-    	stmt.Privilege = influxql.AllPrivileges
-    	stmt.On = $5
-    	stmt.User = $7
+    	stmt := &influxql.RevokeRolePrivilegeStatement{}
+    	stmt.Privilege = rolePrivilege(yylex, $2)
+    	stmt.On = *$4
+    	stmt.Role = $7
     	$$ = stmt
     }
-    |REVOKE IDENT ON IDENT FROM IDENT
+    |REVOKE IDENT FROM IDENT
     {
-    	stmt := &influxql.RevokeStatement{}
-    	switch strings.ToLower($2){
-    	case "read":
-    	    stmt.Privilege = influxql.ReadPrivilege
-    	case "write":
-    	    stmt.Privilege = influxql.WritePrivilege
-    	default:
-    	    yylex.Error("wrong Privilege")
-    	}
-    	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
+    	$$ = &influxql.RevokeRoleStatement{Role: $2, User: $4}
     }
 
 REVOKE_ADMIN_STATEMENT:
@@ -1636,6 +1865,24 @@ REVOKE_ADMIN_STATEMENT:
     	$$ = &influxql.RevokeAdminStatement{User: $4}
     }
 
+CREATE_ROLE_STATEMENT:
+    CREATE ROLE IDENT
+    {
+    	$$ = &influxql.CreateRoleStatement{Name: $3}
+    }
+
+DROP_ROLE_STATEMENT:
+    DROP ROLE IDENT
+    {
+    	$$ = &influxql.DropRoleStatement{Name: $3}
+    }
+
+SHOW_ROLES_STATEMENT:
+    SHOW ROLES
+    {
+    	$$ = &influxql.ShowRolesStatement{}
+    }
+
 DROP_USER_STATEMENT:
     DROP USER IDENT
     {
@@ -1862,6 +2109,25 @@ SHOW_TAG_KEY_CARDINALITY_STATEMENT:
 
 
 
+SHOW_TAG_CARDINALITY_STATEMENT:
+    SHOW TAG CARDINALITY ON_DATABASE FROM_CLAUSE LIMIT_OFFSET_OPTION
+    {
+        stmt := &influxql.ShowTagCardinalityStatement{}
+        stmt.Database = $4
+        stmt.Sources = $5
+        stmt.Limit = $6[0]
+        stmt.Offset = $6[1]
+        $$ = stmt
+    }
+    |SHOW TAG CARDINALITY ON_DATABASE LIMIT_OFFSET_OPTION
+    {
+        stmt := &influxql.ShowTagCardinalityStatement{}
+        stmt.Database = $4
+        stmt.Limit = $5[0]
+        stmt.Offset = $5[1]
+        $$ = stmt
+    }
+
 SHOW_TAG_VALUES_CARDINALITY_STATEMENT:
     SHOW TAG VALUES EXACT CARDINALITY ON_DATABASE FROM_CLAUSE WITH KEY TAG_VALUES_WITH WHERE_CLAUSE GROUP_BY_CLAUSE LIMIT_OFFSET_OPTION
     {
@@ -2260,6 +2526,179 @@ DROP_MEASUREMENT_STATEMENT:
         $$ = stmt
     }
 
+CREATE_CONTINUOUS_QUERY_STATEMENT:
+    CREATE CONTINUOUS QUERY IDENT ON IDENT SAMPLE_POLICY BEGIN SELECT_STATEMENT END
+    {
+        stmt := &influxql.CreateContinuousQueryStatement{
+            Name: $4,
+            Database: $6,
+            Source: $9.(*influxql.SelectStatement),
+        }
+        if $7 != nil {
+            stmt.ResampleEvery = $7.ResampleEvery
+            stmt.ResampleFor = $7.ResampleFor
+        }
+        if err := stmt.Validate(); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = stmt
+    }
+
+SAMPLE_POLICY:
+    RESAMPLE EVERY DURATIONVAL
+    {
+        $$ = &cqSamplePolicyInfo{ResampleEvery: $3}
+    }
+    |RESAMPLE FOR DURATIONVAL
+    {
+        $$ = &cqSamplePolicyInfo{ResampleFor: $3}
+    }
+    |RESAMPLE EVERY DURATIONVAL FOR DURATIONVAL
+    {
+        $$ = &cqSamplePolicyInfo{ResampleEvery: $3, ResampleFor: $5}
+    }
+    |
+    {
+        $$ = nil
+    }
+
+DROP_CONTINUOUS_QUERY_STATEMENT:
+    DROP CONTINUOUS QUERY IDENT ON IDENT
+    {
+        stmt := &influxql.DropContinuousQueryStatement{}
+        stmt.Name = $4
+        stmt.Database = $6
+        $$ = stmt
+    }
+
+SHOW_CONTINUOUS_QUERIES_STATEMENT:
+    SHOW CONTINUOUS QUERIES
+    {
+        $$ = &influxql.ShowContinuousQueriesStatement{}
+    }
+
+CREATE_DOWNSAMPLE_STATEMENT:
+    CREATE DOWNSAMPLE DOWNSAMPLE_ON LPAREN DOWNSAMPLE_CALLS RPAREN WITH SAMPLEINTERVAL LPAREN DURATIONS RPAREN TIMEINTERVAL LPAREN DURATIONS RPAREN
+    {
+        stmt := &influxql.CreateDownSampleStatement{
+            Database: $3[0],
+            RetentionPolicy: $3[1],
+            Calls: $5,
+            SampleIntervals: $10,
+            TimeIntervals: $14,
+        }
+        if err := stmt.Validate(); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = stmt
+    }
+
+DROP_DOWNSAMPLE_STATEMENT:
+    DROP DOWNSAMPLE DOWNSAMPLE_ON
+    {
+        $$ = &influxql.DropDownSampleStatement{Database: $3[0], RetentionPolicy: $3[1]}
+    }
+
+SHOW_DOWNSAMPLES_STATEMENT:
+    SHOW DOWNSAMPLES ON_DATABASE
+    {
+        $$ = &influxql.ShowDownSamplesStatement{Database: $3}
+    }
+
+DOWNSAMPLE_ON:
+    ON IDENT DOT IDENT
+    {
+        $$ = []string{$2, $4}
+    }
+    |ON IDENT
+    {
+        $$ = []string{$2, ""}
+    }
+    |
+    {
+        $$ = []string{"", ""}
+    }
+
+DOWNSAMPLE_CALLS:
+    DOWNSAMPLE_CALL
+    {
+        $$ = []*influxql.DownSampleCall{$1}
+    }
+    |DOWNSAMPLE_CALLS COMMA DOWNSAMPLE_CALL
+    {
+        $$ = append($1, $3)
+    }
+
+DOWNSAMPLE_CALL:
+    COLUMN_VAREF_TYPE LPAREN DOWNSAMPLE_OPS RPAREN
+    {
+        $$ = &influxql.DownSampleCall{DataType: $1, Ops: $3}
+    }
+
+DOWNSAMPLE_OPS:
+    IDENT
+    {
+        $$ = []string{strings.ToLower($1)}
+    }
+    |DOWNSAMPLE_OPS COMMA IDENT
+    {
+        $$ = append($1, strings.ToLower($3))
+    }
+
+DURATIONS:
+    DURATIONVAL
+    {
+        $$ = []time.Duration{$1}
+    }
+    |DURATIONS COMMA DURATIONVAL
+    {
+        $$ = append($1, $3)
+    }
+
+CREATE_SUBSCRIPTION_STATEMENT:
+    CREATE SUBSCRIPTION IDENT ON IDENT DOT IDENT DESTINATIONS SUBSCRIPTION_MODE SUBSCRIPTION_DESTINATIONS
+    {
+        $$ = &influxql.CreateSubscriptionStatement{
+            Name: $3,
+            Database: $5,
+            RetentionPolicy: $7,
+            Mode: $9,
+            Destinations: $10,
+        }
+    }
+
+DROP_SUBSCRIPTION_STATEMENT:
+    DROP SUBSCRIPTION IDENT ON IDENT DOT IDENT
+    {
+        $$ = &influxql.DropSubscriptionStatement{Name: $3, Database: $5, RetentionPolicy: $7}
+    }
+
+SHOW_SUBSCRIPTIONS_STATEMENT:
+    SHOW SUBSCRIPTIONS
+    {
+        $$ = &influxql.ShowSubscriptionsStatement{}
+    }
+
+SUBSCRIPTION_MODE:
+    ALL
+    {
+        $$ = "ALL"
+    }
+    |ANY
+    {
+        $$ = "ANY"
+    }
+
+SUBSCRIPTION_DESTINATIONS:
+    STRING
+    {
+        $$ = []string{$1}
+    }
+    |SUBSCRIPTION_DESTINATIONS COMMA STRING
+    {
+        $$ = append($1, $3)
+    }
+
 
 
 %%
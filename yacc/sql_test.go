@@ -131,6 +131,8 @@ func init() {
 		"create measurement cpu with indextype text indexlist msg text1 indexlist msg1,msg2",
 		"create measurement TSDB_SIT_AlterMeasurement_BaseFunction_002 with shardkey tag1,tag2",
 		"create user xxxxx with password 'xxxx' with partition privileges", // add partition privileges.
+		"select mean(v) into db0.rp0.mst1 from mst group by time(1h)",      // add select into.
+		"select mean(v) into rp0.:MEASUREMENT from /.*/ group by time(1h), *",
 	}
 
 	benchCases = []string{
@@ -184,6 +186,8 @@ func init() {
 		"drop measurement m1",                                            //drop measurement
 		"alter measurement tb1",                                          //alter measurement
 		"alter measurement tb1 with shardkey tag2,tag1",                  //alter measurement with unsorted key
+		"select mean(v) into db0..mst1 from mst group by time(1h)",       //select into
+		"select mean(v) into db0.rp0.:MEASUREMENT from mst group by *",   //select into with back reference
 	}
 }
 
@@ -301,6 +305,119 @@ func TestSingleParser(t *testing.T) {
 	}
 }
 
+func TestSelectIntoParser(t *testing.T) {
+	for _, c := range []string{
+		"SELECT mean(v) INTO db0.rp0.mst1 FROM mst GROUP BY time(1h)",
+		"SELECT mean(v) INTO \"db0\"..mst1 FROM mst GROUP BY time(1h)",
+		"SELECT mean(v) INTO rp0.mst1 FROM mst GROUP BY time(1h)",
+		"SELECT mean(v) INTO db0.rp0.:MEASUREMENT FROM /.*/ GROUP BY *",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q1, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+
+		q2, err := influxql.NewParser(strings.NewReader(c)).ParseQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		stmt1 := q1.Statements[0].(*influxql.SelectStatement)
+		stmt2 := q2.Statements[0].(*influxql.SelectStatement)
+		if !reflect.DeepEqual(stmt1.Target, stmt2.Target) {
+			t.Fatalf("unexpected target of %s, exp: %v, got: %v", c, stmt2.Target, stmt1.Target)
+		}
+	}
+}
+
+func TestContinuousQueryParser(t *testing.T) {
+	for _, c := range []string{
+		"CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) INTO db0.rp0.mst1 FROM mst GROUP BY time(1h) END",
+		"CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(1h), * END",
+		"CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE FOR 2h BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(1h) END",
+		"CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m FOR 2h BEGIN SELECT max(v) INTO mst1 FROM mst WHERE host = 'a' GROUP BY time(30m) END",
+		"DROP CONTINUOUS QUERY cq0 ON db0",
+		"SHOW CONTINUOUS QUERIES",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q1, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+
+		q2, err := influxql.NewParser(strings.NewReader(c)).ParseQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if q1.String() != q2.String() {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, q2.String(), q1.String())
+		}
+	}
+
+	for _, c := range []string{
+		"CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) FROM mst GROUP BY time(1h) END",
+		"CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v) INTO mst1 FROM mst END",
+		"CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE FOR 30m BEGIN SELECT mean(v) INTO mst1 FROM mst GROUP BY time(1h) END",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
+func TestDownSampleParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		"CREATE DOWNSAMPLE ON db0.rp0 (float(mean,max),integer(sum)) WITH SAMPLEINTERVAL(1d,7d) TIMEINTERVAL(1m,10m)": `CREATE DOWNSAMPLE ON db0.rp0 (float(mean,max),integer(sum)) WITH SAMPLEINTERVAL(1d,1w) TIMEINTERVAL(1m,10m)`,
+		"CREATE DOWNSAMPLE ON db0 (float(MIN)) WITH SAMPLEINTERVAL(1d) TIMEINTERVAL(5m)":                              `CREATE DOWNSAMPLE ON db0 (float(min)) WITH SAMPLEINTERVAL(1d) TIMEINTERVAL(5m)`,
+		"CREATE DOWNSAMPLE (integer(first,last)) WITH SAMPLEINTERVAL(1h) TIMEINTERVAL(1m)":                            `CREATE DOWNSAMPLE (integer(first,last)) WITH SAMPLEINTERVAL(1h) TIMEINTERVAL(1m)`,
+		"DROP DOWNSAMPLE ON db0.rp0": `DROP DOWNSAMPLE ON db0.rp0`,
+		"DROP DOWNSAMPLE":            `DROP DOWNSAMPLE`,
+		"SHOW DOWNSAMPLES ON db0":    `SHOW DOWNSAMPLES ON db0`,
+		"SHOW DOWNSAMPLES":           `SHOW DOWNSAMPLES`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+
+	for _, c := range []string{
+		"CREATE DOWNSAMPLE ON db0.rp0 (float(mean)) WITH SAMPLEINTERVAL(1d,7d) TIMEINTERVAL(1m)",
+		"CREATE DOWNSAMPLE ON db0.rp0 (float(mean)) WITH TIMEINTERVAL(1m)",
+		"CREATE DOWNSAMPLE ON db0.rp0 () WITH SAMPLEINTERVAL(1d) TIMEINTERVAL(1m)",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
 func BenchmarkNewParser(b *testing.B) {
 	YyParser := &yacc.YyParser{
 		Query: influxql.Query{},
@@ -324,3 +441,234 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestJoinParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON cpu.host = mem.host":                   `SELECT "cpu.usage", "mem.used" FROM cpu FULL OUTER JOIN mem ON "cpu.host" = "mem.host"`,
+		"SELECT mean(cpu.usage) FROM db0.rp0.cpu FULL OUTER JOIN db0.rp0.mem ON host = host GROUP BY host": `SELECT mean("cpu.usage") FROM db0.rp0.cpu FULL OUTER JOIN db0.rp0.mem ON host = host GROUP BY host`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		stmt := q.Statements[0].(*influxql.SelectStatement)
+		if _, ok := stmt.Sources[0].(*influxql.Join); !ok {
+			t.Fatalf("unexpected source of %s: %T", c, stmt.Sources[0])
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+
+	for _, c := range []string{
+		"DROP SERIES FROM cpu FULL OUTER JOIN mem ON cpu.host = mem.host",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
+func TestJoinRewrite(t *testing.T) {
+	for c, exp := range map[string]string{
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON cpu.host = mem.host WHERE cpu.usage > 10":                   `SELECT "cpu.usage", "mem.used" FROM (SELECT usage AS "cpu.usage" FROM cpu GROUP BY host) FULL OUTER JOIN (SELECT used AS "mem.used" FROM mem GROUP BY host) ON host = host WHERE "cpu.usage" > 10`,
+		"SELECT max(mem.used) - max(cpu.usage) FROM cpu FULL OUTER JOIN mem ON mem.hostname = cpu.host GROUP BY time(1m), host": `SELECT max("mem.used") - max("cpu.usage") FROM (SELECT usage AS "cpu.usage" FROM cpu GROUP BY host) FULL OUTER JOIN (SELECT used AS "mem.used" FROM mem GROUP BY hostname) ON host = hostname GROUP BY time(1m), host`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		stmt := q.Statements[0].(*influxql.SelectStatement)
+		if err := stmt.RewriteJoin(); err != nil {
+			t.Fatalf("rewrite %s failed: %v", c, err)
+		}
+		if stmt.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, stmt.String())
+		}
+	}
+
+	for _, c := range []string{
+		"SELECT cpu.usage FROM cpu FULL OUTER JOIN mem ON cpu.host != mem.host",
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON cpu.host = cpu.host",
+		"SELECT usage, mem.used FROM cpu FULL OUTER JOIN mem ON host = host",
+		"SELECT * FROM cpu FULL OUTER JOIN mem ON host = host",
+		"SELECT cpu.usage FROM cpu FULL OUTER JOIN mem ON host = host",
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON host = host GROUP BY region",
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON host = host FULL OUTER JOIN disk ON host = host",
+		"SELECT cpu.usage, mem.used FROM cpu FULL OUTER JOIN mem ON host = host, disk",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if err := q.Statements[0].(*influxql.SelectStatement).RewriteJoin(); err == nil {
+			t.Fatalf("rewrite %s should fail", c)
+		}
+	}
+}
+
+func TestMatchParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		"SELECT msg FROM mst WHERE msg MATCH 'timeout'":                      `SELECT msg FROM mst WHERE msg MATCH 'timeout'`,
+		"SELECT msg FROM mst WHERE msg match 'read timeout' AND host = 'h1'": `SELECT msg FROM mst WHERE msg MATCH 'read timeout' AND host = 'h1'`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+
+	for _, c := range []string{
+		"SELECT msg FROM mst WHERE msg MATCH 1",
+		"SELECT msg FROM mst WHERE msg MATCH host",
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
+func TestSubscriptionParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		`CREATE SUBSCRIPTION sub0 ON db0.rp0 DESTINATIONS ALL 'udp://h1:9090', 'http://h2:9092'`: `CREATE SUBSCRIPTION sub0 ON db0.rp0 DESTINATIONS ALL 'udp://h1:9090', 'http://h2:9092'`,
+		`create subscription "sub 1" on db0."rp 0" destinations any 'http://h1:9092'`:            `CREATE SUBSCRIPTION "sub 1" ON db0."rp 0" DESTINATIONS ANY 'http://h1:9092'`,
+		`DROP SUBSCRIPTION sub0 ON db0.rp0`:                                                      `DROP SUBSCRIPTION sub0 ON db0.rp0`,
+		`SHOW SUBSCRIPTIONS`:                                                                     `SHOW SUBSCRIPTIONS`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+
+	for _, c := range []string{
+		`CREATE SUBSCRIPTION sub0 ON db0 DESTINATIONS ALL 'udp://h1:9090'`,
+		`CREATE SUBSCRIPTION sub0 ON db0.rp0 DESTINATIONS SOME 'udp://h1:9090'`,
+		`CREATE SUBSCRIPTION sub0 ON db0.rp0 DESTINATIONS ALL`,
+		`DROP SUBSCRIPTION sub0`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
+func TestRoleParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		`CREATE ROLE reader`:                           `CREATE ROLE reader`,
+		`DROP ROLE "the reader"`:                       `DROP ROLE "the reader"`,
+		`SHOW ROLES`:                                   `SHOW ROLES`,
+		`GRANT reader TO bob`:                          `GRANT reader TO bob`,
+		`REVOKE reader FROM bob`:                       `REVOKE reader FROM bob`,
+		`GRANT READ ON db0 TO bob`:                     `GRANT READ ON db0 TO bob`,
+		`GRANT ALL PRIVILEGES ON db0 TO bob`:           `GRANT ALL PRIVILEGES ON db0 TO bob`,
+		`REVOKE WRITE ON db0 FROM bob`:                 `REVOKE WRITE ON db0 FROM bob`,
+		`GRANT read ON db0.rp0.cpu TO ROLE reader`:     `GRANT READ ON db0.rp0.cpu TO ROLE reader`,
+		`GRANT WRITE ON db0.*./^cpu.*/ TO ROLE writer`: `GRANT WRITE ON db0.*./^cpu.*/ TO ROLE writer`,
+		`GRANT DDL ON db0.rp0 TO ROLE dba`:             `GRANT DDL ON db0.rp0 TO ROLE dba`,
+		`GRANT ADMIN ON * TO ROLE ops`:                 `GRANT ADMIN ON * TO ROLE ops`,
+		`GRANT ALL ON db0 TO ROLE owner`:               `GRANT ALL PRIVILEGES ON db0 TO ROLE owner`,
+		`REVOKE READ ON db0.rp0.cpu FROM ROLE reader`:  `REVOKE READ ON db0.rp0.cpu FROM ROLE reader`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+
+	for _, c := range []string{
+		`GRANT READ ON db0.rp0.cpu TO bob`,
+		`GRANT DDL ON db0 TO bob`,
+		`GRANT SOME ON db0 TO ROLE reader`,
+		`REVOKE READ ON * FROM bob`,
+		`CREATE ROLE`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("parse %s should fail", c)
+		}
+	}
+}
+
+func TestShowTagCardinalityParser(t *testing.T) {
+	for c, exp := range map[string]string{
+		`SHOW TAG CARDINALITY`:                               `SHOW TAG CARDINALITY`,
+		`SHOW TAG CARDINALITY ON db0`:                        `SHOW TAG CARDINALITY ON db0`,
+		`SHOW TAG CARDINALITY ON db0 FROM cpu LIMIT 10`:      `SHOW TAG CARDINALITY ON db0 FROM cpu LIMIT 10`,
+		`SHOW TAG CARDINALITY FROM /cpu.*/ LIMIT 5 OFFSET 1`: `SHOW TAG CARDINALITY FROM /cpu.*/ LIMIT 5 OFFSET 1`,
+	} {
+		YyParser := &yacc.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", c, err)
+		}
+		if _, ok := q.Statements[0].(*influxql.ShowTagCardinalityStatement); !ok {
+			t.Fatalf("unexpected statement of %s: %T", c, q.Statements[0])
+		}
+		if q.String() != exp {
+			t.Fatalf("unexpected statement of %s, exp: %s, got: %s", c, exp, q.String())
+		}
+	}
+}
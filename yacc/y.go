@@ -37,6 +37,47 @@ func setParseTree(yylex interface{}, stmts influxql.Statements) {
 	}
 }
 
+// checkJoinSources reports an error if a statement other than SELECT reads from a join.
+func checkJoinSources(yylex interface{}, stmts influxql.Statements) {
+	for _, stmt := range stmts {
+		switch stmt.(type) {
+		case *influxql.SelectStatement, *influxql.CreateContinuousQueryStatement, *influxql.ExplainStatement:
+			continue
+		}
+		influxql.WalkFunc(stmt, func(n influxql.Node) {
+			if _, ok := n.(*influxql.Join); ok {
+				yylex.(*YyParser).Error("FULL OUTER JOIN is only supported in SELECT statements")
+			}
+		})
+	}
+}
+
+// databasePrivilege returns the privilege of a GRANT or REVOKE statement on the database of a user. Users are only
+// granted privileges on whole databases, the finer scopes are granted to roles.
+func databasePrivilege(yylex interface{}, priv string, on *influxql.PrivilegeScope) influxql.Privilege {
+	if on.Database == "" || on.RetentionPolicy != "" || on.Measurement != "" || on.Regex != nil {
+		yylex.(*YyParser).Error("privileges on retention policies and measurements can only be granted to roles")
+	}
+	switch strings.ToLower(priv) {
+	case "read":
+		return influxql.ReadPrivilege
+	case "write":
+		return influxql.WritePrivilege
+	case "all":
+		return influxql.AllPrivileges
+	}
+	yylex.(*YyParser).Error("wrong Privilege")
+	return influxql.NoPrivileges
+}
+
+func rolePrivilege(yylex interface{}, priv string) influxql.RolePrivilege {
+	p, ok := influxql.ParseRolePrivilege(priv)
+	if !ok {
+		yylex.(*YyParser).Error("wrong Privilege")
+	}
+	return p
+}
+
 func deal_Fill(fill interface{}) (influxql.FillOption, interface{}, bool) {
 	switch fill.(type) {
 	case string:
@@ -61,7 +102,7 @@ func deal_Fill(fill interface{}) (influxql.FillOption, interface{}, bool) {
 	}
 }
 
-//line sql.y:64
+//line sql.y:105
 type yySymType struct {
 	yys              int
 	stmt             influxql.Statement
@@ -74,6 +115,9 @@ type yySymType struct {
 	sortfs           influxql.SortFields
 	sortf            *influxql.SortField
 	ment             *influxql.Measurement
+	source           influxql.Source
+	joins            []*influxql.Join
+	join             *influxql.Join
 	subQuery         *influxql.SubQuery
 	dimens           influxql.Dimensions
 	dimen            *influxql.Dimension
@@ -92,6 +136,12 @@ type yySymType struct {
 	strSlice         []string
 	location         *time.Location
 	indexType        *IndexType
+	target           *influxql.Target
+	cqsp             *cqSamplePolicyInfo
+	dsCalls          []*influxql.DownSampleCall
+	dsCall           *influxql.DownSampleCall
+	durationSlice    []time.Duration
+	scope            *influxql.PrivilegeScope
 }
 
 const FROM = 57346
@@ -212,6 +262,20 @@ const DIV = 57460
 const MOD = 57461
 const BITWISE_AND = 57462
 const UMINUS = 57463
+const INTO = 57464
+const COLON = 57465
+const BEGIN = 57466
+const RESAMPLE = 57467
+const EVERY = 57468
+const DOWNSAMPLE = 57469
+const DOWNSAMPLES = 57470
+const SAMPLEINTERVAL = 57471
+const TIMEINTERVAL = 57472
+const MATCH = 57473
+const ANY = 57474
+const DESTINATIONS = 57475
+const ROLE = 57476
+const ROLES = 57477
 
 var yyToknames = [...]string{
 	"$end",
@@ -335,6 +399,20 @@ var yyToknames = [...]string{
 	"MOD",
 	"BITWISE_AND",
 	"UMINUS",
+	"INTO",
+	"COLON",
+	"BEGIN",
+	"RESAMPLE",
+	"EVERY",
+	"DOWNSAMPLE",
+	"DOWNSAMPLES",
+	"SAMPLEINTERVAL",
+	"TIMEINTERVAL",
+	"MATCH",
+	"ANY",
+	"DESTINATIONS",
+	"ROLE",
+	"ROLES",
 }
 
 var yyStatenames = [...]string{}
@@ -343,220 +421,256 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:2265
+//line sql.y:2704
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 333,
-	95, 125,
-	96, 125,
-	97, 125,
-	98, 125,
-	99, 125,
-	100, 125,
-	103, 125,
-	104, 125,
-	-2, 114,
+	-1, 399,
+	95, 147,
+	96, 147,
+	97, 147,
+	98, 147,
+	99, 147,
+	100, 147,
+	103, 147,
+	104, 147,
+	131, 147,
+	-2, 136,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 779
+const yyLast = 912
 
 var yyAct = [...]int{
-	364, 306, 632, 251, 281, 565, 465, 537, 572, 499,
-	363, 273, 417, 454, 399, 4, 435, 146, 398, 407,
-	304, 168, 239, 2, 197, 351, 624, 51, 157, 109,
-	110, 114, 115, 91, 61, 162, 67, 125, 169, 65,
-	66, 121, 68, 432, 111, 112, 116, 113, 109, 110,
-	114, 115, 61, 438, 625, 509, 441, 65, 66, 333,
-	406, 626, 279, 637, 101, 439, 243, 244, 56, 199,
-	68, 577, 561, 243, 244, 90, 492, 243, 244, 55,
-	105, 57, 63, 60, 64, 62, 56, 491, 68, 187,
-	58, 55, 188, 54, 490, 117, 161, 120, 68, 57,
-	63, 60, 64, 62, 52, 145, 489, 394, 58, 144,
-	128, 54, 147, 243, 244, 621, 180, 516, 517, 151,
-	148, 518, 610, 582, 156, 68, 68, 104, 526, 148,
-	108, 525, 148, 322, 184, 355, 413, 321, 182, 147,
-	147, 453, 202, 203, 40, 55, 198, 452, 189, 190,
-	191, 192, 193, 194, 195, 196, 397, 55, 395, 209,
-	207, 150, 213, 154, 205, 206, 359, 360, 215, 216,
-	217, 98, 222, 61, 362, 361, 227, 634, 65, 66,
-	96, 183, 201, 566, 235, 242, 111, 112, 116, 113,
-	109, 110, 114, 115, 68, 539, 573, 468, 124, 605,
-	246, 145, 456, 245, 212, 144, 419, 56, 147, 68,
-	567, 400, 513, 512, 502, 409, 446, 275, 285, 445,
-	57, 63, 60, 64, 62, 431, 522, 298, 429, 58,
-	428, 426, 54, 424, 111, 112, 116, 113, 109, 110,
-	114, 115, 419, 277, 122, 99, 284, 415, 405, 288,
-	290, 323, 286, 396, 97, 356, 348, 294, 347, 296,
-	344, 303, 300, 328, 301, 336, 326, 327, 466, 467,
-	148, 343, 283, 338, 148, 148, 470, 469, 68, 331,
-	332, 272, 271, 270, 267, 266, 369, 354, 265, 368,
-	262, 287, 289, 291, 260, 375, 520, 236, 297, 385,
-	373, 234, 384, 302, 111, 112, 116, 113, 109, 110,
-	114, 115, 230, 247, 248, 392, 225, 210, 357, 155,
-	371, 372, 153, 374, 149, 142, 393, 140, 118, 107,
-	383, 378, 411, 381, 388, 390, 391, 386, 119, 410,
-	412, 324, 414, 252, 253, 254, 255, 256, 257, 350,
-	418, 259, 258, 422, 233, 160, 433, 642, 636, 148,
-	425, 148, 370, 608, 635, 50, 607, 330, 614, 457,
-	379, 574, 382, 571, 461, 423, 387, 389, 245, 462,
-	442, 118, 606, 459, 460, 479, 463, 570, 508, 504,
-	440, 119, 444, 487, 503, 478, 421, 276, 447, 448,
-	483, 541, 485, 486, 458, 521, 434, 420, 337, 334,
-	249, 500, 50, 604, 587, 476, 477, 529, 530, 496,
-	481, 482, 528, 484, 519, 505, 488, 238, 497, 501,
-	237, 106, 498, 507, 562, 511, 506, 139, 126, 143,
-	341, 589, 320, 299, 514, 510, 295, 523, 148, 524,
-	488, 171, 319, 126, 471, 223, 224, 475, 220, 221,
-	532, 533, 480, 137, 138, 293, 226, 214, 546, 545,
-	531, 535, 578, 534, 40, 540, 134, 61, 135, 551,
-	474, 547, 65, 66, 555, 464, 557, 558, 549, 550,
-	563, 377, 576, 553, 554, 278, 556, 443, 204, 536,
-	131, 132, 133, 559, 218, 219, 542, 543, 124, 548,
-	600, 56, 181, 68, 552, 564, 185, 186, 569, 129,
-	130, 136, 3, 560, 57, 63, 60, 64, 62, 494,
-	404, 89, 584, 58, 579, 580, 575, 403, 100, 402,
-	401, 586, 583, 313, 316, 170, 314, 315, 152, 588,
-	594, 595, 590, 591, 597, 598, 440, 599, 141, 127,
-	593, 318, 87, 95, 596, 84, 102, 86, 544, 585,
-	93, 83, 88, 103, 603, 167, 166, 92, 495, 473,
-	92, 592, 85, 376, 261, 92, 232, 612, 609, 231,
-	611, 229, 208, 352, 619, 613, 615, 620, 250, 94,
-	263, 172, 82, 472, 618, 80, 380, 81, 61, 623,
-	622, 292, 627, 65, 66, 173, 335, 264, 174, 631,
-	427, 345, 342, 633, 616, 617, 416, 61, 629, 630,
-	639, 640, 65, 66, 329, 633, 641, 602, 601, 643,
-	638, 241, 164, 581, 68, 527, 178, 367, 176, 628,
-	450, 451, 77, 365, 366, 165, 63, 60, 64, 62,
-	92, 339, 177, 68, 58, 282, 353, 274, 282, 92,
-	93, 40, 568, 93, 57, 63, 60, 64, 62, 269,
-	126, 268, 340, 58, 73, 69, 325, 70, 71, 317,
-	211, 179, 175, 79, 309, 310, 280, 430, 349, 346,
-	92, 76, 408, 72, 437, 307, 311, 313, 316, 40,
-	314, 315, 74, 75, 538, 305, 308, 515, 449, 41,
-	42, 436, 455, 200, 123, 59, 78, 163, 358, 47,
-	158, 44, 240, 159, 1, 312, 53, 45, 39, 38,
-	37, 36, 35, 34, 33, 32, 31, 30, 29, 28,
-	46, 27, 26, 25, 49, 24, 23, 20, 19, 43,
-	21, 18, 22, 17, 16, 15, 13, 14, 12, 11,
-	493, 7, 48, 10, 9, 8, 228, 6, 5,
+	429, 728, 364, 705, 742, 646, 335, 303, 428, 596,
+	617, 4, 570, 414, 491, 524, 464, 508, 465, 362,
+	200, 225, 473, 385, 178, 208, 291, 535, 116, 209,
+	197, 315, 153, 2, 202, 386, 80, 149, 505, 500,
+	655, 131, 702, 64, 304, 305, 306, 307, 308, 309,
+	756, 652, 311, 310, 574, 649, 91, 132, 651, 139,
+	140, 144, 141, 137, 138, 142, 143, 506, 501, 503,
+	750, 417, 111, 128, 317, 416, 513, 751, 226, 226,
+	312, 504, 224, 68, 232, 226, 316, 233, 86, 82,
+	758, 83, 84, 227, 227, 68, 588, 93, 182, 145,
+	227, 148, 749, 109, 333, 90, 105, 85, 107, 133,
+	81, 295, 296, 110, 295, 296, 88, 89, 156, 724,
+	731, 599, 175, 106, 602, 507, 94, 101, 170, 472,
+	92, 650, 96, 600, 399, 187, 664, 665, 180, 220,
+	666, 211, 112, 703, 196, 137, 138, 142, 143, 114,
+	729, 81, 295, 296, 180, 295, 296, 180, 99, 761,
+	757, 97, 479, 98, 68, 179, 744, 234, 235, 236,
+	237, 238, 239, 240, 241, 228, 68, 710, 229, 95,
+	698, 697, 254, 245, 642, 258, 87, 252, 250, 251,
+	243, 260, 261, 262, 113, 267, 268, 102, 242, 563,
+	273, 108, 247, 248, 104, 562, 561, 560, 460, 713,
+	632, 74, 194, 607, 606, 285, 78, 79, 139, 140,
+	144, 141, 137, 138, 142, 143, 463, 201, 748, 81,
+	81, 461, 449, 448, 297, 294, 177, 177, 538, 298,
+	176, 176, 246, 179, 179, 69, 244, 81, 321, 103,
+	53, 326, 191, 706, 152, 647, 100, 619, 70, 76,
+	73, 77, 75, 424, 425, 339, 81, 71, 352, 126,
+	67, 427, 426, 380, 81, 356, 123, 379, 704, 331,
+	179, 420, 700, 526, 493, 660, 338, 648, 466, 342,
+	344, 593, 340, 136, 591, 580, 579, 348, 578, 350,
+	150, 475, 361, 358, 381, 359, 518, 517, 498, 536,
+	537, 488, 487, 402, 485, 481, 180, 540, 539, 394,
+	392, 393, 471, 462, 670, 421, 180, 180, 412, 404,
+	397, 398, 139, 140, 144, 141, 137, 138, 142, 143,
+	432, 410, 391, 125, 388, 431, 337, 325, 493, 418,
+	122, 438, 447, 324, 322, 320, 436, 451, 419, 318,
+	313, 287, 450, 422, 286, 283, 281, 280, 276, 434,
+	435, 271, 437, 255, 195, 458, 192, 299, 300, 446,
+	441, 190, 444, 459, 189, 185, 184, 454, 456, 457,
+	452, 174, 173, 171, 668, 476, 146, 139, 140, 144,
+	141, 137, 138, 142, 143, 478, 147, 480, 135, 592,
+	511, 489, 492, 486, 477, 496, 409, 180, 387, 180,
+	382, 327, 279, 413, 499, 746, 746, 735, 766, 745,
+	734, 115, 527, 514, 81, 297, 764, 531, 654, 512,
+	497, 653, 760, 759, 532, 146, 763, 533, 529, 530,
+	483, 550, 516, 482, 63, 147, 396, 717, 707, 558,
+	548, 659, 528, 519, 520, 658, 587, 583, 554, 582,
+	556, 557, 495, 546, 547, 330, 733, 699, 669, 621,
+	595, 552, 553, 494, 555, 484, 403, 400, 301, 282,
+	571, 63, 747, 695, 678, 667, 608, 568, 609, 610,
+	643, 584, 572, 559, 290, 289, 585, 576, 134, 586,
+	193, 581, 590, 181, 180, 594, 569, 168, 589, 169,
+	159, 160, 161, 605, 378, 567, 162, 180, 407, 559,
+	612, 613, 604, 603, 377, 269, 270, 357, 186, 154,
+	349, 614, 611, 154, 347, 74, 272, 601, 620, 631,
+	78, 79, 265, 266, 259, 636, 644, 638, 639, 680,
+	629, 630, 615, 222, 616, 626, 634, 635, 625, 637,
+	166, 167, 627, 53, 628, 544, 622, 623, 640, 69,
+	633, 81, 534, 163, 645, 164, 440, 711, 257, 230,
+	231, 709, 70, 76, 73, 77, 75, 65, 726, 657,
+	515, 71, 332, 662, 67, 263, 264, 249, 3, 157,
+	158, 671, 675, 152, 672, 74, 691, 727, 121, 641,
+	78, 79, 221, 677, 674, 165, 565, 470, 469, 661,
+	685, 686, 679, 468, 467, 688, 689, 127, 690, 210,
+	188, 172, 684, 155, 676, 120, 376, 687, 129, 69,
+	117, 81, 624, 681, 682, 575, 683, 566, 694, 543,
+	329, 696, 70, 76, 73, 77, 75, 439, 118, 117,
+	117, 71, 130, 314, 67, 278, 542, 708, 715, 124,
+	712, 119, 277, 714, 275, 722, 509, 302, 723, 716,
+	253, 401, 341, 343, 345, 443, 346, 721, 408, 351,
+	355, 725, 319, 214, 601, 360, 215, 213, 718, 490,
+	719, 720, 732, 219, 395, 737, 736, 207, 206, 693,
+	371, 374, 741, 372, 373, 692, 293, 743, 673, 218,
+	522, 523, 549, 739, 740, 117, 353, 354, 336, 510,
+	753, 754, 415, 117, 353, 354, 743, 738, 755, 430,
+	74, 336, 752, 762, 117, 78, 79, 118, 118, 53,
+	656, 154, 406, 390, 389, 765, 383, 375, 74, 288,
+	284, 256, 433, 78, 79, 216, 212, 183, 334, 328,
+	442, 411, 445, 117, 204, 323, 81, 74, 217, 502,
+	453, 455, 78, 79, 730, 701, 384, 205, 76, 73,
+	77, 75, 69, 577, 81, 573, 71, 223, 474, 598,
+	618, 363, 663, 521, 597, 70, 76, 73, 77, 75,
+	525, 405, 151, 81, 71, 72, 203, 292, 367, 368,
+	198, 423, 199, 1, 70, 76, 73, 77, 75, 365,
+	369, 371, 374, 71, 372, 373, 53, 66, 25, 24,
+	366, 23, 52, 51, 50, 49, 54, 55, 48, 47,
+	46, 45, 44, 43, 42, 41, 60, 40, 57, 370,
+	39, 38, 37, 541, 58, 36, 545, 35, 34, 33,
+	31, 32, 30, 29, 551, 28, 27, 59, 26, 20,
+	19, 62, 21, 18, 22, 17, 56, 16, 15, 13,
+	14, 12, 11, 564, 7, 10, 9, 8, 274, 61,
+	6, 5,
 }
 
 var yyPact = [...]int{
-	702, -1000, 321, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	839, -1000, 400, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-6, 647, 566, 526, 665, 558, 149, 140, 467, 534,
-	702, 696, 115, 341, 227, 121, 419, 236, 419, -1000,
-	-1000, 139, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	674, 517, 447, -1000, 433, 409, 468, 391, -1000, 354,
-	222, 515, 220, 100, 219, 665, 505, 217, 57, 214,
-	662, -1000, 4, 550, 502, 100, 595, 686, 642, 685,
-	664, -1000, 459, -1000, 662, 696, 115, 451, -16, 419,
-	419, 419, 419, 419, 419, 419, 419, -69, -24, 77,
-	-1000, 437, 449, 449, 550, 562, 212, 684, 665, 394,
-	674, 674, 432, 386, 674, 383, 211, 393, 674, -1000,
-	561, 207, 559, 556, 253, 196, -1000, -1000, -1000, -1000,
-	662, -1000, 192, -1000, -1000, -1000, -1000, -1000, 340, 337,
-	622, 702, -45, -1000, 550, 289, 318, 572, 248, 191,
-	189, 554, 185, 594, 183, 180, 179, 675, 178, 177,
-	-1000, 176, 657, 662, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -88, -88, -88, -1000, -1000, -88, -1000, 304, -1000,
-	-1000, -1000, -1000, -1000, 419, 434, -1000, 2, 691, 653,
-	-1000, 167, 662, 653, 674, 665, 665, 581, 392, 674,
-	373, 674, 656, 370, 674, -1000, 674, 665, -1000, 661,
-	683, 529, 368, 32, 240, -1000, 680, 4, 4, -1000,
-	622, 613, 274, 550, 550, -69, -34, 317, 592, 664,
-	316, 569, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	676, 366, 599, 166, 155, -1000, 598, 695, 153, 151,
-	-1000, 694, 254, 565, 655, 657, -1000, 73, 150, 419,
-	71, 640, 636, -1000, 653, 640, 665, 662, 657, 662,
-	653, 553, 422, 674, 576, 674, 665, 653, 640, 674,
-	665, 665, 662, 657, -1000, 661, -1000, 0, 52, 148,
-	50, -1000, 106, 496, 495, 493, 486, 143, -48, 110,
-	106, 231, 31, -1000, 31, 142, -1000, -1000, -1000, 604,
-	-1000, -1000, -1000, -1000, 137, 315, 303, 664, -1000, 550,
-	128, 106, 126, 597, -1000, 125, 123, 693, -1000, 120,
-	-65, 653, 314, -52, 565, -1000, 435, 191, 662, 114,
-	111, 184, 184, -1000, 635, 41, 35, 97, 640, -1000,
-	662, 657, 657, 640, 653, 640, 416, 173, 573, 549,
-	411, 665, 662, 657, 640, -1000, 665, 662, 657, 662,
-	657, 657, 640, -1000, -1000, -1000, -1000, -1000, 336, -1000,
-	-1000, -1, -13, -20, -31, 485, 548, 345, 110, 347,
-	360, 31, -1000, -1000, -1000, -1000, 109, 301, 296, 335,
-	137, -1000, 295, -38, 661, 360, -1000, 108, -1000, -1000,
-	107, -1000, -1000, 640, 12, -1000, 334, 194, 313, 124,
-	-1000, -1000, 653, -1000, 653, -1000, -1000, -1000, -1000, -1000,
-	25, 22, 631, -1000, -1000, 332, 329, -1000, 657, 640,
-	640, -1000, 640, -1000, 173, 662, 90, 90, 309, 184,
-	184, 538, 400, 399, 173, 662, 657, 657, 640, -1000,
-	662, 657, 657, 640, 657, 640, 640, -1000, 106, -1000,
-	-1000, -1000, -1000, 478, -35, 403, 106, -1000, 78, -1000,
-	105, -1000, 666, -1000, -1000, 101, 294, 280, -1000, -1000,
-	-1000, -1000, -1000, -1000, 91, 278, -1000, -1000, -1000, -52,
-	427, -36, 407, 640, 640, 627, -1000, 17, 97, -1000,
-	-1000, 640, -1000, -1000, -1000, 662, 653, -1000, 324, -1000,
-	-1000, 90, -1000, -1000, 372, 173, 173, 662, 657, 640,
-	640, -1000, 657, 640, 640, -1000, 640, -1000, -1000, -1000,
-	-1000, 455, 618, 617, 360, -1000, 323, -1000, 94, -1000,
-	-1000, -1000, -1000, 290, -1000, -1000, -1000, 273, -1000, 91,
-	-1000, 16, -1000, -1000, -1000, 653, 640, 90, 275, 173,
-	662, 662, 657, 640, -1000, -1000, 640, -1000, -1000, -1000,
-	9, -1000, -1000, -1000, 78, 248, -82, -1000, -53, -1000,
-	-1000, 640, -1000, -1000, -1000, 662, 657, 657, 640, -1000,
-	-1000, 497, -1000, 72, 271, 265, -44, -1000, 657, 640,
-	640, -1000, -1000, 497, -1000, -1000, -1000, 264, 640, -1000,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 487, 51, 122, 67, 750, 640, 245,
+	238, 566, 616, 839, -65, 557, 418, 306, 284, 710,
+	304, 710, -1000, -1000, 195, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 755, 601, 537, -1000, -1000, 453, 516,
+	572, 498, -1000, 434, 442, 755, -1000, 288, 598, 287,
+	286, 136, 427, 771, 281, 280, 750, 597, 279, 276,
+	146, 271, 424, 771, 269, 749, -1000, 135, 692, 596,
+	136, 770, 684, 683, 769, 784, 709, 752, -1000, 569,
+	-1000, 779, -23, -65, 557, 524, -21, 710, 710, 710,
+	710, 710, 710, 710, 710, 105, 153, 137, -1000, 546,
+	554, 554, 692, 660, 268, 765, 750, 481, 755, 755,
+	533, 480, 755, 755, 463, 266, 473, 755, -1000, -1000,
+	-1000, 654, 263, 652, -1000, 645, 321, 262, -1000, -1000,
+	-1000, 261, 397, 260, 764, -1000, 749, -1000, 259, -1000,
+	-1000, -1000, -1000, 256, -1000, 763, -1000, -1000, 415, 414,
+	707, 839, 0, -1000, 692, 353, 396, 661, -51, -54,
+	255, 643, -31, 254, 679, 250, -31, 249, 781, 248,
+	-1000, 242, 749, -1000, 320, -1000, -1000, 774, 779, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 28, 28, 28, -1000,
+	-1000, 28, -1000, 382, -1000, -1000, -1000, -1000, -1000, 710,
+	541, -1000, 44, 773, 726, -1000, 241, 749, 726, 755,
+	750, 750, 666, 471, 755, 467, 755, 731, 739, 464,
+	755, -1000, 755, 750, -1000, 795, 761, 614, 450, 172,
+	319, 760, 137, 317, 239, -1000, 758, 757, 237, 135,
+	135, -1000, 707, 693, 363, 692, 692, 105, 41, 395,
+	667, 752, 394, 729, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 756, 454, 675, -1000, 315, -1000, 236,
+	-1000, 777, -1000, 223, -1000, 328, 732, -30, -1000, 749,
+	-1000, 219, 220, 710, 168, 723, 738, -1000, 726, 723,
+	750, 749, 732, 749, 726, 637, 517, 755, 665, 755,
+	750, 723, -1000, 127, 126, 726, 723, 755, 750, 750,
+	749, 732, -1000, 795, -1000, 101, 125, 218, 120, -1000,
+	183, 590, 589, 584, 583, 217, 21, 196, 183, 313,
+	57, -1000, 57, 210, 360, -1000, 393, 209, 312, 207,
+	206, 310, -1000, -1000, -1000, 687, -1000, -1000, -1000, -1000,
+	243, 391, 379, 752, -1000, 692, 203, 183, -66, -36,
+	-1000, -67, -1000, 17, 658, 728, 309, -29, -1000, 732,
+	-1000, 538, -54, 749, 202, 201, 340, 340, -1000, 715,
+	178, 723, -1000, 749, 732, 732, 723, 726, 723, 513,
+	214, 646, 629, 506, 750, 749, 732, -1000, 718, -1000,
+	723, -1000, 750, 749, 732, 749, 732, 732, 723, -1000,
+	-1000, -1000, -1000, -1000, 413, -1000, -1000, 100, 99, 98,
+	92, 582, 627, 451, 196, 431, 439, 57, -1000, -1000,
+	-1000, -71, 625, 137, 193, -1000, 191, -1000, -1000, 190,
+	136, 376, 374, 411, 243, -1000, 373, 3, 795, 439,
+	-1000, 189, 308, -1000, -1000, -1000, 186, -1000, 726, 388,
+	16, -29, -1000, -1000, 658, -1000, 726, -1000, -1000, -1000,
+	-1000, -1000, 108, 107, -1000, 406, 410, -1000, 732, 723,
+	723, -1000, 723, -1000, 214, 749, 152, 152, 387, 340,
+	340, 622, 499, 496, 214, 749, 732, 732, 723, 104,
+	-1000, 749, 732, 732, 723, 732, 723, 723, -1000, 183,
+	-1000, -1000, -1000, -1000, 574, 77, 469, 183, -1000, 150,
+	-1000, 182, -1000, -69, 5, -78, -1000, 348, -1000, -93,
+	-1000, 754, -1000, -1000, 179, 372, 368, -1000, -1000, -1000,
+	-1000, -1000, 180, -1000, 723, 31, -1000, 405, 292, 386,
+	222, -1000, -1000, -1000, 726, 723, 712, -1000, 178, -1000,
+	-1000, 723, -1000, -1000, -1000, 749, 726, -1000, 404, -1000,
+	-1000, 152, -1000, -1000, 490, 214, 214, 749, 732, 723,
+	723, -1000, -1000, 732, 723, 723, -1000, 723, -1000, -1000,
+	-1000, -1000, 561, 705, 699, 439, -1000, 403, -1000, 752,
+	74, 73, 385, -1000, 177, 11, 173, -1000, -1000, -1000,
+	-1000, -1000, 148, 365, -1000, -1000, -1000, 16, 526, 70,
+	522, 723, -1000, 103, -1000, -1000, 726, 723, 152, 364,
+	214, 749, 749, 732, 723, -1000, -1000, 723, -1000, -1000,
+	-1000, 13, -1000, -1000, -1000, 150, 536, 564, -1000, 43,
+	-1000, 12, -1000, -1000, -51, -1000, 384, -1000, -1000, -1000,
+	337, -1000, 148, -1000, 723, -1000, -1000, -1000, 749, 732,
+	732, 723, -1000, -1000, 674, -1000, -1000, 59, 336, -1000,
+	402, -1000, 123, -6, -1000, -37, -1000, -1000, 732, 723,
+	723, -1000, -1000, 674, -1000, -80, 53, -18, -1000, 350,
+	349, 52, 723, -1000, -1000, -1000, 354, -1000, -1000, -1000,
+	-1000, 343, -1000, 43, -1000, 335, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 522, 778, 777, 776, 775, 15, 774, 773, 771,
-	770, 769, 768, 767, 766, 765, 764, 763, 762, 761,
-	760, 758, 757, 756, 755, 753, 6, 752, 751, 749,
-	748, 747, 746, 745, 744, 743, 742, 741, 740, 739,
-	738, 27, 12, 736, 734, 23, 75, 28, 733, 17,
-	22, 732, 730, 355, 728, 33, 35, 727, 725, 38,
-	21, 7, 724, 41, 3, 723, 13, 4, 722, 11,
-	16, 721, 10, 0, 718, 25, 717, 2, 1, 715,
-	20, 36, 714, 37, 9, 14, 704, 18, 5, 8,
-	702, 19,
+	0, 608, 911, 910, 908, 907, 11, 906, 905, 904,
+	903, 902, 901, 900, 899, 898, 897, 895, 894, 893,
+	892, 890, 889, 888, 886, 885, 27, 883, 882, 881,
+	880, 879, 878, 877, 875, 872, 871, 870, 867, 865,
+	864, 863, 862, 861, 860, 859, 858, 855, 854, 853,
+	852, 851, 849, 848, 43, 14, 847, 833, 33, 431,
+	30, 832, 24, 20, 831, 830, 26, 827, 28, 34,
+	826, 825, 29, 25, 10, 822, 37, 7, 35, 15,
+	6, 820, 13, 9, 814, 8, 0, 813, 17, 812,
+	4, 2, 811, 19, 36, 810, 32, 12, 18, 809,
+	16, 5, 3, 808, 22, 41, 807, 21, 805, 98,
+	803, 796, 23, 1, 795, 794, 618, 789, 31,
 }
 
 var yyR1 = [...]int{
-	0, 44, 45, 45, 45, 1, 1, 1, 1, 1,
+	0, 57, 58, 58, 58, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 6, 6, 41, 41, 43, 43, 43, 43, 43,
-	43, 63, 63, 62, 42, 42, 59, 59, 59, 59,
-	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
-	59, 59, 46, 47, 47, 47, 47, 48, 52, 53,
-	53, 53, 53, 53, 49, 49, 49, 50, 50, 51,
-	69, 69, 70, 70, 86, 86, 71, 71, 71, 71,
-	71, 71, 71, 71, 89, 89, 75, 75, 76, 76,
-	76, 55, 55, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, 56, 57, 60, 60, 64, 64, 64, 64,
-	64, 64, 64, 64, 81, 58, 58, 58, 58, 58,
-	58, 58, 58, 65, 65, 65, 67, 67, 66, 66,
-	68, 68, 68, 72, 73, 73, 73, 73, 74, 74,
-	74, 74, 2, 3, 3, 4, 80, 80, 79, 79,
-	79, 79, 79, 79, 79, 7, 7, 54, 54, 54,
-	54, 8, 8, 9, 9, 5, 5, 5, 10, 10,
-	77, 77, 78, 78, 78, 78, 11, 11, 12, 14,
-	13, 13, 15, 15, 16, 17, 19, 19, 19, 21,
-	21, 20, 20, 20, 22, 22, 18, 23, 23, 83,
-	83, 24, 24, 25, 25, 26, 26, 26, 26, 26,
-	61, 61, 82, 27, 27, 28, 28, 28, 28, 29,
-	29, 29, 29, 30, 30, 30, 30, 31, 31, 31,
-	31, 90, 91, 91, 88, 88, 84, 84, 87, 87,
-	85, 32, 33, 34, 35, 35, 35, 35, 36, 36,
-	36, 36, 37, 38, 38, 39, 40,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 6, 6, 105, 105, 106, 106,
+	106, 106, 107, 107, 107, 54, 54, 56, 56, 56,
+	56, 56, 56, 76, 76, 75, 55, 55, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 59, 60, 60, 60, 60, 61,
+	65, 63, 63, 63, 63, 63, 62, 62, 62, 66,
+	66, 67, 82, 82, 83, 83, 99, 99, 84, 84,
+	84, 84, 84, 84, 84, 84, 102, 102, 88, 88,
+	89, 89, 89, 68, 68, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 70, 73, 73, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 94, 71, 71,
+	71, 71, 71, 71, 71, 71, 78, 78, 78, 80,
+	80, 79, 79, 81, 81, 81, 85, 86, 86, 86,
+	86, 87, 87, 87, 87, 2, 3, 3, 4, 93,
+	93, 92, 92, 92, 92, 92, 92, 92, 7, 7,
+	64, 64, 64, 64, 8, 8, 9, 9, 5, 5,
+	5, 10, 10, 90, 90, 91, 91, 91, 91, 11,
+	11, 12, 14, 13, 13, 15, 15, 16, 17, 19,
+	19, 19, 116, 116, 116, 118, 118, 118, 118, 118,
+	117, 117, 21, 21, 20, 20, 20, 22, 22, 51,
+	52, 53, 18, 23, 23, 96, 96, 24, 24, 25,
+	25, 26, 26, 26, 26, 26, 74, 74, 95, 27,
+	27, 28, 28, 28, 28, 30, 30, 29, 29, 29,
+	29, 31, 31, 31, 31, 32, 32, 32, 32, 103,
+	104, 104, 101, 101, 97, 97, 100, 100, 98, 33,
+	34, 35, 36, 36, 36, 36, 37, 37, 37, 37,
+	38, 39, 39, 40, 41, 42, 108, 108, 108, 108,
+	43, 44, 45, 46, 47, 109, 109, 109, 111, 111,
+	112, 110, 110, 113, 113, 48, 49, 50, 114, 114,
+	115, 115,
 }
 
 var yyR2 = [...]int{
@@ -564,98 +678,117 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 9, 10, 1, 3, 1, 3, 3, 1, 3,
-	3, 1, 2, 4, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 2, 1, 1,
-	5, 6, 2, 1, 3, 1, 3, 3, 2, 5,
-	4, 4, 3, 1, 1, 1, 1, 2, 0, 8,
-	3, 0, 1, 3, 1, 1, 1, 3, 4, 6,
-	7, 1, 3, 1, 4, 0, 4, 0, 1, 1,
-	1, 2, 0, 1, 3, 3, 3, 5, 5, 4,
-	6, 6, 5, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 1, 3,
-	1, 2, 2, 2, 4, 2, 2, 0, 4, 2,
-	2, 0, 2, 4, 3, 2, 1, 2, 1, 2,
-	2, 2, 2, 1, 2, 9, 6, 2, 2, 2,
-	2, 5, 3, 7, 8, 6, 9, 9, 5, 4,
-	1, 2, 3, 3, 3, 3, 7, 6, 2, 3,
-	4, 3, 3, 2, 7, 6, 6, 7, 6, 5,
-	4, 6, 7, 6, 5, 4, 3, 8, 7, 2,
-	0, 7, 6, 11, 10, 2, 2, 4, 2, 2,
-	1, 3, 1, 3, 2, 10, 9, 9, 8, 13,
-	12, 12, 11, 10, 9, 9, 8, 9, 7, 6,
-	3, 3, 2, 0, 1, 3, 2, 0, 1, 3,
-	1, 3, 6, 4, 9, 8, 8, 7, 9, 8,
-	8, 7, 2, 7, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 10, 11, 2, 0, 5, 4,
+	3, 1, 1, 1, 2, 1, 3, 1, 3, 3,
+	1, 3, 3, 1, 2, 4, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 3, 2,
+	1, 1, 5, 6, 2, 1, 3, 1, 3, 3,
+	2, 5, 4, 4, 3, 1, 1, 1, 1, 2,
+	0, 8, 3, 0, 1, 3, 1, 1, 1, 3,
+	4, 6, 7, 1, 3, 1, 4, 0, 4, 0,
+	1, 1, 1, 2, 0, 1, 3, 3, 3, 5,
+	5, 4, 6, 6, 5, 3, 1, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 1, 3, 1, 2, 2, 2, 4, 2, 2,
+	0, 4, 2, 2, 0, 2, 4, 3, 2, 1,
+	2, 1, 2, 2, 2, 2, 1, 2, 9, 6,
+	2, 2, 2, 2, 5, 3, 7, 8, 6, 9,
+	9, 5, 4, 1, 2, 3, 3, 3, 3, 7,
+	6, 2, 3, 4, 3, 3, 2, 7, 6, 6,
+	7, 4, 1, 2, 1, 1, 1, 3, 5, 5,
+	1, 1, 5, 4, 6, 7, 4, 5, 4, 3,
+	3, 2, 3, 8, 7, 2, 0, 7, 6, 11,
+	10, 2, 2, 4, 2, 2, 1, 3, 1, 3,
+	2, 10, 9, 9, 8, 6, 5, 13, 12, 12,
+	11, 10, 9, 9, 8, 9, 7, 6, 3, 3,
+	2, 0, 1, 3, 2, 0, 1, 3, 1, 3,
+	6, 4, 9, 8, 8, 7, 9, 8, 8, 7,
+	2, 7, 3, 3, 3, 10, 3, 3, 5, 0,
+	6, 3, 15, 3, 3, 4, 2, 0, 1, 3,
+	4, 1, 3, 1, 3, 10, 7, 2, 1, 1,
+	1, 3,
 }
 
 var yyChk = [...]int{
-	-1000, -44, -45, -1, -6, -2, -3, -9, -5, -7,
+	-1000, -57, -58, -1, -6, -2, -3, -9, -5, -7,
 	-8, -11, -12, -14, -13, -15, -16, -17, -19, -21,
-	-22, -20, -18, -23, -24, -25, -27, -28, -29, -30,
-	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	7, 17, 18, 57, 29, 35, 48, 27, 70, 52,
-	91, -41, 110, -43, 117, -59, 92, 105, 114, -58,
-	107, 58, 109, 106, 108, 63, 64, -81, 94, 38,
-	40, 41, 56, 37, 65, 66, 54, 5, 79, 46,
-	39, 41, 36, 5, 39, 56, 41, 36, 46, 5,
-	-46, -55, 4, 8, 41, 5, 31, 105, 31, 105,
-	71, -6, 32, -1, -46, -41, 90, 102, 9, 117,
-	118, 113, 114, 116, 119, 120, 115, -59, 92, 102,
-	-59, -63, 105, -62, 59, -83, 6, 42, -83, 72,
-	73, 67, 68, 69, 67, 69, 53, 72, 73, 83,
-	105, 43, 105, -53, 105, 101, -49, 108, -81, 105,
-	-46, -55, 43, 105, 106, 105, -55, -47, -52, -48,
-	-53, 92, -56, -57, 92, 105, 26, 25, -60, -59,
-	43, -53, 6, 20, 23, 6, 6, 20, 4, 6,
-	-6, 53, -55, -46, -41, 65, 66, 105, 108, -59,
-	-59, -59, -59, -59, -59, -59, -59, 93, -41, 93,
-	-65, 105, 65, 66, 61, -63, -63, -56, 30, -55,
-	105, 6, -46, -55, 73, -83, -83, -83, 72, 73,
-	72, 73, -83, 72, 73, 105, 73, -83, -4, 30,
-	105, 30, 30, 101, 105, -55, 105, 90, 90, -50,
-	-51, 19, -45, 111, 112, -59, -56, 24, 25, 92,
-	26, -64, 95, 96, 97, 98, 99, 100, 104, 103,
-	105, 30, 105, 6, 23, 105, 105, 105, 6, 4,
-	105, 105, 105, -69, 10, -55, 93, -59, 61, 60,
-	5, -67, 12, 105, -55, -67, -83, -46, -55, -46,
-	-55, -46, 30, 73, -83, 73, -83, -46, -67, 73,
-	-83, -83, -46, -55, -80, -79, -78, 44, 55, 33,
-	34, 45, 74, 46, 49, 50, 47, 6, 32, 84,
-	74, 105, 101, -49, 101, 6, -47, -47, -50, 21,
-	93, -56, -56, 93, 92, 24, -6, 92, -60, 92,
-	6, 74, 23, 105, 105, 23, 4, 105, 105, 4,
-	95, -75, 28, 11, -69, 62, 105, -59, -54, 95,
-	96, 104, 103, -72, -73, 13, 14, 11, -67, -73,
-	-46, -55, -55, -69, -55, -67, 30, 69, -83, -46,
-	30, -83, -46, -55, -67, -73, -83, -46, -55, -46,
-	-55, -55, -69, -80, 107, 106, 105, 106, -87, -85,
-	105, 44, 44, 44, 44, 105, 108, -91, -90, 105,
-	-87, 101, -49, 105, -49, 105, 22, -42, -6, 105,
-	92, 93, -6, -56, 105, -87, 105, 23, 105, 105,
-	4, 105, 108, -67, 92, -70, -71, -86, 105, 117,
-	-81, 108, -75, 62, -55, 105, 105, -81, -81, -74,
-	15, 16, 106, 106, -66, -68, 105, -73, -55, -69,
-	-69, -73, -67, -72, 69, -26, 95, 96, 24, 104,
-	103, -46, 30, 30, 69, -46, -55, -55, -69, -73,
-	-46, -55, -55, -69, -55, -69, -69, -73, 90, 107,
-	107, 107, 107, -10, 44, 30, 74, -91, 85, -84,
-	51, -49, 105, 93, 93, 90, -6, -42, 93, 93,
-	-80, -84, 105, 105, -72, -76, 105, 106, 109, 90,
-	102, 92, 102, -67, -67, 106, 106, 14, 90, 88,
-	89, -69, -73, -73, -72, -26, -55, -61, -82, 105,
-	-61, 92, -81, -81, 30, 69, 69, -26, -55, -69,
-	-69, -73, -55, -69, -69, -73, -69, -73, -73, -85,
-	45, 107, 31, 87, -87, -88, 105, 105, 6, -42,
-	93, 93, -89, 105, 93, -70, 65, 107, 65, -72,
-	-72, 16, 106, -66, -73, -55, -67, 90, -61, 69,
-	-26, -26, -55, -69, -73, -73, -69, -73, -73, -73,
-	55, 20, 20, -84, 90, 105, 92, 93, 90, -89,
-	106, -67, -73, -61, 93, -26, -55, -55, -69, -73,
-	-73, 106, -88, -64, 108, 107, 114, -73, -55, -69,
-	-69, -73, -77, -78, 105, 93, 93, 107, -69, -73,
-	-73, -77, 93, -73,
+	-22, -20, -18, -51, -52, -53, -23, -24, -25, -27,
+	-28, -30, -29, -31, -32, -33, -34, -35, -36, -37,
+	-38, -39, -40, -41, -42, -43, -44, -45, -46, -47,
+	-48, -49, -50, 7, 17, 18, 57, 29, 35, 48,
+	27, 70, 52, 91, -54, 110, -56, 117, -72, 92,
+	105, 114, -71, 107, 58, 109, 106, 108, 63, 64,
+	-94, 94, 38, 40, 41, 56, 37, 135, 65, 66,
+	54, 5, 79, 46, 75, 128, 81, 39, 41, 36,
+	134, 5, 75, 127, 82, 39, 56, 41, 134, 36,
+	46, 5, 75, 127, 82, -59, -68, 4, 8, 41,
+	5, -116, 105, 31, -116, 105, 31, 71, -6, 32,
+	-1, -105, 122, -54, 90, 102, 9, 117, 118, 113,
+	114, 116, 119, 120, 115, -72, 92, 102, -72, -76,
+	105, -75, 59, -96, 6, 42, -96, 72, 73, 67,
+	68, 69, 73, 67, 69, 53, 72, 73, 83, 77,
+	-96, 105, 43, 105, 105, -63, 105, 101, -62, 108,
+	-94, 86, -109, 6, 105, 105, -59, -68, 43, 105,
+	105, 106, 105, 86, -109, 105, -68, -60, -65, -61,
+	-63, 92, -69, -70, 92, 105, 26, 25, -73, -72,
+	43, -63, 6, 23, 20, 23, 6, 4, 20, 4,
+	-6, 53, -59, -106, 105, -107, 108, 123, -105, -54,
+	65, 66, 105, 108, -72, -72, -72, -72, -72, -72,
+	-72, -72, 93, -54, 93, -78, 105, 65, 66, 61,
+	-76, -76, -69, 30, -68, 105, 6, -59, -68, 73,
+	-96, -96, -96, 72, 73, 72, 73, -96, -96, 72,
+	73, 105, 73, -96, -4, 30, 105, 30, 30, 101,
+	105, 105, 92, 105, 6, -68, 105, 105, 6, 90,
+	90, -66, -67, 19, -58, 111, 112, -72, -69, 24,
+	25, 92, 26, -77, 95, 96, 97, 98, 99, 100,
+	104, 103, 131, 105, 30, -118, 117, 105, 105, 23,
+	105, -118, 105, 4, 105, 105, -68, 101, 5, -59,
+	93, -72, 61, 60, 5, -80, 12, 105, -68, -80,
+	-96, -59, -68, -59, -68, -59, 30, 73, -96, 73,
+	-96, -59, -86, 13, 14, -59, -80, 73, -96, -96,
+	-59, -68, -93, -92, -91, 44, 55, 33, 34, 45,
+	74, 46, 49, 50, 47, 6, 32, 84, 74, 105,
+	101, -62, 101, 6, -111, -112, -78, 101, 105, 6,
+	6, 105, -60, -60, -66, 21, 93, -69, -69, 93,
+	92, 24, -6, 92, -73, 92, 6, 74, 23, 101,
+	105, 4, 105, 95, -82, 10, 105, 101, -107, -68,
+	62, 105, -72, -64, 95, 96, 104, 103, -85, -86,
+	11, -80, -86, -59, -68, -68, -82, -68, -80, 30,
+	69, -96, -59, 30, -96, -59, -68, -86, 106, 106,
+	-80, -86, -96, -59, -68, -59, -68, -68, -82, -93,
+	107, 106, 105, 106, -100, -98, 105, 44, 44, 44,
+	44, 105, 108, -104, -103, 105, -100, 101, -62, 105,
+	-62, 105, 93, 90, 92, 105, 101, 105, 105, 101,
+	22, -55, -6, 105, 92, 93, -6, -69, 105, -100,
+	105, 134, -117, 105, 117, 105, 134, 108, -88, 28,
+	11, 101, -107, 105, -82, 62, -68, 105, 105, -94,
+	-94, -87, 15, 16, -79, -81, 105, -86, -68, -82,
+	-82, -86, -80, -85, 69, -26, 95, 96, 24, 104,
+	103, -59, 30, 30, 69, -59, -68, -68, -82, 14,
+	-86, -59, -68, -68, -82, -68, -82, -82, -86, 90,
+	107, 107, 107, 107, -10, 44, 30, 74, -104, 85,
+	-97, 51, -62, -108, 125, 30, -112, -110, 105, 105,
+	105, -63, 93, 93, 90, -6, -55, 93, 93, -93,
+	-97, 105, 101, 105, -80, 92, -83, -84, -99, 105,
+	117, -94, 108, -107, -88, -80, 106, 106, 90, 88,
+	89, -82, -86, -86, -85, -26, -68, -74, -95, 105,
+	-74, 92, -94, -94, 30, 69, 69, -26, -68, -82,
+	-82, -86, 106, -68, -82, -82, -86, -82, -86, -86,
+	-98, 45, 107, 31, 87, -100, -101, 105, 105, 124,
+	126, 53, 129, 93, 90, 133, 6, -55, 93, 93,
+	105, -94, -85, -89, 105, 106, 109, 90, 102, 92,
+	102, -80, -85, 16, -79, -86, -68, -80, 90, -74,
+	69, -26, -26, -68, -82, -86, -86, -82, -86, -86,
+	-86, 55, 20, 20, -97, 90, -6, 107, 107, 92,
+	105, -114, 31, 132, 105, -102, 105, 93, -83, 65,
+	107, 65, -85, 106, -80, -86, -74, 93, -26, -68,
+	-68, -82, -86, -86, 106, -101, 62, 53, -113, 107,
+	-115, 108, -77, 92, 93, 90, -102, -86, -68, -82,
+	-82, -86, -90, -91, 107, 93, 90, 90, 105, 108,
+	107, 114, -82, -86, -86, -90, 130, 107, 108, 93,
+	93, 107, -86, 92, 93, -113, 93,
 }
 
 var yyDef = [...]int{
@@ -663,67 +796,79 @@ var yyDef = [...]int{
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
-	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
-	3, 0, 0, 43, 45, 48, 0, 135, 0, 68,
-	69, 0, 137, 138, 139, 140, 141, 142, 134, 162,
-	220, 0, 220, 198, 0, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
-	112, 203, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 0, 4, 112, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	67, 0, 0, 51, 0, 112, 0, 182, 112, 0,
-	220, 220, 220, 0, 220, 0, 0, 0, 220, 275,
-	164, 0, 0, 250, 84, 0, 83, 85, 86, 199,
-	112, 201, 0, 216, 261, 276, 202, 72, 73, 75,
-	88, 0, 111, 113, 0, 135, 0, 0, 0, 124,
-	0, 274, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 91, 112, 44, 46, 47, 49, 50, 56,
-	57, 58, 59, 60, 61, 62, 63, 64, 0, 66,
-	136, 143, 144, 145, 0, 0, 52, 0, 0, 147,
-	219, 0, 112, 147, 220, 112, 112, 0, 0, 220,
-	0, 220, 147, 0, 220, 263, 220, 112, 163, 0,
-	0, 0, 0, 0, 0, 200, 0, 0, 0, 78,
-	88, 0, 0, 0, 0, 124, 0, 0, 0, 0,
-	0, 0, 126, 127, 128, 129, 130, 131, 132, 133,
-	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	215, 0, 0, 107, 0, 91, 65, 0, 0, 0,
-	0, 157, 0, 181, 147, 157, 112, 112, 91, 112,
-	147, 0, 0, 220, 0, 220, 112, 147, 157, 220,
-	112, 112, 112, 91, 165, 166, 168, 0, 0, 0,
-	0, 173, 0, 0, 0, 0, 0, 0, 0, 253,
-	0, 84, 0, 82, 0, 0, 74, 76, 87, 0,
-	77, 115, 116, -2, 0, 0, 0, 0, 123, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 214, 0,
-	0, 147, 0, 0, 107, 70, 0, 53, 112, 0,
-	0, 0, 0, 176, 161, 0, 0, 0, 157, 197,
-	112, 91, 91, 157, 147, 157, 0, 0, 0, 0,
-	0, 112, 112, 91, 157, 222, 112, 112, 91, 112,
-	91, 91, 157, 167, 169, 170, 171, 172, 174, 258,
-	260, 0, 0, 0, 0, 0, 185, 249, 253, 0,
-	257, 0, 81, 84, 80, 205, 0, 0, 0, 54,
-	0, 119, 0, 0, 0, 257, 206, 0, 208, 211,
-	0, 213, 262, 157, 0, 90, 92, 96, 94, 101,
-	103, 95, 147, 71, 147, 177, 178, 179, 180, 153,
-	0, 0, 155, 156, 146, 148, 150, 196, 91, 157,
-	157, 271, 157, 218, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 112, 91, 91, 157, 221,
-	112, 91, 91, 157, 91, 157, 157, 267, 0, 192,
-	193, 194, 195, 183, 0, 0, 0, 252, 0, 248,
-	0, 79, 0, 117, 118, 0, 0, 0, 122, 125,
-	204, 273, 207, 212, 105, 0, 108, 109, 110, 0,
-	0, 0, 0, 157, 157, 159, 160, 0, 0, 151,
-	152, 157, 269, 270, 217, 112, 147, 225, 230, 232,
-	226, 0, 228, 229, 0, 0, 0, 112, 91, 157,
-	157, 238, 91, 157, 157, 246, 157, 265, 266, 259,
-	184, 0, 0, 0, 257, 251, 254, 256, 0, 55,
-	120, 121, 41, 0, 106, 93, 97, 0, 102, 105,
-	175, 0, 154, 149, 268, 147, 157, 0, 0, 0,
-	112, 112, 91, 157, 236, 237, 157, 244, 245, 264,
-	0, 186, 187, 247, 0, 0, 0, 98, 0, 42,
-	158, 157, 224, 231, 227, 112, 91, 91, 157, 235,
-	243, 189, 255, 0, 0, 0, 0, 223, 91, 157,
-	157, 242, 188, 190, 89, 104, 99, 0, 157, 240,
-	241, 191, 100, 239,
+	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
+	51, 52, 53, 0, 0, 0, 0, 134, 0, 0,
+	0, 0, 0, 3, 57, 0, 65, 67, 70, 0,
+	158, 0, 90, 91, 0, 160, 161, 162, 163, 164,
+	165, 157, 185, 256, 0, 256, 221, 251, 0, 0,
+	0, 0, 310, 0, 0, 256, 337, 0, 0, 0,
+	0, 0, 0, 327, 0, 0, 134, 0, 0, 0,
+	0, 0, 0, 327, 0, 134, 226, 0, 0, 0,
+	0, 0, 234, 232, 0, 234, 232, 0, 270, 0,
+	4, 0, 0, 57, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 89, 0,
+	0, 73, 0, 134, 0, 205, 134, 0, 256, 256,
+	256, 0, 256, 256, 0, 0, 0, 256, 313, 321,
+	324, 187, 0, 0, 249, 288, 106, 0, 105, 107,
+	108, 0, 0, 0, 0, 222, 134, 224, 0, 250,
+	252, 299, 314, 0, 323, 0, 225, 94, 95, 97,
+	110, 0, 133, 135, 0, 158, 0, 0, 0, 146,
+	0, 312, 0, 0, 233, 0, 0, 0, 233, 0,
+	269, 0, 134, 56, 62, 61, 63, 0, 0, 66,
+	68, 69, 71, 72, 78, 79, 80, 81, 82, 83,
+	84, 85, 86, 0, 88, 159, 166, 167, 168, 0,
+	0, 74, 0, 0, 170, 255, 0, 134, 170, 256,
+	134, 134, 0, 0, 256, 0, 256, 180, 170, 0,
+	256, 301, 256, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 0, 223, 0, 0, 0, 0,
+	0, 100, 110, 0, 0, 0, 0, 146, 0, 0,
+	0, 0, 0, 0, 148, 149, 150, 151, 152, 153,
+	154, 155, 156, 0, 0, 0, 235, 236, 231, 0,
+	243, 0, 246, 0, 248, 0, 113, 0, 64, 134,
+	87, 0, 0, 0, 0, 180, 0, 204, 170, 180,
+	134, 134, 113, 134, 170, 0, 0, 256, 0, 256,
+	134, 180, 276, 0, 0, 170, 180, 256, 134, 134,
+	134, 113, 188, 189, 191, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 106,
+	0, 104, 0, 0, 0, 328, 0, 0, 0, 0,
+	0, 0, 96, 98, 109, 0, 99, 137, 138, -2,
+	0, 0, 0, 0, 145, 0, 0, 0, 0, 0,
+	242, 0, 247, 0, 129, 0, 62, 0, 60, 113,
+	92, 0, 75, 134, 0, 0, 0, 0, 199, 184,
+	0, 180, 220, 134, 113, 113, 180, 170, 180, 0,
+	0, 0, 0, 0, 134, 134, 113, 275, 178, 179,
+	180, 258, 134, 134, 113, 134, 113, 113, 180, 190,
+	192, 193, 194, 195, 197, 296, 298, 0, 0, 0,
+	0, 0, 208, 287, 291, 0, 295, 0, 103, 106,
+	102, 319, 0, 0, 0, 325, 0, 228, 320, 0,
+	0, 0, 0, 76, 0, 141, 0, 0, 0, 295,
+	229, 0, 237, 240, 241, 244, 0, 300, 170, 0,
+	0, 0, 59, 62, 129, 93, 170, 200, 201, 202,
+	203, 176, 0, 0, 169, 171, 173, 219, 113, 180,
+	180, 309, 180, 254, 0, 134, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 113, 113, 180, 0,
+	257, 134, 113, 113, 180, 113, 180, 180, 305, 0,
+	215, 216, 217, 218, 206, 0, 0, 0, 290, 0,
+	286, 0, 101, 0, 0, 0, 329, 0, 331, 0,
+	336, 0, 139, 140, 0, 0, 0, 144, 147, 227,
+	311, 230, 0, 245, 180, 0, 112, 114, 118, 116,
+	123, 125, 117, 58, 170, 180, 182, 183, 0, 174,
+	175, 180, 307, 308, 253, 134, 170, 261, 266, 268,
+	262, 0, 264, 265, 0, 0, 0, 134, 113, 180,
+	180, 274, 177, 113, 180, 180, 284, 180, 303, 304,
+	297, 207, 0, 0, 0, 295, 289, 292, 294, 0,
+	0, 0, 0, 330, 0, 0, 0, 77, 142, 143,
+	238, 239, 127, 0, 130, 131, 132, 0, 0, 0,
+	0, 180, 198, 0, 172, 306, 170, 180, 0, 0,
+	0, 134, 134, 113, 180, 272, 273, 180, 282, 283,
+	302, 0, 209, 210, 285, 0, 0, 316, 317, 0,
+	332, 0, 338, 339, 0, 54, 0, 128, 115, 119,
+	0, 124, 127, 181, 180, 260, 267, 263, 134, 113,
+	113, 180, 271, 281, 212, 293, 315, 0, 0, 333,
+	335, 340, 0, 0, 120, 0, 55, 259, 113, 180,
+	180, 280, 211, 213, 318, 0, 0, 0, 111, 0,
+	0, 0, 180, 278, 279, 214, 0, 334, 341, 126,
+	121, 0, 277, 0, 122, 0, 322,
 }
 
 var yyTok1 = [...]int{
@@ -743,6 +888,8 @@ var yyTok2 = [...]int{
 	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135,
 }
 
 var yyTok3 = [...]int{
@@ -1088,19 +1235,20 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:151
+//line sql.y:227
 		{
+			checkJoinSources(yylex, yyDollar[1].stmts)
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:157
+//line sql.y:234
 		{
 			yyVAL.stmts = []influxql.Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:161
+//line sql.y:238
 		{
 
 			if len(yyDollar[1].stmts) == 1 {
@@ -1111,242 +1259,321 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:170
+//line sql.y:247
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:178
+//line sql.y:255
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:182
+//line sql.y:259
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:186
+//line sql.y:263
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:190
+//line sql.y:267
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:271
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:198
+//line sql.y:275
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:202
+//line sql.y:279
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:206
+//line sql.y:283
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:210
+//line sql.y:287
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:214
+//line sql.y:291
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:218
+//line sql.y:295
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:222
+//line sql.y:299
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:226
+//line sql.y:303
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:230
+//line sql.y:307
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:234
+//line sql.y:311
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:238
+//line sql.y:315
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:242
+//line sql.y:319
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:246
+//line sql.y:323
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:250
+//line sql.y:327
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:254
+//line sql.y:331
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:258
+//line sql.y:335
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:262
+//line sql.y:339
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:266
+//line sql.y:343
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:270
+//line sql.y:347
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:274
+//line sql.y:351
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:278
+//line sql.y:355
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:282
+//line sql.y:359
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:286
+//line sql.y:363
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:290
+//line sql.y:367
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:294
+//line sql.y:371
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:298
+//line sql.y:375
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:302
+//line sql.y:379
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:306
+//line sql.y:383
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:310
+//line sql.y:387
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:314
+//line sql.y:391
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:318
+//line sql.y:395
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:326
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:399
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:403
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 43:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:407
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 44:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:411
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:415
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:419
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:423
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:427
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:431
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:435
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:439
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:443
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:447
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 54:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:455
 		{
 			stmt := &influxql.SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
-			stmt.Sources = yyDollar[3].sources
-			stmt.Dimensions = yyDollar[5].dimens
-			stmt.Condition = yyDollar[4].expr
-			stmt.SortFields = yyDollar[7].sortfs
-			stmt.Limit = yyDollar[8].intSlice[0]
-			stmt.Offset = yyDollar[8].intSlice[1]
-			stmt.SLimit = yyDollar[8].intSlice[2]
-			stmt.SOffset = yyDollar[8].intSlice[3]
+			stmt.Target = yyDollar[3].target
+			stmt.Sources = yyDollar[4].sources
+			stmt.Dimensions = yyDollar[6].dimens
+			stmt.Condition = yyDollar[5].expr
+			stmt.SortFields = yyDollar[8].sortfs
+			stmt.Limit = yyDollar[9].intSlice[0]
+			stmt.Offset = yyDollar[9].intSlice[1]
+			stmt.SLimit = yyDollar[9].intSlice[2]
+			stmt.SOffset = yyDollar[9].intSlice[3]
 
-			tempfill, tempfillvalue, fillflag := deal_Fill(yyDollar[6].inter)
+			tempfill, tempfillvalue, fillflag := deal_Fill(yyDollar[7].inter)
 			if fillflag == false {
 				yylex.Error("Invalid characters in fill")
 			} else {
@@ -1358,26 +1585,27 @@ yydefault:
 					stmt.IsRawQuery = false
 				}
 			})
-			stmt.Location = yyDollar[9].location
+			stmt.Location = yyDollar[10].location
 			yyVAL.stmt = stmt
 		}
-	case 42:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:354
+	case 55:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:484
 		{
 			stmt := &influxql.SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
 			stmt.Fields = yyDollar[3].fields
-			stmt.Sources = yyDollar[4].sources
-			stmt.Dimensions = yyDollar[6].dimens
-			stmt.Condition = yyDollar[5].expr
-			stmt.SortFields = yyDollar[8].sortfs
-			stmt.Limit = yyDollar[9].intSlice[0]
-			stmt.Offset = yyDollar[9].intSlice[1]
-			stmt.SLimit = yyDollar[9].intSlice[2]
-			stmt.SOffset = yyDollar[9].intSlice[3]
+			stmt.Target = yyDollar[4].target
+			stmt.Sources = yyDollar[5].sources
+			stmt.Dimensions = yyDollar[7].dimens
+			stmt.Condition = yyDollar[6].expr
+			stmt.SortFields = yyDollar[9].sortfs
+			stmt.Limit = yyDollar[10].intSlice[0]
+			stmt.Offset = yyDollar[10].intSlice[1]
+			stmt.SLimit = yyDollar[10].intSlice[2]
+			stmt.SOffset = yyDollar[10].intSlice[3]
 
-			tempfill, tempfillvalue, fillflag := deal_Fill(yyDollar[7].inter)
+			tempfill, tempfillvalue, fillflag := deal_Fill(yyDollar[8].inter)
 			if fillflag == false {
 				yylex.Error("Invalid characters in fill")
 			} else {
@@ -1389,150 +1617,211 @@ yydefault:
 					stmt.IsRawQuery = false
 				}
 			})
-			stmt.Location = yyDollar[10].location
+			stmt.Location = yyDollar[11].location
 			yyVAL.stmt = stmt
 		}
-	case 43:
+	case 56:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:518
+		{
+			yyVAL.target = &influxql.Target{Measurement: yyDollar[2].ment}
+		}
+	case 57:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:522
+		{
+			yyVAL.target = nil
+		}
+	case 58:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:528
+		{
+			mst := yyDollar[5].ment
+			mst.Database = yyDollar[1].str
+			mst.RetentionPolicy = yyDollar[3].str
+			yyVAL.ment = mst
+		}
+	case 59:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:535
+		{
+			mst := yyDollar[4].ment
+			mst.Database = yyDollar[1].str
+			yyVAL.ment = mst
+		}
+	case 60:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:541
+		{
+			mst := yyDollar[3].ment
+			mst.RetentionPolicy = yyDollar[1].str
+			yyVAL.ment = mst
+		}
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:387
+//line sql.y:547
+		{
+			yyVAL.ment = yyDollar[1].ment
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:553
+		{
+			yyVAL.ment = &influxql.Measurement{Name: yyDollar[1].str, IsTarget: true}
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:557
+		{
+			yyVAL.ment = &influxql.Measurement{Name: yyDollar[1].str, IsTarget: true}
+		}
+	case 64:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:561
+		{
+			yyVAL.ment = &influxql.Measurement{IsTarget: true}
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:569
 		{
 			yyVAL.fields = []*influxql.Field{yyDollar[1].field}
 		}
-	case 44:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:391
+//line sql.y:573
 		{
 			yyVAL.fields = append([]*influxql.Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 45:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:397
+//line sql.y:579
 		{
 			yyVAL.field = &influxql.Field{Expr: &influxql.Wildcard{Type: influxql.Token(yyDollar[1].int)}}
 		}
-	case 46:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:401
+//line sql.y:583
 		{
 			yyVAL.field = &influxql.Field{Expr: &influxql.Wildcard{Type: influxql.TAG}}
 		}
-	case 47:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:405
+//line sql.y:587
 		{
 			yyVAL.field = &influxql.Field{Expr: &influxql.Wildcard{Type: influxql.FIELD}}
 		}
-	case 48:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:409
+//line sql.y:591
 		{
 			yyVAL.field = &influxql.Field{Expr: yyDollar[1].expr}
 		}
-	case 49:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:413
+//line sql.y:595
 		{
 			yyVAL.field = &influxql.Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 50:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:417
+//line sql.y:599
 		{
 			yyVAL.field = &influxql.Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 51:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:423
+//line sql.y:605
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 52:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:427
+//line sql.y:609
 		{
 			c := yyDollar[1].expr.(*influxql.CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*influxql.CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*influxql.CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 53:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:436
+//line sql.y:618
 		{
 			c := &influxql.CaseWhenExpr{}
 			c.Conditions = []influxql.Expr{yyDollar[2].expr}
 			c.Assigners = []influxql.Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 54:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:445
+//line sql.y:627
 		{
 			yyVAL.fields = []*influxql.Field{&influxql.Field{Expr: &influxql.VarRef{Val: yyDollar[1].str}}}
 		}
-	case 55:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:449
+//line sql.y:631
 		{
 			yyVAL.fields = append([]*influxql.Field{&influxql.Field{Expr: &influxql.VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 56:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:455
+//line sql.y:637
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 57:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:459
+//line sql.y:641
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 58:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:463
+//line sql.y:645
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 59:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:467
+//line sql.y:649
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 60:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:471
+//line sql.y:653
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 61:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:475
+//line sql.y:657
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 62:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:479
+//line sql.y:661
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 63:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:483
+//line sql.y:665
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(influxql.BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 64:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:487
+//line sql.y:669
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 65:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:491
+//line sql.y:673
 		{
 			cols := &influxql.Call{Name: strings.ToLower(yyDollar[1].str), Args: []influxql.Expr{}}
 			for i := range yyDollar[3].fields {
@@ -1540,16 +1829,16 @@ yydefault:
 			}
 			yyVAL.expr = cols
 		}
-	case 66:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:499
+//line sql.y:681
 		{
 			cols := &influxql.Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 67:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:504
+//line sql.y:686
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *influxql.NumberLiteral:
@@ -1563,66 +1852,66 @@ yydefault:
 			}
 
 		}
-	case 68:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:518
+//line sql.y:700
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 69:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:522
+//line sql.y:704
 		{
 			yyVAL.expr = &influxql.DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 70:
+	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:526
+//line sql.y:708
 		{
 			c := yyDollar[2].expr.(*influxql.CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 71:
+	case 93:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:532
+//line sql.y:714
 		{
 			yyVAL.expr = &influxql.VarRef{}
 		}
-	case 72:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:538
+//line sql.y:720
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 73:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:544
+//line sql.y:726
 		{
-			yyVAL.sources = []influxql.Source{yyDollar[1].ment}
+			yyVAL.sources = []influxql.Source{yyDollar[1].source}
 		}
-	case 74:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:730
 		{
-			yyVAL.sources = append([]influxql.Source{yyDollar[1].ment}, yyDollar[3].sources...)
+			yyVAL.sources = append([]influxql.Source{yyDollar[1].source}, yyDollar[3].sources...)
 		}
-	case 75:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:552
+//line sql.y:734
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 76:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:557
+//line sql.y:739
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 77:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:563
+//line sql.y:745
 		{
 			all_subquerys := []influxql.Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -1636,66 +1925,71 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 78:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:579
+//line sql.y:761
 		{
-			yyVAL.ment = yyDollar[1].ment
+			var src influxql.Source = yyDollar[1].ment
+			for _, join := range yyDollar[2].joins {
+				join.LSrc = src
+				src = join
+			}
+			yyVAL.source = src
 		}
-	case 79:
+	case 101:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:585
+//line sql.y:772
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 80:
+	case 102:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:592
+//line sql.y:779
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 81:
+	case 103:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:598
+//line sql.y:785
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 82:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:604
+//line sql.y:791
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 83:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:610
+//line sql.y:797
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 84:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:616
+//line sql.y:803
 		{
 			yyVAL.ment = &influxql.Measurement{Name: yyDollar[1].str}
 		}
-	case 85:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:620
+//line sql.y:807
 		{
 			yyVAL.ment = &influxql.Measurement{Name: yyDollar[1].str}
 		}
-	case 86:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:624
+//line sql.y:811
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -1704,75 +1998,78 @@ yydefault:
 
 			yyVAL.ment = &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: re}}
 		}
-	case 87:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:635
+//line sql.y:822
 		{
-			yyVAL.ment = &influxql.Measurement{}
+			yyVAL.joins = append([]*influxql.Join{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 88:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:639
+//line sql.y:826
 		{
-			yyVAL.ment = &influxql.Measurement{}
+			yyVAL.joins = nil
 		}
-	case 89:
+	case 111:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:645
+//line sql.y:832
 		{
-			yyVAL.ment = &influxql.Measurement{}
+			yyVAL.join = &influxql.Join{
+				RSrc:      yyDollar[4].ment,
+				Condition: &influxql.BinaryExpr{Op: influxql.Token(yyDollar[7].int), LHS: &influxql.VarRef{Val: yyDollar[6].str}, RHS: &influxql.VarRef{Val: yyDollar[8].str}},
+			}
 		}
-	case 90:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:651
+//line sql.y:841
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 91:
+	case 113:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:655
+//line sql.y:845
 		{
 			yyVAL.dimens = nil
 		}
-	case 92:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:851
 		{
 			yyVAL.dimens = []*influxql.Dimension{yyDollar[1].dimen}
 		}
-	case 93:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:665
+//line sql.y:855
 		{
 			yyVAL.dimens = append([]*influxql.Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 94:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:671
+//line sql.y:861
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 95:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:675
+//line sql.y:865
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 96:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:681
+//line sql.y:871
 		{
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.VarRef{Val: yyDollar[1].str}}
 		}
-	case 97:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:685
+//line sql.y:875
 		{
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.VarRef{Val: yyDollar[1].str}}
 		}
-	case 98:
+	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:689
+//line sql.y:879
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -1780,9 +2077,9 @@ yydefault:
 
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.Call{Name: "time", Args: []influxql.Expr{&influxql.DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 99:
+	case 121:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:697
+//line sql.y:887
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -1790,9 +2087,9 @@ yydefault:
 
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.Call{Name: "time", Args: []influxql.Expr{&influxql.DurationLiteral{Val: yyDollar[3].tdur}, &influxql.DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 100:
+	case 122:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:705
+//line sql.y:895
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -1800,21 +2097,21 @@ yydefault:
 
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.Call{Name: "time", Args: []influxql.Expr{&influxql.DurationLiteral{Val: yyDollar[3].tdur}, &influxql.DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 101:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:713
+//line sql.y:903
 		{
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.Wildcard{Type: influxql.Token(yyDollar[1].int)}}
 		}
-	case 102:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:717
+//line sql.y:907
 		{
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.Wildcard{Type: influxql.Token(yyDollar[1].int)}}
 		}
-	case 103:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:721
+//line sql.y:911
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -1822,9 +2119,9 @@ yydefault:
 			}
 			yyVAL.dimen = &influxql.Dimension{Expr: &influxql.RegexLiteral{Val: re}}
 		}
-	case 104:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:732
+//line sql.y:922
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -1835,117 +2132,117 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 105:
+	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:743
+//line sql.y:933
 		{
 			yyVAL.location = nil
 		}
-	case 106:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:749
+//line sql.y:939
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 107:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:753
+//line sql.y:943
 		{
 			yyVAL.inter = "null"
 		}
-	case 108:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:759
+//line sql.y:949
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 109:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:763
+//line sql.y:953
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 110:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:767
+//line sql.y:957
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 111:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:773
+//line sql.y:963
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 112:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:777
+//line sql.y:967
 		{
 			yyVAL.expr = nil
 		}
-	case 113:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:783
+//line sql.y:973
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 114:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:787
+//line sql.y:977
 		{
 			yyVAL.expr = &influxql.ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 115:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:791
+//line sql.y:981
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 116:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:795
+//line sql.y:985
 		{
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 117:
+	case 139:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:799
+//line sql.y:989
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 118:
+	case 140:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:803
+//line sql.y:993
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 119:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:807
+//line sql.y:997
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 120:
+	case 142:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:811
+//line sql.y:1001
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 121:
+	case 143:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:815
+//line sql.y:1005
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 122:
+	case 144:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:819
+//line sql.y:1009
 		{
 			yyVAL.expr = &influxql.BinaryExpr{}
 		}
-	case 123:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:1015
 		{
 			if yyDollar[2].int == influxql.NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -1954,119 +2251,132 @@ yydefault:
 					yylex.Error("expected regular expression")
 				}
 			}
+			if yyDollar[2].int == influxql.MATCH {
+				switch yyDollar[3].expr.(type) {
+				case *influxql.StringLiteral:
+				default:
+					yylex.Error("expected string after MATCH")
+				}
+			}
 			yyVAL.expr = &influxql.BinaryExpr{Op: influxql.Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 124:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:838
+//line sql.y:1035
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 125:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:842
+//line sql.y:1039
 		{
 			yyVAL.expr = &influxql.ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 126:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:848
+//line sql.y:1045
 		{
 			yyVAL.int = influxql.EQ
 		}
-	case 127:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:852
+//line sql.y:1049
 		{
 			yyVAL.int = influxql.NEQ
 		}
-	case 128:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:856
+//line sql.y:1053
 		{
 			yyVAL.int = influxql.LT
 		}
-	case 129:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:860
+//line sql.y:1057
 		{
 			yyVAL.int = influxql.LTE
 		}
-	case 130:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:864
+//line sql.y:1061
 		{
 			yyVAL.int = influxql.GT
 		}
-	case 131:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:868
+//line sql.y:1065
 		{
 			yyVAL.int = influxql.GTE
 		}
-	case 132:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:872
+//line sql.y:1069
 		{
 			yyVAL.int = influxql.EQREGEX
 		}
-	case 133:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:876
+//line sql.y:1073
 		{
 			yyVAL.int = influxql.NEQREGEX
 		}
-	case 134:
+	case 156:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1077
+		{
+			yyVAL.int = influxql.MATCH
+		}
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:1083
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 135:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:888
+//line sql.y:1089
 		{
 			yyVAL.expr = &influxql.VarRef{Val: yyDollar[1].str}
 		}
-	case 136:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:892
+//line sql.y:1093
 		{
 			yyVAL.expr = &influxql.VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 137:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:896
+//line sql.y:1097
 		{
 			yyVAL.expr = &influxql.NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 138:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:900
+//line sql.y:1101
 		{
 			yyVAL.expr = &influxql.IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 139:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:904
+//line sql.y:1105
 		{
 			yyVAL.expr = &influxql.StringLiteral{Val: yyDollar[1].str}
 		}
-	case 140:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:1109
 		{
 			yyVAL.expr = &influxql.BooleanLiteral{Val: true}
 		}
-	case 141:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:912
+//line sql.y:1113
 		{
 			yyVAL.expr = &influxql.BooleanLiteral{Val: false}
 		}
-	case 142:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:916
+//line sql.y:1117
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2074,9 +2384,9 @@ yydefault:
 			}
 			yyVAL.expr = &influxql.RegexLiteral{Val: re}
 		}
-	case 143:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:926
+//line sql.y:1127
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2097,141 +2407,141 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 144:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:947
+//line sql.y:1148
 		{
 			yyVAL.dataType = influxql.Tag
 		}
-	case 145:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:951
+//line sql.y:1152
 		{
 			yyVAL.dataType = influxql.AnyField
 		}
-	case 146:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:957
+//line sql.y:1158
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 147:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:961
+//line sql.y:1162
 		{
 			yyVAL.sortfs = nil
 		}
-	case 148:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:967
+//line sql.y:1168
 		{
 			yyVAL.sortfs = []*influxql.SortField{yyDollar[1].sortf}
 		}
-	case 149:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:971
+//line sql.y:1172
 		{
 			yyVAL.sortfs = append([]*influxql.SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 150:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:977
+//line sql.y:1178
 		{
 			yyVAL.sortf = &influxql.SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 151:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:981
+//line sql.y:1182
 		{
 			yyVAL.sortf = &influxql.SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 152:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:985
+//line sql.y:1186
 		{
 			yyVAL.sortf = &influxql.SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 153:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:991
+//line sql.y:1192
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 154:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:997
+//line sql.y:1198
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 155:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1001
+//line sql.y:1202
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 156:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1005
+//line sql.y:1206
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 157:
+	case 180:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1009
+//line sql.y:1210
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 158:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1015
+//line sql.y:1216
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 159:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1019
+//line sql.y:1220
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 160:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1023
+//line sql.y:1224
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 161:
+	case 184:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1228
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 162:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1033
+//line sql.y:1234
 		{
 			yyVAL.stmt = &influxql.ShowDatabasesStatement{}
 		}
-	case 163:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1039
+//line sql.y:1240
 		{
 			sms := yyDollar[4].stmt
 
 			sms.(*influxql.CreateDatabaseStatement).Name = yyDollar[3].str
 			yyVAL.stmt = sms
 		}
-	case 164:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1046
+//line sql.y:1247
 		{
 			stmt := &influxql.CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 165:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1055
+//line sql.y:1256
 		{
 			stmt := &influxql.CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2271,15 +2581,15 @@ yydefault:
 			stmt.ReplicaNum = yyDollar[2].durations.ReplicaNum
 			yyVAL.stmt = stmt
 		}
-	case 166:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1099
+//line sql.y:1300
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 167:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1103
+//line sql.y:1304
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -2356,21 +2666,21 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 168:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1383
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 169:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1186
+//line sql.y:1387
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &yyDollar[2].tdur}
 		}
-	case 170:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1190
+//line sql.y:1391
 		{
 			if yyDollar[2].int64 < 1 || yyDollar[2].int64 > 2147483647 {
 				yylex.Error("REPLICATION must be 1 <= n <= 2147483647")
@@ -2378,36 +2688,36 @@ yydefault:
 			int_integer := *(*int)(unsafe.Pointer(&yyDollar[2].int64))
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &int_integer}
 		}
-	case 171:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1198
+//line sql.y:1399
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 172:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1202
+//line sql.y:1403
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 173:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1407
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 174:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1210
+//line sql.y:1411
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 175:
+	case 198:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1221
+//line sql.y:1422
 		{
 			sms := &influxql.ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -2418,9 +2728,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 176:
+	case 199:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1232
+//line sql.y:1433
 		{
 			sms := &influxql.ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -2430,21 +2740,21 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 177:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1245
+//line sql.y:1446
 		{
 			yyVAL.ment = &influxql.Measurement{Name: yyDollar[2].str}
 		}
-	case 178:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1249
+//line sql.y:1450
 		{
 			yyVAL.ment = &influxql.Measurement{Name: yyDollar[2].str}
 		}
-	case 179:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1253
+//line sql.y:1454
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -2452,9 +2762,9 @@ yydefault:
 			}
 			yyVAL.ment = &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: re}}
 		}
-	case 180:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1261
+//line sql.y:1462
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -2462,32 +2772,32 @@ yydefault:
 			}
 			yyVAL.ment = &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: re}}
 		}
-	case 181:
+	case 204:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1273
+//line sql.y:1474
 		{
 			yyVAL.stmt = &influxql.ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 182:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1279
+//line sql.y:1480
 		{
 			yyVAL.stmt = &influxql.ShowRetentionPoliciesStatement{}
 		}
-	case 183:
+	case 206:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1286
+//line sql.y:1487
 		{
 			stmt := yyDollar[7].stmt.(*influxql.CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 184:
+	case 207:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1293
+//line sql.y:1494
 		{
 			stmt := yyDollar[7].stmt.(*influxql.CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -2495,18 +2805,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 185:
+	case 208:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1303
+//line sql.y:1504
 		{
 			stmt := &influxql.CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 186:
+	case 209:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1310
+//line sql.y:1511
 		{
 			stmt := &influxql.CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -2514,9 +2824,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 187:
+	case 210:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1318
+//line sql.y:1519
 		{
 			stmt := &influxql.CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -2524,9 +2834,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 188:
+	case 211:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1329
+//line sql.y:1530
 		{
 			stmt := &influxql.CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -2561,9 +2871,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 189:
+	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1364
+//line sql.y:1565
 		{
 			stmt := &influxql.CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -2573,15 +2883,15 @@ yydefault:
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 190:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1377
+//line sql.y:1578
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 191:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1381
+//line sql.y:1582
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -2616,33 +2926,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 192:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1419
+//line sql.y:1620
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 193:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1423
+//line sql.y:1624
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 194:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1427
+//line sql.y:1628
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 195:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1431
+//line sql.y:1632
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 196:
+	case 219:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1439
+//line sql.y:1640
 		{
 			stmt := &influxql.ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -2653,9 +2963,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 197:
+	case 220:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1450
+//line sql.y:1651
 		{
 			stmt := &influxql.ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -2665,57 +2975,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 198:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1462
+//line sql.y:1663
 		{
 			yyVAL.stmt = &influxql.ShowUsersStatement{}
 		}
-	case 199:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1468
+//line sql.y:1669
 		{
 			stmt := &influxql.DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 200:
+	case 223:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1476
+//line sql.y:1677
 		{
 			stmt := &influxql.DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 201:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1483
+//line sql.y:1684
 		{
 			stmt := &influxql.DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 202:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1491
+//line sql.y:1692
 		{
 			stmt := &influxql.DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 203:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1498
+//line sql.y:1699
 		{
 			stmt := &influxql.DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 204:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1507
+//line sql.y:1708
 		{
 			stmt := &influxql.AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -2750,122 +3060,182 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 205:
+	case 228:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1546
+//line sql.y:1747
 		{
 			stmt := &influxql.DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 206:
+	case 229:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1555
+//line sql.y:1756
 		{
 			stmt := &influxql.GrantStatement{}
-			stmt.Privilege = influxql.AllPrivileges
-			stmt.On = yyDollar[4].str
+			stmt.Privilege = databasePrivilege(yylex, yyDollar[2].str, yyDollar[4].scope)
+			stmt.On = yyDollar[4].scope.Database
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 207:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1563
+//line sql.y:1764
 		{
-			stmt := &influxql.GrantStatement{}
-			stmt.Privilege = influxql.AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
+			stmt := &influxql.GrantRolePrivilegeStatement{}
+			stmt.Privilege = rolePrivilege(yylex, yyDollar[2].str)
+			stmt.On = *yyDollar[4].scope
+			stmt.Role = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 208:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1571
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1772
 		{
-			stmt := &influxql.GrantStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
-			case "read":
-				stmt.Privilege = influxql.ReadPrivilege
-			case "write":
-				stmt.Privilege = influxql.WritePrivilege
-			default:
-				yylex.Error("wrong Privilege")
+			yyVAL.stmt = &influxql.GrantRoleStatement{Role: yyDollar[2].str, User: yyDollar[4].str}
+		}
+	case 232:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1778
+		{
+			yyVAL.str = "all"
+		}
+	case 233:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1782
+		{
+			yyVAL.str = "all"
+		}
+	case 234:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1786
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 235:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1792
+		{
+			yyVAL.scope = &influxql.PrivilegeScope{}
+		}
+	case 236:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1796
+		{
+			yyVAL.scope = &influxql.PrivilegeScope{Database: yyDollar[1].str}
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1800
+		{
+			yyVAL.scope = &influxql.PrivilegeScope{Database: yyDollar[1].str, RetentionPolicy: yyDollar[3].str}
+		}
+	case 238:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1804
+		{
+			yyVAL.scope = &influxql.PrivilegeScope{Database: yyDollar[1].str, RetentionPolicy: yyDollar[3].str, Measurement: yyDollar[5].str}
+		}
+	case 239:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1808
+		{
+			re, err := regexp.Compile(yyDollar[5].str)
+			if err != nil {
+				yylex.Error("Invalid regexprs")
 			}
-			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
-			yyVAL.stmt = stmt
+			yyVAL.scope = &influxql.PrivilegeScope{Database: yyDollar[1].str, RetentionPolicy: yyDollar[3].str, Regex: &influxql.RegexLiteral{Val: re}}
 		}
-	case 209:
+	case 240:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1818
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1822
+		{
+			yyVAL.str = ""
+		}
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1588
+//line sql.y:1828
 		{
 			yyVAL.stmt = &influxql.GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 210:
+	case 243:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1592
+//line sql.y:1832
 		{
 			yyVAL.stmt = &influxql.GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 211:
+	case 244:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1598
+//line sql.y:1838
 		{
 			stmt := &influxql.RevokeStatement{}
-			stmt.Privilege = influxql.AllPrivileges
-			stmt.On = yyDollar[4].str
+			stmt.Privilege = databasePrivilege(yylex, yyDollar[2].str, yyDollar[4].scope)
+			stmt.On = yyDollar[4].scope.Database
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 212:
+	case 245:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1606
+//line sql.y:1846
 		{
-			stmt := &influxql.RevokeStatement{}
-			stmt.Privilege = influxql.AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
+			stmt := &influxql.RevokeRolePrivilegeStatement{}
+			stmt.Privilege = rolePrivilege(yylex, yyDollar[2].str)
+			stmt.On = *yyDollar[4].scope
+			stmt.Role = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 213:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1614
+	case 246:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1854
 		{
-			stmt := &influxql.RevokeStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
-			case "read":
-				stmt.Privilege = influxql.ReadPrivilege
-			case "write":
-				stmt.Privilege = influxql.WritePrivilege
-			default:
-				yylex.Error("wrong Privilege")
-			}
-			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
-			yyVAL.stmt = stmt
+			yyVAL.stmt = &influxql.RevokeRoleStatement{Role: yyDollar[2].str, User: yyDollar[4].str}
 		}
-	case 214:
+	case 247:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1631
+//line sql.y:1860
 		{
 			yyVAL.stmt = &influxql.RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 215:
+	case 248:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1635
+//line sql.y:1864
 		{
 			yyVAL.stmt = &influxql.RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 216:
+	case 249:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1870
+		{
+			yyVAL.stmt = &influxql.CreateRoleStatement{Name: yyDollar[3].str}
+		}
+	case 250:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.stmt = &influxql.DropRoleStatement{Name: yyDollar[3].str}
+		}
+	case 251:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1882
+		{
+			yyVAL.stmt = &influxql.ShowRolesStatement{}
+		}
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1641
+//line sql.y:1888
 		{
 			yyVAL.stmt = &influxql.DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 217:
+	case 253:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1647
+//line sql.y:1894
 		{
 			stmt := &influxql.ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -2879,9 +3249,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 218:
+	case 254:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1661
+//line sql.y:1908
 		{
 			stmt := &influxql.ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -2893,21 +3263,21 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 219:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1675
+//line sql.y:1922
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 220:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1679
+//line sql.y:1926
 		{
 			yyVAL.str = ""
 		}
-	case 221:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1685
+//line sql.y:1932
 		{
 			stmt := &influxql.ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -2917,9 +3287,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 222:
+	case 258:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1695
+//line sql.y:1942
 		{
 			stmt := &influxql.ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -2928,9 +3298,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 223:
+	case 259:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1707
+//line sql.y:1954
 		{
 			stmt := yyDollar[8].stmt.(*influxql.ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -2943,9 +3313,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 224:
+	case 260:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1720
+//line sql.y:1967
 		{
 			stmt := yyDollar[7].stmt.(*influxql.ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -2956,36 +3326,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 225:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1733
+//line sql.y:1980
 		{
 			stmt := &influxql.ShowTagValuesStatement{}
 			stmt.Op = influxql.EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*influxql.ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 226:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1740
+//line sql.y:1987
 		{
 			stmt := &influxql.ShowTagValuesStatement{}
 			stmt.Op = influxql.NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*influxql.ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 227:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1747
+//line sql.y:1994
 		{
 			stmt := &influxql.ShowTagValuesStatement{}
 			stmt.Op = influxql.IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*influxql.ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 228:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1754
+//line sql.y:2001
 		{
 			stmt := &influxql.ShowTagValuesStatement{}
 			stmt.Op = influxql.EQREGEX
@@ -2996,9 +3366,9 @@ yydefault:
 			stmt.TagKeyExpr = &influxql.RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 229:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1765
+//line sql.y:2012
 		{
 			stmt := &influxql.ShowTagValuesStatement{}
 			stmt.Op = influxql.NEQREGEX
@@ -3009,47 +3379,47 @@ yydefault:
 			stmt.TagKeyExpr = &influxql.RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 230:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1779
+//line sql.y:2026
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &influxql.ListLiteral{Vals: temp}
 		}
-	case 231:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1784
+//line sql.y:2031
 		{
 			yyDollar[3].expr.(*influxql.ListLiteral).Vals = append(yyDollar[3].expr.(*influxql.ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 232:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1791
+//line sql.y:2038
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 233:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1799
+//line sql.y:2046
 		{
 			stmt := &influxql.ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*influxql.SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 234:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1806
+//line sql.y:2053
 		{
 			stmt := &influxql.ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*influxql.SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 235:
+	case 271:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1816
+//line sql.y:2063
 		{
 			stmt := &influxql.ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3061,9 +3431,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 236:
+	case 272:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1828
+//line sql.y:2075
 		{
 			stmt := &influxql.ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3074,9 +3444,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 237:
+	case 273:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1839
+//line sql.y:2086
 		{
 			stmt := &influxql.ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3088,9 +3458,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 238:
+	case 274:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1851
+//line sql.y:2098
 		{
 			stmt := &influxql.ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3101,9 +3471,30 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 239:
+	case 275:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2114
+		{
+			stmt := &influxql.ShowTagCardinalityStatement{}
+			stmt.Database = yyDollar[4].str
+			stmt.Sources = yyDollar[5].sources
+			stmt.Limit = yyDollar[6].intSlice[0]
+			stmt.Offset = yyDollar[6].intSlice[1]
+			yyVAL.stmt = stmt
+		}
+	case 276:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2123
+		{
+			stmt := &influxql.ShowTagCardinalityStatement{}
+			stmt.Database = yyDollar[4].str
+			stmt.Limit = yyDollar[5].intSlice[0]
+			stmt.Offset = yyDollar[5].intSlice[1]
+			yyVAL.stmt = stmt
+		}
+	case 277:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:1867
+//line sql.y:2133
 		{
 			stmt := &influxql.ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3120,9 +3511,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 240:
+	case 278:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1884
+//line sql.y:2150
 		{
 			stmt := &influxql.ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3137,9 +3528,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 241:
+	case 279:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1899
+//line sql.y:2165
 		{
 			stmt := &influxql.ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3156,9 +3547,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 242:
+	case 280:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1916
+//line sql.y:2182
 		{
 			stmt := &influxql.ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3173,9 +3564,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 243:
+	case 281:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1934
+//line sql.y:2200
 		{
 			stmt := &influxql.ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3187,9 +3578,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 282:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1946
+//line sql.y:2212
 		{
 			stmt := &influxql.ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3200,9 +3591,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 283:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1957
+//line sql.y:2223
 		{
 			stmt := &influxql.ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3214,9 +3605,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 284:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1969
+//line sql.y:2235
 		{
 			stmt := &influxql.ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3227,9 +3618,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 285:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1983
+//line sql.y:2249
 		{
 			stmt := &influxql.CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3244,9 +3635,9 @@ yydefault:
 			stmt.Type = yyDollar[9].str
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 286:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1998
+//line sql.y:2264
 		{
 			stmt := &influxql.CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3257,9 +3648,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 287:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2009
+//line sql.y:2275
 		{
 			stmt := &influxql.CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3271,9 +3662,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2021
+//line sql.y:2287
 		{
 			stmt := &influxql.CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3282,18 +3673,18 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2032
+//line sql.y:2298
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 252:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2041
+//line sql.y:2307
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -3302,83 +3693,83 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 253:
+	case 291:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2050
+//line sql.y:2316
 		{
 			yyVAL.indexType = nil
 		}
-	case 254:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2056
+//line sql.y:2322
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 255:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2060
+//line sql.y:2326
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 256:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2067
+//line sql.y:2333
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 257:
+	case 295:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2071
+//line sql.y:2337
 		{
 			yyVAL.str = "hash"
 		}
-	case 258:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2077
+//line sql.y:2343
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 259:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2081
+//line sql.y:2347
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 260:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2352
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 261:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2092
+//line sql.y:2358
 		{
 			stmt := &influxql.DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 262:
+	case 300:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2100
+//line sql.y:2366
 		{
 			stmt := &influxql.SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 263:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2111
+//line sql.y:2377
 		{
 			stmt := &influxql.ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 264:
+	case 302:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2119
+//line sql.y:2385
 		{
 			stmt := &influxql.ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3390,9 +3781,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 265:
+	case 303:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2131
+//line sql.y:2397
 		{
 			stmt := &influxql.ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3403,9 +3794,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 266:
+	case 304:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2142
+//line sql.y:2408
 		{
 			stmt := &influxql.ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3417,9 +3808,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 267:
+	case 305:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2154
+//line sql.y:2420
 		{
 			stmt := &influxql.ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3430,9 +3821,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 268:
+	case 306:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2168
+//line sql.y:2434
 		{
 			stmt := &influxql.ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3444,9 +3835,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 269:
+	case 307:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2180
+//line sql.y:2446
 		{
 			stmt := &influxql.ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3457,9 +3848,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 270:
+	case 308:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2191
+//line sql.y:2457
 		{
 			stmt := &influxql.ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3471,9 +3862,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 271:
+	case 309:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2203
+//line sql.y:2469
 		{
 			stmt := &influxql.ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3484,16 +3875,16 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 272:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2217
+//line sql.y:2483
 		{
 			stmt := &influxql.ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 273:
+	case 311:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2225
+//line sql.y:2491
 		{
 			stmt := &influxql.AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3504,9 +3895,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 274:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2236
+//line sql.y:2502
 		{
 			stmt := &influxql.AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3515,21 +3906,214 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 275:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2250
+//line sql.y:2516
 		{
 			stmt := &influxql.ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 276:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2257
+//line sql.y:2523
 		{
 			stmt := &influxql.DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
+	case 315:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2531
+		{
+			stmt := &influxql.CreateContinuousQueryStatement{
+				Name:     yyDollar[4].str,
+				Database: yyDollar[6].str,
+				Source:   yyDollar[9].stmt.(*influxql.SelectStatement),
+			}
+			if yyDollar[7].cqsp != nil {
+				stmt.ResampleEvery = yyDollar[7].cqsp.ResampleEvery
+				stmt.ResampleFor = yyDollar[7].cqsp.ResampleFor
+			}
+			if err := stmt.Validate(); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.stmt = stmt
+		}
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2549
+		{
+			yyVAL.cqsp = &cqSamplePolicyInfo{ResampleEvery: yyDollar[3].tdur}
+		}
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2553
+		{
+			yyVAL.cqsp = &cqSamplePolicyInfo{ResampleFor: yyDollar[3].tdur}
+		}
+	case 318:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2557
+		{
+			yyVAL.cqsp = &cqSamplePolicyInfo{ResampleEvery: yyDollar[3].tdur, ResampleFor: yyDollar[5].tdur}
+		}
+	case 319:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2561
+		{
+			yyVAL.cqsp = nil
+		}
+	case 320:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2567
+		{
+			stmt := &influxql.DropContinuousQueryStatement{}
+			stmt.Name = yyDollar[4].str
+			stmt.Database = yyDollar[6].str
+			yyVAL.stmt = stmt
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2576
+		{
+			yyVAL.stmt = &influxql.ShowContinuousQueriesStatement{}
+		}
+	case 322:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:2582
+		{
+			stmt := &influxql.CreateDownSampleStatement{
+				Database:        yyDollar[3].strSlice[0],
+				RetentionPolicy: yyDollar[3].strSlice[1],
+				Calls:           yyDollar[5].dsCalls,
+				SampleIntervals: yyDollar[10].durationSlice,
+				TimeIntervals:   yyDollar[14].durationSlice,
+			}
+			if err := stmt.Validate(); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.stmt = stmt
+		}
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2598
+		{
+			yyVAL.stmt = &influxql.DropDownSampleStatement{Database: yyDollar[3].strSlice[0], RetentionPolicy: yyDollar[3].strSlice[1]}
+		}
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2604
+		{
+			yyVAL.stmt = &influxql.ShowDownSamplesStatement{Database: yyDollar[3].str}
+		}
+	case 325:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2610
+		{
+			yyVAL.strSlice = []string{yyDollar[2].str, yyDollar[4].str}
+		}
+	case 326:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2614
+		{
+			yyVAL.strSlice = []string{yyDollar[2].str, ""}
+		}
+	case 327:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2618
+		{
+			yyVAL.strSlice = []string{"", ""}
+		}
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2624
+		{
+			yyVAL.dsCalls = []*influxql.DownSampleCall{yyDollar[1].dsCall}
+		}
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2628
+		{
+			yyVAL.dsCalls = append(yyDollar[1].dsCalls, yyDollar[3].dsCall)
+		}
+	case 330:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2634
+		{
+			yyVAL.dsCall = &influxql.DownSampleCall{DataType: yyDollar[1].dataType, Ops: yyDollar[3].strSlice}
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2640
+		{
+			yyVAL.strSlice = []string{strings.ToLower(yyDollar[1].str)}
+		}
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2644
+		{
+			yyVAL.strSlice = append(yyDollar[1].strSlice, strings.ToLower(yyDollar[3].str))
+		}
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2650
+		{
+			yyVAL.durationSlice = []time.Duration{yyDollar[1].tdur}
+		}
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2654
+		{
+			yyVAL.durationSlice = append(yyDollar[1].durationSlice, yyDollar[3].tdur)
+		}
+	case 335:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2660
+		{
+			yyVAL.stmt = &influxql.CreateSubscriptionStatement{
+				Name:            yyDollar[3].str,
+				Database:        yyDollar[5].str,
+				RetentionPolicy: yyDollar[7].str,
+				Mode:            yyDollar[9].str,
+				Destinations:    yyDollar[10].strSlice,
+			}
+		}
+	case 336:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2672
+		{
+			yyVAL.stmt = &influxql.DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2678
+		{
+			yyVAL.stmt = &influxql.ShowSubscriptionsStatement{}
+		}
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2684
+		{
+			yyVAL.str = "ALL"
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2688
+		{
+			yyVAL.str = "ANY"
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2694
+		{
+			yyVAL.strSlice = []string{yyDollar[1].str}
+		}
+	case 341:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2698
+		{
+			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
+		}
 	}
 	goto yystack /* stack new state and value */
 }
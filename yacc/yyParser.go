@@ -152,6 +152,11 @@ type Durations struct {
 	ShardKey       []string
 }
 
+type cqSamplePolicyInfo struct {
+	ResampleEvery time.Duration
+	ResampleFor   time.Duration
+}
+
 type IndexType struct {
 	types []string
 	lists [][]string